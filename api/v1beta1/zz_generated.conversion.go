@@ -138,11 +138,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta2.IBMPowerVSImageStatus)(nil), (*IBMPowerVSImageStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta2_IBMPowerVSImageStatus_To_v1beta1_IBMPowerVSImageStatus(a.(*v1beta2.IBMPowerVSImageStatus), b.(*IBMPowerVSImageStatus), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*IBMPowerVSMachine)(nil), (*v1beta2.IBMPowerVSMachine)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_IBMPowerVSMachine_To_v1beta2_IBMPowerVSMachine(a.(*IBMPowerVSMachine), b.(*v1beta2.IBMPowerVSMachine), scope)
 	}); err != nil {
@@ -168,11 +163,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta2.IBMPowerVSMachineStatus)(nil), (*IBMPowerVSMachineStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta2_IBMPowerVSMachineStatus_To_v1beta1_IBMPowerVSMachineStatus(a.(*v1beta2.IBMPowerVSMachineStatus), b.(*IBMPowerVSMachineStatus), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*IBMPowerVSMachineTemplate)(nil), (*v1beta2.IBMPowerVSMachineTemplate)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_IBMPowerVSMachineTemplate_To_v1beta2_IBMPowerVSMachineTemplate(a.(*IBMPowerVSMachineTemplate), b.(*v1beta2.IBMPowerVSMachineTemplate), scope)
 	}); err != nil {
@@ -418,11 +408,21 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*v1beta2.IBMPowerVSImageStatus)(nil), (*IBMPowerVSImageStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_IBMPowerVSImageStatus_To_v1beta1_IBMPowerVSImageStatus(a.(*v1beta2.IBMPowerVSImageStatus), b.(*IBMPowerVSImageStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*v1beta2.IBMPowerVSMachineSpec)(nil), (*IBMPowerVSMachineSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_IBMPowerVSMachineSpec_To_v1beta1_IBMPowerVSMachineSpec(a.(*v1beta2.IBMPowerVSMachineSpec), b.(*IBMPowerVSMachineSpec), scope)
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*v1beta2.IBMPowerVSMachineStatus)(nil), (*IBMPowerVSMachineStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_IBMPowerVSMachineStatus_To_v1beta1_IBMPowerVSMachineStatus(a.(*v1beta2.IBMPowerVSMachineStatus), b.(*IBMPowerVSMachineStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*v1beta2.IBMVPCClusterSpec)(nil), (*IBMVPCClusterSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_IBMVPCClusterSpec_To_v1beta1_IBMVPCClusterSpec(a.(*v1beta2.IBMVPCClusterSpec), b.(*IBMVPCClusterSpec), scope)
 	}); err != nil {
@@ -555,6 +555,8 @@ func autoConvert_v1beta2_IBMPowerVSClusterSpec_To_v1beta1_IBMPowerVSClusterSpec(
 		return err
 	}
 	// WARNING: in.DHCPServer requires manual conversion: does not exist in peer-type
+	// WARNING: in.ManageDHCPNetwork requires manual conversion: does not exist in peer-type
+	// WARNING: in.NetworkRef requires manual conversion: does not exist in peer-type
 	out.ControlPlaneEndpoint = in.ControlPlaneEndpoint
 	// WARNING: in.ServiceInstance requires manual conversion: does not exist in peer-type
 	// WARNING: in.Zone requires manual conversion: does not exist in peer-type
@@ -563,9 +565,23 @@ func autoConvert_v1beta2_IBMPowerVSClusterSpec_To_v1beta1_IBMPowerVSClusterSpec(
 	// WARNING: in.VPCSubnets requires manual conversion: does not exist in peer-type
 	// WARNING: in.VPCSecurityGroups requires manual conversion: does not exist in peer-type
 	// WARNING: in.TransitGateway requires manual conversion: does not exist in peer-type
+	// WARNING: in.ManageTransitGateway requires manual conversion: does not exist in peer-type
+	// WARNING: in.TransitGatewayRef requires manual conversion: does not exist in peer-type
 	// WARNING: in.LoadBalancers requires manual conversion: does not exist in peer-type
+	// WARNING: in.DNS requires manual conversion: does not exist in peer-type
+	// WARNING: in.ManageLoadBalancer requires manual conversion: does not exist in peer-type
+	// WARNING: in.ControlPlaneProvisioningPolicy requires manual conversion: does not exist in peer-type
 	// WARNING: in.CosInstance requires manual conversion: does not exist in peer-type
 	// WARNING: in.Ignition requires manual conversion: does not exist in peer-type
+	// WARNING: in.Addons requires manual conversion: does not exist in peer-type
+	// WARNING: in.CredentialsSecretRef requires manual conversion: does not exist in peer-type
+	// WARNING: in.EnterpriseAccount requires manual conversion: does not exist in peer-type
+	// WARNING: in.PrivateCloud requires manual conversion: does not exist in peer-type
+	// WARNING: in.Tags requires manual conversion: does not exist in peer-type
+	// WARNING: in.Guardrails requires manual conversion: does not exist in peer-type
+	// WARNING: in.ReconcileIntervals requires manual conversion: does not exist in peer-type
+	// WARNING: in.ConnectivityCheck requires manual conversion: does not exist in peer-type
+	// WARNING: in.SSHKey requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -591,6 +607,9 @@ func autoConvert_v1beta2_IBMPowerVSClusterStatus_To_v1beta1_IBMPowerVSClusterSta
 	// WARNING: in.TransitGateway requires manual conversion: does not exist in peer-type
 	// WARNING: in.COSInstance requires manual conversion: does not exist in peer-type
 	// WARNING: in.LoadBalancers requires manual conversion: does not exist in peer-type
+	// WARNING: in.SSHKey requires manual conversion: does not exist in peer-type
+	// WARNING: in.DNSRecord requires manual conversion: does not exist in peer-type
+	// WARNING: in.FailureDomains requires manual conversion: does not exist in peer-type
 	// WARNING: in.Conditions requires manual conversion: does not exist in peer-type
 	return nil
 }
@@ -812,6 +831,12 @@ func autoConvert_v1beta2_IBMPowerVSImageSpec_To_v1beta1_IBMPowerVSImageSpec(in *
 	out.Region = (*string)(unsafe.Pointer(in.Region))
 	out.StorageType = in.StorageType
 	out.DeletePolicy = in.DeletePolicy
+	// WARNING: in.BucketAccess requires manual conversion: does not exist in peer-type
+	// WARNING: in.CredentialsSecretRef requires manual conversion: does not exist in peer-type
+	// WARNING: in.SourceImage requires manual conversion: does not exist in peer-type
+	// WARNING: in.SourceServiceInstance requires manual conversion: does not exist in peer-type
+	// WARNING: in.CaptureInstance requires manual conversion: does not exist in peer-type
+	// WARNING: in.Export requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -835,14 +860,14 @@ func autoConvert_v1beta2_IBMPowerVSImageStatus_To_v1beta1_IBMPowerVSImageStatus(
 	out.ImageState = PowerVSImageState(in.ImageState)
 	out.JobID = in.JobID
 	out.Conditions = *(*apiv1beta1.Conditions)(unsafe.Pointer(&in.Conditions))
+	// WARNING: in.JobProgress requires manual conversion: does not exist in peer-type
+	// WARNING: in.JobMessage requires manual conversion: does not exist in peer-type
+	// WARNING: in.Export requires manual conversion: does not exist in peer-type
+	// WARNING: in.SourceExport requires manual conversion: does not exist in peer-type
+	// WARNING: in.UsedBy requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1beta2_IBMPowerVSImageStatus_To_v1beta1_IBMPowerVSImageStatus is an autogenerated conversion function.
-func Convert_v1beta2_IBMPowerVSImageStatus_To_v1beta1_IBMPowerVSImageStatus(in *v1beta2.IBMPowerVSImageStatus, out *IBMPowerVSImageStatus, s conversion.Scope) error {
-	return autoConvert_v1beta2_IBMPowerVSImageStatus_To_v1beta1_IBMPowerVSImageStatus(in, out, s)
-}
-
 func autoConvert_v1beta1_IBMPowerVSMachine_To_v1beta2_IBMPowerVSMachine(in *IBMPowerVSMachine, out *v1beta2.IBMPowerVSMachine, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1beta1_IBMPowerVSMachineSpec_To_v1beta2_IBMPowerVSMachineSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -946,7 +971,21 @@ func autoConvert_v1beta2_IBMPowerVSMachineSpec_To_v1beta1_IBMPowerVSMachineSpec(
 	if err := Convert_v1beta2_IBMPowerVSResourceReference_To_v1beta1_IBMPowerVSResourceReference(&in.Network, &out.Network, s); err != nil {
 		return err
 	}
+	// WARNING: in.NetworkRef requires manual conversion: does not exist in peer-type
+	// WARNING: in.NetworkIPAddress requires manual conversion: does not exist in peer-type
+	// WARNING: in.AdditionalNetworks requires manual conversion: does not exist in peer-type
 	out.ProviderID = (*string)(unsafe.Pointer(in.ProviderID))
+	// WARNING: in.NTPServers requires manual conversion: does not exist in peer-type
+	// WARNING: in.SharedProcessorPool requires manual conversion: does not exist in peer-type
+	// WARNING: in.PlacementGroup requires manual conversion: does not exist in peer-type
+	// WARNING: in.AutoRepairPolicy requires manual conversion: does not exist in peer-type
+	// WARNING: in.HealthCheck requires manual conversion: does not exist in peer-type
+	// WARNING: in.ShutdownTimeout requires manual conversion: does not exist in peer-type
+	// WARNING: in.AdditionalVolumes requires manual conversion: does not exist in peer-type
+	// WARNING: in.UserTags requires manual conversion: does not exist in peer-type
+	// WARNING: in.NodeLabels requires manual conversion: does not exist in peer-type
+	// WARNING: in.NodeTaints requires manual conversion: does not exist in peer-type
+	// WARNING: in.ReportBootstrapResult requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -982,14 +1021,12 @@ func autoConvert_v1beta2_IBMPowerVSMachineStatus_To_v1beta1_IBMPowerVSMachineSta
 	out.Conditions = *(*apiv1beta1.Conditions)(unsafe.Pointer(&in.Conditions))
 	out.Region = (*string)(unsafe.Pointer(in.Region))
 	out.Zone = (*string)(unsafe.Pointer(in.Zone))
+	// WARNING: in.Networks requires manual conversion: does not exist in peer-type
+	// WARNING: in.DataVolumes requires manual conversion: does not exist in peer-type
+	// WARNING: in.ActionHistory requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1beta2_IBMPowerVSMachineStatus_To_v1beta1_IBMPowerVSMachineStatus is an autogenerated conversion function.
-func Convert_v1beta2_IBMPowerVSMachineStatus_To_v1beta1_IBMPowerVSMachineStatus(in *v1beta2.IBMPowerVSMachineStatus, out *IBMPowerVSMachineStatus, s conversion.Scope) error {
-	return autoConvert_v1beta2_IBMPowerVSMachineStatus_To_v1beta1_IBMPowerVSMachineStatus(in, out, s)
-}
-
 func autoConvert_v1beta1_IBMPowerVSMachineTemplate_To_v1beta2_IBMPowerVSMachineTemplate(in *IBMPowerVSMachineTemplate, out *v1beta2.IBMPowerVSMachineTemplate, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1beta1_IBMPowerVSMachineTemplateSpec_To_v1beta2_IBMPowerVSMachineTemplateSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -1268,8 +1305,17 @@ func autoConvert_v1beta2_IBMVPCClusterSpec_To_v1beta1_IBMVPCClusterSpec(in *v1be
 	} else {
 		out.ControlPlaneLoadBalancer = nil
 	}
+	// WARNING: in.DNS requires manual conversion: does not exist in peer-type
 	// WARNING: in.Image requires manual conversion: does not exist in peer-type
 	// WARNING: in.Network requires manual conversion: does not exist in peer-type
+	// WARNING: in.ResourceSelector requires manual conversion: does not exist in peer-type
+	// WARNING: in.Tags requires manual conversion: does not exist in peer-type
+	// WARNING: in.Guardrails requires manual conversion: does not exist in peer-type
+	// WARNING: in.ReconcileIntervals requires manual conversion: does not exist in peer-type
+	// WARNING: in.ConnectivityCheck requires manual conversion: does not exist in peer-type
+	// WARNING: in.CredentialsSecretRef requires manual conversion: does not exist in peer-type
+	// WARNING: in.EnterpriseAccount requires manual conversion: does not exist in peer-type
+	// WARNING: in.BootstrapDataBucket requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -1309,6 +1355,9 @@ func autoConvert_v1beta2_IBMVPCClusterStatus_To_v1beta1_IBMVPCClusterStatus(in *
 		return err
 	}
 	out.ControlPlaneLoadBalancerState = VPCLoadBalancerState(in.ControlPlaneLoadBalancerState)
+	// WARNING: in.LoadBalancerPoolMembers requires manual conversion: does not exist in peer-type
+	// WARNING: in.DNSRecord requires manual conversion: does not exist in peer-type
+	// WARNING: in.FailureDomains requires manual conversion: does not exist in peer-type
 	out.Conditions = *(*apiv1beta1.Conditions)(unsafe.Pointer(&in.Conditions))
 	return nil
 }
@@ -1409,6 +1458,8 @@ func autoConvert_v1beta2_IBMVPCMachineSpec_To_v1beta1_IBMVPCMachineSpec(in *v1be
 	out.Name = in.Name
 	// WARNING: in.CatalogOffering requires manual conversion: does not exist in peer-type
 	// WARNING: in.PlacementTarget requires manual conversion: does not exist in peer-type
+	// WARNING: in.Capacity requires manual conversion: does not exist in peer-type
+	// WARNING: in.AutoRepairPolicy requires manual conversion: does not exist in peer-type
 	// WARNING: in.Image requires manual conversion: inconvertible types (*sigs.k8s.io/cluster-api-provider-ibmcloud/api/v1beta2.IBMVPCResourceReference vs string)
 	// WARNING: in.LoadBalancerPoolMembers requires manual conversion: does not exist in peer-type
 	out.Zone = in.Zone
@@ -1418,9 +1469,17 @@ func autoConvert_v1beta2_IBMVPCMachineSpec_To_v1beta1_IBMVPCMachineSpec(in *v1be
 	if err := Convert_v1beta2_NetworkInterface_To_v1beta1_NetworkInterface(&in.PrimaryNetworkInterface, &out.PrimaryNetworkInterface, s); err != nil {
 		return err
 	}
+	// WARNING: in.SecurityGroups requires manual conversion: does not exist in peer-type
 	if err := Convert_Slice_Pointer_v1beta2_IBMVPCResourceReference_To_Slice_Pointer_string(&in.SSHKeys, &out.SSHKeys, s); err != nil {
 		return err
 	}
+	// WARNING: in.ShutdownTimeout requires manual conversion: does not exist in peer-type
+	// WARNING: in.AdditionalVolumes requires manual conversion: does not exist in peer-type
+	// WARNING: in.EncryptionKeyCRN requires manual conversion: does not exist in peer-type
+	// WARNING: in.UserTags requires manual conversion: does not exist in peer-type
+	// WARNING: in.NodeLabels requires manual conversion: does not exist in peer-type
+	// WARNING: in.NodeTaints requires manual conversion: does not exist in peer-type
+	// WARNING: in.ReportBootstrapResult requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -1446,6 +1505,7 @@ func autoConvert_v1beta2_IBMVPCMachineStatus_To_v1beta1_IBMVPCMachineStatus(in *
 	// WARNING: in.FailureMessage requires manual conversion: does not exist in peer-type
 	out.InstanceStatus = in.InstanceStatus
 	// WARNING: in.LoadBalancerPoolMembers requires manual conversion: does not exist in peer-type
+	// WARNING: in.ActionHistory requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -17,10 +17,22 @@ limitations under the License.
 package v1beta2
 
 import (
+	"fmt"
+	"reflect"
+	"sort"
 	"strconv"
+	"strings"
 
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/supportmatrix"
 )
 
 func defaultIBMPowerVSMachineSpec(spec *IBMPowerVSMachineSpec) {
@@ -52,6 +64,90 @@ func validateIBMPowerVSNetworkReference(res IBMPowerVSResourceReference) (bool,
 	return true, nil
 }
 
+func validateIBMPowerVSAdditionalVolumes(spec IBMPowerVSMachineSpec) field.ErrorList {
+	var allErrs field.ErrorList
+
+	names := map[string]bool{}
+	for i, volume := range spec.AdditionalVolumes {
+		if volume == nil {
+			continue
+		}
+		name := dataVolumeName(i, volume.Name)
+		if names[name] {
+			allErrs = append(allErrs, field.Duplicate(field.NewPath("spec", "additionalVolumes").Index(i).Child("name"), name))
+		}
+		names[name] = true
+	}
+
+	return allErrs
+}
+
+func validateIBMPowerVSAdditionalNetworks(spec IBMPowerVSMachineSpec) field.ErrorList {
+	var allErrs field.ErrorList
+
+	ipAddresses := map[string]bool{}
+	if spec.NetworkIPAddress != "" {
+		ipAddresses[spec.NetworkIPAddress] = true
+	}
+	for i, attachment := range spec.AdditionalNetworks {
+		path := field.NewPath("spec", "additionalNetworks").Index(i)
+		network := attachment.Network
+		switch {
+		case network.ID == nil && network.Name == nil && network.RegEx == nil:
+			allErrs = append(allErrs, field.Required(path.Child("network"), "one of network - ID, Name or RegEx must be specified"))
+		case (network.ID != nil && network.Name != nil) || (network.ID != nil && network.RegEx != nil) || (network.Name != nil && network.RegEx != nil):
+			allErrs = append(allErrs, field.Invalid(path.Child("network"), network, "Only one of Network - ID, Name or RegEx can be specified"))
+		}
+		if attachment.IPAddress == "" {
+			continue
+		}
+		if ipAddresses[attachment.IPAddress] {
+			allErrs = append(allErrs, field.Duplicate(path.Child("ipAddress"), attachment.IPAddress))
+		}
+		ipAddresses[attachment.IPAddress] = true
+	}
+
+	return allErrs
+}
+
+// dataVolumeName returns the name of the data volume at index i without the machine name prefix added by the controller.
+func dataVolumeName(i int, name string) string {
+	if name != "" {
+		return name
+	}
+	return fmt.Sprintf("data-%d", i)
+}
+
+func validateIBMPowerVSPlacement(spec IBMPowerVSMachineSpec) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if spec.SharedProcessorPool != nil {
+		if spec.SharedProcessorPool.RegEx != nil {
+			allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "sharedProcessorPool", "regex"), "only ID or Name of sharedProcessorPool can be specified"))
+		}
+		if res, err := validateIBMPowerVSResourceReference(*spec.SharedProcessorPool, "sharedProcessorPool"); !res {
+			allErrs = append(allErrs, err)
+		}
+		if spec.ProcessorType == PowerVSProcessorTypeDedicated {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "processorType"), spec.ProcessorType, "instances in a shared processor pool must use the Shared or Capped processor type"))
+		}
+	}
+
+	if spec.PlacementGroup != nil {
+		if spec.PlacementGroup.RegEx != nil {
+			allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "placementGroup", "regex"), "only ID or Name of placementGroup can be specified"))
+		}
+		if res, err := validateIBMPowerVSResourceReference(*spec.PlacementGroup, "placementGroup"); !res {
+			allErrs = append(allErrs, err)
+		}
+		if spec.SharedProcessorPool != nil {
+			allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "placementGroup"), "placementGroup can't be set along with sharedProcessorPool"))
+		}
+	}
+
+	return allErrs
+}
+
 func validateIBMPowerVSMemoryValues(resValue int32) bool {
 	if val := float64(resValue); val < 2 {
 		return false
@@ -74,6 +170,181 @@ func validateIBMPowerVSProcessorValues(resValue intstr.IntOrString) bool {
 	return true
 }
 
+func validateClusterGuardrails(guardrails *ClusterGuardrails) *field.Error {
+	if guardrails == nil || guardrails.MaxTotalCores == nil {
+		return nil
+	}
+	cores := *guardrails.MaxTotalCores
+	switch cores.Type {
+	case intstr.Int:
+		if cores.IntVal >= 0 {
+			return nil
+		}
+	case intstr.String:
+		if val, err := strconv.ParseFloat(cores.StrVal, 64); err == nil && val >= 0 {
+			return nil
+		}
+	}
+	return field.Invalid(field.NewPath("spec", "guardrails", "maxTotalCores"), cores.String(), "Invalid maxTotalCores value - must be a non-negative number")
+}
+
+func validateReconcileIntervals(intervals *ReconcileIntervals) (allErrs field.ErrorList) {
+	if intervals == nil {
+		return nil
+	}
+	path := field.NewPath("spec", "reconcileIntervals")
+	for _, named := range []struct {
+		name     string
+		interval *ReconcileInterval
+	}{{"cluster", intervals.Cluster}, {"machines", intervals.Machines}, {"images", intervals.Images}} {
+		name, interval := named.name, named.interval
+		if interval == nil {
+			continue
+		}
+		if interval.RequeueInterval != nil && interval.RequeueInterval.Duration < 0 {
+			allErrs = append(allErrs, field.Invalid(path.Child(name, "requeueInterval"), interval.RequeueInterval.Duration.String(), "must not be negative"))
+		}
+		if interval.SyncPeriod != nil && interval.SyncPeriod.Duration < 0 {
+			allErrs = append(allErrs, field.Invalid(path.Child(name, "syncPeriod"), interval.SyncPeriod.Duration.String(), "must not be negative"))
+		}
+	}
+	return allErrs
+}
+
+// validateConnectivityCheck validates the timeout and the retry interval of the connectivity self-test of a cluster.
+func validateConnectivityCheck(check *ConnectivityCheck) (allErrs field.ErrorList) {
+	if check == nil {
+		return nil
+	}
+	path := field.NewPath("spec", "connectivityCheck")
+	if check.Timeout != nil && check.Timeout.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(path.Child("timeout"), check.Timeout.Duration.String(), "must be positive"))
+	}
+	if check.RetryInterval != nil && check.RetryInterval.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(path.Child("retryInterval"), check.RetryInterval.Duration.String(), "must be positive"))
+	}
+	return allErrs
+}
+
+// validateDNSUpdate forbids removing the DNS record of a cluster, or moving it to another zone, while the record created by the
+// controller is registered in status, as the record could no longer be deleted along with the cluster.
+func validateDNSUpdate(oldDNS, newDNS *DNSSpec, record *ResourceReference) *field.Error {
+	if oldDNS == nil || record == nil || record.ID == nil || record.ControllerCreated == nil || !*record.ControllerCreated {
+		return nil
+	}
+	path := field.NewPath("spec", "dns")
+	if newDNS == nil {
+		return field.Forbidden(path, "dns cannot be removed while the record created for the cluster exists")
+	}
+	if newDNS.Provider != oldDNS.Provider || newDNS.InstanceID != oldDNS.InstanceID || newDNS.ZoneID != oldDNS.ZoneID {
+		return field.Forbidden(path, "provider, instanceID and zoneID cannot be changed while the record created for the cluster exists")
+	}
+	return nil
+}
+
+// validateEnterpriseAccount verifies the project of the enterprise account, when set, is referenced by the CRN of an IBM Cloud project,
+// which holds the ID of the child account owning the project.
+func validateEnterpriseAccount(path *field.Path, account *EnterpriseAccount) *field.Error {
+	if account == nil || account.ProjectCRN == nil {
+		return nil
+	}
+	segments := strings.Split(*account.ProjectCRN, ":")
+	if len(segments) != 10 || segments[0] != "crn" || segments[1] != "v1" || segments[4] != "project" ||
+		!strings.HasPrefix(segments[6], "a/") || len(segments[6]) == len("a/") || segments[7] == "" {
+		return field.Invalid(path.Child("projectCRN"), *account.ProjectCRN, "must be the CRN of an IBM Cloud project in the format crn:v1:<cname>:<ctype>:project:<location>:a/<account-id>:<project-id>::")
+	}
+	return nil
+}
+
+// validateEnterpriseAccountUpdate forbids setting, removing or changing the enterprise account once the object is created,
+// as the resources already provisioned into the child account could no longer be managed.
+func validateEnterpriseAccountUpdate(oldAccount, newAccount *EnterpriseAccount) *field.Error {
+	if !reflect.DeepEqual(oldAccount, newAccount) {
+		return field.Forbidden(field.NewPath("spec", "enterpriseAccount"), "enterpriseAccount is immutable")
+	}
+	return nil
+}
+
+// validateInstanceHealthCheck validates the interval and the unhealthy timeout of the health check of the instance of a machine.
+func validateInstanceHealthCheck(check *PowerVSInstanceHealthCheck) (allErrs field.ErrorList) {
+	if check == nil {
+		return nil
+	}
+	path := field.NewPath("spec", "healthCheck")
+	if check.Interval != nil && check.Interval.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(path.Child("interval"), check.Interval.Duration.String(), "must be positive"))
+	}
+	if check.UnhealthyTimeout != nil && check.UnhealthyTimeout.Duration < 0 {
+		allErrs = append(allErrs, field.Invalid(path.Child("unhealthyTimeout"), check.UnhealthyTimeout.Duration.String(), "must not be negative"))
+	}
+	return allErrs
+}
+
+// validateShutdownTimeout validates the time to wait for the instance of a machine to shut down before it gets deleted.
+func validateShutdownTimeout(timeout *metav1.Duration) field.ErrorList {
+	if timeout == nil || timeout.Duration >= 0 {
+		return nil
+	}
+	return field.ErrorList{field.Invalid(field.NewPath("spec", "shutdownTimeout"), timeout.Duration.String(), "must not be negative")}
+}
+
+// validateNodeRegistration validates the labels and taints the node of a machine registers with, which are passed
+// to the kubelet as is.
+func validateNodeRegistration(labels map[string]string, taints []corev1.Taint) (allErrs field.ErrorList) {
+	labelsPath := field.NewPath("spec", "nodeLabels")
+	keys := make([]string, 0, len(labels))
+	for key := range labels {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		for _, msg := range validation.IsQualifiedName(key) {
+			allErrs = append(allErrs, field.Invalid(labelsPath, key, msg))
+		}
+		for _, msg := range validation.IsValidLabelValue(labels[key]) {
+			allErrs = append(allErrs, field.Invalid(labelsPath.Key(key), labels[key], msg))
+		}
+		if isKubeletRestrictedLabel(key) {
+			allErrs = append(allErrs, field.Invalid(labelsPath, key, "labels in the kubernetes.io and k8s.io namespaces can't be set by the kubelet, except for the kubelet.kubernetes.io and node.kubernetes.io ones"))
+		}
+	}
+
+	effects := sets.New(corev1.TaintEffectNoSchedule, corev1.TaintEffectPreferNoSchedule, corev1.TaintEffectNoExecute)
+	seen := map[string]bool{}
+	for i, taint := range taints {
+		path := field.NewPath("spec", "nodeTaints").Index(i)
+		for _, msg := range validation.IsQualifiedName(taint.Key) {
+			allErrs = append(allErrs, field.Invalid(path.Child("key"), taint.Key, msg))
+		}
+		for _, msg := range validation.IsValidLabelValue(taint.Value) {
+			allErrs = append(allErrs, field.Invalid(path.Child("value"), taint.Value, msg))
+		}
+		if !effects.Has(taint.Effect) {
+			allErrs = append(allErrs, field.NotSupported(path.Child("effect"), taint.Effect, sets.List(effects)))
+		}
+		if id := taint.Key + ":" + string(taint.Effect); seen[id] {
+			allErrs = append(allErrs, field.Duplicate(path, id))
+		} else {
+			seen[id] = true
+		}
+	}
+	return allErrs
+}
+
+// isKubeletRestrictedLabel returns whether the kubelet refuses to register its node with the label.
+func isKubeletRestrictedLabel(key string) bool {
+	prefix, _, found := strings.Cut(key, "/")
+	if !found {
+		return false
+	}
+	if prefix == "kubelet.kubernetes.io" || strings.HasSuffix(prefix, ".kubelet.kubernetes.io") ||
+		prefix == "node.kubernetes.io" || strings.HasSuffix(prefix, ".node.kubernetes.io") {
+		return false
+	}
+	return prefix == "kubernetes.io" || strings.HasSuffix(prefix, ".kubernetes.io") ||
+		prefix == "k8s.io" || strings.HasSuffix(prefix, ".k8s.io")
+}
+
 func defaultIBMVPCMachineSpec(spec *IBMVPCMachineSpec) {
 	if spec.Profile == "" {
 		spec.Profile = "bx2-2x8"
@@ -95,7 +366,121 @@ func validateBootVolume(spec IBMVPCMachineSpec) field.ErrorList {
 		allErrs = append(allErrs, field.Invalid(field.NewPath("spec.bootVolume.iops"), spec, "iops applicable only to volumes using a profile of type `custom`"))
 	}
 
-	//TODO: Add validation for the spec.BootVolume.EncryptionKeyCRN to ensure its in proper IBM Cloud CRN format
+	return allErrs
+}
+
+func validateAdditionalVolumes(spec IBMVPCMachineSpec) field.ErrorList {
+	var allErrs field.ErrorList
+
+	names := map[string]bool{}
+	for i, volume := range spec.AdditionalVolumes {
+		if volume == nil {
+			continue
+		}
+		path := field.NewPath("spec", "additionalVolumes").Index(i)
+		if volume.SizeGiB < 10 || volume.SizeGiB > 16000 {
+			allErrs = append(allErrs, field.Invalid(path.Child("sizeGiB"), volume.SizeGiB, "valid data VPCVolume size is 10 - 16000 GB"))
+		}
+		if volume.Iops != 0 && volume.Profile != "custom" {
+			allErrs = append(allErrs, field.Invalid(path.Child("iops"), volume.Iops, "iops applicable only to volumes using a profile of type `custom`"))
+		}
+		name := dataVolumeName(i, volume.Name)
+		if names[name] {
+			allErrs = append(allErrs, field.Duplicate(path.Child("name"), name))
+		}
+		names[name] = true
+	}
+
+	return allErrs
+}
+
+func validateEncryptionKeyCRNs(spec IBMVPCMachineSpec) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if spec.EncryptionKeyCRN != "" {
+		if err := validateEncryptionKeyCRN(field.NewPath("spec", "encryptionKeyCRN"), spec.EncryptionKeyCRN); err != nil {
+			allErrs = append(allErrs, err)
+		}
+	}
+	if spec.BootVolume != nil && spec.BootVolume.EncryptionKeyCRN != "" {
+		if err := validateEncryptionKeyCRN(field.NewPath("spec", "bootVolume", "encryptionKeyCRN"), spec.BootVolume.EncryptionKeyCRN); err != nil {
+			allErrs = append(allErrs, err)
+		}
+	}
+	for i, volume := range spec.AdditionalVolumes {
+		if volume == nil || volume.EncryptionKeyCRN == "" {
+			continue
+		}
+		if err := validateEncryptionKeyCRN(field.NewPath("spec", "additionalVolumes").Index(i).Child("encryptionKeyCRN"), volume.EncryptionKeyCRN); err != nil {
+			allErrs = append(allErrs, err)
+		}
+	}
+
+	return allErrs
+}
+
+// validateEncryptionKeyCRN verifies the CRN is in the format crn:v1:<cname>:<ctype>:<service>:<location>:a/<account>:<instance>:key:<key>
+// and points to the root key of a Key Protect (kms) or Hyper Protect Crypto Services (hs-crypto) instance.
+func validateEncryptionKeyCRN(path *field.Path, crn string) *field.Error {
+	segments := strings.Split(crn, ":")
+	if len(segments) != 10 || segments[0] != "crn" || segments[1] != "v1" || !strings.HasPrefix(segments[6], "a/") ||
+		segments[7] == "" || segments[8] != "key" || segments[9] == "" {
+		return field.Invalid(path, crn, "must be a CRN in the format crn:v1:<cname>:<ctype>:<service-name>:<location>:a/<account-id>:<service-instance>:key:<key-id>")
+	}
+	if segments[4] != "kms" && segments[4] != "hs-crypto" {
+		return field.Invalid(path, crn, "must be the CRN of a Key Protect (kms) or Hyper Protect Crypto Services (hs-crypto) root key")
+	}
+	return nil
+}
+
+// PlacementTargetZoneLookup returns the zone of the dedicated host or dedicated host group of the placement target of a machine in the zone.
+// It is set when the webhooks are set up, the placement target is not looked up on admission when it is nil.
+var PlacementTargetZoneLookup func(zone string, placementTarget VPCMachinePlacementTarget) (string, error)
+
+func validatePlacementTarget(spec IBMVPCMachineSpec) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if spec.PlacementTarget == nil || (spec.PlacementTarget.DedicatedHost == nil && spec.PlacementTarget.DedicatedHostGroup == nil) {
+		return allErrs
+	}
+
+	if spec.Capacity != nil && spec.Capacity.Reservation != nil {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec.capacity.reservation"), "reserved capacity cannot be used with a dedicated host or dedicated host group placement target"))
+	}
+
+	// The zone of the dedicated host or group is verified again by the controller before creating the instance,
+	// as the failure domain of the owning Machine takes precedence over the zone of the spec.
+	if spec.Zone == "" || PlacementTargetZoneLookup == nil {
+		return allErrs
+	}
+	zone, err := PlacementTargetZoneLookup(spec.Zone, *spec.PlacementTarget)
+	if err != nil {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec.placementTarget"), spec.PlacementTarget, err.Error()))
+	} else if zone != spec.Zone {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec.placementTarget"), spec.PlacementTarget, fmt.Sprintf("must be in zone %s, not in zone %s", spec.Zone, zone)))
+	}
 
 	return allErrs
 }
+
+// validatePlacementTargetUpdate validates the placement target when it, the zone or the capacity of the machine changed,
+// so a dedicated host removed afterwards does not prevent the machine from being updated.
+func validatePlacementTargetUpdate(spec, oldSpec IBMVPCMachineSpec) field.ErrorList {
+	if reflect.DeepEqual(spec.PlacementTarget, oldSpec.PlacementTarget) && spec.Zone == oldSpec.Zone && reflect.DeepEqual(spec.Capacity, oldSpec.Capacity) {
+		return nil
+	}
+	return validatePlacementTarget(spec)
+}
+
+// vpcGPUProfilePrefix is the prefix of the VPC GPU instance profile families.
+const vpcGPUProfilePrefix = "gx"
+
+func validateVPCMachineProfile(spec IBMVPCMachineSpec) admission.Warnings {
+	if spec.Zone == "" || !strings.HasPrefix(spec.Profile, vpcGPUProfilePrefix) {
+		return nil
+	}
+	if !supportmatrix.IsSupported(supportmatrix.GPUProfiles, spec.Zone) {
+		return admission.Warnings{fmt.Sprintf("GPU profile %s may not be available in zone %s", spec.Profile, spec.Zone)}
+	}
+	return nil
+}
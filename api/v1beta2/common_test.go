@@ -17,9 +17,15 @@ limitations under the License.
 package v1beta2
 
 import (
+	"fmt"
 	"testing"
+	"time"
 
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 )
 
 func TestValidateIBMPowerVSMemoryValues(t *testing.T) {
@@ -110,6 +116,163 @@ func TestValidateIBMPowerVSProcessorValues(t *testing.T) {
 	}
 }
 
+func Test_validateIBMPowerVSPlacement(t *testing.T) {
+	tests := []struct {
+		name      string
+		spec      IBMPowerVSMachineSpec
+		wantError bool
+	}{
+		{
+			name:      "No placement",
+			spec:      IBMPowerVSMachineSpec{},
+			wantError: false,
+		},
+		{
+			name: "Placement group by name",
+			spec: IBMPowerVSMachineSpec{
+				PlacementGroup: &IBMPowerVSResourceReference{Name: ptr.To("placement-group")},
+			},
+			wantError: false,
+		},
+		{
+			name: "Shared processor pool with shared processors",
+			spec: IBMPowerVSMachineSpec{
+				ProcessorType:       PowerVSProcessorTypeShared,
+				SharedProcessorPool: &IBMPowerVSResourceReference{ID: ptr.To("pool-id")},
+			},
+			wantError: false,
+		},
+		{
+			name: "Shared processor pool with dedicated processors",
+			spec: IBMPowerVSMachineSpec{
+				ProcessorType:       PowerVSProcessorTypeDedicated,
+				SharedProcessorPool: &IBMPowerVSResourceReference{ID: ptr.To("pool-id")},
+			},
+			wantError: true,
+		},
+		{
+			name: "Shared processor pool by regex",
+			spec: IBMPowerVSMachineSpec{
+				SharedProcessorPool: &IBMPowerVSResourceReference{RegEx: ptr.To("pool-*")},
+			},
+			wantError: true,
+		},
+		{
+			name: "Placement group with both ID and Name",
+			spec: IBMPowerVSMachineSpec{
+				PlacementGroup: &IBMPowerVSResourceReference{ID: ptr.To("placement-group-id"), Name: ptr.To("placement-group")},
+			},
+			wantError: true,
+		},
+		{
+			name: "Placement group along with shared processor pool",
+			spec: IBMPowerVSMachineSpec{
+				PlacementGroup:      &IBMPowerVSResourceReference{Name: ptr.To("placement-group")},
+				SharedProcessorPool: &IBMPowerVSResourceReference{Name: ptr.To("pool")},
+			},
+			wantError: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateIBMPowerVSPlacement(tt.spec); (err != nil) != tt.wantError {
+				t.Errorf("validateIBMPowerVSPlacement() = %v, wantError %v", err, tt.wantError)
+			}
+		})
+	}
+}
+
+func Test_validateIBMPowerVSAdditionalVolumes(t *testing.T) {
+	tests := []struct {
+		name      string
+		spec      IBMPowerVSMachineSpec
+		wantError bool
+	}{
+		{
+			name:      "No additional volumes",
+			spec:      IBMPowerVSMachineSpec{},
+			wantError: false,
+		},
+		{
+			name: "Unique volume names",
+			spec: IBMPowerVSMachineSpec{
+				AdditionalVolumes: []*PowerVSVolume{{SizeGiB: 100}, {Name: "etcd", SizeGiB: 20}},
+			},
+			wantError: false,
+		},
+		{
+			name: "Duplicate volume names",
+			spec: IBMPowerVSMachineSpec{
+				AdditionalVolumes: []*PowerVSVolume{{Name: "etcd", SizeGiB: 100}, {Name: "etcd", SizeGiB: 20}},
+			},
+			wantError: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateIBMPowerVSAdditionalVolumes(tt.spec); (err != nil) != tt.wantError {
+				t.Errorf("validateIBMPowerVSAdditionalVolumes() = %v, wantError %v", err, tt.wantError)
+			}
+		})
+	}
+}
+
+func Test_validateIBMPowerVSAdditionalNetworks(t *testing.T) {
+	tests := []struct {
+		name      string
+		spec      IBMPowerVSMachineSpec
+		wantError bool
+	}{
+		{
+			name:      "No additional networks",
+			spec:      IBMPowerVSMachineSpec{NetworkIPAddress: "192.168.0.10"},
+			wantError: false,
+		},
+		{
+			name: "Additional networks with static IP addresses",
+			spec: IBMPowerVSMachineSpec{
+				NetworkIPAddress: "192.168.0.10",
+				AdditionalNetworks: []PowerVSNetworkAttachment{
+					{Network: IBMPowerVSResourceReference{Name: ptr.To("data-plane")}, IPAddress: "10.0.0.10"},
+					{Network: IBMPowerVSResourceReference{RegEx: ptr.To("^storage")}},
+				},
+			},
+			wantError: false,
+		},
+		{
+			name: "Additional network without reference",
+			spec: IBMPowerVSMachineSpec{
+				AdditionalNetworks: []PowerVSNetworkAttachment{{IPAddress: "10.0.0.10"}},
+			},
+			wantError: true,
+		},
+		{
+			name: "Additional network with both ID and Name",
+			spec: IBMPowerVSMachineSpec{
+				AdditionalNetworks: []PowerVSNetworkAttachment{{Network: IBMPowerVSResourceReference{ID: ptr.To("data-plane-id"), Name: ptr.To("data-plane")}}},
+			},
+			wantError: true,
+		},
+		{
+			name: "Duplicate static IP addresses",
+			spec: IBMPowerVSMachineSpec{
+				NetworkIPAddress: "10.0.0.10",
+				AdditionalNetworks: []PowerVSNetworkAttachment{
+					{Network: IBMPowerVSResourceReference{Name: ptr.To("data-plane")}, IPAddress: "10.0.0.10"},
+				},
+			},
+			wantError: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateIBMPowerVSAdditionalNetworks(tt.spec); (err != nil) != tt.wantError {
+				t.Errorf("validateIBMPowerVSAdditionalNetworks() = %v, wantError %v", err, tt.wantError)
+			}
+		})
+	}
+}
+
 func Test_validateBootVolume(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -167,3 +330,457 @@ func Test_validateBootVolume(t *testing.T) {
 		})
 	}
 }
+
+func Test_validateAdditionalVolumes(t *testing.T) {
+	tests := []struct {
+		name      string
+		spec      IBMVPCMachineSpec
+		wantError bool
+	}{
+		{
+			name:      "No additional volumes",
+			spec:      IBMVPCMachineSpec{},
+			wantError: false,
+		},
+		{
+			name: "Valid additional volumes",
+			spec: IBMVPCMachineSpec{
+				AdditionalVolumes: []*VPCVolume{{SizeGiB: 100}, {Name: "etcd", SizeGiB: 20, Iops: 3000, Profile: "custom"}},
+			},
+			wantError: false,
+		},
+		{
+			name: "Invalid sizeGiB",
+			spec: IBMVPCMachineSpec{
+				AdditionalVolumes: []*VPCVolume{{SizeGiB: 1}},
+			},
+			wantError: true,
+		},
+		{
+			name: "Iops without custom profile",
+			spec: IBMVPCMachineSpec{
+				AdditionalVolumes: []*VPCVolume{{SizeGiB: 100, Iops: 3000, Profile: "general-purpose"}},
+			},
+			wantError: true,
+		},
+		{
+			name: "Duplicate volume names",
+			spec: IBMVPCMachineSpec{
+				AdditionalVolumes: []*VPCVolume{{SizeGiB: 100}, {Name: "data-0", SizeGiB: 100}},
+			},
+			wantError: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateAdditionalVolumes(tt.spec); (err != nil) != tt.wantError {
+				t.Errorf("validateAdditionalVolumes() = %v, wantError %v", err, tt.wantError)
+			}
+		})
+	}
+}
+
+func Test_validateEncryptionKeyCRNs(t *testing.T) {
+	keyProtectCRN := "crn:v1:bluemix:public:kms:us-south:a/aa1b2c3d4e5f:12345678-abcd-1234-abcd-123456789012:key:87654321-dcba-4321-dcba-210987654321"
+	tests := []struct {
+		name      string
+		spec      IBMVPCMachineSpec
+		wantError bool
+	}{
+		{
+			name:      "No encryption keys",
+			spec:      IBMVPCMachineSpec{},
+			wantError: false,
+		},
+		{
+			name: "Valid Key Protect and Hyper Protect Crypto Services keys",
+			spec: IBMVPCMachineSpec{
+				EncryptionKeyCRN: keyProtectCRN,
+				BootVolume:       &VPCVolume{EncryptionKeyCRN: keyProtectCRN},
+				AdditionalVolumes: []*VPCVolume{
+					{SizeGiB: 100, EncryptionKeyCRN: "crn:v1:bluemix:public:hs-crypto:us-south:a/aa1b2c3d4e5f:12345678-abcd-1234-abcd-123456789012:key:87654321"},
+				},
+			},
+			wantError: false,
+		},
+		{
+			name: "Malformed machine key",
+			spec: IBMVPCMachineSpec{
+				EncryptionKeyCRN: "foo-key-crn",
+			},
+			wantError: true,
+		},
+		{
+			name: "Boot volume key of another service",
+			spec: IBMVPCMachineSpec{
+				BootVolume: &VPCVolume{EncryptionKeyCRN: "crn:v1:bluemix:public:is:us-south:a/aa1b2c3d4e5f::key:87654321"},
+			},
+			wantError: true,
+		},
+		{
+			name: "Additional volume key without resource type key",
+			spec: IBMVPCMachineSpec{
+				AdditionalVolumes: []*VPCVolume{
+					{SizeGiB: 100, EncryptionKeyCRN: "crn:v1:bluemix:public:kms:us-south:a/aa1b2c3d4e5f:12345678-abcd-1234-abcd-123456789012::"},
+				},
+			},
+			wantError: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateEncryptionKeyCRNs(tt.spec); (err != nil) != tt.wantError {
+				t.Errorf("validateEncryptionKeyCRNs() = %v, wantError %v", err, tt.wantError)
+			}
+		})
+	}
+}
+
+func Test_validatePlacementTarget(t *testing.T) {
+	tests := []struct {
+		name      string
+		spec      IBMVPCMachineSpec
+		wantError bool
+	}{
+		{
+			name: "Nil placement target",
+			spec: IBMVPCMachineSpec{
+				Capacity: &VPCMachineCapacity{
+					Reservation: &VPCResource{Name: ptr.To("reservation")},
+				},
+			},
+			wantError: false,
+		},
+		{
+			name: "Placement group with reservation",
+			spec: IBMVPCMachineSpec{
+				PlacementTarget: &VPCMachinePlacementTarget{
+					PlacementGroup: &VPCResource{Name: ptr.To("placement-group")},
+				},
+				Capacity: &VPCMachineCapacity{
+					Reservation: &VPCResource{Name: ptr.To("reservation")},
+				},
+			},
+			wantError: false,
+		},
+		{
+			name: "Dedicated host without reservation",
+			spec: IBMVPCMachineSpec{
+				PlacementTarget: &VPCMachinePlacementTarget{
+					DedicatedHost: &VPCResource{Name: ptr.To("dedicated-host")},
+				},
+			},
+			wantError: false,
+		},
+		{
+			name: "Dedicated host group with reservation",
+			spec: IBMVPCMachineSpec{
+				PlacementTarget: &VPCMachinePlacementTarget{
+					DedicatedHostGroup: &VPCResource{Name: ptr.To("dedicated-host-group")},
+				},
+				Capacity: &VPCMachineCapacity{
+					Reservation: &VPCResource{Name: ptr.To("reservation")},
+				},
+			},
+			wantError: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validatePlacementTarget(tt.spec); (err != nil) != tt.wantError {
+				t.Errorf("validatePlacementTarget() = %v, wantError %v", err, tt.wantError)
+			}
+		})
+	}
+}
+
+func Test_validatePlacementTargetZone(t *testing.T) {
+	PlacementTargetZoneLookup = func(_ string, placementTarget VPCMachinePlacementTarget) (string, error) {
+		if placementTarget.DedicatedHost != nil && *placementTarget.DedicatedHost.Name == "missing" {
+			return "", fmt.Errorf("dedicated host missing not found")
+		}
+		return "us-south-1", nil
+	}
+	t.Cleanup(func() { PlacementTargetZoneLookup = nil })
+
+	tests := []struct {
+		name      string
+		spec      IBMVPCMachineSpec
+		oldSpec   *IBMVPCMachineSpec
+		wantError bool
+	}{
+		{
+			name: "Dedicated host in the zone",
+			spec: IBMVPCMachineSpec{
+				Zone: "us-south-1",
+				PlacementTarget: &VPCMachinePlacementTarget{
+					DedicatedHost: &VPCResource{Name: ptr.To("dedicated-host")},
+				},
+			},
+			wantError: false,
+		},
+		{
+			name: "Dedicated host in another zone",
+			spec: IBMVPCMachineSpec{
+				Zone: "us-south-2",
+				PlacementTarget: &VPCMachinePlacementTarget{
+					DedicatedHost: &VPCResource{Name: ptr.To("dedicated-host")},
+				},
+			},
+			wantError: true,
+		},
+		{
+			name: "Missing dedicated host",
+			spec: IBMVPCMachineSpec{
+				Zone: "us-south-1",
+				PlacementTarget: &VPCMachinePlacementTarget{
+					DedicatedHost: &VPCResource{Name: ptr.To("missing")},
+				},
+			},
+			wantError: true,
+		},
+		{
+			name: "Zone updated away from the dedicated host group",
+			spec: IBMVPCMachineSpec{
+				Zone: "us-south-2",
+				PlacementTarget: &VPCMachinePlacementTarget{
+					DedicatedHostGroup: &VPCResource{Name: ptr.To("dedicated-host-group")},
+				},
+			},
+			oldSpec: &IBMVPCMachineSpec{
+				Zone: "us-south-1",
+				PlacementTarget: &VPCMachinePlacementTarget{
+					DedicatedHostGroup: &VPCResource{Name: ptr.To("dedicated-host-group")},
+				},
+			},
+			wantError: true,
+		},
+		{
+			name: "Update not changing a missing dedicated host",
+			spec: IBMVPCMachineSpec{
+				Zone: "us-south-1",
+				PlacementTarget: &VPCMachinePlacementTarget{
+					DedicatedHost: &VPCResource{Name: ptr.To("missing")},
+				},
+			},
+			oldSpec: &IBMVPCMachineSpec{
+				Zone: "us-south-1",
+				PlacementTarget: &VPCMachinePlacementTarget{
+					DedicatedHost: &VPCResource{Name: ptr.To("missing")},
+				},
+			},
+			wantError: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var err field.ErrorList
+			if tt.oldSpec != nil {
+				err = validatePlacementTargetUpdate(tt.spec, *tt.oldSpec)
+			} else {
+				err = validatePlacementTarget(tt.spec)
+			}
+			if (err != nil) != tt.wantError {
+				t.Errorf("validatePlacementTarget() = %v, wantError %v", err, tt.wantError)
+			}
+		})
+	}
+}
+
+func Test_validateVPCMachineProfile(t *testing.T) {
+	tests := []struct {
+		name        string
+		spec        IBMVPCMachineSpec
+		wantWarning bool
+	}{
+		{
+			name: "Non GPU profile",
+			spec: IBMVPCMachineSpec{
+				Profile: "bx2-2x8",
+				Zone:    "br-sao-1",
+			},
+			wantWarning: false,
+		},
+		{
+			name: "GPU profile in supported zone",
+			spec: IBMVPCMachineSpec{
+				Profile: "gx3-16x80x1l4",
+				Zone:    "us-south-1",
+			},
+			wantWarning: false,
+		},
+		{
+			name: "GPU profile in unsupported zone",
+			spec: IBMVPCMachineSpec{
+				Profile: "gx3-16x80x1l4",
+				Zone:    "br-sao-1",
+			},
+			wantWarning: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if warnings := validateVPCMachineProfile(tt.spec); (len(warnings) != 0) != tt.wantWarning {
+				t.Errorf("validateVPCMachineProfile() = %v, wantWarning %v", warnings, tt.wantWarning)
+			}
+		})
+	}
+}
+
+func Test_validateNodeRegistration(t *testing.T) {
+	tests := []struct {
+		name    string
+		labels  map[string]string
+		taints  []corev1.Taint
+		wantErr bool
+	}{
+		{
+			name:   "Valid labels and taints",
+			labels: map[string]string{"pool": "gpu", "node.kubernetes.io/instance-family": "gx3", "example.com/spot": "true"},
+			taints: []corev1.Taint{
+				{Key: "nvidia.com/gpu", Value: "true", Effect: corev1.TaintEffectNoSchedule},
+				{Key: "nvidia.com/gpu", Value: "true", Effect: corev1.TaintEffectNoExecute},
+			},
+		},
+		{
+			name:    "Invalid label value",
+			labels:  map[string]string{"pool": "gpu pool"},
+			wantErr: true,
+		},
+		{
+			name:    "Label refused by the kubelet",
+			labels:  map[string]string{"node-role.kubernetes.io/worker": ""},
+			wantErr: true,
+		},
+		{
+			name:    "Taint without effect",
+			taints:  []corev1.Taint{{Key: "gpu"}},
+			wantErr: true,
+		},
+		{
+			name: "Duplicate taint",
+			taints: []corev1.Taint{
+				{Key: "gpu", Value: "true", Effect: corev1.TaintEffectNoSchedule},
+				{Key: "gpu", Value: "false", Effect: corev1.TaintEffectNoSchedule},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if errs := validateNodeRegistration(tt.labels, tt.taints); (len(errs) != 0) != tt.wantErr {
+				t.Errorf("validateNodeRegistration() = %v, wantErr %v", errs, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_validateConnectivityCheck(t *testing.T) {
+	tests := []struct {
+		name    string
+		check   *ConnectivityCheck
+		wantErr bool
+	}{
+		{
+			name: "Connectivity check is not set",
+		},
+		{
+			name:  "Defaults of the connectivity check",
+			check: &ConnectivityCheck{},
+		},
+		{
+			name:  "Valid timeout and retry interval",
+			check: &ConnectivityCheck{Timeout: &metav1.Duration{Duration: 5 * time.Second}, RetryInterval: &metav1.Duration{Duration: 2 * time.Minute}},
+		},
+		{
+			name:    "Zero timeout",
+			check:   &ConnectivityCheck{Timeout: &metav1.Duration{}},
+			wantErr: true,
+		},
+		{
+			name:    "Negative retry interval",
+			check:   &ConnectivityCheck{RetryInterval: &metav1.Duration{Duration: -time.Minute}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if errs := validateConnectivityCheck(tt.check); (len(errs) != 0) != tt.wantErr {
+				t.Errorf("validateConnectivityCheck() = %v, wantErr %v", errs, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_validateInstanceHealthCheck(t *testing.T) {
+	tests := []struct {
+		name    string
+		check   *PowerVSInstanceHealthCheck
+		wantErr bool
+	}{
+		{
+			name: "Health check is not set",
+		},
+		{
+			name:  "Defaults of the health check",
+			check: &PowerVSInstanceHealthCheck{},
+		},
+		{
+			name:  "Valid interval and unhealthy timeout",
+			check: &PowerVSInstanceHealthCheck{Interval: &metav1.Duration{Duration: time.Minute}, UnhealthyTimeout: &metav1.Duration{Duration: 10 * time.Minute}},
+		},
+		{
+			name:  "Zero unhealthy timeout",
+			check: &PowerVSInstanceHealthCheck{UnhealthyTimeout: &metav1.Duration{}},
+		},
+		{
+			name:    "Zero interval",
+			check:   &PowerVSInstanceHealthCheck{Interval: &metav1.Duration{}},
+			wantErr: true,
+		},
+		{
+			name:    "Negative unhealthy timeout",
+			check:   &PowerVSInstanceHealthCheck{UnhealthyTimeout: &metav1.Duration{Duration: -time.Minute}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if errs := validateInstanceHealthCheck(tt.check); (len(errs) != 0) != tt.wantErr {
+				t.Errorf("validateInstanceHealthCheck() = %v, wantErr %v", errs, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_validateShutdownTimeout(t *testing.T) {
+	tests := []struct {
+		name    string
+		timeout *metav1.Duration
+		wantErr bool
+	}{
+		{
+			name: "Shutdown timeout is not set",
+		},
+		{
+			name:    "Zero shutdown timeout",
+			timeout: &metav1.Duration{},
+		},
+		{
+			name:    "Positive shutdown timeout",
+			timeout: &metav1.Duration{Duration: 10 * time.Minute},
+		},
+		{
+			name:    "Negative shutdown timeout",
+			timeout: &metav1.Duration{Duration: -time.Minute},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if errs := validateShutdownTimeout(tt.timeout); (len(errs) != 0) != tt.wantErr {
+				t.Errorf("validateShutdownTimeout() = %v, wantErr %v", errs, tt.wantErr)
+			}
+		})
+	}
+}
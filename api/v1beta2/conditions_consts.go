@@ -27,6 +27,14 @@ const (
 	WaitingForClusterInfrastructureReason = "WaitingForClusterInfrastructure"
 	// WaitingForBootstrapDataReason used when machine is waiting for bootstrap data to be ready before proceeding.
 	WaitingForBootstrapDataReason = "WaitingForBootstrapData"
+	// WaitingForControlPlaneMachinesReason used when control plane machine is waiting for the previously created control plane machines
+	// to be ready before proceeding as the control plane machines are provisioned sequentially.
+	WaitingForControlPlaneMachinesReason = "WaitingForControlPlaneMachines"
+	// WaitingForDataVolumesReason used when machine is waiting for its data volumes to be available before the instance is created
+	// with the data volumes attached.
+	WaitingForDataVolumesReason = "WaitingForDataVolumes"
+	// GuardrailsExceededReason used when the instance of the machine is not created as it would exceed the guardrails of the cluster.
+	GuardrailsExceededReason = "GuardrailsExceeded"
 )
 
 const (
@@ -41,6 +49,9 @@ const (
 
 	// InstanceStateUnknownReason used when the instance is in a unknown state.
 	InstanceStateUnknownReason = "InstanceStateUnknown"
+
+	// InstanceInterruptedReason used when the instance was reclaimed by IBM Cloud.
+	InstanceInterruptedReason = "InstanceInterrupted"
 )
 
 const (
@@ -48,9 +59,65 @@ const (
 	InstanceReadyCondition capiv1beta1.ConditionType = "InstanceReady"
 )
 
+const (
+	// InstanceHealthyCondition reports on the health of the instance when the health check of the machine is configured.
+	// False indicates the instance is in the ERROR or SHUTOFF state or has an unhealthy health status, the condition gets
+	// the Error severity once the instance is unhealthy for longer than the unhealthy timeout of the health check.
+	InstanceHealthyCondition capiv1beta1.ConditionType = "InstanceHealthy"
+
+	// InstanceUnhealthyReason used when the ACTIVE instance has one of the unhealthy health statuses of the health check.
+	InstanceUnhealthyReason = "InstanceUnhealthy"
+
+	// InstanceHealthUnknownReason used when the state and the health status of the instance don't tell whether it is healthy,
+	// e.g. while it is building or rebooting.
+	InstanceHealthUnknownReason = "InstanceHealthUnknown"
+)
+
+const (
+	// BootstrapSucceededCondition reports on the bootstrap of the instance.
+	// True indicates the machine joined the cluster.
+	BootstrapSucceededCondition capiv1beta1.ConditionType = "BootstrapSucceeded"
+
+	// WaitingForNodeJoinReason used while the machine did not join the cluster yet, within the bootstrap timeout of its running instance.
+	WaitingForNodeJoinReason = "WaitingForNodeJoin"
+
+	// NodeNotJoinedReason used when the instance is running for longer than the bootstrap timeout without the machine having joined the cluster.
+	NodeNotJoinedReason = "NodeNotJoined"
+)
+
+const (
+	// InstanceShutdownCondition reports on the graceful shutdown of the instance before it gets deleted.
+	// True indicates the operating system of the instance is shut down.
+	InstanceShutdownCondition capiv1beta1.ConditionType = "InstanceShutdown"
+
+	// VolumesDetachedCondition reports on the detachment of the data volumes from the instance before it gets deleted.
+	// True indicates no data volume is attached to the instance anymore.
+	VolumesDetachedCondition capiv1beta1.ConditionType = "VolumesDetached"
+)
+
+const (
+	// InstanceShuttingDownReason used when the operating system of the instance is shutting down before the instance is deleted.
+	InstanceShuttingDownReason = "InstanceShuttingDown"
+
+	// InstanceShutdownTimeoutReason used when the instance did not shut down within the shutdown timeout and is deleted anyway.
+	InstanceShutdownTimeoutReason = "InstanceShutdownTimeout"
+
+	// DetachingVolumesReason used when the data volumes are detaching from the instance before the instance is deleted.
+	DetachingVolumesReason = "DetachingVolumes"
+
+	// VolumeDetachTimeoutReason used when the data volumes did not detach within the volume detach timeout and the instance is deleted anyway.
+	VolumeDetachTimeoutReason = "VolumeDetachTimeout"
+)
+
 const (
 	// WaitingForIBMPowerVSImageReason used when machine is waiting for powervs image to be ready before proceeding.
 	WaitingForIBMPowerVSImageReason = "WaitingForIBMPowerVSImage"
+
+	// WaitingForIBMPowerVSNetworkReason used when cluster or machine is waiting for powervs network to be ready before proceeding.
+	WaitingForIBMPowerVSNetworkReason = "WaitingForIBMPowerVSNetwork"
+
+	// WaitingForIBMTransitGatewayReason used when cluster is waiting for transit gateway to be ready before proceeding.
+	WaitingForIBMTransitGatewayReason = "WaitingForIBMTransitGateway"
 )
 
 const (
@@ -62,6 +129,9 @@ const (
 
 	// ImageReconciliationFailedReason used when an error occurs during VPC Custom Image reconciliation.
 	ImageReconciliationFailedReason = "ImageReconciliationFailed"
+
+	// ImageInUseReason used when the deletion of the image is blocked as it is still referenced by machines or machine templates.
+	ImageInUseReason = "ImageInUse"
 )
 
 const (
@@ -70,11 +140,45 @@ const (
 
 	// ImageImportedCondition reports on current status of the image import job. Ready indicates the import job is finished.
 	ImageImportedCondition capiv1beta1.ConditionType = "ImageImported"
+
+	// ImportJobRunningCondition reports on whether the image import job is currently running. True indicates the import job is in progress.
+	ImportJobRunningCondition capiv1beta1.ConditionType = "ImportJobRunning"
+
+	// ImageExportedCondition reports on the export of the image to Cloud Object Storage. True indicates the export job is completed.
+	ImageExportedCondition capiv1beta1.ConditionType = "ImageExported"
+)
+
+const (
+	// ImportJobQueuedReason used when the image import job is waiting to be picked up.
+	ImportJobQueuedReason = "ImportJobQueued"
+
+	// ImportJobCompletedReason used when the image import job is completed.
+	ImportJobCompletedReason = "ImportJobCompleted"
+
+	// ImportJobFailedReason used when the image import job is failed.
+	ImportJobFailedReason = "ImportJobFailed"
+
+	// ImportJobPendingReason used when the image import job is not yet created as another import job is in flight in the workspace.
+	ImportJobPendingReason = "ImportJobPending"
+)
+
+const (
+	// ImageExportRunningReason used when the image export job is queued or in progress.
+	ImageExportRunningReason = "ImageExportRunning"
+
+	// ImageExportFailedReason used when the image export job is failed.
+	ImageExportFailedReason = "ImageExportFailed"
+
+	// ImageExportPendingReason used when the image export job is not yet created as another job is in flight in the workspace.
+	ImageExportPendingReason = "ImageExportPending"
 )
 
 const (
 	// LoadBalancerNotReadyReason used when cluster is waiting for load balancer to be ready before proceeding.
 	LoadBalancerNotReadyReason = "LoadBalancerNotReady"
+	// ClusterInfrastructureNotReadyReason used on the Ready condition of an IBMPowerVSCluster while the
+	// conditions of its components are true but its infrastructure is not ready yet.
+	ClusterInfrastructureNotReadyReason = "ClusterInfrastructureNotReady"
 )
 
 const (
@@ -87,6 +191,8 @@ const (
 	NetworkReadyCondition capiv1beta1.ConditionType = "NetworkReady"
 	// NetworkReconciliationFailedReason used when an error occurs during network reconciliation.
 	NetworkReconciliationFailedReason = "NetworkReconciliationFailed"
+	// NetworkNotReadyReason used when the network is waiting for its DHCP server to be active.
+	NetworkNotReadyReason = "NetworkNotReady"
 
 	// VPCSecurityGroupReadyCondition reports on the successful reconciliation of a VPC.
 	VPCSecurityGroupReadyCondition capiv1beta1.ConditionType = "VPCSecurityGroupReady"
@@ -107,20 +213,102 @@ const (
 	TransitGatewayReadyCondition capiv1beta1.ConditionType = "TransitGatewayReady"
 	// TransitGatewayReconciliationFailedReason used when an error occurs during transit gateway reconciliation.
 	TransitGatewayReconciliationFailedReason = "TransitGatewayReconciliationFailed"
+	// TransitGatewayNotReadyReason used when the transit gateway or its connections are waiting to be available.
+	TransitGatewayNotReadyReason = "TransitGatewayNotReady"
 
 	// LoadBalancerReadyCondition reports on the successful reconciliation of a Power VS network.
 	LoadBalancerReadyCondition capiv1beta1.ConditionType = "LoadBalancerReady"
 	// LoadBalancerReconciliationFailedReason used when an error occurs during loadbalancer reconciliation.
 	LoadBalancerReconciliationFailedReason = "LoadBalancerReconciliationFailed"
 
+	// ControlPlaneEndpointReadyCondition reports on the control plane endpoint matching the hostname of the public load balancer.
+	ControlPlaneEndpointReadyCondition capiv1beta1.ConditionType = "ControlPlaneEndpointReady"
+	// LoadBalancerRecreatingReason used when the load balancer created by the controller was deleted out-of-band and is created again.
+	LoadBalancerRecreatingReason = "LoadBalancerRecreating"
+	// ControlPlaneEndpointChangedReason used when the hostname of the recreated load balancer differs from the control plane endpoint
+	// and the update of the endpoint is waiting to be approved with the update-control-plane-endpoint annotation.
+	ControlPlaneEndpointChangedReason = "ControlPlaneEndpointChanged"
+
+	// LoadBalancerPoolMembersReadyCondition reports on the membership of the control plane machines in the pools of the control plane load balancer.
+	// True indicates the pools contain the running control plane machines only and all their members are active.
+	LoadBalancerPoolMembersReadyCondition capiv1beta1.ConditionType = "LoadBalancerPoolMembersReady"
+	// LoadBalancerPoolMembersReconciliationFailedReason used when an error occurs during load balancer pool members reconciliation.
+	LoadBalancerPoolMembersReconciliationFailedReason = "LoadBalancerPoolMembersReconciliationFailed"
+	// LoadBalancerPoolMembersNotReadyReason used when members are added to or removed from the load balancer pools, or are not active yet.
+	LoadBalancerPoolMembersNotReadyReason = "LoadBalancerPoolMembersNotReady"
+
+	// ControlPlaneEndpointResolvedCondition reports on the resolution of the host of the control plane endpoint
+	// from the management cluster, set by the connectivity self-test of the cluster.
+	ControlPlaneEndpointResolvedCondition capiv1beta1.ConditionType = "ControlPlaneEndpointResolved"
+	// ControlPlaneEndpointReachableCondition reports on TCP connections from the management cluster to the addresses
+	// of the control plane endpoint, set by the connectivity self-test of the cluster.
+	ControlPlaneEndpointReachableCondition capiv1beta1.ConditionType = "ControlPlaneEndpointReachable"
+	// TransitGatewayRoutesReadyCondition reports on the transit gateway of a Power VS cluster being available with the
+	// connections of the workspace and of the VPC attached, set by the connectivity self-test of the cluster.
+	TransitGatewayRoutesReadyCondition capiv1beta1.ConditionType = "TransitGatewayRoutesReady"
+	// LoadBalancerMembersHealthyCondition reports on the health of the members of the pools of the load balancers
+	// of a cluster, set by the connectivity self-test of the cluster.
+	LoadBalancerMembersHealthyCondition capiv1beta1.ConditionType = "LoadBalancerMembersHealthy"
+	// ConnectivityCheckFailedReason used when a probe of the connectivity self-test of the cluster fails.
+	ConnectivityCheckFailedReason = "ConnectivityCheckFailed"
+	// ConnectivityCheckSkippedReason used when a probe of the connectivity self-test is skipped as an earlier probe failed.
+	ConnectivityCheckSkippedReason = "ConnectivityCheckSkipped"
+
 	// COSInstanceReadyCondition reports on the successful reconciliation of a COS instance.
 	COSInstanceReadyCondition capiv1beta1.ConditionType = "COSInstanceCreated"
 	// COSInstanceReconciliationFailedReason used when an error occurs during COS instance reconciliation.
 	COSInstanceReconciliationFailedReason = "COSInstanceCreationFailed"
+
+	// SSHKeyReadyCondition reports on the successful reconciliation of the Power VS SSH key of the cluster.
+	SSHKeyReadyCondition capiv1beta1.ConditionType = "SSHKeyReady"
+	// SSHKeyReconciliationFailedReason used when an error occurs during SSH key reconciliation.
+	SSHKeyReconciliationFailedReason = "SSHKeyReconciliationFailed"
+
+	// AddonsReadyCondition reports on the successful reconciliation of the ClusterResourceSet containing the cluster addons.
+	AddonsReadyCondition capiv1beta1.ConditionType = "AddonsReady"
+	// AddonsReconciliationFailedReason used when an error occurs during cluster addons reconciliation.
+	AddonsReconciliationFailedReason = "AddonsReconciliationFailed"
+
+	// DNSRecordReadyCondition reports on the successful reconciliation of the DNS record registering the control plane endpoint.
+	DNSRecordReadyCondition capiv1beta1.ConditionType = "DNSRecordReady"
+	// DNSRecordReconciliationFailedReason used when an error occurs during DNS record reconciliation.
+	DNSRecordReconciliationFailedReason = "DNSRecordReconciliationFailed"
+	// DNSRecordConflictReason used when a record of the hostname not created for the cluster exists in the zone and is not adopted.
+	DNSRecordConflictReason = "DNSRecordConflict"
+
+	// ServiceUnreachableReason used when the service of an optional component like the transit gateway or the COS instance
+	// can't be reached, the remaining components of the cluster are reconciled while the component is degraded.
+	ServiceUnreachableReason = "ServiceUnreachable"
 )
 
 const (
 	// CreateInfrastructureAnnotation is the name of an annotation that indicates if
 	// Power VS infrastructure should be created as a part of cluster creation.
 	CreateInfrastructureAnnotation = "powervs.cluster.x-k8s.io/create-infra"
+
+	// DebugDumpAnnotation is the name of an annotation that indicates if a sanitized dump of the cloud resources
+	// backing the object should be written into a ConfigMap next to it, the value is the duration for which the dump is kept.
+	DebugDumpAnnotation = "infrastructure.cluster.x-k8s.io/debug-dump"
+
+	// ControlPlaneEndpointUpdateAnnotation is the name of an annotation that approves moving the control plane endpoint
+	// of a Power VS or VPC cluster to the hostname of its recreated load balancer or of its DNS record.
+	ControlPlaneEndpointUpdateAnnotation = "powervs.cluster.x-k8s.io/update-control-plane-endpoint"
+
+	// ForceDeleteAnnotation is the name of an annotation that indicates if an image should be deleted
+	// even though it is still referenced by machines or machine templates.
+	ForceDeleteAnnotation = "infrastructure.cluster.x-k8s.io/force-delete"
+
+	// ImportJobAnnotation is the name of an annotation recording the ID of the import job of an image, it is kept
+	// in the metadata as the status of the image is not restored by clusterctl move.
+	ImportJobAnnotation = "powervs.cluster.x-k8s.io/import-job-id"
+
+	// ExportAnnotation is the name of an annotation recording the export status of an image in JSON, it is kept
+	// in the metadata as the status of the image is not restored by clusterctl move.
+	ExportAnnotation = "powervs.cluster.x-k8s.io/export"
+)
+
+const (
+	// ClusterAddonsLabel is the name of the label set on the Cluster to bind it to
+	// the ClusterResourceSet containing the IBM specific addons.
+	ClusterAddonsLabel = "powervs.cluster.x-k8s.io/addons"
 )
@@ -17,6 +17,7 @@ limitations under the License.
 package v1beta2
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	capiv1beta1 "sigs.k8s.io/cluster-api/api/v1beta1"
@@ -30,11 +31,25 @@ const (
 	IBMPowerVSClusterFinalizer = "ibmpowervscluster.infrastructure.cluster.x-k8s.io"
 )
 
+// ControlPlaneProvisioningPolicy enum attribute to identify the policy used to provision the control plane machines.
+type ControlPlaneProvisioningPolicy string
+
+const (
+	// ControlPlaneProvisioningPolicyParallel enum property to provision the control plane machines in parallel.
+	ControlPlaneProvisioningPolicyParallel ControlPlaneProvisioningPolicy = "Parallel"
+	// ControlPlaneProvisioningPolicySequential enum property to provision the control plane machines one after another.
+	ControlPlaneProvisioningPolicySequential ControlPlaneProvisioningPolicy = "Sequential"
+)
+
 // IBMPowerVSClusterSpec defines the desired state of IBMPowerVSCluster.
 type IBMPowerVSClusterSpec struct {
 	// ServiceInstanceID is the id of the power cloud instance where the vsi instance will get deployed.
+	// when powervs.cluster.x-k8s.io/create-infra=true annotation is set on IBMPowerVSCluster resource, the field can be omitted
+	// and the service instance will be created in Zone under ResourceGroup and deleted along with the cluster.
+	// otherwise either the field or ServiceInstance must be set.
 	// Deprecated: use ServiceInstance instead
-	ServiceInstanceID string `json:"serviceInstanceID"`
+	// +optional
+	ServiceInstanceID string `json:"serviceInstanceID,omitempty"`
 
 	// Network is the reference to the Network to use for this cluster.
 	// when the field is omitted, A DHCP service will be created in the Power VS workspace and its private network will be used.
@@ -52,6 +67,20 @@ type IBMPowerVSClusterSpec struct {
 	// +optional
 	DHCPServer *DHCPServer `json:"dhcpServer,omitempty"`
 
+	// manageDHCPNetwork when set to true, the DHCP server and its private network are created in the existing Power VS workspace
+	// set via ServiceInstanceID or ServiceInstance.ID without requiring the powervs.cluster.x-k8s.io/create-infra=true annotation.
+	// the DHCP server is configured using DHCPServer, its ID is recorded in status and it is deleted along with the cluster.
+	// when Network refers to an existing network, the network is used as is and no DHCP server will be created.
+	// the field is ignored when powervs.cluster.x-k8s.io/create-infra=true annotation is set, as the network is managed along with the rest of the infrastructure.
+	// +optional
+	ManageDHCPNetwork *bool `json:"manageDHCPNetwork,omitempty"`
+
+	// networkRef is an optional reference to an IBMPowerVSNetwork in the same namespace, whose network is used for this cluster.
+	// the IBMPowerVSNetwork manages the lifecycle of the network independently of the cluster, so the network can be shared across clusters.
+	// when set, Network and DHCPServer must not be set and ManageDHCPNetwork is ignored.
+	// +optional
+	NetworkRef *corev1.LocalObjectReference `json:"networkRef,omitempty"`
+
 	// ControlPlaneEndpoint represents the endpoint used to communicate with the control plane.
 	// +optional
 	ControlPlaneEndpoint capiv1beta1.APIEndpoint `json:"controlPlaneEndpoint"`
@@ -116,6 +145,21 @@ type IBMPowerVSClusterSpec struct {
 	// +optional
 	TransitGateway *TransitGateway `json:"transitGateway,omitempty"`
 
+	// manageTransitGateway when set to true, the transit gateway configured via TransitGateway is created or reused and the existing
+	// Power VS workspace and VPC are attached to it without requiring the powervs.cluster.x-k8s.io/create-infra=true annotation.
+	// the transit gateway and its connections are deleted along with the cluster only when they are created by the controller.
+	// ServiceInstanceID or ServiceInstance.ID, Zone, VPC.ID, VPC.Region and ResourceGroup must be set when the field is set to true.
+	// the field is ignored when powervs.cluster.x-k8s.io/create-infra=true annotation is set, as the transit gateway is managed along with the rest of the infrastructure.
+	// +optional
+	ManageTransitGateway *bool `json:"manageTransitGateway,omitempty"`
+
+	// transitGatewayRef is an optional reference to an IBMTransitGateway in the same namespace, whose transit gateway is used for this cluster.
+	// the IBMTransitGateway manages the lifecycle of the transit gateway independently of the cluster, so the transit gateway can be shared across clusters.
+	// the Power VS workspace and VPC of the cluster are attached to the transit gateway and the connections are deleted along with the cluster.
+	// when set, TransitGateway must not be set and ManageTransitGateway is ignored.
+	// +optional
+	TransitGatewayRef *corev1.LocalObjectReference `json:"transitGatewayRef,omitempty"`
+
 	// loadBalancers is optional configuration for configuring loadbalancers to control plane or data plane nodes.
 	// when omitted system will create a default public loadbalancer with name CLUSTER_NAME-loadbalancer.
 	// when specified a vpc loadbalancer will be created and controlPlaneEndpoint will be set with associated hostname of loadbalancer.
@@ -126,6 +170,28 @@ type IBMPowerVSClusterSpec struct {
 	// +optional
 	LoadBalancers []VPCLoadBalancerSpec `json:"loadBalancers,omitempty"`
 
+	// dns is the DNS record registering the control plane endpoint, pointing to the hostname of the public load balancer.
+	// when set, the hostname of the record is used as the host of ControlPlaneEndpoint instead of the hostname of the load balancer.
+	// +optional
+	DNS *DNSSpec `json:"dns,omitempty"`
+
+	// manageLoadBalancer when set to true, the VPC load balancers configured via LoadBalancers are created in the existing VPC
+	// without requiring the powervs.cluster.x-k8s.io/create-infra=true annotation.
+	// the load balancers are created in the subnets referred by VPCSubnets, control plane machines are registered as pool members
+	// as they come up and ControlPlaneEndpoint will be set with associated hostname of public loadbalancer.
+	// VPC.Region, VPCSubnets and ResourceGroup must be set when the field is set to true.
+	// the field is ignored when powervs.cluster.x-k8s.io/create-infra=true annotation is set, as the load balancers are managed along with the rest of the infrastructure.
+	// +optional
+	ManageLoadBalancer *bool `json:"manageLoadBalancer,omitempty"`
+
+	// controlPlaneProvisioningPolicy is the policy used to provision the control plane machines of the cluster.
+	// when set to Sequential, the instance of a control plane machine is created only after the instances of all the control plane
+	// machines created before it are running, this helps when the Power VS workspace capacity or the DHCP server requires staggered creation.
+	// when omitted or set to Parallel, the instances of the control plane machines are created in parallel.
+	// +kubebuilder:validation:Enum:="Parallel";"Sequential";""
+	// +optional
+	ControlPlaneProvisioningPolicy ControlPlaneProvisioningPolicy `json:"controlPlaneProvisioningPolicy,omitempty"`
+
 	// cosInstance contains options to configure a supporting IBM Cloud COS bucket for this
 	// cluster - currently used for nodes requiring Ignition
 	// (https://coreos.github.io/ignition/) for bootstrapping (requires
@@ -140,6 +206,153 @@ type IBMPowerVSClusterSpec struct {
 	// Ignition defined options related to the bootstrapping systems where Ignition is used.
 	// +optional
 	Ignition *Ignition `json:"ignition,omitempty"`
+
+	// addons contains the configuration of the IBM specific addons to be installed on the workload cluster.
+	// when set, system will generate a ClusterResourceSet with name CLUSTER_NAME-addons containing the configured addons
+	// and bind it to the cluster by setting the powervs.cluster.x-k8s.io/addons=CLUSTER_NAME label on the Cluster.
+	// +optional
+	Addons *ClusterAddons `json:"addons,omitempty"`
+
+	// credentialsSecretRef is the reference to the secret containing the IBM Cloud API key used to provision the cluster,
+	// its machines, images and networks, which allows to provision clusters in different IBM Cloud accounts.
+	// the secret must exist in the same namespace as the IBMPowerVSCluster and contain the apiKey key.
+	// when omitted, the API key configured for the controller is used.
+	// +optional
+	CredentialsSecretRef *corev1.LocalObjectReference `json:"credentialsSecretRef,omitempty"`
+
+	// enterpriseAccount is the child account of the IBM Cloud enterprise the cluster is provisioned into. the IAM trusted
+	// profile of the child account is assumed with the API key of the credentials of the cluster, which belong to an
+	// account of the enterprise, so a central platform team can provision clusters into the accounts of the enterprise.
+	// +optional
+	EnterpriseAccount *EnterpriseAccount `json:"enterpriseAccount,omitempty"`
+
+	// privateCloud configures the cluster to be provisioned in a Power Virtual Server private cloud, the Power VS deployment
+	// backed by PowerVC in a client data center, instead of the public Power VS.
+	// the Power VS API of its machines, images and DHCP network is then served by the endpoint of the private cloud
+	// and zone along with serviceInstanceID or serviceInstance.id must be set, as the workspace can't be looked up in IBM Cloud.
+	// the powervs.cluster.x-k8s.io/create-infra annotation is not supported for private clouds, and credentialsSecretRef
+	// must be set as only the credentials of the secret are sent to the endpoints of the private cloud.
+	// +optional
+	PrivateCloud *PowerVSPrivateCloud `json:"privateCloud,omitempty"`
+
+	// tags configures the user tags attached to the resources created by the controller, along with the cluster tag
+	// used to find the resources left behind on deletion.
+	// the tags are reconciled without detaching the tags attached by other systems unless tags.prune is set.
+	// +optional
+	Tags *ResourceTags `json:"tags,omitempty"`
+
+	// guardrails is the budget of the instances of the cluster. the machines exceeding it are not created
+	// and report the GuardrailsExceeded reason on their InstanceReady condition.
+	// +optional
+	Guardrails *ClusterGuardrails `json:"guardrails,omitempty"`
+
+	// reconcileIntervals overrides the intervals at which the objects of the cluster are reconciled, e.g. to poll
+	// image import jobs every few minutes while the load balancer pool members are reconciled every few seconds.
+	// +optional
+	ReconcileIntervals *ReconcileIntervals `json:"reconcileIntervals,omitempty"`
+
+	// connectivityCheck enables the connectivity self-test of the cluster, run from the management cluster once its
+	// infrastructure is ready to catch clusters which are ready but can't be reached.
+	// removing it removes the conditions reported by the test.
+	// +optional
+	ConnectivityCheck *ConnectivityCheck `json:"connectivityCheck,omitempty"`
+
+	// sshKey is the SSH key registered in the Power VS tenant of the account and set on the instances of the machines
+	// which don't set their own sshKey, so operators have a consistent emergency access to the nodes.
+	// the key is created when publicKey is set and no key with the name exists, and deleted along with the cluster
+	// only when created by the controller.
+	// +optional
+	SSHKey *PowerVSSSHKey `json:"sshKey,omitempty"`
+}
+
+// PowerVSSSHKey defines the SSH key of the Power VS tenant set on the instances of the cluster.
+// +kubebuilder:validation:XValidation:rule="has(self.name) || has(self.publicKey)",message="either name or publicKey must be specified"
+type PowerVSSSHKey struct {
+	// name of the SSH key in the Power VS tenant, when omitted, defaults to CLUSTER_NAME-ssh-key.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	Name *string `json:"name,omitempty"`
+
+	// publicKey is the public key in OpenSSH format, used to create the key when no key with the name exists.
+	// when omitted, the key must already exist in the tenant.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	PublicKey *string `json:"publicKey,omitempty"`
+}
+
+// PowerVSPrivateCloud holds the endpoints of a Power Virtual Server private cloud.
+type PowerVSPrivateCloud struct {
+	// endpoint is the URL of the Power VS API of the private cloud.
+	// +kubebuilder:validation:Pattern=`^https://`
+	// +required
+	Endpoint string `json:"endpoint"`
+
+	// iamEndpoint is the URL of the IAM token service authenticating the API key of the credentials secret
+	// against the private cloud. when omitted, the IAM endpoint configured for the controller is used.
+	// +kubebuilder:validation:Pattern=`^https://`
+	// +optional
+	IAMEndpoint string `json:"iamEndpoint,omitempty"`
+
+	// accountID is the ID of the account owning the workspaces of the private cloud, which is part of the CRN
+	// sent along with the requests to the Power VS API. when omitted, the account of the IAM token is used.
+	// +optional
+	AccountID string `json:"accountID,omitempty"`
+}
+
+// ClusterAddons contains the configuration of the IBM specific addons installed using a ClusterResourceSet.
+type ClusterAddons struct {
+	// cloudControllerManager is the configuration of the IBM cloud controller manager addon.
+	// when set, the cloud config used by the cloud controller manager is generated from the cluster infrastructure
+	// and added to the ClusterResourceSet along with the resources.
+	// +optional
+	CloudControllerManager *AddonSpec `json:"cloudControllerManager,omitempty"`
+
+	// csiDriver is the configuration of the IBM Power VS block CSI driver addon.
+	// +optional
+	CSIDriver *AddonSpec `json:"csiDriver,omitempty"`
+
+	// calico is the configuration of the Calico CNI addon.
+	// when set, the Calico config with the MTU tuned for the Power VS network is generated
+	// and added to the ClusterResourceSet along with the resources.
+	// +optional
+	Calico *CalicoAddonSpec `json:"calico,omitempty"`
+
+	// strategy is the strategy to be used by the ClusterResourceSet while applying the addons to the workload cluster.
+	// +kubebuilder:default=ApplyOnce
+	// +kubebuilder:validation:Enum=ApplyOnce;Reconcile
+	// +optional
+	Strategy string `json:"strategy,omitempty"`
+}
+
+// AddonSpec contains the resources which make up an addon.
+type AddonSpec struct {
+	// resources is the list of Secrets or ConfigMaps in the cluster namespace containing the manifests of the addon.
+	// +optional
+	Resources []AddonResourceReference `json:"resources,omitempty"`
+}
+
+// CalicoAddonSpec contains the configuration of the Calico CNI addon.
+type CalicoAddonSpec struct {
+	AddonSpec `json:",inline"`
+
+	// mtu is the MTU to be used by the Calico interfaces.
+	// when omitted, the MTU of the Power VS network minus the VXLAN encapsulation overhead will be used,
+	// or 1400 when the MTU of the network is unknown.
+	// +kubebuilder:validation:Minimum=576
+	// +kubebuilder:validation:Maximum=8950
+	// +optional
+	MTU *int32 `json:"mtu,omitempty"`
+}
+
+// AddonResourceReference identifies a Secret or ConfigMap containing the manifests of an addon.
+type AddonResourceReference struct {
+	// kind of the resource. Supported kinds are: Secret and ConfigMap.
+	// +kubebuilder:validation:Enum=Secret;ConfigMap
+	Kind string `json:"kind"`
+
+	// name of the resource that is in the same namespace as the cluster.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
 }
 
 // Ignition defines options related to the bootstrapping systems where Ignition is used.
@@ -170,6 +383,16 @@ type DHCPServer struct {
 	// Optional indicates if SNAT will be enabled for DHCP service
 	// +kubebuilder:default=true
 	Snat *bool `json:"snat,omitempty"`
+
+	// updatePolicy defines how the changes of DNSServer and Cidr are applied to the DHCP server created by the controller for an IBMPowerVSCluster.
+	// the DNS server is always updated in place on the private network of the DHCP server.
+	// the Cidr can only be changed by recreating the DHCP server along with its network, which is done only when set to recreate
+	// and no instance holds a lease of the DHCP server, otherwise the change is reported with an event and not applied.
+	// the field is ignored for the DHCP server of an IBMPowerVSNetwork.
+	// +kubebuilder:default=inPlace
+	// +kubebuilder:validation:Enum=inPlace;recreate
+	// +optional
+	UpdatePolicy string `json:"updatePolicy,omitempty"`
 }
 
 // ResourceReference identifies a resource with id.
@@ -230,6 +453,17 @@ type IBMPowerVSClusterStatus struct {
 	// loadBalancers reference to IBM Cloud VPC Loadbalancer.
 	LoadBalancers map[string]VPCLoadBalancerStatus `json:"loadBalancers,omitempty"`
 
+	// sshKey is the reference to the Power VS SSH key set on the instances of the cluster, identified by its name.
+	SSHKey *ResourceReference `json:"sshKey,omitempty"`
+
+	// dnsRecord is the reference to the DNS record registering the control plane endpoint.
+	DNSRecord *ResourceReference `json:"dnsRecord,omitempty"`
+
+	// failureDomains is the zone of the Power VS workspace of the cluster, keyed by zone name, as all the machines
+	// of the cluster are created in the workspace. the region of the zone is set in the region attribute.
+	// +optional
+	FailureDomains capiv1beta1.FailureDomains `json:"failureDomains,omitempty"`
+
 	// Conditions defines current service state of the IBMPowerVSCluster.
 	Conditions capiv1beta1.Conditions `json:"conditions,omitempty"`
 }
@@ -238,6 +472,7 @@ type IBMPowerVSClusterStatus struct {
 // +kubebuilder:subresource:status
 // +kubebuilder:storageversion
 // +kubebuilder:printcolumn:name="Cluster",type="string",JSONPath=".metadata.labels.cluster\\.x-k8s\\.io/cluster-name",description="Cluster to which this IBMPowerVSCluster belongs"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.ready",description="Cluster infrastructure is ready for Power VS instances"
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="Time duration since creation of IBMPowerVSCluster"
 // +kubebuilder:printcolumn:name="PowerVS Cloud Instance ID",type="string",priority=1,JSONPath=".spec.serviceInstanceID"
 // +kubebuilder:printcolumn:name="Endpoint",type="string",priority=1,JSONPath=".spec.controlPlaneEndpoint.host",description="Control Plane Endpoint"
@@ -18,6 +18,7 @@ package v1beta2
 
 import (
 	"fmt"
+	"net"
 	"strconv"
 
 	regionUtil "github.com/ppc64le-cloud/powervs-utils"
@@ -32,6 +33,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/supportmatrix"
 	genUtil "sigs.k8s.io/cluster-api-provider-ibmcloud/util"
 )
 
@@ -65,8 +67,27 @@ func (r *IBMPowerVSCluster) ValidateCreate() (admission.Warnings, error) {
 }
 
 // ValidateUpdate implements webhook.Validator so a webhook will be registered for the type.
-func (r *IBMPowerVSCluster) ValidateUpdate(_ runtime.Object) (admission.Warnings, error) {
+func (r *IBMPowerVSCluster) ValidateUpdate(oldRaw runtime.Object) (admission.Warnings, error) {
 	ibmpowervsclusterlog.Info("validate update", "name", r.Name)
+	old, ok := oldRaw.(*IBMPowerVSCluster)
+	if !ok {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("expected an IBMPowerVSCluster but got a %T", oldRaw))
+	}
+	if err := r.validateIBMPowerVSClusterDHCPServerCidrUpdate(old); err != nil {
+		return nil, apierrors.NewInvalid(
+			schema.GroupKind{Group: "infrastructure.cluster.x-k8s.io", Kind: "IBMPowerVSCluster"},
+			r.Name, field.ErrorList{err})
+	}
+	if err := validateDNSUpdate(old.Spec.DNS, r.Spec.DNS, old.Status.DNSRecord); err != nil {
+		return nil, apierrors.NewInvalid(
+			schema.GroupKind{Group: "infrastructure.cluster.x-k8s.io", Kind: "IBMPowerVSCluster"},
+			r.Name, field.ErrorList{err})
+	}
+	if err := validateEnterpriseAccountUpdate(old.Spec.EnterpriseAccount, r.Spec.EnterpriseAccount); err != nil {
+		return nil, apierrors.NewInvalid(
+			schema.GroupKind{Group: "infrastructure.cluster.x-k8s.io", Kind: "IBMPowerVSCluster"},
+			r.Name, field.ErrorList{err})
+	}
 	return r.validateIBMPowerVSCluster()
 }
 
@@ -86,6 +107,46 @@ func (r *IBMPowerVSCluster) validateIBMPowerVSCluster() (admission.Warnings, err
 		allErrs = append(allErrs, err...)
 	}
 
+	if err := r.validateIBMPowerVSClusterServiceInstance(); err != nil {
+		allErrs = append(allErrs, err)
+	}
+
+	if err := r.validateIBMPowerVSClusterManageDHCPNetwork(); err != nil {
+		allErrs = append(allErrs, err)
+	}
+
+	if err := r.validateIBMPowerVSClusterManageLoadBalancer(); err != nil {
+		allErrs = append(allErrs, err...)
+	}
+
+	if err := r.validateIBMPowerVSClusterManageTransitGateway(); err != nil {
+		allErrs = append(allErrs, err...)
+	}
+
+	if err := r.validateIBMPowerVSClusterTransitGatewayRef(); err != nil {
+		allErrs = append(allErrs, err...)
+	}
+
+	if err := r.validateIBMPowerVSClusterPrivateCloud(); err != nil {
+		allErrs = append(allErrs, err...)
+	}
+
+	if err := validateEnterpriseAccount(field.NewPath("spec", "enterpriseAccount"), r.Spec.EnterpriseAccount); err != nil {
+		allErrs = append(allErrs, err)
+	}
+
+	if err := validateClusterGuardrails(r.Spec.Guardrails); err != nil {
+		allErrs = append(allErrs, err)
+	}
+
+	if err := validateReconcileIntervals(r.Spec.ReconcileIntervals); err != nil {
+		allErrs = append(allErrs, err...)
+	}
+
+	if err := validateConnectivityCheck(r.Spec.ConnectivityCheck); err != nil {
+		allErrs = append(allErrs, err...)
+	}
+
 	if len(allErrs) == 0 {
 		return nil, nil
 	}
@@ -99,9 +160,179 @@ func (r *IBMPowerVSCluster) validateIBMPowerVSClusterNetwork() *field.Error {
 	if res, err := validateIBMPowerVSNetworkReference(r.Spec.Network); !res {
 		return err
 	}
+	if r.Spec.NetworkRef != nil && (r.Spec.Network.ID != nil || r.Spec.Network.Name != nil || r.Spec.Network.RegEx != nil || r.Spec.DHCPServer != nil) {
+		return field.Forbidden(field.NewPath("spec", "networkRef"), "network and dhcpServer must not be set when networkRef is set")
+	}
+	return nil
+}
+
+func (r *IBMPowerVSCluster) validateIBMPowerVSClusterManageDHCPNetwork() *field.Error {
+	if r.Spec.ManageDHCPNetwork == nil || !*r.Spec.ManageDHCPNetwork {
+		return nil
+	}
+	// the DHCP network is managed along with the rest of the infrastructure when create-infra annotation is set.
+	if createInfra, _ := strconv.ParseBool(r.GetAnnotations()[CreateInfrastructureAnnotation]); createInfra {
+		return nil
+	}
+	if r.Spec.ServiceInstanceID != "" || (r.Spec.ServiceInstance != nil && r.Spec.ServiceInstance.ID != nil) {
+		return nil
+	}
+	return field.Invalid(field.NewPath("spec.manageDHCPNetwork"), r.Spec.ManageDHCPNetwork, "serviceInstanceID or serviceInstance.id must be set to manage the DHCP network")
+}
+
+// validateIBMPowerVSClusterPrivateCloud validates that the workspace and zone of a cluster provisioned in a Power VS private cloud
+// are set, as they can't be looked up in IBM Cloud, that the infrastructure is not created by the controller, and that the
+// cluster references its own credentials secret, as the credentials are sent to the user-provided endpoints of the private cloud.
+func (r *IBMPowerVSCluster) validateIBMPowerVSClusterPrivateCloud() (allErrs field.ErrorList) {
+	if r.Spec.PrivateCloud == nil {
+		return nil
+	}
+	if createInfra, _ := strconv.ParseBool(r.GetAnnotations()[CreateInfrastructureAnnotation]); createInfra {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "privateCloud"), "privateCloud is not supported along with the powervs.cluster.x-k8s.io/create-infra annotation"))
+	}
+	if r.Spec.EnterpriseAccount != nil {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "enterpriseAccount"), "enterpriseAccount is not supported along with privateCloud"))
+	}
+	if r.Spec.Zone == nil || *r.Spec.Zone == "" {
+		allErrs = append(allErrs, field.Required(field.NewPath("spec", "zone"), "zone must be set when privateCloud is set"))
+	}
+	if r.Spec.ServiceInstanceID == "" && (r.Spec.ServiceInstance == nil || r.Spec.ServiceInstance.ID == nil) {
+		allErrs = append(allErrs, field.Required(field.NewPath("spec", "serviceInstanceID"), "serviceInstanceID or serviceInstance.id must be set when privateCloud is set"))
+	}
+	if r.Spec.CredentialsSecretRef == nil || r.Spec.CredentialsSecretRef.Name == "" {
+		allErrs = append(allErrs, field.Required(field.NewPath("spec", "credentialsSecretRef"), "credentialsSecretRef must be set when privateCloud is set"))
+	}
+	return allErrs
+}
+
+// validateIBMPowerVSClusterDHCPServerCidrUpdate validates that the CIDR of the DHCP server is only expanded,
+// so the addresses of the existing network are still part of the network once the DHCP server is recreated.
+func (r *IBMPowerVSCluster) validateIBMPowerVSClusterDHCPServerCidrUpdate(old *IBMPowerVSCluster) *field.Error {
+	if r.Spec.DHCPServer == nil || r.Spec.DHCPServer.Cidr == nil || old.Spec.DHCPServer == nil || old.Spec.DHCPServer.Cidr == nil {
+		return nil
+	}
+	if *r.Spec.DHCPServer.Cidr == *old.Spec.DHCPServer.Cidr {
+		return nil
+	}
+	path := field.NewPath("spec", "dhcpServer", "cidr")
+	_, newNet, err := net.ParseCIDR(*r.Spec.DHCPServer.Cidr)
+	if err != nil {
+		return field.Invalid(path, *r.Spec.DHCPServer.Cidr, "cidr must be a valid CIDR")
+	}
+	_, oldNet, err := net.ParseCIDR(*old.Spec.DHCPServer.Cidr)
+	if err != nil {
+		// the previous value has never been usable, hence any valid CIDR is accepted.
+		return nil
+	}
+	newOnes, _ := newNet.Mask.Size()
+	oldOnes, _ := oldNet.Mask.Size()
+	if !newNet.Contains(oldNet.IP) || newOnes > oldOnes {
+		return field.Invalid(path, *r.Spec.DHCPServer.Cidr, fmt.Sprintf("cidr can only be expanded to a CIDR containing %s", *old.Spec.DHCPServer.Cidr))
+	}
 	return nil
 }
 
+func (r *IBMPowerVSCluster) validateIBMPowerVSClusterManageLoadBalancer() (allErrs field.ErrorList) {
+	if r.Spec.ManageLoadBalancer == nil || !*r.Spec.ManageLoadBalancer {
+		return nil
+	}
+	// the load balancers are managed along with the rest of the infrastructure when create-infra annotation is set.
+	if createInfra, _ := strconv.ParseBool(r.GetAnnotations()[CreateInfrastructureAnnotation]); createInfra {
+		return nil
+	}
+
+	if r.Spec.VPC == nil || r.Spec.VPC.Region == nil {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec.vpc.region"), r.Spec.VPC, "value of VPC region is empty, VPC region must be set to manage the load balancers"))
+	} else if !regionUtil.ValidateVPCRegion(*r.Spec.VPC.Region) {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec.vpc.region"), r.Spec.VPC.Region, fmt.Sprintf("vpc region '%s' is not supported", *r.Spec.VPC.Region)))
+	}
+
+	if len(r.Spec.VPCSubnets) == 0 {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec.vpcSubnets"), r.Spec.VPCSubnets, "value of VPC subnets is empty, existing VPC subnets must be set to manage the load balancers"))
+	}
+	for i, subnet := range r.Spec.VPCSubnets {
+		if subnet.ID == nil && subnet.Name == nil {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("spec", fmt.Sprintf("vpcSubnets[%d]", i)), subnet, "either ID or name of the VPC subnet must be set"))
+		}
+	}
+	if err := r.validateIBMPowerVSClusterVPCSubnetNames(); err != nil {
+		allErrs = append(allErrs, err...)
+	}
+
+	if r.Spec.ResourceGroup == nil {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec.resourceGroup"), r.Spec.ResourceGroup, "value of resource group is empty, resource group must be set to manage the load balancers"))
+	}
+
+	if err := r.validateIBMPowerVSClusterLoadBalancers(); err != nil {
+		allErrs = append(allErrs, err...)
+	}
+	return allErrs
+}
+
+func (r *IBMPowerVSCluster) validateIBMPowerVSClusterManageTransitGateway() (allErrs field.ErrorList) {
+	if r.Spec.ManageTransitGateway == nil || !*r.Spec.ManageTransitGateway {
+		return nil
+	}
+	// the transit gateway is managed along with the rest of the infrastructure when create-infra annotation is set.
+	if createInfra, _ := strconv.ParseBool(r.GetAnnotations()[CreateInfrastructureAnnotation]); createInfra {
+		return nil
+	}
+
+	if r.Spec.ServiceInstanceID == "" && (r.Spec.ServiceInstance == nil || r.Spec.ServiceInstance.ID == nil) {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec.manageTransitGateway"), r.Spec.ManageTransitGateway, "serviceInstanceID or serviceInstance.id must be set to manage the transit gateway"))
+	}
+
+	if r.Spec.Zone == nil {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec.zone"), r.Spec.Zone, "value of zone is empty, zone must be set to manage the transit gateway"))
+	} else if !regionUtil.ValidateZone(*r.Spec.Zone) {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec.zone"), r.Spec.Zone, fmt.Sprintf("zone '%s' is not supported", *r.Spec.Zone)))
+	}
+
+	if r.Spec.VPC == nil || r.Spec.VPC.ID == nil {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec.vpc.id"), r.Spec.VPC, "value of VPC ID is empty, VPC ID must be set to manage the transit gateway"))
+	}
+	if r.Spec.VPC == nil || r.Spec.VPC.Region == nil {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec.vpc.region"), r.Spec.VPC, "value of VPC region is empty, VPC region must be set to manage the transit gateway"))
+	} else if !regionUtil.ValidateVPCRegion(*r.Spec.VPC.Region) {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec.vpc.region"), r.Spec.VPC.Region, fmt.Sprintf("vpc region '%s' is not supported", *r.Spec.VPC.Region)))
+	}
+
+	if r.Spec.ResourceGroup == nil {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec.resourceGroup"), r.Spec.ResourceGroup, "value of resource group is empty, resource group must be set to manage the transit gateway"))
+	}
+
+	if len(allErrs) == 0 {
+		if err := r.validateIBMPowerVSClusterTransitGateway(); err != nil {
+			allErrs = append(allErrs, err)
+		}
+	}
+	return allErrs
+}
+
+func (r *IBMPowerVSCluster) validateIBMPowerVSClusterTransitGatewayRef() (allErrs field.ErrorList) {
+	if r.Spec.TransitGatewayRef == nil {
+		return nil
+	}
+	if r.Spec.TransitGateway != nil {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "transitGatewayRef"), "transitGateway must not be set when transitGatewayRef is set"))
+	}
+	// the workspace and VPC are created along with the rest of the infrastructure when create-infra annotation is set.
+	if createInfra, _ := strconv.ParseBool(r.GetAnnotations()[CreateInfrastructureAnnotation]); createInfra {
+		return allErrs
+	}
+
+	if r.Spec.ServiceInstanceID == "" && (r.Spec.ServiceInstance == nil || r.Spec.ServiceInstance.ID == nil) {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec.transitGatewayRef"), r.Spec.TransitGatewayRef, "serviceInstanceID or serviceInstance.id must be set to connect to the transit gateway"))
+	}
+	if r.Spec.VPC == nil || r.Spec.VPC.ID == nil {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec.vpc.id"), r.Spec.VPC, "value of VPC ID is empty, VPC ID must be set to connect to the transit gateway"))
+	}
+	if r.Spec.VPC == nil || r.Spec.VPC.Region == nil {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec.vpc.region"), r.Spec.VPC, "value of VPC region is empty, VPC region must be set to connect to the transit gateway"))
+	}
+	return allErrs
+}
+
 func (r *IBMPowerVSCluster) validateIBMPowerVSClusterLoadBalancers() (allErrs field.ErrorList) {
 	if err := r.validateIBMPowerVSClusterLoadBalancerNames(); err != nil {
 		allErrs = append(allErrs, err...)
@@ -166,6 +397,21 @@ func (r *IBMPowerVSCluster) validateIBMPowerVSClusterTransitGateway() *field.Err
 	return nil
 }
 
+// validateIBMPowerVSClusterServiceInstance validates the workspace of the cluster is referenced when the workspace is not
+// created by the controller, i.e. when the powervs.cluster.x-k8s.io/create-infra annotation is not set.
+func (r *IBMPowerVSCluster) validateIBMPowerVSClusterServiceInstance() *field.Error {
+	if createInfra, err := strconv.ParseBool(r.GetAnnotations()[CreateInfrastructureAnnotation]); err == nil && createInfra {
+		return nil
+	}
+	if r.Spec.ServiceInstanceID != "" || r.Spec.PrivateCloud != nil {
+		return nil
+	}
+	if r.Spec.ServiceInstance != nil && (r.Spec.ServiceInstance.ID != nil || r.Spec.ServiceInstance.Name != nil) {
+		return nil
+	}
+	return field.Required(field.NewPath("spec", "serviceInstanceID"), "serviceInstanceID or serviceInstance is required when powervs.cluster.x-k8s.io/create-infra annotation is not set")
+}
+
 func (r *IBMPowerVSCluster) validateIBMPowerVSClusterCreateInfraPrereq() (allErrs field.ErrorList) {
 	annotations := r.GetAnnotations()
 	if len(annotations) == 0 {
@@ -192,6 +438,8 @@ func (r *IBMPowerVSCluster) validateIBMPowerVSClusterCreateInfraPrereq() (allErr
 
 	if r.Spec.Zone != nil && !regionUtil.ValidateZone(*r.Spec.Zone) {
 		allErrs = append(allErrs, field.Invalid(field.NewPath("spec.zone"), r.Spec.Zone, fmt.Sprintf("zone '%s' is not supported", *r.Spec.Zone)))
+	} else if r.Spec.Zone != nil && !supportmatrix.IsSupported(supportmatrix.PowerEdgeRouter, *r.Spec.Zone) {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec.zone"), r.Spec.Zone, fmt.Sprintf("zone '%s' does not support Power Edge Router(PER)", *r.Spec.Zone)))
 	}
 
 	if r.Spec.VPC == nil {
@@ -18,8 +18,11 @@ package v1beta2
 
 import (
 	"testing"
+	"time"
 
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/utils/ptr"
 )
 
@@ -41,6 +44,225 @@ func TestIBMPowerVSCluster_create(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "Should error if the service instance is not set without create-infra annotation",
+			powervsCluster: &IBMPowerVSCluster{
+				Spec: IBMPowerVSClusterSpec{
+					Network: IBMPowerVSResourceReference{
+						ID: ptr.To("capi-net-id"),
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Should allow if the service instance name is set without create-infra annotation",
+			powervsCluster: &IBMPowerVSCluster{
+				Spec: IBMPowerVSClusterSpec{
+					ServiceInstance: &IBMPowerVSResourceReference{Name: ptr.To("capi-si")},
+					Network: IBMPowerVSResourceReference{
+						ID: ptr.To("capi-net-id"),
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Should allow if networkRef is set without Network and DHCPServer",
+			powervsCluster: &IBMPowerVSCluster{
+				Spec: IBMPowerVSClusterSpec{
+					ServiceInstanceID: "capi-si-id",
+					NetworkRef:        &corev1.LocalObjectReference{Name: "capi-net"},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Should error if both networkRef and Network are set",
+			powervsCluster: &IBMPowerVSCluster{
+				Spec: IBMPowerVSClusterSpec{
+					ServiceInstanceID: "capi-si-id",
+					Network: IBMPowerVSResourceReference{
+						ID: ptr.To("capi-net-id"),
+					},
+					NetworkRef: &corev1.LocalObjectReference{Name: "capi-net"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Should allow managing DHCP network when service instance ID is set",
+			powervsCluster: &IBMPowerVSCluster{
+				Spec: IBMPowerVSClusterSpec{
+					ServiceInstanceID: "capi-si-id",
+					ManageDHCPNetwork: ptr.To(true),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Should allow private cloud when zone and service instance ID are set",
+			powervsCluster: &IBMPowerVSCluster{
+				Spec: IBMPowerVSClusterSpec{
+					ServiceInstanceID:    "capi-si-id",
+					Zone:                 ptr.To("satloc_dal_capi"),
+					CredentialsSecretRef: &corev1.LocalObjectReference{Name: "private-cloud-credentials"},
+					PrivateCloud: &PowerVSPrivateCloud{
+						Endpoint:    "https://power.private-cloud.example.com",
+						IAMEndpoint: "https://iam.private-cloud.example.com",
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Should error if private cloud is set without credentials secret",
+			powervsCluster: &IBMPowerVSCluster{
+				Spec: IBMPowerVSClusterSpec{
+					ServiceInstanceID: "capi-si-id",
+					Zone:              ptr.To("satloc_dal_capi"),
+					PrivateCloud: &PowerVSPrivateCloud{
+						Endpoint:    "https://power.private-cloud.example.com",
+						IAMEndpoint: "https://iam.private-cloud.example.com",
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Should error if private cloud is set without zone and service instance ID",
+			powervsCluster: &IBMPowerVSCluster{
+				Spec: IBMPowerVSClusterSpec{
+					ServiceInstance: &IBMPowerVSResourceReference{
+						Name: ptr.To("capi-si"),
+					},
+					PrivateCloud: &PowerVSPrivateCloud{
+						Endpoint: "https://power.private-cloud.example.com",
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Should error if managing DHCP network without service instance ID",
+			powervsCluster: &IBMPowerVSCluster{
+				Spec: IBMPowerVSClusterSpec{
+					ServiceInstance:   &IBMPowerVSResourceReference{Name: ptr.To("capi-si")},
+					ManageDHCPNetwork: ptr.To(true),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Should allow managing load balancers when VPC region, subnets and resource group are set",
+			powervsCluster: &IBMPowerVSCluster{
+				Spec: IBMPowerVSClusterSpec{
+					ServiceInstanceID:  "capi-si-id",
+					ManageLoadBalancer: ptr.To(true),
+					VPC:                &VPCResourceReference{Region: ptr.To("us-south")},
+					VPCSubnets:         []Subnet{{Name: ptr.To("capi-subnet")}},
+					ResourceGroup:      &IBMPowerVSResourceReference{Name: ptr.To("capi-rg")},
+					LoadBalancers:      []VPCLoadBalancerSpec{{Name: "capi-lb", Public: ptr.To(true)}},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Should error if managing load balancers without VPC subnets and resource group",
+			powervsCluster: &IBMPowerVSCluster{
+				Spec: IBMPowerVSClusterSpec{
+					ServiceInstanceID:  "capi-si-id",
+					ManageLoadBalancer: ptr.To(true),
+					VPC:                &VPCResourceReference{Region: ptr.To("us-south")},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Should error if managing load balancers without VPC region",
+			powervsCluster: &IBMPowerVSCluster{
+				Spec: IBMPowerVSClusterSpec{
+					ServiceInstanceID:  "capi-si-id",
+					ManageLoadBalancer: ptr.To(true),
+					VPCSubnets:         []Subnet{{ID: ptr.To("capi-subnet-id")}},
+					ResourceGroup:      &IBMPowerVSResourceReference{ID: ptr.To("capi-rg-id")},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Should allow managing transit gateway when service instance ID, zone, VPC and resource group are set",
+			powervsCluster: &IBMPowerVSCluster{
+				Spec: IBMPowerVSClusterSpec{
+					ServiceInstanceID:    "capi-si-id",
+					ManageTransitGateway: ptr.To(true),
+					Zone:                 ptr.To("dal10"),
+					VPC:                  &VPCResourceReference{ID: ptr.To("capi-vpc-id"), Region: ptr.To("us-south")},
+					ResourceGroup:        &IBMPowerVSResourceReference{Name: ptr.To("capi-rg")},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Should error if managing transit gateway without VPC ID and zone",
+			powervsCluster: &IBMPowerVSCluster{
+				Spec: IBMPowerVSClusterSpec{
+					ServiceInstanceID:    "capi-si-id",
+					ManageTransitGateway: ptr.To(true),
+					VPC:                  &VPCResourceReference{Name: ptr.To("capi-vpc"), Region: ptr.To("us-south")},
+					ResourceGroup:        &IBMPowerVSResourceReference{Name: ptr.To("capi-rg")},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Should error if managing transit gateway with local routing when global routing is required",
+			powervsCluster: &IBMPowerVSCluster{
+				Spec: IBMPowerVSClusterSpec{
+					ServiceInstanceID:    "capi-si-id",
+					ManageTransitGateway: ptr.To(true),
+					Zone:                 ptr.To("dal10"),
+					VPC:                  &VPCResourceReference{ID: ptr.To("capi-vpc-id"), Region: ptr.To("eu-de")},
+					ResourceGroup:        &IBMPowerVSResourceReference{Name: ptr.To("capi-rg")},
+					TransitGateway:       &TransitGateway{GlobalRouting: ptr.To(false)},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Should allow transitGatewayRef when service instance ID and VPC are set",
+			powervsCluster: &IBMPowerVSCluster{
+				Spec: IBMPowerVSClusterSpec{
+					ServiceInstanceID: "capi-si-id",
+					VPC:               &VPCResourceReference{ID: ptr.To("capi-vpc-id"), Region: ptr.To("us-south")},
+					TransitGatewayRef: &corev1.LocalObjectReference{Name: "capi-tg"},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Should error if transitGatewayRef is set without VPC ID",
+			powervsCluster: &IBMPowerVSCluster{
+				Spec: IBMPowerVSClusterSpec{
+					ServiceInstanceID: "capi-si-id",
+					VPC:               &VPCResourceReference{Name: ptr.To("capi-vpc"), Region: ptr.To("us-south")},
+					TransitGatewayRef: &corev1.LocalObjectReference{Name: "capi-tg"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Should error if both transitGatewayRef and TransitGateway are set",
+			powervsCluster: &IBMPowerVSCluster{
+				Spec: IBMPowerVSClusterSpec{
+					ServiceInstanceID: "capi-si-id",
+					VPC:               &VPCResourceReference{ID: ptr.To("capi-vpc-id"), Region: ptr.To("us-south")},
+					TransitGateway:    &TransitGateway{Name: ptr.To("capi-tg")},
+					TransitGatewayRef: &corev1.LocalObjectReference{Name: "capi-tg"},
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "Should error if both Network ID and name are set",
 			powervsCluster: &IBMPowerVSCluster{
@@ -68,6 +290,75 @@ func TestIBMPowerVSCluster_create(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "Should allow fractional guardrails maxTotalCores",
+			powervsCluster: &IBMPowerVSCluster{
+				Spec: IBMPowerVSClusterSpec{
+					ServiceInstanceID: "capi-si-id",
+					Network: IBMPowerVSResourceReference{
+						ID: ptr.To("capi-net-id"),
+					},
+					Guardrails: &ClusterGuardrails{
+						MaxInstances:  ptr.To[int32](5),
+						MaxTotalCores: ptr.To(intstr.FromString("7.5")),
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Should error if guardrails maxTotalCores is not a number",
+			powervsCluster: &IBMPowerVSCluster{
+				Spec: IBMPowerVSClusterSpec{
+					ServiceInstanceID: "capi-si-id",
+					Network: IBMPowerVSResourceReference{
+						ID: ptr.To("capi-net-id"),
+					},
+					Guardrails: &ClusterGuardrails{
+						MaxTotalCores: ptr.To(intstr.FromString("eight")),
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Should allow reconcile intervals",
+			powervsCluster: &IBMPowerVSCluster{
+				Spec: IBMPowerVSClusterSpec{
+					ServiceInstanceID: "capi-si-id",
+					Network: IBMPowerVSResourceReference{
+						ID: ptr.To("capi-net-id"),
+					},
+					ReconcileIntervals: &ReconcileIntervals{
+						Cluster: &ReconcileInterval{
+							RequeueInterval: &metav1.Duration{Duration: 5 * time.Second},
+						},
+						Images: &ReconcileInterval{
+							RequeueInterval: &metav1.Duration{Duration: 5 * time.Minute},
+							SyncPeriod:      &metav1.Duration{Duration: time.Hour},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Should error if a reconcile interval is negative",
+			powervsCluster: &IBMPowerVSCluster{
+				Spec: IBMPowerVSClusterSpec{
+					ServiceInstanceID: "capi-si-id",
+					Network: IBMPowerVSResourceReference{
+						ID: ptr.To("capi-net-id"),
+					},
+					ReconcileIntervals: &ReconcileIntervals{
+						Machines: &ReconcileInterval{
+							SyncPeriod: &metav1.Duration{Duration: -time.Minute},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tc := range tests {
@@ -196,3 +487,165 @@ func TestIBMPowerVSCluster_update(t *testing.T) {
 		})
 	}
 }
+
+func TestIBMPowerVSCluster_ValidateUpdateDHCPServerCidr(t *testing.T) {
+	tests := []struct {
+		name    string
+		oldCidr *string
+		newCidr *string
+		wantErr bool
+	}{
+		{
+			name:    "Should allow expanding the cidr",
+			oldCidr: ptr.To("192.168.0.0/24"),
+			newCidr: ptr.To("192.168.0.0/16"),
+			wantErr: false,
+		},
+		{
+			name:    "Should allow setting the cidr",
+			newCidr: ptr.To("192.168.0.0/24"),
+			wantErr: false,
+		},
+		{
+			name:    "Should reject shrinking the cidr",
+			oldCidr: ptr.To("192.168.0.0/16"),
+			newCidr: ptr.To("192.168.0.0/24"),
+			wantErr: true,
+		},
+		{
+			name:    "Should reject a cidr not containing the previous cidr",
+			oldCidr: ptr.To("192.168.0.0/24"),
+			newCidr: ptr.To("10.0.0.0/8"),
+			wantErr: true,
+		},
+		{
+			name:    "Should reject an invalid cidr",
+			oldCidr: ptr.To("192.168.0.0/24"),
+			newCidr: ptr.To("192.168.0.0"),
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			oldCluster := &IBMPowerVSCluster{
+				Spec: IBMPowerVSClusterSpec{
+					ServiceInstanceID: "capi-si-id",
+					DHCPServer:        &DHCPServer{Cidr: tc.oldCidr},
+				},
+			}
+			newCluster := oldCluster.DeepCopy()
+			newCluster.Spec.DHCPServer.Cidr = tc.newCidr
+
+			if _, err := newCluster.ValidateUpdate(oldCluster); (err != nil) != tc.wantErr {
+				t.Errorf("ValidateUpdate() error = %v, wantErr %v", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestIBMPowerVSCluster_ValidateUpdateDNS(t *testing.T) {
+	dns := &DNSSpec{Provider: DNSProviderDNSServices, InstanceID: "instance-id", ZoneID: "zone-id", Hostname: "api.capi-cluster.example.com"}
+	tests := []struct {
+		name    string
+		newDNS  *DNSSpec
+		record  *ResourceReference
+		wantErr bool
+	}{
+		{
+			name:    "Should allow changing the hostname of the record created by the controller",
+			newDNS:  &DNSSpec{Provider: DNSProviderDNSServices, InstanceID: "instance-id", ZoneID: "zone-id", Hostname: "api2.capi-cluster.example.com"},
+			record:  &ResourceReference{ID: ptr.To("record-id"), ControllerCreated: ptr.To(true)},
+			wantErr: false,
+		},
+		{
+			name:    "Should allow removing dns when the record is adopted",
+			record:  &ResourceReference{ID: ptr.To("record-id"), ControllerCreated: ptr.To(false)},
+			wantErr: false,
+		},
+		{
+			name:    "Should allow removing dns before the record is created",
+			wantErr: false,
+		},
+		{
+			name:    "Should reject removing dns when the record is created by the controller",
+			record:  &ResourceReference{ID: ptr.To("record-id"), ControllerCreated: ptr.To(true)},
+			wantErr: true,
+		},
+		{
+			name:    "Should reject moving the record created by the controller to another zone",
+			newDNS:  &DNSSpec{Provider: DNSProviderDNSServices, InstanceID: "instance-id", ZoneID: "other-zone-id", Hostname: "api.capi-cluster.example.com"},
+			record:  &ResourceReference{ID: ptr.To("record-id"), ControllerCreated: ptr.To(true)},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			oldCluster := &IBMPowerVSCluster{
+				Spec: IBMPowerVSClusterSpec{
+					ServiceInstanceID: "capi-si-id",
+					DNS:               dns,
+				},
+				Status: IBMPowerVSClusterStatus{DNSRecord: tc.record},
+			}
+			newCluster := oldCluster.DeepCopy()
+			newCluster.Spec.DNS = tc.newDNS
+
+			if _, err := newCluster.ValidateUpdate(oldCluster); (err != nil) != tc.wantErr {
+				t.Errorf("ValidateUpdate() error = %v, wantErr %v", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestIBMPowerVSCluster_ValidateEnterpriseAccount(t *testing.T) {
+	account := &EnterpriseAccount{ProjectCRN: ptr.To("crn:v1:bluemix:public:project:us-south:a/child-account-id:project-id::"), TrustedProfileName: "capibm-provisioner"}
+	tests := []struct {
+		name       string
+		oldAccount *EnterpriseAccount
+		newAccount *EnterpriseAccount
+		wantErr    bool
+	}{
+		{
+			name:       "Should allow keeping the project of the enterprise account",
+			oldAccount: account,
+			newAccount: account.DeepCopy(),
+			wantErr:    false,
+		},
+		{
+			name:       "Should reject changing the enterprise account",
+			oldAccount: account,
+			newAccount: &EnterpriseAccount{ID: ptr.To("other-account-id"), TrustedProfileName: "capibm-provisioner"},
+			wantErr:    true,
+		},
+		{
+			name:       "Should reject setting the enterprise account",
+			newAccount: account.DeepCopy(),
+			wantErr:    true,
+		},
+		{
+			name:       "Should reject the CRN of a resource other than a project",
+			newAccount: &EnterpriseAccount{ProjectCRN: ptr.To("crn:v1:bluemix:public:kms:us-south:a/child-account-id:instance-id:key:key-id"), TrustedProfileName: "capibm-provisioner"},
+			oldAccount: &EnterpriseAccount{ProjectCRN: ptr.To("crn:v1:bluemix:public:kms:us-south:a/child-account-id:instance-id:key:key-id"), TrustedProfileName: "capibm-provisioner"},
+			wantErr:    true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			oldCluster := &IBMPowerVSCluster{
+				Spec: IBMPowerVSClusterSpec{
+					ServiceInstanceID: "capi-si-id",
+					EnterpriseAccount: tc.oldAccount,
+				},
+			}
+			newCluster := oldCluster.DeepCopy()
+			newCluster.Spec.EnterpriseAccount = tc.newAccount
+
+			if _, err := newCluster.ValidateUpdate(oldCluster); (err != nil) != tc.wantErr {
+				t.Errorf("ValidateUpdate() error = %v, wantErr %v", err, tc.wantErr)
+			}
+		})
+	}
+}
@@ -21,6 +21,7 @@ import (
 	"reflect"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -55,7 +56,15 @@ var _ webhook.Validator = &IBMPowerVSClusterTemplate{}
 func (r *IBMPowerVSClusterTemplate) ValidateCreate() (admission.Warnings, error) {
 	ibmpowervsclustertemplatelog.Info("validate create", "name", r.Name)
 
-	return nil, nil
+	// Validate the spec of the template the same way as the IBMPowerVSCluster created from it.
+	cluster := &IBMPowerVSCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        r.Name,
+			Annotations: r.Spec.Template.ObjectMeta.Annotations,
+		},
+		Spec: r.Spec.Template.Spec,
+	}
+	return cluster.validateIBMPowerVSCluster()
 }
 
 // ValidateUpdate implements webhook.Validator so a webhook will be registered for the type.
@@ -19,9 +19,61 @@ package v1beta2
 import (
 	"testing"
 
+	capiv1beta1 "sigs.k8s.io/cluster-api/api/v1beta1"
+
 	. "github.com/onsi/gomega"
 )
 
+func TestIBMPowerVSClusterTemplate_ValidateCreate(t *testing.T) {
+	g := NewWithT(t)
+
+	tests := []struct {
+		name     string
+		template *IBMPowerVSClusterTemplate
+		wantErr  bool
+	}{
+		{
+			name: "IBMPowerVSClusterTemplate with service instance ID",
+			template: &IBMPowerVSClusterTemplate{
+				Spec: IBMPowerVSClusterTemplateSpec{
+					Template: IBMPowerVSClusterTemplateResource{
+						Spec: IBMPowerVSClusterSpec{
+							ServiceInstanceID: "test-instance1",
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "IBMPowerVSClusterTemplate with create infra annotation and without zone",
+			template: &IBMPowerVSClusterTemplate{
+				Spec: IBMPowerVSClusterTemplateSpec{
+					Template: IBMPowerVSClusterTemplateResource{
+						ObjectMeta: capiv1beta1.ObjectMeta{
+							Annotations: map[string]string{CreateInfrastructureAnnotation: "true"},
+						},
+						Spec: IBMPowerVSClusterSpec{
+							ServiceInstanceID: "test-instance1",
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(_ *testing.T) {
+			_, err := test.template.ValidateCreate()
+			if test.wantErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+		})
+	}
+}
+
 func TestIBMPowerVSClusterTemplate_ValidateUpdate(t *testing.T) {
 	g := NewWithT(t)
 
@@ -17,6 +17,7 @@ limitations under the License.
 package v1beta2
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	capiv1beta1 "sigs.k8s.io/cluster-api/api/v1beta1"
@@ -30,6 +31,17 @@ const (
 	IBMPowerVSImageFinalizer = "ibmpowervsimage.infrastructure.cluster.x-k8s.io"
 )
 
+const (
+	// PowerVSImageBucketAccessPublic is the access of a Cloud Object Storage bucket which can be read anonymously.
+	PowerVSImageBucketAccessPublic = "public"
+	// PowerVSImageBucketAccessPrivate is the access of a Cloud Object Storage bucket read with HMAC credentials.
+	PowerVSImageBucketAccessPrivate = "private"
+	// PowerVSImageStorageTypeTier1 is the tier1 storage type of an image.
+	PowerVSImageStorageTypeTier1 = "tier1"
+	// PowerVSImageStorageTypeTier3 is the tier3 storage type of an image.
+	PowerVSImageStorageTypeTier3 = "tier3"
+)
+
 // IBMPowerVSImageSpec defines the desired state of IBMPowerVSImage.
 type IBMPowerVSImageSpec struct {
 
@@ -52,13 +64,55 @@ type IBMPowerVSImageSpec struct {
 	ServiceInstance *IBMPowerVSResourceReference `json:"serviceInstance,omitempty"`
 
 	// Cloud Object Storage bucket name; bucket-name[/optional/folder]
-	Bucket *string `json:"bucket"`
+	// required when SourceImage is not set.
+	// +optional
+	Bucket *string `json:"bucket,omitempty"`
 
 	// Cloud Object Storage image filename.
-	Object *string `json:"object"`
+	// required when SourceImage is not set.
+	// +optional
+	Object *string `json:"object,omitempty"`
 
 	// Cloud Object Storage region.
-	Region *string `json:"region"`
+	// required when SourceImage is not set.
+	// +optional
+	Region *string `json:"region,omitempty"`
+
+	// SourceImage is the reference to a stock catalog image which will be copied into the Power VS workspace
+	// instead of importing the image from Cloud Object Storage.
+	// supported identifiers are Name and ID of the stock image, and only one of them may be specified.
+	// Bucket, Object, Region, BucketAccess and CredentialsSecretRef must not be set when SourceImage is set,
+	// unless SourceServiceInstance is set.
+	// +optional
+	SourceImage *IBMPowerVSResourceReference `json:"sourceImage,omitempty"`
+
+	// SourceServiceInstance is the reference to the Power VS workspace holding SourceImage, the image is then cloned from
+	// the workspace instead of copied from the stock catalog.
+	// as images can't be copied across workspaces, SourceImage is exported from the workspace to Bucket in Region as
+	// <image name>.ova.gz with the HMAC credentials of CredentialsSecretRef, which must have write access to the bucket,
+	// and imported into the Power VS workspace of the image from there. Object must not be set.
+	// supported identifier is ID of the workspace.
+	// +optional
+	SourceServiceInstance *IBMPowerVSResourceReference `json:"sourceServiceInstance,omitempty"`
+
+	// CaptureInstance is the reference to an instance of the Power VS workspace whose boot volume is captured into the image
+	// instead of importing the image from Cloud Object Storage.
+	// supported identifiers are Name and ID of the instance, and only one of them may be specified.
+	// Bucket, Object, Region, SourceImage, BucketAccess and CredentialsSecretRef must not be set when CaptureInstance is set.
+	// +optional
+	CaptureInstance *IBMPowerVSResourceReference `json:"captureInstance,omitempty"`
+
+	// BucketAccess indicates if the Cloud Object Storage bucket has public or private access.
+	// when set to private, CredentialsSecretRef must be set with the HMAC credentials used to access the bucket.
+	// +kubebuilder:default=public
+	// +kubebuilder:validation:Enum=public;private
+	// +optional
+	BucketAccess string `json:"bucketAccess,omitempty"`
+
+	// CredentialsSecretRef is the reference to the secret containing the HMAC credentials of the Cloud Object Storage bucket.
+	// the secret must exist in the same namespace as the IBMPowerVSImage and contain the accessKey and secretKey keys.
+	// +optional
+	CredentialsSecretRef *corev1.LocalObjectReference `json:"credentialsSecretRef,omitempty"`
 
 	// Type of storage, storage pool with the most available space will be selected.
 	// +kubebuilder:default=tier1
@@ -71,6 +125,48 @@ type IBMPowerVSImageSpec struct {
 	// +kubebuilder:validation:Enum=delete;retain
 	// +optional
 	DeletePolicy string `json:"deletePolicy,omitempty"`
+
+	// Export is the Cloud Object Storage bucket the image is exported to once it is ready.
+	// the image is exported again when the bucket or the region are changed.
+	// +optional
+	Export *PowerVSImageExport `json:"export,omitempty"`
+}
+
+// PowerVSImageExport defines the Cloud Object Storage bucket an image is exported to.
+type PowerVSImageExport struct {
+	// Bucket is the name of the Cloud Object Storage bucket; bucket-name[/optional/folder].
+	// +kubebuilder:validation:MinLength=3
+	Bucket string `json:"bucket"`
+
+	// Region is the region of the Cloud Object Storage bucket.
+	// +kubebuilder:validation:MinLength=1
+	Region string `json:"region"`
+
+	// CredentialsSecretRef is the reference to the secret containing the HMAC credentials with write access to the bucket.
+	// the secret must exist in the same namespace as the IBMPowerVSImage and contain the accessKey and secretKey keys.
+	CredentialsSecretRef corev1.LocalObjectReference `json:"credentialsSecretRef"`
+}
+
+// PowerVSImageExportStatus defines the observed state of the export of an image.
+type PowerVSImageExportStatus struct {
+	// Bucket is the Cloud Object Storage bucket the image is exported to.
+	Bucket string `json:"bucket"`
+
+	// Region is the region of the Cloud Object Storage bucket.
+	Region string `json:"region"`
+
+	// JobID is the job ID of the export operation, it is cleared once the job is finished.
+	// +optional
+	JobID string `json:"jobID,omitempty"`
+
+	// State is the state of the export job, completed or failed once the job is finished.
+	// +optional
+	State string `json:"state,omitempty"`
+
+	// Message is the latest message reported by the export job, it will contain the failure reason
+	// in case the export job failed.
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
 // IBMPowerVSImageStatus defines the observed state of IBMPowerVSImage.
@@ -87,10 +183,33 @@ type IBMPowerVSImageStatus struct {
 	// +optional
 	ImageState PowerVSImageState `json:"imageState,omitempty"`
 
-	// JobID is the job ID of an import operation.
+	// JobID is the job ID of an import or capture operation.
 	// +optional
 	JobID string `json:"jobID,omitempty"`
 
+	// JobProgress is the progress of the import operation as reported by the import job.
+	// +optional
+	JobProgress string `json:"jobProgress,omitempty"`
+
+	// JobMessage is the latest message reported by the import job, it will contain the failure reason
+	// in case the import job failed.
+	// +optional
+	JobMessage string `json:"jobMessage,omitempty"`
+
+	// Export is the state of the export of the image to Cloud Object Storage.
+	// +optional
+	Export *PowerVSImageExportStatus `json:"export,omitempty"`
+
+	// SourceExport is the state of the export of the source image from its workspace to Cloud Object Storage,
+	// when the image is cloned from another workspace.
+	// +optional
+	SourceExport *PowerVSImageExportStatus `json:"sourceExport,omitempty"`
+
+	// UsedBy is the list of the IBMPowerVSMachines and IBMPowerVSMachineTemplates referencing the image via their imageRef.
+	// The deletion of the image is blocked while it is in use, unless the force-delete annotation is set.
+	// +optional
+	UsedBy []ImageReferrer `json:"usedBy,omitempty"`
+
 	// Conditions defines current service state of the IBMPowerVSImage.
 	// +optional
 	Conditions capiv1beta1.Conditions `json:"conditions,omitempty"`
@@ -101,6 +220,10 @@ type IBMPowerVSImageStatus struct {
 //+kubebuilder:storageversion
 // +kubebuilder:printcolumn:name="State",type="string",JSONPath=".status.imageState",description="PowerVS image state"
 // +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.ready",description="Image is ready for IBM PowerVS instances"
+// +kubebuilder:printcolumn:name="Image ID",type="string",priority=1,JSONPath=".status.imageID",description="PowerVS image ID"
+// +kubebuilder:printcolumn:name="Progress",type="string",priority=1,JSONPath=".status.jobProgress",description="Progress of the image import job"
+// +kubebuilder:printcolumn:name="Reason",type="string",priority=1,JSONPath=".status.conditions[?(@.type==\"ImageImported\")].reason",description="Reason of the image import job state"
+// +kubebuilder:printcolumn:name="Message",type="string",priority=1,JSONPath=".status.jobMessage",description="Message reported by the image import job"
 
 // IBMPowerVSImage is the Schema for the ibmpowervsimages API.
 type IBMPowerVSImage struct {
@@ -17,7 +17,17 @@ limitations under the License.
 package v1beta2
 
 import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+
+	regionUtil "github.com/ppc64le-cloud/powervs-utils"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	ctrl "sigs.k8s.io/controller-runtime"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
@@ -28,6 +38,12 @@ import (
 // log is for logging in this package.
 var ibmpowervsimagelog = logf.Log.WithName("ibmpowervsimage-resource")
 
+// cosBucketNameRegex matches the names of the Cloud Object Storage buckets.
+var cosBucketNameRegex = regexp.MustCompile(`^[a-z0-9][a-z0-9.-]{1,61}[a-z0-9]$`)
+
+// powerVSImageObjectSuffixes are the formats of the image files imported into Power VS.
+var powerVSImageObjectSuffixes = []string{".ova", ".ova.gz", ".tar", ".tar.gz", ".tgz"}
+
 func (r *IBMPowerVSImage) SetupWebhookWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewWebhookManagedBy(mgr).
 		For(r).
@@ -41,9 +57,17 @@ var _ webhook.Defaulter = &IBMPowerVSImage{}
 // Default implements webhook.Defaulter so a webhook will be registered for the type.
 func (r *IBMPowerVSImage) Default() {
 	ibmpowervsimagelog.Info("default", "name", r.Name)
+	if r.Spec.SourceImage == nil && r.Spec.CaptureInstance == nil && r.Spec.BucketAccess == "" {
+		r.Spec.BucketAccess = PowerVSImageBucketAccessPublic
+	}
+	if r.Spec.StorageType == "" {
+		r.Spec.StorageType = PowerVSImageStorageTypeTier1
+	}
+	if r.Spec.DeletePolicy == "" {
+		r.Spec.DeletePolicy = string(DeletePolicyDelete)
+	}
 }
 
-// TODO(user): change verbs to "verbs=create;update;delete" if you want to enable deletion validation.
 //+kubebuilder:webhook:verbs=create;update,path=/validate-infrastructure-cluster-x-k8s-io-v1beta2-ibmpowervsimage,mutating=false,failurePolicy=fail,groups=infrastructure.cluster.x-k8s.io,resources=ibmpowervsimages,versions=v1beta2,name=vibmpowervsimage.kb.io,sideEffects=None,admissionReviewVersions=v1;v1beta1
 
 var _ webhook.Validator = &IBMPowerVSImage{}
@@ -51,13 +75,34 @@ var _ webhook.Validator = &IBMPowerVSImage{}
 // ValidateCreate implements webhook.Validator so a webhook will be registered for the type.
 func (r *IBMPowerVSImage) ValidateCreate() (admission.Warnings, error) {
 	ibmpowervsimagelog.Info("validate create", "name", r.Name)
-	return nil, nil
+	return r.validateIBMPowerVSImage()
 }
 
 // ValidateUpdate implements webhook.Validator so a webhook will be registered for the type.
-func (r *IBMPowerVSImage) ValidateUpdate(_ runtime.Object) (admission.Warnings, error) {
+func (r *IBMPowerVSImage) ValidateUpdate(oldRaw runtime.Object) (admission.Warnings, error) {
 	ibmpowervsimagelog.Info("validate update", "name", r.Name)
-	return nil, nil
+	old, ok := oldRaw.(*IBMPowerVSImage)
+	if !ok {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("expected an IBMPowerVSImage but got a %T", oldRaw))
+	}
+	// the image is imported once, only the delete policy, the credentials of the bucket and the export can be changed afterwards.
+	oldSpec := old.Spec.DeepCopy()
+	oldSpec.DeletePolicy = r.Spec.DeletePolicy
+	oldSpec.CredentialsSecretRef = r.Spec.CredentialsSecretRef
+	oldSpec.Export = r.Spec.Export
+	if !reflect.DeepEqual(r.Spec, *oldSpec) {
+		return nil, apierrors.NewBadRequest("IBMPowerVSImage.Spec is immutable except deletePolicy, credentialsSecretRef and export")
+	}
+	// the rest of the spec was validated on creation, and images created before a validation was added must remain
+	// updatable, e.g. for their finalizer to be removed.
+	var allErrs field.ErrorList
+	if !reflect.DeepEqual(r.Spec.CredentialsSecretRef, old.Spec.CredentialsSecretRef) {
+		allErrs = append(allErrs, r.validateIBMPowerVSImageBucketCredentials()...)
+	}
+	if !reflect.DeepEqual(r.Spec.Export, old.Spec.Export) {
+		allErrs = append(allErrs, r.validateIBMPowerVSImageExport()...)
+	}
+	return nil, aggregateObjErrors(schema.GroupKind{Group: "infrastructure.cluster.x-k8s.io", Kind: "IBMPowerVSImage"}, r.Name, allErrs)
 }
 
 // ValidateDelete implements webhook.Validator so a webhook will be registered for the type.
@@ -65,3 +110,202 @@ func (r *IBMPowerVSImage) ValidateDelete() (admission.Warnings, error) {
 	ibmpowervsimagelog.Info("validate delete", "name", r.Name)
 	return nil, nil
 }
+
+func (r *IBMPowerVSImage) validateIBMPowerVSImage() (admission.Warnings, error) {
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, r.validateIBMPowerVSImageServiceInstance()...)
+	allErrs = append(allErrs, r.validateIBMPowerVSImageSource()...)
+	allErrs = append(allErrs, r.validateIBMPowerVSImageStorageType()...)
+	allErrs = append(allErrs, r.validateIBMPowerVSImageExport()...)
+
+	return nil, aggregateObjErrors(schema.GroupKind{Group: "infrastructure.cluster.x-k8s.io", Kind: "IBMPowerVSImage"}, r.Name, allErrs)
+}
+
+func (r *IBMPowerVSImage) validateIBMPowerVSImageSource() field.ErrorList {
+	var allErrs field.ErrorList
+	spec := r.Spec
+	if spec.SourceServiceInstance != nil && spec.SourceImage == nil {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "sourceServiceInstance"), "sourceServiceInstance must not be set when sourceImage is not set"))
+	}
+	if spec.CaptureInstance != nil {
+		return append(allErrs, r.validateIBMPowerVSImageCaptureInstance()...)
+	}
+	if spec.SourceImage == nil {
+		if spec.Bucket == nil {
+			allErrs = append(allErrs, field.Required(field.NewPath("spec", "bucket"), "bucket must be set when sourceImage is not set"))
+		}
+		if spec.Object == nil {
+			allErrs = append(allErrs, field.Required(field.NewPath("spec", "object"), "object must be set when sourceImage is not set"))
+		}
+		if spec.Region == nil {
+			allErrs = append(allErrs, field.Required(field.NewPath("spec", "region"), "region must be set when sourceImage is not set"))
+		}
+		allErrs = append(allErrs, r.validateIBMPowerVSImageBucket()...)
+		return allErrs
+	}
+
+	if spec.SourceImage.ID == nil && spec.SourceImage.Name == nil {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "sourceImage"), spec.SourceImage, "One of - ID or Name must be specified"))
+	}
+	if res, err := validateIBMPowerVSResourceReference(*spec.SourceImage, "sourceImage"); !res {
+		allErrs = append(allErrs, err)
+	}
+	if spec.SourceImage.RegEx != nil {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "sourceImage", "regex"), "regex is not supported for sourceImage"))
+	}
+	if spec.SourceServiceInstance != nil {
+		return append(allErrs, r.validateIBMPowerVSImageSourceServiceInstance()...)
+	}
+	if spec.Bucket != nil || spec.Object != nil || spec.Region != nil || spec.CredentialsSecretRef != nil {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "sourceImage"), "bucket, object, region and credentialsSecretRef must not be set when sourceImage is set"))
+	}
+	return allErrs
+}
+
+// validateIBMPowerVSImageSourceServiceInstance validates the workspace the source image is cloned from, along with the
+// Cloud Object Storage bucket the source image is exported to and imported from.
+func (r *IBMPowerVSImage) validateIBMPowerVSImageSourceServiceInstance() field.ErrorList {
+	var allErrs field.ErrorList
+	spec := r.Spec
+	if spec.SourceServiceInstance.ID == nil {
+		allErrs = append(allErrs, field.Required(field.NewPath("spec", "sourceServiceInstance", "id"), "id of the workspace must be set"))
+	}
+	if spec.SourceServiceInstance.Name != nil || spec.SourceServiceInstance.RegEx != nil {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "sourceServiceInstance"), "only id is supported for sourceServiceInstance"))
+	}
+	if spec.Bucket == nil {
+		allErrs = append(allErrs, field.Required(field.NewPath("spec", "bucket"), "bucket must be set when sourceServiceInstance is set"))
+	}
+	if spec.Region == nil {
+		allErrs = append(allErrs, field.Required(field.NewPath("spec", "region"), "region must be set when sourceServiceInstance is set"))
+	}
+	if spec.Object != nil {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "object"), "object must not be set when sourceServiceInstance is set, the source image is exported as <image name>.ova.gz"))
+	}
+	allErrs = append(allErrs, r.validateIBMPowerVSImageBucket()...)
+	return allErrs
+}
+
+func (r *IBMPowerVSImage) validateIBMPowerVSImageServiceInstance() field.ErrorList {
+	var allErrs field.ErrorList
+	serviceInstance := r.Spec.ServiceInstance
+	if serviceInstance == nil {
+		return nil
+	}
+	if r.Spec.ServiceInstanceID != "" {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "serviceInstance"), "serviceInstanceID and serviceInstance must not be set together"))
+	}
+	if res, err := validateIBMPowerVSResourceReference(*serviceInstance, "serviceInstance"); !res {
+		allErrs = append(allErrs, err)
+	}
+	if serviceInstance.RegEx != nil {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "serviceInstance", "regex"), "regex is not supported for serviceInstance"))
+	}
+	return allErrs
+}
+
+// validateIBMPowerVSImageBucket validates the Cloud Object Storage source of the image, so the mistakes are reported
+// on admission rather than by the import job failing.
+func (r *IBMPowerVSImage) validateIBMPowerVSImageBucket() field.ErrorList {
+	var allErrs field.ErrorList
+	spec := r.Spec
+	if spec.Bucket != nil {
+		if err := validateCOSBucket(field.NewPath("spec", "bucket"), *spec.Bucket); err != nil {
+			allErrs = append(allErrs, err)
+		}
+	}
+	if spec.Object != nil && !hasPowerVSImageObjectSuffix(*spec.Object) {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "object"), *spec.Object, fmt.Sprintf("object must be an image file with one of the %s extensions", strings.Join(powerVSImageObjectSuffixes, ", "))))
+	} else if spec.Object != nil && strings.HasPrefix(*spec.Object, "/") {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "object"), *spec.Object, "object must not start with /, the folder must be set in bucket"))
+	}
+	if spec.Region != nil {
+		if err := validateCOSRegion(field.NewPath("spec", "region"), *spec.Region); err != nil {
+			allErrs = append(allErrs, err)
+		}
+	}
+	allErrs = append(allErrs, r.validateIBMPowerVSImageBucketCredentials()...)
+	return allErrs
+}
+
+func (r *IBMPowerVSImage) validateIBMPowerVSImageBucketCredentials() field.ErrorList {
+	if r.Spec.SourceServiceInstance != nil && r.Spec.CredentialsSecretRef == nil {
+		return field.ErrorList{field.Required(field.NewPath("spec", "credentialsSecretRef"), "credentialsSecretRef must be set when sourceServiceInstance is set")}
+	}
+	if r.Spec.SourceImage == nil && r.Spec.BucketAccess == PowerVSImageBucketAccessPrivate && r.Spec.CredentialsSecretRef == nil {
+		return field.ErrorList{field.Required(field.NewPath("spec", "credentialsSecretRef"), "credentialsSecretRef must be set when bucketAccess is private")}
+	}
+	return nil
+}
+
+func (r *IBMPowerVSImage) validateIBMPowerVSImageCaptureInstance() field.ErrorList {
+	var allErrs field.ErrorList
+	spec := r.Spec
+	if spec.CaptureInstance.ID == nil && spec.CaptureInstance.Name == nil {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "captureInstance"), spec.CaptureInstance, "One of - ID or Name must be specified"))
+	}
+	if res, err := validateIBMPowerVSResourceReference(*spec.CaptureInstance, "captureInstance"); !res {
+		allErrs = append(allErrs, err)
+	}
+	if spec.CaptureInstance.RegEx != nil {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "captureInstance", "regex"), "regex is not supported for captureInstance"))
+	}
+	if spec.SourceImage != nil || spec.Bucket != nil || spec.Object != nil || spec.Region != nil || spec.CredentialsSecretRef != nil {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "captureInstance"), "sourceImage, bucket, object, region and credentialsSecretRef must not be set when captureInstance is set"))
+	}
+	return allErrs
+}
+
+func (r *IBMPowerVSImage) validateIBMPowerVSImageExport() field.ErrorList {
+	var allErrs field.ErrorList
+	export := r.Spec.Export
+	if export == nil {
+		return nil
+	}
+	if err := validateCOSBucket(field.NewPath("spec", "export", "bucket"), export.Bucket); err != nil {
+		allErrs = append(allErrs, err)
+	}
+	if err := validateCOSRegion(field.NewPath("spec", "export", "region"), export.Region); err != nil {
+		allErrs = append(allErrs, err)
+	}
+	if export.CredentialsSecretRef.Name == "" {
+		allErrs = append(allErrs, field.Required(field.NewPath("spec", "export", "credentialsSecretRef", "name"), "credentials of the bucket must be set to export the image"))
+	}
+	return allErrs
+}
+
+func (r *IBMPowerVSImage) validateIBMPowerVSImageStorageType() field.ErrorList {
+	switch r.Spec.StorageType {
+	case "", PowerVSImageStorageTypeTier1, PowerVSImageStorageTypeTier3:
+		return nil
+	}
+	return field.ErrorList{field.NotSupported(field.NewPath("spec", "storageType"), r.Spec.StorageType, []string{PowerVSImageStorageTypeTier1, PowerVSImageStorageTypeTier3})}
+}
+
+func hasPowerVSImageObjectSuffix(object string) bool {
+	for _, suffix := range powerVSImageObjectSuffixes {
+		if strings.HasSuffix(strings.ToLower(object), suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// validateCOSBucket validates the name of a Cloud Object Storage bucket, optionally followed by a folder; bucket-name[/optional/folder].
+func validateCOSBucket(path *field.Path, value string) *field.Error {
+	bucket, folder, _ := strings.Cut(value, "/")
+	if !cosBucketNameRegex.MatchString(bucket) || strings.Contains(bucket, "..") {
+		return field.Invalid(path, value, "bucket must be a valid Cloud Object Storage bucket name, optionally followed by /folder")
+	}
+	if strings.HasSuffix(folder, "/") || strings.Contains(folder, "//") {
+		return field.Invalid(path, value, "folder of the bucket must not contain empty segments")
+	}
+	return nil
+}
+
+func validateCOSRegion(path *field.Path, region string) *field.Error {
+	if !regionUtil.ValidateCOSRegion(region) {
+		return field.Invalid(path, region, fmt.Sprintf("region '%s' is not a supported Cloud Object Storage region", region))
+	}
+	return nil
+}
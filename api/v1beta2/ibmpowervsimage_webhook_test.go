@@ -0,0 +1,430 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestIBMPowerVSImage_Default(t *testing.T) {
+	g := NewWithT(t)
+
+	image := &IBMPowerVSImage{}
+	image.Default()
+	g.Expect(image.Spec.BucketAccess).To(Equal(PowerVSImageBucketAccessPublic))
+	g.Expect(image.Spec.StorageType).To(Equal(PowerVSImageStorageTypeTier1))
+	g.Expect(image.Spec.DeletePolicy).To(Equal(string(DeletePolicyDelete)))
+
+	image = &IBMPowerVSImage{Spec: IBMPowerVSImageSpec{SourceImage: &IBMPowerVSResourceReference{Name: ptr.To("CentOS-Stream-9")}}}
+	image.Default()
+	g.Expect(image.Spec.BucketAccess).To(BeEmpty())
+}
+
+func TestIBMPowerVSImage_ValidateCreate(t *testing.T) {
+	g := NewWithT(t)
+
+	tests := []struct {
+		name    string
+		image   *IBMPowerVSImage
+		wantErr bool
+	}{
+		{
+			name: "IBMPowerVSImage with Cloud Object Storage source",
+			image: &IBMPowerVSImage{
+				Spec: IBMPowerVSImageSpec{
+					Bucket: ptr.To("capi-bucket"),
+					Object: ptr.To("capi-image.ova.gz"),
+					Region: ptr.To("us-south"),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "IBMPowerVSImage without Cloud Object Storage object",
+			image: &IBMPowerVSImage{
+				Spec: IBMPowerVSImageSpec{
+					Bucket: ptr.To("capi-bucket"),
+					Region: ptr.To("us-south"),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "IBMPowerVSImage with source image name",
+			image: &IBMPowerVSImage{
+				Spec: IBMPowerVSImageSpec{
+					SourceImage: &IBMPowerVSResourceReference{Name: ptr.To("CentOS-Stream-9")},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "IBMPowerVSImage with source image without ID and Name",
+			image: &IBMPowerVSImage{
+				Spec: IBMPowerVSImageSpec{
+					SourceImage: &IBMPowerVSResourceReference{},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "IBMPowerVSImage with both source image ID and Name",
+			image: &IBMPowerVSImage{
+				Spec: IBMPowerVSImageSpec{
+					SourceImage: &IBMPowerVSResourceReference{ID: ptr.To("image-id"), Name: ptr.To("CentOS-Stream-9")},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "IBMPowerVSImage with source image of another workspace",
+			image: &IBMPowerVSImage{
+				Spec: IBMPowerVSImageSpec{
+					SourceImage:           &IBMPowerVSResourceReference{Name: ptr.To("capi-image")},
+					SourceServiceInstance: &IBMPowerVSResourceReference{ID: ptr.To("source-workspace-id")},
+					Bucket:                ptr.To("capi-bucket"),
+					Region:                ptr.To("us-south"),
+					CredentialsSecretRef:  &corev1.LocalObjectReference{Name: "capi-bucket-credentials"},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "IBMPowerVSImage with source image of another workspace without credentials",
+			image: &IBMPowerVSImage{
+				Spec: IBMPowerVSImageSpec{
+					SourceImage:           &IBMPowerVSResourceReference{Name: ptr.To("capi-image")},
+					SourceServiceInstance: &IBMPowerVSResourceReference{ID: ptr.To("source-workspace-id")},
+					Bucket:                ptr.To("capi-bucket"),
+					Region:                ptr.To("us-south"),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "IBMPowerVSImage with source image of another workspace and object",
+			image: &IBMPowerVSImage{
+				Spec: IBMPowerVSImageSpec{
+					SourceImage:           &IBMPowerVSResourceReference{Name: ptr.To("capi-image")},
+					SourceServiceInstance: &IBMPowerVSResourceReference{ID: ptr.To("source-workspace-id")},
+					Bucket:                ptr.To("capi-bucket"),
+					Object:                ptr.To("capi-image.ova.gz"),
+					Region:                ptr.To("us-south"),
+					CredentialsSecretRef:  &corev1.LocalObjectReference{Name: "capi-bucket-credentials"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "IBMPowerVSImage with source workspace by name",
+			image: &IBMPowerVSImage{
+				Spec: IBMPowerVSImageSpec{
+					SourceImage:           &IBMPowerVSResourceReference{Name: ptr.To("capi-image")},
+					SourceServiceInstance: &IBMPowerVSResourceReference{Name: ptr.To("source-workspace")},
+					Bucket:                ptr.To("capi-bucket"),
+					Region:                ptr.To("us-south"),
+					CredentialsSecretRef:  &corev1.LocalObjectReference{Name: "capi-bucket-credentials"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "IBMPowerVSImage with source workspace without source image",
+			image: &IBMPowerVSImage{
+				Spec: IBMPowerVSImageSpec{
+					SourceServiceInstance: &IBMPowerVSResourceReference{ID: ptr.To("source-workspace-id")},
+					Bucket:                ptr.To("capi-bucket"),
+					Object:                ptr.To("capi-image.ova.gz"),
+					Region:                ptr.To("us-south"),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "IBMPowerVSImage with Cloud Object Storage folder",
+			image: &IBMPowerVSImage{
+				Spec: IBMPowerVSImageSpec{
+					Bucket: ptr.To("capi-bucket/images/rhcos"),
+					Object: ptr.To("capi-image.tar.gz"),
+					Region: ptr.To("eu-de"),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "IBMPowerVSImage with invalid Cloud Object Storage bucket name",
+			image: &IBMPowerVSImage{
+				Spec: IBMPowerVSImageSpec{
+					Bucket: ptr.To("CAPI_bucket"),
+					Object: ptr.To("capi-image.ova.gz"),
+					Region: ptr.To("us-south"),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "IBMPowerVSImage with empty Cloud Object Storage folder",
+			image: &IBMPowerVSImage{
+				Spec: IBMPowerVSImageSpec{
+					Bucket: ptr.To("capi-bucket/images/"),
+					Object: ptr.To("capi-image.ova.gz"),
+					Region: ptr.To("us-south"),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "IBMPowerVSImage with unsupported image file",
+			image: &IBMPowerVSImage{
+				Spec: IBMPowerVSImageSpec{
+					Bucket: ptr.To("capi-bucket"),
+					Object: ptr.To("capi-image.qcow2"),
+					Region: ptr.To("us-south"),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "IBMPowerVSImage with unsupported Cloud Object Storage region",
+			image: &IBMPowerVSImage{
+				Spec: IBMPowerVSImageSpec{
+					Bucket: ptr.To("capi-bucket"),
+					Object: ptr.To("capi-image.ova.gz"),
+					Region: ptr.To("us-soutth"),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "IBMPowerVSImage with private bucket access without credentials",
+			image: &IBMPowerVSImage{
+				Spec: IBMPowerVSImageSpec{
+					Bucket:       ptr.To("capi-bucket"),
+					Object:       ptr.To("capi-image.ova.gz"),
+					Region:       ptr.To("us-south"),
+					BucketAccess: PowerVSImageBucketAccessPrivate,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "IBMPowerVSImage with unsupported storage type",
+			image: &IBMPowerVSImage{
+				Spec: IBMPowerVSImageSpec{
+					Bucket:      ptr.To("capi-bucket"),
+					Object:      ptr.To("capi-image.ova.gz"),
+					Region:      ptr.To("us-south"),
+					StorageType: "teir1",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "IBMPowerVSImage with both serviceInstanceID and serviceInstance",
+			image: &IBMPowerVSImage{
+				Spec: IBMPowerVSImageSpec{
+					ServiceInstanceID: "capi-si-id",
+					ServiceInstance:   &IBMPowerVSResourceReference{ID: ptr.To("capi-si-id")},
+					SourceImage:       &IBMPowerVSResourceReference{Name: ptr.To("CentOS-Stream-9")},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "IBMPowerVSImage with both source image and Cloud Object Storage source",
+			image: &IBMPowerVSImage{
+				Spec: IBMPowerVSImageSpec{
+					SourceImage: &IBMPowerVSResourceReference{Name: ptr.To("CentOS-Stream-9")},
+					Bucket:      ptr.To("capi-bucket"),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "IBMPowerVSImage capturing an instance",
+			image: &IBMPowerVSImage{
+				Spec: IBMPowerVSImageSpec{
+					CaptureInstance: &IBMPowerVSResourceReference{Name: ptr.To("capi-golden-instance")},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "IBMPowerVSImage capturing an instance without ID and Name",
+			image: &IBMPowerVSImage{
+				Spec: IBMPowerVSImageSpec{
+					CaptureInstance: &IBMPowerVSResourceReference{},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "IBMPowerVSImage with both capture instance and Cloud Object Storage source",
+			image: &IBMPowerVSImage{
+				Spec: IBMPowerVSImageSpec{
+					CaptureInstance: &IBMPowerVSResourceReference{ID: ptr.To("capi-instance-id")},
+					Bucket:          ptr.To("capi-bucket"),
+					Object:          ptr.To("capi-image.ova.gz"),
+					Region:          ptr.To("us-south"),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "IBMPowerVSImage with export",
+			image: &IBMPowerVSImage{
+				Spec: IBMPowerVSImageSpec{
+					SourceImage: &IBMPowerVSResourceReference{Name: ptr.To("CentOS-Stream-9")},
+					Export: &PowerVSImageExport{
+						Bucket:               "capi-export-bucket",
+						Region:               "us-south",
+						CredentialsSecretRef: corev1.LocalObjectReference{Name: "capi-bucket-credentials"},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "IBMPowerVSImage with export to invalid bucket",
+			image: &IBMPowerVSImage{
+				Spec: IBMPowerVSImageSpec{
+					SourceImage: &IBMPowerVSResourceReference{Name: ptr.To("CentOS-Stream-9")},
+					Export: &PowerVSImageExport{
+						Bucket:               "Capi_Bucket",
+						Region:               "us-south",
+						CredentialsSecretRef: corev1.LocalObjectReference{Name: "capi-bucket-credentials"},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "IBMPowerVSImage with export to unsupported region",
+			image: &IBMPowerVSImage{
+				Spec: IBMPowerVSImageSpec{
+					SourceImage: &IBMPowerVSResourceReference{Name: ptr.To("CentOS-Stream-9")},
+					Export: &PowerVSImageExport{
+						Bucket:               "capi-export-bucket",
+						Region:               "moon-1",
+						CredentialsSecretRef: corev1.LocalObjectReference{Name: "capi-bucket-credentials"},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "IBMPowerVSImage with export without credentials",
+			image: &IBMPowerVSImage{
+				Spec: IBMPowerVSImageSpec{
+					SourceImage: &IBMPowerVSResourceReference{Name: ptr.To("CentOS-Stream-9")},
+					Export: &PowerVSImageExport{
+						Bucket: "capi-export-bucket",
+						Region: "us-south",
+					},
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(_ *testing.T) {
+			_, err := test.image.ValidateCreate()
+			if test.wantErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+		})
+	}
+}
+
+func TestIBMPowerVSImage_ValidateUpdate(t *testing.T) {
+	g := NewWithT(t)
+
+	oldImage := &IBMPowerVSImage{
+		Spec: IBMPowerVSImageSpec{
+			ServiceInstanceID: "capi-si-id",
+			Bucket:            ptr.To("capi-bucket"),
+			Object:            ptr.To("capi-image.ova.gz"),
+			Region:            ptr.To("us-south"),
+			BucketAccess:      PowerVSImageBucketAccessPrivate,
+			CredentialsSecretRef: &corev1.LocalObjectReference{
+				Name: "capi-bucket-credentials",
+			},
+			StorageType:  PowerVSImageStorageTypeTier1,
+			DeletePolicy: string(DeletePolicyDelete),
+		},
+	}
+
+	t.Run("Should allow updating the delete policy", func(_ *testing.T) {
+		image := oldImage.DeepCopy()
+		image.Spec.DeletePolicy = string(DeletePolicyRetain)
+		_, err := image.ValidateUpdate(oldImage)
+		g.Expect(err).NotTo(HaveOccurred())
+	})
+
+	t.Run("Should allow rotating the credentials of the bucket", func(_ *testing.T) {
+		image := oldImage.DeepCopy()
+		image.Spec.CredentialsSecretRef = &corev1.LocalObjectReference{Name: "rotated-bucket-credentials"}
+		_, err := image.ValidateUpdate(oldImage)
+		g.Expect(err).NotTo(HaveOccurred())
+	})
+
+	t.Run("Should error when removing the credentials of the private bucket", func(_ *testing.T) {
+		image := oldImage.DeepCopy()
+		image.Spec.CredentialsSecretRef = nil
+		_, err := image.ValidateUpdate(oldImage)
+		g.Expect(err).To(HaveOccurred())
+	})
+
+	t.Run("Should error when updating the serviceInstanceID", func(_ *testing.T) {
+		image := oldImage.DeepCopy()
+		image.Spec.ServiceInstanceID = "other-si-id"
+		_, err := image.ValidateUpdate(oldImage)
+		g.Expect(err).To(HaveOccurred())
+	})
+
+	t.Run("Should error when updating the storage type", func(_ *testing.T) {
+		image := oldImage.DeepCopy()
+		image.Spec.StorageType = PowerVSImageStorageTypeTier3
+		_, err := image.ValidateUpdate(oldImage)
+		g.Expect(err).To(HaveOccurred())
+	})
+
+	t.Run("Should allow adding an export", func(_ *testing.T) {
+		image := oldImage.DeepCopy()
+		image.Spec.Export = &PowerVSImageExport{
+			Bucket:               "capi-export-bucket",
+			Region:               "us-south",
+			CredentialsSecretRef: corev1.LocalObjectReference{Name: "capi-bucket-credentials"},
+		}
+		_, err := image.ValidateUpdate(oldImage)
+		g.Expect(err).NotTo(HaveOccurred())
+	})
+
+	t.Run("Should error when adding an invalid export", func(_ *testing.T) {
+		image := oldImage.DeepCopy()
+		image.Spec.Export = &PowerVSImageExport{Bucket: "capi-export-bucket", Region: "moon-1"}
+		_, err := image.ValidateUpdate(oldImage)
+		g.Expect(err).To(HaveOccurred())
+	})
+}
@@ -63,6 +63,7 @@ type IBMPowerVSMachineSpec struct {
 	ServiceInstance *IBMPowerVSResourceReference `json:"serviceInstance,omitempty"`
 
 	// SSHKey is the name of the SSH key pair provided to the vsi for authenticating users.
+	// when omitted, the sshKey of the IBMPowerVSCluster is used when set.
 	SSHKey string `json:"sshKey,omitempty"`
 
 	// Image the reference to the image which is used to create the instance.
@@ -129,9 +130,170 @@ type IBMPowerVSMachineSpec struct {
 	// supported network identifier in IBMPowerVSResourceReference are Name, ID and RegEx and that can be obtained from IBM Cloud UI or IBM Cloud cli.
 	Network IBMPowerVSResourceReference `json:"network"`
 
+	// networkRef is an optional reference to an IBMPowerVSNetwork in the same namespace, whose network is used for this instance.
+	// when set, Network must not be set.
+	// +optional
+	NetworkRef *corev1.LocalObjectReference `json:"networkRef,omitempty"`
+
+	// networkIPAddress is the static IP address assigned to the instance on the network of Network or NetworkRef.
+	// when omitted, the address is assigned by the network.
+	// +kubebuilder:validation:Format=ipv4
+	// +optional
+	NetworkIPAddress string `json:"networkIPAddress,omitempty"`
+
+	// additionalNetworks is the list of networks attached to the instance in addition to the network of Network or NetworkRef,
+	// like separate management and data plane networks. the interfaces are attached in the order of the list after the interface
+	// of the primary network.
+	// +kubebuilder:validation:MaxItems=7
+	// +optional
+	AdditionalNetworks []PowerVSNetworkAttachment `json:"additionalNetworks,omitempty"`
+
 	// ProviderID is the unique identifier as specified by the cloud provider.
 	// +optional
 	ProviderID *string `json:"providerID,omitempty"`
+
+	// ntpServers is the list of NTP servers the clock of the instance is synchronized with.
+	// when set, chrony is configured with the servers via the cloud-init user data of the instance, this helps to avoid
+	// the TLS failures caused by clock skew while the machine joins the cluster.
+	// the field is ignored when the bootstrap data already configures NTP or is in Ignition format.
+	// +optional
+	NTPServers []string `json:"ntpServers,omitempty"`
+
+	// sharedProcessorPool is the reference to the shared processor pool the instance is created in.
+	// supported sharedProcessorPool identifier in IBMPowerVSResourceReference are Name and ID.
+	// the instance must use the Shared or Capped processorType.
+	// +optional
+	SharedProcessorPool *IBMPowerVSResourceReference `json:"sharedProcessorPool,omitempty"`
+
+	// placementGroup is the reference to the server placement group the instance joins when it is created.
+	// the affinity or anti-affinity policy of the placement group places its instances on the same or on different hosts,
+	// for example an anti-affinity placement group keeps the control plane instances on separate hosts.
+	// supported placementGroup identifier in IBMPowerVSResourceReference are Name and ID.
+	// placementGroup can't be set along with sharedProcessorPool.
+	// +optional
+	PlacementGroup *IBMPowerVSResourceReference `json:"placementGroup,omitempty"`
+
+	// autoRepairPolicy defines the action taken when the instance is in the ERROR state, or unhealthy for longer than the unhealthy timeout of a health check failing unhealthy machines.
+	// with Replace, the Machine is deleted, so its MachineSet or MachinePool replaces it without requiring a MachineHealthCheck.
+	// control plane Machines and Machines not owned by a MachineSet or a MachinePool are never replaced.
+	// +kubebuilder:validation:Enum=None;Replace
+	// +kubebuilder:default=None
+	// +optional
+	AutoRepairPolicy MachineAutoRepairPolicy `json:"autoRepairPolicy,omitempty"`
+
+	// healthCheck configures the health check of the instance, which reports the state and the health status of the instance
+	// in the InstanceHealthy condition of the machine and may fail the machine when the instance stays unhealthy.
+	// the instance is not checked when healthCheck is not set.
+	// +optional
+	HealthCheck *PowerVSInstanceHealthCheck `json:"healthCheck,omitempty"`
+
+	// shutdownTimeout is the time to wait for the operating system of the instance to shut down gracefully
+	// when the machine is deleted, before the data volumes are detached and the instance is deleted.
+	// the instance is deleted without waiting any longer once the timeout elapsed.
+	// the instance is deleted without shutting it down when the timeout is not set or 0s.
+	// +optional
+	ShutdownTimeout *metav1.Duration `json:"shutdownTimeout,omitempty"`
+
+	// additionalVolumes is the list of data volumes created along with the instance and attached to it from its first boot.
+	// the volumes are deleted along with the instance unless deleteVolumeOnInstanceDelete is set to false.
+	// +kubebuilder:validation:MaxItems=16
+	// +optional
+	AdditionalVolumes []*PowerVSVolume `json:"additionalVolumes,omitempty"`
+
+	// userTags are the user tags attached to the instance and the data volumes of the machine when they are created, e.g. to tell apart
+	// the instances of the GPU or storage worker pools. they are attached along with the tags managed by the controller.
+	// +kubebuilder:validation:items:MinLength=1
+	// +kubebuilder:validation:items:MaxLength=128
+	// +listType=set
+	// +optional
+	UserTags []string `json:"userTags,omitempty"`
+
+	// nodeLabels are the labels the node of the machine registers with, set with the --node-labels flag of the kubelet.
+	// the labels are only injected into cloud-config bootstrap data, they are merged with the labels set by the bootstrap provider
+	// and override the values of the same keys. the kubelet refuses labels in the kubernetes.io and k8s.io namespaces,
+	// except for the kubelet.kubernetes.io and node.kubernetes.io ones.
+	// +optional
+	NodeLabels map[string]string `json:"nodeLabels,omitempty"`
+
+	// nodeTaints are the taints the node of the machine registers with, set with the --register-with-taints flag of the kubelet.
+	// the taints are only injected into cloud-config bootstrap data, they replace the taints set by the bootstrap provider.
+	// +optional
+	NodeTaints []corev1.Taint `json:"nodeTaints,omitempty"`
+
+	// reportBootstrapResult wraps cloud-config bootstrap data into a multipart MIME message along with a boothook which logs the
+	// result of the bootstrap to the console and to the cloud-init output of the instance once cloud-init finished, for the
+	// operators inspecting the instance as the result is not collected by the controller. The bootstrap data is left unchanged
+	// when it would exceed the user data limit of the instance.
+	// +optional
+	ReportBootstrapResult bool `json:"reportBootstrapResult,omitempty"`
+}
+
+// PowerVSVolume defines a data volume of the instance.
+type PowerVSVolume struct {
+	// name of the volume, it is prefixed with the names of the cluster and of the machine to keep the volumes of the machines
+	// created from the same template, and of the clusters sharing the workspace, unique. defaults to <cluster name>-<machine name>-data-<index>.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// sizeGiB is the size of the volume in GiB.
+	// +kubebuilder:validation:Minimum=1
+	SizeGiB int64 `json:"sizeGiB"`
+
+	// tier is the storage tier of the volume.
+	// when not set, the default storage tier of the workspace is used.
+	// +kubebuilder:validation:Enum=tier0;tier1;tier3;tier5k
+	// +optional
+	Tier string `json:"tier,omitempty"`
+
+	// deleteVolumeOnInstanceDelete defines whether the volume is deleted when the machine is deleted.
+	// defaults to true.
+	// +optional
+	DeleteVolumeOnInstanceDelete *bool `json:"deleteVolumeOnInstanceDelete,omitempty"`
+}
+
+// PowerVSNetworkAttachment defines an additional network attached to the instance.
+type PowerVSNetworkAttachment struct {
+	// network is the reference to the network to attach.
+	// supported network identifier in IBMPowerVSResourceReference are Name, ID and RegEx.
+	Network IBMPowerVSResourceReference `json:"network"`
+
+	// ipAddress is the static IP address assigned to the instance on the network.
+	// when omitted, the address is assigned by the network.
+	// +kubebuilder:validation:Format=ipv4
+	// +optional
+	IPAddress string `json:"ipAddress,omitempty"`
+}
+
+// PowerVSMachineVolumeStatus is a data volume created for the instance.
+type PowerVSMachineVolumeStatus struct {
+	// name of the volume in the Power VS workspace.
+	Name string `json:"name"`
+
+	// volumeID is the ID of the volume in the Power VS workspace.
+	VolumeID string `json:"volumeID"`
+}
+
+// PowerVSMachineNetworkStatus is the state of a network attached to the instance.
+type PowerVSMachineNetworkStatus struct {
+	// networkID is the ID of the network.
+	NetworkID string `json:"networkID"`
+
+	// networkName is the name of the network.
+	// +optional
+	NetworkName string `json:"networkName,omitempty"`
+
+	// ipAddress is the IP address of the instance on the network.
+	// +optional
+	IPAddress string `json:"ipAddress,omitempty"`
+
+	// externalIP is the external IP address of the instance on the network.
+	// +optional
+	ExternalIP string `json:"externalIP,omitempty"`
+
+	// macAddress is the MAC address of the interface of the instance on the network.
+	// +optional
+	MACAddress string `json:"macAddress,omitempty"`
 }
 
 // IBMPowerVSResourceReference is a reference to a specific PowerVS resource by ID, Name or RegEx
@@ -228,6 +390,22 @@ type IBMPowerVSMachineStatus struct {
 
 	// Zone specifies the Power VS Service instance zone.
 	Zone *string `json:"zone,omitempty"`
+
+	// networks are the networks attached to the instance along with the addresses of the instance on each network,
+	// in the order of the interfaces of the instance.
+	// +optional
+	Networks []PowerVSMachineNetworkStatus `json:"networks,omitempty"`
+
+	// dataVolumes are the additional data volumes created for the instance, which are looked up by their ID.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	DataVolumes []PowerVSMachineVolumeStatus `json:"dataVolumes,omitempty"`
+
+	// actionHistory records the last actions performed by the controller against the instance, oldest first.
+	// +kubebuilder:validation:MaxItems=10
+	// +optional
+	ActionHistory []MachineAction `json:"actionHistory,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -83,6 +83,12 @@ func (r *IBMPowerVSMachine) validateIBMPowerVSMachine() (admission.Warnings, err
 	if err := r.validateIBMPowerVSMachineProcessors(); err != nil {
 		allErrs = append(allErrs, err)
 	}
+	allErrs = append(allErrs, validateIBMPowerVSPlacement(r.Spec)...)
+	allErrs = append(allErrs, validateIBMPowerVSAdditionalVolumes(r.Spec)...)
+	allErrs = append(allErrs, validateIBMPowerVSAdditionalNetworks(r.Spec)...)
+	allErrs = append(allErrs, validateNodeRegistration(r.Spec.NodeLabels, r.Spec.NodeTaints)...)
+	allErrs = append(allErrs, validateInstanceHealthCheck(r.Spec.HealthCheck)...)
+	allErrs = append(allErrs, validateShutdownTimeout(r.Spec.ShutdownTimeout)...)
 	if len(allErrs) == 0 {
 		return nil, nil
 	}
@@ -96,6 +102,9 @@ func (r *IBMPowerVSMachine) validateIBMPowerVSMachineNetwork() *field.Error {
 	if res, err := validateIBMPowerVSNetworkReference(r.Spec.Network); !res {
 		return err
 	}
+	if r.Spec.NetworkRef != nil && (r.Spec.Network.ID != nil || r.Spec.Network.Name != nil || r.Spec.Network.RegEx != nil) {
+		return field.Forbidden(field.NewPath("spec", "networkRef"), "network must not be set when networkRef is set")
+	}
 	return nil
 }
 
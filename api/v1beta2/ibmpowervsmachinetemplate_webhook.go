@@ -83,6 +83,12 @@ func (r *IBMPowerVSMachineTemplate) validateIBMPowerVSMachineTemplate() (admissi
 	if err := r.validateIBMPowerVSMachineTemplateProcessors(); err != nil {
 		allErrs = append(allErrs, err)
 	}
+	allErrs = append(allErrs, validateIBMPowerVSPlacement(r.Spec.Template.Spec)...)
+	allErrs = append(allErrs, validateIBMPowerVSAdditionalVolumes(r.Spec.Template.Spec)...)
+	allErrs = append(allErrs, validateIBMPowerVSAdditionalNetworks(r.Spec.Template.Spec)...)
+	allErrs = append(allErrs, validateNodeRegistration(r.Spec.Template.Spec.NodeLabels, r.Spec.Template.Spec.NodeTaints)...)
+	allErrs = append(allErrs, validateInstanceHealthCheck(r.Spec.Template.Spec.HealthCheck)...)
+	allErrs = append(allErrs, validateShutdownTimeout(r.Spec.Template.Spec.ShutdownTimeout)...)
 	if len(allErrs) == 0 {
 		return nil, nil
 	}
@@ -96,6 +102,9 @@ func (r *IBMPowerVSMachineTemplate) validateIBMPowerVSMachineTemplateNetwork() *
 	if res, err := validateIBMPowerVSNetworkReference(r.Spec.Template.Spec.Network); !res {
 		return err
 	}
+	if r.Spec.Template.Spec.NetworkRef != nil && (r.Spec.Template.Spec.Network.ID != nil || r.Spec.Template.Spec.Network.Name != nil || r.Spec.Template.Spec.Network.RegEx != nil) {
+		return field.Forbidden(field.NewPath("spec", "template", "spec", "networkRef"), "network must not be set when networkRef is set")
+	}
 	return nil
 }
 
@@ -0,0 +1,160 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	capiv1beta1 "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+const (
+	// IBMPowerVSNetworkFinalizer allows IBMPowerVSNetworkReconciler to clean up resources associated with IBMPowerVSNetwork before
+	// removing it from the apiserver.
+	IBMPowerVSNetworkFinalizer = "ibmpowervsnetwork.infrastructure.cluster.x-k8s.io"
+)
+
+// IBMPowerVSNetworkSpec defines the desired state of IBMPowerVSNetwork.
+type IBMPowerVSNetworkSpec struct {
+	// serviceInstance is the reference to the Power VS workspace in which the network will be created.
+	// supported serviceInstance identifier in PowerVSResource are Name and ID and that can be obtained from IBM Cloud UI or IBM Cloud cli.
+	// ServiceInstance.RegEx is not supported.
+	ServiceInstance IBMPowerVSResourceReference `json:"serviceInstance"`
+
+	// zone is the name of Power VS zone where the workspace exists.
+	// it is required when ServiceInstance.Name is set, to look up the workspace.
+	// +optional
+	Zone *string `json:"zone,omitempty"`
+
+	// name of the network in the Power VS workspace.
+	// when omitted, the name of the IBMPowerVSNetwork is used.
+	// when a network with the name already exists in the workspace, it is used as is and is not deleted along with the IBMPowerVSNetwork.
+	// +optional
+	Name *string `json:"name,omitempty"`
+
+	// type of the network, vlan for a private network and pub-vlan for a public network.
+	// +kubebuilder:default=vlan
+	// +kubebuilder:validation:Enum=vlan;pub-vlan
+	// +optional
+	Type PowerVSNetworkType `json:"type,omitempty"`
+
+	// cidr of the network in CIDR notation, for example 192.168.0.0/24.
+	// it is required for a vlan network when DHCPServer is not set.
+	// +optional
+	CIDR *string `json:"cidr,omitempty"`
+
+	// gateway is the gateway IP address of the network.
+	// when omitted, the first IP address of the CIDR is used.
+	// +optional
+	Gateway *string `json:"gateway,omitempty"`
+
+	// dnsServers is the list of DNS servers of the network.
+	// when omitted, 127.0.0.1 is used for a vlan network and 9.9.9.9 for a pub-vlan network.
+	// +optional
+	DNSServers []string `json:"dnsServers,omitempty"`
+
+	// dhcpServer contains the configuration of the DHCP server to be created in the Power VS workspace.
+	// when set, the network is created along with the DHCP server with name DHCPSERVER<DHCPServer.Name>_Private,
+	// DHCPServer.Name defaults to Name, and CIDR, Gateway and DNSServers must not be set.
+	// when DHCPServer.ID is set, the network of the existing DHCP server is used.
+	// +optional
+	DHCPServer *DHCPServer `json:"dhcpServer,omitempty"`
+
+	// deletePolicy defines the policy used to identify networks to be preserved beyond the lifecycle of the IBMPowerVSNetwork.
+	// the network is deleted only when it is created by the controller and the policy is set to delete.
+	// +kubebuilder:default=delete
+	// +kubebuilder:validation:Enum=delete;retain
+	// +optional
+	DeletePolicy string `json:"deletePolicy,omitempty"`
+
+	// credentialsSecretRef is the reference to the secret containing the IBM Cloud API key used to manage the network,
+	// it is expected to be the credentials secret of the clusters using the network.
+	// the secret must exist in the same namespace as the IBMPowerVSNetwork and contain the apiKey key.
+	// when omitted, the API key configured for the controller is used.
+	// +optional
+	CredentialsSecretRef *corev1.LocalObjectReference `json:"credentialsSecretRef,omitempty"`
+
+	// enterpriseAccount is the child account of the IBM Cloud enterprise the network is provisioned into,
+	// it is expected to be the child account of the clusters using the network.
+	// +optional
+	EnterpriseAccount *EnterpriseAccount `json:"enterpriseAccount,omitempty"`
+}
+
+// IBMPowerVSNetworkStatus defines the observed state of IBMPowerVSNetwork.
+type IBMPowerVSNetworkStatus struct {
+	// ready is true when the network is available to be used by clusters and machines.
+	// +optional
+	Ready bool `json:"ready"`
+
+	// networkID is the id of the network in the Power VS workspace.
+	// +optional
+	NetworkID string `json:"networkID,omitempty"`
+
+	// dhcpServerID is the id of the DHCP server of the network.
+	// +optional
+	DHCPServerID string `json:"dhcpServerID,omitempty"`
+
+	// controllerCreated indicates whether the network is created by the controller.
+	// +optional
+	ControllerCreated *bool `json:"controllerCreated,omitempty"`
+
+	// conditions defines current service state of the IBMPowerVSNetwork.
+	// +optional
+	Conditions capiv1beta1.Conditions `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=ibmpowervsnetworks,scope=Namespaced,categories=cluster-api
+// +kubebuilder:metadata:labels="clusterctl.cluster.x-k8s.io/move="
+// +kubebuilder:printcolumn:name="Type",type="string",JSONPath=".spec.type",description="PowerVS network type"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.ready",description="Network is ready for IBM PowerVS clusters and instances"
+// +kubebuilder:printcolumn:name="Network ID",type="string",priority=1,JSONPath=".status.networkID",description="PowerVS network ID"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="Time duration since creation of IBMPowerVSNetwork"
+
+// IBMPowerVSNetwork is the Schema for the ibmpowervsnetworks API.
+type IBMPowerVSNetwork struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   IBMPowerVSNetworkSpec   `json:"spec,omitempty"`
+	Status IBMPowerVSNetworkStatus `json:"status,omitempty"`
+}
+
+// GetConditions returns the observations of the operational state of the IBMPowerVSNetwork resource.
+func (r *IBMPowerVSNetwork) GetConditions() capiv1beta1.Conditions {
+	return r.Status.Conditions
+}
+
+// SetConditions sets the underlying service state of the IBMPowerVSNetwork to the predescribed clusterv1.Conditions.
+func (r *IBMPowerVSNetwork) SetConditions(conditions capiv1beta1.Conditions) {
+	r.Status.Conditions = conditions
+}
+
+// +kubebuilder:object:root=true
+
+// IBMPowerVSNetworkList contains a list of IBMPowerVSNetwork.
+type IBMPowerVSNetworkList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []IBMPowerVSNetwork `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&IBMPowerVSNetwork{}, &IBMPowerVSNetworkList{})
+}
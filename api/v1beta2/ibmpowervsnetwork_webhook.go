@@ -0,0 +1,150 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	"fmt"
+	"net"
+	"reflect"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// log is for logging in this package.
+var ibmpowervsnetworklog = logf.Log.WithName("ibmpowervsnetwork-resource")
+
+func (r *IBMPowerVSNetwork) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/mutate-infrastructure-cluster-x-k8s-io-v1beta2-ibmpowervsnetwork,mutating=true,failurePolicy=fail,groups=infrastructure.cluster.x-k8s.io,resources=ibmpowervsnetworks,verbs=create;update,versions=v1beta2,name=mibmpowervsnetwork.kb.io,sideEffects=None,admissionReviewVersions=v1;v1beta1
+
+var _ webhook.Defaulter = &IBMPowerVSNetwork{}
+
+// Default implements webhook.Defaulter so a webhook will be registered for the type.
+func (r *IBMPowerVSNetwork) Default() {
+	ibmpowervsnetworklog.Info("default", "name", r.Name)
+	if r.Spec.Type == "" {
+		r.Spec.Type = PowerVSNetworkTypeVLAN
+	}
+	if r.Spec.DeletePolicy == "" {
+		r.Spec.DeletePolicy = string(DeletePolicyDelete)
+	}
+}
+
+//+kubebuilder:webhook:verbs=create;update,path=/validate-infrastructure-cluster-x-k8s-io-v1beta2-ibmpowervsnetwork,mutating=false,failurePolicy=fail,groups=infrastructure.cluster.x-k8s.io,resources=ibmpowervsnetworks,versions=v1beta2,name=vibmpowervsnetwork.kb.io,sideEffects=None,admissionReviewVersions=v1;v1beta1
+
+var _ webhook.Validator = &IBMPowerVSNetwork{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type.
+func (r *IBMPowerVSNetwork) ValidateCreate() (admission.Warnings, error) {
+	ibmpowervsnetworklog.Info("validate create", "name", r.Name)
+	return r.validateIBMPowerVSNetwork()
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type.
+func (r *IBMPowerVSNetwork) ValidateUpdate(oldRaw runtime.Object) (admission.Warnings, error) {
+	ibmpowervsnetworklog.Info("validate update", "name", r.Name)
+	old, ok := oldRaw.(*IBMPowerVSNetwork)
+	if !ok {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("expected an IBMPowerVSNetwork but got a %T", oldRaw))
+	}
+	// only the delete policy and the credentials secret can be changed once the network is created.
+	oldSpec := old.Spec.DeepCopy()
+	oldSpec.DeletePolicy = r.Spec.DeletePolicy
+	oldSpec.CredentialsSecretRef = r.Spec.CredentialsSecretRef
+	if !reflect.DeepEqual(r.Spec, *oldSpec) {
+		return nil, apierrors.NewBadRequest("IBMPowerVSNetwork.Spec is immutable except deletePolicy and credentialsSecretRef")
+	}
+	return r.validateIBMPowerVSNetwork()
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type.
+func (r *IBMPowerVSNetwork) ValidateDelete() (admission.Warnings, error) {
+	ibmpowervsnetworklog.Info("validate delete", "name", r.Name)
+	return nil, nil
+}
+
+func (r *IBMPowerVSNetwork) validateIBMPowerVSNetwork() (admission.Warnings, error) {
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, r.validateIBMPowerVSNetworkServiceInstance()...)
+	allErrs = append(allErrs, r.validateIBMPowerVSNetworkSettings()...)
+	if err := validateEnterpriseAccount(field.NewPath("spec", "enterpriseAccount"), r.Spec.EnterpriseAccount); err != nil {
+		allErrs = append(allErrs, err)
+	}
+
+	return nil, aggregateObjErrors(schema.GroupKind{Group: "infrastructure.cluster.x-k8s.io", Kind: "IBMPowerVSNetwork"}, r.Name, allErrs)
+}
+
+func (r *IBMPowerVSNetwork) validateIBMPowerVSNetworkServiceInstance() field.ErrorList {
+	var allErrs field.ErrorList
+	serviceInstance := r.Spec.ServiceInstance
+	if serviceInstance.ID == nil && serviceInstance.Name == nil {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "serviceInstance"), serviceInstance, "One of - ID or Name must be specified"))
+	}
+	if res, err := validateIBMPowerVSResourceReference(serviceInstance, "serviceInstance"); !res {
+		allErrs = append(allErrs, err)
+	}
+	if serviceInstance.RegEx != nil {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "serviceInstance", "regex"), "regex is not supported for serviceInstance"))
+	}
+	if serviceInstance.Name != nil && r.Spec.Zone == nil {
+		allErrs = append(allErrs, field.Required(field.NewPath("spec", "zone"), "zone must be set when serviceInstance.name is set"))
+	}
+	return allErrs
+}
+
+func (r *IBMPowerVSNetwork) validateIBMPowerVSNetworkSettings() field.ErrorList {
+	var allErrs field.ErrorList
+	spec := r.Spec
+	if spec.DHCPServer != nil {
+		if spec.CIDR != nil || spec.Gateway != nil || len(spec.DNSServers) != 0 {
+			allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "dhcpServer"), "cidr, gateway and dnsServers must not be set when dhcpServer is set"))
+		}
+		if spec.Type == PowerVSNetworkTypePublicVLAN {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "type"), spec.Type, "type must be vlan when dhcpServer is set"))
+		}
+		return allErrs
+	}
+
+	if spec.CIDR == nil {
+		if spec.Type != PowerVSNetworkTypePublicVLAN {
+			allErrs = append(allErrs, field.Required(field.NewPath("spec", "cidr"), "cidr must be set for a vlan network when dhcpServer is not set"))
+		}
+	} else if _, _, err := net.ParseCIDR(*spec.CIDR); err != nil {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "cidr"), spec.CIDR, "cidr must be a valid CIDR notation"))
+	}
+	if spec.Gateway != nil && net.ParseIP(*spec.Gateway) == nil {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "gateway"), spec.Gateway, "gateway must be a valid IP address"))
+	}
+	for i, dnsServer := range spec.DNSServers {
+		if net.ParseIP(dnsServer) == nil {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "dnsServers").Index(i), dnsServer, "DNS server must be a valid IP address"))
+		}
+	}
+	return allErrs
+}
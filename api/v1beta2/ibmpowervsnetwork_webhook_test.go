@@ -0,0 +1,174 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestIBMPowerVSNetwork_Default(t *testing.T) {
+	g := NewWithT(t)
+
+	network := &IBMPowerVSNetwork{}
+	network.Default()
+	g.Expect(network.Spec.Type).To(Equal(PowerVSNetworkTypeVLAN))
+	g.Expect(network.Spec.DeletePolicy).To(Equal(string(DeletePolicyDelete)))
+}
+
+func TestIBMPowerVSNetwork_ValidateCreate(t *testing.T) {
+	g := NewWithT(t)
+
+	tests := []struct {
+		name    string
+		network *IBMPowerVSNetwork
+		wantErr bool
+	}{
+		{
+			name: "IBMPowerVSNetwork with CIDR",
+			network: &IBMPowerVSNetwork{
+				Spec: IBMPowerVSNetworkSpec{
+					ServiceInstance: IBMPowerVSResourceReference{ID: ptr.To("capi-si-id")},
+					Type:            PowerVSNetworkTypeVLAN,
+					CIDR:            ptr.To("192.168.0.0/24"),
+					Gateway:         ptr.To("192.168.0.1"),
+					DNSServers:      []string{"9.9.9.9"},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "IBMPowerVSNetwork with DHCP server",
+			network: &IBMPowerVSNetwork{
+				Spec: IBMPowerVSNetworkSpec{
+					ServiceInstance: IBMPowerVSResourceReference{ID: ptr.To("capi-si-id")},
+					Type:            PowerVSNetworkTypeVLAN,
+					DHCPServer:      &DHCPServer{Name: ptr.To("capi-dhcp")},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "IBMPowerVSNetwork of type pub-vlan without CIDR",
+			network: &IBMPowerVSNetwork{
+				Spec: IBMPowerVSNetworkSpec{
+					ServiceInstance: IBMPowerVSResourceReference{Name: ptr.To("capi-si")},
+					Zone:            ptr.To("osa21"),
+					Type:            PowerVSNetworkTypePublicVLAN,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "IBMPowerVSNetwork without service instance ID and Name",
+			network: &IBMPowerVSNetwork{
+				Spec: IBMPowerVSNetworkSpec{
+					Type: PowerVSNetworkTypeVLAN,
+					CIDR: ptr.To("192.168.0.0/24"),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "IBMPowerVSNetwork with service instance Name without zone",
+			network: &IBMPowerVSNetwork{
+				Spec: IBMPowerVSNetworkSpec{
+					ServiceInstance: IBMPowerVSResourceReference{Name: ptr.To("capi-si")},
+					Type:            PowerVSNetworkTypeVLAN,
+					CIDR:            ptr.To("192.168.0.0/24"),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "IBMPowerVSNetwork of type vlan without CIDR and DHCP server",
+			network: &IBMPowerVSNetwork{
+				Spec: IBMPowerVSNetworkSpec{
+					ServiceInstance: IBMPowerVSResourceReference{ID: ptr.To("capi-si-id")},
+					Type:            PowerVSNetworkTypeVLAN,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "IBMPowerVSNetwork with invalid CIDR and gateway",
+			network: &IBMPowerVSNetwork{
+				Spec: IBMPowerVSNetworkSpec{
+					ServiceInstance: IBMPowerVSResourceReference{ID: ptr.To("capi-si-id")},
+					Type:            PowerVSNetworkTypeVLAN,
+					CIDR:            ptr.To("192.168.0.0"),
+					Gateway:         ptr.To("gateway"),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "IBMPowerVSNetwork with both DHCP server and CIDR",
+			network: &IBMPowerVSNetwork{
+				Spec: IBMPowerVSNetworkSpec{
+					ServiceInstance: IBMPowerVSResourceReference{ID: ptr.To("capi-si-id")},
+					Type:            PowerVSNetworkTypeVLAN,
+					CIDR:            ptr.To("192.168.0.0/24"),
+					DHCPServer:      &DHCPServer{},
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(_ *testing.T) {
+			_, err := test.network.ValidateCreate()
+			if test.wantErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+		})
+	}
+}
+
+func TestIBMPowerVSNetwork_ValidateUpdate(t *testing.T) {
+	g := NewWithT(t)
+
+	oldNetwork := &IBMPowerVSNetwork{
+		Spec: IBMPowerVSNetworkSpec{
+			ServiceInstance: IBMPowerVSResourceReference{ID: ptr.To("capi-si-id")},
+			Type:            PowerVSNetworkTypeVLAN,
+			CIDR:            ptr.To("192.168.0.0/24"),
+			DeletePolicy:    string(DeletePolicyDelete),
+		},
+	}
+
+	t.Run("Should allow updating the delete policy and credentials secret", func(_ *testing.T) {
+		network := oldNetwork.DeepCopy()
+		network.Spec.DeletePolicy = string(DeletePolicyRetain)
+		network.Spec.CredentialsSecretRef = &corev1.LocalObjectReference{Name: "capi-credentials"}
+		_, err := network.ValidateUpdate(oldNetwork)
+		g.Expect(err).NotTo(HaveOccurred())
+	})
+
+	t.Run("Should error when updating the CIDR", func(_ *testing.T) {
+		network := oldNetwork.DeepCopy()
+		network.Spec.CIDR = ptr.To("192.168.1.0/24")
+		_, err := network.ValidateUpdate(oldNetwork)
+		g.Expect(err).To(HaveOccurred())
+	})
+}
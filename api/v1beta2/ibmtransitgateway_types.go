@@ -0,0 +1,176 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	capiv1beta1 "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+const (
+	// IBMTransitGatewayFinalizer allows IBMTransitGatewayReconciler to clean up resources associated with IBMTransitGateway before
+	// removing it from the apiserver.
+	IBMTransitGatewayFinalizer = "ibmtransitgateway.infrastructure.cluster.x-k8s.io"
+)
+
+// IBMTransitGatewaySpec defines the desired state of IBMTransitGateway.
+type IBMTransitGatewaySpec struct {
+	// name of the transit gateway.
+	// when omitted, the name of the IBMTransitGateway is used.
+	// when a transit gateway with the name already exists, it is used as is and is not deleted along with the IBMTransitGateway.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength:=63
+	// +kubebuilder:validation:Pattern=`^([a-zA-Z]|[a-zA-Z][-_a-zA-Z0-9]*[a-zA-Z0-9])$`
+	// +optional
+	Name *string `json:"name,omitempty"`
+
+	// id of an existing transit gateway.
+	// when set, the transit gateway is used as is and is not deleted along with the IBMTransitGateway.
+	// +optional
+	ID *string `json:"id,omitempty"`
+
+	// location is the IBM Cloud region where the transit gateway is created, for example us-south.
+	// it is required when ID is not set.
+	// +optional
+	Location string `json:"location,omitempty"`
+
+	// globalRouting indicates whether to set global routing true or not while creating the transit gateway.
+	// set this field to true only when the connected networks are from different regions.
+	// +kubebuilder:default=false
+	// +optional
+	GlobalRouting *bool `json:"globalRouting,omitempty"`
+
+	// resourceGroup is the resource group in which the transit gateway is created.
+	// supported resourceGroup identifier in PowerVSResource are Name and ID, ResourceGroup.RegEx is not supported.
+	// it is required when ID is not set.
+	// +optional
+	ResourceGroup *IBMPowerVSResourceReference `json:"resourceGroup,omitempty"`
+
+	// connections is the list of connections to be attached to the transit gateway in addition to the ones of the referencing clusters.
+	// connections missing in the transit gateway are created, and connections created by the controller are deleted once removed from the list.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Connections []TransitGatewayConnection `json:"connections,omitempty"`
+
+	// deletePolicy defines the policy used to identify transit gateways to be preserved beyond the lifecycle of the IBMTransitGateway.
+	// the transit gateway is deleted only when it is created by the controller, no cluster references it and the policy is set to delete.
+	// +kubebuilder:default=delete
+	// +kubebuilder:validation:Enum=delete;retain
+	// +optional
+	DeletePolicy string `json:"deletePolicy,omitempty"`
+
+	// credentialsSecretRef is the reference to the secret containing the IBM Cloud API key used to manage the transit gateway,
+	// it is expected to be the credentials secret of the clusters using the transit gateway.
+	// the secret must exist in the same namespace as the IBMTransitGateway and contain the apiKey key.
+	// when omitted, the API key configured for the controller is used.
+	// +optional
+	CredentialsSecretRef *corev1.LocalObjectReference `json:"credentialsSecretRef,omitempty"`
+
+	// enterpriseAccount is the child account of the IBM Cloud enterprise the transit gateway is provisioned into,
+	// it is expected to be the child account of the clusters attached to the transit gateway.
+	// +optional
+	EnterpriseAccount *EnterpriseAccount `json:"enterpriseAccount,omitempty"`
+}
+
+// TransitGatewayConnection defines a connection to be attached to the transit gateway.
+type TransitGatewayConnection struct {
+	// name of the connection.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength:=63
+	// +kubebuilder:validation:Pattern=`^([a-zA-Z]|[a-zA-Z][-_a-zA-Z0-9]*[a-zA-Z0-9])$`
+	Name string `json:"name"`
+
+	// networkType is the type of the network attached to the connection.
+	// +kubebuilder:validation:Enum=vpc;power_virtual_server;directlink
+	NetworkType TransitGatewayConnectionNetworkType `json:"networkType"`
+
+	// networkID is the CRN of the network attached to the connection.
+	// +kubebuilder:validation:MinLength=1
+	NetworkID string `json:"networkID"`
+}
+
+// IBMTransitGatewayStatus defines the observed state of IBMTransitGateway.
+type IBMTransitGatewayStatus struct {
+	// ready is true when the transit gateway and its connections are available to be used by clusters.
+	// +optional
+	Ready bool `json:"ready"`
+
+	// transitGatewayID is the id of the transit gateway.
+	// +optional
+	TransitGatewayID string `json:"transitGatewayID,omitempty"`
+
+	// controllerCreated indicates whether the transit gateway is created by the controller.
+	// +optional
+	ControllerCreated *bool `json:"controllerCreated,omitempty"`
+
+	// connections is the status of the connections listed in the spec, keyed by connection name.
+	// +optional
+	Connections map[string]ResourceReference `json:"connections,omitempty"`
+
+	// referencedBy is the list of IBMPowerVSClusters referencing the IBMTransitGateway.
+	// the transit gateway is not deleted as long as the list is not empty.
+	// +optional
+	ReferencedBy []string `json:"referencedBy,omitempty"`
+
+	// conditions defines current service state of the IBMTransitGateway.
+	// +optional
+	Conditions capiv1beta1.Conditions `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=ibmtransitgateways,scope=Namespaced,categories=cluster-api
+// +kubebuilder:metadata:labels="clusterctl.cluster.x-k8s.io/move="
+// +kubebuilder:printcolumn:name="Location",type="string",JSONPath=".spec.location",description="Transit gateway location"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.ready",description="Transit gateway is ready for IBM PowerVS clusters"
+// +kubebuilder:printcolumn:name="Transit Gateway ID",type="string",priority=1,JSONPath=".status.transitGatewayID",description="Transit gateway ID"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="Time duration since creation of IBMTransitGateway"
+
+// IBMTransitGateway is the Schema for the ibmtransitgateways API.
+type IBMTransitGateway struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   IBMTransitGatewaySpec   `json:"spec,omitempty"`
+	Status IBMTransitGatewayStatus `json:"status,omitempty"`
+}
+
+// GetConditions returns the observations of the operational state of the IBMTransitGateway resource.
+func (r *IBMTransitGateway) GetConditions() capiv1beta1.Conditions {
+	return r.Status.Conditions
+}
+
+// SetConditions sets the underlying service state of the IBMTransitGateway to the predescribed clusterv1.Conditions.
+func (r *IBMTransitGateway) SetConditions(conditions capiv1beta1.Conditions) {
+	r.Status.Conditions = conditions
+}
+
+// +kubebuilder:object:root=true
+
+// IBMTransitGatewayList contains a list of IBMTransitGateway.
+type IBMTransitGatewayList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []IBMTransitGateway `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&IBMTransitGateway{}, &IBMTransitGatewayList{})
+}
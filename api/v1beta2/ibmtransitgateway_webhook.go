@@ -0,0 +1,137 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	"fmt"
+	"reflect"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// log is for logging in this package.
+var ibmtransitgatewaylog = logf.Log.WithName("ibmtransitgateway-resource")
+
+func (r *IBMTransitGateway) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/mutate-infrastructure-cluster-x-k8s-io-v1beta2-ibmtransitgateway,mutating=true,failurePolicy=fail,groups=infrastructure.cluster.x-k8s.io,resources=ibmtransitgateways,verbs=create;update,versions=v1beta2,name=mibmtransitgateway.kb.io,sideEffects=None,admissionReviewVersions=v1;v1beta1
+
+var _ webhook.Defaulter = &IBMTransitGateway{}
+
+// Default implements webhook.Defaulter so a webhook will be registered for the type.
+func (r *IBMTransitGateway) Default() {
+	ibmtransitgatewaylog.Info("default", "name", r.Name)
+	if r.Spec.GlobalRouting == nil {
+		r.Spec.GlobalRouting = ptr.To(false)
+	}
+	if r.Spec.DeletePolicy == "" {
+		r.Spec.DeletePolicy = string(DeletePolicyDelete)
+	}
+}
+
+//+kubebuilder:webhook:verbs=create;update,path=/validate-infrastructure-cluster-x-k8s-io-v1beta2-ibmtransitgateway,mutating=false,failurePolicy=fail,groups=infrastructure.cluster.x-k8s.io,resources=ibmtransitgateways,versions=v1beta2,name=vibmtransitgateway.kb.io,sideEffects=None,admissionReviewVersions=v1;v1beta1
+
+var _ webhook.Validator = &IBMTransitGateway{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type.
+func (r *IBMTransitGateway) ValidateCreate() (admission.Warnings, error) {
+	ibmtransitgatewaylog.Info("validate create", "name", r.Name)
+	return r.validateIBMTransitGateway()
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type.
+func (r *IBMTransitGateway) ValidateUpdate(oldRaw runtime.Object) (admission.Warnings, error) {
+	ibmtransitgatewaylog.Info("validate update", "name", r.Name)
+	old, ok := oldRaw.(*IBMTransitGateway)
+	if !ok {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("expected an IBMTransitGateway but got a %T", oldRaw))
+	}
+	// only the connections, the delete policy and the credentials secret can be changed once the transit gateway is created.
+	oldSpec := old.Spec.DeepCopy()
+	oldSpec.Connections = r.Spec.Connections
+	oldSpec.DeletePolicy = r.Spec.DeletePolicy
+	oldSpec.CredentialsSecretRef = r.Spec.CredentialsSecretRef
+	if !reflect.DeepEqual(r.Spec, *oldSpec) {
+		return nil, apierrors.NewBadRequest("IBMTransitGateway.Spec is immutable except connections, deletePolicy and credentialsSecretRef")
+	}
+	return r.validateIBMTransitGateway()
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type.
+func (r *IBMTransitGateway) ValidateDelete() (admission.Warnings, error) {
+	ibmtransitgatewaylog.Info("validate delete", "name", r.Name)
+	return nil, nil
+}
+
+func (r *IBMTransitGateway) validateIBMTransitGateway() (admission.Warnings, error) {
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, r.validateIBMTransitGatewayLocation()...)
+	allErrs = append(allErrs, r.validateIBMTransitGatewayConnections()...)
+	if err := validateEnterpriseAccount(field.NewPath("spec", "enterpriseAccount"), r.Spec.EnterpriseAccount); err != nil {
+		allErrs = append(allErrs, err)
+	}
+
+	return nil, aggregateObjErrors(schema.GroupKind{Group: "infrastructure.cluster.x-k8s.io", Kind: "IBMTransitGateway"}, r.Name, allErrs)
+}
+
+func (r *IBMTransitGateway) validateIBMTransitGatewayLocation() field.ErrorList {
+	var allErrs field.ErrorList
+	// an existing transit gateway is used as is, the location and resource group are required only to create one.
+	if r.Spec.ID != nil {
+		return allErrs
+	}
+	if r.Spec.Location == "" {
+		allErrs = append(allErrs, field.Required(field.NewPath("spec", "location"), "location must be set when id is not set"))
+	}
+	resourceGroup := r.Spec.ResourceGroup
+	if resourceGroup == nil || (resourceGroup.ID == nil && resourceGroup.Name == nil) {
+		allErrs = append(allErrs, field.Required(field.NewPath("spec", "resourceGroup"), "One of - ID or Name must be specified when id is not set"))
+		return allErrs
+	}
+	if res, err := validateIBMPowerVSResourceReference(*resourceGroup, "resourceGroup"); !res {
+		allErrs = append(allErrs, err)
+	}
+	if resourceGroup.RegEx != nil {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "resourceGroup", "regex"), "regex is not supported for resourceGroup"))
+	}
+	return allErrs
+}
+
+func (r *IBMTransitGateway) validateIBMTransitGatewayConnections() field.ErrorList {
+	var allErrs field.ErrorList
+	names := make(map[string]bool, len(r.Spec.Connections))
+	for i, connection := range r.Spec.Connections {
+		if names[connection.Name] {
+			allErrs = append(allErrs, field.Duplicate(field.NewPath("spec", "connections").Index(i).Child("name"), connection.Name))
+		}
+		names[connection.Name] = true
+	}
+	return allErrs
+}
@@ -0,0 +1,159 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestIBMTransitGateway_Default(t *testing.T) {
+	g := NewWithT(t)
+
+	transitGateway := &IBMTransitGateway{}
+	transitGateway.Default()
+	g.Expect(transitGateway.Spec.GlobalRouting).To(Equal(ptr.To(false)))
+	g.Expect(transitGateway.Spec.DeletePolicy).To(Equal(string(DeletePolicyDelete)))
+}
+
+func TestIBMTransitGateway_ValidateCreate(t *testing.T) {
+	g := NewWithT(t)
+
+	tests := []struct {
+		name           string
+		transitGateway *IBMTransitGateway
+		wantErr        bool
+	}{
+		{
+			name: "IBMTransitGateway with location and resource group",
+			transitGateway: &IBMTransitGateway{
+				Spec: IBMTransitGatewaySpec{
+					Location:      "us-south",
+					ResourceGroup: &IBMPowerVSResourceReference{Name: ptr.To("capi-rg")},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "IBMTransitGateway with ID",
+			transitGateway: &IBMTransitGateway{
+				Spec: IBMTransitGatewaySpec{
+					ID: ptr.To("capi-tg-id"),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "IBMTransitGateway with connections",
+			transitGateway: &IBMTransitGateway{
+				Spec: IBMTransitGatewaySpec{
+					ID: ptr.To("capi-tg-id"),
+					Connections: []TransitGatewayConnection{
+						{Name: "capi-vpc", NetworkType: TransitGatewayConnectionNetworkTypeVPC, NetworkID: "crn:vpc"},
+						{Name: "capi-powervs", NetworkType: TransitGatewayConnectionNetworkTypePowerVS, NetworkID: "crn:powervs"},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "IBMTransitGateway without location",
+			transitGateway: &IBMTransitGateway{
+				Spec: IBMTransitGatewaySpec{
+					ResourceGroup: &IBMPowerVSResourceReference{ID: ptr.To("capi-rg-id")},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "IBMTransitGateway without resource group",
+			transitGateway: &IBMTransitGateway{
+				Spec: IBMTransitGatewaySpec{
+					Location: "us-south",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "IBMTransitGateway with resource group regex",
+			transitGateway: &IBMTransitGateway{
+				Spec: IBMTransitGatewaySpec{
+					Location:      "us-south",
+					ResourceGroup: &IBMPowerVSResourceReference{Name: ptr.To("capi-rg"), RegEx: ptr.To("^capi")},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "IBMTransitGateway with duplicate connection names",
+			transitGateway: &IBMTransitGateway{
+				Spec: IBMTransitGatewaySpec{
+					ID: ptr.To("capi-tg-id"),
+					Connections: []TransitGatewayConnection{
+						{Name: "capi-vpc", NetworkType: TransitGatewayConnectionNetworkTypeVPC, NetworkID: "crn:vpc"},
+						{Name: "capi-vpc", NetworkType: TransitGatewayConnectionNetworkTypeVPC, NetworkID: "crn:vpc-2"},
+					},
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(_ *testing.T) {
+			_, err := test.transitGateway.ValidateCreate()
+			if test.wantErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+		})
+	}
+}
+
+func TestIBMTransitGateway_ValidateUpdate(t *testing.T) {
+	g := NewWithT(t)
+
+	oldTransitGateway := &IBMTransitGateway{
+		Spec: IBMTransitGatewaySpec{
+			Location:      "us-south",
+			ResourceGroup: &IBMPowerVSResourceReference{Name: ptr.To("capi-rg")},
+			DeletePolicy:  string(DeletePolicyDelete),
+		},
+	}
+
+	t.Run("Should allow updating the connections, delete policy and credentials secret", func(_ *testing.T) {
+		transitGateway := oldTransitGateway.DeepCopy()
+		transitGateway.Spec.DeletePolicy = string(DeletePolicyRetain)
+		transitGateway.Spec.CredentialsSecretRef = &corev1.LocalObjectReference{Name: "capi-credentials"}
+		transitGateway.Spec.Connections = []TransitGatewayConnection{
+			{Name: "capi-vpc", NetworkType: TransitGatewayConnectionNetworkTypeVPC, NetworkID: "crn:vpc"},
+		}
+		_, err := transitGateway.ValidateUpdate(oldTransitGateway)
+		g.Expect(err).NotTo(HaveOccurred())
+	})
+
+	t.Run("Should error when updating the location", func(_ *testing.T) {
+		transitGateway := oldTransitGateway.DeepCopy()
+		transitGateway.Spec.Location = "eu-de"
+		_, err := transitGateway.ValidateUpdate(oldTransitGateway)
+		g.Expect(err).To(HaveOccurred())
+	})
+}
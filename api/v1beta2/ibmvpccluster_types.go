@@ -17,6 +17,7 @@ limitations under the License.
 package v1beta2
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	capiv1beta1 "sigs.k8s.io/cluster-api/api/v1beta1"
@@ -56,6 +57,12 @@ type IBMVPCClusterSpec struct {
 	// +optional
 	ControlPlaneLoadBalancer *VPCLoadBalancerSpec `json:"controlPlaneLoadBalancer,omitempty"`
 
+	// dns is the DNS record registering the control plane endpoint, pointing to the hostname of the load balancer of Network
+	// serving the control plane. when set, the hostname of the record is used as the host of ControlPlaneEndpoint instead of
+	// the hostname of the load balancer. only used along with Network.
+	// +optional
+	DNS *DNSSpec `json:"dns,omitempty"`
+
 	// image represents the Image details used for the cluster.
 	// +optional
 	Image *ImageSpec `json:"image,omitempty"`
@@ -63,6 +70,64 @@ type IBMVPCClusterSpec struct {
 	// network represents the VPC network to use for the cluster.
 	// +optional
 	Network *VPCNetworkSpec `json:"network,omitempty"`
+
+	// resourceSelector constrains the existing IBM Cloud resources considered when resolving the resources of the cluster and its machines by name.
+	// Resources found by name not matching the resourceSelector are ignored.
+	// Resources created by the controller are tagged with the tags of the resourceSelector.
+	// +optional
+	ResourceSelector *ResourceSelector `json:"resourceSelector,omitempty"`
+
+	// tags configures the user tags attached to the resources created by the controller.
+	// the tags are reconciled without detaching the tags attached by other systems unless tags.prune is set.
+	// +optional
+	Tags *ResourceTags `json:"tags,omitempty"`
+
+	// guardrails is the budget of the instances of the cluster. the machines exceeding it are not created
+	// and report the GuardrailsExceeded reason on their InstanceReady condition.
+	// +optional
+	Guardrails *ClusterGuardrails `json:"guardrails,omitempty"`
+
+	// reconcileIntervals overrides the intervals at which the objects of the cluster are reconciled, e.g. to poll
+	// image import jobs every few minutes while the load balancer pool members are reconciled every few seconds.
+	// +optional
+	ReconcileIntervals *ReconcileIntervals `json:"reconcileIntervals,omitempty"`
+
+	// connectivityCheck enables the connectivity self-test of the cluster, run from the management cluster once its
+	// infrastructure is ready to catch clusters which are ready but can't be reached.
+	// removing it removes the conditions reported by the test.
+	// +optional
+	ConnectivityCheck *ConnectivityCheck `json:"connectivityCheck,omitempty"`
+
+	// credentialsSecretRef is the reference to the secret containing the IBM Cloud API key used to provision the cluster
+	// and its machines, which allows to provision clusters in different IBM Cloud accounts.
+	// the secret must exist in the same namespace as the IBMVPCCluster and contain the apiKey key.
+	// when omitted, the API key configured for the controller is used.
+	// +optional
+	CredentialsSecretRef *corev1.LocalObjectReference `json:"credentialsSecretRef,omitempty"`
+
+	// enterpriseAccount is the child account of the IBM Cloud enterprise the cluster is provisioned into. the IAM trusted
+	// profile of the child account is assumed with the API key of the credentials of the cluster, which belong to an
+	// account of the enterprise, so a central platform team can provision clusters into the accounts of the enterprise.
+	// +optional
+	EnterpriseAccount *EnterpriseAccount `json:"enterpriseAccount,omitempty"`
+
+	// bootstrapDataBucket is the existing IBM Cloud COS bucket used to stage the Ignition bootstrap data of the machines
+	// which exceeds the user data limit of 64 KiB, the machines then fetch it with a pre-signed URL.
+	// the pre-signed URLs are generated with the HMAC credentials held by the accessKey and secretKey keys of the credentials secret.
+	// +optional
+	BootstrapDataBucket *COSBucket `json:"bootstrapDataBucket,omitempty"`
+}
+
+// COSBucket is a reference to an existing IBM Cloud COS bucket.
+type COSBucket struct {
+	// name is the name of the bucket.
+	// +kubebuilder:validation:MinLength:=3
+	// +kubebuilder:validation:MaxLength:=63
+	Name string `json:"name"`
+
+	// region is the region of the bucket, defaults to the region of the cluster.
+	// +optional
+	Region string `json:"region,omitempty"`
 }
 
 // VPCLoadBalancerSpec defines the desired state of an VPC load balancer.
@@ -312,6 +377,21 @@ type IBMVPCClusterStatus struct {
 	// +optional
 	ControlPlaneLoadBalancerState VPCLoadBalancerState `json:"controlPlaneLoadBalancerState,omitempty"`
 
+	// loadBalancerPoolMembers are the addresses of the machines registered by the controller as members of the pools of the
+	// load balancers of the cluster. Only these members are removed from the pools once they no longer belong to a machine.
+	// +optional
+	// +listType=set
+	LoadBalancerPoolMembers []string `json:"loadBalancerPoolMembers,omitempty"`
+
+	// dnsRecord is the reference to the DNS record registering the control plane endpoint.
+	// +optional
+	DNSRecord *ResourceReference `json:"dnsRecord,omitempty"`
+
+	// failureDomains is the set of zones the cluster's subnets span, keyed by zone name.
+	// Zones hosting a control plane subnet are marked as eligible for control plane machines.
+	// +optional
+	FailureDomains capiv1beta1.FailureDomains `json:"failureDomains,omitempty"`
+
 	// Conditions defines current service state of the load balancer.
 	// +optional
 	Conditions capiv1beta1.Conditions `json:"conditions,omitempty"`
@@ -17,6 +17,8 @@ limitations under the License.
 package v1beta2
 
 import (
+	"fmt"
+
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -58,8 +60,22 @@ func (r *IBMVPCCluster) ValidateCreate() (admission.Warnings, error) {
 }
 
 // ValidateUpdate implements webhook.Validator so a webhook will be registered for the type.
-func (r *IBMVPCCluster) ValidateUpdate(_ runtime.Object) (admission.Warnings, error) {
+func (r *IBMVPCCluster) ValidateUpdate(oldRaw runtime.Object) (admission.Warnings, error) {
 	ibmvpcclusterlog.Info("validate update", "name", r.Name)
+	old, ok := oldRaw.(*IBMVPCCluster)
+	if !ok {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("expected an IBMVPCCluster but got a %T", oldRaw))
+	}
+	if err := validateDNSUpdate(old.Spec.DNS, r.Spec.DNS, old.Status.DNSRecord); err != nil {
+		return nil, apierrors.NewInvalid(
+			schema.GroupKind{Group: "infrastructure.cluster.x-k8s.io", Kind: "IBMVPCCluster"},
+			r.Name, field.ErrorList{err})
+	}
+	if err := validateEnterpriseAccountUpdate(old.Spec.EnterpriseAccount, r.Spec.EnterpriseAccount); err != nil {
+		return nil, apierrors.NewInvalid(
+			schema.GroupKind{Group: "infrastructure.cluster.x-k8s.io", Kind: "IBMVPCCluster"},
+			r.Name, field.ErrorList{err})
+	}
 	return r.validateIBMVPCCluster()
 }
 
@@ -74,6 +90,21 @@ func (r *IBMVPCCluster) validateIBMVPCCluster() (admission.Warnings, error) {
 	if err := r.validateIBMVPCClusterControlPlane(); err != nil {
 		allErrs = append(allErrs, err)
 	}
+	if err := validateEnterpriseAccount(field.NewPath("spec", "enterpriseAccount"), r.Spec.EnterpriseAccount); err != nil {
+		allErrs = append(allErrs, err)
+	}
+	if err := validateClusterGuardrails(r.Spec.Guardrails); err != nil {
+		allErrs = append(allErrs, err)
+	}
+	if err := validateReconcileIntervals(r.Spec.ReconcileIntervals); err != nil {
+		allErrs = append(allErrs, err...)
+	}
+	if err := validateConnectivityCheck(r.Spec.ConnectivityCheck); err != nil {
+		allErrs = append(allErrs, err...)
+	}
+	if r.Spec.ReconcileIntervals != nil && r.Spec.ReconcileIntervals.Images != nil {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "reconcileIntervals", "images"), "images is only supported for Power VS clusters"))
+	}
 	if len(allErrs) == 0 {
 		return nil, nil
 	}
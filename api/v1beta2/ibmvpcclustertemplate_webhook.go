@@ -0,0 +1,88 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	"fmt"
+	"reflect"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// log is for logging in this package.
+var ibmvpcclustertemplatelog = logf.Log.WithName("ibmvpcclustertemplate-resource")
+
+func (r *IBMVPCClusterTemplate) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/mutate-infrastructure-cluster-x-k8s-io-v1beta2-ibmvpcclustertemplate,mutating=true,failurePolicy=fail,groups=infrastructure.cluster.x-k8s.io,resources=ibmvpcclustertemplates,verbs=create;update,versions=v1beta2,name=mibmvpcclustertemplate.kb.io,sideEffects=None,admissionReviewVersions=v1;v1beta1
+
+var _ webhook.Defaulter = &IBMVPCClusterTemplate{}
+
+// Default implements webhook.Defaulter so a webhook will be registered for the type.
+func (r *IBMVPCClusterTemplate) Default() {
+	ibmvpcclustertemplatelog.Info("default", "name", r.Name)
+}
+
+//+kubebuilder:webhook:verbs=create;update,path=/validate-infrastructure-cluster-x-k8s-io-v1beta2-ibmvpcclustertemplate,mutating=false,failurePolicy=fail,groups=infrastructure.cluster.x-k8s.io,resources=ibmvpcclustertemplates,versions=v1beta2,name=vibmvpcclustertemplate.kb.io,sideEffects=None,admissionReviewVersions=v1;v1beta1
+
+var _ webhook.Validator = &IBMVPCClusterTemplate{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type.
+func (r *IBMVPCClusterTemplate) ValidateCreate() (admission.Warnings, error) {
+	ibmvpcclustertemplatelog.Info("validate create", "name", r.Name)
+
+	// Validate the spec of the template the same way as the IBMVPCCluster created from it.
+	cluster := &IBMVPCCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        r.Name,
+			Annotations: r.Spec.Template.ObjectMeta.Annotations,
+		},
+		Spec: r.Spec.Template.Spec,
+	}
+	return cluster.validateIBMVPCCluster()
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type.
+func (r *IBMVPCClusterTemplate) ValidateUpdate(oldRaw runtime.Object) (admission.Warnings, error) {
+	ibmvpcclustertemplatelog.Info("validate update", "name", r.Name)
+	old, ok := oldRaw.(*IBMVPCClusterTemplate)
+	if !ok {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("expected an IBMVPCClusterTemplate but got a %T", oldRaw))
+	}
+	if !reflect.DeepEqual(r.Spec, old.Spec) {
+		return nil, apierrors.NewBadRequest("IBMVPCClusterTemplate.Spec is immutable")
+	}
+	return nil, nil
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type.
+func (r *IBMVPCClusterTemplate) ValidateDelete() (admission.Warnings, error) {
+	ibmvpcclustertemplatelog.Info("validate delete", "name", r.Name)
+
+	return nil, nil
+}
@@ -0,0 +1,155 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	"testing"
+
+	capiv1beta1 "sigs.k8s.io/cluster-api/api/v1beta1"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestIBMVPCClusterTemplate_ValidateCreate(t *testing.T) {
+	g := NewWithT(t)
+
+	tests := []struct {
+		name     string
+		template *IBMVPCClusterTemplate
+		wantErr  bool
+	}{
+		{
+			name: "IBMVPCClusterTemplate with control plane load balancer",
+			template: &IBMVPCClusterTemplate{
+				Spec: IBMVPCClusterTemplateSpec{
+					Template: IBMVPCClusterTemplateResource{
+						Spec: IBMVPCClusterSpec{
+							ControlPlaneLoadBalancer: &VPCLoadBalancerSpec{
+								Name: "vpc-load-balancer",
+							},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "IBMVPCClusterTemplate with control plane endpoint",
+			template: &IBMVPCClusterTemplate{
+				Spec: IBMVPCClusterTemplateSpec{
+					Template: IBMVPCClusterTemplateResource{
+						Spec: IBMVPCClusterSpec{
+							ControlPlaneEndpoint: capiv1beta1.APIEndpoint{
+								Host: "192.168.1.10",
+							},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "IBMVPCClusterTemplate without control plane endpoint and load balancer",
+			template: &IBMVPCClusterTemplate{
+				Spec: IBMVPCClusterTemplateSpec{
+					Template: IBMVPCClusterTemplateResource{
+						Spec: IBMVPCClusterSpec{
+							Region: "us-south",
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(_ *testing.T) {
+			_, err := test.template.ValidateCreate()
+			if test.wantErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+		})
+	}
+}
+
+func TestIBMVPCClusterTemplate_ValidateUpdate(t *testing.T) {
+	g := NewWithT(t)
+
+	tests := []struct {
+		name        string
+		newTemplate *IBMVPCClusterTemplate
+		oldTemplate *IBMVPCClusterTemplate
+		wantErr     bool
+	}{
+		{
+			name: "IBMVPCClusterTemplate with immutable spec",
+			newTemplate: &IBMVPCClusterTemplate{
+				Spec: IBMVPCClusterTemplateSpec{
+					Template: IBMVPCClusterTemplateResource{
+						Spec: IBMVPCClusterSpec{
+							Region: "us-south",
+						},
+					},
+				},
+			},
+			oldTemplate: &IBMVPCClusterTemplate{
+				Spec: IBMVPCClusterTemplateSpec{
+					Template: IBMVPCClusterTemplateResource{
+						Spec: IBMVPCClusterSpec{
+							Region: "us-south",
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "IBMVPCClusterTemplate with mutable spec",
+			newTemplate: &IBMVPCClusterTemplate{
+				Spec: IBMVPCClusterTemplateSpec{
+					Template: IBMVPCClusterTemplateResource{
+						Spec: IBMVPCClusterSpec{
+							Region: "us-south",
+						},
+					},
+				},
+			},
+			oldTemplate: &IBMVPCClusterTemplate{
+				Spec: IBMVPCClusterTemplateSpec{
+					Template: IBMVPCClusterTemplateResource{
+						Spec: IBMVPCClusterSpec{
+							Region: "us-east",
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(_ *testing.T) {
+			_, err := test.newTemplate.ValidateUpdate(test.oldTemplate)
+			if test.wantErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+		})
+	}
+}
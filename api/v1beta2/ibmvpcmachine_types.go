@@ -47,6 +47,25 @@ type IBMVPCMachineSpec struct {
 	// +optional
 	PlacementTarget *VPCMachinePlacementTarget `json:"placementTarget,omitempty"`
 
+	// Capacity defines the pricing and capacity options of the instance, like reserved capacity and the handling of interruptions.
+	// +optional
+	Capacity *VPCMachineCapacity `json:"capacity,omitempty"`
+
+	// AutoRepairPolicy defines the action taken when IBM Cloud reports the instance as failed.
+	// With Replace, the Machine is deleted, so its MachineSet or MachinePool replaces it without requiring a MachineHealthCheck.
+	// Control plane Machines and Machines not owned by a MachineSet or a MachinePool are never replaced.
+	// +kubebuilder:validation:Enum=None;Replace
+	// +kubebuilder:default=None
+	// +optional
+	AutoRepairPolicy MachineAutoRepairPolicy `json:"autoRepairPolicy,omitempty"`
+
+	// ShutdownTimeout is the time to wait for the operating system of the instance to shut down gracefully
+	// when the machine is deleted, before the data volumes are detached and the instance is deleted.
+	// The instance is deleted without waiting any longer once the timeout elapsed.
+	// The instance is deleted without shutting it down when the timeout is not set or 0s.
+	// +optional
+	ShutdownTimeout *metav1.Duration `json:"shutdownTimeout,omitempty"`
+
 	// Image is the OS image which would be install on the instance.
 	// ID will take higher precedence over Name if both specified.
 	Image *IBMVPCResourceReference `json:"image"`
@@ -56,6 +75,7 @@ type IBMVPCMachineSpec struct {
 	LoadBalancerPoolMembers []VPCLoadBalancerBackendPoolMember `json:"loadBalancerPoolMembers,omitempty"`
 
 	// Zone is the place where the instance should be created. Example: us-south-3
+	// The failure domain of the owning Machine takes precedence when set.
 	// TODO: Actually zone is transparent to user. The field user can access is location. Example: Dallas 2
 	Zone string `json:"zone"`
 
@@ -68,6 +88,19 @@ type IBMVPCMachineSpec struct {
 	// +optional
 	BootVolume *VPCVolume `json:"bootVolume,omitempty"`
 
+	// AdditionalVolumes is the list of data volumes created along with the instance and attached to it.
+	// The name of a volume is prefixed with the names of the cluster and of the machine and defaults to <cluster name>-<machine name>-data-<index>.
+	// SizeGiB is required for every volume.
+	// +kubebuilder:validation:MaxItems=12
+	// +optional
+	AdditionalVolumes []*VPCVolume `json:"additionalVolumes,omitempty"`
+
+	// EncryptionKeyCRN is the CRN of the Key Protect or Hyper Protect Crypto Services root key used to encrypt
+	// the boot volume and the additional volumes which don't set an encryptionKeyCRN of their own.
+	// If unspecified, the volumes are encrypted with provider managed keys.
+	// +optional
+	EncryptionKeyCRN string `json:"encryptionKeyCRN,omitempty"`
+
 	// ProviderID is the unique identifier as specified by the cloud provider.
 	// +optional
 	ProviderID *string `json:"providerID,omitempty"`
@@ -75,9 +108,43 @@ type IBMVPCMachineSpec struct {
 	// PrimaryNetworkInterface is required to specify subnet.
 	PrimaryNetworkInterface NetworkInterface `json:"primaryNetworkInterface,omitempty"`
 
+	// SecurityGroups is the set of additional IBM Cloud VPC Security Groups to attach to the primary network interface.
+	// They are attached in addition to the PrimaryNetworkInterface's SecurityGroups or, when those are not defined,
+	// the Security Groups defined in the network of the IBMVPCCluster.
+	// +kubebuilder:validation:MaxItems=5
+	// +optional
+	SecurityGroups []VPCResource `json:"securityGroups,omitempty"`
+
 	// SSHKeys is the SSH pub keys that will be used to access VM.
 	// ID will take higher precedence over Name if both specified.
 	SSHKeys []*IBMVPCResourceReference `json:"sshKeys,omitempty"`
+
+	// userTags are the user tags attached to the instance of the machine when it is created, e.g. to tell apart
+	// the instances of the GPU or storage worker pools. they are attached along with the tags managed by the controller.
+	// +kubebuilder:validation:items:MinLength=1
+	// +kubebuilder:validation:items:MaxLength=128
+	// +listType=set
+	// +optional
+	UserTags []string `json:"userTags,omitempty"`
+
+	// nodeLabels are the labels the node of the machine registers with, set with the --node-labels flag of the kubelet.
+	// the labels are only injected into cloud-config bootstrap data, they are merged with the labels set by the bootstrap provider
+	// and override the values of the same keys. the kubelet refuses labels in the kubernetes.io and k8s.io namespaces,
+	// except for the kubelet.kubernetes.io and node.kubernetes.io ones.
+	// +optional
+	NodeLabels map[string]string `json:"nodeLabels,omitempty"`
+
+	// nodeTaints are the taints the node of the machine registers with, set with the --register-with-taints flag of the kubelet.
+	// the taints are only injected into cloud-config bootstrap data, they replace the taints set by the bootstrap provider.
+	// +optional
+	NodeTaints []corev1.Taint `json:"nodeTaints,omitempty"`
+
+	// reportBootstrapResult wraps cloud-config bootstrap data into a multipart MIME message along with a boothook which logs the
+	// result of the bootstrap to the console and to the cloud-init output of the instance once cloud-init finished, for the
+	// operators inspecting the instance as the result is not collected by the controller. The bootstrap data is left unchanged
+	// when it would exceed the user data limit of the instance.
+	// +optional
+	ReportBootstrapResult bool `json:"reportBootstrapResult,omitempty"`
 }
 
 // IBMVPCResourceReference is a reference to a specific VPC resource by ID or Name
@@ -175,6 +242,11 @@ type IBMVPCMachineStatus struct {
 	// LoadBalancerPoolMembers is the status of IBM Cloud VPC Load Balancer Backend Pools the machine is a member.
 	// +optional
 	LoadBalancerPoolMembers []VPCLoadBalancerBackendPoolMember `json:"loadBalancerPoolMembers,omitempty"`
+
+	// actionHistory records the last actions performed by the controller against the instance, oldest first.
+	// +kubebuilder:validation:MaxItems=10
+	// +optional
+	ActionHistory []MachineAction `json:"actionHistory,omitempty"`
 }
 
 // +kubebuilder:object:root=true
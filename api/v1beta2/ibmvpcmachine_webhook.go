@@ -17,7 +17,12 @@ limitations under the License.
 package v1beta2
 
 import (
+	"fmt"
+	"reflect"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -56,14 +61,32 @@ func (r *IBMVPCMachine) ValidateCreate() (admission.Warnings, error) {
 
 	var allErrs field.ErrorList
 	allErrs = append(allErrs, r.validateIBMVPCMachineBootVolume()...)
+	allErrs = append(allErrs, validatePlacementTarget(r.Spec)...)
+	allErrs = append(allErrs, validateAdditionalVolumes(r.Spec)...)
+	allErrs = append(allErrs, validateEncryptionKeyCRNs(r.Spec)...)
+	allErrs = append(allErrs, validateNodeRegistration(r.Spec.NodeLabels, r.Spec.NodeTaints)...)
+	allErrs = append(allErrs, validateShutdownTimeout(r.Spec.ShutdownTimeout)...)
 
-	return nil, aggregateObjErrors(r.GroupVersionKind().GroupKind(), r.Name, allErrs)
+	return validateVPCMachineProfile(r.Spec), aggregateObjErrors(r.GroupVersionKind().GroupKind(), r.Name, allErrs)
 }
 
 // ValidateUpdate implements webhook.Validator so a webhook will be registered for the type.
-func (r *IBMVPCMachine) ValidateUpdate(_ runtime.Object) (admission.Warnings, error) {
+func (r *IBMVPCMachine) ValidateUpdate(oldRaw runtime.Object) (admission.Warnings, error) {
 	ibmvpcmachinelog.Info("validate update", "name", r.Name)
-	return nil, nil
+	old, ok := oldRaw.(*IBMVPCMachine)
+	if !ok {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("expected an IBMVPCMachine but got a %T", oldRaw))
+	}
+
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, validatePlacementTargetUpdate(r.Spec, old.Spec)...)
+	allErrs = append(allErrs, validateEncryptionKeyCRNs(r.Spec)...)
+	allErrs = append(allErrs, validateNodeRegistration(r.Spec.NodeLabels, r.Spec.NodeTaints)...)
+	if !reflect.DeepEqual(r.Spec.ShutdownTimeout, old.Spec.ShutdownTimeout) {
+		allErrs = append(allErrs, validateShutdownTimeout(r.Spec.ShutdownTimeout)...)
+	}
+
+	return nil, aggregateObjErrors(schema.GroupKind{Group: "infrastructure.cluster.x-k8s.io", Kind: "IBMVPCMachine"}, r.Name, allErrs)
 }
 
 // ValidateDelete implements webhook.Validator so a webhook will be registered for the type.
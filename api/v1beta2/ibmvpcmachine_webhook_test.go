@@ -77,3 +77,43 @@ func TestIBMVPCMachine_Create(t *testing.T) {
 		})
 	}
 }
+
+func TestIBMVPCMachine_ValidateUpdate(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    IBMVPCMachineSpec
+		wantErr bool
+	}{
+		{
+			name: "Update a IBMVPCMachine with a valid encryption key CRN",
+			spec: IBMVPCMachineSpec{
+				EncryptionKeyCRN: "crn:v1:bluemix:public:kms:us-south:a/account-id:instance-id:key:key-id",
+			},
+			wantErr: false,
+		},
+		{
+			name: "Update a IBMVPCMachine with an invalid encryption key CRN",
+			spec: IBMVPCMachineSpec{
+				EncryptionKeyCRN: "crn:v1:bluemix:public:kms:us-south:a/account-id:instance-id",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Update a IBMVPCMachine with a node label in the kubernetes.io namespace",
+			spec: IBMVPCMachineSpec{
+				NodeLabels: map[string]string{"node-role.kubernetes.io/gpu": ""},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			old := &IBMVPCMachine{ObjectMeta: metav1.ObjectMeta{Name: "capi-machine", Namespace: "default"}}
+			machine := old.DeepCopy()
+			machine.Spec = tt.spec
+			if _, err := machine.ValidateUpdate(old); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateUpdate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
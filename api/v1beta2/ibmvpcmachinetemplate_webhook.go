@@ -17,7 +17,12 @@ limitations under the License.
 package v1beta2
 
 import (
+	"fmt"
+	"reflect"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -55,14 +60,32 @@ func (r *IBMVPCMachineTemplate) ValidateCreate() (admission.Warnings, error) {
 	ibmvpcmachinetemplatelog.Info("validate create", "name", r.Name)
 	var allErrs field.ErrorList
 	allErrs = append(allErrs, r.validateIBMVPCMachineBootVolume()...)
+	allErrs = append(allErrs, validatePlacementTarget(r.Spec.Template.Spec)...)
+	allErrs = append(allErrs, validateAdditionalVolumes(r.Spec.Template.Spec)...)
+	allErrs = append(allErrs, validateEncryptionKeyCRNs(r.Spec.Template.Spec)...)
+	allErrs = append(allErrs, validateNodeRegistration(r.Spec.Template.Spec.NodeLabels, r.Spec.Template.Spec.NodeTaints)...)
+	allErrs = append(allErrs, validateShutdownTimeout(r.Spec.Template.Spec.ShutdownTimeout)...)
 
-	return nil, aggregateObjErrors(r.GroupVersionKind().GroupKind(), r.Name, allErrs)
+	return validateVPCMachineProfile(r.Spec.Template.Spec), aggregateObjErrors(r.GroupVersionKind().GroupKind(), r.Name, allErrs)
 }
 
 // ValidateUpdate implements webhook.Validator so a webhook will be registered for the type.
-func (r *IBMVPCMachineTemplate) ValidateUpdate(_ runtime.Object) (admission.Warnings, error) {
+func (r *IBMVPCMachineTemplate) ValidateUpdate(oldRaw runtime.Object) (admission.Warnings, error) {
 	ibmvpcmachinetemplatelog.Info("validate update", "name", r.Name)
-	return nil, nil
+	old, ok := oldRaw.(*IBMVPCMachineTemplate)
+	if !ok {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("expected an IBMVPCMachineTemplate but got a %T", oldRaw))
+	}
+
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, validatePlacementTargetUpdate(r.Spec.Template.Spec, old.Spec.Template.Spec)...)
+	allErrs = append(allErrs, validateEncryptionKeyCRNs(r.Spec.Template.Spec)...)
+	allErrs = append(allErrs, validateNodeRegistration(r.Spec.Template.Spec.NodeLabels, r.Spec.Template.Spec.NodeTaints)...)
+	if !reflect.DeepEqual(r.Spec.Template.Spec.ShutdownTimeout, old.Spec.Template.Spec.ShutdownTimeout) {
+		allErrs = append(allErrs, validateShutdownTimeout(r.Spec.Template.Spec.ShutdownTimeout)...)
+	}
+
+	return nil, aggregateObjErrors(schema.GroupKind{Group: "infrastructure.cluster.x-k8s.io", Kind: "IBMVPCMachineTemplate"}, r.Name, allErrs)
 }
 
 // ValidateDelete implements webhook.Validator so a webhook will be registered for the type.
@@ -75,6 +75,15 @@ func setup() {
 	if err := (&IBMPowerVSClusterTemplate{}).SetupWebhookWithManager(testEnv); err != nil {
 		panic(fmt.Sprintf("Unable to setup IBMPowerVSClusterTemplate webhook: %v", err))
 	}
+	if err := (&IBMVPCClusterTemplate{}).SetupWebhookWithManager(testEnv); err != nil {
+		panic(fmt.Sprintf("Unable to setup IBMVPCClusterTemplate webhook: %v", err))
+	}
+	if err := (&IBMPowerVSNetwork{}).SetupWebhookWithManager(testEnv); err != nil {
+		panic(fmt.Sprintf("Unable to setup IBMPowerVSNetwork webhook: %v", err))
+	}
+	if err := (&IBMTransitGateway{}).SetupWebhookWithManager(testEnv); err != nil {
+		panic(fmt.Sprintf("Unable to setup IBMTransitGateway webhook: %v", err))
+	}
 
 	go func() {
 		fmt.Println("Starting the manager")
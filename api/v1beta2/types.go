@@ -16,7 +16,12 @@ limitations under the License.
 
 package v1beta2
 
-import "github.com/IBM/vpc-go-sdk/vpcv1"
+import (
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
 
 const (
 	// CIDRBlockAny is the CIDRBlock representing any allowable destination/source IP.
@@ -117,6 +122,20 @@ var (
 	TransitGatewayConnectionStateDeleting = TransitGatewayConnectionState("deleting")
 )
 
+// TransitGatewayConnectionNetworkType describes the type of the network attached to an IBM Transit Gateway connection.
+type TransitGatewayConnectionNetworkType string
+
+var (
+	// TransitGatewayConnectionNetworkTypeVPC is the string representing a VPC connection.
+	TransitGatewayConnectionNetworkTypeVPC = TransitGatewayConnectionNetworkType("vpc")
+
+	// TransitGatewayConnectionNetworkTypePowerVS is the string representing a Power VS workspace connection.
+	TransitGatewayConnectionNetworkTypePowerVS = TransitGatewayConnectionNetworkType("power_virtual_server")
+
+	// TransitGatewayConnectionNetworkTypeDirectLink is the string representing a Direct Link connection.
+	TransitGatewayConnectionNetworkTypeDirectLink = TransitGatewayConnectionNetworkType("directlink")
+)
+
 // VPCLoadBalancerBackendPoolAlgorithm describes the backend pool's load balancing algorithm.
 // +kubebuilder:validation:Enum=least_connections;round_robin;weighted_round_robin
 type VPCLoadBalancerBackendPoolAlgorithm string
@@ -235,14 +254,50 @@ var (
 	DHCPServerStateError = DHCPServerState("ERROR")
 )
 
-// DeletePolicy defines the policy used to identify images to be preserved.
+// DHCPServerUpdatePolicy defines the policy used to apply the changes of the DHCP server settings.
+type DHCPServerUpdatePolicy string
+
+var (
+	// DHCPServerUpdatePolicyInPlace is the string representing the changes of the DHCP server settings to be applied only in place.
+	DHCPServerUpdatePolicyInPlace = DHCPServerUpdatePolicy("inPlace")
+
+	// DHCPServerUpdatePolicyRecreate is the string representing the DHCP server to be recreated to apply the changes which cannot be applied in place.
+	DHCPServerUpdatePolicyRecreate = DHCPServerUpdatePolicy("recreate")
+)
+
+// DeletePolicy defines the policy used to identify images, networks and transit gateways to be preserved.
 type DeletePolicy string
 
 var (
-	// DeletePolicyRetain is the string representing an image to be retained.
+	// DeletePolicyDelete is the string representing an image, network or transit gateway to be deleted.
+	DeletePolicyDelete = DeletePolicy("delete")
+
+	// DeletePolicyRetain is the string representing an image, network or transit gateway to be retained.
 	DeletePolicyRetain = DeletePolicy("retain")
 )
 
+// DNSProvider describes the IBM Cloud service hosting the DNS zone of the control plane endpoint of a cluster.
+type DNSProvider string
+
+var (
+	// DNSProviderDNSServices is the string representing IBM Cloud DNS Services, which hosts private zones.
+	DNSProviderDNSServices = DNSProvider("DNSServices")
+
+	// DNSProviderCIS is the string representing IBM Cloud Internet Services, which hosts public zones.
+	DNSProviderCIS = DNSProvider("CIS")
+)
+
+// PowerVSNetworkType describes the type of a Power VS network.
+type PowerVSNetworkType string
+
+var (
+	// PowerVSNetworkTypeVLAN is the string representing a private Power VS network.
+	PowerVSNetworkTypeVLAN = PowerVSNetworkType("vlan")
+
+	// PowerVSNetworkTypePublicVLAN is the string representing a public Power VS network.
+	PowerVSNetworkTypePublicVLAN = PowerVSNetworkType("pub-vlan")
+)
+
 // ResourceType describes IBM Cloud resource name.
 type ResourceType string
 
@@ -253,6 +308,8 @@ var (
 	ResourceTypeNetwork = ResourceType("network")
 	// ResourceTypeDHCPServer is Power VS DHCP server.
 	ResourceTypeDHCPServer = ResourceType("dhcpServer")
+	// ResourceTypeSSHKey is Power VS SSH key.
+	ResourceTypeSSHKey = ResourceType("sshKey")
 	// ResourceTypeLoadBalancer VPC loadBalancer resource.
 	ResourceTypeLoadBalancer = ResourceType("loadBalancer")
 	// ResourceTypeLoadBalancerPool is a Load Balancer Pool resource.
@@ -279,6 +336,14 @@ var (
 	ResourceTypePublicGateway = ResourceType("publicGateway")
 	// ResourceTypeCustomImage is a VPC Custom Image.
 	ResourceTypeCustomImage = ResourceType("customImage")
+	// ResourceTypeDedicatedHost is a VPC Dedicated Host.
+	ResourceTypeDedicatedHost = ResourceType("dedicatedHost")
+	// ResourceTypeDedicatedHostGroup is a VPC Dedicated Host Group.
+	ResourceTypeDedicatedHostGroup = ResourceType("dedicatedHostGroup")
+	// ResourceTypePlacementGroup is a VPC Placement Group.
+	ResourceTypePlacementGroup = ResourceType("placementGroup")
+	// ResourceTypeReservation is a VPC Reservation.
+	ResourceTypeReservation = ResourceType("reservation")
 )
 
 const (
@@ -350,6 +415,62 @@ const (
 	VPCSecurityGroupRuleRemoteTypeSG VPCSecurityGroupRuleRemoteType = VPCSecurityGroupRuleRemoteType("sg")
 )
 
+// MachineActionType describes the action performed by the controller against the instance of a machine.
+// +kubebuilder:validation:Enum=Create;Stop;Delete;Remediate
+type MachineActionType string
+
+const (
+	// MachineActionCreate is the creation of the instance.
+	MachineActionCreate MachineActionType = MachineActionType("Create")
+	// MachineActionStop is the shutdown of the instance before its deletion.
+	MachineActionStop MachineActionType = MachineActionType("Stop")
+	// MachineActionDelete is the deletion of the instance.
+	MachineActionDelete MachineActionType = MachineActionType("Delete")
+	// MachineActionRemediate is the deletion of the owning Machine, so it gets replaced by its MachineSet or MachinePool.
+	MachineActionRemediate MachineActionType = MachineActionType("Remediate")
+)
+
+// MachineActionHistoryLimit is the number of actions kept in the action history of a machine.
+const MachineActionHistoryLimit = 10
+
+// MachineAutoRepairPolicy defines the action taken when IBM Cloud reports the instance of a machine as failed.
+type MachineAutoRepairPolicy string
+
+const (
+	// MachineAutoRepairPolicyNone leaves the failed instance in place, so it can be inspected or remediated by a MachineHealthCheck.
+	MachineAutoRepairPolicyNone MachineAutoRepairPolicy = MachineAutoRepairPolicy("None")
+	// MachineAutoRepairPolicyReplace deletes the owning Machine, so it gets replaced by its MachineSet or MachinePool.
+	MachineAutoRepairPolicyReplace MachineAutoRepairPolicy = MachineAutoRepairPolicy("Replace")
+)
+
+// PowerVSInstanceHealthCheck defines how the health of the instance of an IBMPowerVSMachine is checked.
+// The instance is unhealthy when it is in the ERROR or the SHUTOFF state, or when it is ACTIVE with one of the
+// unhealthy health statuses reported by Power VS.
+type PowerVSInstanceHealthCheck struct {
+	// interval is the interval at which the health of the instance is checked, defaults to 5m.
+	// +optional
+	Interval *metav1.Duration `json:"interval,omitempty"`
+
+	// unhealthyTimeout is how long the instance can be unhealthy before the InstanceHealthy condition gets the Error severity
+	// and the machine is failed if failOnUnhealthy is set, defaults to 5m, 0s does so as soon as the instance is unhealthy.
+	// +optional
+	UnhealthyTimeout *metav1.Duration `json:"unhealthyTimeout,omitempty"`
+
+	// unhealthyHealthStatuses are the health statuses which make an ACTIVE instance unhealthy, defaults to CRITICAL.
+	// Power VS reports the WARNING health status when it lost the RMC connection to the instance, e.g. when its operating system hangs,
+	// as well as for the operating systems which do not run RMC.
+	// +kubebuilder:validation:items:Enum=WARNING;CRITICAL
+	// +listType=set
+	// +optional
+	UnhealthyHealthStatuses []string `json:"unhealthyHealthStatuses,omitempty"`
+
+	// failOnUnhealthy sets the failure reason and message of the machine once the instance is unhealthy for longer than unhealthyTimeout.
+	// Cluster API copies them to the Machine, which marks it as failed, so it gets remediated by the MachineHealthChecks targeting it.
+	// the failure is terminal, the machine is not reconciled as healthy again even if the instance recovers.
+	// +optional
+	FailOnUnhealthy bool `json:"failOnUnhealthy,omitempty"`
+}
+
 // IBMCloudResourceReference represents an IBM Cloud resource.
 type IBMCloudResourceReference struct {
 	// id defines the IBM Cloud Resource ID.
@@ -385,6 +506,7 @@ type NetworkInterface struct {
 	SecurityGroups []VPCResource `json:"securityGroups,omitempty"`
 
 	// Subnet ID of the network interface.
+	// When omitted for a Machine within a failure domain, the cluster subnet within that zone is used.
 	Subnet string `json:"subnet,omitempty"`
 }
 
@@ -407,6 +529,53 @@ type VPCLoadBalancerBackendPoolMember struct {
 	Weight *int64 `json:"weight,omitempty"`
 }
 
+// VPCMachineReservationAffinityPolicy defines whether a VPC Machine (Instance) uses reserved capacity.
+type VPCMachineReservationAffinityPolicy string
+
+const (
+	// VPCMachineReservationAffinityPolicyAutomatic uses any reservation with an automatic affinity policy matching the profile and zone of the VPC Machine (Instance).
+	VPCMachineReservationAffinityPolicyAutomatic VPCMachineReservationAffinityPolicy = "Automatic"
+	// VPCMachineReservationAffinityPolicyDisabled never uses reserved capacity.
+	VPCMachineReservationAffinityPolicyDisabled VPCMachineReservationAffinityPolicy = "Disabled"
+	// VPCMachineReservationAffinityPolicyManual uses the reservation defined for the VPC Machine (Instance).
+	VPCMachineReservationAffinityPolicyManual VPCMachineReservationAffinityPolicy = "Manual"
+)
+
+// VPCMachineInterruptionPolicy defines the action taken when IBM Cloud reclaims the VPC Machine (Instance).
+type VPCMachineInterruptionPolicy string
+
+const (
+	// VPCMachineInterruptionPolicyNone recreates the VPC Machine (Instance) in place.
+	VPCMachineInterruptionPolicyNone VPCMachineInterruptionPolicy = "None"
+	// VPCMachineInterruptionPolicyDeleteMachine deletes the owning Machine, so it gets replaced by its MachineSet or MachinePool.
+	VPCMachineInterruptionPolicyDeleteMachine VPCMachineInterruptionPolicy = "DeleteMachine"
+)
+
+// VPCMachineCapacity represents a VPC Machine's pricing and capacity options.
+// +kubebuilder:validation:XValidation:rule="!has(self.reservation) || !has(self.reservationAffinityPolicy) || self.reservationAffinityPolicy == 'Manual'",message="reservation can only be used with the Manual reservationAffinityPolicy"
+// +kubebuilder:validation:XValidation:rule="!has(self.reservationAffinityPolicy) || self.reservationAffinityPolicy != 'Manual' || has(self.reservation)",message="reservation must be set for the Manual reservationAffinityPolicy"
+type VPCMachineCapacity struct {
+	// reservationAffinityPolicy defines whether the VPC Machine (Instance) uses reserved capacity, billed with committed-use pricing.
+	// Automatic uses any reservation with an automatic affinity policy matching the profile and zone of the instance,
+	// Manual uses the reservation defined in reservation and Disabled never uses reserved capacity.
+	// When omitted, Manual is used if a reservation is defined, otherwise IBM Cloud defaults apply.
+	// +kubebuilder:validation:Enum=Automatic;Disabled;Manual
+	// +optional
+	ReservationAffinityPolicy VPCMachineReservationAffinityPolicy `json:"reservationAffinityPolicy,omitempty"`
+
+	// reservation defines the reservation providing the capacity of the VPC Machine (Instance).
+	// +optional
+	Reservation *VPCResource `json:"reservation,omitempty"`
+
+	// interruptionPolicy defines the action taken when IBM Cloud reclaims the VPC Machine (Instance).
+	// With DeleteMachine the owning Machine is deleted, so it gets replaced by its MachineSet or MachinePool, once the instance was stopped by a host failure
+	// or its reservation expired, was deleted or lacks capacity. The Machines of the control plane and the Machines not owned by a MachineSet or a MachinePool are never deleted.
+	// +kubebuilder:validation:Enum=None;DeleteMachine
+	// +kubebuilder:default=None
+	// +optional
+	InterruptionPolicy VPCMachineInterruptionPolicy `json:"interruptionPolicy,omitempty"`
+}
+
 // VPCMachinePlacementTarget represents a VPC Machine's placement restrictions.
 // +kubebuilder:validation:XValidation:rule="(has(self.dedicatedHost) && !has(self.dedicatedHostGroup) && !has(self.placementGroup)) || (!has(self.dedicatedHost) && has(self.dedicatedHostGroup) && !has(self.placementGroup)) || (!has(self.dedicatedHost) && !has(self.dedicatedHostGroup) && has(self.placementGroup))",message="only one of dedicatedHost, dedicatedHostGroup, or placementGroup must be defined for machine placement"
 type VPCMachinePlacementTarget struct {
@@ -580,6 +749,12 @@ type ResourceStatus struct {
 	// ready defines whether the IBM Cloud resource is ready.
 	// +required
 	Ready bool `json:"ready"`
+
+	// controllerCreated indicates whether the resource is created by the controller.
+	// Only resources created by the controller are deleted along with the cluster.
+	// +kubebuilder:default=false
+	// +optional
+	ControllerCreated *bool `json:"controllerCreated,omitempty"`
 }
 
 // Set sets the ResourceStatus fields.
@@ -590,6 +765,168 @@ func (s *ResourceStatus) Set(resource ResourceStatus) {
 		s.Name = resource.Name
 	}
 	s.Ready = resource.Ready
+	// Once the resource is known to be created by the controller, do not lose track of it.
+	if s.ControllerCreated == nil || !*s.ControllerCreated {
+		s.ControllerCreated = resource.ControllerCreated
+	}
+}
+
+// ResourceSelector constrains the existing IBM Cloud resources considered when resolving resources by name.
+type ResourceSelector struct {
+	// tags are the user tags an existing resource must have attached, to be used when it is found by name.
+	// +kubebuilder:validation:MinItems=1
+	// +listType=set
+	// +required
+	Tags []string `json:"tags"`
+}
+
+// ResourceTags defines the user tags attached to the IBM Cloud resources created by the controller.
+type ResourceTags struct {
+	// userTags are the user tags attached to the resources created by the controller, along with the tags managed by the controller.
+	// a tag in the key:value form replaces the tags with the same key attached to the resources.
+	// +kubebuilder:validation:items:MinLength=1
+	// +kubebuilder:validation:items:MaxLength=128
+	// +listType=set
+	// +optional
+	UserTags []string `json:"userTags,omitempty"`
+
+	// prune detaches from the resources created by the controller the user tags which are neither managed by the controller
+	// nor listed in userTags. by default the tags attached by other systems are preserved, only the tags with the capibm- prefix
+	// and the tags sharing the key of a tag of userTags are detached.
+	// +optional
+	Prune bool `json:"prune,omitempty"`
+}
+
+// EnterpriseAccount defines a child account of an IBM Cloud enterprise, which is the target the resources are provisioned into.
+// +kubebuilder:validation:XValidation:rule="has(self.id) || has(self.name) || has(self.projectCRN)",message="an id, name or projectCRN must be provided"
+// +kubebuilder:validation:XValidation:rule="!has(self.name) || has(self.accountGroupID)",message="accountGroupID must be provided along with name"
+// +kubebuilder:validation:XValidation:rule="!has(self.projectCRN) || (!has(self.id) && !has(self.name))",message="projectCRN must not be provided along with id or name"
+type EnterpriseAccount struct {
+	// id is the ID of the child account.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	ID *string `json:"id,omitempty"`
+
+	// name is the name of the child account, looked up among the accounts of accountGroupID.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	Name *string `json:"name,omitempty"`
+
+	// accountGroupID is the ID of the enterprise account group the child account belongs to.
+	// when set, the child account must be a direct child of the account group.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	AccountGroupID *string `json:"accountGroupID,omitempty"`
+
+	// projectCRN is the CRN of the IBM Cloud project the resources are provisioned for, the child account is the account
+	// owning the project, in the format crn:v1:<cname>:<ctype>:project:<location>:a/<account-id>:<project-id>::.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	ProjectCRN *string `json:"projectCRN,omitempty"`
+
+	// trustedProfileName is the name of the IAM trusted profile of the child account assumed to provision the resources.
+	// the same trusted profile is typically created in every child account of an account group by assigning an IAM
+	// trusted profile template of the enterprise to the account group.
+	// +kubebuilder:validation:MinLength=1
+	TrustedProfileName string `json:"trustedProfileName"`
+}
+
+// ClusterGuardrails defines the budget of the instances of a cluster, enforced before the instance of a machine is created.
+// the instances of the machines of the cluster which already started to be created, and of the machines created earlier
+// which are still waiting for their instance, are counted against the budget.
+type ClusterGuardrails struct {
+	// maxInstances is the maximum number of instances of the cluster.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxInstances *int32 `json:"maxInstances,omitempty"`
+
+	// maxTotalCores is the maximum sum of the processors of the Power VS instances, or of the vCPUs of the profiles
+	// of the VPC instances, of the cluster. fractional values like 7.5 are allowed for Power VS clusters.
+	// +kubebuilder:validation:XIntOrString
+	// +optional
+	MaxTotalCores *intstr.IntOrString `json:"maxTotalCores,omitempty"`
+
+	// maxTotalMemoryGiB is the maximum sum of the memory of the instances of the cluster, in GiB.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxTotalMemoryGiB *int32 `json:"maxTotalMemoryGiB,omitempty"`
+}
+
+// ReconcileIntervals overrides the intervals at which the controllers reconcile the objects of a cluster,
+// which default to the intervals set with the flags of the controllers.
+type ReconcileIntervals struct {
+	// cluster overrides the intervals at which the infrastructure cluster is reconciled.
+	// +optional
+	Cluster *ReconcileInterval `json:"cluster,omitempty"`
+
+	// machines overrides the intervals at which the infrastructure machines of the cluster are reconciled.
+	// +optional
+	Machines *ReconcileInterval `json:"machines,omitempty"`
+
+	// images overrides the intervals at which the IBMPowerVSImages of the cluster are reconciled, only used by Power VS clusters.
+	// +optional
+	Images *ReconcileInterval `json:"images,omitempty"`
+}
+
+// ReconcileInterval defines the intervals at which objects are reconciled.
+type ReconcileInterval struct {
+	// requeueInterval is the interval at which objects waiting on IBM Cloud, like instances being provisioned or
+	// image import jobs in flight, are reconciled again. when omitted, each wait uses the interval of the controller.
+	// +optional
+	RequeueInterval *metav1.Duration `json:"requeueInterval,omitempty"`
+
+	// syncPeriod is the interval at which objects which are fully reconciled are reconciled again, to detect drift
+	// of their IBM Cloud resources. when omitted, they are reconciled again on changes and on the resync of the controller cache.
+	// +optional
+	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+}
+
+// ConnectivityCheck configures the connectivity self-test run from the management cluster once the infrastructure
+// of a cluster is ready. the test resolves the host of the control plane endpoint, opens TCP connections to its addresses
+// and, for Power VS clusters, checks the connections of the transit gateway. the results are reported in the
+// ControlPlaneEndpointResolved, ControlPlaneEndpointReachable and TransitGatewayRoutesReady conditions of the cluster,
+// the test is run again until all the probes passed.
+type ConnectivityCheck struct {
+	// timeout is the timeout of each probe of the test, defaults to 10s.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+
+	// retryInterval is the interval at which the test is run again while a probe fails, defaults to 1m.
+	// +optional
+	RetryInterval *metav1.Duration `json:"retryInterval,omitempty"`
+}
+
+// DNSSpec defines the DNS record registering the control plane endpoint of a cluster, so the endpoint keeps a stable
+// hostname when the load balancer serving it is recreated. the record is a CNAME record to the hostname of the load balancer,
+// or an A record when the load balancer is only known by its IP address.
+type DNSSpec struct {
+	// provider is the IBM Cloud service hosting the zone, DNSServices for a private zone or CIS for a public zone.
+	// +kubebuilder:validation:Enum=DNSServices;CIS
+	Provider DNSProvider `json:"provider"`
+
+	// instanceID is the GUID of the DNS Services instance, or the CRN of the CIS instance, hosting the zone.
+	// +kubebuilder:validation:MinLength=1
+	InstanceID string `json:"instanceID"`
+
+	// zoneID is the ID of the zone.
+	// +kubebuilder:validation:MinLength=1
+	ZoneID string `json:"zoneID"`
+
+	// hostname is the fully qualified name of the record, e.g. api.my-cluster.example.com, it must be a name of the zone.
+	// the hostname is used as the host of the control plane endpoint of the cluster.
+	// +kubebuilder:validation:MinLength=1
+	Hostname string `json:"hostname"`
+
+	// ttl is the time to live of the record in seconds, defaults to 300.
+	// +kubebuilder:validation:Minimum=60
+	// +optional
+	TTL *int64 `json:"ttl,omitempty"`
+
+	// adoptExistingRecord allows the controller to take over a record of the hostname it did not create.
+	// the adopted record is updated to point to the load balancer and is left in the zone when the cluster is deleted.
+	// when not set, a record of the hostname not created for the cluster fails the reconciliation, as it may serve another cluster.
+	// +optional
+	AdoptExistingRecord bool `json:"adoptExistingRecord,omitempty"`
 }
 
 // VPCResource represents a VPC resource.
@@ -605,3 +942,32 @@ type VPCResource struct {
 	// +optional
 	Name *string `json:"name,omitempty"`
 }
+
+// MachineAction records an action performed by the controller against the instance of a machine.
+type MachineAction struct {
+	// type of the action.
+	Type MachineActionType `json:"type"`
+
+	// time at which the action was performed.
+	Time metav1.Time `json:"time"`
+
+	// instanceID is the id of the instance the action was performed against.
+	// +optional
+	InstanceID string `json:"instanceID,omitempty"`
+
+	// succeeded is true when the action was accepted by IBM Cloud.
+	Succeeded bool `json:"succeeded"`
+
+	// message is a human readable description of the action or of its failure.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// ImageReferrer is an object referencing an image, like a machine booting from it.
+type ImageReferrer struct {
+	// kind of the referencing object.
+	Kind string `json:"kind"`
+
+	// name of the referencing object.
+	Name string `json:"name"`
+}
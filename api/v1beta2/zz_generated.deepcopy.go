@@ -22,7 +22,9 @@ package v1beta2
 
 import (
 	"k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"sigs.k8s.io/cluster-api/api/v1beta1"
 )
 
@@ -51,6 +53,162 @@ func (in *AdditionalListenerSpec) DeepCopy() *AdditionalListenerSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AddonResourceReference) DeepCopyInto(out *AddonResourceReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddonResourceReference.
+func (in *AddonResourceReference) DeepCopy() *AddonResourceReference {
+	if in == nil {
+		return nil
+	}
+	out := new(AddonResourceReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AddonSpec) DeepCopyInto(out *AddonSpec) {
+	*out = *in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]AddonResourceReference, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddonSpec.
+func (in *AddonSpec) DeepCopy() *AddonSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AddonSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *COSBucket) DeepCopyInto(out *COSBucket) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new COSBucket.
+func (in *COSBucket) DeepCopy() *COSBucket {
+	if in == nil {
+		return nil
+	}
+	out := new(COSBucket)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CalicoAddonSpec) DeepCopyInto(out *CalicoAddonSpec) {
+	*out = *in
+	in.AddonSpec.DeepCopyInto(&out.AddonSpec)
+	if in.MTU != nil {
+		in, out := &in.MTU, &out.MTU
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CalicoAddonSpec.
+func (in *CalicoAddonSpec) DeepCopy() *CalicoAddonSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CalicoAddonSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterAddons) DeepCopyInto(out *ClusterAddons) {
+	*out = *in
+	if in.CloudControllerManager != nil {
+		in, out := &in.CloudControllerManager, &out.CloudControllerManager
+		*out = new(AddonSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CSIDriver != nil {
+		in, out := &in.CSIDriver, &out.CSIDriver
+		*out = new(AddonSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Calico != nil {
+		in, out := &in.Calico, &out.Calico
+		*out = new(CalicoAddonSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAddons.
+func (in *ClusterAddons) DeepCopy() *ClusterAddons {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterAddons)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterGuardrails) DeepCopyInto(out *ClusterGuardrails) {
+	*out = *in
+	if in.MaxInstances != nil {
+		in, out := &in.MaxInstances, &out.MaxInstances
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxTotalCores != nil {
+		in, out := &in.MaxTotalCores, &out.MaxTotalCores
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.MaxTotalMemoryGiB != nil {
+		in, out := &in.MaxTotalMemoryGiB, &out.MaxTotalMemoryGiB
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterGuardrails.
+func (in *ClusterGuardrails) DeepCopy() *ClusterGuardrails {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterGuardrails)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectivityCheck) DeepCopyInto(out *ConnectivityCheck) {
+	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.RetryInterval != nil {
+		in, out := &in.RetryInterval, &out.RetryInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectivityCheck.
+func (in *ConnectivityCheck) DeepCopy() *ConnectivityCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectivityCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CosInstance) DeepCopyInto(out *CosInstance) {
 	*out = *in
@@ -106,6 +264,61 @@ func (in *DHCPServer) DeepCopy() *DHCPServer {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSSpec) DeepCopyInto(out *DNSSpec) {
+	*out = *in
+	if in.TTL != nil {
+		in, out := &in.TTL, &out.TTL
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSSpec.
+func (in *DNSSpec) DeepCopy() *DNSSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnterpriseAccount) DeepCopyInto(out *EnterpriseAccount) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.AccountGroupID != nil {
+		in, out := &in.AccountGroupID, &out.AccountGroupID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ProjectCRN != nil {
+		in, out := &in.ProjectCRN, &out.ProjectCRN
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnterpriseAccount.
+func (in *EnterpriseAccount) DeepCopy() *EnterpriseAccount {
+	if in == nil {
+		return nil
+	}
+	out := new(EnterpriseAccount)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IBMCloudCatalogOffering) DeepCopyInto(out *IBMCloudCatalogOffering) {
 	*out = *in
@@ -224,6 +437,16 @@ func (in *IBMPowerVSClusterSpec) DeepCopyInto(out *IBMPowerVSClusterSpec) {
 		*out = new(DHCPServer)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ManageDHCPNetwork != nil {
+		in, out := &in.ManageDHCPNetwork, &out.ManageDHCPNetwork
+		*out = new(bool)
+		**out = **in
+	}
+	if in.NetworkRef != nil {
+		in, out := &in.NetworkRef, &out.NetworkRef
+		*out = new(v1.LocalObjectReference)
+		**out = **in
+	}
 	out.ControlPlaneEndpoint = in.ControlPlaneEndpoint
 	if in.ServiceInstance != nil {
 		in, out := &in.ServiceInstance, &out.ServiceInstance
@@ -264,6 +487,16 @@ func (in *IBMPowerVSClusterSpec) DeepCopyInto(out *IBMPowerVSClusterSpec) {
 		*out = new(TransitGateway)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ManageTransitGateway != nil {
+		in, out := &in.ManageTransitGateway, &out.ManageTransitGateway
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TransitGatewayRef != nil {
+		in, out := &in.TransitGatewayRef, &out.TransitGatewayRef
+		*out = new(v1.LocalObjectReference)
+		**out = **in
+	}
 	if in.LoadBalancers != nil {
 		in, out := &in.LoadBalancers, &out.LoadBalancers
 		*out = make([]VPCLoadBalancerSpec, len(*in))
@@ -271,6 +504,16 @@ func (in *IBMPowerVSClusterSpec) DeepCopyInto(out *IBMPowerVSClusterSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.DNS != nil {
+		in, out := &in.DNS, &out.DNS
+		*out = new(DNSSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ManageLoadBalancer != nil {
+		in, out := &in.ManageLoadBalancer, &out.ManageLoadBalancer
+		*out = new(bool)
+		**out = **in
+	}
 	if in.CosInstance != nil {
 		in, out := &in.CosInstance, &out.CosInstance
 		*out = new(CosInstance)
@@ -281,6 +524,51 @@ func (in *IBMPowerVSClusterSpec) DeepCopyInto(out *IBMPowerVSClusterSpec) {
 		*out = new(Ignition)
 		**out = **in
 	}
+	if in.Addons != nil {
+		in, out := &in.Addons, &out.Addons
+		*out = new(ClusterAddons)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CredentialsSecretRef != nil {
+		in, out := &in.CredentialsSecretRef, &out.CredentialsSecretRef
+		*out = new(v1.LocalObjectReference)
+		**out = **in
+	}
+	if in.EnterpriseAccount != nil {
+		in, out := &in.EnterpriseAccount, &out.EnterpriseAccount
+		*out = new(EnterpriseAccount)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PrivateCloud != nil {
+		in, out := &in.PrivateCloud, &out.PrivateCloud
+		*out = new(PowerVSPrivateCloud)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = new(ResourceTags)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Guardrails != nil {
+		in, out := &in.Guardrails, &out.Guardrails
+		*out = new(ClusterGuardrails)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ReconcileIntervals != nil {
+		in, out := &in.ReconcileIntervals, &out.ReconcileIntervals
+		*out = new(ReconcileIntervals)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ConnectivityCheck != nil {
+		in, out := &in.ConnectivityCheck, &out.ConnectivityCheck
+		*out = new(ConnectivityCheck)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SSHKey != nil {
+		in, out := &in.SSHKey, &out.SSHKey
+		*out = new(PowerVSSSHKey)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMPowerVSClusterSpec.
@@ -352,6 +640,23 @@ func (in *IBMPowerVSClusterStatus) DeepCopyInto(out *IBMPowerVSClusterStatus) {
 			(*out)[key] = *val.DeepCopy()
 		}
 	}
+	if in.SSHKey != nil {
+		in, out := &in.SSHKey, &out.SSHKey
+		*out = new(ResourceReference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DNSRecord != nil {
+		in, out := &in.DNSRecord, &out.DNSRecord
+		*out = new(ResourceReference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FailureDomains != nil {
+		in, out := &in.FailureDomains, &out.FailureDomains
+		*out = make(v1beta1.FailureDomains, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make(v1beta1.Conditions, len(*in))
@@ -544,6 +849,31 @@ func (in *IBMPowerVSImageSpec) DeepCopyInto(out *IBMPowerVSImageSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.SourceImage != nil {
+		in, out := &in.SourceImage, &out.SourceImage
+		*out = new(IBMPowerVSResourceReference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SourceServiceInstance != nil {
+		in, out := &in.SourceServiceInstance, &out.SourceServiceInstance
+		*out = new(IBMPowerVSResourceReference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CaptureInstance != nil {
+		in, out := &in.CaptureInstance, &out.CaptureInstance
+		*out = new(IBMPowerVSResourceReference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CredentialsSecretRef != nil {
+		in, out := &in.CredentialsSecretRef, &out.CredentialsSecretRef
+		*out = new(v1.LocalObjectReference)
+		**out = **in
+	}
+	if in.Export != nil {
+		in, out := &in.Export, &out.Export
+		*out = new(PowerVSImageExport)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMPowerVSImageSpec.
@@ -559,6 +889,16 @@ func (in *IBMPowerVSImageSpec) DeepCopy() *IBMPowerVSImageSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IBMPowerVSImageStatus) DeepCopyInto(out *IBMPowerVSImageStatus) {
 	*out = *in
+	if in.Export != nil {
+		in, out := &in.Export, &out.Export
+		*out = new(PowerVSImageExportStatus)
+		**out = **in
+	}
+	if in.SourceExport != nil {
+		in, out := &in.SourceExport, &out.SourceExport
+		*out = new(PowerVSImageExportStatus)
+		**out = **in
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make(v1beta1.Conditions, len(*in))
@@ -566,6 +906,11 @@ func (in *IBMPowerVSImageStatus) DeepCopyInto(out *IBMPowerVSImageStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.UsedBy != nil {
+		in, out := &in.UsedBy, &out.UsedBy
+		*out = make([]ImageReferrer, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMPowerVSImageStatus.
@@ -657,11 +1002,78 @@ func (in *IBMPowerVSMachineSpec) DeepCopyInto(out *IBMPowerVSMachineSpec) {
 	}
 	out.Processors = in.Processors
 	in.Network.DeepCopyInto(&out.Network)
+	if in.NetworkRef != nil {
+		in, out := &in.NetworkRef, &out.NetworkRef
+		*out = new(v1.LocalObjectReference)
+		**out = **in
+	}
+	if in.AdditionalNetworks != nil {
+		in, out := &in.AdditionalNetworks, &out.AdditionalNetworks
+		*out = make([]PowerVSNetworkAttachment, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.ProviderID != nil {
 		in, out := &in.ProviderID, &out.ProviderID
 		*out = new(string)
 		**out = **in
 	}
+	if in.NTPServers != nil {
+		in, out := &in.NTPServers, &out.NTPServers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SharedProcessorPool != nil {
+		in, out := &in.SharedProcessorPool, &out.SharedProcessorPool
+		*out = new(IBMPowerVSResourceReference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PlacementGroup != nil {
+		in, out := &in.PlacementGroup, &out.PlacementGroup
+		*out = new(IBMPowerVSResourceReference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HealthCheck != nil {
+		in, out := &in.HealthCheck, &out.HealthCheck
+		*out = new(PowerVSInstanceHealthCheck)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ShutdownTimeout != nil {
+		in, out := &in.ShutdownTimeout, &out.ShutdownTimeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.AdditionalVolumes != nil {
+		in, out := &in.AdditionalVolumes, &out.AdditionalVolumes
+		*out = make([]*PowerVSVolume, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(PowerVSVolume)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.UserTags != nil {
+		in, out := &in.UserTags, &out.UserTags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NodeLabels != nil {
+		in, out := &in.NodeLabels, &out.NodeLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.NodeTaints != nil {
+		in, out := &in.NodeTaints, &out.NodeTaints
+		*out = make([]v1.Taint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMPowerVSMachineSpec.
@@ -709,6 +1121,23 @@ func (in *IBMPowerVSMachineStatus) DeepCopyInto(out *IBMPowerVSMachineStatus) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Networks != nil {
+		in, out := &in.Networks, &out.Networks
+		*out = make([]PowerVSMachineNetworkStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.DataVolumes != nil {
+		in, out := &in.DataVolumes, &out.DataVolumes
+		*out = make([]PowerVSMachineVolumeStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.ActionHistory != nil {
+		in, out := &in.ActionHistory, &out.ActionHistory
+		*out = make([]MachineAction, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMPowerVSMachineStatus.
@@ -835,12 +1264,154 @@ func (in *IBMPowerVSMachineTemplateStatus) DeepCopy() *IBMPowerVSMachineTemplate
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IBMPowerVSResourceReference) DeepCopyInto(out *IBMPowerVSResourceReference) {
+func (in *IBMPowerVSNetwork) DeepCopyInto(out *IBMPowerVSNetwork) {
 	*out = *in
-	if in.ID != nil {
-		in, out := &in.ID, &out.ID
-		*out = new(string)
-		**out = **in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMPowerVSNetwork.
+func (in *IBMPowerVSNetwork) DeepCopy() *IBMPowerVSNetwork {
+	if in == nil {
+		return nil
+	}
+	out := new(IBMPowerVSNetwork)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IBMPowerVSNetwork) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IBMPowerVSNetworkList) DeepCopyInto(out *IBMPowerVSNetworkList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]IBMPowerVSNetwork, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMPowerVSNetworkList.
+func (in *IBMPowerVSNetworkList) DeepCopy() *IBMPowerVSNetworkList {
+	if in == nil {
+		return nil
+	}
+	out := new(IBMPowerVSNetworkList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IBMPowerVSNetworkList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IBMPowerVSNetworkSpec) DeepCopyInto(out *IBMPowerVSNetworkSpec) {
+	*out = *in
+	in.ServiceInstance.DeepCopyInto(&out.ServiceInstance)
+	if in.Zone != nil {
+		in, out := &in.Zone, &out.Zone
+		*out = new(string)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.CIDR != nil {
+		in, out := &in.CIDR, &out.CIDR
+		*out = new(string)
+		**out = **in
+	}
+	if in.Gateway != nil {
+		in, out := &in.Gateway, &out.Gateway
+		*out = new(string)
+		**out = **in
+	}
+	if in.DNSServers != nil {
+		in, out := &in.DNSServers, &out.DNSServers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DHCPServer != nil {
+		in, out := &in.DHCPServer, &out.DHCPServer
+		*out = new(DHCPServer)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CredentialsSecretRef != nil {
+		in, out := &in.CredentialsSecretRef, &out.CredentialsSecretRef
+		*out = new(v1.LocalObjectReference)
+		**out = **in
+	}
+	if in.EnterpriseAccount != nil {
+		in, out := &in.EnterpriseAccount, &out.EnterpriseAccount
+		*out = new(EnterpriseAccount)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMPowerVSNetworkSpec.
+func (in *IBMPowerVSNetworkSpec) DeepCopy() *IBMPowerVSNetworkSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(IBMPowerVSNetworkSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IBMPowerVSNetworkStatus) DeepCopyInto(out *IBMPowerVSNetworkStatus) {
+	*out = *in
+	if in.ControllerCreated != nil {
+		in, out := &in.ControllerCreated, &out.ControllerCreated
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(v1beta1.Conditions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMPowerVSNetworkStatus.
+func (in *IBMPowerVSNetworkStatus) DeepCopy() *IBMPowerVSNetworkStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(IBMPowerVSNetworkStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IBMPowerVSResourceReference) DeepCopyInto(out *IBMPowerVSResourceReference) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
 	}
 	if in.Name != nil {
 		in, out := &in.Name, &out.Name
@@ -864,6 +1435,154 @@ func (in *IBMPowerVSResourceReference) DeepCopy() *IBMPowerVSResourceReference {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IBMTransitGateway) DeepCopyInto(out *IBMTransitGateway) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMTransitGateway.
+func (in *IBMTransitGateway) DeepCopy() *IBMTransitGateway {
+	if in == nil {
+		return nil
+	}
+	out := new(IBMTransitGateway)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IBMTransitGateway) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IBMTransitGatewayList) DeepCopyInto(out *IBMTransitGatewayList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]IBMTransitGateway, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMTransitGatewayList.
+func (in *IBMTransitGatewayList) DeepCopy() *IBMTransitGatewayList {
+	if in == nil {
+		return nil
+	}
+	out := new(IBMTransitGatewayList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IBMTransitGatewayList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IBMTransitGatewaySpec) DeepCopyInto(out *IBMTransitGatewaySpec) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.GlobalRouting != nil {
+		in, out := &in.GlobalRouting, &out.GlobalRouting
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ResourceGroup != nil {
+		in, out := &in.ResourceGroup, &out.ResourceGroup
+		*out = new(IBMPowerVSResourceReference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Connections != nil {
+		in, out := &in.Connections, &out.Connections
+		*out = make([]TransitGatewayConnection, len(*in))
+		copy(*out, *in)
+	}
+	if in.CredentialsSecretRef != nil {
+		in, out := &in.CredentialsSecretRef, &out.CredentialsSecretRef
+		*out = new(v1.LocalObjectReference)
+		**out = **in
+	}
+	if in.EnterpriseAccount != nil {
+		in, out := &in.EnterpriseAccount, &out.EnterpriseAccount
+		*out = new(EnterpriseAccount)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMTransitGatewaySpec.
+func (in *IBMTransitGatewaySpec) DeepCopy() *IBMTransitGatewaySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(IBMTransitGatewaySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IBMTransitGatewayStatus) DeepCopyInto(out *IBMTransitGatewayStatus) {
+	*out = *in
+	if in.ControllerCreated != nil {
+		in, out := &in.ControllerCreated, &out.ControllerCreated
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Connections != nil {
+		in, out := &in.Connections, &out.Connections
+		*out = make(map[string]ResourceReference, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.ReferencedBy != nil {
+		in, out := &in.ReferencedBy, &out.ReferencedBy
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(v1beta1.Conditions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMTransitGatewayStatus.
+func (in *IBMTransitGatewayStatus) DeepCopy() *IBMTransitGatewayStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(IBMTransitGatewayStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IBMVPCCluster) DeepCopyInto(out *IBMVPCCluster) {
 	*out = *in
@@ -932,6 +1651,11 @@ func (in *IBMVPCClusterSpec) DeepCopyInto(out *IBMVPCClusterSpec) {
 		*out = new(VPCLoadBalancerSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DNS != nil {
+		in, out := &in.DNS, &out.DNS
+		*out = new(DNSSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Image != nil {
 		in, out := &in.Image, &out.Image
 		*out = new(ImageSpec)
@@ -942,6 +1666,46 @@ func (in *IBMVPCClusterSpec) DeepCopyInto(out *IBMVPCClusterSpec) {
 		*out = new(VPCNetworkSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ResourceSelector != nil {
+		in, out := &in.ResourceSelector, &out.ResourceSelector
+		*out = new(ResourceSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = new(ResourceTags)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Guardrails != nil {
+		in, out := &in.Guardrails, &out.Guardrails
+		*out = new(ClusterGuardrails)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ReconcileIntervals != nil {
+		in, out := &in.ReconcileIntervals, &out.ReconcileIntervals
+		*out = new(ReconcileIntervals)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ConnectivityCheck != nil {
+		in, out := &in.ConnectivityCheck, &out.ConnectivityCheck
+		*out = new(ConnectivityCheck)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CredentialsSecretRef != nil {
+		in, out := &in.CredentialsSecretRef, &out.CredentialsSecretRef
+		*out = new(v1.LocalObjectReference)
+		**out = **in
+	}
+	if in.EnterpriseAccount != nil {
+		in, out := &in.EnterpriseAccount, &out.EnterpriseAccount
+		*out = new(EnterpriseAccount)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BootstrapDataBucket != nil {
+		in, out := &in.BootstrapDataBucket, &out.BootstrapDataBucket
+		*out = new(COSBucket)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMVPCClusterSpec.
@@ -975,6 +1739,23 @@ func (in *IBMVPCClusterStatus) DeepCopyInto(out *IBMVPCClusterStatus) {
 	}
 	in.Subnet.DeepCopyInto(&out.Subnet)
 	in.VPCEndpoint.DeepCopyInto(&out.VPCEndpoint)
+	if in.LoadBalancerPoolMembers != nil {
+		in, out := &in.LoadBalancerPoolMembers, &out.LoadBalancerPoolMembers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DNSRecord != nil {
+		in, out := &in.DNSRecord, &out.DNSRecord
+		*out = new(ResourceReference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FailureDomains != nil {
+		in, out := &in.FailureDomains, &out.FailureDomains
+		*out = make(v1beta1.FailureDomains, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make(v1beta1.Conditions, len(*in))
@@ -1157,6 +1938,16 @@ func (in *IBMVPCMachineSpec) DeepCopyInto(out *IBMVPCMachineSpec) {
 		*out = new(VPCMachinePlacementTarget)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Capacity != nil {
+		in, out := &in.Capacity, &out.Capacity
+		*out = new(VPCMachineCapacity)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ShutdownTimeout != nil {
+		in, out := &in.ShutdownTimeout, &out.ShutdownTimeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 	if in.Image != nil {
 		in, out := &in.Image, &out.Image
 		*out = new(IBMVPCResourceReference)
@@ -1174,12 +1965,30 @@ func (in *IBMVPCMachineSpec) DeepCopyInto(out *IBMVPCMachineSpec) {
 		*out = new(VPCVolume)
 		**out = **in
 	}
+	if in.AdditionalVolumes != nil {
+		in, out := &in.AdditionalVolumes, &out.AdditionalVolumes
+		*out = make([]*VPCVolume, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(VPCVolume)
+				**out = **in
+			}
+		}
+	}
 	if in.ProviderID != nil {
 		in, out := &in.ProviderID, &out.ProviderID
 		*out = new(string)
 		**out = **in
 	}
 	in.PrimaryNetworkInterface.DeepCopyInto(&out.PrimaryNetworkInterface)
+	if in.SecurityGroups != nil {
+		in, out := &in.SecurityGroups, &out.SecurityGroups
+		*out = make([]VPCResource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.SSHKeys != nil {
 		in, out := &in.SSHKeys, &out.SSHKeys
 		*out = make([]*IBMVPCResourceReference, len(*in))
@@ -1191,6 +2000,25 @@ func (in *IBMVPCMachineSpec) DeepCopyInto(out *IBMVPCMachineSpec) {
 			}
 		}
 	}
+	if in.UserTags != nil {
+		in, out := &in.UserTags, &out.UserTags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NodeLabels != nil {
+		in, out := &in.NodeLabels, &out.NodeLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.NodeTaints != nil {
+		in, out := &in.NodeTaints, &out.NodeTaints
+		*out = make([]v1.Taint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMVPCMachineSpec.
@@ -1235,6 +2063,13 @@ func (in *IBMVPCMachineStatus) DeepCopyInto(out *IBMVPCMachineStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ActionHistory != nil {
+		in, out := &in.ActionHistory, &out.ActionHistory
+		*out = make([]MachineAction, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMVPCMachineStatus.
@@ -1400,6 +2235,21 @@ func (in *Ignition) DeepCopy() *Ignition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageReferrer) DeepCopyInto(out *ImageReferrer) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageReferrer.
+func (in *ImageReferrer) DeepCopy() *ImageReferrer {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageReferrer)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ImageSpec) DeepCopyInto(out *ImageSpec) {
 	*out = *in
@@ -1455,6 +2305,22 @@ func (in *ImageSpec) DeepCopy() *ImageSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineAction) DeepCopyInto(out *MachineAction) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineAction.
+func (in *MachineAction) DeepCopy() *MachineAction {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineAction)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NetworkInterface) DeepCopyInto(out *NetworkInterface) {
 	*out = *in
@@ -1477,6 +2343,227 @@ func (in *NetworkInterface) DeepCopy() *NetworkInterface {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PowerVSImageExport) DeepCopyInto(out *PowerVSImageExport) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PowerVSImageExport.
+func (in *PowerVSImageExport) DeepCopy() *PowerVSImageExport {
+	if in == nil {
+		return nil
+	}
+	out := new(PowerVSImageExport)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PowerVSImageExportStatus) DeepCopyInto(out *PowerVSImageExportStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PowerVSImageExportStatus.
+func (in *PowerVSImageExportStatus) DeepCopy() *PowerVSImageExportStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PowerVSImageExportStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PowerVSInstanceHealthCheck) DeepCopyInto(out *PowerVSInstanceHealthCheck) {
+	*out = *in
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.UnhealthyTimeout != nil {
+		in, out := &in.UnhealthyTimeout, &out.UnhealthyTimeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.UnhealthyHealthStatuses != nil {
+		in, out := &in.UnhealthyHealthStatuses, &out.UnhealthyHealthStatuses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PowerVSInstanceHealthCheck.
+func (in *PowerVSInstanceHealthCheck) DeepCopy() *PowerVSInstanceHealthCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(PowerVSInstanceHealthCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PowerVSMachineNetworkStatus) DeepCopyInto(out *PowerVSMachineNetworkStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PowerVSMachineNetworkStatus.
+func (in *PowerVSMachineNetworkStatus) DeepCopy() *PowerVSMachineNetworkStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PowerVSMachineNetworkStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PowerVSMachineVolumeStatus) DeepCopyInto(out *PowerVSMachineVolumeStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PowerVSMachineVolumeStatus.
+func (in *PowerVSMachineVolumeStatus) DeepCopy() *PowerVSMachineVolumeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PowerVSMachineVolumeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PowerVSNetworkAttachment) DeepCopyInto(out *PowerVSNetworkAttachment) {
+	*out = *in
+	in.Network.DeepCopyInto(&out.Network)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PowerVSNetworkAttachment.
+func (in *PowerVSNetworkAttachment) DeepCopy() *PowerVSNetworkAttachment {
+	if in == nil {
+		return nil
+	}
+	out := new(PowerVSNetworkAttachment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PowerVSPrivateCloud) DeepCopyInto(out *PowerVSPrivateCloud) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PowerVSPrivateCloud.
+func (in *PowerVSPrivateCloud) DeepCopy() *PowerVSPrivateCloud {
+	if in == nil {
+		return nil
+	}
+	out := new(PowerVSPrivateCloud)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PowerVSSSHKey) DeepCopyInto(out *PowerVSSSHKey) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.PublicKey != nil {
+		in, out := &in.PublicKey, &out.PublicKey
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PowerVSSSHKey.
+func (in *PowerVSSSHKey) DeepCopy() *PowerVSSSHKey {
+	if in == nil {
+		return nil
+	}
+	out := new(PowerVSSSHKey)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PowerVSVolume) DeepCopyInto(out *PowerVSVolume) {
+	*out = *in
+	if in.DeleteVolumeOnInstanceDelete != nil {
+		in, out := &in.DeleteVolumeOnInstanceDelete, &out.DeleteVolumeOnInstanceDelete
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PowerVSVolume.
+func (in *PowerVSVolume) DeepCopy() *PowerVSVolume {
+	if in == nil {
+		return nil
+	}
+	out := new(PowerVSVolume)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReconcileInterval) DeepCopyInto(out *ReconcileInterval) {
+	*out = *in
+	if in.RequeueInterval != nil {
+		in, out := &in.RequeueInterval, &out.RequeueInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.SyncPeriod != nil {
+		in, out := &in.SyncPeriod, &out.SyncPeriod
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReconcileInterval.
+func (in *ReconcileInterval) DeepCopy() *ReconcileInterval {
+	if in == nil {
+		return nil
+	}
+	out := new(ReconcileInterval)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReconcileIntervals) DeepCopyInto(out *ReconcileIntervals) {
+	*out = *in
+	if in.Cluster != nil {
+		in, out := &in.Cluster, &out.Cluster
+		*out = new(ReconcileInterval)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Machines != nil {
+		in, out := &in.Machines, &out.Machines
+		*out = new(ReconcileInterval)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Images != nil {
+		in, out := &in.Images, &out.Images
+		*out = new(ReconcileInterval)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReconcileIntervals.
+func (in *ReconcileIntervals) DeepCopy() *ReconcileIntervals {
+	if in == nil {
+		return nil
+	}
+	out := new(ReconcileIntervals)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceReference) DeepCopyInto(out *ResourceReference) {
 	*out = *in
@@ -1502,6 +2589,46 @@ func (in *ResourceReference) DeepCopy() *ResourceReference {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceSelector) DeepCopyInto(out *ResourceSelector) {
+	*out = *in
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceSelector.
+func (in *ResourceSelector) DeepCopy() *ResourceSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceSelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceTags) DeepCopyInto(out *ResourceTags) {
+	*out = *in
+	if in.UserTags != nil {
+		in, out := &in.UserTags, &out.UserTags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceTags.
+func (in *ResourceTags) DeepCopy() *ResourceTags {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceTags)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceStatus) DeepCopyInto(out *ResourceStatus) {
 	*out = *in
@@ -1510,6 +2637,11 @@ func (in *ResourceStatus) DeepCopyInto(out *ResourceStatus) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.ControllerCreated != nil {
+		in, out := &in.ControllerCreated, &out.ControllerCreated
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceStatus.
@@ -1587,6 +2719,21 @@ func (in *TransitGateway) DeepCopy() *TransitGateway {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewayConnection) DeepCopyInto(out *TransitGatewayConnection) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayConnection.
+func (in *TransitGatewayConnection) DeepCopy() *TransitGatewayConnection {
+	if in == nil {
+		return nil
+	}
+	out := new(TransitGatewayConnection)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TransitGatewayStatus) DeepCopyInto(out *TransitGatewayStatus) {
 	*out = *in
@@ -1818,6 +2965,26 @@ func (in *VPCLoadBalancerStatus) DeepCopy() *VPCLoadBalancerStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCMachineCapacity) DeepCopyInto(out *VPCMachineCapacity) {
+	*out = *in
+	if in.Reservation != nil {
+		in, out := &in.Reservation, &out.Reservation
+		*out = new(VPCResource)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCMachineCapacity.
+func (in *VPCMachineCapacity) DeepCopy() *VPCMachineCapacity {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCMachineCapacity)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VPCMachinePlacementTarget) DeepCopyInto(out *VPCMachinePlacementTarget) {
 	*out = *in
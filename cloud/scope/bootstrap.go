@@ -0,0 +1,110 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scope
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	cosSession "github.com/IBM/ibm-cos-sdk-go/aws/session"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/cos"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/endpoints"
+)
+
+const (
+	// vpcUserDataLimit is the maximum size in bytes of the user data of a VPC instance.
+	vpcUserDataLimit = 64 * 1024
+	// powerVSUserDataLimit is the maximum size in bytes of the base64 encoded user data of a Power VS instance.
+	powerVSUserDataLimit = 63 * 1024
+
+	// bootstrapDataURLExpiry is the duration for which the pre-signed URL of the bootstrap data staged in COS is valid.
+	bootstrapDataURLExpiry = time.Hour
+)
+
+// errCOSHMACCredentialsNotFound is returned when the credentials secret does not hold the HMAC credentials of the COS.
+var errCOSHMACCredentialsNotFound = fmt.Errorf("credentials secret must hold the %s and %s keys with the HMAC credentials of the COS", COSAccessKey, COSSecretKey)
+
+// getCOSHMACCredentials returns the HMAC access key and secret key of the COS held by the credentials secret referenced by the cluster.
+func getCOSHMACCredentials(c client.Client, namespace string, credentialsSecretRef *corev1.LocalObjectReference) (string, string, error) {
+	credentialsSecretRef = resolveCredentialsSecretRef(credentialsSecretRef)
+	if credentialsSecretRef == nil {
+		return "", "", errCOSHMACCredentialsNotFound
+	}
+
+	secret := &corev1.Secret{}
+	key := client.ObjectKey{Namespace: namespace, Name: credentialsSecretRef.Name}
+	if err := c.Get(context.TODO(), key, secret); err != nil {
+		return "", "", fmt.Errorf("failed to get credentials secret %s: %w", key, err)
+	}
+	accessKey, secretKey := secret.Data[COSAccessKey], secret.Data[COSSecretKey]
+	if len(accessKey) == 0 || len(secretKey) == 0 {
+		return "", "", errCOSHMACCredentialsNotFound
+	}
+	return string(accessKey), string(secretKey), nil
+}
+
+// newCOSHMACClient returns a COS client for the region authenticating with the HMAC credentials, which can pre-sign URLs.
+func newCOSHMACClient(region string, serviceEndpoint []endpoints.ServiceEndpoint, accessKey, secretKey string) (cos.Cos, error) {
+	endpoint := fmt.Sprintf("s3.%s.%s", region, cosURLDomain)
+	if cosServiceEndpoint := endpoints.FetchRegionalEndpoint(string(endpoints.COS), region, serviceEndpoint); cosServiceEndpoint != "" {
+		endpoint = cosServiceEndpoint
+	}
+	cosClient, err := cos.NewServiceWithHMAC(cos.ServiceOptions{
+		Options: &cosSession.Options{
+			Config: aws.Config{
+				Endpoint: &endpoint,
+				Region:   &region,
+			},
+		},
+	}, accessKey, secretKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create COS client: %w", err)
+	}
+	return cosClient, nil
+}
+
+// stageBootstrapData uploads the bootstrap data into the COS bucket and returns the pre-signed URL to fetch it.
+func stageBootstrapData(cosClient cos.Cos, bucket, key string, data []byte) (string, error) {
+	if len(data) == 0 {
+		return "", errors.New("user data is empty")
+	}
+	if _, err := cosClient.PutObject(&s3.PutObjectInput{
+		Body:   aws.ReadSeekCloser(bytes.NewReader(data)),
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	}); err != nil {
+		return "", fmt.Errorf("failed to push object to COS bucket %w", err)
+	}
+	objectURL, err := cosClient.PresignGetObject(&s3.GetObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	}, bootstrapDataURLExpiry)
+	if err != nil {
+		return "", fmt.Errorf("failed to pre-sign URL of COS object: %w", err)
+	}
+	return objectURL, nil
+}
@@ -20,13 +20,16 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 
 	"github.com/go-logr/logr"
 
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -37,6 +40,7 @@ import (
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/utils"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/vpc"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/endpoints"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/names"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/record"
 )
 
@@ -85,7 +89,12 @@ func NewClusterScope(params ClusterScopeParams) (*ClusterScope, error) {
 	// Fetch the service endpoint.
 	svcEndpoint := endpoints.FetchVPCEndpoint(params.IBMVPCCluster.Spec.Region, params.ServiceEndpoint)
 
-	vpcClient, err := vpc.NewService(svcEndpoint)
+	auth, err := getAuthenticator(params.Client, params.IBMVPCCluster.Namespace, params.IBMVPCCluster.Spec.CredentialsSecretRef, params.IBMVPCCluster.Spec.EnterpriseAccount)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create authenticator: %w", err)
+	}
+
+	vpcClient, err := vpc.NewServiceWithAuthenticator(svcEndpoint, auth)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create IBM VPC session: %w", err)
 	}
@@ -485,24 +494,26 @@ func (s *ClusterScope) CreateLoadBalancer() (*vpcv1.LoadBalancer, error) {
 		return nil, fmt.Errorf("error subnet required for load balancer creation")
 	}
 
-	options.SetPools([]vpcv1.LoadBalancerPoolPrototype{
-		{
+	// Every listener forwards to a pool of its own, the members of the pools are the control plane machines listening on the port of the listener.
+	var pools []vpcv1.LoadBalancerPoolPrototype
+	var listeners []vpcv1.LoadBalancerListenerPrototypeLoadBalancerContext
+	for _, pool := range s.controlPlaneLoadBalancerPools() {
+		pools = append(pools, vpcv1.LoadBalancerPoolPrototype{
 			Algorithm:     core.StringPtr("round_robin"),
 			HealthMonitor: &vpcv1.LoadBalancerPoolHealthMonitorPrototype{Delay: core.Int64Ptr(5), MaxRetries: core.Int64Ptr(2), Timeout: core.Int64Ptr(2), Type: core.StringPtr("tcp")},
-			Name:          core.StringPtr(s.IBMVPCCluster.Spec.ControlPlaneLoadBalancer.Name + "-pool"),
-			Protocol:      core.StringPtr("tcp"),
-		},
-	})
-
-	options.SetListeners([]vpcv1.LoadBalancerListenerPrototypeLoadBalancerContext{
-		{
-			Protocol: core.StringPtr("tcp"),
-			Port:     core.Int64Ptr(int64(s.APIServerPort())),
+			Name:          core.StringPtr(pool.name),
+			Protocol:      core.StringPtr(pool.protocol),
+		})
+		listeners = append(listeners, vpcv1.LoadBalancerListenerPrototypeLoadBalancerContext{
+			Protocol: core.StringPtr(pool.protocol),
+			Port:     core.Int64Ptr(pool.port),
 			DefaultPool: &vpcv1.LoadBalancerPoolIdentityByName{
-				Name: core.StringPtr(s.IBMVPCCluster.Spec.ControlPlaneLoadBalancer.Name + "-pool"),
+				Name: core.StringPtr(pool.name),
 			},
-		},
-	})
+		})
+	}
+	options.SetPools(pools)
+	options.SetListeners(listeners)
 
 	loadBalancer, _, err := s.IBMVPCClient.CreateLoadBalancer(options)
 	if err != nil {
@@ -647,6 +658,224 @@ func (s *ClusterScope) DeleteLoadBalancer() (bool, error) {
 	return deleted, nil
 }
 
+// controlPlaneLoadBalancerPool is a pool of the control plane load balancer along with the port and protocol of its listener.
+type controlPlaneLoadBalancerPool struct {
+	name     string
+	port     int64
+	protocol string
+}
+
+// controlPlaneLoadBalancerPools returns the pools of the control plane load balancer, the pool of the API server followed by
+// the pools of the additional listeners.
+func (s *ClusterScope) controlPlaneLoadBalancerPools() []controlPlaneLoadBalancerPool {
+	name := ""
+	if s.IBMVPCCluster.Spec.ControlPlaneLoadBalancer != nil {
+		name = s.IBMVPCCluster.Spec.ControlPlaneLoadBalancer.Name
+	}
+	pools := []controlPlaneLoadBalancerPool{{name: names.Normalize(name+"-pool", names.VPCMaxLength), port: int64(s.APIServerPort()), protocol: vpcv1.LoadBalancerListenerProtocolTCPConst}}
+	if s.IBMVPCCluster.Spec.ControlPlaneLoadBalancer == nil {
+		return pools
+	}
+	for _, listener := range s.IBMVPCCluster.Spec.ControlPlaneLoadBalancer.AdditionalListeners {
+		protocol := vpcv1.LoadBalancerListenerProtocolTCPConst
+		if listener.Protocol != nil {
+			protocol = string(*listener.Protocol)
+		}
+		pools = append(pools, controlPlaneLoadBalancerPool{name: additionalListenerPoolName(name, listener), port: listener.Port, protocol: protocol})
+	}
+	return pools
+}
+
+// additionalListenerPoolName returns the name of the pool the additional listener forwards to.
+func additionalListenerPoolName(loadBalancerName string, listener infrav1beta2.AdditionalListenerSpec) string {
+	if listener.DefaultPoolName != nil {
+		return *listener.DefaultPoolName
+	}
+	return names.Normalize(fmt.Sprintf("%s-pool-%d", loadBalancerName, listener.Port), names.VPCMaxLength)
+}
+
+// controlPlaneLoadBalancerPoolMemberTargets returns the internal IPs of the control plane machines which should be members of
+// the pools of the control plane load balancer, along with the internal IPs of the control plane machines whose members are
+// managed by the machines themselves with loadBalancerPoolMembers and hence must be left untouched, and the internal IPs of
+// all the control plane machines.
+func (s *ClusterScope) controlPlaneLoadBalancerPoolMemberTargets() ([]string, map[string]bool, map[string]bool, error) {
+	opts := []client.ListOption{
+		client.InNamespace(s.IBMVPCCluster.Namespace),
+		client.MatchingLabels{capiv1beta1.ClusterNameLabel: s.Cluster.Name},
+		client.HasLabels{capiv1beta1.MachineControlPlaneNameLabel},
+	}
+	machineList := &capiv1beta1.MachineList{}
+	if err := s.Client.List(context.TODO(), machineList, opts...); err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to list control plane machines: %w", err)
+	}
+	machines := make(map[string]*capiv1beta1.Machine, len(machineList.Items))
+	for i := range machineList.Items {
+		machines[machineList.Items[i].Spec.InfrastructureRef.Name] = &machineList.Items[i]
+	}
+
+	ibmMachineList := &infrav1beta2.IBMVPCMachineList{}
+	if err := s.Client.List(context.TODO(), ibmMachineList, opts...); err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to list control plane IBMVPCMachines: %w", err)
+	}
+
+	var targets []string
+	retained := map[string]bool{}
+	addresses := map[string]bool{}
+	for i := range ibmMachineList.Items {
+		ibmMachine := &ibmMachineList.Items[i]
+		internalIP := machineInternalIP(ibmMachine)
+		if internalIP == "" {
+			continue
+		}
+		addresses[internalIP] = true
+		switch {
+		case len(ibmMachine.Spec.LoadBalancerPoolMembers) > 0:
+			retained[internalIP] = true
+		case IsLoadBalancerPoolMemberEligible(machines[ibmMachine.Name], ibmMachine):
+			targets = append(targets, internalIP)
+			retained[internalIP] = true
+		}
+	}
+	sort.Strings(targets)
+	return targets, retained, addresses, nil
+}
+
+// IsLoadBalancerPoolMemberEligible returns whether the control plane machine should be a member of the pools of the control
+// plane load balancer, which is the case while its instance is running and neither the Machine nor the IBMVPCMachine is being deleted.
+// Machines are thereby removed from the pools before their instances get deleted.
+func IsLoadBalancerPoolMemberEligible(machine *capiv1beta1.Machine, ibmMachine *infrav1beta2.IBMVPCMachine) bool {
+	if machine == nil || !machine.DeletionTimestamp.IsZero() || !ibmMachine.DeletionTimestamp.IsZero() {
+		return false
+	}
+	return ibmMachine.Status.InstanceStatus == vpcv1.InstanceStatusRunningConst
+}
+
+// machineInternalIP returns the internal IP of the machine, empty until its addresses are set.
+func machineInternalIP(ibmMachine *infrav1beta2.IBMVPCMachine) string {
+	for _, address := range ibmMachine.Status.Addresses {
+		if address.Type == corev1.NodeInternalIP {
+			return address.Address
+		}
+	}
+	return ""
+}
+
+// ReconcileControlPlaneLoadBalancerPoolMembers keeps the members of the pools of the control plane load balancer in sync with the
+// control plane machines of the cluster. Running machines are added to the pools, while members of machines which are being deleted,
+// are not running anymore or were replaced are removed. As the load balancer can't be updated until the previous update completed,
+// a single member is added or removed at a time, requeue is true until all members are reconciled and active.
+// Only the members registered by the controller, recorded in loadBalancerPoolMembers of the status, or belonging to a control
+// plane machine are removed, members added to the pools by other means are left untouched.
+func (s *ClusterScope) ReconcileControlPlaneLoadBalancerPoolMembers() (bool, error) {
+	loadBalancer, _, err := s.IBMVPCClient.GetLoadBalancer(&vpcv1.GetLoadBalancerOptions{
+		ID: s.IBMVPCCluster.Status.VPCEndpoint.LBID,
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to get load balancer: %w", err)
+	}
+	if *loadBalancer.ProvisioningStatus != string(infrav1beta2.VPCLoadBalancerStateActive) {
+		s.V(3).Info("Load balancer is not active, waiting to reconcile its pool members", "loadBalancerID", *loadBalancer.ID, "state", *loadBalancer.ProvisioningStatus)
+		return true, nil
+	}
+
+	targets, retained, addresses, err := s.controlPlaneLoadBalancerPoolMemberTargets()
+	if err != nil {
+		return false, err
+	}
+
+	registered := newRegisteredPoolMembers(s.IBMVPCCluster.Status.LoadBalancerPoolMembers)
+	defer func() {
+		s.IBMVPCCluster.Status.LoadBalancerPoolMembers = registered.list()
+	}()
+
+	requeue := false
+	for i, pool := range s.controlPlaneLoadBalancerPools() {
+		poolID := loadBalancerPoolID(loadBalancer, pool.name)
+		// Load balancers looked up by the hostname of the control plane endpoint use their own naming, the pool of the API server
+		// is the first pool of the load balancer just like for the machines adding themselves to it.
+		if poolID == nil && i == 0 && len(loadBalancer.Pools) > 0 {
+			poolID = loadBalancer.Pools[0].ID
+		}
+		if poolID == nil {
+			s.V(3).Info("Load balancer pool does not exist, skipping its members", "pool", pool.name)
+			continue
+		}
+
+		members, _, err := s.IBMVPCClient.ListLoadBalancerPoolMembers(&vpcv1.ListLoadBalancerPoolMembersOptions{
+			LoadBalancerID: loadBalancer.ID,
+			PoolID:         poolID,
+		})
+		if err != nil {
+			return false, fmt.Errorf("failed to list members of load balancer pool %s: %w", pool.name, err)
+		}
+
+		existing := map[string]bool{}
+		for _, member := range members.Members {
+			target, ok := member.Target.(*vpcv1.LoadBalancerPoolMemberTarget)
+			if !ok || target.Address == nil {
+				continue
+			}
+			registered.observe(*target.Address)
+			if !retained[*target.Address] {
+				if !registered.has(*target.Address) && !addresses[*target.Address] {
+					s.V(3).Info("Skipping load balancer pool member not registered by the controller", "pool", pool.name, "address", *target.Address)
+					continue
+				}
+				if _, err := s.IBMVPCClient.DeleteLoadBalancerPoolMember(&vpcv1.DeleteLoadBalancerPoolMemberOptions{
+					LoadBalancerID: loadBalancer.ID,
+					PoolID:         poolID,
+					ID:             member.ID,
+				}); err != nil {
+					record.Warnf(s.IBMVPCCluster, "FailedDeleteLoadBalancerPoolMember", "Failed to remove %s from load balancer pool %s - %v", *target.Address, pool.name, err)
+					return false, fmt.Errorf("failed to delete member %s of load balancer pool %s: %w", *member.ID, pool.name, err)
+				}
+				record.Eventf(s.IBMVPCCluster, "SuccessfulDeleteLoadBalancerPoolMember", "Removed %s from load balancer pool %s", *target.Address, pool.name)
+				return true, nil
+			}
+			registered.add(*target.Address)
+			if member.Port != nil && *member.Port == pool.port {
+				existing[*target.Address] = true
+			}
+			if member.ProvisioningStatus != nil && *member.ProvisioningStatus != vpcv1.LoadBalancerPoolMemberProvisioningStatusActiveConst {
+				requeue = true
+			}
+		}
+
+		for _, target := range targets {
+			if existing[target] {
+				continue
+			}
+			if _, _, err := s.IBMVPCClient.CreateLoadBalancerPoolMember(&vpcv1.CreateLoadBalancerPoolMemberOptions{
+				LoadBalancerID: loadBalancer.ID,
+				PoolID:         poolID,
+				Port:           ptr.To(pool.port),
+				Target: &vpcv1.LoadBalancerPoolMemberTargetPrototypeIP{
+					Address: ptr.To(target),
+				},
+			}); err != nil {
+				record.Warnf(s.IBMVPCCluster, "FailedCreateLoadBalancerPoolMember", "Failed to add %s to load balancer pool %s - %v", target, pool.name, err)
+				return false, fmt.Errorf("failed to add %s to load balancer pool %s: %w", target, pool.name, err)
+			}
+			record.Eventf(s.IBMVPCCluster, "SuccessfulCreateLoadBalancerPoolMember", "Added %s to load balancer pool %s", target, pool.name)
+			registered.add(target)
+			return true, nil
+		}
+	}
+	// All the pools are listed, the registered members which are no longer in any pool were removed.
+	registered.prune()
+	return requeue, nil
+}
+
+// loadBalancerPoolID returns the ID of the pool of the load balancer with the name, nil if the load balancer has no such pool.
+func loadBalancerPoolID(loadBalancer *vpcv1.LoadBalancer, name string) *string {
+	for _, pool := range loadBalancer.Pools {
+		if pool.Name != nil && *pool.Name == name {
+			return pool.ID
+		}
+	}
+	return nil
+}
+
 // SetReady will set the status as ready for the cluster.
 func (s *ClusterScope) SetReady() {
 	s.IBMVPCCluster.Status.Ready = true
@@ -686,6 +915,11 @@ func (s *ClusterScope) GetLoadBalancerID() string {
 	return *s.IBMVPCCluster.Status.VPCEndpoint.LBID
 }
 
+// CheckLoadBalancerMembers checks the members of the pools of the load balancer of the cluster are healthy.
+func (s *ClusterScope) CheckLoadBalancerMembers() error {
+	return checkLoadBalancerMembersHealth(s.IBMVPCClient, map[string]string{s.GetLoadBalancerID(): s.GetLoadBalancerID()})
+}
+
 // SetLoadBalancerAddress will set the address for the load balancer.
 func (s *ClusterScope) SetLoadBalancerAddress(address *string) {
 	s.IBMVPCCluster.Status.VPCEndpoint.Address = address
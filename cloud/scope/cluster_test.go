@@ -19,17 +19,23 @@ package scope
 import (
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	capiv1beta1 "sigs.k8s.io/cluster-api/api/v1beta1"
+
 	infrav1beta2 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/v1beta2"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/vpc/mock"
 
@@ -752,3 +758,246 @@ func TestDeleteLoadBalancer(t *testing.T) {
 		})
 	})
 }
+
+func TestCreateLoadBalancerWithAdditionalListeners(t *testing.T) {
+	g := NewWithT(t)
+	mockController := gomock.NewController(t)
+	t.Cleanup(mockController.Finish)
+	mockvpc := mock.NewMockVpc(mockController)
+	scope := setupClusterScope(clusterName, mockvpc)
+	scope.IBMVPCCluster.Spec.ControlPlaneLoadBalancer = &infrav1beta2.VPCLoadBalancerSpec{
+		Name: "foo-load-balancer",
+		AdditionalListeners: []infrav1beta2.AdditionalListenerSpec{
+			{Port: 8132},
+			{Port: 9345, DefaultPoolName: ptr.To("foo-rke2-pool"), Protocol: ptr.To(infrav1beta2.VPCLoadBalancerListenerProtocolTCP)},
+		},
+	}
+	scope.IBMVPCCluster.Status.Subnet.ID = core.StringPtr("foo-subnet-id")
+
+	mockvpc.EXPECT().ListLoadBalancers(gomock.AssignableToTypeOf(&vpcv1.ListLoadBalancersOptions{})).Return(&vpcv1.LoadBalancerCollection{}, &core.DetailedResponse{}, nil)
+	mockvpc.EXPECT().CreateLoadBalancer(gomock.AssignableToTypeOf(&vpcv1.CreateLoadBalancerOptions{})).DoAndReturn(func(options *vpcv1.CreateLoadBalancerOptions) (*vpcv1.LoadBalancer, *core.DetailedResponse, error) {
+		g.Expect(options.Pools).To(HaveLen(3))
+		g.Expect(options.Listeners).To(HaveLen(3))
+		for i, name := range []string{"foo-load-balancer-pool", "foo-load-balancer-pool-8132", "foo-rke2-pool"} {
+			g.Expect(*options.Pools[i].Name).To(Equal(name))
+			g.Expect(*options.Listeners[i].DefaultPool.Name).To(Equal(name))
+		}
+		g.Expect(*options.Listeners[0].Port).To(Equal(int64(infrav1beta2.DefaultAPIServerPort)))
+		g.Expect(*options.Listeners[1].Port).To(Equal(int64(8132)))
+		g.Expect(*options.Listeners[2].Port).To(Equal(int64(9345)))
+		return &vpcv1.LoadBalancer{Name: core.StringPtr("foo-load-balancer")}, &core.DetailedResponse{}, nil
+	})
+	_, err := scope.CreateLoadBalancer()
+	g.Expect(err).To(BeNil())
+}
+
+// newControlPlaneMachine returns a control plane Machine and its IBMVPCMachine with the internal IP.
+func newControlPlaneMachine(name, internalIP, instanceStatus string, deleting bool) (*capiv1beta1.Machine, *infrav1beta2.IBMVPCMachine) {
+	labels := map[string]string{
+		capiv1beta1.ClusterNameLabel:             clusterName,
+		capiv1beta1.MachineControlPlaneNameLabel: "foo-control-plane",
+	}
+	machine := &capiv1beta1.Machine{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", Labels: labels},
+		Spec: capiv1beta1.MachineSpec{
+			ClusterName:       clusterName,
+			InfrastructureRef: corev1.ObjectReference{Name: name},
+		},
+	}
+	if deleting {
+		machine.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+		machine.Finalizers = []string{capiv1beta1.MachineFinalizer}
+	}
+	ibmMachine := &infrav1beta2.IBMVPCMachine{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", Labels: labels},
+		Status: infrav1beta2.IBMVPCMachineStatus{
+			InstanceStatus: instanceStatus,
+			Addresses:      []corev1.NodeAddress{{Type: corev1.NodeInternalIP, Address: internalIP}},
+		},
+	}
+	return machine, ibmMachine
+}
+
+func newLoadBalancerPoolMember(id, address string, port int64) vpcv1.LoadBalancerPoolMember {
+	return vpcv1.LoadBalancerPoolMember{
+		ID:                 core.StringPtr(id),
+		Port:               core.Int64Ptr(port),
+		ProvisioningStatus: core.StringPtr(vpcv1.LoadBalancerPoolMemberProvisioningStatusActiveConst),
+		Target:             &vpcv1.LoadBalancerPoolMemberTarget{Address: core.StringPtr(address)},
+	}
+}
+
+func TestReconcileControlPlaneLoadBalancerPoolMembers(t *testing.T) {
+	setup := func(t *testing.T, objects ...client.Object) (*gomock.Controller, *mock.MockVpc, *ClusterScope) {
+		t.Helper()
+		mockController := gomock.NewController(t)
+		mockvpc := mock.NewMockVpc(mockController)
+		scope := setupClusterScope(clusterName, mockvpc)
+		scope.Client = fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(objects...).Build()
+		scope.IBMVPCCluster.Spec.ControlPlaneLoadBalancer = &infrav1beta2.VPCLoadBalancerSpec{Name: "foo-load-balancer"}
+		scope.IBMVPCCluster.Status.VPCEndpoint.LBID = core.StringPtr("foo-load-balancer-id")
+		return mockController, mockvpc, scope
+	}
+
+	loadBalancer := func(state string) *vpcv1.LoadBalancer {
+		return &vpcv1.LoadBalancer{
+			ID:                 core.StringPtr("foo-load-balancer-id"),
+			ProvisioningStatus: core.StringPtr(state),
+			Pools: []vpcv1.LoadBalancerPoolReference{
+				{ID: core.StringPtr("foo-pool-id"), Name: core.StringPtr("foo-load-balancer-pool")},
+				{ID: core.StringPtr("foo-pool-8132-id"), Name: core.StringPtr("foo-load-balancer-pool-8132")},
+			},
+		}
+	}
+
+	running1, runningIBM1 := newControlPlaneMachine("foo-machine-1", "10.0.0.1", vpcv1.InstanceStatusRunningConst, false)
+	running2, runningIBM2 := newControlPlaneMachine("foo-machine-2", "10.0.0.2", vpcv1.InstanceStatusRunningConst, false)
+	deleting, deletingIBM := newControlPlaneMachine("foo-machine-3", "10.0.0.3", vpcv1.InstanceStatusRunningConst, true)
+	stopped, stoppedIBM := newControlPlaneMachine("foo-machine-4", "10.0.0.4", vpcv1.InstanceStatusStoppedConst, false)
+
+	t.Run("Should wait for the load balancer to be active", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc, scope := setup(t, running1, runningIBM1)
+		t.Cleanup(mockController.Finish)
+		mockvpc.EXPECT().GetLoadBalancer(gomock.AssignableToTypeOf(&vpcv1.GetLoadBalancerOptions{})).Return(loadBalancer(string(infrav1beta2.VPCLoadBalancerStateUpdatePending)), &core.DetailedResponse{}, nil)
+		requeue, err := scope.ReconcileControlPlaneLoadBalancerPoolMembers()
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
+	})
+
+	t.Run("Should add running machine to the pool", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc, scope := setup(t, running1, runningIBM1, running2, runningIBM2)
+		t.Cleanup(mockController.Finish)
+		mockvpc.EXPECT().GetLoadBalancer(gomock.AssignableToTypeOf(&vpcv1.GetLoadBalancerOptions{})).Return(loadBalancer(string(infrav1beta2.VPCLoadBalancerStateActive)), &core.DetailedResponse{}, nil)
+		mockvpc.EXPECT().ListLoadBalancerPoolMembers(gomock.AssignableToTypeOf(&vpcv1.ListLoadBalancerPoolMembersOptions{})).Return(&vpcv1.LoadBalancerPoolMemberCollection{
+			Members: []vpcv1.LoadBalancerPoolMember{newLoadBalancerPoolMember("foo-member-1", "10.0.0.1", 6443)},
+		}, &core.DetailedResponse{}, nil)
+		mockvpc.EXPECT().CreateLoadBalancerPoolMember(gomock.AssignableToTypeOf(&vpcv1.CreateLoadBalancerPoolMemberOptions{})).DoAndReturn(func(options *vpcv1.CreateLoadBalancerPoolMemberOptions) (*vpcv1.LoadBalancerPoolMember, *core.DetailedResponse, error) {
+			g.Expect(*options.PoolID).To(Equal("foo-pool-id"))
+			g.Expect(*options.Port).To(Equal(int64(6443)))
+			g.Expect(*options.Target.(*vpcv1.LoadBalancerPoolMemberTargetPrototypeIP).Address).To(Equal("10.0.0.2"))
+			return &vpcv1.LoadBalancerPoolMember{}, &core.DetailedResponse{}, nil
+		})
+		requeue, err := scope.ReconcileControlPlaneLoadBalancerPoolMembers()
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
+	})
+
+	t.Run("Should remove members of deleting, stopped and replaced machines", func(t *testing.T) {
+		for _, address := range []string{"10.0.0.3", "10.0.0.4", "10.0.0.5"} {
+			g := NewWithT(t)
+			mockController, mockvpc, scope := setup(t, running1, runningIBM1, deleting, deletingIBM, stopped, stoppedIBM)
+			t.Cleanup(mockController.Finish)
+			scope.IBMVPCCluster.Status.LoadBalancerPoolMembers = []string{"10.0.0.5"}
+			mockvpc.EXPECT().GetLoadBalancer(gomock.AssignableToTypeOf(&vpcv1.GetLoadBalancerOptions{})).Return(loadBalancer(string(infrav1beta2.VPCLoadBalancerStateActive)), &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().ListLoadBalancerPoolMembers(gomock.AssignableToTypeOf(&vpcv1.ListLoadBalancerPoolMembersOptions{})).Return(&vpcv1.LoadBalancerPoolMemberCollection{
+				Members: []vpcv1.LoadBalancerPoolMember{
+					newLoadBalancerPoolMember("foo-member-1", "10.0.0.1", 6443),
+					newLoadBalancerPoolMember("foo-member-2", address, 6443),
+				},
+			}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().DeleteLoadBalancerPoolMember(gomock.AssignableToTypeOf(&vpcv1.DeleteLoadBalancerPoolMemberOptions{})).DoAndReturn(func(options *vpcv1.DeleteLoadBalancerPoolMemberOptions) (*core.DetailedResponse, error) {
+				g.Expect(*options.ID).To(Equal("foo-member-2"))
+				return &core.DetailedResponse{}, nil
+			})
+			requeue, err := scope.ReconcileControlPlaneLoadBalancerPoolMembers()
+			g.Expect(err).To(BeNil())
+			g.Expect(requeue).To(BeTrue())
+		}
+	})
+
+	t.Run("Should keep members not registered by the controller", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc, scope := setup(t, running1, runningIBM1)
+		t.Cleanup(mockController.Finish)
+		scope.IBMVPCCluster.Status.LoadBalancerPoolMembers = []string{"10.0.0.1", "10.0.0.9"}
+		mockvpc.EXPECT().GetLoadBalancer(gomock.AssignableToTypeOf(&vpcv1.GetLoadBalancerOptions{})).Return(loadBalancer(string(infrav1beta2.VPCLoadBalancerStateActive)), &core.DetailedResponse{}, nil)
+		mockvpc.EXPECT().ListLoadBalancerPoolMembers(gomock.AssignableToTypeOf(&vpcv1.ListLoadBalancerPoolMembersOptions{})).Return(&vpcv1.LoadBalancerPoolMemberCollection{
+			Members: []vpcv1.LoadBalancerPoolMember{
+				newLoadBalancerPoolMember("foo-member-1", "10.0.0.1", 6443),
+				newLoadBalancerPoolMember("foo-member-2", "192.168.0.1", 6443),
+			},
+		}, &core.DetailedResponse{}, nil)
+		requeue, err := scope.ReconcileControlPlaneLoadBalancerPoolMembers()
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeFalse())
+		g.Expect(scope.IBMVPCCluster.Status.LoadBalancerPoolMembers).To(Equal([]string{"10.0.0.1"}))
+	})
+
+	t.Run("Should register the added members", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc, scope := setup(t, running1, runningIBM1)
+		t.Cleanup(mockController.Finish)
+		mockvpc.EXPECT().GetLoadBalancer(gomock.AssignableToTypeOf(&vpcv1.GetLoadBalancerOptions{})).Return(loadBalancer(string(infrav1beta2.VPCLoadBalancerStateActive)), &core.DetailedResponse{}, nil)
+		mockvpc.EXPECT().ListLoadBalancerPoolMembers(gomock.AssignableToTypeOf(&vpcv1.ListLoadBalancerPoolMembersOptions{})).Return(&vpcv1.LoadBalancerPoolMemberCollection{}, &core.DetailedResponse{}, nil)
+		mockvpc.EXPECT().CreateLoadBalancerPoolMember(gomock.AssignableToTypeOf(&vpcv1.CreateLoadBalancerPoolMemberOptions{})).Return(&vpcv1.LoadBalancerPoolMember{}, &core.DetailedResponse{}, nil)
+		requeue, err := scope.ReconcileControlPlaneLoadBalancerPoolMembers()
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
+		g.Expect(scope.IBMVPCCluster.Status.LoadBalancerPoolMembers).To(Equal([]string{"10.0.0.1"}))
+	})
+
+	t.Run("Should keep members of machines managing their own pool members", func(t *testing.T) {
+		g := NewWithT(t)
+		explicit, explicitIBM := newControlPlaneMachine("foo-machine-5", "10.0.0.5", vpcv1.InstanceStatusStoppedConst, false)
+		explicitIBM.Spec.LoadBalancerPoolMembers = []infrav1beta2.VPCLoadBalancerBackendPoolMember{{LoadBalancer: infrav1beta2.VPCResource{ID: core.StringPtr("foo-load-balancer-id")}, Pool: infrav1beta2.VPCResource{ID: core.StringPtr("foo-pool-id")}, Port: 6443}}
+		mockController, mockvpc, scope := setup(t, running1, runningIBM1, explicit, explicitIBM)
+		t.Cleanup(mockController.Finish)
+		mockvpc.EXPECT().GetLoadBalancer(gomock.AssignableToTypeOf(&vpcv1.GetLoadBalancerOptions{})).Return(loadBalancer(string(infrav1beta2.VPCLoadBalancerStateActive)), &core.DetailedResponse{}, nil)
+		mockvpc.EXPECT().ListLoadBalancerPoolMembers(gomock.AssignableToTypeOf(&vpcv1.ListLoadBalancerPoolMembersOptions{})).Return(&vpcv1.LoadBalancerPoolMemberCollection{
+			Members: []vpcv1.LoadBalancerPoolMember{
+				newLoadBalancerPoolMember("foo-member-1", "10.0.0.1", 6443),
+				newLoadBalancerPoolMember("foo-member-2", "10.0.0.5", 6443),
+			},
+		}, &core.DetailedResponse{}, nil)
+		requeue, err := scope.ReconcileControlPlaneLoadBalancerPoolMembers()
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeFalse())
+	})
+
+	t.Run("Should add running machine to the pools of the additional listeners", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc, scope := setup(t, running1, runningIBM1)
+		t.Cleanup(mockController.Finish)
+		scope.IBMVPCCluster.Spec.ControlPlaneLoadBalancer.AdditionalListeners = []infrav1beta2.AdditionalListenerSpec{{Port: 8132}}
+		mockvpc.EXPECT().GetLoadBalancer(gomock.AssignableToTypeOf(&vpcv1.GetLoadBalancerOptions{})).Return(loadBalancer(string(infrav1beta2.VPCLoadBalancerStateActive)), &core.DetailedResponse{}, nil)
+		mockvpc.EXPECT().ListLoadBalancerPoolMembers(&vpcv1.ListLoadBalancerPoolMembersOptions{LoadBalancerID: core.StringPtr("foo-load-balancer-id"), PoolID: core.StringPtr("foo-pool-id")}).Return(&vpcv1.LoadBalancerPoolMemberCollection{
+			Members: []vpcv1.LoadBalancerPoolMember{newLoadBalancerPoolMember("foo-member-1", "10.0.0.1", 6443)},
+		}, &core.DetailedResponse{}, nil)
+		mockvpc.EXPECT().ListLoadBalancerPoolMembers(&vpcv1.ListLoadBalancerPoolMembersOptions{LoadBalancerID: core.StringPtr("foo-load-balancer-id"), PoolID: core.StringPtr("foo-pool-8132-id")}).Return(&vpcv1.LoadBalancerPoolMemberCollection{}, &core.DetailedResponse{}, nil)
+		mockvpc.EXPECT().CreateLoadBalancerPoolMember(gomock.AssignableToTypeOf(&vpcv1.CreateLoadBalancerPoolMemberOptions{})).DoAndReturn(func(options *vpcv1.CreateLoadBalancerPoolMemberOptions) (*vpcv1.LoadBalancerPoolMember, *core.DetailedResponse, error) {
+			g.Expect(*options.PoolID).To(Equal("foo-pool-8132-id"))
+			g.Expect(*options.Port).To(Equal(int64(8132)))
+			return &vpcv1.LoadBalancerPoolMember{}, &core.DetailedResponse{}, nil
+		})
+		requeue, err := scope.ReconcileControlPlaneLoadBalancerPoolMembers()
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
+	})
+
+	t.Run("Should requeue until the members are active", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc, scope := setup(t, running1, runningIBM1)
+		t.Cleanup(mockController.Finish)
+		member := newLoadBalancerPoolMember("foo-member-1", "10.0.0.1", 6443)
+		member.ProvisioningStatus = core.StringPtr(vpcv1.LoadBalancerPoolMemberProvisioningStatusCreatePendingConst)
+		mockvpc.EXPECT().GetLoadBalancer(gomock.AssignableToTypeOf(&vpcv1.GetLoadBalancerOptions{})).Return(loadBalancer(string(infrav1beta2.VPCLoadBalancerStateActive)), &core.DetailedResponse{}, nil)
+		mockvpc.EXPECT().ListLoadBalancerPoolMembers(gomock.AssignableToTypeOf(&vpcv1.ListLoadBalancerPoolMembersOptions{})).Return(&vpcv1.LoadBalancerPoolMemberCollection{
+			Members: []vpcv1.LoadBalancerPoolMember{member},
+		}, &core.DetailedResponse{}, nil)
+		requeue, err := scope.ReconcileControlPlaneLoadBalancerPoolMembers()
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
+	})
+
+	t.Run("Error when listing pool members", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc, scope := setup(t, running1, runningIBM1)
+		t.Cleanup(mockController.Finish)
+		mockvpc.EXPECT().GetLoadBalancer(gomock.AssignableToTypeOf(&vpcv1.GetLoadBalancerOptions{})).Return(loadBalancer(string(infrav1beta2.VPCLoadBalancerStateActive)), &core.DetailedResponse{}, nil)
+		mockvpc.EXPECT().ListLoadBalancerPoolMembers(gomock.AssignableToTypeOf(&vpcv1.ListLoadBalancerPoolMembersOptions{})).Return(nil, &core.DetailedResponse{}, errors.New("failed to list pool members"))
+		_, err := scope.ReconcileControlPlaneLoadBalancerPoolMembers()
+		g.Expect(err).To(Not(BeNil()))
+	})
+}
@@ -21,10 +21,14 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"path"
+	"slices"
+	"sort"
 
 	"github.com/go-logr/logr"
 
 	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
 	"github.com/IBM/platform-services-go-sdk/globaltaggingv1"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 
@@ -36,13 +40,20 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	capiv1beta1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util"
+	"sigs.k8s.io/cluster-api/util/conditions"
 	"sigs.k8s.io/cluster-api/util/patch"
 
 	infrav1beta2 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/cos"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/globaltagging"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/utils"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/vpc"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloudinit"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/debugdump"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/endpoints"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/ignition"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/names"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/options"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/record"
 )
@@ -72,6 +83,9 @@ type MachineScope struct {
 	IBMVPCCluster       *infrav1beta2.IBMVPCCluster
 	IBMVPCMachine       *infrav1beta2.IBMVPCMachine
 	ServiceEndpoint     []endpoints.ServiceEndpoint
+
+	// COSClient is the client of the COS bucket staging the bootstrap data, it is created on demand when nil.
+	COSClient cos.Cos
 }
 
 // NewMachineScope creates a new MachineScope from the supplied parameters.
@@ -95,7 +109,12 @@ func NewMachineScope(params MachineScopeParams) (*MachineScope, error) {
 	// Fetch the service endpoint.
 	svcEndpoint := endpoints.FetchVPCEndpoint(params.IBMVPCCluster.Spec.Region, params.ServiceEndpoint)
 
-	vpcClient, err := vpc.NewService(svcEndpoint)
+	auth, err := getAuthenticator(params.Client, params.IBMVPCCluster.Namespace, params.IBMVPCCluster.Spec.CredentialsSecretRef, params.IBMVPCCluster.Spec.EnterpriseAccount)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create authenticator: %w", err)
+	}
+
+	vpcClient, err := vpc.NewServiceWithAuthenticator(svcEndpoint, auth)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create IBM VPC session: %w", err)
 	}
@@ -105,7 +124,11 @@ func NewMachineScope(params MachineScopeParams) (*MachineScope, error) {
 	}
 
 	// Create Global Tagging client.
-	gtOptions := globaltagging.ServiceOptions{}
+	gtOptions := globaltagging.ServiceOptions{
+		GlobalTaggingV1Options: &globaltaggingv1.GlobalTaggingV1Options{
+			Authenticator: auth,
+		},
+	}
 	// Override the Global Tagging endpoint if provided.
 	if gtEndpoint := endpoints.FetchEndpoints(string(endpoints.GlobalTagging), params.ServiceEndpoint); gtEndpoint != "" {
 		gtOptions.URL = gtEndpoint
@@ -126,12 +149,117 @@ func NewMachineScope(params MachineScopeParams) (*MachineScope, error) {
 		patchHelper:         helper,
 		Machine:             params.Machine,
 		IBMVPCMachine:       params.IBMVPCMachine,
+		ServiceEndpoint:     params.ServiceEndpoint,
 	}, nil
 }
 
+// instanceName returns the name of the instance of the machine, normalized to meet the constraints of the VPC names.
+func (m *MachineScope) instanceName() string {
+	return names.Normalize(m.IBMVPCMachine.Name, names.VPCMaxLength)
+}
+
+// ExceededGuardrails returns the message describing the guardrails of the cluster exceeded by creating the instance
+// of the machine, or an empty string when the instance fits within them. The instances of the other machines of the
+// cluster, including the machines being deleted, are counted once they are created, along with the pending instances
+// of the machines created before the machine, so machines reconciled at the same time don't exceed the guardrails.
+// The cores and the memory of the instances are the ones of their profiles. An existing instance of the machine is
+// adopted regardless of the guardrails.
+func (m *MachineScope) ExceededGuardrails() (string, error) {
+	if m.IBMVPCCluster == nil || m.IBMVPCCluster.Spec.Guardrails == nil {
+		return "", nil
+	}
+	if m.IBMVPCMachine.Status.InstanceID != "" {
+		return "", nil
+	}
+	guardrails := m.IBMVPCCluster.Spec.Guardrails
+	countProfiles := guardrails.MaxTotalCores != nil || guardrails.MaxTotalMemoryGiB != nil
+
+	profiles := map[string]instanceBudget{}
+	budgetOf := func(machine *infrav1beta2.IBMVPCMachine) (instanceBudget, error) {
+		if !countProfiles {
+			return instanceBudget{instances: 1}, nil
+		}
+		if budget, ok := profiles[machine.Spec.Profile]; ok {
+			return budget, nil
+		}
+		budget, err := m.instanceProfileBudget(machine.Spec.Profile)
+		if err != nil {
+			return instanceBudget{}, fmt.Errorf("failed to get the profile of machine %s: %w", machine.Name, err)
+		}
+		profiles[machine.Spec.Profile] = budget
+		return budget, nil
+	}
+
+	instance, err := budgetOf(m.IBMVPCMachine)
+	if err != nil {
+		return "", err
+	}
+	machineList := &infrav1beta2.IBMVPCMachineList{}
+	if err := m.Client.List(context.TODO(), machineList, client.InNamespace(m.IBMVPCMachine.Namespace), client.MatchingLabels{
+		capiv1beta1.ClusterNameLabel: m.Cluster.Name,
+	}); err != nil {
+		return "", fmt.Errorf("failed to list the machines of the cluster: %w", err)
+	}
+
+	var used instanceBudget
+	for i := range machineList.Items {
+		machine := &machineList.Items[i]
+		if machine.Name == m.IBMVPCMachine.Name {
+			continue
+		}
+		if machine.Status.InstanceID == "" && !isPendingBefore(machine, m.IBMVPCMachine) {
+			continue
+		}
+		budget, err := budgetOf(machine)
+		if err != nil {
+			return "", err
+		}
+		used.instances += budget.instances
+		used.cores += budget.cores
+		used.memoryGiB += budget.memoryGiB
+	}
+	exceeded, err := exceededGuardrails(guardrails, used, instance)
+	if err != nil || exceeded == "" {
+		return exceeded, err
+	}
+	// The instance may already exist, e.g. when the status of the machine was lost, it is then adopted rather than created.
+	existing, err := m.ensureInstanceUnique(m.instanceName())
+	if err != nil {
+		return "", fmt.Errorf("failed to check if the instance of the machine exists: %w", err)
+	}
+	if existing != nil {
+		return "", nil
+	}
+	return exceeded, nil
+}
+
+func (m *MachineScope) instanceProfileBudget(name string) (instanceBudget, error) {
+	if name == "" {
+		return instanceBudget{}, errors.New("profile is empty")
+	}
+	profile, _, err := m.IBMVPCClient.GetInstanceProfile(&vpcv1.GetInstanceProfileOptions{Name: &name})
+	if err != nil {
+		return instanceBudget{}, fmt.Errorf("failed to get instance profile %s: %w", name, err)
+	}
+	budget := instanceBudget{instances: 1}
+	switch vcpu := profile.VcpuCount.(type) {
+	case *vpcv1.InstanceProfileVcpu:
+		budget.cores = float64(ptr.Deref(vcpu.Value, ptr.Deref(vcpu.Default, 0)))
+	case *vpcv1.InstanceProfileVcpuFixed:
+		budget.cores = float64(ptr.Deref(vcpu.Value, 0))
+	}
+	switch memory := profile.Memory.(type) {
+	case *vpcv1.InstanceProfileMemory:
+		budget.memoryGiB = float64(ptr.Deref(memory.Value, ptr.Deref(memory.Default, 0)))
+	case *vpcv1.InstanceProfileMemoryFixed:
+		budget.memoryGiB = float64(ptr.Deref(memory.Value, 0))
+	}
+	return budget, nil
+}
+
 // CreateMachine creates a vpc machine.
 func (m *MachineScope) CreateMachine() (*vpcv1.Instance, error) { //nolint: gocyclo
-	instanceReply, err := m.ensureInstanceUnique(m.IBMVPCMachine.Name)
+	instanceReply, err := m.ensureInstanceUnique(m.instanceName())
 	if err != nil {
 		return nil, err
 	} else if instanceReply != nil {
@@ -139,7 +267,11 @@ func (m *MachineScope) CreateMachine() (*vpcv1.Instance, error) { //nolint: gocy
 		return instanceReply, nil
 	}
 
-	cloudInitData, err := m.GetBootstrapData()
+	bootstrapData, err := m.GetBootstrapData()
+	if err != nil {
+		return nil, err
+	}
+	cloudInitData, err := m.resolveUserData(bootstrapData)
 	if err != nil {
 		return nil, err
 	}
@@ -155,8 +287,16 @@ func (m *MachineScope) CreateMachine() (*vpcv1.Instance, error) { //nolint: gocy
 	}
 
 	subnetIdentity := &vpcv1.SubnetIdentity{}
+	// If no subnet was provided for a Machine within a failure domain, place it in the cluster's subnet within that zone.
+	if m.IBMVPCMachine.Spec.PrimaryNetworkInterface.Subnet == "" && m.hasFailureDomain() {
+		subnetID, err := m.getSubnetIDForZone(m.zone())
+		if err != nil {
+			return nil, fmt.Errorf("error retrieving subnet ID for machine %s: %w", m.IBMVPCMachine.Name, err)
+		}
+		subnetIdentity.ID = subnetID
+	}
 	// If Network Status is available, attempt to retrieve subnet ID from there.
-	if m.IBMVPCCluster.Status.Network != nil {
+	if subnetIdentity.ID == nil && m.IBMVPCCluster.Status.Network != nil {
 		if m.IBMVPCCluster.Status.Network.ControlPlaneSubnets != nil {
 			if subnet, ok := m.IBMVPCCluster.Status.Network.ControlPlaneSubnets[m.IBMVPCMachine.Spec.PrimaryNetworkInterface.Subnet]; ok {
 				subnetIdentity.ID = ptr.To(subnet.ID)
@@ -172,6 +312,12 @@ func (m *MachineScope) CreateMachine() (*vpcv1.Instance, error) { //nolint: gocy
 	if subnetIdentity.ID == nil {
 		// For Machines not reliant directly on Cluster managed subnets, lookup subnet ID by name.
 		subnetDetails, err := m.IBMVPCClient.GetVPCSubnetByName(m.IBMVPCMachine.Spec.PrimaryNetworkInterface.Subnet)
+		if err == nil && subnetDetails != nil {
+			var matched bool
+			if matched, err = m.MatchesResourceSelector(infrav1beta2.ResourceTypeSubnet, m.IBMVPCMachine.Spec.PrimaryNetworkInterface.Subnet, subnetDetails.CRN); err == nil && !matched {
+				subnetDetails = nil
+			}
+		}
 		if err != nil {
 			return nil, fmt.Errorf("error retrieving subnet ID for machine %s: %w", m.IBMVPCMachine.Name, err)
 		} else if subnetDetails != nil {
@@ -184,54 +330,12 @@ func (m *MachineScope) CreateMachine() (*vpcv1.Instance, error) { //nolint: gocy
 		Subnet: subnetIdentity,
 	}
 
-	// Populate the PrimaryNetworkInterface's SecurityGroups, if provided.
-	if len(m.IBMVPCMachine.Spec.PrimaryNetworkInterface.SecurityGroups) > 0 {
-		securityGroups := make([]vpcv1.SecurityGroupIdentityIntf, 0, len(m.IBMVPCMachine.Spec.PrimaryNetworkInterface.SecurityGroups))
-		for _, sg := range m.IBMVPCMachine.Spec.PrimaryNetworkInterface.SecurityGroups {
-			// Try using Security Group name if provided.
-			if sg.Name != nil {
-				// If Network Status is available, attempt to retrieve Security Group ID from there.
-				if m.IBMVPCCluster.Status.Network != nil {
-					if sgStatus, ok := m.IBMVPCCluster.Status.Network.SecurityGroups[*sg.Name]; ok {
-						securityGroups = append(securityGroups, &vpcv1.SecurityGroupIdentityByID{
-							ID: ptr.To(sgStatus.ID),
-						})
-						continue
-					}
-				}
-				// If not found in Network Status, try looking up the Security Group via API.
-				sgDetails, err := m.IBMVPCClient.GetSecurityGroupByName(*sg.Name)
-				if err != nil {
-					return nil, fmt.Errorf("error retrieving security group id with name %s for machine %s: %w", *sg.Name, m.IBMVPCMachine.Name, err)
-				} else if sgDetails != nil {
-					securityGroups = append(securityGroups, &vpcv1.SecurityGroupIdentityByID{
-						ID: sgDetails.ID,
-					})
-					continue
-				}
-				// If Name was provided but it cannot be found in Network Status or via API, return an error.
-				return nil, fmt.Errorf("error cannot find security group %s for machine %s", *sg.Name, m.IBMVPCMachine.Name)
-			}
-			// If ID is provided for Security Group, attempt lookup to confirm it exists.
-			if sg.ID != nil {
-				sgOptions := &vpcv1.GetSecurityGroupOptions{
-					ID: sg.ID,
-				}
-				sgDetails, _, err := m.IBMVPCClient.GetSecurityGroup(sgOptions)
-				if err != nil {
-					return nil, fmt.Errorf("error retrieving security by id %s for machine %s: %w", *sg.ID, m.IBMVPCMachine.Name, err)
-				} else if sgDetails == nil {
-					return nil, fmt.Errorf("error security group not found with id %s for machine %s", *sg.ID, m.IBMVPCMachine.Name)
-				}
-				securityGroups = append(securityGroups, &vpcv1.SecurityGroupIdentityByID{
-					ID: sg.ID,
-				})
-				continue
-			}
-			// TODO(cjschaef): Replace with webhook validation check.
-			return nil, fmt.Errorf("error no name or id provided for security group for machine %s", m.IBMVPCMachine.Name)
-		}
-		// After processing all Security Groups, add them to the PrimaryNetworkInterface.
+	// Populate the PrimaryNetworkInterface's SecurityGroups, if any are defined for the Machine or the Cluster.
+	securityGroups, err := m.getSecurityGroupIdentities()
+	if err != nil {
+		return nil, err
+	}
+	if len(securityGroups) > 0 {
 		primaryNetworkInterface.SecurityGroups = securityGroups
 	}
 
@@ -254,7 +358,7 @@ func (m *MachineScope) CreateMachine() (*vpcv1.Instance, error) { //nolint: gocy
 	}
 
 	zone := &vpcv1.ZoneIdentity{
-		Name: &m.IBMVPCMachine.Spec.Zone,
+		Name: ptr.To(m.zone()),
 	}
 
 	// Populate Placement target details, if provided.
@@ -266,6 +370,15 @@ func (m *MachineScope) CreateMachine() (*vpcv1.Instance, error) { //nolint: gocy
 		}
 	}
 
+	// Populate Reservation affinity details, if provided.
+	var reservationAffinity *vpcv1.InstanceReservationAffinityPrototype
+	if m.IBMVPCMachine.Spec.Capacity != nil {
+		reservationAffinity, err = m.configureReservationAffinity()
+		if err != nil {
+			return nil, fmt.Errorf("error configuring machine reservation affinity: %w", err)
+		}
+	}
+
 	// Populate any SSH Keys, if provided.
 	sshKeys := make([]vpcv1.KeyIdentityIntf, 0)
 	if m.IBMVPCMachine.Spec.SSHKeys != nil {
@@ -285,13 +398,18 @@ func (m *MachineScope) CreateMachine() (*vpcv1.Instance, error) { //nolint: gocy
 	var bootVolumeAttachment *vpcv1.VolumeAttachmentPrototypeInstanceByImageContext
 	if m.IBMVPCMachine.Spec.BootVolume != nil {
 		bootVolumeAttachment = m.volumeToVPCVolumeAttachment(m.IBMVPCMachine.Spec.BootVolume)
+	} else if m.IBMVPCMachine.Spec.EncryptionKeyCRN != "" {
+		bootVolumeAttachment = m.volumeToVPCVolumeAttachment(&infrav1beta2.VPCVolume{DeleteVolumeOnInstanceDelete: true, Profile: "general-purpose"})
 	}
 
+	// Populate data volume attachments, if provided.
+	dataVolumeAttachments := m.dataVolumeAttachments()
+
 	// Configure the Machine's Image or CatalogOffering based on provided fields.
 	// If an Image was provided, use that, if a Catalog Offering was provided use that (based on details provided), otherwise return an error.
 	if m.IBMVPCMachine.Spec.Image != nil {
 		imageInstancePrototype := &vpcv1.InstancePrototype{
-			Name:                    ptr.To(m.IBMVPCMachine.Name),
+			Name:                    ptr.To(m.instanceName()),
 			Profile:                 profile,
 			PrimaryNetworkInterface: primaryNetworkInterface,
 			ResourceGroup:           resourceGroupIdentity,
@@ -312,18 +430,24 @@ func (m *MachineScope) CreateMachine() (*vpcv1.Instance, error) { //nolint: gocy
 		if placementTarget != nil {
 			imageInstancePrototype.PlacementTarget = placementTarget
 		}
+		if reservationAffinity != nil {
+			imageInstancePrototype.ReservationAffinity = reservationAffinity
+		}
 		if len(sshKeys) > 0 {
 			imageInstancePrototype.Keys = sshKeys
 		}
 		if bootVolumeAttachment != nil {
 			imageInstancePrototype.BootVolumeAttachment = bootVolumeAttachment
 		}
+		if len(dataVolumeAttachments) > 0 {
+			imageInstancePrototype.VolumeAttachments = dataVolumeAttachments
+		}
 
 		m.Logger.Info("machine creation configured with existing image", "machineName", m.IBMVPCMachine.Name, "imageID", *imageID)
 		options.SetInstancePrototype(imageInstancePrototype)
 	} else if m.IBMVPCMachine.Spec.CatalogOffering != nil {
 		catalogInstancePrototype := &vpcv1.InstancePrototypeInstanceByCatalogOffering{
-			Name:                    ptr.To(m.IBMVPCMachine.Name),
+			Name:                    ptr.To(m.instanceName()),
 			Profile:                 profile,
 			PrimaryNetworkInterface: primaryNetworkInterface,
 			ResourceGroup:           resourceGroupIdentity,
@@ -360,12 +484,18 @@ func (m *MachineScope) CreateMachine() (*vpcv1.Instance, error) { //nolint: gocy
 		if placementTarget != nil {
 			catalogInstancePrototype.PlacementTarget = placementTarget
 		}
+		if reservationAffinity != nil {
+			catalogInstancePrototype.ReservationAffinity = reservationAffinity
+		}
 		if len(sshKeys) > 0 {
 			catalogInstancePrototype.Keys = sshKeys
 		}
 		if bootVolumeAttachment != nil {
 			catalogInstancePrototype.BootVolumeAttachment = bootVolumeAttachment
 		}
+		if len(dataVolumeAttachments) > 0 {
+			catalogInstancePrototype.VolumeAttachments = dataVolumeAttachments
+		}
 
 		catalogInstancePrototype.CatalogOffering = catalogOfferingPrototype
 		options.SetInstancePrototype(catalogInstancePrototype)
@@ -374,42 +504,340 @@ func (m *MachineScope) CreateMachine() (*vpcv1.Instance, error) { //nolint: gocy
 		return nil, fmt.Errorf("error no machine image or catalog offering provided to build: %s", m.IBMVPCMachine.Spec.Name)
 	}
 
-	m.Logger.Info("creating instance", "createOptions", options, "name", m.IBMVPCMachine.Name, "profile", *profile.Name, "resourceGroup", resourceGroupIdentity, "vpc", vpcIdentity, "zone", zone)
+	m.Logger.Info("creating instance", "createOptions", options, "name", m.instanceName(), "profile", *profile.Name, "resourceGroup", resourceGroupIdentity, "vpc", vpcIdentity, "zone", zone)
 	instance, _, err := m.IBMVPCClient.CreateInstance(options)
 	if err != nil {
 		record.Warnf(m.IBMVPCMachine, "FailedCreateInstance", "Failed instance creation - %s, %v", options, err)
+		m.recordAction(infrav1beta2.MachineActionCreate, "", "failed to create instance", err)
 	} else {
 		record.Eventf(m.IBMVPCMachine, "SuccessfulCreateInstance", "Created Instance %q", *instance.Name)
+		m.recordAction(infrav1beta2.MachineActionCreate, ptr.Deref(instance.ID, ""), fmt.Sprintf("created instance %s", *instance.Name), nil)
 	}
 	return instance, err
 }
 
+// TagInstance attaches the tag of the cluster and the user tags of the machine to its instance. The tags are attached
+// on every reconcile, so a tag which can't be attached is retried once the returned error requeues the machine.
+func (m *MachineScope) TagInstance(instance *vpcv1.Instance) error {
+	if err := m.TagResource(m.IBMVPCCluster.Name, *instance.CRN); err != nil {
+		return err
+	}
+	for _, tag := range m.IBMVPCMachine.Spec.UserTags {
+		if err := m.TagResource(tag, *instance.CRN); err != nil {
+			record.Warnf(m.IBMVPCMachine, "FailedTagInstance", "Failed to attach user tag %q to instance %q - %v", tag, *instance.Name, err)
+			return fmt.Errorf("failed to attach user tag %s: %w", tag, err)
+		}
+	}
+	return nil
+}
+
+// getSecurityGroupIdentities returns the Security Groups to attach to the primary network interface of the machine.
+// The PrimaryNetworkInterface's SecurityGroups take precedence over the Security Groups defined for the Cluster, the
+// machine's additional SecurityGroups are always attached.
+func (m *MachineScope) getSecurityGroupIdentities() ([]vpcv1.SecurityGroupIdentityIntf, error) {
+	securityGroups := m.IBMVPCMachine.Spec.PrimaryNetworkInterface.SecurityGroups
+	if len(securityGroups) == 0 {
+		securityGroups = m.getClusterSecurityGroups()
+	}
+	securityGroups = append(append([]infrav1beta2.VPCResource{}, securityGroups...), m.IBMVPCMachine.Spec.SecurityGroups...)
+
+	securityGroupIdentities := make([]vpcv1.SecurityGroupIdentityIntf, 0, len(securityGroups))
+	securityGroupIDs := make(map[string]bool, len(securityGroups))
+	for _, sg := range securityGroups {
+		sgID, err := m.getSecurityGroupID(sg)
+		if err != nil {
+			return nil, err
+		}
+		// Skip Security Groups defined both for the Cluster and the Machine.
+		if securityGroupIDs[*sgID] {
+			continue
+		}
+		securityGroupIDs[*sgID] = true
+		securityGroupIdentities = append(securityGroupIdentities, &vpcv1.SecurityGroupIdentityByID{
+			ID: sgID,
+		})
+	}
+	return securityGroupIdentities, nil
+}
+
+// getClusterSecurityGroups returns the Security Groups defined in the Network of the IBMVPCCluster.
+func (m *MachineScope) getClusterSecurityGroups() []infrav1beta2.VPCResource {
+	if m.IBMVPCCluster.Spec.Network == nil {
+		return nil
+	}
+	securityGroups := make([]infrav1beta2.VPCResource, 0, len(m.IBMVPCCluster.Spec.Network.SecurityGroups))
+	for _, sg := range m.IBMVPCCluster.Spec.Network.SecurityGroups {
+		securityGroups = append(securityGroups, infrav1beta2.VPCResource{
+			ID:   sg.ID,
+			Name: sg.Name,
+		})
+	}
+	return securityGroups
+}
+
+// getSecurityGroupID returns the ID of the Security Group, looked up from the Network Status or via API.
+func (m *MachineScope) getSecurityGroupID(sg infrav1beta2.VPCResource) (*string, error) {
+	// Try using Security Group name if provided.
+	if sg.Name != nil {
+		// If Network Status is available, attempt to retrieve Security Group ID from there.
+		if m.IBMVPCCluster.Status.Network != nil {
+			if sgStatus, ok := m.IBMVPCCluster.Status.Network.SecurityGroups[*sg.Name]; ok {
+				return ptr.To(sgStatus.ID), nil
+			}
+		}
+		// If not found in Network Status, try looking up the Security Group via API.
+		sgDetails, err := m.IBMVPCClient.GetSecurityGroupByName(*sg.Name)
+		if err == nil && sgDetails != nil {
+			var matched bool
+			if matched, err = m.MatchesResourceSelector(infrav1beta2.ResourceTypeSecurityGroup, *sg.Name, sgDetails.CRN); err == nil && !matched {
+				sgDetails = nil
+			}
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error retrieving security group id with name %s for machine %s: %w", *sg.Name, m.IBMVPCMachine.Name, err)
+		} else if sgDetails != nil {
+			return sgDetails.ID, nil
+		}
+		// If Name was provided but it cannot be found in Network Status or via API, return an error.
+		return nil, fmt.Errorf("error cannot find security group %s for machine %s", *sg.Name, m.IBMVPCMachine.Name)
+	}
+	// If ID is provided for Security Group, attempt lookup to confirm it exists.
+	if sg.ID != nil {
+		sgOptions := &vpcv1.GetSecurityGroupOptions{
+			ID: sg.ID,
+		}
+		sgDetails, _, err := m.IBMVPCClient.GetSecurityGroup(sgOptions)
+		if err != nil {
+			return nil, fmt.Errorf("error retrieving security by id %s for machine %s: %w", *sg.ID, m.IBMVPCMachine.Name, err)
+		} else if sgDetails == nil {
+			return nil, fmt.Errorf("error security group not found with id %s for machine %s", *sg.ID, m.IBMVPCMachine.Name)
+		}
+		return sg.ID, nil
+	}
+	return nil, fmt.Errorf("error no name or id provided for security group for machine %s", m.IBMVPCMachine.Name)
+}
+
 // configurePlacementTarget will configure a Machine's Placement Target based on the Machine's provided configuration, if supplied.
 func (m *MachineScope) configurePlacementTarget() (vpcv1.InstancePlacementTargetPrototypeIntf, error) {
-	// TODO(cjschaef): We currently don't support the other placement target options (Dedicated Host Group, Placement Group), they need to be added.
-	if m.IBMVPCMachine.Spec.PlacementTarget.DedicatedHost != nil {
-		// Lookup Dedicated Host ID by Name if it was provided.
-		var dedicatedHostID *string
-		if m.IBMVPCMachine.Spec.PlacementTarget.DedicatedHost.ID != nil {
-			dedicatedHostID = m.IBMVPCMachine.Spec.PlacementTarget.DedicatedHost.ID
-		} else if m.IBMVPCMachine.Spec.PlacementTarget.DedicatedHost.Name != nil {
-			dHost, err := m.IBMVPCClient.GetDedicatedHostByName(*m.IBMVPCMachine.Spec.PlacementTarget.DedicatedHost.Name)
-			if err != nil {
-				return nil, fmt.Errorf("error failed lookup of dedicated host by name %s: %w", *m.IBMVPCMachine.Spec.PlacementTarget.DedicatedHost.Name, err)
-			} else if dHost == nil {
-				return nil, fmt.Errorf("error no dedicated host found with name %s", *m.IBMVPCMachine.Spec.PlacementTarget.DedicatedHost.Name)
-			}
-			dedicatedHostID = dHost.ID
+	placementTarget := m.IBMVPCMachine.Spec.PlacementTarget
+	switch {
+	case placementTarget.DedicatedHost != nil:
+		dHost, err := m.getDedicatedHost(placementTarget.DedicatedHost)
+		if err != nil {
+			return nil, err
+		}
+		if dHost.Zone != nil && dHost.Zone.Name != nil && *dHost.Zone.Name != m.zone() {
+			return nil, fmt.Errorf("error dedicated host %s is in zone %s, not in the machine zone %s", *dHost.ID, *dHost.Zone.Name, m.zone())
+		}
+
+		m.Logger.Info("machine creation configured with dedicated host placement", "machineName", m.IBMVPCMachine.Name, "dedicatedHostID", *dHost.ID)
+		return &vpcv1.InstancePlacementTargetPrototypeDedicatedHostIdentityDedicatedHostIdentityByID{
+			ID: dHost.ID,
+		}, nil
+	case placementTarget.DedicatedHostGroup != nil:
+		dHostGroup, err := m.getDedicatedHostGroup(placementTarget.DedicatedHostGroup)
+		if err != nil {
+			return nil, err
+		}
+		if dHostGroup.Zone != nil && dHostGroup.Zone.Name != nil && *dHostGroup.Zone.Name != m.zone() {
+			return nil, fmt.Errorf("error dedicated host group %s is in zone %s, not in the machine zone %s", *dHostGroup.ID, *dHostGroup.Zone.Name, m.zone())
 		}
 
-		m.Logger.Info("machine creation configured with dedicated host placement", "machineName", m.IBMVPCMachine.Name, "dedicatedHostID", *dedicatedHostID)
+		m.Logger.Info("machine creation configured with dedicated host group placement", "machineName", m.IBMVPCMachine.Name, "dedicatedHostGroupID", *dHostGroup.ID)
 		return &vpcv1.InstancePlacementTargetPrototypeDedicatedHostGroupIdentityDedicatedHostGroupIdentityByID{
-			ID: dedicatedHostID,
+			ID: dHostGroup.ID,
+		}, nil
+	case placementTarget.PlacementGroup != nil:
+		placementGroup, err := m.getPlacementGroup(placementTarget.PlacementGroup)
+		if err != nil {
+			return nil, err
+		}
+
+		m.Logger.Info("machine creation configured with placement group", "machineName", m.IBMVPCMachine.Name, "placementGroupID", *placementGroup.ID)
+		return &vpcv1.InstancePlacementTargetPrototypePlacementGroupIdentityPlacementGroupIdentityByID{
+			ID: placementGroup.ID,
 		}, nil
 	}
 	return nil, nil
 }
 
+// getDedicatedHost returns the Dedicated Host referenced by its id or name.
+func (m *MachineScope) getDedicatedHost(resource *infrav1beta2.VPCResource) (*vpcv1.DedicatedHost, error) {
+	if resource.ID != nil {
+		dHost, _, err := m.IBMVPCClient.GetDedicatedHost(&vpcv1.GetDedicatedHostOptions{ID: resource.ID})
+		if err != nil {
+			return nil, fmt.Errorf("error failed lookup of dedicated host by id %s: %w", *resource.ID, err)
+		} else if dHost == nil {
+			return nil, fmt.Errorf("error no dedicated host found with id %s", *resource.ID)
+		}
+		return dHost, nil
+	} else if resource.Name != nil {
+		dHost, err := m.IBMVPCClient.GetDedicatedHostByName(*resource.Name)
+		if err == nil && dHost != nil {
+			var matched bool
+			if matched, err = m.MatchesResourceSelector(infrav1beta2.ResourceTypeDedicatedHost, *resource.Name, dHost.CRN); err == nil && !matched {
+				dHost = nil
+			}
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error failed lookup of dedicated host by name %s: %w", *resource.Name, err)
+		} else if dHost == nil {
+			return nil, fmt.Errorf("error no dedicated host found with name %s", *resource.Name)
+		}
+		return dHost, nil
+	}
+	return nil, fmt.Errorf("error no name or id provided for dedicated host")
+}
+
+// getDedicatedHostGroup returns the Dedicated Host Group referenced by its id or name.
+func (m *MachineScope) getDedicatedHostGroup(resource *infrav1beta2.VPCResource) (*vpcv1.DedicatedHostGroup, error) {
+	if resource.ID != nil {
+		dHostGroup, _, err := m.IBMVPCClient.GetDedicatedHostGroup(&vpcv1.GetDedicatedHostGroupOptions{ID: resource.ID})
+		if err != nil {
+			return nil, fmt.Errorf("error failed lookup of dedicated host group by id %s: %w", *resource.ID, err)
+		} else if dHostGroup == nil {
+			return nil, fmt.Errorf("error no dedicated host group found with id %s", *resource.ID)
+		}
+		return dHostGroup, nil
+	} else if resource.Name != nil {
+		dHostGroup, err := m.IBMVPCClient.GetDedicatedHostGroupByName(*resource.Name)
+		if err == nil && dHostGroup != nil {
+			var matched bool
+			if matched, err = m.MatchesResourceSelector(infrav1beta2.ResourceTypeDedicatedHostGroup, *resource.Name, dHostGroup.CRN); err == nil && !matched {
+				dHostGroup = nil
+			}
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error failed lookup of dedicated host group by name %s: %w", *resource.Name, err)
+		} else if dHostGroup == nil {
+			return nil, fmt.Errorf("error no dedicated host group found with name %s", *resource.Name)
+		}
+		return dHostGroup, nil
+	}
+	return nil, fmt.Errorf("error no name or id provided for dedicated host group")
+}
+
+// PlacementTargetZone returns the zone of the dedicated host or dedicated host group of the placement target.
+func PlacementTargetZone(c vpc.Vpc, placementTarget infrav1beta2.VPCMachinePlacementTarget) (string, error) {
+	var zone *vpcv1.ZoneReference
+	switch {
+	case placementTarget.DedicatedHost != nil && placementTarget.DedicatedHost.ID != nil:
+		dHost, _, err := c.GetDedicatedHost(&vpcv1.GetDedicatedHostOptions{ID: placementTarget.DedicatedHost.ID})
+		if err != nil {
+			return "", fmt.Errorf("failed to get dedicated host %s: %w", *placementTarget.DedicatedHost.ID, err)
+		}
+		zone = dHost.Zone
+	case placementTarget.DedicatedHost != nil && placementTarget.DedicatedHost.Name != nil:
+		dHost, err := c.GetDedicatedHostByName(*placementTarget.DedicatedHost.Name)
+		if err != nil {
+			return "", fmt.Errorf("failed to get dedicated host %s: %w", *placementTarget.DedicatedHost.Name, err)
+		} else if dHost == nil {
+			return "", fmt.Errorf("dedicated host %s not found", *placementTarget.DedicatedHost.Name)
+		}
+		zone = dHost.Zone
+	case placementTarget.DedicatedHostGroup != nil && placementTarget.DedicatedHostGroup.ID != nil:
+		dHostGroup, _, err := c.GetDedicatedHostGroup(&vpcv1.GetDedicatedHostGroupOptions{ID: placementTarget.DedicatedHostGroup.ID})
+		if err != nil {
+			return "", fmt.Errorf("failed to get dedicated host group %s: %w", *placementTarget.DedicatedHostGroup.ID, err)
+		}
+		zone = dHostGroup.Zone
+	case placementTarget.DedicatedHostGroup != nil && placementTarget.DedicatedHostGroup.Name != nil:
+		dHostGroup, err := c.GetDedicatedHostGroupByName(*placementTarget.DedicatedHostGroup.Name)
+		if err != nil {
+			return "", fmt.Errorf("failed to get dedicated host group %s: %w", *placementTarget.DedicatedHostGroup.Name, err)
+		} else if dHostGroup == nil {
+			return "", fmt.Errorf("dedicated host group %s not found", *placementTarget.DedicatedHostGroup.Name)
+		}
+		zone = dHostGroup.Zone
+	default:
+		return "", fmt.Errorf("no dedicated host or dedicated host group id or name provided")
+	}
+	if zone == nil || zone.Name == nil {
+		return "", fmt.Errorf("zone of the placement target is unknown")
+	}
+	return *zone.Name, nil
+}
+
+// getPlacementGroup returns the Placement Group referenced by its id or name.
+func (m *MachineScope) getPlacementGroup(resource *infrav1beta2.VPCResource) (*vpcv1.PlacementGroup, error) {
+	if resource.ID != nil {
+		placementGroup, _, err := m.IBMVPCClient.GetPlacementGroup(&vpcv1.GetPlacementGroupOptions{ID: resource.ID})
+		if err != nil {
+			return nil, fmt.Errorf("error failed lookup of placement group by id %s: %w", *resource.ID, err)
+		} else if placementGroup == nil {
+			return nil, fmt.Errorf("error no placement group found with id %s", *resource.ID)
+		}
+		return placementGroup, nil
+	} else if resource.Name != nil {
+		placementGroup, err := m.IBMVPCClient.GetPlacementGroupByName(*resource.Name)
+		if err == nil && placementGroup != nil {
+			var matched bool
+			if matched, err = m.MatchesResourceSelector(infrav1beta2.ResourceTypePlacementGroup, *resource.Name, placementGroup.CRN); err == nil && !matched {
+				placementGroup = nil
+			}
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error failed lookup of placement group by name %s: %w", *resource.Name, err)
+		} else if placementGroup == nil {
+			return nil, fmt.Errorf("error no placement group found with name %s", *resource.Name)
+		}
+		return placementGroup, nil
+	}
+	return nil, fmt.Errorf("error no name or id provided for placement group")
+}
+
+// configureReservationAffinity will configure a Machine's Reservation affinity based on the Machine's provided capacity configuration, if supplied.
+func (m *MachineScope) configureReservationAffinity() (*vpcv1.InstanceReservationAffinityPrototype, error) {
+	capacity := m.IBMVPCMachine.Spec.Capacity
+	if capacity.Reservation == nil {
+		switch capacity.ReservationAffinityPolicy {
+		case infrav1beta2.VPCMachineReservationAffinityPolicyAutomatic:
+			return &vpcv1.InstanceReservationAffinityPrototype{
+				Policy: ptr.To(vpcv1.InstanceReservationAffinityPrototypePolicyAutomaticConst),
+			}, nil
+		case infrav1beta2.VPCMachineReservationAffinityPolicyDisabled:
+			return &vpcv1.InstanceReservationAffinityPrototype{
+				Policy: ptr.To(vpcv1.InstanceReservationAffinityPrototypePolicyDisabledConst),
+			}, nil
+		case infrav1beta2.VPCMachineReservationAffinityPolicyManual:
+			return nil, fmt.Errorf("error no reservation provided for manual reservation affinity policy")
+		}
+		return nil, nil
+	}
+
+	// Lookup Reservation ID by Name if it was provided.
+	var reservationID *string
+	if capacity.Reservation.ID != nil {
+		reservationID = capacity.Reservation.ID
+	} else if capacity.Reservation.Name != nil {
+		reservation, err := m.IBMVPCClient.GetReservationByName(*capacity.Reservation.Name)
+		if err == nil && reservation != nil {
+			var matched bool
+			if matched, err = m.MatchesResourceSelector(infrav1beta2.ResourceTypeReservation, *capacity.Reservation.Name, reservation.CRN); err == nil && !matched {
+				reservation = nil
+			}
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error failed lookup of reservation by name %s: %w", *capacity.Reservation.Name, err)
+		} else if reservation == nil {
+			return nil, fmt.Errorf("error no reservation found with name %s", *capacity.Reservation.Name)
+		}
+		reservationID = reservation.ID
+	} else {
+		return nil, fmt.Errorf("error no name or id provided for reservation")
+	}
+
+	m.Logger.Info("machine creation configured with reservation", "machineName", m.IBMVPCMachine.Name, "reservationID", *reservationID)
+	return &vpcv1.InstanceReservationAffinityPrototype{
+		Policy: ptr.To(vpcv1.InstanceReservationAffinityPrototypePolicyManualConst),
+		Pool: []vpcv1.ReservationIdentityIntf{
+			&vpcv1.ReservationIdentityByID{
+				ID: reservationID,
+			},
+		},
+	}, nil
+}
+
 func (m *MachineScope) volumeToVPCVolumeAttachment(volume *infrav1beta2.VPCVolume) *vpcv1.VolumeAttachmentPrototypeInstanceByImageContext {
 	bootVolume := &vpcv1.VolumeAttachmentPrototypeInstanceByImageContext{
 		DeleteVolumeOnInstanceDelete: core.BoolPtr(volume.DeleteVolumeOnInstanceDelete),
@@ -434,16 +862,61 @@ func (m *MachineScope) volumeToVPCVolumeAttachment(volume *infrav1beta2.VPCVolum
 		bootVolume.Volume.Iops = core.Int64Ptr(volume.Iops)
 	}
 
-	if volume.EncryptionKeyCRN != "" {
+	if encryptionKeyCRN := m.volumeEncryptionKeyCRN(volume); encryptionKeyCRN != "" {
 		bootVolume.Volume.EncryptionKey = &vpcv1.EncryptionKeyIdentity{
-			CRN: core.StringPtr(volume.EncryptionKeyCRN),
+			CRN: core.StringPtr(encryptionKeyCRN),
 		}
-		m.Logger.Info("machine creation configured with volumn encryption key", "machineName", m.IBMVPCMachine.Name, "encryptionKeyCRN", volume.EncryptionKeyCRN)
+		m.Logger.Info("machine creation configured with volumn encryption key", "machineName", m.IBMVPCMachine.Name, "encryptionKeyCRN", encryptionKeyCRN)
 	}
 
 	return bootVolume
 }
 
+// dataVolumeAttachments returns the attachments of the data volumes created along with the instance.
+// The volume names are prefixed with the cluster and machine names, so the machines of the clusters sharing a VPC don't conflict.
+func (m *MachineScope) dataVolumeAttachments() []vpcv1.VolumeAttachmentPrototype {
+	attachments := make([]vpcv1.VolumeAttachmentPrototype, 0, len(m.IBMVPCMachine.Spec.AdditionalVolumes))
+	for i, volume := range m.IBMVPCMachine.Spec.AdditionalVolumes {
+		if volume == nil {
+			continue
+		}
+		name := names.Normalize(dataVolumeName(m.Machine.Spec.ClusterName, m.IBMVPCMachine.Name, i, volume.Name), names.VPCMaxLength)
+		profile := volume.Profile
+		if profile == "" {
+			profile = "general-purpose"
+		}
+		dataVolume := &vpcv1.VolumeAttachmentPrototypeVolumeVolumePrototypeInstanceContextVolumePrototypeInstanceContextVolumeByCapacity{
+			Name:     core.StringPtr(name),
+			Capacity: core.Int64Ptr(volume.SizeGiB),
+			Profile: &vpcv1.VolumeProfileIdentity{
+				Name: core.StringPtr(profile),
+			},
+		}
+		if volume.Iops != 0 {
+			dataVolume.Iops = core.Int64Ptr(volume.Iops)
+		}
+		if encryptionKeyCRN := m.volumeEncryptionKeyCRN(volume); encryptionKeyCRN != "" {
+			dataVolume.EncryptionKey = &vpcv1.EncryptionKeyIdentity{
+				CRN: core.StringPtr(encryptionKeyCRN),
+			}
+		}
+		attachments = append(attachments, vpcv1.VolumeAttachmentPrototype{
+			DeleteVolumeOnInstanceDelete: core.BoolPtr(volume.DeleteVolumeOnInstanceDelete),
+			Name:                         core.StringPtr(name),
+			Volume:                       dataVolume,
+		})
+	}
+	return attachments
+}
+
+// volumeEncryptionKeyCRN returns the root key used to encrypt the volume, the key of the machine applies to the volumes without a key of their own.
+func (m *MachineScope) volumeEncryptionKeyCRN(volume *infrav1beta2.VPCVolume) string {
+	if volume.EncryptionKeyCRN != "" {
+		return volume.EncryptionKeyCRN
+	}
+	return m.IBMVPCMachine.Spec.EncryptionKeyCRN
+}
+
 // DeleteMachine deletes the vpc machine associated with machine instance id.
 func (m *MachineScope) DeleteMachine() error {
 	if m.IBMVPCMachine.Status.InstanceID == "" {
@@ -451,15 +924,163 @@ func (m *MachineScope) DeleteMachine() error {
 	}
 	options := &vpcv1.DeleteInstanceOptions{}
 	options.SetID(m.IBMVPCMachine.Status.InstanceID)
-	_, err := m.IBMVPCClient.DeleteInstance(options)
+	response, err := m.IBMVPCClient.DeleteInstance(options)
+	if err != nil && response != nil && response.StatusCode == http.StatusNotFound {
+		// The instance was already deleted, for example after being reclaimed by IBM Cloud.
+		m.Info("instance not found, skipping deletion", "instanceID", m.IBMVPCMachine.Status.InstanceID)
+		m.recordAction(infrav1beta2.MachineActionDelete, m.IBMVPCMachine.Status.InstanceID, "instance already deleted", nil)
+		return nil
+	}
 	if err != nil {
 		record.Warnf(m.IBMVPCMachine, "FailedDeleteInstance", "Failed instance deletion - %v", err)
+		m.recordAction(infrav1beta2.MachineActionDelete, m.IBMVPCMachine.Status.InstanceID, "failed to delete instance", err)
 	} else {
 		record.Eventf(m.IBMVPCMachine, "SuccessfulDeleteInstance", "Deleted Instance %q", m.IBMVPCMachine.Name)
+		m.recordAction(infrav1beta2.MachineActionDelete, m.IBMVPCMachine.Status.InstanceID, "deleted instance", nil)
 	}
 	return err
 }
 
+// ReconcileInstanceShutdown stops the instance gracefully before it gets deleted, so its operating system shuts down cleanly,
+// it returns true while the instance is stopping within the shutdown timeout of the machine.
+func (m *MachineScope) ReconcileInstanceShutdown() (bool, error) {
+	if isInstanceShutdownDone(m.IBMVPCMachine) {
+		return false, nil
+	}
+	timeout := shutdownTimeout(m.IBMVPCMachine.Spec.ShutdownTimeout)
+	if timeout == 0 {
+		return false, nil
+	}
+	instanceID := m.IBMVPCMachine.Status.InstanceID
+	instance, response, err := m.IBMVPCClient.GetInstance(&vpcv1.GetInstanceOptions{
+		ID: ptr.To(instanceID),
+	})
+	if err != nil {
+		if response != nil && response.StatusCode == http.StatusNotFound {
+			m.Info("instance not found, skipping shutdown", "instanceID", instanceID)
+			conditions.MarkTrue(m.IBMVPCMachine, infrav1beta2.InstanceShutdownCondition)
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to get instance %s: %w", instanceID, err)
+	}
+	switch ptr.Deref(instance.Status, "") {
+	case vpcv1.InstanceStatusStoppedConst:
+		conditions.MarkTrue(m.IBMVPCMachine, infrav1beta2.InstanceShutdownCondition)
+		return false, nil
+	case vpcv1.InstanceStatusFailedConst:
+		// A failed instance can't be stopped gracefully.
+		conditions.MarkFalse(m.IBMVPCMachine, infrav1beta2.InstanceShutdownCondition, infrav1beta2.InstanceErroredReason, capiv1beta1.ConditionSeverityWarning, "")
+		return false, nil
+	}
+	m.V(3).Info("Stopping instance", "instanceID", instanceID)
+	return reconcileInstanceShutdown(m.IBMVPCMachine, timeout, func() error {
+		if _, _, err := m.IBMVPCClient.CreateInstanceAction(&vpcv1.CreateInstanceActionOptions{
+			InstanceID: ptr.To(instanceID),
+			Type:       ptr.To(vpcv1.InstanceActionTypeStopConst),
+			Force:      ptr.To(false),
+		}); err != nil {
+			m.recordAction(infrav1beta2.MachineActionStop, instanceID, "failed to stop instance", err)
+			return err
+		}
+		m.recordAction(infrav1beta2.MachineActionStop, instanceID, "stopping instance before deletion", nil)
+		return nil
+	})
+}
+
+// ReconcileVolumeDetach detaches the data volumes from the instance before it gets deleted, it returns true
+// while data volumes are attached to the instance within the volume detach timeout.
+// The volumes deleted along with the instance are left attached.
+func (m *MachineScope) ReconcileVolumeDetach() (bool, error) {
+	if isVolumeDetachDone(m.IBMVPCMachine) {
+		return false, nil
+	}
+	instanceID := m.IBMVPCMachine.Status.InstanceID
+	attachments, response, err := m.IBMVPCClient.ListInstanceVolumeAttachments(&vpcv1.ListInstanceVolumeAttachmentsOptions{
+		InstanceID: ptr.To(instanceID),
+	})
+	if err != nil {
+		if response != nil && response.StatusCode == http.StatusNotFound {
+			m.Info("instance not found, skipping volume detachment", "instanceID", instanceID)
+			conditions.MarkTrue(m.IBMVPCMachine, infrav1beta2.VolumesDetachedCondition)
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to list volume attachments of instance %s: %w", instanceID, err)
+	}
+	attached := 0
+	for _, attachment := range attachments.VolumeAttachments {
+		if ptr.Deref(attachment.Type, "") != vpcv1.VolumeAttachmentTypeDataConst || ptr.Deref(attachment.DeleteVolumeOnInstanceDelete, false) {
+			continue
+		}
+		attached++
+		if status := ptr.Deref(attachment.Status, ""); status == vpcv1.VolumeAttachmentStatusDetachingConst || status == vpcv1.VolumeAttachmentStatusDeletingConst {
+			continue
+		}
+		m.Info("Detaching volume from instance", "volumeAttachmentID", *attachment.ID, "instanceID", instanceID)
+		if _, err := m.IBMVPCClient.DeleteInstanceVolumeAttachment(&vpcv1.DeleteInstanceVolumeAttachmentOptions{
+			InstanceID: ptr.To(instanceID),
+			ID:         attachment.ID,
+		}); err != nil {
+			record.Warnf(m.IBMVPCMachine, "FailedDetachVolume", "Failed volume attachment %s deletion - %v", *attachment.ID, err)
+			return false, err
+		}
+		record.Eventf(m.IBMVPCMachine, "SuccessfulDetachVolume", "Detached volume attachment %q", ptr.Deref(attachment.Name, *attachment.ID))
+	}
+	if attached == 0 {
+		conditions.MarkTrue(m.IBMVPCMachine, infrav1beta2.VolumesDetachedCondition)
+		return false, nil
+	}
+	return markVolumesDetaching(m.IBMVPCMachine, attached), nil
+}
+
+// interruptionStatusReasons are the status reason codes of the instances stopped by IBM Cloud which can't be started again
+// on the capacity they were provisioned with.
+var interruptionStatusReasons = []string{
+	vpcv1.InstanceStatusReasonCodeStoppedByHostFailureConst,
+	vpcv1.InstanceStatusReasonCodeCannotStartReservationExpiredConst,
+	vpcv1.InstanceStatusReasonCodeCannotStartReservationCapacityConst,
+}
+
+// interruptionHealthReasons are the health reason codes of the instances whose reserved capacity was reclaimed by IBM Cloud.
+var interruptionHealthReasons = []string{
+	vpcv1.InstanceHealthReasonCodeReservationExpiredConst,
+	vpcv1.InstanceHealthReasonCodeReservationDeletedConst,
+	vpcv1.InstanceHealthReasonCodeReservationCapacityUnavailableConst,
+}
+
+// IsInstanceInterrupted returns the reason code when the instance of a Machine using the DeleteMachine interruption policy was
+// reclaimed by IBM Cloud, an empty string otherwise. Only the Machines replaced by their MachineSet or MachinePool once deleted
+// are reported as interrupted, the Machines of the control plane are left to the remediation of their control plane.
+func (m *MachineScope) IsInstanceInterrupted(instance *vpcv1.Instance) string {
+	if m.IBMVPCMachine.Spec.Capacity == nil || m.IBMVPCMachine.Spec.Capacity.InterruptionPolicy != infrav1beta2.VPCMachineInterruptionPolicyDeleteMachine {
+		return ""
+	}
+	if instance == nil || !isReplacedOnDeletion(m.Machine) {
+		return ""
+	}
+	for _, reason := range instance.StatusReasons {
+		if reason.Code != nil && slices.Contains(interruptionStatusReasons, *reason.Code) {
+			return *reason.Code
+		}
+	}
+	for _, reason := range instance.HealthReasons {
+		if reason.Code != nil && slices.Contains(interruptionHealthReasons, *reason.Code) {
+			return *reason.Code
+		}
+	}
+	return ""
+}
+
+// ShouldAutoRepair returns true when the Machine should be replaced because IBM Cloud reports its instance as failed.
+func (m *MachineScope) ShouldAutoRepair() bool {
+	return shouldAutoRepair(m.Machine, m.IBMVPCMachine.Spec.AutoRepairPolicy)
+}
+
+// DeleteOwnerMachine deletes the Machine owning the IBMVPCMachine, so it gets replaced by its MachineSet or MachinePool.
+// The reason is recorded in the action history of the IBMVPCMachine.
+func (m *MachineScope) DeleteOwnerMachine(reason string) error {
+	return remediateMachine(m.Client, m.Machine, &m.IBMVPCMachine.Status.ActionHistory, m.IBMVPCMachine.Status.InstanceID, reason)
+}
+
 func (m *MachineScope) ensureInstanceUnique(instanceName string) (*vpcv1.Instance, error) {
 	var instance *vpcv1.Instance
 	f := func(start string) (bool, string, error) {
@@ -505,6 +1126,12 @@ func (m *MachineScope) getLoadBalancerID(loadBalancer *infrav1beta2.VPCResource)
 		return loadBalancer.ID, nil
 	} else if loadBalancer.Name != nil {
 		loadBalancerDetails, err := m.IBMVPCClient.GetLoadBalancerByName(*loadBalancer.Name)
+		if err == nil && loadBalancerDetails != nil {
+			var matched bool
+			if matched, err = m.MatchesResourceSelector(infrav1beta2.ResourceTypeLoadBalancer, *loadBalancer.Name, loadBalancerDetails.CRN); err == nil && !matched {
+				loadBalancerDetails = nil
+			}
+		}
 		if err != nil {
 			return nil, fmt.Errorf("error failed to lookup load balancer id by name %s: %w", *loadBalancer.Name, err)
 		} else if loadBalancerDetails == nil || loadBalancerDetails.ID == nil {
@@ -863,6 +1490,11 @@ func (m *MachineScope) deleteVPCLoadBalancerPoolMembers() error {
 	return nil
 }
 
+// recordAction records an action performed against the instance in the action history of the IBMVPCMachine.
+func (m *MachineScope) recordAction(actionType infrav1beta2.MachineActionType, instanceID, message string, err error) {
+	m.IBMVPCMachine.Status.ActionHistory = appendMachineAction(m.IBMVPCMachine.Status.ActionHistory, actionType, instanceID, message, err)
+}
+
 // PatchObject persists the cluster configuration and status.
 func (m *MachineScope) PatchObject() error {
 	return m.patchHelper.Patch(context.TODO(), m.IBMVPCMachine)
@@ -873,6 +1505,104 @@ func (m *MachineScope) Close() error {
 	return m.PatchObject()
 }
 
+// resolveUserData returns the user data of the instance. Ignition bootstrap data exceeding the user data limit is staged in the
+// bootstrap data bucket of the cluster and replaced by an Ignition config fetching it with a pre-signed URL, while cloud-configs
+// are wrapped along with the node labels and taints of the machine, and the bootstrap sentinel when the result of the bootstrap is
+// reported, as long as they fit into the user data limit.
+func (m *MachineScope) resolveUserData(bootstrapData string) (string, error) {
+	if !ignition.IsIgnition([]byte(bootstrapData)) {
+		nodeRegistration := cloudinit.NodeRegistrationBoothook(m.IBMVPCMachine.Spec.NodeLabels, m.IBMVPCMachine.Spec.NodeTaints)
+		if userData, injected := injectBoothooks([]byte(bootstrapData), m.IBMVPCMachine.Spec.ReportBootstrapResult, nodeRegistration); injected && len(userData) <= vpcUserDataLimit {
+			return string(userData), nil
+		}
+		if nodeRegistration != "" {
+			record.Warnf(m.IBMVPCMachine, "SkippedNodeRegistration", "Skipped node labels and taints as bootstrap data is not a cloud-config or exceeds the user data limit")
+		}
+		if m.IBMVPCMachine.Spec.ReportBootstrapResult {
+			record.Warnf(m.IBMVPCMachine, "SkippedBootstrapResult", "Skipped bootstrap result reporting as bootstrap data is not a cloud-config or exceeds the user data limit")
+		}
+		if len(bootstrapData) > vpcUserDataLimit {
+			return "", fmt.Errorf("bootstrap data of %d bytes exceeds the user data limit of %d bytes", len(bootstrapData), vpcUserDataLimit)
+		}
+		return bootstrapData, nil
+	}
+	if len(m.IBMVPCMachine.Spec.NodeLabels) > 0 || len(m.IBMVPCMachine.Spec.NodeTaints) > 0 {
+		record.Warnf(m.IBMVPCMachine, "SkippedNodeRegistration", "Skipped node labels and taints as they are only supported with cloud-config bootstrap data")
+	}
+	if len(bootstrapData) <= vpcUserDataLimit {
+		return bootstrapData, nil
+	}
+	bucket := m.IBMVPCCluster.Spec.BootstrapDataBucket
+	if bucket == nil {
+		return "", fmt.Errorf("ignition bootstrap data of %d bytes exceeds the user data limit of %d bytes, bootstrapDataBucket must be set on the IBMVPCCluster to stage it", len(bootstrapData), vpcUserDataLimit)
+	}
+
+	cosClient, err := m.cosClient()
+	if err != nil {
+		return "", err
+	}
+	objectURL, err := stageBootstrapData(cosClient, bucket.Name, m.bootstrapDataKey(), []byte(bootstrapData))
+	if err != nil {
+		record.Warnf(m.IBMVPCMachine, "FailedStageBootstrapData", "Failed bootstrap data staging - %v", err)
+		return "", err
+	}
+	userData, err := ignition.ReplaceConfig(ignition.ConfigVersion([]byte(bootstrapData)), objectURL, "")
+	if err != nil {
+		return "", err
+	}
+	record.Eventf(m.IBMVPCMachine, "SuccessfulStageBootstrapData", "Staged bootstrap data in COS bucket %q", bucket.Name)
+	return string(userData), nil
+}
+
+// DeleteBootstrapData deletes the bootstrap data of the machine staged in the bootstrap data bucket of the cluster.
+func (m *MachineScope) DeleteBootstrapData() error {
+	if m.IBMVPCCluster == nil || m.IBMVPCCluster.Spec.BootstrapDataBucket == nil {
+		return nil
+	}
+	bucket := m.IBMVPCCluster.Spec.BootstrapDataBucket
+	cosClient, err := m.cosClient()
+	if err != nil {
+		// Without HMAC credentials no bootstrap data could be staged.
+		if errors.Is(err, errCOSHMACCredentialsNotFound) {
+			return nil
+		}
+		return err
+	}
+	if _, err := cosClient.DeleteObject(&s3.DeleteObjectInput{
+		Bucket: ptr.To(bucket.Name),
+		Key:    ptr.To(m.bootstrapDataKey()),
+	}); err != nil {
+		record.Warnf(m.IBMVPCMachine, "FailedDeleteBootstrapData", "Failed bootstrap data deletion - %v", err)
+		return fmt.Errorf("failed to delete COS object %w", err)
+	}
+	return nil
+}
+
+// bootstrapDataKey returns the key of the object holding the bootstrap data of the machine, the bucket may be shared by several clusters.
+func (m *MachineScope) bootstrapDataKey() string {
+	return path.Join(m.IBMVPCMachine.Namespace, m.IBMVPCCluster.Name, m.IBMVPCMachine.Name)
+}
+
+func (m *MachineScope) cosClient() (cos.Cos, error) {
+	if m.COSClient != nil {
+		return m.COSClient, nil
+	}
+	accessKey, secretKey, err := getCOSHMACCredentials(m.Client, m.IBMVPCCluster.Namespace, m.IBMVPCCluster.Spec.CredentialsSecretRef)
+	if err != nil {
+		return nil, err
+	}
+	region := m.IBMVPCCluster.Spec.BootstrapDataBucket.Region
+	if region == "" {
+		region = m.IBMVPCCluster.Spec.Region
+	}
+	cosClient, err := newCOSHMACClient(region, m.ServiceEndpoint, accessKey, secretKey)
+	if err != nil {
+		return nil, err
+	}
+	m.COSClient = cosClient
+	return cosClient, nil
+}
+
 // GetBootstrapData returns the bootstrap data from the secret in the Machine's bootstrap.dataSecretName.
 func (m *MachineScope) GetBootstrapData() (string, error) {
 	if m.Machine.Spec.Bootstrap.DataSecretName == nil {
@@ -1077,7 +1807,7 @@ func (m *MachineScope) SetNotReady() {
 func (m *MachineScope) SetProviderID(id *string) error {
 	// Based on the ProviderIDFormat version the providerID format will be decided.
 	if options.ProviderIDFormatType(options.ProviderIDFormat) == options.ProviderIDFormatV2 {
-		accountID, err := utils.GetAccountIDWrapper()
+		accountID, err := getAccountID(m.Client, m.IBMVPCCluster.Namespace, m.IBMVPCCluster.Spec.CredentialsSecretRef, m.IBMVPCCluster.Spec.EnterpriseAccount)
 		if err != nil {
 			m.Logger.Error(err, "failed to get cloud account id", err.Error())
 			return err
@@ -1089,11 +1819,77 @@ func (m *MachineScope) SetProviderID(id *string) error {
 	return nil
 }
 
+// zone returns the zone the machine is created in.
+// The failure domain of the Machine, as chosen by Cluster API, takes precedence over the zone of the IBMVPCMachine.
+func (m *MachineScope) zone() string {
+	if m.hasFailureDomain() {
+		return *m.Machine.Spec.FailureDomain
+	}
+	return m.IBMVPCMachine.Spec.Zone
+}
+
+// hasFailureDomain returns true if Cluster API placed the Machine in a failure domain.
+func (m *MachineScope) hasFailureDomain() bool {
+	return m.Machine.Spec.FailureDomain != nil && *m.Machine.Spec.FailureDomain != ""
+}
+
+// getSubnetIDForZone returns the ID of the cluster subnet within the zone.
+// Control Plane machines are placed in the Control Plane subnets, all other machines in the Worker subnets.
+func (m *MachineScope) getSubnetIDForZone(zone string) (*string, error) {
+	// Clusters without extended VPC Infrastructure support only have a single subnet.
+	if m.IBMVPCCluster.Status.Network == nil {
+		if m.IBMVPCCluster.Status.Subnet.ID != nil && m.IBMVPCCluster.Status.Subnet.Zone != nil && *m.IBMVPCCluster.Status.Subnet.Zone == zone {
+			return m.IBMVPCCluster.Status.Subnet.ID, nil
+		}
+		return nil, fmt.Errorf("error no cluster subnet found in zone %s", zone)
+	}
+
+	subnets := m.IBMVPCCluster.Status.Network.WorkerSubnets
+	if util.IsControlPlaneMachine(m.Machine) {
+		subnets = m.IBMVPCCluster.Status.Network.ControlPlaneSubnets
+	}
+	// Walk the subnets in a stable order, so the same subnet is picked when a zone has more than one.
+	names := make([]string, 0, len(subnets))
+	for name := range subnets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		options := &vpcv1.GetSubnetOptions{
+			ID: ptr.To(subnets[name].ID),
+		}
+		subnetDetails, _, err := m.IBMVPCClient.GetSubnet(options)
+		if err != nil {
+			return nil, fmt.Errorf("error retrieving subnet by id %s: %w", subnets[name].ID, err)
+		}
+		if subnetDetails != nil && subnetDetails.Zone != nil && subnetDetails.Zone.Name != nil && *subnetDetails.Zone.Name == zone {
+			return subnetDetails.ID, nil
+		}
+	}
+	return nil, fmt.Errorf("error no cluster subnet found in zone %s", zone)
+}
+
 // SetReady sets the Machine Status as ready.
 func (m *MachineScope) SetReady() {
 	m.IBMVPCMachine.Status.Ready = true
 }
 
+// MatchesResourceSelector reports whether a resource found by name has all the tags of the ResourceSelector of the cluster attached,
+// the lookups by name ignore the resources not matching the ResourceSelector.
+func (m *MachineScope) MatchesResourceSelector(resourceType infrav1beta2.ResourceType, name string, resourceCRN *string) (bool, error) {
+	if m.IBMVPCCluster == nil {
+		return true, nil
+	}
+	matched, err := matchesResourceSelector(m.GlobalTaggingClient, m.IBMVPCCluster.Spec.ResourceSelector, resourceCRN)
+	if err != nil {
+		return false, fmt.Errorf("error checking resource selector for %s %s: %w", resourceType, name, err)
+	}
+	if !matched {
+		m.V(3).Info("Ignoring resource found by name not matching the resource selector", "resourceType", resourceType, "name", name)
+	}
+	return matched, nil
+}
+
 // CheckTagExists checks whether a user tag already exists.
 func (m *MachineScope) CheckTagExists(tagName string) (bool, error) {
 	exists, err := m.GlobalTaggingClient.GetTagByName(tagName)
@@ -1145,3 +1941,19 @@ func (m *MachineScope) APIServerPort() int32 {
 	}
 	return infrav1beta2.DefaultAPIServerPort
 }
+
+// ReconcileBootstrapSucceeded sets the BootstrapSucceeded condition of the IBMVPCMachine from whether the Machine joined the cluster.
+func (m *MachineScope) ReconcileBootstrapSucceeded() {
+	reconcileBootstrapSucceeded(m.IBMVPCMachine, m.Machine)
+}
+
+// ReconcileDebugDump writes the sanitized dump of the VPC instance into the debug dump ConfigMap of the IBMVPCMachine
+// when the debug dump annotation is set on it.
+func (m *MachineScope) ReconcileDebugDump(instance *vpcv1.Instance) error {
+	if !debugdump.Requested(m.IBMVPCMachine) {
+		return nil
+	}
+	return debugdump.Reconcile(context.TODO(), m.Client, m.IBMVPCMachine, map[string]interface{}{
+		"instance.json": instance,
+	})
+}
@@ -19,22 +19,35 @@ package scope
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/IBM/platform-services-go-sdk/enterprisemanagementv1"
+	"github.com/IBM/platform-services-go-sdk/globaltaggingv1"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
 	capiv1beta1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	infrav1beta2 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/authenticator"
+	mockcos "sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/cos/mock"
+	mockem "sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/enterprisemanagement/mock"
+	gtmock "sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/globaltagging/mock"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/utils"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/vpc/mock"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/options"
@@ -188,6 +201,196 @@ func TestCreateMachine(t *testing.T) {
 			out, err := scope.CreateMachine()
 			g.Expect(err).To(BeNil())
 			require.Equal(t, expectedOutput, out)
+			g.Expect(scope.IBMVPCMachine.Status.ActionHistory).To(HaveLen(1))
+			g.Expect(scope.IBMVPCMachine.Status.ActionHistory[0].Type).To(Equal(infrav1beta2.MachineActionCreate))
+			g.Expect(scope.IBMVPCMachine.Status.ActionHistory[0].Succeeded).To(BeTrue())
+		})
+
+		t.Run("Should create Machine with the node labels and taints of the machine", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			secret := &corev1.Secret{}
+			g.Expect(scope.Client.Get(context.TODO(), client.ObjectKey{Namespace: "default", Name: machineName}, secret)).To(Succeed())
+			secret.Data["value"] = []byte("#cloud-config\nruncmd:\n- kubeadm join\n")
+			g.Expect(scope.Client.Update(context.TODO(), secret)).To(Succeed())
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Spec.NodeLabels = map[string]string{"pool": "gpu"}
+			scope.IBMVPCMachine.Spec.NodeTaints = []corev1.Taint{{Key: "gpu", Value: "true", Effect: corev1.TaintEffectNoSchedule}}
+			instance := &vpcv1.Instance{
+				Name: &scope.Machine.Name,
+				CRN:  ptr.To("foo-instance-crn"),
+			}
+			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().GetVPCSubnetByName(vpcMachine.Spec.PrimaryNetworkInterface.Subnet).Return(&vpcv1.Subnet{ID: core.StringPtr("subnet-name")}, nil)
+			mockvpc.EXPECT().CreateInstance(gomock.AssignableToTypeOf(&vpcv1.CreateInstanceOptions{})).DoAndReturn(func(options *vpcv1.CreateInstanceOptions) (*vpcv1.Instance, *core.DetailedResponse, error) {
+				prototype := options.InstancePrototype.(*vpcv1.InstancePrototype)
+				g.Expect(*prototype.UserData).To(ContainSubstring("--node-labels=pool=gpu --register-with-taints=gpu=true:NoSchedule"))
+				g.Expect(*prototype.UserData).To(ContainSubstring("#cloud-config\nruncmd:\n- kubeadm join\n"))
+				return instance, &core.DetailedResponse{}, nil
+			})
+			_, err := scope.CreateMachine()
+			g.Expect(err).To(BeNil())
+		})
+
+		t.Run("Should create Machine with additional volumes", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Spec.AdditionalVolumes = []*infrav1beta2.VPCVolume{
+				{
+					SizeGiB:                      100,
+					DeleteVolumeOnInstanceDelete: true,
+				},
+				{
+					Name:             "etcd",
+					SizeGiB:          20,
+					Profile:          "custom",
+					Iops:             3000,
+					EncryptionKeyCRN: "foo-key-crn",
+				},
+			}
+			instance := &vpcv1.Instance{
+				Name: &scope.Machine.Name,
+			}
+			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().GetVPCSubnetByName(vpcMachine.Spec.PrimaryNetworkInterface.Subnet).Return(&vpcv1.Subnet{ID: core.StringPtr("subnet-name")}, nil)
+			mockvpc.EXPECT().CreateInstance(gomock.AssignableToTypeOf(&vpcv1.CreateInstanceOptions{})).DoAndReturn(func(options *vpcv1.CreateInstanceOptions) (*vpcv1.Instance, *core.DetailedResponse, error) {
+				prototype := options.InstancePrototype.(*vpcv1.InstancePrototype)
+				g.Expect(prototype.VolumeAttachments).To(HaveLen(2))
+
+				data := prototype.VolumeAttachments[0]
+				g.Expect(*data.DeleteVolumeOnInstanceDelete).To(BeTrue())
+				volume := data.Volume.(*vpcv1.VolumeAttachmentPrototypeVolumeVolumePrototypeInstanceContextVolumePrototypeInstanceContextVolumeByCapacity)
+				g.Expect(*volume.Name).To(Equal(fmt.Sprintf("%s-data-0", machineName)))
+				g.Expect(*volume.Capacity).To(Equal(int64(100)))
+				g.Expect(*volume.Profile.(*vpcv1.VolumeProfileIdentity).Name).To(Equal("general-purpose"))
+
+				etcd := prototype.VolumeAttachments[1]
+				g.Expect(*etcd.DeleteVolumeOnInstanceDelete).To(BeFalse())
+				volume = etcd.Volume.(*vpcv1.VolumeAttachmentPrototypeVolumeVolumePrototypeInstanceContextVolumePrototypeInstanceContextVolumeByCapacity)
+				g.Expect(*volume.Name).To(Equal(fmt.Sprintf("%s-etcd", machineName)))
+				g.Expect(*volume.Profile.(*vpcv1.VolumeProfileIdentity).Name).To(Equal("custom"))
+				g.Expect(*volume.Iops).To(Equal(int64(3000)))
+				g.Expect(*volume.EncryptionKey.(*vpcv1.EncryptionKeyIdentity).CRN).To(Equal("foo-key-crn"))
+				return instance, &core.DetailedResponse{}, nil
+			})
+			_, err := scope.CreateMachine()
+			g.Expect(err).To(BeNil())
+		})
+
+		t.Run("Should create Machine with the encryption key of the machine", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Spec.EncryptionKeyCRN = "machine-key-crn"
+			scope.IBMVPCMachine.Spec.AdditionalVolumes = []*infrav1beta2.VPCVolume{
+				{
+					SizeGiB: 100,
+				},
+				{
+					SizeGiB:          20,
+					EncryptionKeyCRN: "volume-key-crn",
+				},
+			}
+			instance := &vpcv1.Instance{
+				Name: &scope.Machine.Name,
+			}
+			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().GetVPCSubnetByName(vpcMachine.Spec.PrimaryNetworkInterface.Subnet).Return(&vpcv1.Subnet{ID: core.StringPtr("subnet-name")}, nil)
+			mockvpc.EXPECT().CreateInstance(gomock.AssignableToTypeOf(&vpcv1.CreateInstanceOptions{})).DoAndReturn(func(options *vpcv1.CreateInstanceOptions) (*vpcv1.Instance, *core.DetailedResponse, error) {
+				prototype := options.InstancePrototype.(*vpcv1.InstancePrototype)
+				g.Expect(*prototype.BootVolumeAttachment.DeleteVolumeOnInstanceDelete).To(BeTrue())
+				g.Expect(*prototype.BootVolumeAttachment.Volume.EncryptionKey.(*vpcv1.EncryptionKeyIdentity).CRN).To(Equal("machine-key-crn"))
+				g.Expect(*prototype.BootVolumeAttachment.Volume.Profile.(*vpcv1.VolumeProfileIdentity).Name).To(Equal("general-purpose"))
+
+				g.Expect(prototype.VolumeAttachments).To(HaveLen(2))
+				volume := prototype.VolumeAttachments[0].Volume.(*vpcv1.VolumeAttachmentPrototypeVolumeVolumePrototypeInstanceContextVolumePrototypeInstanceContextVolumeByCapacity)
+				g.Expect(*volume.EncryptionKey.(*vpcv1.EncryptionKeyIdentity).CRN).To(Equal("machine-key-crn"))
+				volume = prototype.VolumeAttachments[1].Volume.(*vpcv1.VolumeAttachmentPrototypeVolumeVolumePrototypeInstanceContextVolumePrototypeInstanceContextVolumeByCapacity)
+				g.Expect(*volume.EncryptionKey.(*vpcv1.EncryptionKeyIdentity).CRN).To(Equal("volume-key-crn"))
+				return instance, &core.DetailedResponse{}, nil
+			})
+			_, err := scope.CreateMachine()
+			g.Expect(err).To(BeNil())
+		})
+
+		t.Run("Should stage Ignition bootstrap data exceeding the user data limit in COS", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			mockCOS := mockcos.NewMockCos(mockController)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCCluster.Spec.BootstrapDataBucket = &infrav1beta2.COSBucket{Name: "bootstrap-bucket"}
+			scope.COSClient = mockCOS
+			setBootstrapData(t, scope, ignitionBootstrapData(vpcUserDataLimit))
+			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().GetVPCSubnetByName(vpcMachine.Spec.PrimaryNetworkInterface.Subnet).Return(&vpcv1.Subnet{ID: core.StringPtr("subnet-name")}, nil)
+			mockCOS.EXPECT().PutObject(gomock.AssignableToTypeOf(&s3.PutObjectInput{})).DoAndReturn(func(input *s3.PutObjectInput) (*s3.PutObjectOutput, error) {
+				g.Expect(*input.Bucket).To(Equal("bootstrap-bucket"))
+				g.Expect(*input.Key).To(Equal(fmt.Sprintf("default/%s/%s", clusterName, machineName)))
+				return &s3.PutObjectOutput{}, nil
+			})
+			mockCOS.EXPECT().PresignGetObject(gomock.AssignableToTypeOf(&s3.GetObjectInput{}), bootstrapDataURLExpiry).Return("https://bootstrap-bucket.example.com/object?X-Amz-Signature=signature", nil)
+			mockvpc.EXPECT().CreateInstance(gomock.AssignableToTypeOf(&vpcv1.CreateInstanceOptions{})).DoAndReturn(func(options *vpcv1.CreateInstanceOptions) (*vpcv1.Instance, *core.DetailedResponse, error) {
+				prototype := options.InstancePrototype.(*vpcv1.InstancePrototype)
+				g.Expect(*prototype.UserData).To(ContainSubstring(`"replace":{"source":"https://bootstrap-bucket.example.com/object?X-Amz-Signature=signature"`))
+				g.Expect(len(*prototype.UserData)).To(BeNumerically("<", vpcUserDataLimit))
+				return &vpcv1.Instance{Name: &scope.Machine.Name}, &core.DetailedResponse{}, nil
+			})
+			_, err := scope.CreateMachine()
+			g.Expect(err).To(BeNil())
+		})
+
+		t.Run("Should pass Ignition bootstrap data within the user data limit as is", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			bootstrapData := ignitionBootstrapData(1024)
+			setBootstrapData(t, scope, bootstrapData)
+			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().GetVPCSubnetByName(vpcMachine.Spec.PrimaryNetworkInterface.Subnet).Return(&vpcv1.Subnet{ID: core.StringPtr("subnet-name")}, nil)
+			mockvpc.EXPECT().CreateInstance(gomock.AssignableToTypeOf(&vpcv1.CreateInstanceOptions{})).DoAndReturn(func(options *vpcv1.CreateInstanceOptions) (*vpcv1.Instance, *core.DetailedResponse, error) {
+				g.Expect(*options.InstancePrototype.(*vpcv1.InstancePrototype).UserData).To(Equal(bootstrapData))
+				return &vpcv1.Instance{Name: &scope.Machine.Name}, &core.DetailedResponse{}, nil
+			})
+			_, err := scope.CreateMachine()
+			g.Expect(err).To(BeNil())
+		})
+
+		t.Run("Should fail when Ignition bootstrap data exceeds the user data limit without bootstrap data bucket", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			setBootstrapData(t, scope, ignitionBootstrapData(vpcUserDataLimit))
+			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
+			_, err := scope.CreateMachine()
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(ContainSubstring("bootstrapDataBucket must be set"))
+		})
+
+		t.Run("Should fail when cloud-config bootstrap data exceeds the user data limit", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Spec.ReportBootstrapResult = true
+			setBootstrapData(t, scope, "#cloud-config\nruncmd:\n- "+strings.Repeat("a", vpcUserDataLimit))
+			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
+			_, err := scope.CreateMachine()
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(ContainSubstring("exceeds the user data limit"))
 		})
 
 		t.Run("Return existing Machine", func(t *testing.T) {
@@ -312,6 +515,72 @@ func TestCreateMachine(t *testing.T) {
 			require.Equal(t, expectedOutput, out)
 		})
 
+		t.Run("Create machine using the subnet of the failure domain", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			expectedOutput := &vpcv1.Instance{
+				Name: core.StringPtr("foo-machine"),
+			}
+			scope.Machine.Spec.FailureDomain = ptr.To("us-south-2")
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Spec.Zone = "us-south-1"
+			scope.IBMVPCCluster.Status = infrav1beta2.IBMVPCClusterStatus{
+				Network: &infrav1beta2.VPCNetworkStatus{
+					WorkerSubnets: map[string]*infrav1beta2.ResourceStatus{
+						"subnet-name-1": {
+							ID: "subnet-id-1",
+						},
+						"subnet-name-2": {
+							ID: "subnet-id-2",
+						},
+					},
+				},
+			}
+			instance := &vpcv1.Instance{
+				Name: &scope.Machine.Name,
+			}
+
+			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().GetSubnet(&vpcv1.GetSubnetOptions{ID: ptr.To("subnet-id-1")}).Return(&vpcv1.Subnet{ID: ptr.To("subnet-id-1"), Zone: &vpcv1.ZoneReference{Name: ptr.To("us-south-1")}}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().GetSubnet(&vpcv1.GetSubnetOptions{ID: ptr.To("subnet-id-2")}).Return(&vpcv1.Subnet{ID: ptr.To("subnet-id-2"), Zone: &vpcv1.ZoneReference{Name: ptr.To("us-south-2")}}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().CreateInstance(gomock.AssignableToTypeOf(&vpcv1.CreateInstanceOptions{})).DoAndReturn(func(options *vpcv1.CreateInstanceOptions) (*vpcv1.Instance, *core.DetailedResponse, error) {
+				prototype := options.InstancePrototype.(*vpcv1.InstancePrototype)
+				g.Expect(*prototype.Zone.(*vpcv1.ZoneIdentity).Name).To(Equal("us-south-2"))
+				g.Expect(*prototype.PrimaryNetworkInterface.Subnet.(*vpcv1.SubnetIdentity).ID).To(Equal("subnet-id-2"))
+				return instance, &core.DetailedResponse{}, nil
+			})
+
+			out, err := scope.CreateMachine()
+			g.Expect(err).To(BeNil())
+			require.Equal(t, expectedOutput, out)
+		})
+
+		t.Run("Error when no subnet exists in the failure domain", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.Machine.Spec.FailureDomain = ptr.To("us-south-3")
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCCluster.Status = infrav1beta2.IBMVPCClusterStatus{
+				Network: &infrav1beta2.VPCNetworkStatus{
+					WorkerSubnets: map[string]*infrav1beta2.ResourceStatus{
+						"subnet-name-1": {
+							ID: "subnet-id-1",
+						},
+					},
+				},
+			}
+
+			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().GetSubnet(&vpcv1.GetSubnetOptions{ID: ptr.To("subnet-id-1")}).Return(&vpcv1.Subnet{ID: ptr.To("subnet-id-1"), Zone: &vpcv1.ZoneReference{Name: ptr.To("us-south-1")}}, &core.DetailedResponse{}, nil)
+
+			_, err := scope.CreateMachine()
+			g.Expect(err).To(Not(BeNil()))
+		})
+
 		t.Run("Create machine using network status security groups", func(t *testing.T) {
 			g := NewWithT(t)
 			mockController, mockvpc := setup(t)
@@ -418,6 +687,126 @@ func TestCreateMachine(t *testing.T) {
 			require.Equal(t, expectedOutput, out)
 		})
 
+		t.Run("Create machine attaching cluster and additional security groups", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			expectedOutput := &vpcv1.Instance{
+				Name: core.StringPtr("foo-machine"),
+			}
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Spec.PrimaryNetworkInterface = infrav1beta2.NetworkInterface{
+				Subnet: "subnet-name",
+			}
+			scope.IBMVPCMachine.Spec.SecurityGroups = []infrav1beta2.VPCResource{
+				{
+					Name: core.StringPtr("security-group-1"),
+				},
+				{
+					ID: core.StringPtr("security-group-id-2"),
+				},
+			}
+			scope.IBMVPCCluster.Spec.Network = &infrav1beta2.VPCNetworkSpec{
+				SecurityGroups: []infrav1beta2.VPCSecurityGroup{
+					{
+						Name: core.StringPtr("security-group-1"),
+					},
+				},
+			}
+			scope.IBMVPCCluster.Status = infrav1beta2.IBMVPCClusterStatus{
+				Network: &infrav1beta2.VPCNetworkStatus{
+					ControlPlaneSubnets: map[string]*infrav1beta2.ResourceStatus{
+						"subnet-name": {
+							ID: "subnet-id",
+						},
+					},
+					SecurityGroups: map[string]*infrav1beta2.ResourceStatus{
+						"security-group-1": {
+							ID: "security-group-id-1",
+						},
+					},
+				},
+			}
+			instance := &vpcv1.Instance{
+				Name: &scope.Machine.Name,
+			}
+
+			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().GetSecurityGroup(&vpcv1.GetSecurityGroupOptions{ID: core.StringPtr("security-group-id-2")}).Return(&vpcv1.SecurityGroup{ID: core.StringPtr("security-group-id-2")}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().CreateInstance(gomock.AssignableToTypeOf(&vpcv1.CreateInstanceOptions{})).DoAndReturn(func(options *vpcv1.CreateInstanceOptions) (*vpcv1.Instance, *core.DetailedResponse, error) {
+				prototype := options.InstancePrototype.(*vpcv1.InstancePrototype)
+				g.Expect(prototype.PrimaryNetworkInterface.SecurityGroups).To(Equal([]vpcv1.SecurityGroupIdentityIntf{
+					&vpcv1.SecurityGroupIdentityByID{ID: core.StringPtr("security-group-id-1")},
+					&vpcv1.SecurityGroupIdentityByID{ID: core.StringPtr("security-group-id-2")},
+				}))
+				return instance, &core.DetailedResponse{}, nil
+			})
+
+			out, err := scope.CreateMachine()
+			g.Expect(err).To(BeNil())
+			require.Equal(t, expectedOutput, out)
+		})
+
+		t.Run("Create machine with primary network interface security groups overriding cluster security groups", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			expectedOutput := &vpcv1.Instance{
+				Name: core.StringPtr("foo-machine"),
+			}
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Spec.PrimaryNetworkInterface = infrav1beta2.NetworkInterface{
+				SecurityGroups: []infrav1beta2.VPCResource{
+					{
+						Name: core.StringPtr("security-group-2"),
+					},
+				},
+				Subnet: "subnet-name",
+			}
+			scope.IBMVPCCluster.Spec.Network = &infrav1beta2.VPCNetworkSpec{
+				SecurityGroups: []infrav1beta2.VPCSecurityGroup{
+					{
+						Name: core.StringPtr("security-group-1"),
+					},
+				},
+			}
+			scope.IBMVPCCluster.Status = infrav1beta2.IBMVPCClusterStatus{
+				Network: &infrav1beta2.VPCNetworkStatus{
+					ControlPlaneSubnets: map[string]*infrav1beta2.ResourceStatus{
+						"subnet-name": {
+							ID: "subnet-id",
+						},
+					},
+					SecurityGroups: map[string]*infrav1beta2.ResourceStatus{
+						"security-group-1": {
+							ID: "security-group-id-1",
+						},
+						"security-group-2": {
+							ID: "security-group-id-2",
+						},
+					},
+				},
+			}
+			instance := &vpcv1.Instance{
+				Name: &scope.Machine.Name,
+			}
+
+			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().CreateInstance(gomock.AssignableToTypeOf(&vpcv1.CreateInstanceOptions{})).DoAndReturn(func(options *vpcv1.CreateInstanceOptions) (*vpcv1.Instance, *core.DetailedResponse, error) {
+				prototype := options.InstancePrototype.(*vpcv1.InstancePrototype)
+				g.Expect(prototype.PrimaryNetworkInterface.SecurityGroups).To(Equal([]vpcv1.SecurityGroupIdentityIntf{
+					&vpcv1.SecurityGroupIdentityByID{ID: core.StringPtr("security-group-id-2")},
+				}))
+				return instance, &core.DetailedResponse{}, nil
+			})
+
+			out, err := scope.CreateMachine()
+			g.Expect(err).To(BeNil())
+			require.Equal(t, expectedOutput, out)
+		})
+
 		t.Run("Create machine using network status vpc", func(t *testing.T) {
 			g := NewWithT(t)
 			mockController, mockvpc := setup(t)
@@ -447,30 +836,194 @@ func TestCreateMachine(t *testing.T) {
 			g.Expect(err).To(BeNil())
 			require.Equal(t, expectedOutput, out)
 		})
-	})
-
-	t.Run("Error when machine profile is empty", func(t *testing.T) {
-		g := NewWithT(t)
-		mockController, mockvpc := setup(t)
-		t.Cleanup(mockController.Finish)
-		scope := setupMachineScope(clusterName, machineName, mockvpc)
-		vpcMachine := infrav1beta2.IBMVPCMachine{
-			Spec: infrav1beta2.IBMVPCMachineSpec{},
-		}
-		scope.IBMVPCMachine.Spec = vpcMachine.Spec
-		mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
-		_, err := scope.CreateMachine()
-		g.Expect(err).To(Not(BeNil()))
-	})
 
-	t.Run("Error when both SSHKeys ID and Name are nil", func(t *testing.T) {
-		g := NewWithT(t)
-		mockController, mockvpc := setup(t)
-		t.Cleanup(mockController.Finish)
-		scope := setupMachineScope(clusterName, machineName, mockvpc)
-		vpcMachine := infrav1beta2.IBMVPCMachine{
-			Spec: infrav1beta2.IBMVPCMachineSpec{
-				SSHKeys: []*infrav1beta2.IBMVPCResourceReference{
+		t.Run("Create machine on a dedicated host looked up by name", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Spec.Zone = "us-south-1"
+			scope.IBMVPCMachine.Spec.PlacementTarget = &infrav1beta2.VPCMachinePlacementTarget{
+				DedicatedHost: &infrav1beta2.VPCResource{
+					Name: core.StringPtr("dedicated-host-name"),
+				},
+			}
+			instance := &vpcv1.Instance{
+				Name: &scope.Machine.Name,
+			}
+
+			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().GetVPCSubnetByName(vpcMachine.Spec.PrimaryNetworkInterface.Subnet).Return(&vpcv1.Subnet{ID: core.StringPtr("subnet-name")}, nil).AnyTimes()
+			mockvpc.EXPECT().GetDedicatedHostByName("dedicated-host-name").Return(&vpcv1.DedicatedHost{ID: core.StringPtr("dedicated-host-id"), Zone: &vpcv1.ZoneReference{Name: core.StringPtr("us-south-1")}}, nil)
+			mockvpc.EXPECT().CreateInstance(gomock.AssignableToTypeOf(&vpcv1.CreateInstanceOptions{})).DoAndReturn(func(options *vpcv1.CreateInstanceOptions) (*vpcv1.Instance, *core.DetailedResponse, error) {
+				prototype := options.InstancePrototype.(*vpcv1.InstancePrototype)
+				g.Expect(prototype.PlacementTarget).To(Equal(&vpcv1.InstancePlacementTargetPrototypeDedicatedHostIdentityDedicatedHostIdentityByID{
+					ID: core.StringPtr("dedicated-host-id"),
+				}))
+				return instance, &core.DetailedResponse{}, nil
+			})
+
+			_, err := scope.CreateMachine()
+			g.Expect(err).To(BeNil())
+		})
+
+		t.Run("Error when the dedicated host group is in another zone", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Spec.Zone = "us-south-1"
+			scope.IBMVPCMachine.Spec.PlacementTarget = &infrav1beta2.VPCMachinePlacementTarget{
+				DedicatedHostGroup: &infrav1beta2.VPCResource{
+					ID: core.StringPtr("dedicated-host-group-id"),
+				},
+			}
+
+			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().GetVPCSubnetByName(vpcMachine.Spec.PrimaryNetworkInterface.Subnet).Return(&vpcv1.Subnet{ID: core.StringPtr("subnet-name")}, nil).AnyTimes()
+			mockvpc.EXPECT().GetDedicatedHostGroup(&vpcv1.GetDedicatedHostGroupOptions{ID: core.StringPtr("dedicated-host-group-id")}).Return(&vpcv1.DedicatedHostGroup{ID: core.StringPtr("dedicated-host-group-id"), Zone: &vpcv1.ZoneReference{Name: core.StringPtr("us-south-2")}}, &core.DetailedResponse{}, nil)
+
+			_, err := scope.CreateMachine()
+			g.Expect(err).To(Not(BeNil()))
+		})
+
+		t.Run("Create machine in a placement group", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Spec.PlacementTarget = &infrav1beta2.VPCMachinePlacementTarget{
+				PlacementGroup: &infrav1beta2.VPCResource{
+					ID: core.StringPtr("placement-group-id"),
+				},
+			}
+			instance := &vpcv1.Instance{
+				Name: &scope.Machine.Name,
+			}
+
+			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().GetVPCSubnetByName(vpcMachine.Spec.PrimaryNetworkInterface.Subnet).Return(&vpcv1.Subnet{ID: core.StringPtr("subnet-name")}, nil).AnyTimes()
+			mockvpc.EXPECT().GetPlacementGroup(&vpcv1.GetPlacementGroupOptions{ID: core.StringPtr("placement-group-id")}).Return(&vpcv1.PlacementGroup{ID: core.StringPtr("placement-group-id")}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().CreateInstance(gomock.AssignableToTypeOf(&vpcv1.CreateInstanceOptions{})).DoAndReturn(func(options *vpcv1.CreateInstanceOptions) (*vpcv1.Instance, *core.DetailedResponse, error) {
+				prototype := options.InstancePrototype.(*vpcv1.InstancePrototype)
+				g.Expect(prototype.PlacementTarget).To(Equal(&vpcv1.InstancePlacementTargetPrototypePlacementGroupIdentityPlacementGroupIdentityByID{
+					ID: core.StringPtr("placement-group-id"),
+				}))
+				return instance, &core.DetailedResponse{}, nil
+			})
+
+			_, err := scope.CreateMachine()
+			g.Expect(err).To(BeNil())
+		})
+
+		t.Run("Create machine using a reservation looked up by name", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Spec.Capacity = &infrav1beta2.VPCMachineCapacity{
+				ReservationAffinityPolicy: infrav1beta2.VPCMachineReservationAffinityPolicyManual,
+				Reservation: &infrav1beta2.VPCResource{
+					Name: core.StringPtr("reservation-name"),
+				},
+			}
+			instance := &vpcv1.Instance{
+				Name: &scope.Machine.Name,
+			}
+
+			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().GetVPCSubnetByName(vpcMachine.Spec.PrimaryNetworkInterface.Subnet).Return(&vpcv1.Subnet{ID: core.StringPtr("subnet-name")}, nil)
+			mockvpc.EXPECT().GetReservationByName("reservation-name").Return(&vpcv1.Reservation{ID: core.StringPtr("reservation-id")}, nil)
+			mockvpc.EXPECT().CreateInstance(gomock.AssignableToTypeOf(&vpcv1.CreateInstanceOptions{})).DoAndReturn(func(options *vpcv1.CreateInstanceOptions) (*vpcv1.Instance, *core.DetailedResponse, error) {
+				prototype := options.InstancePrototype.(*vpcv1.InstancePrototype)
+				g.Expect(prototype.ReservationAffinity).To(Equal(&vpcv1.InstanceReservationAffinityPrototype{
+					Policy: core.StringPtr(vpcv1.InstanceReservationAffinityPrototypePolicyManualConst),
+					Pool: []vpcv1.ReservationIdentityIntf{
+						&vpcv1.ReservationIdentityByID{ID: core.StringPtr("reservation-id")},
+					},
+				}))
+				return instance, &core.DetailedResponse{}, nil
+			})
+
+			_, err := scope.CreateMachine()
+			g.Expect(err).To(BeNil())
+		})
+
+		t.Run("Create machine using the automatic reservation affinity policy", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Spec.Capacity = &infrav1beta2.VPCMachineCapacity{
+				ReservationAffinityPolicy: infrav1beta2.VPCMachineReservationAffinityPolicyAutomatic,
+			}
+			instance := &vpcv1.Instance{
+				Name: &scope.Machine.Name,
+			}
+
+			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().GetVPCSubnetByName(vpcMachine.Spec.PrimaryNetworkInterface.Subnet).Return(&vpcv1.Subnet{ID: core.StringPtr("subnet-name")}, nil)
+			mockvpc.EXPECT().CreateInstance(gomock.AssignableToTypeOf(&vpcv1.CreateInstanceOptions{})).DoAndReturn(func(options *vpcv1.CreateInstanceOptions) (*vpcv1.Instance, *core.DetailedResponse, error) {
+				prototype := options.InstancePrototype.(*vpcv1.InstancePrototype)
+				g.Expect(prototype.ReservationAffinity).To(Equal(&vpcv1.InstanceReservationAffinityPrototype{
+					Policy: core.StringPtr(vpcv1.InstanceReservationAffinityPrototypePolicyAutomaticConst),
+				}))
+				return instance, &core.DetailedResponse{}, nil
+			})
+
+			_, err := scope.CreateMachine()
+			g.Expect(err).To(BeNil())
+		})
+
+		t.Run("Error when the reservation does not exist", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Spec.Capacity = &infrav1beta2.VPCMachineCapacity{
+				ReservationAffinityPolicy: infrav1beta2.VPCMachineReservationAffinityPolicyManual,
+				Reservation: &infrav1beta2.VPCResource{
+					Name: core.StringPtr("reservation-name"),
+				},
+			}
+
+			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().GetVPCSubnetByName(vpcMachine.Spec.PrimaryNetworkInterface.Subnet).Return(&vpcv1.Subnet{ID: core.StringPtr("subnet-name")}, nil).AnyTimes()
+			mockvpc.EXPECT().GetReservationByName("reservation-name").Return(nil, nil)
+
+			_, err := scope.CreateMachine()
+			g.Expect(err).To(Not(BeNil()))
+		})
+	})
+
+	t.Run("Error when machine profile is empty", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockvpc)
+		vpcMachine := infrav1beta2.IBMVPCMachine{
+			Spec: infrav1beta2.IBMVPCMachineSpec{},
+		}
+		scope.IBMVPCMachine.Spec = vpcMachine.Spec
+		mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
+		_, err := scope.CreateMachine()
+		g.Expect(err).To(Not(BeNil()))
+	})
+
+	t.Run("Error when both SSHKeys ID and Name are nil", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockvpc)
+		vpcMachine := infrav1beta2.IBMVPCMachine{
+			Spec: infrav1beta2.IBMVPCMachineSpec{
+				SSHKeys: []*infrav1beta2.IBMVPCResourceReference{
 					{},
 				},
 				Image: &infrav1beta2.IBMVPCResourceReference{
@@ -686,93 +1239,687 @@ func TestCreateMachine(t *testing.T) {
 		g.Expect(err).To(Not(BeNil()))
 	})
 
-	t.Run("Should create machine when both Image/SSHKey ID and Name are defined with ID taking higher precedence", func(t *testing.T) {
+	t.Run("Should create machine when both Image/SSHKey ID and Name are defined with ID taking higher precedence", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockvpc)
+		expectedOutput := &vpcv1.Instance{
+			Name: core.StringPtr("foo-machine"),
+		}
+		vpcMachine := infrav1beta2.IBMVPCMachine{
+			Spec: infrav1beta2.IBMVPCMachineSpec{
+				SSHKeys: []*infrav1beta2.IBMVPCResourceReference{
+					{
+						Name: core.StringPtr("foo-ssh-key"),
+						ID:   core.StringPtr("foo-ssh-key-id"),
+					},
+				},
+				Image: &infrav1beta2.IBMVPCResourceReference{
+					Name: core.StringPtr("foo-image"),
+					ID:   core.StringPtr("foo-image-id"),
+				},
+				PrimaryNetworkInterface: infrav1beta2.NetworkInterface{
+					Subnet: "subnet-name",
+				},
+				Profile: "machine-profile",
+			},
+		}
+		scope.IBMVPCMachine.Spec = vpcMachine.Spec
+		instance := &vpcv1.Instance{
+			Name: &scope.Machine.Name,
+		}
+		mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
+		mockvpc.EXPECT().GetVPCSubnetByName(vpcMachine.Spec.PrimaryNetworkInterface.Subnet).Return(&vpcv1.Subnet{ID: core.StringPtr("subnet-id")}, nil)
+		mockvpc.EXPECT().CreateInstance(gomock.AssignableToTypeOf(&vpcv1.CreateInstanceOptions{})).Return(instance, &core.DetailedResponse{}, nil)
+		out, err := scope.CreateMachine()
+		g.Expect(err).To(BeNil())
+		require.Equal(t, expectedOutput, out)
+	})
+}
+
+func ignitionBootstrapData(size int) string {
+	return fmt.Sprintf(`{"ignition":{"version":"3.4.0"},"storage":{"files":[{"path":"/etc/data","contents":{"source":"data:,%s"}}]}}`, strings.Repeat("a", size))
+}
+
+func setBootstrapData(t *testing.T, scope *MachineScope, data string) {
+	t.Helper()
+	secret := &corev1.Secret{}
+	key := client.ObjectKey{Namespace: scope.Machine.Namespace, Name: *scope.Machine.Spec.Bootstrap.DataSecretName}
+	require.NoError(t, scope.Client.Get(context.Background(), key, secret))
+	secret.Data["value"] = []byte(data)
+	require.NoError(t, scope.Client.Update(context.Background(), secret))
+}
+
+func TestTagInstance(t *testing.T) {
+	setup := func(t *testing.T) (*gomock.Controller, *gtmock.MockGlobalTagging) {
+		t.Helper()
+		return gomock.NewController(t), gtmock.NewMockGlobalTagging(gomock.NewController(t))
+	}
+	instance := &vpcv1.Instance{
+		Name: ptr.To("foo-machine"),
+		CRN:  ptr.To("foo-instance-crn"),
+	}
+
+	t.Run("Should attach the tag of the cluster and the user tags of the machine", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockgt := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope("foo-cluster", "foo-machine", mock.NewMockVpc(mockController))
+		scope.GlobalTaggingClient = mockgt
+		scope.IBMVPCMachine.Spec.UserTags = []string{"pool:gpu"}
+		var tags []string
+		mockgt.EXPECT().GetTagByName(gomock.Any()).Return(&globaltaggingv1.Tag{}, nil).Times(2)
+		mockgt.EXPECT().AttachTag(gomock.AssignableToTypeOf(&globaltaggingv1.AttachTagOptions{})).DoAndReturn(func(options *globaltaggingv1.AttachTagOptions) (*globaltaggingv1.TagResults, *core.DetailedResponse, error) {
+			g.Expect(*options.Resources[0].ResourceID).To(Equal("foo-instance-crn"))
+			tags = append(tags, *options.TagName)
+			return &globaltaggingv1.TagResults{}, &core.DetailedResponse{}, nil
+		}).Times(2)
+		g.Expect(scope.TagInstance(instance)).To(Succeed())
+		g.Expect(tags).To(Equal([]string{scope.IBMVPCCluster.Name, "pool:gpu"}))
+	})
+
+	t.Run("Should return an error when a user tag can't be attached", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockgt := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope("foo-cluster", "foo-machine", mock.NewMockVpc(mockController))
+		scope.GlobalTaggingClient = mockgt
+		scope.IBMVPCMachine.Spec.UserTags = []string{"pool:gpu"}
+		mockgt.EXPECT().GetTagByName(scope.IBMVPCCluster.Name).Return(&globaltaggingv1.Tag{}, nil)
+		mockgt.EXPECT().GetTagByName("pool:gpu").Return(nil, errors.New("failed to get tag"))
+		mockgt.EXPECT().AttachTag(gomock.AssignableToTypeOf(&globaltaggingv1.AttachTagOptions{})).Return(&globaltaggingv1.TagResults{}, &core.DetailedResponse{}, nil)
+		g.Expect(scope.TagInstance(instance)).ToNot(Succeed())
+	})
+}
+
+func TestDeleteBootstrapData(t *testing.T) {
+	setup := func(t *testing.T) (*gomock.Controller, *mock.MockVpc, *mockcos.MockCos) {
+		t.Helper()
+		mockController := gomock.NewController(t)
+		return mockController, mock.NewMockVpc(mockController), mockcos.NewMockCos(mockController)
+	}
+
+	t.Run("Should skip the deletion without bootstrap data bucket", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc, mockCOS := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockvpc)
+		scope.COSClient = mockCOS
+		g.Expect(scope.DeleteBootstrapData()).To(Succeed())
+	})
+	t.Run("Should skip the deletion without HMAC credentials", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc, _ := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockvpc)
+		scope.IBMVPCCluster.Spec.BootstrapDataBucket = &infrav1beta2.COSBucket{Name: "bootstrap-bucket"}
+		g.Expect(scope.DeleteBootstrapData()).To(Succeed())
+	})
+	t.Run("Should delete the bootstrap data", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc, mockCOS := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockvpc)
+		scope.IBMVPCCluster.Spec.BootstrapDataBucket = &infrav1beta2.COSBucket{Name: "bootstrap-bucket"}
+		scope.COSClient = mockCOS
+		mockCOS.EXPECT().DeleteObject(&s3.DeleteObjectInput{
+			Bucket: ptr.To("bootstrap-bucket"),
+			Key:    ptr.To(fmt.Sprintf("default/%s/%s", clusterName, machineName)),
+		}).Return(&s3.DeleteObjectOutput{}, nil)
+		g.Expect(scope.DeleteBootstrapData()).To(Succeed())
+	})
+	t.Run("Should fail when the bootstrap data can't be deleted", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc, mockCOS := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockvpc)
+		scope.IBMVPCCluster.Spec.BootstrapDataBucket = &infrav1beta2.COSBucket{Name: "bootstrap-bucket"}
+		scope.COSClient = mockCOS
+		mockCOS.EXPECT().DeleteObject(gomock.AssignableToTypeOf(&s3.DeleteObjectInput{})).Return(nil, errors.New("failed to delete object"))
+		g.Expect(scope.DeleteBootstrapData()).To(HaveOccurred())
+	})
+}
+
+func TestDeleteMachine(t *testing.T) {
+	setup := func(t *testing.T) (*gomock.Controller, *mock.MockVpc) {
+		t.Helper()
+		return gomock.NewController(t), mock.NewMockVpc(gomock.NewController(t))
+	}
+
+	vpcMachine := infrav1beta2.IBMVPCMachine{
+		Spec: infrav1beta2.IBMVPCMachineSpec{
+			Name: "foo-machine",
+		},
+		Status: infrav1beta2.IBMVPCMachineStatus{
+			InstanceID: "foo-instance-id",
+		},
+	}
+
+	t.Run("Delete Machine", func(t *testing.T) {
+		t.Run("Should delete Machine", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Status = vpcMachine.Status
+			mockvpc.EXPECT().DeleteInstance(gomock.AssignableToTypeOf(&vpcv1.DeleteInstanceOptions{})).Return(&core.DetailedResponse{}, nil)
+			err := scope.DeleteMachine()
+			g.Expect(err).To(BeNil())
+		})
+
+		t.Run("Error when deleting Machine", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Status = vpcMachine.Status
+			mockvpc.EXPECT().DeleteInstance(gomock.AssignableToTypeOf(&vpcv1.DeleteInstanceOptions{})).Return(&core.DetailedResponse{}, errors.New("Failed instance deletion"))
+			err := scope.DeleteMachine()
+			g.Expect(err).To(Not(BeNil()))
+		})
+
+		t.Run("Empty InstanceID", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Status.InstanceID = ""
+			err := scope.DeleteMachine()
+			g.Expect(err).To(BeNil())
+		})
+
+		t.Run("Instance already deleted", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Status = vpcMachine.Status
+			mockvpc.EXPECT().DeleteInstance(gomock.AssignableToTypeOf(&vpcv1.DeleteInstanceOptions{})).Return(&core.DetailedResponse{StatusCode: 404}, errors.New("instance not found"))
+			err := scope.DeleteMachine()
+			g.Expect(err).To(BeNil())
+		})
+
+		t.Run("Keep the last actions in the action history", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Status = vpcMachine.Status
+			for i := 0; i < infrav1beta2.MachineActionHistoryLimit; i++ {
+				scope.IBMVPCMachine.Status.ActionHistory = append(scope.IBMVPCMachine.Status.ActionHistory, infrav1beta2.MachineAction{
+					Type:      infrav1beta2.MachineActionCreate,
+					Succeeded: true,
+				})
+			}
+			mockvpc.EXPECT().DeleteInstance(gomock.AssignableToTypeOf(&vpcv1.DeleteInstanceOptions{})).Return(&core.DetailedResponse{}, errors.New("Failed instance deletion"))
+			err := scope.DeleteMachine()
+			g.Expect(err).To(Not(BeNil()))
+			g.Expect(scope.IBMVPCMachine.Status.ActionHistory).To(HaveLen(infrav1beta2.MachineActionHistoryLimit))
+			action := scope.IBMVPCMachine.Status.ActionHistory[infrav1beta2.MachineActionHistoryLimit-1]
+			g.Expect(action.Type).To(Equal(infrav1beta2.MachineActionDelete))
+			g.Expect(action.InstanceID).To(Equal("foo-instance-id"))
+			g.Expect(action.Succeeded).To(BeFalse())
+			g.Expect(action.Time.IsZero()).To(BeFalse())
+		})
+	})
+}
+
+func TestIsInstanceInterrupted(t *testing.T) {
+	capacity := &infrav1beta2.VPCMachineCapacity{
+		InterruptionPolicy: infrav1beta2.VPCMachineInterruptionPolicyDeleteMachine,
+	}
+	machineSetOwner := metav1.OwnerReference{
+		APIVersion: capiv1beta1.GroupVersion.String(),
+		Kind:       "MachineSet",
+		Name:       "foo-machine-set",
+	}
+	newInstance := func(statusReason, healthReason string) *vpcv1.Instance {
+		instance := &vpcv1.Instance{ID: core.StringPtr("foo-instance-id"), Status: core.StringPtr(vpcv1.InstanceStatusStoppedConst)}
+		if statusReason != "" {
+			instance.StatusReasons = []vpcv1.InstanceStatusReason{{Code: core.StringPtr(statusReason)}}
+		}
+		if healthReason != "" {
+			instance.HealthReasons = []vpcv1.InstanceHealthReason{{Code: core.StringPtr(healthReason)}}
+		}
+		return instance
+	}
+
+	t.Run("Is instance interrupted", func(t *testing.T) {
+		t.Run("Interruption policy is not set", func(t *testing.T) {
+			g := NewWithT(t)
+			scope := setupMachineScope(clusterName, machineName, nil)
+			g.Expect(scope.IsInstanceInterrupted(newInstance(vpcv1.InstanceStatusReasonCodeStoppedByHostFailureConst, ""))).To(BeEmpty())
+		})
+
+		t.Run("Instance is not created yet", func(t *testing.T) {
+			g := NewWithT(t)
+			scope := setupMachineScope(clusterName, machineName, nil)
+			scope.IBMVPCMachine.Spec.Capacity = capacity
+			g.Expect(scope.IsInstanceInterrupted(nil)).To(BeEmpty())
+		})
+
+		t.Run("Instance is stopped without interruption reason", func(t *testing.T) {
+			g := NewWithT(t)
+			scope := setupMachineScope(clusterName, machineName, nil)
+			scope.IBMVPCMachine.Spec.Capacity = capacity
+			g.Expect(scope.IsInstanceInterrupted(newInstance(vpcv1.InstanceStatusReasonCodeStoppedForImageCreationConst, ""))).To(BeEmpty())
+		})
+
+		t.Run("Instance was stopped by a host failure", func(t *testing.T) {
+			g := NewWithT(t)
+			scope := setupMachineScope(clusterName, machineName, nil)
+			scope.IBMVPCMachine.Spec.Capacity = capacity
+			scope.Machine.OwnerReferences = []metav1.OwnerReference{machineSetOwner}
+			g.Expect(scope.IsInstanceInterrupted(newInstance(vpcv1.InstanceStatusReasonCodeStoppedByHostFailureConst, ""))).To(Equal(vpcv1.InstanceStatusReasonCodeStoppedByHostFailureConst))
+		})
+
+		t.Run("Reservation of the instance expired", func(t *testing.T) {
+			g := NewWithT(t)
+			scope := setupMachineScope(clusterName, machineName, nil)
+			scope.IBMVPCMachine.Spec.Capacity = capacity
+			scope.Machine.OwnerReferences = []metav1.OwnerReference{machineSetOwner}
+			g.Expect(scope.IsInstanceInterrupted(newInstance("", vpcv1.InstanceHealthReasonCodeReservationExpiredConst))).To(Equal(vpcv1.InstanceHealthReasonCodeReservationExpiredConst))
+		})
+
+		t.Run("Control plane machines are never interrupted", func(t *testing.T) {
+			g := NewWithT(t)
+			scope := setupMachineScope(clusterName, machineName, nil)
+			scope.IBMVPCMachine.Spec.Capacity = capacity
+			scope.Machine.OwnerReferences = []metav1.OwnerReference{machineSetOwner}
+			scope.Machine.Labels = map[string]string{capiv1beta1.MachineControlPlaneLabel: ""}
+			g.Expect(scope.IsInstanceInterrupted(newInstance(vpcv1.InstanceStatusReasonCodeStoppedByHostFailureConst, ""))).To(BeEmpty())
+		})
+
+		t.Run("Machines not replaced once deleted are never interrupted", func(t *testing.T) {
+			g := NewWithT(t)
+			scope := setupMachineScope(clusterName, machineName, nil)
+			scope.IBMVPCMachine.Spec.Capacity = capacity
+			g.Expect(scope.IsInstanceInterrupted(newInstance(vpcv1.InstanceStatusReasonCodeStoppedByHostFailureConst, ""))).To(BeEmpty())
+		})
+	})
+}
+
+func TestShouldAutoRepair(t *testing.T) {
+	machineSetOwner := metav1.OwnerReference{
+		APIVersion: capiv1beta1.GroupVersion.String(),
+		Kind:       "MachineSet",
+		Name:       "foo-machine-set",
+	}
+
+	t.Run("Should auto repair", func(t *testing.T) {
+		t.Run("Auto repair policy is not set", func(t *testing.T) {
+			g := NewWithT(t)
+			scope := setupMachineScope(clusterName, machineName, nil)
+			scope.Machine.OwnerReferences = []metav1.OwnerReference{machineSetOwner}
+			g.Expect(scope.ShouldAutoRepair()).To(BeFalse())
+		})
+
+		t.Run("Machine is owned by a MachineSet", func(t *testing.T) {
+			g := NewWithT(t)
+			scope := setupMachineScope(clusterName, machineName, nil)
+			scope.IBMVPCMachine.Spec.AutoRepairPolicy = infrav1beta2.MachineAutoRepairPolicyReplace
+			scope.Machine.OwnerReferences = []metav1.OwnerReference{machineSetOwner}
+			g.Expect(scope.ShouldAutoRepair()).To(BeTrue())
+		})
+
+		t.Run("Machine is owned by a MachinePool", func(t *testing.T) {
+			g := NewWithT(t)
+			scope := setupMachineScope(clusterName, machineName, nil)
+			scope.IBMVPCMachine.Spec.AutoRepairPolicy = infrav1beta2.MachineAutoRepairPolicyReplace
+			scope.Machine.OwnerReferences = []metav1.OwnerReference{
+				{
+					APIVersion: capiv1beta1.GroupVersion.String(),
+					Kind:       "MachinePool",
+					Name:       "foo-machine-pool",
+				},
+			}
+			g.Expect(scope.ShouldAutoRepair()).To(BeTrue())
+		})
+
+		t.Run("Machine is not owned by a MachineSet", func(t *testing.T) {
+			g := NewWithT(t)
+			scope := setupMachineScope(clusterName, machineName, nil)
+			scope.IBMVPCMachine.Spec.AutoRepairPolicy = infrav1beta2.MachineAutoRepairPolicyReplace
+			g.Expect(scope.ShouldAutoRepair()).To(BeFalse())
+		})
+
+		t.Run("Machine is a control plane machine", func(t *testing.T) {
+			g := NewWithT(t)
+			scope := setupMachineScope(clusterName, machineName, nil)
+			scope.IBMVPCMachine.Spec.AutoRepairPolicy = infrav1beta2.MachineAutoRepairPolicyReplace
+			scope.Machine.OwnerReferences = []metav1.OwnerReference{machineSetOwner}
+			scope.Machine.Labels = map[string]string{capiv1beta1.MachineControlPlaneLabel: ""}
+			g.Expect(scope.ShouldAutoRepair()).To(BeFalse())
+		})
+	})
+}
+
+func TestGetAuthenticator(t *testing.T) {
+	newCredentialsSecret := func(name, apiKey string) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "default",
+			},
+			Data: map[string][]byte{
+				"apiKey": []byte(apiKey),
+			},
+		}
+	}
+
+	t.Run("Should get the authenticator from the credentials secret", func(t *testing.T) {
+		g := NewWithT(t)
+		secret := newCredentialsSecret("foo-credentials", "foo-api-key")
+		c := fake.NewClientBuilder().WithObjects(secret).Build()
+		auth, err := getAuthenticator(c, "default", &corev1.LocalObjectReference{Name: secret.Name}, nil)
+		g.Expect(err).To(BeNil())
+		g.Expect(auth.(*core.IamAuthenticator).ApiKey).To(Equal("foo-api-key"))
+
+		apiKey, err := getAPIKey(c, "default", &corev1.LocalObjectReference{Name: secret.Name}, nil)
+		g.Expect(err).To(BeNil())
+		g.Expect(apiKey).To(Equal("foo-api-key"))
+	})
+
+	t.Run("Should reuse the authenticator until the credentials secret is rotated", func(t *testing.T) {
+		g := NewWithT(t)
+		secret := newCredentialsSecret("bar-credentials", "bar-api-key")
+		c := fake.NewClientBuilder().WithObjects(secret).Build()
+		secretRef := &corev1.LocalObjectReference{Name: secret.Name}
+		auth, err := getAuthenticator(c, "default", secretRef, nil)
+		g.Expect(err).To(BeNil())
+		cachedAuth, err := getAuthenticator(c, "default", secretRef, nil)
+		g.Expect(err).To(BeNil())
+		g.Expect(cachedAuth).To(BeIdenticalTo(auth))
+
+		secret.Data["apiKey"] = []byte("rotated-api-key")
+		g.Expect(c.Update(context.Background(), secret)).To(Succeed())
+		rotatedAuth, err := getAuthenticator(c, "default", secretRef, nil)
+		g.Expect(err).To(BeNil())
+		g.Expect(rotatedAuth).ToNot(BeIdenticalTo(auth))
+		g.Expect(rotatedAuth.(*core.IamAuthenticator).ApiKey).To(Equal("rotated-api-key"))
+	})
+
+	t.Run("Error when the credentials secret does not exist", func(t *testing.T) {
+		g := NewWithT(t)
+		c := fake.NewClientBuilder().Build()
+		_, err := getAuthenticator(c, "default", &corev1.LocalObjectReference{Name: "missing-credentials"}, nil)
+		g.Expect(err).To(Not(BeNil()))
+	})
+
+	t.Run("Error when the credentials secret does not contain the API key", func(t *testing.T) {
+		g := NewWithT(t)
+		secret := newCredentialsSecret("empty-credentials", "")
+		c := fake.NewClientBuilder().WithObjects(secret).Build()
+		_, err := getAuthenticator(c, "default", &corev1.LocalObjectReference{Name: secret.Name}, nil)
+		g.Expect(err).To(Not(BeNil()))
+	})
+
+	t.Run("Should get the trusted profile authenticator from the credentials secret", func(t *testing.T) {
+		g := NewWithT(t)
+		secret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "trusted-profile-credentials",
+				Namespace: "default",
+			},
+			Data: map[string][]byte{
+				"authType":           []byte("container"),
+				"trustedProfileName": []byte("foo-profile"),
+				"crTokenFilename":    []byte("/var/run/secrets/tokens/sa-token"),
+			},
+		}
+		c := fake.NewClientBuilder().WithObjects(secret).Build()
+		secretRef := &corev1.LocalObjectReference{Name: secret.Name}
+		auth, err := getTrustedProfileAuthenticator(c, "default", secretRef, nil)
+		g.Expect(err).To(BeNil())
+		containerAuth, ok := auth.(*core.ContainerAuthenticator)
+		g.Expect(ok).To(BeTrue())
+		g.Expect(containerAuth.IAMProfileName).To(Equal("foo-profile"))
+		g.Expect(containerAuth.CRTokenFilename).To(Equal("/var/run/secrets/tokens/sa-token"))
+
+		apiKey, err := getAPIKey(c, "default", secretRef, nil)
+		g.Expect(err).To(BeNil())
+		g.Expect(apiKey).To(BeEmpty())
+	})
+
+	t.Run("Should not get the trusted profile authenticator for the API key credentials secret", func(t *testing.T) {
+		g := NewWithT(t)
+		secret := newCredentialsSecret("api-key-credentials", "foo-api-key")
+		c := fake.NewClientBuilder().WithObjects(secret).Build()
+		auth, err := getTrustedProfileAuthenticator(c, "default", &corev1.LocalObjectReference{Name: secret.Name}, nil)
+		g.Expect(err).To(BeNil())
+		g.Expect(auth).To(BeNil())
+	})
+
+	t.Run("Error when the credentials secret does not contain the trusted profile", func(t *testing.T) {
+		g := NewWithT(t)
+		secret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "incomplete-trusted-profile-credentials",
+				Namespace: "default",
+			},
+			Data: map[string][]byte{
+				"authType":        []byte("container"),
+				"crTokenFilename": []byte("/var/run/secrets/tokens/sa-token"),
+			},
+		}
+		c := fake.NewClientBuilder().WithObjects(secret).Build()
+		_, err := getAuthenticator(c, "default", &corev1.LocalObjectReference{Name: secret.Name}, nil)
+		g.Expect(err).To(Not(BeNil()))
+	})
+
+	t.Run("Should get the authenticator from the namespace credentials secret", func(t *testing.T) {
+		g := NewWithT(t)
+		authenticator.NamespaceCredentialsSecret = "tenant-credentials"
+		t.Cleanup(func() { authenticator.NamespaceCredentialsSecret = "" })
+		secret := newCredentialsSecret("tenant-credentials", "tenant-api-key")
+		c := fake.NewClientBuilder().WithObjects(secret).Build()
+		auth, err := getAuthenticator(c, "default", nil, nil)
+		g.Expect(err).To(BeNil())
+		g.Expect(auth.(*core.IamAuthenticator).ApiKey).To(Equal("tenant-api-key"))
+
+		_, err = getAuthenticator(c, "other-tenant", nil, nil)
+		g.Expect(err).To(Not(BeNil()))
+	})
+
+	t.Run("Error when the credentials secret contains an unsupported authentication type", func(t *testing.T) {
+		g := NewWithT(t)
+		secret := newCredentialsSecret("unsupported-credentials", "foo-api-key")
+		secret.Data["authType"] = []byte("basic")
+		c := fake.NewClientBuilder().WithObjects(secret).Build()
+		_, err := getAuthenticator(c, "default", &corev1.LocalObjectReference{Name: secret.Name}, nil)
+		g.Expect(err).To(Not(BeNil()))
+	})
+
+	t.Run("Should assume the trusted profile of the enterprise account with the API key", func(t *testing.T) {
+		g := NewWithT(t)
+		secret := newCredentialsSecret("enterprise-credentials", "enterprise-api-key")
+		c := fake.NewClientBuilder().WithObjects(secret).Build()
+		secretRef := &corev1.LocalObjectReference{Name: secret.Name}
+		account := &infrav1beta2.EnterpriseAccount{ID: ptr.To("child-account-id"), TrustedProfileName: "foo-profile"}
+		auth, err := getAuthenticator(c, "default", secretRef, account)
+		g.Expect(err).To(BeNil())
+		g.Expect(auth.AuthenticationType()).To(Equal(core.AUTHTYPE_IAM_ASSUME))
+
+		trustedProfileAuth, err := getTrustedProfileAuthenticator(c, "default", secretRef, account)
+		g.Expect(err).To(BeNil())
+		g.Expect(trustedProfileAuth).To(BeIdenticalTo(auth))
+
+		apiKey, err := getAPIKey(c, "default", secretRef, account)
+		g.Expect(err).To(BeNil())
+		g.Expect(apiKey).To(BeEmpty())
+
+		accountID, err := getAccountID(c, "default", secretRef, account)
+		g.Expect(err).To(BeNil())
+		g.Expect(accountID).To(Equal("child-account-id"))
+	})
+
+	t.Run("Error when the enterprise account is assumed without an API key", func(t *testing.T) {
 		g := NewWithT(t)
-		mockController, mockvpc := setup(t)
-		t.Cleanup(mockController.Finish)
-		scope := setupMachineScope(clusterName, machineName, mockvpc)
-		expectedOutput := &vpcv1.Instance{
-			Name: core.StringPtr("foo-machine"),
-		}
-		vpcMachine := infrav1beta2.IBMVPCMachine{
-			Spec: infrav1beta2.IBMVPCMachineSpec{
-				SSHKeys: []*infrav1beta2.IBMVPCResourceReference{
-					{
-						Name: core.StringPtr("foo-ssh-key"),
-						ID:   core.StringPtr("foo-ssh-key-id"),
-					},
-				},
-				Image: &infrav1beta2.IBMVPCResourceReference{
-					Name: core.StringPtr("foo-image"),
-					ID:   core.StringPtr("foo-image-id"),
-				},
-				PrimaryNetworkInterface: infrav1beta2.NetworkInterface{
-					Subnet: "subnet-name",
-				},
-				Profile: "machine-profile",
+		secret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "enterprise-trusted-profile-credentials",
+				Namespace: "default",
+			},
+			Data: map[string][]byte{
+				"authType":           []byte("container"),
+				"trustedProfileName": []byte("foo-profile"),
+				"crTokenFilename":    []byte("/var/run/secrets/tokens/sa-token"),
 			},
 		}
-		scope.IBMVPCMachine.Spec = vpcMachine.Spec
-		instance := &vpcv1.Instance{
-			Name: &scope.Machine.Name,
-		}
-		mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
-		mockvpc.EXPECT().GetVPCSubnetByName(vpcMachine.Spec.PrimaryNetworkInterface.Subnet).Return(&vpcv1.Subnet{ID: core.StringPtr("subnet-id")}, nil)
-		mockvpc.EXPECT().CreateInstance(gomock.AssignableToTypeOf(&vpcv1.CreateInstanceOptions{})).Return(instance, &core.DetailedResponse{}, nil)
-		out, err := scope.CreateMachine()
-		g.Expect(err).To(BeNil())
-		require.Equal(t, expectedOutput, out)
+		c := fake.NewClientBuilder().WithObjects(secret).Build()
+		account := &infrav1beta2.EnterpriseAccount{ID: ptr.To("child-account-id"), TrustedProfileName: "foo-profile"}
+		_, err := getAuthenticator(c, "default", &corev1.LocalObjectReference{Name: secret.Name}, account)
+		g.Expect(err).To(Not(BeNil()))
 	})
 }
 
-func TestDeleteMachine(t *testing.T) {
-	setup := func(t *testing.T) (*gomock.Controller, *mock.MockVpc) {
-		t.Helper()
-		return gomock.NewController(t), mock.NewMockVpc(gomock.NewController(t))
+func TestLookupEnterpriseAccountID(t *testing.T) {
+	accounts := []enterprisemanagementv1.Account{
+		{ID: ptr.To("foo-account-id"), Name: ptr.To("foo-account")},
+		{ID: ptr.To("bar-account-id"), Name: ptr.To("bar-account")},
 	}
 
-	vpcMachine := infrav1beta2.IBMVPCMachine{
-		Spec: infrav1beta2.IBMVPCMachineSpec{
-			Name: "foo-machine",
+	testCases := []struct {
+		name        string
+		account     *infrav1beta2.EnterpriseAccount
+		expectedID  string
+		expectedErr string
+	}{
+		{
+			name:       "Should find the account by ID",
+			account:    &infrav1beta2.EnterpriseAccount{ID: ptr.To("bar-account-id"), AccountGroupID: ptr.To("account-group-id")},
+			expectedID: "bar-account-id",
 		},
-		Status: infrav1beta2.IBMVPCMachineStatus{
-			InstanceID: "foo-instance-id",
+		{
+			name:       "Should find the account by name",
+			account:    &infrav1beta2.EnterpriseAccount{Name: ptr.To("foo-account"), AccountGroupID: ptr.To("account-group-id")},
+			expectedID: "foo-account-id",
+		},
+		{
+			name:        "Error when the account is not a child of the account group",
+			account:     &infrav1beta2.EnterpriseAccount{ID: ptr.To("baz-account-id"), AccountGroupID: ptr.To("account-group-id")},
+			expectedErr: "account baz-account-id is not a child of account group account-group-id",
 		},
+		{
+			name:        "Error when no account of the account group has the name",
+			account:     &infrav1beta2.EnterpriseAccount{Name: ptr.To("baz-account"), AccountGroupID: ptr.To("account-group-id")},
+			expectedErr: "account baz-account not found in account group account-group-id",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockEM := mockem.NewMockEnterpriseManagement(gomock.NewController(t))
+			mockEM.EXPECT().ListAccountGroupAccounts("account-group-id").Return(accounts, nil)
+			id, err := lookupEnterpriseAccountID(mockEM, tc.account)
+			if tc.expectedErr != "" {
+				g.Expect(err).To(MatchError(tc.expectedErr))
+				return
+			}
+			g.Expect(err).To(BeNil())
+			g.Expect(id).To(Equal(tc.expectedID))
+		})
 	}
 
-	t.Run("Delete Machine", func(t *testing.T) {
-		t.Run("Should delete Machine", func(t *testing.T) {
+	t.Run("Error when listing the accounts of the account group fails", func(t *testing.T) {
+		g := NewWithT(t)
+		mockEM := mockem.NewMockEnterpriseManagement(gomock.NewController(t))
+		mockEM.EXPECT().ListAccountGroupAccounts("account-group-id").Return(nil, errors.New("failed to list accounts"))
+		_, err := lookupEnterpriseAccountID(mockEM, &infrav1beta2.EnterpriseAccount{ID: ptr.To("foo-account-id"), AccountGroupID: ptr.To("account-group-id")})
+		g.Expect(err).To(Not(BeNil()))
+	})
+}
+
+func TestGetEnterpriseAccountID(t *testing.T) {
+	testCases := []struct {
+		name        string
+		account     *infrav1beta2.EnterpriseAccount
+		expectedID  string
+		expectedErr bool
+	}{
+		{
+			name:       "Should return the ID of the account",
+			account:    &infrav1beta2.EnterpriseAccount{ID: ptr.To("foo-account-id")},
+			expectedID: "foo-account-id",
+		},
+		{
+			name:       "Should return the ID of the account owning the project",
+			account:    &infrav1beta2.EnterpriseAccount{ProjectCRN: ptr.To("crn:v1:bluemix:public:project:us-south:a/foo-account-id:project-id::")},
+			expectedID: "foo-account-id",
+		},
+		{
+			name:        "Error when the CRN is not the CRN of a project",
+			account:     &infrav1beta2.EnterpriseAccount{ProjectCRN: ptr.To("crn:v1:bluemix:public:kms:us-south:a/foo-account-id:instance-id:key:key-id")},
+			expectedErr: true,
+		},
+		{
+			name:        "Error when the CRN is malformed",
+			account:     &infrav1beta2.EnterpriseAccount{ProjectCRN: ptr.To("project-id")},
+			expectedErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
 			g := NewWithT(t)
-			mockController, mockvpc := setup(t)
-			t.Cleanup(mockController.Finish)
-			scope := setupMachineScope(clusterName, machineName, mockvpc)
-			scope.IBMVPCMachine.Spec = vpcMachine.Spec
-			scope.IBMVPCMachine.Status = vpcMachine.Status
-			mockvpc.EXPECT().DeleteInstance(gomock.AssignableToTypeOf(&vpcv1.DeleteInstanceOptions{})).Return(&core.DetailedResponse{}, nil)
-			err := scope.DeleteMachine()
+			id, err := getEnterpriseAccountID(nil, tc.account)
+			if tc.expectedErr {
+				g.Expect(err).To(Not(BeNil()))
+				return
+			}
 			g.Expect(err).To(BeNil())
+			g.Expect(id).To(Equal(tc.expectedID))
 		})
+	}
+}
 
-		t.Run("Error when deleting Machine", func(t *testing.T) {
+func TestDeleteOwnerMachine(t *testing.T) {
+	machinePoolOwner := metav1.OwnerReference{
+		APIVersion: capiv1beta1.GroupVersion.String(),
+		Kind:       "MachinePool",
+		Name:       "foo-machine-pool",
+	}
+
+	t.Run("Delete owner machine", func(t *testing.T) {
+		t.Run("Should delete the Machine and record the remediation", func(t *testing.T) {
 			g := NewWithT(t)
-			mockController, mockvpc := setup(t)
-			t.Cleanup(mockController.Finish)
-			scope := setupMachineScope(clusterName, machineName, mockvpc)
-			scope.IBMVPCMachine.Spec = vpcMachine.Spec
-			scope.IBMVPCMachine.Status = vpcMachine.Status
-			mockvpc.EXPECT().DeleteInstance(gomock.AssignableToTypeOf(&vpcv1.DeleteInstanceOptions{})).Return(&core.DetailedResponse{}, errors.New("Failed instance deletion"))
-			err := scope.DeleteMachine()
+			scope := setupMachineScope(clusterName, machineName, nil)
+			scope.IBMVPCMachine.Status.InstanceID = "foo-instance-id"
+			scope.Machine.OwnerReferences = []metav1.OwnerReference{machinePoolOwner}
+			err := scope.DeleteOwnerMachine("the instance failed")
+			g.Expect(err).To(BeNil())
+			err = scope.Client.Get(context.TODO(), client.ObjectKeyFromObject(scope.Machine), &capiv1beta1.Machine{})
 			g.Expect(err).To(Not(BeNil()))
+			g.Expect(scope.IBMVPCMachine.Status.ActionHistory).To(HaveLen(1))
+			action := scope.IBMVPCMachine.Status.ActionHistory[0]
+			g.Expect(action.Type).To(Equal(infrav1beta2.MachineActionRemediate))
+			g.Expect(action.InstanceID).To(Equal("foo-instance-id"))
+			g.Expect(action.Succeeded).To(BeTrue())
+			g.Expect(action.Message).To(Equal(fmt.Sprintf("deleted machine %s, the instance failed", machineName)))
 		})
 
-		t.Run("Empty InstanceID", func(t *testing.T) {
+		t.Run("Should skip the Machine being deleted", func(t *testing.T) {
 			g := NewWithT(t)
-			mockController, mockvpc := setup(t)
-			t.Cleanup(mockController.Finish)
-			scope := setupMachineScope(clusterName, machineName, mockvpc)
-			scope.IBMVPCMachine.Status.InstanceID = ""
-			err := scope.DeleteMachine()
+			scope := setupMachineScope(clusterName, machineName, nil)
+			scope.Machine.OwnerReferences = []metav1.OwnerReference{machinePoolOwner}
+			scope.Machine.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+			err := scope.DeleteOwnerMachine("the instance failed")
+			g.Expect(err).To(BeNil())
+			g.Expect(scope.IBMVPCMachine.Status.ActionHistory).To(BeEmpty())
+		})
+
+		t.Run("Should leave the Machine not replaced once deleted", func(t *testing.T) {
+			g := NewWithT(t)
+			scope := setupMachineScope(clusterName, machineName, nil)
+			err := scope.DeleteOwnerMachine("the instance failed")
 			g.Expect(err).To(BeNil())
+			err = scope.Client.Get(context.TODO(), client.ObjectKeyFromObject(scope.Machine), &capiv1beta1.Machine{})
+			g.Expect(err).To(BeNil())
+			g.Expect(scope.IBMVPCMachine.Status.ActionHistory).To(BeEmpty())
 		})
 	})
 }
@@ -1084,3 +2231,311 @@ func TestDeleteVPCLoadBalancerPoolMember(t *testing.T) {
 		})
 	})
 }
+
+func TestReconcileInstanceTeardown(t *testing.T) {
+	var (
+		mockvpc  *mock.MockVpc
+		mockCtrl *gomock.Controller
+	)
+
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockvpc = mock.NewMockVpc(mockCtrl)
+	}
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+
+	t.Run("Should request a graceful stop of a running instance", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := setupMachineScope(clusterName, machineName, mockvpc)
+		scope.IBMVPCMachine.Status.InstanceID = "foo-instance-id"
+		scope.IBMVPCMachine.Spec.ShutdownTimeout = &metav1.Duration{Duration: time.Minute}
+		mockvpc.EXPECT().GetInstance(gomock.AssignableToTypeOf(&vpcv1.GetInstanceOptions{})).Return(&vpcv1.Instance{Status: ptr.To(vpcv1.InstanceStatusRunningConst)}, &core.DetailedResponse{}, nil)
+		mockvpc.EXPECT().CreateInstanceAction(&vpcv1.CreateInstanceActionOptions{
+			InstanceID: ptr.To("foo-instance-id"),
+			Type:       ptr.To(vpcv1.InstanceActionTypeStopConst),
+			Force:      ptr.To(false),
+		}).Return(&vpcv1.InstanceAction{}, &core.DetailedResponse{}, nil)
+		requeue, err := scope.ReconcileInstanceShutdown()
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
+		g.Expect(conditions.GetReason(scope.IBMVPCMachine, infrav1beta2.InstanceShutdownCondition)).To(Equal(infrav1beta2.InstanceShuttingDownReason))
+		g.Expect(scope.IBMVPCMachine.Status.ActionHistory).To(HaveLen(1))
+		g.Expect(scope.IBMVPCMachine.Status.ActionHistory[0].Type).To(Equal(infrav1beta2.MachineActionStop))
+	})
+
+	t.Run("Should not stop the instance when the shutdown timeout is not set", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := setupMachineScope(clusterName, machineName, mockvpc)
+		scope.IBMVPCMachine.Status.InstanceID = "foo-instance-id"
+		requeue, err := scope.ReconcileInstanceShutdown()
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeFalse())
+		g.Expect(scope.IBMVPCMachine.Status.ActionHistory).To(BeEmpty())
+	})
+
+	t.Run("Should skip the shutdown of an instance which no longer exists", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := setupMachineScope(clusterName, machineName, mockvpc)
+		scope.IBMVPCMachine.Status.InstanceID = "foo-instance-id"
+		scope.IBMVPCMachine.Spec.ShutdownTimeout = &metav1.Duration{Duration: time.Minute}
+		mockvpc.EXPECT().GetInstance(gomock.AssignableToTypeOf(&vpcv1.GetInstanceOptions{})).Return(nil, &core.DetailedResponse{StatusCode: 404}, errors.New("instance not found"))
+		requeue, err := scope.ReconcileInstanceShutdown()
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeFalse())
+		g.Expect(conditions.IsTrue(scope.IBMVPCMachine, infrav1beta2.InstanceShutdownCondition)).To(BeTrue())
+	})
+
+	t.Run("Should detach only the data volumes kept after the instance deletion", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := setupMachineScope(clusterName, machineName, mockvpc)
+		scope.IBMVPCMachine.Status.InstanceID = "foo-instance-id"
+		attachments := &vpcv1.VolumeAttachmentCollection{
+			VolumeAttachments: []vpcv1.VolumeAttachment{
+				{
+					ID:                           ptr.To("boot-attachment-id"),
+					Type:                         ptr.To(vpcv1.VolumeAttachmentTypeBootConst),
+					Status:                       ptr.To(vpcv1.VolumeAttachmentStatusAttachedConst),
+					DeleteVolumeOnInstanceDelete: ptr.To(true),
+				},
+				{
+					ID:                           ptr.To("ephemeral-attachment-id"),
+					Type:                         ptr.To(vpcv1.VolumeAttachmentTypeDataConst),
+					Status:                       ptr.To(vpcv1.VolumeAttachmentStatusAttachedConst),
+					DeleteVolumeOnInstanceDelete: ptr.To(true),
+				},
+				{
+					ID:                           ptr.To("data-attachment-id"),
+					Type:                         ptr.To(vpcv1.VolumeAttachmentTypeDataConst),
+					Status:                       ptr.To(vpcv1.VolumeAttachmentStatusAttachedConst),
+					DeleteVolumeOnInstanceDelete: ptr.To(false),
+				},
+				{
+					ID:                           ptr.To("detaching-attachment-id"),
+					Type:                         ptr.To(vpcv1.VolumeAttachmentTypeDataConst),
+					Status:                       ptr.To(vpcv1.VolumeAttachmentStatusDetachingConst),
+					DeleteVolumeOnInstanceDelete: ptr.To(false),
+				},
+			},
+		}
+		mockvpc.EXPECT().ListInstanceVolumeAttachments(gomock.AssignableToTypeOf(&vpcv1.ListInstanceVolumeAttachmentsOptions{})).Return(attachments, &core.DetailedResponse{}, nil)
+		mockvpc.EXPECT().DeleteInstanceVolumeAttachment(&vpcv1.DeleteInstanceVolumeAttachmentOptions{
+			InstanceID: ptr.To("foo-instance-id"),
+			ID:         ptr.To("data-attachment-id"),
+		}).Return(&core.DetailedResponse{}, nil)
+		requeue, err := scope.ReconcileVolumeDetach()
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
+		g.Expect(conditions.GetReason(scope.IBMVPCMachine, infrav1beta2.VolumesDetachedCondition)).To(Equal(infrav1beta2.DetachingVolumesReason))
+
+		mockvpc.EXPECT().ListInstanceVolumeAttachments(gomock.AssignableToTypeOf(&vpcv1.ListInstanceVolumeAttachmentsOptions{})).Return(&vpcv1.VolumeAttachmentCollection{
+			VolumeAttachments: attachments.VolumeAttachments[:2],
+		}, &core.DetailedResponse{}, nil)
+		requeue, err = scope.ReconcileVolumeDetach()
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeFalse())
+		g.Expect(conditions.IsTrue(scope.IBMVPCMachine, infrav1beta2.VolumesDetachedCondition)).To(BeTrue())
+	})
+}
+
+func TestVPCMachineExceededGuardrails(t *testing.T) {
+	setup := func(t *testing.T) (*gomock.Controller, *mock.MockVpc) {
+		t.Helper()
+		return gomock.NewController(t), mock.NewMockVpc(gomock.NewController(t))
+	}
+	createdMachine := func(name, profile string) *infrav1beta2.IBMVPCMachine {
+		machine := newVPCMachine(clusterName, name)
+		machine.Spec.Profile = profile
+		machine.Status.InstanceID = name + "-instance-id"
+		return machine
+	}
+	profile := func(vcpu, memory int64) *vpcv1.InstanceProfile {
+		return &vpcv1.InstanceProfile{
+			VcpuCount: &vpcv1.InstanceProfileVcpu{Type: ptr.To("fixed"), Value: ptr.To(vcpu)},
+			Memory:    &vpcv1.InstanceProfileMemory{Type: ptr.To("fixed"), Value: ptr.To(memory)},
+		}
+	}
+
+	t.Run("Should not check guardrails when the cluster has none", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockvpc)
+		exceeded, err := scope.ExceededGuardrails()
+		g.Expect(err).To(BeNil())
+		g.Expect(exceeded).To(BeEmpty())
+	})
+
+	t.Run("Should count the created instances against maxInstances", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockvpc)
+		scope.IBMVPCCluster.Spec.Guardrails = &infrav1beta2.ClusterGuardrails{MaxInstances: ptr.To[int32](2)}
+		g.Expect(scope.Client.Create(context.Background(), createdMachine("machine-0", "bx2-2x8"))).To(Succeed())
+		g.Expect(scope.Client.Create(context.Background(), newVPCMachine(clusterName, "machine-1"))).To(Succeed())
+
+		exceeded, err := scope.ExceededGuardrails()
+		g.Expect(err).To(BeNil())
+		g.Expect(exceeded).To(BeEmpty())
+
+		g.Expect(scope.Client.Create(context.Background(), createdMachine("machine-2", "bx2-2x8"))).To(Succeed())
+		mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
+		exceeded, err = scope.ExceededGuardrails()
+		g.Expect(err).To(BeNil())
+		g.Expect(exceeded).To(Equal("3 instances exceed maxInstances 2"))
+	})
+
+	t.Run("Should count the cores and memory of the profiles of the instances", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockvpc)
+		scope.IBMVPCMachine.Spec.Profile = "bx2-4x16"
+		scope.IBMVPCCluster.Spec.Guardrails = &infrav1beta2.ClusterGuardrails{
+			MaxTotalCores:     ptr.To(intstr.FromInt32(8)),
+			MaxTotalMemoryGiB: ptr.To[int32](48),
+		}
+		g.Expect(scope.Client.Create(context.Background(), createdMachine("machine-0", "bx2-4x16"))).To(Succeed())
+		g.Expect(scope.Client.Create(context.Background(), createdMachine("machine-1", "bx2-2x8"))).To(Succeed())
+		mockvpc.EXPECT().GetInstanceProfile(&vpcv1.GetInstanceProfileOptions{Name: ptr.To("bx2-4x16")}).Return(profile(4, 16), &core.DetailedResponse{}, nil)
+		mockvpc.EXPECT().GetInstanceProfile(&vpcv1.GetInstanceProfileOptions{Name: ptr.To("bx2-2x8")}).Return(profile(2, 8), &core.DetailedResponse{}, nil)
+		mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
+
+		exceeded, err := scope.ExceededGuardrails()
+		g.Expect(err).To(BeNil())
+		g.Expect(exceeded).To(Equal("10 cores exceed maxTotalCores 8"))
+	})
+
+	t.Run("Should count the pending instances of the machines created before the machine", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockvpc)
+		scope.IBMVPCCluster.Spec.Guardrails = &infrav1beta2.ClusterGuardrails{MaxInstances: ptr.To[int32](1)}
+		earlier := newVPCMachine(clusterName, "a-machine")
+		g.Expect(scope.Client.Create(context.Background(), earlier)).To(Succeed())
+		mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
+
+		exceeded, err := scope.ExceededGuardrails()
+		g.Expect(err).To(BeNil())
+		g.Expect(exceeded).To(Equal("2 instances exceed maxInstances 1"))
+	})
+
+	t.Run("Should adopt the existing instance of the machine exceeding the guardrails", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockvpc)
+		scope.IBMVPCCluster.Spec.Guardrails = &infrav1beta2.ClusterGuardrails{MaxInstances: ptr.To[int32](1)}
+		g.Expect(scope.Client.Create(context.Background(), createdMachine("machine-0", "bx2-2x8"))).To(Succeed())
+		mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{
+			Instances: []vpcv1.Instance{{ID: ptr.To("instance-id"), Name: ptr.To(scope.instanceName())}},
+		}, &core.DetailedResponse{}, nil)
+
+		exceeded, err := scope.ExceededGuardrails()
+		g.Expect(err).To(BeNil())
+		g.Expect(exceeded).To(BeEmpty())
+	})
+
+	t.Run("Should not check guardrails once the instance is created", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockvpc)
+		scope.IBMVPCCluster.Spec.Guardrails = &infrav1beta2.ClusterGuardrails{MaxInstances: ptr.To[int32](0)}
+		scope.IBMVPCMachine.Status.InstanceID = "instance-id"
+		exceeded, err := scope.ExceededGuardrails()
+		g.Expect(err).To(BeNil())
+		g.Expect(exceeded).To(BeEmpty())
+	})
+
+	t.Run("Should error when the profile can't be fetched", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockvpc)
+		scope.IBMVPCMachine.Spec.Profile = "bx2-4x16"
+		scope.IBMVPCCluster.Spec.Guardrails = &infrav1beta2.ClusterGuardrails{MaxTotalMemoryGiB: ptr.To[int32](64)}
+		mockvpc.EXPECT().GetInstanceProfile(gomock.AssignableToTypeOf(&vpcv1.GetInstanceProfileOptions{})).Return(nil, &core.DetailedResponse{}, errors.New("failed to get instance profile"))
+		_, err := scope.ExceededGuardrails()
+		g.Expect(err).ToNot(BeNil())
+	})
+}
+
+func TestGetSecurityGroupIDWithResourceSelector(t *testing.T) {
+	setup := func(t *testing.T) (*MachineScope, *mock.MockVpc, *gtmock.MockGlobalTagging) {
+		t.Helper()
+		mockCtrl := gomock.NewController(t)
+		mockvpc := mock.NewMockVpc(mockCtrl)
+		mockGT := gtmock.NewMockGlobalTagging(mockCtrl)
+		scope := setupMachineScope(clusterName, machineName, mockvpc)
+		scope.GlobalTaggingClient = mockGT
+		scope.IBMVPCCluster.Spec.ResourceSelector = &infrav1beta2.ResourceSelector{Tags: []string{"team:infra"}}
+		return scope, mockvpc, mockGT
+	}
+	securityGroup := &vpcv1.SecurityGroup{ID: ptr.To("security-group-id"), CRN: ptr.To("security-group-crn")}
+
+	t.Run("Should return the id of the security group matching the resource selector", func(t *testing.T) {
+		g := NewWithT(t)
+		scope, mockvpc, mockGT := setup(t)
+		mockvpc.EXPECT().GetSecurityGroupByName("security-group").Return(securityGroup, nil)
+		mockGT.EXPECT().GetAttachedTags("security-group-crn").Return([]string{"team:infra"}, nil)
+		g.Expect(scope.getSecurityGroupID(infrav1beta2.VPCResource{Name: ptr.To("security-group")})).To(Equal(ptr.To("security-group-id")))
+	})
+
+	t.Run("Should ignore the security group not matching the resource selector", func(t *testing.T) {
+		g := NewWithT(t)
+		scope, mockvpc, mockGT := setup(t)
+		mockvpc.EXPECT().GetSecurityGroupByName("security-group").Return(securityGroup, nil)
+		mockGT.EXPECT().GetAttachedTags("security-group-crn").Return([]string{"team:other"}, nil)
+		_, err := scope.getSecurityGroupID(infrav1beta2.VPCResource{Name: ptr.To("security-group")})
+		g.Expect(err).To(MatchError(ContainSubstring("cannot find security group security-group")))
+	})
+}
+
+func TestPlacementTargetZone(t *testing.T) {
+	setup := func(t *testing.T) (*gomock.Controller, *mock.MockVpc) {
+		t.Helper()
+		return gomock.NewController(t), mock.NewMockVpc(gomock.NewController(t))
+	}
+
+	t.Run("Should return the zone of the dedicated host", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc := setup(t)
+		t.Cleanup(mockController.Finish)
+		mockvpc.EXPECT().GetDedicatedHost(&vpcv1.GetDedicatedHostOptions{ID: ptr.To("dedicated-host-id")}).Return(&vpcv1.DedicatedHost{Zone: &vpcv1.ZoneReference{Name: ptr.To("us-south-1")}}, &core.DetailedResponse{}, nil)
+		zone, err := PlacementTargetZone(mockvpc, infrav1beta2.VPCMachinePlacementTarget{DedicatedHost: &infrav1beta2.VPCResource{ID: ptr.To("dedicated-host-id")}})
+		g.Expect(err).To(BeNil())
+		g.Expect(zone).To(Equal("us-south-1"))
+	})
+
+	t.Run("Should return the zone of the dedicated host group", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc := setup(t)
+		t.Cleanup(mockController.Finish)
+		mockvpc.EXPECT().GetDedicatedHostGroupByName("dedicated-host-group").Return(&vpcv1.DedicatedHostGroup{Zone: &vpcv1.ZoneReference{Name: ptr.To("us-south-2")}}, nil)
+		zone, err := PlacementTargetZone(mockvpc, infrav1beta2.VPCMachinePlacementTarget{DedicatedHostGroup: &infrav1beta2.VPCResource{Name: ptr.To("dedicated-host-group")}})
+		g.Expect(err).To(BeNil())
+		g.Expect(zone).To(Equal("us-south-2"))
+	})
+
+	t.Run("Should return an error when the dedicated host does not exist", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc := setup(t)
+		t.Cleanup(mockController.Finish)
+		mockvpc.EXPECT().GetDedicatedHostByName("dedicated-host").Return(nil, nil)
+		_, err := PlacementTargetZone(mockvpc, infrav1beta2.VPCMachinePlacementTarget{DedicatedHost: &infrav1beta2.VPCResource{Name: ptr.To("dedicated-host")}})
+		g.Expect(err).ToNot(BeNil())
+	})
+}
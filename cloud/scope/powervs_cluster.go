@@ -21,12 +21,15 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/go-logr/logr"
 	regionUtil "github.com/ppc64le-cloud/powervs-utils"
 
 	"github.com/IBM-Cloud/power-go-client/ibmpisession"
+	"github.com/IBM-Cloud/power-go-client/power/client/p_cloud_tenants_ssh_keys"
 	"github.com/IBM-Cloud/power-go-client/power/models"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/ibm-cos-sdk-go/aws"
@@ -34,29 +37,41 @@ import (
 	cosSession "github.com/IBM/ibm-cos-sdk-go/aws/session"
 	"github.com/IBM/ibm-cos-sdk-go/service/s3"
 	tgapiv1 "github.com/IBM/networking-go-sdk/transitgatewayapisv1"
+	"github.com/IBM/platform-services-go-sdk/globalsearchv2"
+	"github.com/IBM/platform-services-go-sdk/globaltaggingv1"
 	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
 	"github.com/IBM/platform-services-go-sdk/resourcemanagerv2"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	kerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/klog/v2"
 	"k8s.io/utils/ptr"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/yaml"
 
 	capiv1beta1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	addonsv1beta1 "sigs.k8s.io/cluster-api/exp/addons/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
 	"sigs.k8s.io/cluster-api/util/patch"
 
 	infrav1beta2 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/v1beta2"
-	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/authenticator"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/cos"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/dns"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/globalsearch"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/globaltagging"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/powervs"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/resourcecontroller"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/resourcemanager"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/transitgateway"
-	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/utils"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/vpc"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/debugdump"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/endpoints"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/names"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/record"
 	genUtil "sigs.k8s.io/cluster-api-provider-ibmcloud/util"
 )
 
@@ -79,6 +94,11 @@ const (
 	// vpcSubnetIPAddressCount is the total IP Addresses for the subnet.
 	// Support for custom address prefixes will be added at a later time. Currently, we use the ip count for subnet creation.
 	vpcSubnetIPAddressCount int64 = 256
+	// vxlanOverhead is the size of the VXLAN encapsulation headers of the calico interfaces.
+	vxlanOverhead int64 = 50
+	// defaultCalicoMTU is the MTU used by calico interfaces when the MTU of the Power VS network is unknown,
+	// the default Power VS network MTU 1450 minus the VXLAN overhead.
+	defaultCalicoMTU int32 = 1400
 )
 
 // PowerVSClusterScopeParams defines the input parameters used to create a new PowerVSClusterScope.
@@ -101,6 +121,9 @@ type ClientFactory struct {
 	TransitGatewayFactory     func() (transitgateway.TransitGateway, error)
 	ResourceControllerFactory func() (resourcecontroller.ResourceController, error)
 	ResourceManagerFactory    func() (resourcemanager.ResourceManager, error)
+	GlobalTaggingFactory      func() (globaltagging.GlobalTagging, error)
+	GlobalSearchFactory       func() (globalsearch.GlobalSearch, error)
+	DNSFactory                func() (dns.DNS, error)
 }
 
 // PowerVSClusterScope defines a scope defined around a Power VS Cluster.
@@ -115,15 +138,29 @@ type PowerVSClusterScope struct {
 	ResourceClient        resourcecontroller.ResourceController
 	COSClient             cos.Cos
 	ResourceManagerClient resourcemanager.ResourceManager
+	GlobalTaggingClient   globaltagging.GlobalTagging
+	GlobalSearchClient    globalsearch.GlobalSearch
+	DNSClient             dns.DNS
 
 	Cluster           *capiv1beta1.Cluster
 	IBMPowerVSCluster *infrav1beta2.IBMPowerVSCluster
 	ServiceEndpoint   []endpoints.ServiceEndpoint
 }
 
-func getTGPowerVSConnectionName(tgName string) string { return fmt.Sprintf("%s-pvs-con", tgName) }
+func getTGPowerVSConnectionName(tgName string) string {
+	return names.Normalize(fmt.Sprintf("%s-pvs-con", tgName), names.VPCMaxLength)
+}
+
+func getTGVPCConnectionName(tgName string) string {
+	return names.Normalize(fmt.Sprintf("%s-vpc-con", tgName), names.VPCMaxLength)
+}
 
-func getTGVPCConnectionName(tgName string) string { return fmt.Sprintf("%s-vpc-con", tgName) }
+// loadBalancerPoolName returns the name of the pool of the load balancer forwarding to port, the port is kept as
+// suffix of the name as it is read back to set the port of the pool members.
+func loadBalancerPoolName(loadBalancerName string, port int32) string {
+	prefix := names.Normalize(loadBalancerName+"-pool", names.VPCMaxLength-len(strconv.Itoa(int(port)))-1)
+	return fmt.Sprintf("%s-%d", prefix, port)
+}
 
 func dhcpNetworkName(dhcpServerName string) string {
 	return fmt.Sprintf("DHCPSERVER%s_Private", dhcpServerName)
@@ -155,14 +192,75 @@ func NewPowerVSClusterScope(params PowerVSClusterScopeParams) (*PowerVSClusterSc
 
 	// if powervs.cluster.x-k8s.io/create-infra=true annotation is not set, create only powerVSClient.
 	if !CheckCreateInfraAnnotation(*params.IBMPowerVSCluster) {
-		return &PowerVSClusterScope{
+		clusterScope := &PowerVSClusterScope{
 			Logger:            params.Logger,
 			Client:            params.Client,
 			patchHelper:       helper,
 			Cluster:           params.Cluster,
 			IBMPowerVSCluster: params.IBMPowerVSCluster,
 			ServiceEndpoint:   params.ServiceEndpoint,
-		}, nil
+		}
+		if CheckManageDHCPNetwork(*params.IBMPowerVSCluster) {
+			// create PowerVS client on the existing workspace to manage the DHCP network.
+			powerVSClient, err := params.getManagedNetworkPowerVSClient()
+			if err != nil {
+				return nil, fmt.Errorf("failed to create PowerVS client %w", err)
+			}
+			clusterScope.IBMPowerVSClient = powerVSClient
+		}
+		if CheckManageLoadBalancer(*params.IBMPowerVSCluster) || CheckManageTransitGateway(*params.IBMPowerVSCluster) {
+			// create VPC and resource manager clients to manage the load balancers or the transit gateway of the existing VPC.
+			vpcClient, err := params.getVPCClient()
+			if err != nil {
+				return nil, fmt.Errorf("failed to create VPC client: %w", err)
+			}
+			clusterScope.IBMVPCClient = vpcClient
+
+			auth, err := params.getAuthenticator()
+			if err != nil {
+				return nil, fmt.Errorf("failed to create authenticator %w", err)
+			}
+			rmClient, err := params.getResourceManagerClient(&resourcemanagerv2.ResourceManagerV2Options{
+				Authenticator: auth,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to create resource manager client: %w", err)
+			}
+			clusterScope.ResourceManagerClient = rmClient
+		}
+		if CheckManageTransitGateway(*params.IBMPowerVSCluster) {
+			// create transit gateway and resource controller clients to attach the existing workspace and VPC to the transit gateway.
+			auth, err := params.getAuthenticator()
+			if err != nil {
+				return nil, fmt.Errorf("failed to create authenticator %w", err)
+			}
+			tgClient, err := params.getTransitGatewayClient(&tgapiv1.TransitGatewayApisV1Options{
+				Authenticator: auth,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to create tranist gateway client: %w", err)
+			}
+			clusterScope.TransitGatewayClient = tgClient
+
+			resourceClient, err := params.getResourceControllerClient(resourcecontroller.ServiceOptions{
+				ResourceControllerV2Options: &resourcecontrollerv2.ResourceControllerV2Options{
+					Authenticator: auth,
+				},
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to create resource controller client: %w", err)
+			}
+			clusterScope.ResourceClient = resourceClient
+		}
+		if params.IBMPowerVSCluster.Spec.DNS != nil {
+			// create DNS client to register the control plane endpoint.
+			dnsClient, err := params.getDNSClient()
+			if err != nil {
+				return nil, fmt.Errorf("failed to create DNS client: %w", err)
+			}
+			clusterScope.DNSClient = dnsClient
+		}
+		return clusterScope, nil
 	}
 
 	// if powervs.cluster.x-k8s.io/create-infra=true annotation is set, create necessary clients.
@@ -174,35 +272,11 @@ func NewPowerVSClusterScope(params PowerVSClusterScopeParams) (*PowerVSClusterSc
 
 	// if Spec.ServiceInstanceID is set fetch zone associated with it or else use Spec.Zone.
 	if params.IBMPowerVSCluster.Spec.ServiceInstanceID != "" {
-		// Create Resource Controller client.
-		var serviceOption resourcecontroller.ServiceOptions
-		// Fetch the resource controller endpoint.
-		rcEndpoint := endpoints.FetchEndpoints(string(endpoints.RC), params.ServiceEndpoint)
-		if rcEndpoint != "" {
-			serviceOption.URL = rcEndpoint
-			params.Logger.V(3).Info("Overriding the default resource controller endpoint", "ResourceControllerEndpoint", rcEndpoint)
-		}
-		rc, err := resourcecontroller.NewService(serviceOption)
+		zone, err := params.getServiceInstanceZone(params.IBMPowerVSCluster.Spec.ServiceInstanceID)
 		if err != nil {
 			return nil, err
 		}
-
-		// Fetch the resource controller endpoint.
-		if rcEndpoint := endpoints.FetchRCEndpoint(params.ServiceEndpoint); rcEndpoint != "" {
-			params.Logger.V(3).Info("Overriding the default resource controller endpoint", "ResourceControllerEndpoint", rcEndpoint)
-			if err := rc.SetServiceURL(rcEndpoint); err != nil {
-				return nil, fmt.Errorf("failed to set resource controller endpoint: %w", err)
-			}
-		}
-
-		res, _, err := rc.GetResourceInstance(
-			&resourcecontrollerv2.GetResourceInstanceOptions{
-				ID: core.StringPtr(params.IBMPowerVSCluster.Spec.ServiceInstanceID),
-			})
-		if err != nil {
-			return nil, fmt.Errorf("failed to get resource instance: %w", err)
-		}
-		piOptions.Zone = *res.RegionID
+		piOptions.Zone = zone
 		piOptions.CloudInstanceID = params.IBMPowerVSCluster.Spec.ServiceInstanceID
 	} else {
 		piOptions.Zone = *params.IBMPowerVSCluster.Spec.Zone
@@ -259,6 +333,26 @@ func NewPowerVSClusterScope(params PowerVSClusterScopeParams) (*PowerVSClusterSc
 		return nil, fmt.Errorf("failed to create resource manager client: %w", err)
 	}
 
+	// Create Global Tagging client.
+	gtClient, err := params.getGlobalTaggingClient(globaltagging.ServiceOptions{
+		GlobalTaggingV1Options: &globaltaggingv1.GlobalTaggingV1Options{
+			Authenticator: auth,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create global tagging client: %w", err)
+	}
+
+	// Create Global Search client.
+	gsClient, err := params.getGlobalSearchClient(globalsearch.ServiceOptions{
+		GlobalSearchV2Options: &globalsearchv2.GlobalSearchV2Options{
+			Authenticator: auth,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create global search client: %w", err)
+	}
+
 	clusterScope := &PowerVSClusterScope{
 		Logger:                params.Logger,
 		Client:                params.Client,
@@ -271,6 +365,17 @@ func NewPowerVSClusterScope(params PowerVSClusterScopeParams) (*PowerVSClusterSc
 		TransitGatewayClient:  tgClient,
 		ResourceClient:        resourceClient,
 		ResourceManagerClient: rmClient,
+		GlobalTaggingClient:   gtClient,
+		GlobalSearchClient:    gsClient,
+	}
+
+	// Create DNS client when the control plane endpoint is registered in DNS.
+	if params.IBMPowerVSCluster.Spec.DNS != nil {
+		dnsClient, err := params.getDNSClient()
+		if err != nil {
+			return nil, fmt.Errorf("failed to create DNS client: %w", err)
+		}
+		clusterScope.DNSClient = dnsClient
 	}
 	return clusterScope, nil
 }
@@ -279,7 +384,7 @@ func (params PowerVSClusterScopeParams) getAuthenticator() (core.Authenticator,
 	if params.AuthenticatorFactory != nil {
 		return params.AuthenticatorFactory()
 	}
-	return authenticator.GetAuthenticator()
+	return getAuthenticator(params.Client, params.IBMPowerVSCluster.Namespace, params.IBMPowerVSCluster.Spec.CredentialsSecretRef, params.IBMPowerVSCluster.Spec.EnterpriseAccount)
 }
 
 func (params PowerVSClusterScopeParams) getPowerVSClient(options powervs.ServiceOptions) (powervs.PowerVS, error) {
@@ -288,14 +393,105 @@ func (params PowerVSClusterScopeParams) getPowerVSClient(options powervs.Service
 	}
 
 	// Fetch the PowerVS service endpoint.
-	powerVSServiceEndpoint := endpoints.FetchEndpoints(string(endpoints.PowerVS), params.ServiceEndpoint)
+	powerVSServiceEndpoint := endpoints.FetchRegionalEndpoint(string(endpoints.PowerVS), endpoints.ConstructRegionFromZone(options.Zone), params.ServiceEndpoint)
 	if powerVSServiceEndpoint != "" {
 		params.Logger.V(3).Info("Overriding the default PowerVS endpoint", "powerVSEndpoint", powerVSServiceEndpoint)
 		options.URL = powerVSServiceEndpoint
 	}
+	cluster := params.IBMPowerVSCluster
+	if err := applyPowerVSPrivateCloud(params.Client, cluster.Namespace, cluster.Spec.CredentialsSecretRef, cluster.Spec.PrivateCloud, options.IBMPIOptions); err != nil {
+		return nil, err
+	}
 	return powervs.NewService(options)
 }
 
+// getServiceInstanceZone returns the zone of the Power VS workspace with the given ID.
+func (params PowerVSClusterScopeParams) getServiceInstanceZone(serviceInstanceID string) (string, error) {
+	auth, err := params.getAuthenticator()
+	if err != nil {
+		return "", fmt.Errorf("failed to create authenticator %w", err)
+	}
+
+	// Create Resource Controller client.
+	serviceOption := resourcecontroller.ServiceOptions{
+		ResourceControllerV2Options: &resourcecontrollerv2.ResourceControllerV2Options{
+			Authenticator: auth,
+		},
+	}
+	// Fetch the resource controller endpoint.
+	rcEndpoint := endpoints.FetchEndpoints(string(endpoints.RC), params.ServiceEndpoint)
+	if rcEndpoint != "" {
+		serviceOption.URL = rcEndpoint
+		params.Logger.V(3).Info("Overriding the default resource controller endpoint", "ResourceControllerEndpoint", rcEndpoint)
+	}
+	rc, err := resourcecontroller.NewService(serviceOption)
+	if err != nil {
+		return "", err
+	}
+
+	// Fetch the resource controller endpoint.
+	if rcEndpoint := endpoints.FetchRCEndpoint(params.ServiceEndpoint); rcEndpoint != "" {
+		params.Logger.V(3).Info("Overriding the default resource controller endpoint", "ResourceControllerEndpoint", rcEndpoint)
+		if err := rc.SetServiceURL(rcEndpoint); err != nil {
+			return "", fmt.Errorf("failed to set resource controller endpoint: %w", err)
+		}
+	}
+
+	res, _, err := rc.GetResourceInstance(
+		&resourcecontrollerv2.GetResourceInstanceOptions{
+			ID: core.StringPtr(serviceInstanceID),
+		})
+	if err != nil {
+		return "", fmt.Errorf("failed to get resource instance: %w", err)
+	}
+	return *res.RegionID, nil
+}
+
+// getManagedNetworkPowerVSClient returns the PowerVS client of the existing workspace in which the DHCP network is managed.
+func (params PowerVSClusterScopeParams) getManagedNetworkPowerVSClient() (powervs.PowerVS, error) {
+	if params.PowerVSClientFactory != nil {
+		return params.PowerVSClientFactory()
+	}
+
+	serviceInstanceID := params.IBMPowerVSCluster.Spec.ServiceInstanceID
+	if serviceInstanceID == "" && params.IBMPowerVSCluster.Spec.ServiceInstance != nil && params.IBMPowerVSCluster.Spec.ServiceInstance.ID != nil {
+		serviceInstanceID = *params.IBMPowerVSCluster.Spec.ServiceInstance.ID
+	}
+	if serviceInstanceID == "" {
+		return nil, fmt.Errorf("failed to find Power VS workspace ID to manage DHCP network")
+	}
+
+	piOptions := powervs.ServiceOptions{
+		IBMPIOptions: &ibmpisession.IBMPIOptions{
+			Debug: params.Logger.V(DEBUGLEVEL).Enabled(),
+		},
+		CloudInstanceID: serviceInstanceID,
+	}
+
+	if params.IBMPowerVSCluster.Spec.Zone != nil {
+		piOptions.Zone = *params.IBMPowerVSCluster.Spec.Zone
+	} else {
+		zone, err := params.getServiceInstanceZone(serviceInstanceID)
+		if err != nil {
+			return nil, err
+		}
+		piOptions.Zone = zone
+	}
+
+	auth, err := params.getAuthenticator()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create authenticator %w", err)
+	}
+	piOptions.Authenticator = auth
+
+	powerVSClient, err := params.getPowerVSClient(piOptions)
+	if err != nil {
+		return nil, err
+	}
+	powerVSClient.WithClients(powervs.ServiceOptions{CloudInstanceID: serviceInstanceID})
+	return powerVSClient, nil
+}
+
 func (params PowerVSClusterScopeParams) getVPCClient() (vpc.Vpc, error) {
 	if params.Logger.V(DEBUGLEVEL).Enabled() {
 		core.SetLoggingLevel(core.LevelDebug)
@@ -307,8 +503,12 @@ func (params PowerVSClusterScopeParams) getVPCClient() (vpc.Vpc, error) {
 		return nil, fmt.Errorf("failed to create VPC client as VPC info is nil")
 	}
 	// Fetch the VPC service endpoint.
+	auth, err := params.getAuthenticator()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create authenticator %w", err)
+	}
 	svcEndpoint := endpoints.FetchVPCEndpoint(*params.IBMPowerVSCluster.Spec.VPC.Region, params.ServiceEndpoint)
-	return vpc.NewService(svcEndpoint)
+	return vpc.NewServiceWithAuthenticator(svcEndpoint, auth)
 }
 
 func (params PowerVSClusterScopeParams) getTransitGatewayClient(options *tgapiv1.TransitGatewayApisV1Options) (transitgateway.TransitGateway, error) {
@@ -350,6 +550,43 @@ func (params PowerVSClusterScopeParams) getResourceManagerClient(options *resour
 	return resourcemanager.NewService(options)
 }
 
+func (params PowerVSClusterScopeParams) getGlobalTaggingClient(options globaltagging.ServiceOptions) (globaltagging.GlobalTagging, error) {
+	if params.GlobalTaggingFactory != nil {
+		return params.GlobalTaggingFactory()
+	}
+	// Fetch the global tagging endpoint.
+	gtEndpoint := endpoints.FetchEndpoints(string(endpoints.GlobalTagging), params.ServiceEndpoint)
+	if gtEndpoint != "" {
+		options.URL = gtEndpoint
+		params.Logger.V(3).Info("Overriding the default global tagging endpoint", "GlobalTaggingEndpoint", gtEndpoint)
+	}
+	return globaltagging.NewService(options)
+}
+
+func (params PowerVSClusterScopeParams) getGlobalSearchClient(options globalsearch.ServiceOptions) (globalsearch.GlobalSearch, error) {
+	if params.GlobalSearchFactory != nil {
+		return params.GlobalSearchFactory()
+	}
+	// Fetch the global search endpoint.
+	gsEndpoint := endpoints.FetchEndpoints(string(endpoints.GlobalSearch), params.ServiceEndpoint)
+	if gsEndpoint != "" {
+		options.URL = gsEndpoint
+		params.Logger.V(3).Info("Overriding the default global search endpoint", "GlobalSearchEndpoint", gsEndpoint)
+	}
+	return globalsearch.NewService(options)
+}
+
+func (params PowerVSClusterScopeParams) getDNSClient() (dns.DNS, error) {
+	if params.DNSFactory != nil {
+		return params.DNSFactory()
+	}
+	auth, err := params.getAuthenticator()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create authenticator %w", err)
+	}
+	return newDNSClient(params.IBMPowerVSCluster.Spec.DNS, auth, params.ServiceEndpoint)
+}
+
 // PatchObject persists the cluster configuration and status.
 func (s *PowerVSClusterScope) PatchObject() error {
 	return s.patchHelper.Patch(context.TODO(), s.IBMPowerVSCluster)
@@ -357,9 +594,58 @@ func (s *PowerVSClusterScope) PatchObject() error {
 
 // Close closes the current scope persisting the cluster configuration and status.
 func (s *PowerVSClusterScope) Close() error {
+	s.setReadyCondition()
 	return s.PatchObject()
 }
 
+// clusterReadyConditions are the conditions of the components of the cluster summarized in its Ready condition.
+var clusterReadyConditions = []capiv1beta1.ConditionType{
+	infrav1beta2.ServiceInstanceReadyCondition,
+	infrav1beta2.NetworkReadyCondition,
+	infrav1beta2.VPCReadyCondition,
+	infrav1beta2.VPCSubnetReadyCondition,
+	infrav1beta2.VPCSecurityGroupReadyCondition,
+	infrav1beta2.TransitGatewayReadyCondition,
+	infrav1beta2.LoadBalancerReadyCondition,
+	infrav1beta2.ControlPlaneEndpointReadyCondition,
+	infrav1beta2.COSInstanceReadyCondition,
+	infrav1beta2.SSHKeyReadyCondition,
+	infrav1beta2.DNSRecordReadyCondition,
+	infrav1beta2.AddonsReadyCondition,
+}
+
+// setReadyCondition summarizes the conditions of the components of the cluster into its Ready condition,
+// which is false until the infrastructure of the cluster is ready.
+func (s *PowerVSClusterScope) setReadyCondition() {
+	cluster := s.IBMPowerVSCluster
+	if !cluster.DeletionTimestamp.IsZero() {
+		conditions.MarkFalse(cluster, capiv1beta1.ReadyCondition, capiv1beta1.DeletingReason, capiv1beta1.ConditionSeverityInfo, "")
+		return
+	}
+	conditions.SetSummary(cluster, conditions.WithConditions(clusterReadyConditions...))
+	switch {
+	case !cluster.Status.Ready && !conditions.IsFalse(cluster, capiv1beta1.ReadyCondition):
+		conditions.MarkFalse(cluster, capiv1beta1.ReadyCondition, infrav1beta2.ClusterInfrastructureNotReadyReason, capiv1beta1.ConditionSeverityInfo, "")
+	case cluster.Status.Ready && !conditions.Has(cluster, capiv1beta1.ReadyCondition):
+		conditions.MarkTrue(cluster, capiv1beta1.ReadyCondition)
+	}
+}
+
+// SetFailureDomains reports the zone of the Power VS workspace as the failure domain of the cluster, as the workspace
+// is zonal all the machines of the cluster, the control plane machines included, are created in this zone.
+func (s *PowerVSClusterScope) SetFailureDomains() {
+	zone := ptr.Deref(s.Zone(), "")
+	if zone == "" {
+		return
+	}
+	s.IBMPowerVSCluster.Status.FailureDomains = capiv1beta1.FailureDomains{
+		zone: capiv1beta1.FailureDomainSpec{
+			ControlPlane: true,
+			Attributes:   map[string]string{"region": endpoints.ConstructRegionFromZone(zone)},
+		},
+	}
+}
+
 // Name returns the CAPI cluster name.
 func (s *PowerVSClusterScope) Name() string {
 	return s.Cluster.Name
@@ -459,6 +745,12 @@ func (s *PowerVSClusterScope) SetStatus(resourceType infrav1beta2.ResourceType,
 			return
 		}
 		s.IBMPowerVSCluster.Status.COSInstance.Set(resource)
+	case infrav1beta2.ResourceTypeSSHKey:
+		if s.IBMPowerVSCluster.Status.SSHKey == nil {
+			s.IBMPowerVSCluster.Status.SSHKey = &resource
+			return
+		}
+		s.IBMPowerVSCluster.Status.SSHKey.Set(resource)
 	case infrav1beta2.ResourceTypeResourceGroup:
 		if s.IBMPowerVSCluster.Status.ResourceGroup == nil {
 			s.IBMPowerVSCluster.Status.ResourceGroup = &resource
@@ -620,6 +912,17 @@ func (s *PowerVSClusterScope) GetLoadBalancerID(loadBalancerName string) *string
 	return nil
 }
 
+// CheckLoadBalancerMembers checks the members of the pools of the load balancers of the cluster are healthy.
+func (s *PowerVSClusterScope) CheckLoadBalancerMembers() error {
+	loadBalancerIDs := map[string]string{}
+	for name, loadBalancer := range s.IBMPowerVSCluster.Status.LoadBalancers {
+		if loadBalancer.ID != nil {
+			loadBalancerIDs[name] = *loadBalancer.ID
+		}
+	}
+	return checkLoadBalancerMembersHealth(s.IBMVPCClient, loadBalancerIDs)
+}
+
 // GetLoadBalancerState will return the state for the load balancer.
 func (s *PowerVSClusterScope) GetLoadBalancerState(name string) *infrav1beta2.VPCLoadBalancerState {
 	if s.IBMPowerVSCluster.Status.LoadBalancers == nil {
@@ -864,13 +1167,41 @@ func (s *PowerVSClusterScope) createServiceInstance() (*resourcecontrollerv2.Res
 	return serviceInstance, nil
 }
 
+// ReconcileNetworkRef sets the network of the IBMPowerVSNetwork referenced by IBMPowerVSCluster.Spec.NetworkRef as the cluster's network.
+// It returns false when the IBMPowerVSNetwork is not yet ready.
+func (s *PowerVSClusterScope) ReconcileNetworkRef() (bool, error) {
+	network := &infrav1beta2.IBMPowerVSNetwork{}
+	key := client.ObjectKey{
+		Namespace: s.IBMPowerVSCluster.Namespace,
+		Name:      s.IBMPowerVSCluster.Spec.NetworkRef.Name,
+	}
+	if err := s.Client.Get(context.TODO(), key, network); err != nil {
+		return false, fmt.Errorf("failed to get IBMPowerVSNetwork %s: %w", key.Name, err)
+	}
+	if !network.Status.Ready || network.Status.NetworkID == "" {
+		s.V(3).Info("IBMPowerVSNetwork is not yet ready", "IBMPowerVSNetwork", key.Name)
+		return false, nil
+	}
+	// the network is owned by the IBMPowerVSNetwork, hence never deleted along with the cluster.
+	s.IBMPowerVSCluster.Status.Network = &infrav1beta2.ResourceReference{ID: ptr.To(network.Status.NetworkID), ControllerCreated: ptr.To(false)}
+	return true, nil
+}
+
 // ReconcileNetwork reconciles network
+// If IBMPowerVSCluster.Spec.NetworkRef is set, the network of the referenced IBMPowerVSNetwork is used as cluster's network.
 // If only IBMPowerVSCluster.Spec.Network is set, network would be validated and if exists already will get used as cluster’s network or a new network will be created via DHCP service.
 // If only IBMPowerVSCluster.Spec.DHCPServer is set, DHCP server would be validated and if exists already, will use DHCP server’s network as cluster network. If not a new DHCP service will be created and it’s network will be used.
 // If both IBMPowerVSCluster.Spec.Network & IBMPowerVSCluster.Spec.DHCPServer is set, network and DHCP server would be validated and if both exists already then network is belongs to given DHCP server or not would be validated.
 // If both IBMPowerVSCluster.Spec.Network & IBMPowerVSCluster.Spec.DHCPServer is not set, by default DHCP service will be created to setup cluster's network.
 func (s *PowerVSClusterScope) ReconcileNetwork() (bool, error) {
+	if s.IBMPowerVSCluster.Spec.NetworkRef != nil {
+		return s.ReconcileNetworkRef()
+	}
 	if s.GetNetworkID() != nil {
+		if s.GetDHCPServerID() != nil && s.isResourceCreatedByController(infrav1beta2.ResourceTypeDHCPServer) {
+			return s.reconcileDHCPServerSettings()
+		}
+
 		// Check the network exists
 		if _, err := s.IBMPowerVSClient.GetNetworkByID(*s.GetNetworkID()); err != nil {
 			return false, err
@@ -1018,6 +1349,70 @@ func (s *PowerVSClusterScope) isDHCPServerActive() (bool, error) {
 	return active, nil
 }
 
+// reconcileDHCPServerSettings applies the changes of DNSServer and Cidr to the DHCP server created by the controller.
+// The DNS server is updated in place on the network of the DHCP server. The Cidr can only be changed by recreating the DHCP server
+// along with its network, which is done when the update policy is set to recreate and no instance holds a lease of the DHCP server.
+// Once the DHCP server is deleted, its status is cleared so it is created again with the current settings.
+func (s *PowerVSClusterScope) reconcileDHCPServerSettings() (bool, error) {
+	dhcpServer, err := s.IBMPowerVSClient.GetDHCPServer(*s.GetDHCPServerID())
+	if err != nil {
+		if strings.Contains(err.Error(), string(DHCPServerNotFound)) {
+			s.Info("DHCP server not found, it will be created again", "dhcpServerID", *s.GetDHCPServerID())
+			s.IBMPowerVSCluster.Status.DHCPServer = nil
+			s.IBMPowerVSCluster.Status.Network = nil
+			return false, nil
+		}
+		return false, err
+	}
+	if active, err := s.checkDHCPServerStatus(*dhcpServer); err != nil || !active {
+		return false, err
+	}
+
+	network, err := s.IBMPowerVSClient.GetNetworkByID(*s.GetNetworkID())
+	if err != nil {
+		return false, err
+	}
+	dhcpServerDetails := s.DHCPServer()
+	if dhcpServerDetails == nil {
+		return true, nil
+	}
+
+	if dnsServer := dhcpServerDetails.DNSServer; dnsServer != nil && !reflect.DeepEqual(network.DNSServers, []string{*dnsServer}) {
+		s.Info("Updating DNS server of DHCP server network", "networkID", *s.GetNetworkID(), "dnsServer", *dnsServer)
+		if _, err := s.IBMPowerVSClient.UpdateNetwork(*s.GetNetworkID(), &models.NetworkUpdate{
+			DNSServers:      []string{*dnsServer},
+			IPAddressRanges: network.IPAddressRanges,
+		}); err != nil {
+			record.Warnf(s.IBMPowerVSCluster, "FailedUpdateDHCPServerNetwork", "Failed to update DNS server of DHCP server network - %v", err)
+			return false, fmt.Errorf("failed to update DNS server of DHCP server network: %w", err)
+		}
+		record.Eventf(s.IBMPowerVSCluster, "SuccessfulUpdateDHCPServerNetwork", "Updated DNS server of DHCP server network to %q", *dnsServer)
+	}
+
+	cidr := dhcpServerDetails.Cidr
+	if cidr == nil || network.Cidr == nil || *cidr == *network.Cidr {
+		return true, nil
+	}
+	if dhcpServerDetails.UpdatePolicy != string(infrav1beta2.DHCPServerUpdatePolicyRecreate) {
+		s.Info("Skipping CIDR change of DHCP server network as the DHCP server has to be recreated", "currentCIDR", *network.Cidr, "cidr", *cidr)
+		record.Warnf(s.IBMPowerVSCluster, "DHCPServerRecreateRequired", "CIDR of DHCP server network cannot be changed from %q to %q in place, set updatePolicy to recreate to apply it", *network.Cidr, *cidr)
+		return true, nil
+	}
+	if len(dhcpServer.Leases) != 0 {
+		s.Info("Waiting for the DHCP server leases to be released before recreating it", "leases", len(dhcpServer.Leases))
+		record.Warnf(s.IBMPowerVSCluster, "DHCPServerRecreatePending", "DHCP server will be recreated to change the CIDR to %q once its %d leases are released", *cidr, len(dhcpServer.Leases))
+		return true, nil
+	}
+
+	s.Info("Deleting DHCP server to recreate it with the changed CIDR", "dhcpServerID", *dhcpServer.ID, "cidr", *cidr)
+	if err := s.IBMPowerVSClient.DeleteDHCPServer(*dhcpServer.ID); err != nil {
+		record.Warnf(s.IBMPowerVSCluster, "FailedDeleteDHCPServer", "Failed to delete DHCP server to recreate it - %v", err)
+		return false, fmt.Errorf("failed to delete DHCP server: %w", err)
+	}
+	record.Eventf(s.IBMPowerVSCluster, "SuccessfulDeleteDHCPServer", "Deleted DHCP server %q to recreate it with CIDR %q", *dhcpServer.ID, *cidr)
+	return false, nil
+}
+
 // checkDHCPServerStatus checks the state of a DHCP server.
 // If state is active, true is returned.
 // In all other cases, it returns false.
@@ -1069,6 +1464,7 @@ func (s *PowerVSClusterScope) createDHCPServer() (*string, error) {
 
 	s.Info("DHCP Server network details", "details", *dhcpServer.Network)
 	s.SetStatus(infrav1beta2.ResourceTypeNetwork, infrav1beta2.ResourceReference{ID: dhcpServer.Network.ID, ControllerCreated: ptr.To(true)})
+	s.tagNetwork(dhcpServer.Network.ID)
 	return dhcpServer.ID, nil
 }
 
@@ -1203,7 +1599,7 @@ func (s *PowerVSClusterScope) ReconcileVPCSubnets() (bool, error) {
 		// if the user did not set any subnet, we try to create subnet in all the zones.
 		for _, zone := range vpcZones {
 			subnet := infrav1beta2.Subnet{
-				Name: ptr.To(fmt.Sprintf("%s-%s", *s.GetServiceName(infrav1beta2.ResourceTypeSubnet), zone)),
+				Name: ptr.To(names.Normalize(fmt.Sprintf("%s-%s", *s.GetServiceName(infrav1beta2.ResourceTypeSubnet), zone), names.VPCMaxLength)),
 				Zone: ptr.To(zone),
 			}
 			subnets = append(subnets, subnet)
@@ -1219,7 +1615,7 @@ func (s *PowerVSClusterScope) ReconcileVPCSubnets() (bool, error) {
 			subnetID = subnet.ID
 		} else {
 			if subnet.Name == nil {
-				subnet.Name = ptr.To(fmt.Sprintf("%s-%d", *s.GetServiceName(infrav1beta2.ResourceTypeSubnet), index))
+				subnet.Name = ptr.To(names.Normalize(fmt.Sprintf("%s-%d", *s.GetServiceName(infrav1beta2.ResourceTypeSubnet), index), names.VPCMaxLength))
 			}
 			subnetID = s.GetVPCSubnetID(*subnet.Name)
 		}
@@ -1272,6 +1668,41 @@ func (s *PowerVSClusterScope) ReconcileVPCSubnets() (bool, error) {
 	return false, nil
 }
 
+// ReconcileExistingVPCSubnets sets the status of the existing VPC subnets set via VPCSubnets.
+// unlike ReconcileVPCSubnets, the subnets are never created and an error is returned when a subnet does not exist.
+func (s *PowerVSClusterScope) ReconcileExistingVPCSubnets() error {
+	if len(s.IBMPowerVSCluster.Spec.VPCSubnets) == 0 {
+		return fmt.Errorf("VPC subnets are not set")
+	}
+	for _, subnet := range s.IBMPowerVSCluster.Spec.VPCSubnets {
+		if subnet.ID != nil {
+			subnetDetails, _, err := s.IBMVPCClient.GetSubnet(&vpcv1.GetSubnetOptions{
+				ID: subnet.ID,
+			})
+			if err != nil {
+				return err
+			}
+			if subnetDetails == nil {
+				return fmt.Errorf("failed to get VPC subnet with ID %s", *subnet.ID)
+			}
+			s.SetVPCSubnetStatus(*subnetDetails.Name, infrav1beta2.ResourceReference{ID: subnetDetails.ID, ControllerCreated: ptr.To(false)})
+			continue
+		}
+		if subnet.Name == nil {
+			return fmt.Errorf("either ID or name of the VPC subnet must be set")
+		}
+		vpcSubnetID, err := s.checkVPCSubnet(*subnet.Name)
+		if err != nil {
+			return err
+		}
+		if vpcSubnetID == "" {
+			return fmt.Errorf("failed to find VPC subnet with name %s", *subnet.Name)
+		}
+		s.SetVPCSubnetStatus(*subnet.Name, infrav1beta2.ResourceReference{ID: &vpcSubnetID, ControllerCreated: ptr.To(false)})
+	}
+	return nil
+}
+
 // checkVPCSubnet checks if VPC subnet by the given name exists in cloud.
 func (s *PowerVSClusterScope) checkVPCSubnet(subnetName string) (string, error) {
 	vpcSubnet, err := s.IBMVPCClient.GetVPCSubnetByName(subnetName)
@@ -1325,6 +1756,7 @@ func (s *PowerVSClusterScope) createVPCSubnet(subnet infrav1beta2.Subnet) (*stri
 	if subnetDetails == nil {
 		return nil, fmt.Errorf("create VPC subnet is nil")
 	}
+	s.tagResource(subnetDetails.CRN)
 	return subnetDetails.ID, nil
 }
 
@@ -1720,8 +2152,41 @@ func (s *PowerVSClusterScope) validateVPCSecurityGroup(securityGroup infrav1beta
 	return securityGroupDet, ruleIDs, nil
 }
 
+// ReconcileTransitGatewayRef attaches the Power VS workspace and the VPC of the cluster to the transit gateway of the IBMTransitGateway
+// referenced by IBMPowerVSCluster.Spec.TransitGatewayRef. It returns true when the IBMTransitGateway is not yet ready or the connections
+// are pending and requires requeue.
+func (s *PowerVSClusterScope) ReconcileTransitGatewayRef() (bool, error) {
+	transitGateway := &infrav1beta2.IBMTransitGateway{}
+	key := client.ObjectKey{
+		Namespace: s.IBMPowerVSCluster.Namespace,
+		Name:      s.IBMPowerVSCluster.Spec.TransitGatewayRef.Name,
+	}
+	if err := s.Client.Get(context.TODO(), key, transitGateway); err != nil {
+		return false, fmt.Errorf("failed to get IBMTransitGateway %s: %w", key.Name, err)
+	}
+	if !transitGateway.Status.Ready || transitGateway.Status.TransitGatewayID == "" {
+		s.V(3).Info("IBMTransitGateway is not yet ready", "IBMTransitGateway", key.Name)
+		return true, nil
+	}
+	// the transit gateway is owned by the IBMTransitGateway, hence only the connections of the cluster are deleted along with the cluster.
+	if s.GetTransitGatewayID() == nil || *s.GetTransitGatewayID() != transitGateway.Status.TransitGatewayID {
+		s.SetTransitGatewayStatus(ptr.To(transitGateway.Status.TransitGatewayID), ptr.To(false))
+	}
+	tg, _, err := s.TransitGatewayClient.GetTransitGateway(&tgapiv1.GetTransitGatewayOptions{
+		ID: s.GetTransitGatewayID(),
+	})
+	if err != nil {
+		return false, err
+	}
+	return s.checkAndUpdateTransitGateway(tg)
+}
+
 // ReconcileTransitGateway reconcile transit gateway.
+// If IBMPowerVSCluster.Spec.TransitGatewayRef is set, the cluster is attached to the transit gateway of the referenced IBMTransitGateway.
 func (s *PowerVSClusterScope) ReconcileTransitGateway() (bool, error) {
+	if s.IBMPowerVSCluster.Spec.TransitGatewayRef != nil {
+		return s.ReconcileTransitGatewayRef()
+	}
 	if s.GetTransitGatewayID() != nil {
 		s.V(3).Info("Transit gateway ID is set, fetching details", "tgID", s.GetTransitGatewayID())
 		tg, _, err := s.TransitGatewayClient.GetTransitGateway(&tgapiv1.GetTransitGatewayOptions{
@@ -1755,7 +2220,7 @@ func (s *PowerVSClusterScope) ReconcileTransitGateway() (bool, error) {
 	// create transit gateway
 	s.V(3).Info("Creating transit gateway")
 	if err := s.createTransitGateway(); err != nil {
-		return false, fmt.Errorf("failed to create transit gateway: %v", err)
+		return false, fmt.Errorf("failed to create transit gateway: %w", err)
 	}
 
 	return true, nil
@@ -1865,14 +2330,14 @@ func (s *PowerVSClusterScope) checkAndUpdateTransitGatewayConnections(transitGat
 	// update the connections when connection not exist
 	if !powerVSConnStatus {
 		s.V(3).Info("Only PowerVS connection not exist in transit gateway, creating it")
-		if err := s.createTransitGatewayConnection(transitGateway.ID, ptr.To(getTGPowerVSConnectionName(*transitGateway.Name)), pvsServiceInstanceCRN, powervsNetworkConnectionType); err != nil {
+		if err := s.createTransitGatewayConnection(transitGateway.ID, ptr.To(getTGPowerVSConnectionName(s.transitGatewayConnectionPrefix(transitGateway))), pvsServiceInstanceCRN, powervsNetworkConnectionType); err != nil {
 			return false, err
 		}
 	}
 
 	if !vpcConnStatus {
 		s.V(3).Info("Only VPC connection not exist in transit gateway, creating it")
-		if err := s.createTransitGatewayConnection(transitGateway.ID, ptr.To(getTGVPCConnectionName(*transitGateway.Name)), vpcCRN, vpcNetworkConnectionType); err != nil {
+		if err := s.createTransitGatewayConnection(transitGateway.ID, ptr.To(getTGVPCConnectionName(s.transitGatewayConnectionPrefix(transitGateway))), vpcCRN, vpcNetworkConnectionType); err != nil {
 			return false, err
 		}
 	}
@@ -1931,6 +2396,55 @@ func (s *PowerVSClusterScope) checkTransitGatewayConnectionStatus(con tgapiv1.Tr
 	return false, nil
 }
 
+// CheckTransitGatewayRoutes checks the transit gateway of the cluster is available and the connections of the
+// Power VS workspace and of the VPC are attached to it, the routes between them are exchanged through these connections.
+func (s *PowerVSClusterScope) CheckTransitGatewayRoutes() error {
+	transitGatewayID := s.GetTransitGatewayID()
+	if transitGatewayID == nil {
+		return fmt.Errorf("transit gateway is not set in the status of the cluster")
+	}
+	transitGateway, _, err := s.TransitGatewayClient.GetTransitGateway(&tgapiv1.GetTransitGatewayOptions{
+		ID: transitGatewayID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get transit gateway %s: %w", *transitGatewayID, err)
+	}
+	if status := ptr.Deref(transitGateway.Status, ""); status != string(infrav1beta2.TransitGatewayStateAvailable) {
+		return fmt.Errorf("transit gateway %s is not available, current status: %s", *transitGatewayID, status)
+	}
+
+	tgConnections, _, err := s.TransitGatewayClient.ListTransitGatewayConnections(&tgapiv1.ListTransitGatewayConnectionsOptions{
+		TransitGatewayID: transitGatewayID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list transit gateway connections: %w", err)
+	}
+
+	status := s.IBMPowerVSCluster.Status.TransitGateway
+	for _, expected := range []struct {
+		networkType networkConnectionType
+		connection  *infrav1beta2.ResourceReference
+	}{{powervsNetworkConnectionType, status.PowerVSConnection}, {vpcNetworkConnectionType, status.VPCConnection}} {
+		var attached bool
+		for _, conn := range tgConnections.Connections {
+			if ptr.Deref(conn.NetworkType, "") != string(expected.networkType) {
+				continue
+			}
+			if expected.connection != nil && expected.connection.ID != nil && ptr.Deref(conn.ID, "") != *expected.connection.ID {
+				continue
+			}
+			if ptr.Deref(conn.Status, "") == string(infrav1beta2.TransitGatewayConnectionStateAttached) {
+				attached = true
+				break
+			}
+		}
+		if !attached {
+			return fmt.Errorf("no %s connection is attached to transit gateway %s", expected.networkType, *transitGatewayID)
+		}
+	}
+	return nil
+}
+
 // createTransitGatewayConnection creates transit gateway connection and sets the connection status.
 func (s *PowerVSClusterScope) createTransitGatewayConnection(transitGatewayID, connName, networkID *string, networkType networkConnectionType) error {
 	s.V(3).Info("Creating transit gateway connection", "tgID", transitGatewayID, "type", networkType, "name", connName)
@@ -1948,13 +2462,22 @@ func (s *PowerVSClusterScope) createTransitGatewayConnection(transitGatewayID, c
 	return nil
 }
 
-// createTransitGatewayConnections creates PowerVS and VPC connections in the transit gateway.
+// transitGatewayConnectionPrefix returns the prefix of the names of the connections created for the cluster in the transit gateway.
+// the transit gateway of an IBMTransitGateway is shared across clusters, hence the connections are named after the cluster.
+func (s *PowerVSClusterScope) transitGatewayConnectionPrefix(tg *tgapiv1.TransitGateway) string {
+	if s.IBMPowerVSCluster.Spec.TransitGatewayRef != nil {
+		return *s.GetServiceName(infrav1beta2.ResourceTypeTransitGateway)
+	}
+	return *tg.Name
+}
+
+// createTransitGatewayConnections creates PowerVS and VPC connections in the transit gateway.
 func (s *PowerVSClusterScope) createTransitGatewayConnections(tg *tgapiv1.TransitGateway, pvsServiceInstanceCRN, vpcCRN *string) error {
-	if err := s.createTransitGatewayConnection(tg.ID, ptr.To(getTGPowerVSConnectionName(*tg.Name)), pvsServiceInstanceCRN, powervsNetworkConnectionType); err != nil {
+	if err := s.createTransitGatewayConnection(tg.ID, ptr.To(getTGPowerVSConnectionName(s.transitGatewayConnectionPrefix(tg))), pvsServiceInstanceCRN, powervsNetworkConnectionType); err != nil {
 		return fmt.Errorf("failed to create PowerVS connection in transit gateway: %w", err)
 	}
 
-	if err := s.createTransitGatewayConnection(tg.ID, ptr.To(getTGVPCConnectionName(*tg.Name)), vpcCRN, vpcNetworkConnectionType); err != nil {
+	if err := s.createTransitGatewayConnection(tg.ID, ptr.To(getTGVPCConnectionName(s.transitGatewayConnectionPrefix(tg))), vpcCRN, vpcNetworkConnectionType); err != nil {
 		return fmt.Errorf("failed to create VPC connection in transit gateway: %w", err)
 	}
 
@@ -1972,7 +2495,7 @@ func (s *PowerVSClusterScope) createTransitGateway() error {
 		return fmt.Errorf("failed to fetch resource group ID for resource group %v, ID is empty", s.ResourceGroup())
 	}
 
-	if s.IBMPowerVSCluster.Status.ServiceInstance == nil || s.IBMPowerVSCluster.Status.VPC == nil {
+	if !CheckManageTransitGateway(*s.IBMPowerVSCluster) && (s.IBMPowerVSCluster.Status.ServiceInstance == nil || s.IBMPowerVSCluster.Status.VPC == nil) {
 		return fmt.Errorf("failed to proeceed with transit gateway creation as either one of VPC or PowerVS service instance reconciliation is not successful")
 	}
 
@@ -2041,31 +2564,39 @@ func (s *PowerVSClusterScope) ReconcileLoadBalancers() (bool, error) {
 			loadBalancerID = loadBalancer.ID
 		} else {
 			if loadBalancer.Name == "" {
-				loadBalancer.Name = fmt.Sprintf("%s-%d", *s.GetServiceName(infrav1beta2.ResourceTypeLoadBalancer), index)
+				loadBalancer.Name = names.Normalize(fmt.Sprintf("%s-%d", *s.GetServiceName(infrav1beta2.ResourceTypeLoadBalancer), index), names.VPCMaxLength)
 			}
 			loadBalancerID = s.GetLoadBalancerID(loadBalancer.Name)
 		}
 		if loadBalancerID != nil {
 			s.V(3).Info("LoadBalancer ID is set, fetching loadbalancer details", "loadbalancerid", *loadBalancerID)
-			loadBalancer, _, err := s.IBMVPCClient.GetLoadBalancer(&vpcv1.GetLoadBalancerOptions{
+			lb, resp, err := s.IBMVPCClient.GetLoadBalancer(&vpcv1.GetLoadBalancerOptions{
 				ID: loadBalancerID,
 			})
 			if err != nil {
-				return false, err
-			}
-
-			if isReady := s.checkLoadBalancerStatus(*loadBalancer); !isReady {
-				s.V(3).Info("LoadBalancer is still not Active", "name", *loadBalancer.Name, "state", *loadBalancer.ProvisioningStatus)
-				isAnyLoadBalancerNotReady = true
-			}
+				if loadBalancer.ID != nil || resp == nil || resp.StatusCode != ResourceNotFoundCode || !s.isControllerCreatedLoadBalancer(loadBalancer.Name) {
+					return false, err
+				}
+				// The load balancer created by the controller was deleted out-of-band, it is created again with the same name
+				// and the control plane machines register themselves as members of its pools once it is active.
+				s.Info("VPC load balancer not found, it will be created again", "name", loadBalancer.Name, "loadBalancerID", *loadBalancerID)
+				record.Warnf(s.IBMPowerVSCluster, "LoadBalancerDeleted", "VPC load balancer %q was deleted out-of-band, recreating it", loadBalancer.Name)
+				conditions.MarkFalse(s.IBMPowerVSCluster, infrav1beta2.ControlPlaneEndpointReadyCondition, infrav1beta2.LoadBalancerRecreatingReason, capiv1beta1.ConditionSeverityWarning, "load balancer %s was deleted out-of-band, recreating it", loadBalancer.Name)
+				delete(s.IBMPowerVSCluster.Status.LoadBalancers, loadBalancer.Name)
+			} else {
+				if isReady := s.checkLoadBalancerStatus(*lb); !isReady {
+					s.V(3).Info("LoadBalancer is still not Active", "name", *lb.Name, "state", *lb.ProvisioningStatus)
+					isAnyLoadBalancerNotReady = true
+				}
 
-			loadBalancerStatus := infrav1beta2.VPCLoadBalancerStatus{
-				ID:       loadBalancer.ID,
-				State:    infrav1beta2.VPCLoadBalancerState(*loadBalancer.ProvisioningStatus),
-				Hostname: loadBalancer.Hostname,
+				loadBalancerStatus := infrav1beta2.VPCLoadBalancerStatus{
+					ID:       lb.ID,
+					State:    infrav1beta2.VPCLoadBalancerState(*lb.ProvisioningStatus),
+					Hostname: lb.Hostname,
+				}
+				s.SetLoadBalancerStatus(*lb.Name, loadBalancerStatus)
+				continue
 			}
-			s.SetLoadBalancerStatus(*loadBalancer.Name, loadBalancerStatus)
-			continue
 		}
 
 		// check VPC load balancer exist in cloud
@@ -2101,6 +2632,12 @@ func (s *PowerVSClusterScope) ReconcileLoadBalancers() (bool, error) {
 	return true, nil
 }
 
+// isControllerCreatedLoadBalancer returns true when the VPC load balancer with the given name was created by the controller.
+func (s *PowerVSClusterScope) isControllerCreatedLoadBalancer(name string) bool {
+	val, ok := s.IBMPowerVSCluster.Status.LoadBalancers[name]
+	return ok && val.ControllerCreated != nil && *val.ControllerCreated
+}
+
 // checkLoadBalancerStatus checks the state of a VPC load balancer.
 // If state is active, true is returned, in all other cases, it returns false indicating that load balancer is still not ready.
 func (s *PowerVSClusterScope) checkLoadBalancerStatus(lb vpcv1.LoadBalancer) bool {
@@ -2178,7 +2715,7 @@ func (s *PowerVSClusterScope) createLoadBalancer(lb infrav1beta2.VPCLoadBalancer
 			Algorithm:     core.StringPtr("round_robin"),
 			HealthMonitor: &vpcv1.LoadBalancerPoolHealthMonitorPrototype{Delay: core.Int64Ptr(5), MaxRetries: core.Int64Ptr(2), Timeout: core.Int64Ptr(2), Type: core.StringPtr("tcp")},
 			// Note: Appending port number to the name, it will be referenced to set target port while adding new pool member
-			Name:     core.StringPtr(fmt.Sprintf("%s-pool-%d", lb.Name, s.APIServerPort())),
+			Name:     core.StringPtr(loadBalancerPoolName(lb.Name, s.APIServerPort())),
 			Protocol: core.StringPtr("tcp"),
 		},
 	})
@@ -2188,7 +2725,7 @@ func (s *PowerVSClusterScope) createLoadBalancer(lb infrav1beta2.VPCLoadBalancer
 			Protocol: core.StringPtr("tcp"),
 			Port:     core.Int64Ptr(int64(s.APIServerPort())),
 			DefaultPool: &vpcv1.LoadBalancerPoolIdentityByName{
-				Name: core.StringPtr(fmt.Sprintf("%s-pool-%d", lb.Name, s.APIServerPort())),
+				Name: core.StringPtr(loadBalancerPoolName(lb.Name, s.APIServerPort())),
 			},
 		},
 	})
@@ -2217,6 +2754,7 @@ func (s *PowerVSClusterScope) createLoadBalancer(lb infrav1beta2.VPCLoadBalancer
 	if err != nil {
 		return nil, err
 	}
+	s.tagResource(loadBalancer.CRN)
 	lbState := infrav1beta2.VPCLoadBalancerState(*loadBalancer.ProvisioningStatus)
 	return &infrav1beta2.VPCLoadBalancerStatus{
 		ID:                loadBalancer.ID,
@@ -2253,14 +2791,17 @@ func (s *PowerVSClusterScope) ReconcileCOSInstance() error {
 		s.SetStatus(infrav1beta2.ResourceTypeCOSInstance, infrav1beta2.ResourceReference{ID: cosServiceInstanceStatus.GUID, ControllerCreated: ptr.To(true)})
 	}
 
-	props, err := authenticator.GetProperties()
+	apiKey, err := getAPIKey(s.Client, s.IBMPowerVSCluster.Namespace, s.IBMPowerVSCluster.Spec.CredentialsSecretRef, s.IBMPowerVSCluster.Spec.EnterpriseAccount)
 	if err != nil {
-		s.Error(err, "failed to fetch service properties")
+		s.Error(err, "failed to fetch the API key")
 		return err
 	}
-
-	apiKey, ok := props["APIKEY"]
-	if !ok {
+	trustedProfileAuth, err := getTrustedProfileAuthenticator(s.Client, s.IBMPowerVSCluster.Namespace, s.IBMPowerVSCluster.Spec.CredentialsSecretRef, s.IBMPowerVSCluster.Spec.EnterpriseAccount)
+	if err != nil {
+		s.Error(err, "failed to fetch the trusted profile authenticator")
+		return err
+	}
+	if apiKey == "" && trustedProfileAuth == nil {
 		return fmt.Errorf("IBM Cloud API key is not provided, set %s environmental variable", "IBMCLOUD_API_KEY")
 	}
 
@@ -2271,7 +2812,7 @@ func (s *PowerVSClusterScope) ReconcileCOSInstance() error {
 
 	serviceEndpoint := fmt.Sprintf("s3.%s.%s", region, cosURLDomain)
 	// Fetch the COS service endpoint.
-	cosServiceEndpoint := endpoints.FetchEndpoints(string(endpoints.COS), s.ServiceEndpoint)
+	cosServiceEndpoint := endpoints.FetchRegionalEndpoint(string(endpoints.COS), region, s.ServiceEndpoint)
 	if cosServiceEndpoint != "" {
 		s.Logger.V(3).Info("Overriding the default COS endpoint", "cosEndpoint", cosServiceEndpoint)
 		serviceEndpoint = cosServiceEndpoint
@@ -2286,7 +2827,12 @@ func (s *PowerVSClusterScope) ReconcileCOSInstance() error {
 		},
 	}
 
-	cosClient, err := cos.NewService(cosOptions, apiKey, *cosServiceInstanceStatus.GUID)
+	var cosClient *cos.Service
+	if trustedProfileAuth != nil {
+		cosClient, err = cos.NewServiceWithAuthenticator(cosOptions, trustedProfileAuth, *cosServiceInstanceStatus.GUID)
+	} else {
+		cosClient, err = cos.NewService(cosOptions, apiKey, *cosServiceInstanceStatus.GUID)
+	}
 	if err != nil {
 		return fmt.Errorf("failed to create COS client: %w", err)
 	}
@@ -2387,18 +2933,84 @@ func (s *PowerVSClusterScope) createCOSServiceInstance() (*resourcecontrollerv2.
 	return serviceInstance, nil
 }
 
+// SSHKey returns the SSH key of the cluster.
+func (s *PowerVSClusterScope) SSHKey() *infrav1beta2.PowerVSSSHKey {
+	return s.IBMPowerVSCluster.Spec.SSHKey
+}
+
+// ReconcileSSHKey registers the SSH key of the cluster in the Power VS tenant,
+// the key is created when it does not exist and the public key is set.
+// When the SSH key of the spec is changed or removed, the previous key is deleted if it was created by the controller.
+func (s *PowerVSClusterScope) ReconcileSSHKey() error {
+	var name *string
+	if s.SSHKey() != nil {
+		name = s.GetServiceName(infrav1beta2.ResourceTypeSSHKey)
+	}
+	if status := s.IBMPowerVSCluster.Status.SSHKey; status != nil && status.ID != nil && (name == nil || *status.ID != *name) {
+		s.Info("SSH key of the cluster changed, releasing the previous key", "name", *status.ID)
+		if err := s.DeleteSSHKey(); err != nil {
+			return err
+		}
+		s.IBMPowerVSCluster.Status.SSHKey = nil
+	}
+	if name == nil {
+		return nil
+	}
+	key, err := s.IBMPowerVSClient.GetSSHKey(*name)
+	if err == nil {
+		if s.SSHKey().PublicKey != nil && ptr.Deref(key.SSHKey, "") != *s.SSHKey().PublicKey {
+			s.Info("SSH key found in Power VS tenant with a different public key, the key is not updated", "name", *name)
+		}
+		s.V(3).Info("SSH key found in Power VS tenant", "name", *name)
+		s.SetStatus(infrav1beta2.ResourceTypeSSHKey, infrav1beta2.ResourceReference{ID: name, ControllerCreated: ptr.To(false)})
+		return nil
+	}
+	var notFound *p_cloud_tenants_ssh_keys.PcloudTenantsSshkeysGetNotFound
+	if !errors.As(err, &notFound) {
+		return fmt.Errorf("failed to get SSH key %s: %w", *name, err)
+	}
+	if s.SSHKey().PublicKey == nil {
+		return fmt.Errorf("SSH key %s not found in Power VS tenant and publicKey is not set", *name)
+	}
+
+	s.V(3).Info("Creating SSH key", "name", *name)
+	if _, err = s.IBMPowerVSClient.CreateSSHKey(&models.SSHKey{
+		Name:   name,
+		SSHKey: s.SSHKey().PublicKey,
+	}); err != nil {
+		return fmt.Errorf("failed to create SSH key %s: %w", *name, err)
+	}
+	s.Info("Created SSH key", "name", *name)
+	s.SetStatus(infrav1beta2.ResourceTypeSSHKey, infrav1beta2.ResourceReference{ID: name, ControllerCreated: ptr.To(true)})
+	return nil
+}
+
+// DNS returns the DNS record registering the control plane endpoint of the cluster.
+func (s *PowerVSClusterScope) DNS() *infrav1beta2.DNSSpec {
+	return s.IBMPowerVSCluster.Spec.DNS
+}
+
+// ReconcileDNSRecord registers the control plane endpoint in the DNS zone, the record resolving to the hostname of the public load balancer.
+func (s *PowerVSClusterScope) ReconcileDNSRecord(loadBalancerHostName string) error {
+	if s.DNS() == nil {
+		return nil
+	}
+	status, err := reconcileDNSRecord(s.DNSClient, s.DNS(), s.IBMPowerVSCluster.Status.DNSRecord, loadBalancerHostName)
+	if err != nil {
+		return fmt.Errorf("failed to reconcile DNS record %s: %w", s.DNS().Hostname, err)
+	}
+	s.V(3).Info("DNS record reconciled", "hostname", s.DNS().Hostname, "id", *status.ID)
+	s.IBMPowerVSCluster.Status.DNSRecord = status
+	return nil
+}
+
 // fetchResourceGroupID retrieving id of resource group.
 func (s *PowerVSClusterScope) fetchResourceGroupID() (string, error) {
 	if s.ResourceGroup() == nil || s.ResourceGroup().Name == nil {
 		return "", fmt.Errorf("resource group name is not set")
 	}
 
-	auth, err := authenticator.GetAuthenticator()
-	if err != nil {
-		return "", err
-	}
-
-	account, err := utils.GetAccount(auth)
+	account, err := getAccountID(s.Client, s.IBMPowerVSCluster.Namespace, s.IBMPowerVSCluster.Spec.CredentialsSecretRef, s.IBMPowerVSCluster.Spec.EnterpriseAccount)
 	if err != nil {
 		return "", err
 	}
@@ -2473,12 +3085,12 @@ func (s *PowerVSClusterScope) GetServiceName(resourceType infrav1beta2.ResourceT
 		return s.Network().Name
 	case infrav1beta2.ResourceTypeVPC:
 		if s.VPC() == nil || s.VPC().Name == nil {
-			return ptr.To(fmt.Sprintf("%s-vpc", s.InfraCluster()))
+			return ptr.To(names.Normalize(fmt.Sprintf("%s-vpc", s.InfraCluster()), names.VPCMaxLength))
 		}
 		return s.VPC().Name
 	case infrav1beta2.ResourceTypeTransitGateway:
 		if s.TransitGateway() == nil || s.TransitGateway().Name == nil {
-			return ptr.To(fmt.Sprintf("%s-transitgateway", s.InfraCluster()))
+			return ptr.To(names.Normalize(fmt.Sprintf("%s-transitgateway", s.InfraCluster()), names.VPCMaxLength))
 		}
 		return s.TransitGateway().Name
 	case infrav1beta2.ResourceTypeDHCPServer:
@@ -2493,13 +3105,18 @@ func (s *PowerVSClusterScope) GetServiceName(resourceType infrav1beta2.ResourceT
 		return &s.COSInstance().Name
 	case infrav1beta2.ResourceTypeCOSBucket:
 		if s.COSInstance() == nil || s.COSInstance().BucketName == "" {
-			return ptr.To(fmt.Sprintf("%s-cosbucket", s.InfraCluster()))
+			return ptr.To(names.Normalize(fmt.Sprintf("%s-cosbucket", s.InfraCluster()), names.VPCMaxLength))
 		}
 		return &s.COSInstance().BucketName
+	case infrav1beta2.ResourceTypeSSHKey:
+		if s.SSHKey() == nil || s.SSHKey().Name == nil {
+			return ptr.To(fmt.Sprintf("%s-ssh-key", s.InfraCluster()))
+		}
+		return s.SSHKey().Name
 	case infrav1beta2.ResourceTypeSubnet:
-		return ptr.To(fmt.Sprintf("%s-vpcsubnet", s.InfraCluster()))
+		return ptr.To(names.Normalize(fmt.Sprintf("%s-vpcsubnet", s.InfraCluster()), names.VPCMaxLength))
 	case infrav1beta2.ResourceTypeLoadBalancer:
-		return ptr.To(fmt.Sprintf("%s-loadbalancer", s.InfraCluster()))
+		return ptr.To(names.Normalize(fmt.Sprintf("%s-loadbalancer", s.InfraCluster()), names.VPCMaxLength))
 	}
 	return nil
 }
@@ -2726,7 +3343,7 @@ func (s *PowerVSClusterScope) deleteTransitGatewayConnections(tg *tgapiv1.Transi
 
 		return true, nil
 	}
-	if *s.IBMPowerVSCluster.Status.TransitGateway.PowerVSConnection.ControllerCreated {
+	if conn := s.IBMPowerVSCluster.Status.TransitGateway.PowerVSConnection; conn != nil && conn.ControllerCreated != nil && *conn.ControllerCreated {
 		s.V(3).Info("Deleting PowerVS connection in Transit gateway")
 		requeue, err := deleteConnection(s.IBMPowerVSCluster.Status.TransitGateway.PowerVSConnection.ID)
 		if err != nil {
@@ -2737,7 +3354,7 @@ func (s *PowerVSClusterScope) deleteTransitGatewayConnections(tg *tgapiv1.Transi
 		}
 	}
 
-	if *s.IBMPowerVSCluster.Status.TransitGateway.VPCConnection.ControllerCreated {
+	if conn := s.IBMPowerVSCluster.Status.TransitGateway.VPCConnection; conn != nil && conn.ControllerCreated != nil && *conn.ControllerCreated {
 		s.V(3).Info("Deleting VPC connection in Transit gateway")
 		requeue, err := deleteConnection(s.IBMPowerVSCluster.Status.TransitGateway.VPCConnection.ID)
 		if err != nil {
@@ -2751,6 +3368,355 @@ func (s *PowerVSClusterScope) deleteTransitGatewayConnections(tg *tgapiv1.Transi
 	return false, nil
 }
 
+// clusterTag returns the user Tag attached to the resources created for the cluster.
+func (s *PowerVSClusterScope) clusterTag() string {
+	return globaltagging.ClusterTag(s.IBMPowerVSCluster.Namespace, s.Name())
+}
+
+// clusterUIDTag returns the user Tag identifying the resources created for the cluster, empty until the UID of the cluster is known.
+func (s *PowerVSClusterScope) clusterUIDTag() string {
+	if s.IBMPowerVSCluster.UID == "" {
+		return ""
+	}
+	return globaltagging.ClusterUIDTag(string(s.IBMPowerVSCluster.UID))
+}
+
+// desiredTags returns the cluster tags along with the user tags of the cluster.
+func (s *PowerVSClusterScope) desiredTags() []string {
+	tags := []string{s.clusterTag()}
+	if uidTag := s.clusterUIDTag(); uidTag != "" {
+		tags = append(tags, uidTag)
+	}
+	if s.IBMPowerVSCluster.Spec.Tags != nil {
+		tags = append(tags, s.IBMPowerVSCluster.Spec.Tags.UserTags...)
+	}
+	return tags
+}
+
+// tagResource attaches the cluster tag and the user tags to the resource. As the resource is already created, failures are only reported.
+func (s *PowerVSClusterScope) tagResource(resourceCRN *string) {
+	if s.GlobalTaggingClient == nil || resourceCRN == nil || *resourceCRN == "" {
+		return
+	}
+	for _, tag := range s.desiredTags() {
+		if err := attachTag(s.GlobalTaggingClient, tag, *resourceCRN); err != nil {
+			s.Error(err, "Failed to tag resource", "crn", *resourceCRN)
+			record.Warnf(s.IBMPowerVSCluster, "FailedTagResource", "Failed to tag resource %s - %v", *resourceCRN, err)
+			return
+		}
+	}
+}
+
+// tagNetwork attaches the cluster tag to the network, the CRN of the network is not returned on the DHCP server creation.
+func (s *PowerVSClusterScope) tagNetwork(networkID *string) {
+	if s.GlobalTaggingClient == nil || networkID == nil {
+		return
+	}
+	network, err := s.IBMPowerVSClient.GetNetworkByID(*networkID)
+	if err != nil {
+		s.Error(err, "Failed to fetch network to tag it", "networkID", *networkID)
+		return
+	}
+	s.tagResource(ptr.To(network.Crn))
+}
+
+// ReconcileTags reconciles the user tags of the network, VPC subnets and load balancers created by the controller, the tags attached
+// by other systems are preserved unless the prune option of the tags is set.
+func (s *PowerVSClusterScope) ReconcileTags() error {
+	if s.GlobalTaggingClient == nil {
+		return nil
+	}
+	var crns []string
+	if network := s.IBMPowerVSCluster.Status.Network; network != nil && network.ID != nil && ptr.Deref(network.ControllerCreated, false) {
+		networkDetails, err := s.IBMPowerVSClient.GetNetworkByID(*network.ID)
+		if err != nil {
+			return fmt.Errorf("failed to get network %s: %w", *network.ID, err)
+		}
+		if networkDetails != nil {
+			crns = append(crns, networkDetails.Crn)
+		}
+	}
+	for _, subnet := range s.IBMPowerVSCluster.Status.VPCSubnet {
+		if subnet.ID == nil || !ptr.Deref(subnet.ControllerCreated, false) {
+			continue
+		}
+		subnetDetails, _, err := s.IBMVPCClient.GetSubnet(&vpcv1.GetSubnetOptions{ID: subnet.ID})
+		if err != nil {
+			return fmt.Errorf("failed to get VPC subnet %s: %w", *subnet.ID, err)
+		}
+		crns = append(crns, ptr.Deref(subnetDetails.CRN, ""))
+	}
+	for _, lb := range s.IBMPowerVSCluster.Status.LoadBalancers {
+		if lb.ID == nil || !ptr.Deref(lb.ControllerCreated, false) {
+			continue
+		}
+		loadBalancer, _, err := s.IBMVPCClient.GetLoadBalancer(&vpcv1.GetLoadBalancerOptions{ID: lb.ID})
+		if err != nil {
+			return fmt.Errorf("failed to get load balancer %s: %w", *lb.ID, err)
+		}
+		crns = append(crns, ptr.Deref(loadBalancer.CRN, ""))
+	}
+
+	prune := s.IBMPowerVSCluster.Spec.Tags != nil && s.IBMPowerVSCluster.Spec.Tags.Prune
+	var errs []error
+	for _, crn := range crns {
+		if crn == "" {
+			continue
+		}
+		if err := reconcileTags(s.GlobalTaggingClient, crn, s.desiredTags(), prune); err != nil {
+			errs = append(errs, fmt.Errorf("failed to reconcile tags of resource %s: %w", crn, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// DeleteOrphanTaggedResources deletes the instances, volumes and images of the workspace and the VPC subnets and load balancers
+// of the VPC of the cluster carrying the cluster UID tag which are not tracked in the status of the cluster or of its machines,
+// like the resources left behind by failed reconciles. The UID tag is used rather than the cluster tag, which is shared by the
+// clusters with the same namespace and name of other management clusters. The images of IBMPowerVSImages are left to their DeletePolicy. The other resources, like the DHCP server
+// or the import jobs, are not swept and are deleted along with the cluster infrastructure. The instances and the load balancers
+// are deleted first and true is returned until they are gone, as the volumes and the subnets can't be deleted while they are attached.
+func (s *PowerVSClusterScope) DeleteOrphanTaggedResources() (bool, error) {
+	uidTag := s.clusterUIDTag()
+	if s.GlobalSearchClient == nil || uidTag == "" {
+		return false, nil
+	}
+	crns, err := s.GlobalSearchClient.GetResourcesByTag(uidTag)
+	if err != nil {
+		return false, err
+	}
+	if len(crns) == 0 {
+		return false, nil
+	}
+
+	orphans, err := s.orphanTaggedResources(crns)
+	if err != nil {
+		return false, err
+	}
+
+	var errs []error
+	for _, phase := range [][]string{{pvmInstanceResourceType, loadBalancerResourceType}, {volumeResourceType, imageResourceType, subnetResourceType}} {
+		deleted := false
+		for _, orphan := range orphans {
+			if !slices.Contains(phase, orphan.resourceType) {
+				continue
+			}
+			deleted = true
+			if strings.EqualFold(orphan.state, "deleting") {
+				s.V(3).Info("Orphan resource carrying the cluster tag is being deleted", "crn", orphan.crn)
+				continue
+			}
+			s.Info("Deleting orphan resource carrying the cluster tag", "crn", orphan.crn)
+			if err := s.deleteTaggedResource(orphan); err != nil {
+				record.Warnf(s.IBMPowerVSCluster, "FailedDeleteOrphanResource", "Failed orphan resource %s deletion - %v", orphan.crn, err)
+				errs = append(errs, fmt.Errorf("failed to delete orphan resource %s: %w", orphan.crn, err))
+				continue
+			}
+			record.Eventf(s.IBMPowerVSCluster, "SuccessfulDeleteOrphanResource", "Deleted orphan resource %s", orphan.crn)
+		}
+		if len(errs) > 0 {
+			return false, errors.Join(errs...)
+		}
+		if deleted {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+const (
+	pvmInstanceResourceType  = "pvm-instance"
+	volumeResourceType       = "volume"
+	imageResourceType        = "image"
+	loadBalancerResourceType = "load-balancer"
+	subnetResourceType       = "subnet"
+)
+
+// taggedResource is a resource found with the cluster UID tag.
+type taggedResource struct {
+	crn          string
+	resourceType string
+	id           string
+	// state is the state of the resource, deleting when its deletion is in progress.
+	state string
+}
+
+// orphanTaggedResources returns the resources of the CRNs which still exist and are not tracked in the status of the cluster
+// or of its machines. The resources are looked up as the search index is updated asynchronously and can return deleted resources.
+func (s *PowerVSClusterScope) orphanTaggedResources(crns []string) ([]taggedResource, error) {
+	tracked := map[string]bool{}
+	for _, lb := range s.IBMPowerVSCluster.Status.LoadBalancers {
+		tracked[ptr.Deref(lb.ID, "")] = true
+	}
+	for _, subnet := range s.IBMPowerVSCluster.Status.VPCSubnet {
+		tracked[ptr.Deref(subnet.ID, "")] = true
+	}
+	machines := &infrav1beta2.IBMPowerVSMachineList{}
+	if err := s.Client.List(context.TODO(), machines, client.InNamespace(s.IBMPowerVSCluster.Namespace), client.MatchingLabels{capiv1beta1.ClusterNameLabel: s.Name()}); err != nil {
+		return nil, fmt.Errorf("failed to list IBMPowerVSMachines: %w", err)
+	}
+	for _, machine := range machines.Items {
+		tracked[machine.Status.InstanceID] = true
+	}
+	// The images imported or captured by IBMPowerVSImages are left to their DeletePolicy, the images still being
+	// imported or captured are matched by name as their ID is not known yet.
+	images := &infrav1beta2.IBMPowerVSImageList{}
+	if err := s.Client.List(context.TODO(), images, client.InNamespace(s.IBMPowerVSCluster.Namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list IBMPowerVSImages: %w", err)
+	}
+	imageNames := map[string]bool{}
+	for _, image := range images.Items {
+		tracked[image.Status.ImageID] = true
+		imageNames[image.Name] = true
+	}
+
+	vpcID := ptr.Deref(s.GetVPCID(), "")
+	if vpcID == "" && s.IBMPowerVSCluster.Spec.VPC != nil {
+		vpcID = ptr.Deref(s.IBMPowerVSCluster.Spec.VPC.ID, "")
+	}
+
+	var existing map[string]string
+	var orphans []taggedResource
+	for _, crn := range crns {
+		// The CRNs have the crn:version:cname:ctype:service-name:location:scope:service-instance:resource-type:resource format.
+		segments := strings.Split(crn, ":")
+		if len(segments) != 10 || segments[9] == "" || tracked[segments[9]] {
+			continue
+		}
+		resource := taggedResource{crn: crn, resourceType: segments[8], id: segments[9]}
+		switch segments[4] {
+		case "power-iaas":
+			// The PowerVS resources are deleted along with the workspace created by the controller.
+			if s.isResourceCreatedByController(infrav1beta2.ResourceTypeServiceInstance) || segments[7] != s.GetServiceInstanceID() {
+				continue
+			}
+			if existing == nil {
+				var err error
+				if existing, err = s.listPowerVSResources(imageNames); err != nil {
+					return nil, err
+				}
+			}
+			state, ok := existing[resource.resourceType+"/"+resource.id]
+			if !ok {
+				continue
+			}
+			resource.state = state
+		case "is":
+			// The VPC resources are only swept in the VPC of the cluster.
+			if vpcID == "" || (resource.resourceType != loadBalancerResourceType && resource.resourceType != subnetResourceType) {
+				continue
+			}
+			state, resourceVPCID, ok, err := s.vpcResourceState(resource)
+			if err != nil {
+				return nil, err
+			}
+			if !ok || resourceVPCID != vpcID {
+				continue
+			}
+			resource.state = state
+		default:
+			continue
+		}
+		orphans = append(orphans, resource)
+	}
+	return orphans, nil
+}
+
+// listPowerVSResources returns the states of the instances, volumes and images of the workspace, keyed by resource type and ID.
+// The images named in skipImageNames are left out.
+func (s *PowerVSClusterScope) listPowerVSResources(skipImageNames map[string]bool) (map[string]string, error) {
+	existing := map[string]string{}
+	instances, err := s.IBMPowerVSClient.GetAllInstance()
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch instances: %w", err)
+	}
+	if instances != nil {
+		for _, instance := range instances.PvmInstances {
+			existing[pvmInstanceResourceType+"/"+ptr.Deref(instance.PvmInstanceID, "")] = ptr.Deref(instance.Status, "")
+		}
+	}
+	volumes, err := s.IBMPowerVSClient.GetAllVolumes()
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch volumes: %w", err)
+	}
+	if volumes != nil {
+		for _, volume := range volumes.Volumes {
+			existing[volumeResourceType+"/"+ptr.Deref(volume.VolumeID, "")] = ptr.Deref(volume.State, "")
+		}
+	}
+	images, err := s.IBMPowerVSClient.GetAllImage()
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch images: %w", err)
+	}
+	if images != nil {
+		for _, image := range images.Images {
+			if skipImageNames[ptr.Deref(image.Name, "")] {
+				continue
+			}
+			existing[imageResourceType+"/"+ptr.Deref(image.ImageID, "")] = ptr.Deref(image.State, "")
+		}
+	}
+	return existing, nil
+}
+
+// vpcResourceState returns the state and the ID of the VPC of the VPC load balancer or subnet, along with false when it doesn't
+// exist anymore. The VPC of a load balancer is the VPC of its first subnet.
+func (s *PowerVSClusterScope) vpcResourceState(resource taggedResource) (string, string, bool, error) {
+	var state *string
+	var resp *core.DetailedResponse
+	var err error
+	subnetID := resource.id
+	if resource.resourceType == loadBalancerResourceType {
+		var lb *vpcv1.LoadBalancer
+		lb, resp, err = s.IBMVPCClient.GetLoadBalancer(&vpcv1.GetLoadBalancerOptions{ID: ptr.To(resource.id)})
+		if lb != nil {
+			state = lb.ProvisioningStatus
+			subnetID = ""
+			if len(lb.Subnets) > 0 {
+				subnetID = ptr.Deref(lb.Subnets[0].ID, "")
+			}
+		}
+	}
+	var subnet *vpcv1.Subnet
+	if err == nil && subnetID != "" {
+		subnet, resp, err = s.IBMVPCClient.GetSubnet(&vpcv1.GetSubnetOptions{ID: ptr.To(subnetID)})
+		if subnet != nil && resource.resourceType == subnetResourceType {
+			state = subnet.Status
+		}
+	}
+	if err != nil {
+		if resp != nil && resp.StatusCode == ResourceNotFoundCode {
+			return "", "", false, nil
+		}
+		return "", "", false, fmt.Errorf("failed to fetch %s %s: %w", resource.resourceType, resource.id, err)
+	}
+	var vpcID string
+	if subnet != nil && subnet.VPC != nil {
+		vpcID = ptr.Deref(subnet.VPC.ID, "")
+	}
+	// The deletion of the load balancers is in progress in the delete_pending state.
+	return strings.Replace(ptr.Deref(state, ""), "delete_pending", "deleting", 1), vpcID, true, nil
+}
+
+// deleteTaggedResource deletes the resource found with the cluster tag.
+func (s *PowerVSClusterScope) deleteTaggedResource(resource taggedResource) error {
+	switch resource.resourceType {
+	case pvmInstanceResourceType:
+		return s.IBMPowerVSClient.DeleteInstance(resource.id)
+	case volumeResourceType:
+		return s.IBMPowerVSClient.DeleteVolume(resource.id)
+	case imageResourceType:
+		return s.IBMPowerVSClient.DeleteImage(resource.id)
+	case loadBalancerResourceType:
+		_, err := s.IBMVPCClient.DeleteLoadBalancer(&vpcv1.DeleteLoadBalancerOptions{ID: ptr.To(resource.id)})
+		return err
+	case subnetResourceType:
+		_, err := s.IBMVPCClient.DeleteSubnet(&vpcv1.DeleteSubnetOptions{ID: ptr.To(resource.id)})
+		return err
+	}
+	return nil
+}
+
 // DeleteDHCPServer deletes DHCP server.
 func (s *PowerVSClusterScope) DeleteDHCPServer() error {
 	if !s.isResourceCreatedByController(infrav1beta2.ResourceTypeDHCPServer) {
@@ -2851,6 +3817,45 @@ func (s *PowerVSClusterScope) DeleteCOSInstance() error {
 	return nil
 }
 
+// DeleteSSHKey deletes the SSH key of the cluster from the Power VS tenant.
+func (s *PowerVSClusterScope) DeleteSSHKey() error {
+	if !s.isResourceCreatedByController(infrav1beta2.ResourceTypeSSHKey) {
+		s.Info("Skipping SSH key deletion as resource is not created by controller")
+		return nil
+	}
+
+	if s.IBMPowerVSCluster.Status.SSHKey.ID == nil {
+		return nil
+	}
+
+	name := *s.IBMPowerVSCluster.Status.SSHKey.ID
+	if err := s.IBMPowerVSClient.DeleteSSHKey(name); err != nil {
+		var notFound *p_cloud_tenants_ssh_keys.PcloudTenantsSshkeysDeleteNotFound
+		if errors.As(err, &notFound) {
+			return nil
+		}
+		return fmt.Errorf("failed to delete SSH key %s: %w", name, err)
+	}
+	s.Info("SSH key successfully deleted", "name", name)
+	return nil
+}
+
+// DeleteDNSRecord deletes the DNS record registering the control plane endpoint when it is created by the controller.
+func (s *PowerVSClusterScope) DeleteDNSRecord() error {
+	if s.DNS() == nil || s.DNSClient == nil {
+		return nil
+	}
+	if record := s.IBMPowerVSCluster.Status.DNSRecord; record == nil || !ptr.Deref(record.ControllerCreated, false) {
+		s.Info("Skipping DNS record deletion as resource is not created by controller")
+		return nil
+	}
+	if err := deleteDNSRecord(s.DNSClient, s.IBMPowerVSCluster.Status.DNSRecord); err != nil {
+		return fmt.Errorf("failed to delete DNS record %s: %w", s.DNS().Hostname, err)
+	}
+	s.Info("DNS record successfully deleted", "hostname", s.DNS().Hostname)
+	return nil
+}
+
 // resourceCreatedByController helps to identify resource created by controller or not.
 func (s *PowerVSClusterScope) isResourceCreatedByController(resourceType infrav1beta2.ResourceType) bool { //nolint:gocyclo
 	switch resourceType {
@@ -2884,6 +3889,12 @@ func (s *PowerVSClusterScope) isResourceCreatedByController(resourceType infrav1
 			return false
 		}
 		return true
+	case infrav1beta2.ResourceTypeSSHKey:
+		sshKey := s.IBMPowerVSCluster.Status.SSHKey
+		if sshKey == nil || sshKey.ControllerCreated == nil || !*sshKey.ControllerCreated {
+			return false
+		}
+		return true
 	}
 	return false
 }
@@ -2900,3 +3911,250 @@ func (s *PowerVSClusterScope) bucketRegion() string {
 	}
 	return ""
 }
+
+// ReconcileAddons reconciles the ClusterResourceSet containing the IBM specific addons of the workload cluster.
+func (s *PowerVSClusterScope) ReconcileAddons() error {
+	addons := s.IBMPowerVSCluster.Spec.Addons
+	if addons == nil {
+		return nil
+	}
+	ctx := context.TODO()
+
+	resources := []addonsv1beta1.ResourceRef{}
+	if addons.Calico != nil {
+		data, err := s.calicoConfig()
+		if err != nil {
+			return err
+		}
+		ref, err := s.reconcileAddonConfigMap(ctx, fmt.Sprintf("%s-calico-config", s.InfraCluster()), "calico-config.yaml", data)
+		if err != nil {
+			return fmt.Errorf("failed to reconcile calico config: %w", err)
+		}
+		resources = append(resources, *ref)
+		resources = append(resources, addonResourceRefs(addons.Calico.Resources)...)
+	}
+	if addons.CloudControllerManager != nil {
+		data, err := s.cloudControllerManagerConfig()
+		if err != nil {
+			return err
+		}
+		ref, err := s.reconcileAddonConfigMap(ctx, fmt.Sprintf("%s-ibmpowervs-cfg", s.InfraCluster()), "ibmpowervs-cloud-conf.yaml", data)
+		if err != nil {
+			return fmt.Errorf("failed to reconcile cloud controller manager config: %w", err)
+		}
+		resources = append(resources, *ref)
+		resources = append(resources, addonResourceRefs(addons.CloudControllerManager.Resources)...)
+	}
+	if addons.CSIDriver != nil {
+		resources = append(resources, addonResourceRefs(addons.CSIDriver.Resources)...)
+	}
+
+	crs := &addonsv1beta1.ClusterResourceSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-addons", s.InfraCluster()),
+			Namespace: s.IBMPowerVSCluster.Namespace,
+		},
+	}
+	if _, err := controllerutil.CreateOrPatch(ctx, s.Client, crs, func() error {
+		crs.Spec.ClusterSelector = metav1.LabelSelector{
+			MatchLabels: map[string]string{
+				infrav1beta2.ClusterAddonsLabel: s.InfraCluster(),
+			},
+		}
+		crs.Spec.Resources = resources
+		// strategy is immutable, set it only while creating the ClusterResourceSet.
+		if crs.CreationTimestamp.IsZero() {
+			crs.Spec.Strategy = addons.Strategy
+			if crs.Spec.Strategy == "" {
+				crs.Spec.SetTypedStrategy(addonsv1beta1.ClusterResourceSetStrategyApplyOnce)
+			}
+		}
+		return controllerutil.SetControllerReference(s.IBMPowerVSCluster, crs, s.Client.Scheme())
+	}); err != nil {
+		return fmt.Errorf("failed to reconcile ClusterResourceSet %s: %w", crs.Name, err)
+	}
+
+	// bind the ClusterResourceSet to the cluster.
+	if s.Cluster.Labels[infrav1beta2.ClusterAddonsLabel] != s.InfraCluster() {
+		s.Info("Setting addons label on cluster", "label", infrav1beta2.ClusterAddonsLabel)
+		patchBase := client.MergeFrom(s.Cluster.DeepCopy())
+		if s.Cluster.Labels == nil {
+			s.Cluster.Labels = map[string]string{}
+		}
+		s.Cluster.Labels[infrav1beta2.ClusterAddonsLabel] = s.InfraCluster()
+		if err := s.Client.Patch(ctx, s.Cluster, patchBase); err != nil {
+			return fmt.Errorf("failed to set addons label on cluster: %w", err)
+		}
+	}
+	return nil
+}
+
+// reconcileAddonConfigMap creates or updates the ConfigMap holding the generated addon manifest and returns its reference.
+func (s *PowerVSClusterScope) reconcileAddonConfigMap(ctx context.Context, name, key string, data []byte) (*addonsv1beta1.ResourceRef, error) {
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: s.IBMPowerVSCluster.Namespace,
+		},
+	}
+	if _, err := controllerutil.CreateOrPatch(ctx, s.Client, cm, func() error {
+		cm.Data = map[string]string{
+			key: string(data),
+		}
+		return controllerutil.SetControllerReference(s.IBMPowerVSCluster, cm, s.Client.Scheme())
+	}); err != nil {
+		return nil, err
+	}
+	return &addonsv1beta1.ResourceRef{
+		Kind: string(addonsv1beta1.ConfigMapClusterResourceSetResourceKind),
+		Name: cm.Name,
+	}, nil
+}
+
+// calicoConfig returns the calico-config ConfigMap manifest with the MTU tuned for the Power VS network.
+func (s *PowerVSClusterScope) calicoConfig() ([]byte, error) {
+	mtu, err := s.calicoMTU()
+	if err != nil {
+		return nil, err
+	}
+	return yaml.Marshal(&corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "v1",
+			Kind:       "ConfigMap",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "calico-config",
+			Namespace: metav1.NamespaceSystem,
+		},
+		Data: map[string]string{
+			"veth_mtu": strconv.Itoa(int(mtu)),
+		},
+	})
+}
+
+// calicoMTU returns the MTU set in the calico addon spec, or the MTU of the Power VS network of the cluster minus the VXLAN overhead.
+// defaultCalicoMTU is returned when the network is not yet known or does not report its MTU.
+func (s *PowerVSClusterScope) calicoMTU() (int32, error) {
+	if s.IBMPowerVSCluster.Spec.Addons.Calico.MTU != nil {
+		return *s.IBMPowerVSCluster.Spec.Addons.Calico.MTU, nil
+	}
+	networkID := s.GetNetworkID()
+	if networkID == nil {
+		return defaultCalicoMTU, nil
+	}
+	network, err := s.IBMPowerVSClient.GetNetworkByID(*networkID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get network %s: %w", *networkID, err)
+	}
+	if network.Mtu == nil {
+		return defaultCalicoMTU, nil
+	}
+	return int32(*network.Mtu - vxlanOverhead), nil
+}
+
+// cloudControllerManagerConfig returns the cloud config ConfigMap manifest used by the IBM cloud controller manager.
+func (s *PowerVSClusterScope) cloudControllerManagerConfig() ([]byte, error) {
+	serviceInstanceID := s.GetServiceInstanceID()
+	if serviceInstanceID == "" {
+		serviceInstanceID = s.IBMPowerVSCluster.Spec.ServiceInstanceID
+	}
+	if serviceInstanceID == "" && s.ServiceInstance() != nil && s.ServiceInstance().ID != nil {
+		serviceInstanceID = *s.ServiceInstance().ID
+	}
+	if serviceInstanceID == "" {
+		return nil, fmt.Errorf("service instance ID is not yet available")
+	}
+	if s.Zone() == nil {
+		return nil, fmt.Errorf("zone is not set")
+	}
+	accountID, err := getAccountID(s.Client, s.IBMPowerVSCluster.Namespace, s.IBMPowerVSCluster.Spec.CredentialsSecretRef, s.IBMPowerVSCluster.Spec.EnterpriseAccount)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get account ID: %w", err)
+	}
+
+	var resourceGroupName, vpcName, vpcRegion string
+	if s.ResourceGroup() != nil && s.ResourceGroup().Name != nil {
+		resourceGroupName = *s.ResourceGroup().Name
+	}
+	if s.VPC() != nil {
+		vpcName = *s.GetServiceName(infrav1beta2.ResourceTypeVPC)
+		if s.VPC().Region != nil {
+			vpcRegion = *s.VPC().Region
+		}
+	}
+	subnetNames := []string{}
+	for _, subnet := range s.IBMPowerVSCluster.Spec.VPCSubnets {
+		if subnet.Name != nil {
+			subnetNames = append(subnetNames, *subnet.Name)
+		}
+	}
+
+	config := fmt.Sprintf(`[global]
+version = 1.1.0
+[kubernetes]
+config-file = ""
+[provider]
+cluster-default-provider = g2
+accountID = %s
+clusterID = %s
+g2workerServiceAccountID = %s
+g2Credentials = /etc/ibm-secret/ibmcloud_api_key
+g2ResourceGroupName = %s
+g2VpcSubnetNames = %s
+g2VpcName = %s
+region = %s
+powerVSCloudInstanceID = %s
+powerVSRegion = %s
+powerVSZone = %s
+`, accountID, s.Name(), accountID, resourceGroupName, strings.Join(subnetNames, ","), vpcName, vpcRegion,
+		serviceInstanceID, endpoints.ConstructRegionFromZone(*s.Zone()), *s.Zone())
+
+	return yaml.Marshal(&corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "v1",
+			Kind:       "ConfigMap",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "ibmpowervs-cloud-config",
+			Namespace: metav1.NamespaceSystem,
+		},
+		Data: map[string]string{
+			"ibmpowervs.conf": config,
+		},
+	})
+}
+
+func addonResourceRefs(refs []infrav1beta2.AddonResourceReference) []addonsv1beta1.ResourceRef {
+	resources := make([]addonsv1beta1.ResourceRef, 0, len(refs))
+	for _, ref := range refs {
+		resources = append(resources, addonsv1beta1.ResourceRef{
+			Kind: ref.Kind,
+			Name: ref.Name,
+		})
+	}
+	return resources
+}
+
+// ReconcileDebugDump writes the sanitized dump of the VPC load balancers into the debug dump ConfigMap of the IBMPowerVSCluster
+// when the debug dump annotation is set on it.
+func (s *PowerVSClusterScope) ReconcileDebugDump() error {
+	if !debugdump.Requested(s.IBMPowerVSCluster) {
+		return nil
+	}
+	resources := map[string]interface{}{}
+	if s.IBMVPCClient != nil {
+		for name, lb := range s.IBMPowerVSCluster.Status.LoadBalancers {
+			if lb.ID == nil {
+				continue
+			}
+			loadBalancer, _, err := s.IBMVPCClient.GetLoadBalancer(&vpcv1.GetLoadBalancerOptions{
+				ID: lb.ID,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to get load balancer %s: %w", name, err)
+			}
+			resources[fmt.Sprintf("loadbalancer-%s.json", name)] = loadBalancer
+		}
+	}
+	return debugdump.Reconcile(context.TODO(), s.Client, s.IBMPowerVSCluster, resources)
+}
@@ -17,35 +17,51 @@ limitations under the License.
 package scope
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"os"
 	"testing"
 
+	"github.com/IBM-Cloud/power-go-client/power/client/p_cloud_tenants_ssh_keys"
 	"github.com/IBM-Cloud/power-go-client/power/models"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/ibm-cos-sdk-go/aws/awserr"
+	"github.com/IBM/platform-services-go-sdk/globaltaggingv1"
 	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	regionUtil "github.com/ppc64le-cloud/powervs-utils"
 	"go.uber.org/mock/gomock"
 
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/klog/v2"
 	"k8s.io/utils/ptr"
 
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
 	tgapiv1 "github.com/IBM/networking-go-sdk/transitgatewayapisv1"
 	infrav1beta2 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/v1beta2"
 	mockP "sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/powervs/mock"
 	tgmock "sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/transitgateway/mock"
 	capiv1beta1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	addonsv1beta1 "sigs.k8s.io/cluster-api/exp/addons/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
 
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/utils"
 	mockcos "sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/cos/mock"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/dns"
+	mockdns "sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/dns/mock"
+	mockgs "sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/globalsearch/mock"
+	mockgt "sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/globaltagging/mock"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/powervs"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/resourcecontroller"
 	mockRC "sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/resourcecontroller/mock"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/resourcemanager"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/transitgateway"
+	serviceutils "sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/utils"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/vpc"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/vpc/mock"
 
@@ -557,6 +573,72 @@ func TestVPCSecurityGroupByID(t *testing.T) {
 	}
 }
 
+func TestSetFailureDomains(t *testing.T) {
+	t.Run("Should report the zone of the workspace as failure domain", func(t *testing.T) {
+		g := NewWithT(t)
+		clusterScope := PowerVSClusterScope{
+			IBMPowerVSCluster: &infrav1beta2.IBMPowerVSCluster{
+				Spec: infrav1beta2.IBMPowerVSClusterSpec{Zone: ptr.To("dal10")},
+			},
+		}
+		clusterScope.SetFailureDomains()
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.FailureDomains).To(Equal(capiv1beta1.FailureDomains{
+			"dal10": {ControlPlane: true, Attributes: map[string]string{"region": "dal"}},
+		}))
+	})
+
+	t.Run("Should not report failure domains when the zone is not set", func(t *testing.T) {
+		g := NewWithT(t)
+		clusterScope := PowerVSClusterScope{
+			IBMPowerVSCluster: &infrav1beta2.IBMPowerVSCluster{},
+		}
+		clusterScope.SetFailureDomains()
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.FailureDomains).To(BeNil())
+	})
+}
+
+func TestSetReadyCondition(t *testing.T) {
+	t.Run("Should summarize the conditions of the components", func(t *testing.T) {
+		g := NewWithT(t)
+		cluster := &infrav1beta2.IBMPowerVSCluster{Status: infrav1beta2.IBMPowerVSClusterStatus{Ready: true}}
+		conditions.MarkTrue(cluster, infrav1beta2.ServiceInstanceReadyCondition)
+		conditions.MarkFalse(cluster, infrav1beta2.TransitGatewayReadyCondition, infrav1beta2.ServiceUnreachableReason, capiv1beta1.ConditionSeverityWarning, "transit gateway is unreachable")
+		conditions.MarkFalse(cluster, infrav1beta2.ControlPlaneEndpointReachableCondition, infrav1beta2.ConnectivityCheckFailedReason, capiv1beta1.ConditionSeverityWarning, "connection refused")
+		clusterScope := PowerVSClusterScope{IBMPowerVSCluster: cluster}
+		clusterScope.setReadyCondition()
+		g.Expect(conditions.GetReason(cluster, capiv1beta1.ReadyCondition)).To(Equal(infrav1beta2.ServiceUnreachableReason))
+
+		conditions.MarkTrue(cluster, infrav1beta2.TransitGatewayReadyCondition)
+		clusterScope.setReadyCondition()
+		g.Expect(conditions.IsTrue(cluster, capiv1beta1.ReadyCondition)).To(BeTrue())
+	})
+
+	t.Run("Should not be ready until the infrastructure is ready", func(t *testing.T) {
+		g := NewWithT(t)
+		cluster := &infrav1beta2.IBMPowerVSCluster{}
+		conditions.MarkTrue(cluster, infrav1beta2.NetworkReadyCondition)
+		clusterScope := PowerVSClusterScope{IBMPowerVSCluster: cluster}
+		clusterScope.setReadyCondition()
+		g.Expect(conditions.GetReason(cluster, capiv1beta1.ReadyCondition)).To(Equal(infrav1beta2.ClusterInfrastructureNotReadyReason))
+	})
+
+	t.Run("Should be ready without conditions of the components once the infrastructure is ready", func(t *testing.T) {
+		g := NewWithT(t)
+		cluster := &infrav1beta2.IBMPowerVSCluster{Status: infrav1beta2.IBMPowerVSClusterStatus{Ready: true}}
+		clusterScope := PowerVSClusterScope{IBMPowerVSCluster: cluster}
+		clusterScope.setReadyCondition()
+		g.Expect(conditions.IsTrue(cluster, capiv1beta1.ReadyCondition)).To(BeTrue())
+	})
+
+	t.Run("Should report the deletion of the cluster", func(t *testing.T) {
+		g := NewWithT(t)
+		cluster := &infrav1beta2.IBMPowerVSCluster{ObjectMeta: metav1.ObjectMeta{DeletionTimestamp: ptr.To(metav1.Now())}}
+		clusterScope := PowerVSClusterScope{IBMPowerVSCluster: cluster}
+		clusterScope.setReadyCondition()
+		g.Expect(conditions.GetReason(cluster, capiv1beta1.ReadyCondition)).To(Equal(capiv1beta1.DeletingReason))
+	})
+}
+
 func TestGetTransitGatewayID(t *testing.T) {
 	testCases := []struct {
 		name         string
@@ -802,10 +884,11 @@ func TestGetLoadBalancerHostName(t *testing.T) {
 
 		clusterScope := PowerVSClusterScope{
 			IBMPowerVSCluster: &infrav1beta2.IBMPowerVSCluster{
-				Spec: infrav1beta2.IBMPowerVSClusterSpec{},
+				ObjectMeta: metav1.ObjectMeta{Name: "foo"},
+				Spec:       infrav1beta2.IBMPowerVSClusterSpec{},
 				Status: infrav1beta2.IBMPowerVSClusterStatus{
 					LoadBalancers: map[string]infrav1beta2.VPCLoadBalancerStatus{
-						"-loadbalancer": {
+						"foo-loadbalancer": {
 							Hostname: ptr.To("lb-hostname"),
 						},
 					},
@@ -1545,6 +1628,101 @@ func TestReconcileLoadBalancers(t *testing.T) {
 		g.Expect(loadBalancer.ControllerCreated).To(Equal(ptr.To(true)))
 		g.Expect(loadBalancer.Hostname).To(Equal(ptr.To("test-lb-hostname")))
 	})
+
+	t.Run("When the load balancer created by the controller is deleted out-of-band, it is created again", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := PowerVSClusterScope{
+			IBMVPCClient: mockVpc,
+			IBMPowerVSCluster: &infrav1beta2.IBMPowerVSCluster{
+				Spec: infrav1beta2.IBMPowerVSClusterSpec{
+					ResourceGroup: &infrav1beta2.IBMPowerVSResourceReference{
+						ID: ptr.To("test-resource-gid"),
+					},
+					LoadBalancers: []infrav1beta2.VPCLoadBalancerSpec{
+						{
+							Name: "test-lb",
+						},
+					},
+					VPCSubnets: []infrav1beta2.Subnet{
+						{
+							Name: ptr.To("test-subnet"),
+							ID:   ptr.To("test-subnetid"),
+						},
+					},
+				},
+				Status: infrav1beta2.IBMPowerVSClusterStatus{
+					LoadBalancers: map[string]infrav1beta2.VPCLoadBalancerStatus{
+						"test-lb": {
+							ID:                ptr.To("deleted-lb-id"),
+							State:             infrav1beta2.VPCLoadBalancerStateActive,
+							Hostname:          ptr.To("deleted-lb-hostname"),
+							ControllerCreated: ptr.To(true),
+						},
+					},
+					VPCSubnet: map[string]infrav1beta2.ResourceReference{
+						"test-subnet": {
+							ID: ptr.To("test-resource-reference-id"),
+						},
+					},
+				},
+			},
+			Cluster: &capiv1beta1.Cluster{},
+		}
+
+		mockVpc.EXPECT().GetLoadBalancer(&vpcv1.GetLoadBalancerOptions{ID: ptr.To("deleted-lb-id")}).Return(nil, &core.DetailedResponse{StatusCode: ResourceNotFoundCode}, errors.New("load balancer not found"))
+		mockVpc.EXPECT().GetLoadBalancerByName("test-lb").Return(nil, nil)
+		mockVpc.EXPECT().CreateLoadBalancer(gomock.Any()).Return(&vpcv1.LoadBalancer{
+			ID:                 ptr.To("test-lb-id"),
+			ProvisioningStatus: ptr.To("create_pending"),
+		}, nil, nil)
+
+		loadBalancerReady, err := clusterScope.ReconcileLoadBalancers()
+		g.Expect(loadBalancerReady).To(BeFalse())
+		g.Expect(err).To(BeNil())
+
+		loadBalancer, ok := clusterScope.IBMPowerVSCluster.Status.LoadBalancers["test-lb"]
+		g.Expect(ok).To(BeTrue())
+		g.Expect(loadBalancer.ID).To(Equal(ptr.To("test-lb-id")))
+		g.Expect(loadBalancer.ControllerCreated).To(Equal(ptr.To(true)))
+		g.Expect(conditions.GetReason(clusterScope.IBMPowerVSCluster, infrav1beta2.ControlPlaneEndpointReadyCondition)).To(Equal(infrav1beta2.LoadBalancerRecreatingReason))
+	})
+
+	t.Run("When the load balancer not created by the controller is deleted out-of-band, it is not created again", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := PowerVSClusterScope{
+			IBMVPCClient: mockVpc,
+			IBMPowerVSCluster: &infrav1beta2.IBMPowerVSCluster{
+				Spec: infrav1beta2.IBMPowerVSClusterSpec{
+					LoadBalancers: []infrav1beta2.VPCLoadBalancerSpec{
+						{
+							Name: "test-lb",
+						},
+					},
+				},
+				Status: infrav1beta2.IBMPowerVSClusterStatus{
+					LoadBalancers: map[string]infrav1beta2.VPCLoadBalancerStatus{
+						"test-lb": {
+							ID:    ptr.To("deleted-lb-id"),
+							State: infrav1beta2.VPCLoadBalancerStateActive,
+						},
+					},
+				},
+			},
+		}
+
+		mockVpc.EXPECT().GetLoadBalancer(gomock.Any()).Return(nil, &core.DetailedResponse{StatusCode: ResourceNotFoundCode}, errors.New("load balancer not found"))
+
+		loadBalancerReady, err := clusterScope.ReconcileLoadBalancers()
+		g.Expect(loadBalancerReady).To(BeFalse())
+		g.Expect(err).ToNot(BeNil())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.LoadBalancers).To(HaveKey("test-lb"))
+	})
 }
 
 func TestCreateLoadbalancer(t *testing.T) {
@@ -2788,7 +2966,7 @@ func TestGetServiceName(t *testing.T) {
 			clusterScope: PowerVSClusterScope{
 				IBMPowerVSCluster: &infrav1beta2.IBMPowerVSCluster{ObjectMeta: metav1.ObjectMeta{Name: "ClusterName"}},
 			},
-			expectedName: ptr.To("ClusterName-vpc"),
+			expectedName: ptr.To("clustername-vpc-686008ca"),
 		},
 		{
 			name:         "Resource type is vpc and VPC is not nil",
@@ -2804,7 +2982,7 @@ func TestGetServiceName(t *testing.T) {
 			clusterScope: PowerVSClusterScope{
 				IBMPowerVSCluster: &infrav1beta2.IBMPowerVSCluster{ObjectMeta: metav1.ObjectMeta{Name: "ClusterName"}},
 			},
-			expectedName: ptr.To("ClusterName-transitgateway"),
+			expectedName: ptr.To("clustername-transitgateway-04c81344"),
 		},
 		{
 			name:         "Resource type is transit gateway and transitgateway is not nil",
@@ -2852,7 +3030,7 @@ func TestGetServiceName(t *testing.T) {
 			clusterScope: PowerVSClusterScope{
 				IBMPowerVSCluster: &infrav1beta2.IBMPowerVSCluster{ObjectMeta: metav1.ObjectMeta{Name: "ClusterName"}},
 			},
-			expectedName: ptr.To("ClusterName-cosbucket"),
+			expectedName: ptr.To("clustername-cosbucket-7e2d8fed"),
 		},
 		{
 			name:         "Resource type is cos bucket and cos bucket is not nil",
@@ -2868,7 +3046,7 @@ func TestGetServiceName(t *testing.T) {
 			clusterScope: PowerVSClusterScope{
 				IBMPowerVSCluster: &infrav1beta2.IBMPowerVSCluster{ObjectMeta: metav1.ObjectMeta{Name: "ClusterName"}},
 			},
-			expectedName: ptr.To("ClusterName-vpcsubnet"),
+			expectedName: ptr.To("clustername-vpcsubnet-de7a823e"),
 		},
 		{
 			name:         "Resource type is load balancer",
@@ -2876,7 +3054,7 @@ func TestGetServiceName(t *testing.T) {
 			clusterScope: PowerVSClusterScope{
 				IBMPowerVSCluster: &infrav1beta2.IBMPowerVSCluster{ObjectMeta: metav1.ObjectMeta{Name: "ClusterName"}},
 			},
-			expectedName: ptr.To("ClusterName-loadbalancer"),
+			expectedName: ptr.To("clustername-loadbalancer-3315f712"),
 		},
 		{
 			name: "Resource type is invalid",
@@ -3534,6 +3712,120 @@ func TestReconcileNetwork(t *testing.T) {
 	})
 }
 
+func TestReconcileDHCPServerSettings(t *testing.T) {
+	var (
+		mockPowerVS *mockP.MockPowerVS
+		mockCtrl    *gomock.Controller
+	)
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockPowerVS = mockP.NewMockPowerVS(mockCtrl)
+	}
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+	newClusterScope := func(dhcpServer *infrav1beta2.DHCPServer) PowerVSClusterScope {
+		return PowerVSClusterScope{
+			IBMPowerVSClient: mockPowerVS,
+			IBMPowerVSCluster: &infrav1beta2.IBMPowerVSCluster{
+				Spec: infrav1beta2.IBMPowerVSClusterSpec{DHCPServer: dhcpServer},
+				Status: infrav1beta2.IBMPowerVSClusterStatus{
+					DHCPServer: &infrav1beta2.ResourceReference{ID: ptr.To("dhcpID"), ControllerCreated: ptr.To(true)},
+					Network:    &infrav1beta2.ResourceReference{ID: ptr.To("netID"), ControllerCreated: ptr.To(true)},
+				},
+			},
+		}
+	}
+	activeDHCPServer := &models.DHCPServerDetail{ID: ptr.To("dhcpID"), Status: ptr.To(string(infrav1beta2.DHCPServerStateActive))}
+
+	t.Run("When DNS server is changed, it is updated in place", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope(&infrav1beta2.DHCPServer{DNSServer: ptr.To("9.9.9.9")})
+		ipAddressRanges := []*models.IPAddressRange{{StartingIPAddress: ptr.To("192.168.0.2"), EndingIPAddress: ptr.To("192.168.0.254")}}
+		mockPowerVS.EXPECT().GetDHCPServer("dhcpID").Return(activeDHCPServer, nil)
+		mockPowerVS.EXPECT().GetNetworkByID("netID").Return(&models.Network{NetworkID: ptr.To("netID"), DNSServers: []string{"1.1.1.1"}, IPAddressRanges: ipAddressRanges}, nil)
+		mockPowerVS.EXPECT().UpdateNetwork("netID", &models.NetworkUpdate{DNSServers: []string{"9.9.9.9"}, IPAddressRanges: ipAddressRanges}).Return(&models.Network{}, nil)
+
+		isNetworkAvailable, err := clusterScope.ReconcileNetwork()
+		g.Expect(err).To(BeNil())
+		g.Expect(isNetworkAvailable).To(BeTrue())
+	})
+	t.Run("When DNS server update fails", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope(&infrav1beta2.DHCPServer{DNSServer: ptr.To("9.9.9.9")})
+		mockPowerVS.EXPECT().GetDHCPServer("dhcpID").Return(activeDHCPServer, nil)
+		mockPowerVS.EXPECT().GetNetworkByID("netID").Return(&models.Network{NetworkID: ptr.To("netID"), DNSServers: []string{"1.1.1.1"}}, nil)
+		mockPowerVS.EXPECT().UpdateNetwork("netID", gomock.Any()).Return(nil, fmt.Errorf("UpdateNetwork error"))
+
+		isNetworkAvailable, err := clusterScope.ReconcileNetwork()
+		g.Expect(err).ToNot(BeNil())
+		g.Expect(isNetworkAvailable).To(BeFalse())
+	})
+	t.Run("When CIDR is changed with inPlace update policy, DHCP server is not recreated", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope(&infrav1beta2.DHCPServer{Cidr: ptr.To("192.168.0.0/16"), UpdatePolicy: string(infrav1beta2.DHCPServerUpdatePolicyInPlace)})
+		mockPowerVS.EXPECT().GetDHCPServer("dhcpID").Return(activeDHCPServer, nil)
+		mockPowerVS.EXPECT().GetNetworkByID("netID").Return(&models.Network{NetworkID: ptr.To("netID"), Cidr: ptr.To("192.168.0.0/24")}, nil)
+
+		isNetworkAvailable, err := clusterScope.ReconcileNetwork()
+		g.Expect(err).To(BeNil())
+		g.Expect(isNetworkAvailable).To(BeTrue())
+	})
+	t.Run("When CIDR is changed with recreate update policy and DHCP server has leases, DHCP server is not recreated", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope(&infrav1beta2.DHCPServer{Cidr: ptr.To("192.168.0.0/16"), UpdatePolicy: string(infrav1beta2.DHCPServerUpdatePolicyRecreate)})
+		dhcpServer := &models.DHCPServerDetail{ID: ptr.To("dhcpID"), Status: ptr.To(string(infrav1beta2.DHCPServerStateActive)), Leases: []*models.DHCPServerLeases{{InstanceIP: ptr.To("192.168.0.10")}}}
+		mockPowerVS.EXPECT().GetDHCPServer("dhcpID").Return(dhcpServer, nil)
+		mockPowerVS.EXPECT().GetNetworkByID("netID").Return(&models.Network{NetworkID: ptr.To("netID"), Cidr: ptr.To("192.168.0.0/24")}, nil)
+
+		isNetworkAvailable, err := clusterScope.ReconcileNetwork()
+		g.Expect(err).To(BeNil())
+		g.Expect(isNetworkAvailable).To(BeTrue())
+	})
+	t.Run("When CIDR is changed with recreate update policy, DHCP server is deleted", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope(&infrav1beta2.DHCPServer{Cidr: ptr.To("192.168.0.0/16"), UpdatePolicy: string(infrav1beta2.DHCPServerUpdatePolicyRecreate)})
+		mockPowerVS.EXPECT().GetDHCPServer("dhcpID").Return(activeDHCPServer, nil)
+		mockPowerVS.EXPECT().GetNetworkByID("netID").Return(&models.Network{NetworkID: ptr.To("netID"), Cidr: ptr.To("192.168.0.0/24")}, nil)
+		mockPowerVS.EXPECT().DeleteDHCPServer("dhcpID").Return(nil)
+
+		isNetworkAvailable, err := clusterScope.ReconcileNetwork()
+		g.Expect(err).To(BeNil())
+		g.Expect(isNetworkAvailable).To(BeFalse())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.DHCPServer.ID).To(Equal(ptr.To("dhcpID")))
+	})
+	t.Run("When DHCP server being recreated is not found, its status is cleared", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope(&infrav1beta2.DHCPServer{Cidr: ptr.To("192.168.0.0/16"), UpdatePolicy: string(infrav1beta2.DHCPServerUpdatePolicyRecreate)})
+		mockPowerVS.EXPECT().GetDHCPServer("dhcpID").Return(nil, fmt.Errorf("%s", DHCPServerNotFound))
+
+		isNetworkAvailable, err := clusterScope.ReconcileNetwork()
+		g.Expect(err).To(BeNil())
+		g.Expect(isNetworkAvailable).To(BeFalse())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.DHCPServer).To(BeNil())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.Network).To(BeNil())
+	})
+}
+
 func TestReconcileVPCSubnets(t *testing.T) {
 	var (
 		mockVPC  *mock.MockVpc
@@ -3697,7 +3989,7 @@ func TestReconcileVPCSubnets(t *testing.T) {
 		clusterScope := PowerVSClusterScope{
 			IBMVPCClient: mockVPC,
 			IBMPowerVSCluster: &infrav1beta2.IBMPowerVSCluster{
-				ObjectMeta: metav1.ObjectMeta{Name: "ClusterName"},
+				ObjectMeta: metav1.ObjectMeta{Name: "clustername"},
 				Status: infrav1beta2.IBMPowerVSClusterStatus{
 					VPC: &infrav1beta2.ResourceReference{ID: ptr.To("vpcID")},
 				},
@@ -3706,7 +3998,7 @@ func TestReconcileVPCSubnets(t *testing.T) {
 					VPC:           &infrav1beta2.VPCResourceReference{Region: ptr.To("eu-de")}},
 			},
 		}
-		subnet1Details := &vpcv1.Subnet{ID: ptr.To("subnet1ID"), Name: ptr.To("ClusterName-vpcsubnet-eu-de-1")}
+		subnet1Details := &vpcv1.Subnet{ID: ptr.To("subnet1ID"), Name: ptr.To("clustername-vpcsubnet-eu-de-1")}
 		mockVPC.EXPECT().GetVPCSubnetByName(gomock.Any()).Return(nil, nil).Times(3)
 		mockVPC.EXPECT().CreateSubnet(gomock.Any()).Return(subnet1Details, nil, nil).Times(3)
 		requeue, err := clusterScope.ReconcileVPCSubnets()
@@ -3725,7 +4017,7 @@ func TestReconcileVPCSubnets(t *testing.T) {
 		clusterScope := PowerVSClusterScope{
 			IBMVPCClient: mockVPC,
 			IBMPowerVSCluster: &infrav1beta2.IBMPowerVSCluster{
-				ObjectMeta: metav1.ObjectMeta{Name: "ClusterName"},
+				ObjectMeta: metav1.ObjectMeta{Name: "clustername"},
 				Status: infrav1beta2.IBMPowerVSClusterStatus{
 					VPC: &infrav1beta2.ResourceReference{ID: ptr.To("vpcID")},
 				},
@@ -3785,7 +4077,7 @@ func TestReconcileVPCSubnets(t *testing.T) {
 		clusterScope := PowerVSClusterScope{
 			IBMVPCClient: mockVPC,
 			IBMPowerVSCluster: &infrav1beta2.IBMPowerVSCluster{
-				ObjectMeta: metav1.ObjectMeta{Name: "ClusterName"},
+				ObjectMeta: metav1.ObjectMeta{Name: "clustername"},
 				Spec: infrav1beta2.IBMPowerVSClusterSpec{
 					VPC:        &infrav1beta2.VPCResourceReference{Region: ptr.To("eu-de")},
 					VPCSubnets: []infrav1beta2.Subnet{{Zone: ptr.To("eu-de-1")}},
@@ -5491,82 +5783,798 @@ func TestDeleteDHCPServer(t *testing.T) {
 	})
 }
 
-func TestDeleteTransitGatewayConnections(t *testing.T) {
+func TestReconcileSSHKey(t *testing.T) {
 	var (
-		mockTransitGateway *tgmock.MockTransitGateway
-		mockCtrl           *gomock.Controller
+		mockPowerVS *mockP.MockPowerVS
+		mockCtrl    *gomock.Controller
 	)
 	setup := func(t *testing.T) {
 		t.Helper()
 		mockCtrl = gomock.NewController(t)
-		mockTransitGateway = tgmock.NewMockTransitGateway(mockCtrl)
+		mockPowerVS = mockP.NewMockPowerVS(mockCtrl)
 	}
 	teardown := func() {
 		mockCtrl.Finish()
 	}
-	t.Run("When PowerVS connection of transit gateway is in deleting state", func(t *testing.T) {
+	newClusterScope := func(sshKey *infrav1beta2.PowerVSSSHKey) *PowerVSClusterScope {
+		return &PowerVSClusterScope{
+			IBMPowerVSCluster: &infrav1beta2.IBMPowerVSCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "capi-cluster"},
+				Spec:       infrav1beta2.IBMPowerVSClusterSpec{SSHKey: sshKey},
+			},
+			IBMPowerVSClient: mockPowerVS,
+		}
+	}
+	t.Run("When SSH key is not set", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 
-		clusterScope := PowerVSClusterScope{
-			IBMPowerVSCluster: &infrav1beta2.IBMPowerVSCluster{
-				Status: infrav1beta2.IBMPowerVSClusterStatus{
-					TransitGateway: &infrav1beta2.TransitGatewayStatus{
-						PowerVSConnection: &infrav1beta2.ResourceReference{
-							ControllerCreated: ptr.To(true),
-						},
-					},
-				},
-			},
-			TransitGatewayClient: mockTransitGateway,
-		}
-		tgResponse := &tgapiv1.TransitGatewayConnectionCust{Status: ptr.To(string(infrav1beta2.TransitGatewayConnectionStateDeleting))}
-		tg := &tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID")}
-		mockTransitGateway.EXPECT().GetTransitGatewayConnection(gomock.Any()).Return(tgResponse, &core.DetailedResponse{StatusCode: 200}, nil)
-		requeue, err := clusterScope.deleteTransitGatewayConnections(tg)
-		g.Expect(err).To(BeNil())
-		g.Expect(requeue).To(BeTrue())
+		clusterScope := newClusterScope(nil)
+		g.Expect(clusterScope.ReconcileSSHKey()).To(Succeed())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.SSHKey).To(BeNil())
 	})
+	t.Run("When SSH key exists in the Power VS tenant", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
 
-	t.Run("When DeleteTransitGatewayConnection for PowerVS connection returns error", func(t *testing.T) {
+		clusterScope := newClusterScope(&infrav1beta2.PowerVSSSHKey{Name: ptr.To("break-glass")})
+		mockPowerVS.EXPECT().GetSSHKey("break-glass").Return(&models.SSHKey{Name: ptr.To("break-glass"), SSHKey: ptr.To("ssh-rsa AAAA")}, nil)
+		g.Expect(clusterScope.ReconcileSSHKey()).To(Succeed())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.SSHKey).To(Equal(&infrav1beta2.ResourceReference{ID: ptr.To("break-glass"), ControllerCreated: ptr.To(false)}))
+	})
+	t.Run("When SSH key created by the controller is found", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 
-		clusterScope := PowerVSClusterScope{
-			IBMPowerVSCluster: &infrav1beta2.IBMPowerVSCluster{
-				Status: infrav1beta2.IBMPowerVSClusterStatus{
-					TransitGateway: &infrav1beta2.TransitGatewayStatus{
-						PowerVSConnection: &infrav1beta2.ResourceReference{
-							ControllerCreated: ptr.To(true),
-						},
-					},
-				},
-			},
-			TransitGatewayClient: mockTransitGateway,
-		}
-		tgResponse := &tgapiv1.TransitGatewayConnectionCust{Status: ptr.To(string(infrav1beta2.TransitGatewayConnectionStateAttached))}
-		tg := &tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID")}
-		mockTransitGateway.EXPECT().GetTransitGatewayConnection(gomock.Any()).Return(tgResponse, &core.DetailedResponse{StatusCode: 200}, nil)
-		mockTransitGateway.EXPECT().DeleteTransitGatewayConnection(gomock.Any()).Return(nil, fmt.Errorf("error deleting transit gateway connection"))
-		requeue, err := clusterScope.deleteTransitGatewayConnections(tg)
-		g.Expect(err.Error()).To(Equal("failed to delete transit gateway connection: error deleting transit gateway connection"))
-		g.Expect(requeue).To(BeFalse())
+		clusterScope := newClusterScope(&infrav1beta2.PowerVSSSHKey{PublicKey: ptr.To("ssh-rsa AAAA")})
+		clusterScope.IBMPowerVSCluster.Status.SSHKey = &infrav1beta2.ResourceReference{ID: ptr.To("capi-cluster-ssh-key"), ControllerCreated: ptr.To(true)}
+		mockPowerVS.EXPECT().GetSSHKey("capi-cluster-ssh-key").Return(&models.SSHKey{Name: ptr.To("capi-cluster-ssh-key"), SSHKey: ptr.To("ssh-rsa AAAA")}, nil)
+		g.Expect(clusterScope.ReconcileSSHKey()).To(Succeed())
+		g.Expect(*clusterScope.IBMPowerVSCluster.Status.SSHKey.ControllerCreated).To(BeTrue())
 	})
+	t.Run("When SSH key created by the controller is renamed to an existing key", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
 
-	t.Run("When DeleteTransitGatewayConnection for PowerVS connection succeeds", func(t *testing.T) {
+		clusterScope := newClusterScope(&infrav1beta2.PowerVSSSHKey{Name: ptr.To("break-glass")})
+		clusterScope.IBMPowerVSCluster.Status.SSHKey = &infrav1beta2.ResourceReference{ID: ptr.To("capi-cluster-ssh-key"), ControllerCreated: ptr.To(true)}
+		mockPowerVS.EXPECT().DeleteSSHKey("capi-cluster-ssh-key").Return(nil)
+		mockPowerVS.EXPECT().GetSSHKey("break-glass").Return(&models.SSHKey{Name: ptr.To("break-glass"), SSHKey: ptr.To("ssh-rsa AAAA")}, nil)
+		g.Expect(clusterScope.ReconcileSSHKey()).To(Succeed())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.SSHKey).To(Equal(&infrav1beta2.ResourceReference{ID: ptr.To("break-glass"), ControllerCreated: ptr.To(false)}))
+	})
+	t.Run("When existing SSH key is replaced by a key created by the controller", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 
-		clusterScope := PowerVSClusterScope{
-			IBMPowerVSCluster: &infrav1beta2.IBMPowerVSCluster{
-				Status: infrav1beta2.IBMPowerVSClusterStatus{
-					TransitGateway: &infrav1beta2.TransitGatewayStatus{
-						PowerVSConnection: &infrav1beta2.ResourceReference{
-							ControllerCreated: ptr.To(true),
-						},
+		clusterScope := newClusterScope(&infrav1beta2.PowerVSSSHKey{PublicKey: ptr.To("ssh-rsa AAAA")})
+		clusterScope.IBMPowerVSCluster.Status.SSHKey = &infrav1beta2.ResourceReference{ID: ptr.To("break-glass"), ControllerCreated: ptr.To(false)}
+		mockPowerVS.EXPECT().GetSSHKey("capi-cluster-ssh-key").Return(nil, fmt.Errorf("failed to Get PI Key capi-cluster-ssh-key with error %w", &p_cloud_tenants_ssh_keys.PcloudTenantsSshkeysGetNotFound{}))
+		mockPowerVS.EXPECT().CreateSSHKey(&models.SSHKey{Name: ptr.To("capi-cluster-ssh-key"), SSHKey: ptr.To("ssh-rsa AAAA")}).Return(&models.SSHKey{}, nil)
+		g.Expect(clusterScope.ReconcileSSHKey()).To(Succeed())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.SSHKey).To(Equal(&infrav1beta2.ResourceReference{ID: ptr.To("capi-cluster-ssh-key"), ControllerCreated: ptr.To(true)}))
+	})
+	t.Run("When SSH key created by the controller is removed from the spec", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope(nil)
+		clusterScope.IBMPowerVSCluster.Status.SSHKey = &infrav1beta2.ResourceReference{ID: ptr.To("capi-cluster-ssh-key"), ControllerCreated: ptr.To(true)}
+		mockPowerVS.EXPECT().DeleteSSHKey("capi-cluster-ssh-key").Return(nil)
+		g.Expect(clusterScope.ReconcileSSHKey()).To(Succeed())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.SSHKey).To(BeNil())
+	})
+	t.Run("When SSH key does not exist and public key is set", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope(&infrav1beta2.PowerVSSSHKey{PublicKey: ptr.To("ssh-rsa AAAA")})
+		mockPowerVS.EXPECT().GetSSHKey("capi-cluster-ssh-key").Return(nil, fmt.Errorf("failed to Get PI Key capi-cluster-ssh-key with error %w", &p_cloud_tenants_ssh_keys.PcloudTenantsSshkeysGetNotFound{}))
+		mockPowerVS.EXPECT().CreateSSHKey(&models.SSHKey{Name: ptr.To("capi-cluster-ssh-key"), SSHKey: ptr.To("ssh-rsa AAAA")}).Return(&models.SSHKey{}, nil)
+		g.Expect(clusterScope.ReconcileSSHKey()).To(Succeed())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.SSHKey).To(Equal(&infrav1beta2.ResourceReference{ID: ptr.To("capi-cluster-ssh-key"), ControllerCreated: ptr.To(true)}))
+	})
+	t.Run("When SSH key does not exist and public key is not set", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope(&infrav1beta2.PowerVSSSHKey{Name: ptr.To("break-glass")})
+		mockPowerVS.EXPECT().GetSSHKey("break-glass").Return(nil, &p_cloud_tenants_ssh_keys.PcloudTenantsSshkeysGetNotFound{})
+		err := clusterScope.ReconcileSSHKey()
+		g.Expect(err).To(MatchError("SSH key break-glass not found in Power VS tenant and publicKey is not set"))
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.SSHKey).To(BeNil())
+	})
+	t.Run("When GetSSHKey returns error", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope(&infrav1beta2.PowerVSSSHKey{PublicKey: ptr.To("ssh-rsa AAAA")})
+		mockPowerVS.EXPECT().GetSSHKey("capi-cluster-ssh-key").Return(nil, fmt.Errorf("error getting SSH key"))
+		err := clusterScope.ReconcileSSHKey()
+		g.Expect(err).To(MatchError("failed to get SSH key capi-cluster-ssh-key: error getting SSH key"))
+	})
+	t.Run("When CreateSSHKey returns error", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope(&infrav1beta2.PowerVSSSHKey{PublicKey: ptr.To("ssh-rsa AAAA")})
+		mockPowerVS.EXPECT().GetSSHKey("capi-cluster-ssh-key").Return(nil, &p_cloud_tenants_ssh_keys.PcloudTenantsSshkeysGetNotFound{})
+		mockPowerVS.EXPECT().CreateSSHKey(gomock.Any()).Return(nil, fmt.Errorf("error creating SSH key"))
+		err := clusterScope.ReconcileSSHKey()
+		g.Expect(err).To(MatchError("failed to create SSH key capi-cluster-ssh-key: error creating SSH key"))
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.SSHKey).To(BeNil())
+	})
+}
+
+func TestDeleteSSHKey(t *testing.T) {
+	var (
+		mockPowerVS *mockP.MockPowerVS
+		mockCtrl    *gomock.Controller
+	)
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockPowerVS = mockP.NewMockPowerVS(mockCtrl)
+	}
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+	newClusterScope := func(sshKey *infrav1beta2.ResourceReference) *PowerVSClusterScope {
+		return &PowerVSClusterScope{
+			IBMPowerVSCluster: &infrav1beta2.IBMPowerVSCluster{
+				Status: infrav1beta2.IBMPowerVSClusterStatus{SSHKey: sshKey},
+			},
+			IBMPowerVSClient: mockPowerVS,
+		}
+	}
+	t.Run("When SSH key is not created by controller", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope(&infrav1beta2.ResourceReference{ID: ptr.To("break-glass"), ControllerCreated: ptr.To(false)})
+		g.Expect(clusterScope.DeleteSSHKey()).To(Succeed())
+	})
+	t.Run("When SSH key deletion is successful", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope(&infrav1beta2.ResourceReference{ID: ptr.To("capi-cluster-ssh-key"), ControllerCreated: ptr.To(true)})
+		mockPowerVS.EXPECT().DeleteSSHKey("capi-cluster-ssh-key").Return(nil)
+		g.Expect(clusterScope.DeleteSSHKey()).To(Succeed())
+	})
+	t.Run("When the SSH key is not found", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope(&infrav1beta2.ResourceReference{ID: ptr.To("capi-cluster-ssh-key"), ControllerCreated: ptr.To(true)})
+		mockPowerVS.EXPECT().DeleteSSHKey("capi-cluster-ssh-key").Return(fmt.Errorf("failed to Delete PI Key capi-cluster-ssh-key with error %w", &p_cloud_tenants_ssh_keys.PcloudTenantsSshkeysDeleteNotFound{}))
+		g.Expect(clusterScope.DeleteSSHKey()).To(Succeed())
+	})
+	t.Run("When DeleteSSHKey returns error", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope(&infrav1beta2.ResourceReference{ID: ptr.To("capi-cluster-ssh-key"), ControllerCreated: ptr.To(true)})
+		mockPowerVS.EXPECT().DeleteSSHKey("capi-cluster-ssh-key").Return(fmt.Errorf("error deleting SSH key"))
+		err := clusterScope.DeleteSSHKey()
+		g.Expect(err).To(MatchError("failed to delete SSH key capi-cluster-ssh-key: error deleting SSH key"))
+	})
+}
+
+func TestReconcileDNSRecord(t *testing.T) {
+	var (
+		mockDNS  *mockdns.MockDNS
+		mockCtrl *gomock.Controller
+	)
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockDNS = mockdns.NewMockDNS(mockCtrl)
+	}
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+	dnsSpec := &infrav1beta2.DNSSpec{
+		Provider:   infrav1beta2.DNSProviderDNSServices,
+		InstanceID: "instance-id",
+		ZoneID:     "zone-id",
+		Hostname:   "api.capi-cluster.example.com",
+	}
+	newClusterScope := func(dns *infrav1beta2.DNSSpec, record *infrav1beta2.ResourceReference) *PowerVSClusterScope {
+		return &PowerVSClusterScope{
+			IBMPowerVSCluster: &infrav1beta2.IBMPowerVSCluster{
+				Spec:   infrav1beta2.IBMPowerVSClusterSpec{DNS: dns},
+				Status: infrav1beta2.IBMPowerVSClusterStatus{DNSRecord: record},
+			},
+			DNSClient: mockDNS,
+		}
+	}
+	t.Run("When DNS is not set", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope(nil, nil)
+		g.Expect(clusterScope.ReconcileDNSRecord("lb.example.com")).To(Succeed())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.DNSRecord).To(BeNil())
+	})
+	t.Run("When the record does not exist", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope(dnsSpec, nil)
+		mockDNS.EXPECT().GetRecordByName("api.capi-cluster.example.com").Return(nil, nil)
+		mockDNS.EXPECT().CreateRecord(&dns.Record{Name: "api.capi-cluster.example.com", Type: dns.RecordTypeCNAME, Content: "lb.example.com", TTL: 300}).Return(&dns.Record{ID: "record-id"}, nil)
+		g.Expect(clusterScope.ReconcileDNSRecord("lb.example.com")).To(Succeed())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.DNSRecord).To(Equal(&infrav1beta2.ResourceReference{ID: ptr.To("record-id"), ControllerCreated: ptr.To(true)}))
+	})
+	t.Run("When the record exists in the zone", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope(dnsSpec, nil)
+		mockDNS.EXPECT().GetRecordByName("api.capi-cluster.example.com").Return(&dns.Record{ID: "record-id", Name: "api.capi-cluster.example.com.", Type: dns.RecordTypeCNAME, Content: "other-lb.example.com", TTL: 300}, nil)
+		err := clusterScope.ReconcileDNSRecord("lb.example.com")
+		g.Expect(err).To(MatchError(ErrDNSRecordConflict))
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.DNSRecord).To(BeNil())
+	})
+	t.Run("When the record existing in the zone is adopted", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		spec := dnsSpec.DeepCopy()
+		spec.AdoptExistingRecord = true
+		clusterScope := newClusterScope(spec, nil)
+		mockDNS.EXPECT().GetRecordByName("api.capi-cluster.example.com").Return(&dns.Record{ID: "record-id", Name: "api.capi-cluster.example.com.", Type: dns.RecordTypeCNAME, Content: "other-lb.example.com", TTL: 300}, nil)
+		mockDNS.EXPECT().UpdateRecord(&dns.Record{ID: "record-id", Name: "api.capi-cluster.example.com", Type: dns.RecordTypeCNAME, Content: "lb.example.com", TTL: 300}).Return(&dns.Record{ID: "record-id"}, nil)
+		g.Expect(clusterScope.ReconcileDNSRecord("lb.example.com")).To(Succeed())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.DNSRecord).To(Equal(&infrav1beta2.ResourceReference{ID: ptr.To("record-id"), ControllerCreated: ptr.To(false)}))
+	})
+	t.Run("When the type of the adopted record changes", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		spec := dnsSpec.DeepCopy()
+		spec.AdoptExistingRecord = true
+		clusterScope := newClusterScope(spec, &infrav1beta2.ResourceReference{ID: ptr.To("record-id"), ControllerCreated: ptr.To(false)})
+		mockDNS.EXPECT().GetRecord("record-id").Return(&dns.Record{ID: "record-id", Name: "api.capi-cluster.example.com", Type: dns.RecordTypeCNAME, Content: "lb.example.com", TTL: 300}, nil)
+		mockDNS.EXPECT().DeleteRecord("record-id").Return(nil)
+		mockDNS.EXPECT().CreateRecord(&dns.Record{Name: "api.capi-cluster.example.com", Type: dns.RecordTypeA, Content: "192.168.10.10", TTL: 300}).Return(&dns.Record{ID: "new-record-id"}, nil)
+		g.Expect(clusterScope.ReconcileDNSRecord("192.168.10.10")).To(Succeed())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.DNSRecord).To(Equal(&infrav1beta2.ResourceReference{ID: ptr.To("new-record-id"), ControllerCreated: ptr.To(true)}))
+	})
+	t.Run("When the load balancer of the record is recreated", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope(dnsSpec, &infrav1beta2.ResourceReference{ID: ptr.To("record-id"), ControllerCreated: ptr.To(true)})
+		mockDNS.EXPECT().GetRecord("record-id").Return(&dns.Record{ID: "record-id", Name: "api.capi-cluster.example.com", Type: dns.RecordTypeCNAME, Content: "old-lb.example.com", TTL: 300}, nil)
+		mockDNS.EXPECT().UpdateRecord(&dns.Record{ID: "record-id", Name: "api.capi-cluster.example.com", Type: dns.RecordTypeCNAME, Content: "lb.example.com", TTL: 300}).Return(&dns.Record{ID: "record-id"}, nil)
+		g.Expect(clusterScope.ReconcileDNSRecord("lb.example.com")).To(Succeed())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.DNSRecord).To(Equal(&infrav1beta2.ResourceReference{ID: ptr.To("record-id"), ControllerCreated: ptr.To(true)}))
+	})
+	t.Run("When the record type changes", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		spec := dnsSpec.DeepCopy()
+		spec.TTL = ptr.To[int64](120)
+		clusterScope := newClusterScope(spec, &infrav1beta2.ResourceReference{ID: ptr.To("record-id"), ControllerCreated: ptr.To(true)})
+		mockDNS.EXPECT().GetRecord("record-id").Return(&dns.Record{ID: "record-id", Name: "api.capi-cluster.example.com", Type: dns.RecordTypeCNAME, Content: "lb.example.com", TTL: 120}, nil)
+		mockDNS.EXPECT().DeleteRecord("record-id").Return(nil)
+		mockDNS.EXPECT().CreateRecord(&dns.Record{Name: "api.capi-cluster.example.com", Type: dns.RecordTypeA, Content: "192.168.10.10", TTL: 120}).Return(&dns.Record{ID: "new-record-id"}, nil)
+		g.Expect(clusterScope.ReconcileDNSRecord("192.168.10.10")).To(Succeed())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.DNSRecord).To(Equal(&infrav1beta2.ResourceReference{ID: ptr.To("new-record-id"), ControllerCreated: ptr.To(true)}))
+	})
+	t.Run("When the record in status is not found", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope(dnsSpec, &infrav1beta2.ResourceReference{ID: ptr.To("record-id"), ControllerCreated: ptr.To(true)})
+		mockDNS.EXPECT().GetRecord("record-id").Return(nil, nil)
+		mockDNS.EXPECT().GetRecordByName("api.capi-cluster.example.com").Return(nil, nil)
+		mockDNS.EXPECT().CreateRecord(gomock.Any()).Return(&dns.Record{ID: "new-record-id"}, nil)
+		g.Expect(clusterScope.ReconcileDNSRecord("lb.example.com")).To(Succeed())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.DNSRecord).To(Equal(&infrav1beta2.ResourceReference{ID: ptr.To("new-record-id"), ControllerCreated: ptr.To(true)}))
+	})
+	t.Run("When CreateRecord returns error", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope(dnsSpec, nil)
+		mockDNS.EXPECT().GetRecordByName("api.capi-cluster.example.com").Return(nil, nil)
+		mockDNS.EXPECT().CreateRecord(gomock.Any()).Return(nil, fmt.Errorf("error creating DNS record"))
+		err := clusterScope.ReconcileDNSRecord("lb.example.com")
+		g.Expect(err).To(MatchError("failed to reconcile DNS record api.capi-cluster.example.com: error creating DNS record"))
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.DNSRecord).To(BeNil())
+	})
+}
+
+func TestDeleteDNSRecord(t *testing.T) {
+	var (
+		mockDNS  *mockdns.MockDNS
+		mockCtrl *gomock.Controller
+	)
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockDNS = mockdns.NewMockDNS(mockCtrl)
+	}
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+	newClusterScope := func(record *infrav1beta2.ResourceReference) *PowerVSClusterScope {
+		return &PowerVSClusterScope{
+			IBMPowerVSCluster: &infrav1beta2.IBMPowerVSCluster{
+				Spec:   infrav1beta2.IBMPowerVSClusterSpec{DNS: &infrav1beta2.DNSSpec{Hostname: "api.capi-cluster.example.com"}},
+				Status: infrav1beta2.IBMPowerVSClusterStatus{DNSRecord: record},
+			},
+			DNSClient: mockDNS,
+		}
+	}
+	t.Run("When the record is not created by controller", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope(&infrav1beta2.ResourceReference{ID: ptr.To("record-id"), ControllerCreated: ptr.To(false)})
+		g.Expect(clusterScope.DeleteDNSRecord()).To(Succeed())
+	})
+	t.Run("When the record deletion is successful", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope(&infrav1beta2.ResourceReference{ID: ptr.To("record-id"), ControllerCreated: ptr.To(true)})
+		mockDNS.EXPECT().DeleteRecord("record-id").Return(nil)
+		g.Expect(clusterScope.DeleteDNSRecord()).To(Succeed())
+	})
+	t.Run("When DeleteRecord returns error", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope(&infrav1beta2.ResourceReference{ID: ptr.To("record-id"), ControllerCreated: ptr.To(true)})
+		mockDNS.EXPECT().DeleteRecord("record-id").Return(fmt.Errorf("error deleting DNS record"))
+		err := clusterScope.DeleteDNSRecord()
+		g.Expect(err).To(MatchError("failed to delete DNS record api.capi-cluster.example.com: error deleting DNS record"))
+	})
+}
+
+func TestReconcilePowerVSClusterTags(t *testing.T) {
+	var (
+		mockPowerVS       *mockP.MockPowerVS
+		mockVPC           *mock.MockVpc
+		mockGlobalTagging *mockgt.MockGlobalTagging
+		mockCtrl          *gomock.Controller
+	)
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockPowerVS = mockP.NewMockPowerVS(mockCtrl)
+		mockVPC = mock.NewMockVpc(mockCtrl)
+		mockGlobalTagging = mockgt.NewMockGlobalTagging(mockCtrl)
+	}
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+	newClusterScope := func(tags *infrav1beta2.ResourceTags) *PowerVSClusterScope {
+		return &PowerVSClusterScope{
+			Logger:  klog.Background(),
+			Cluster: &capiv1beta1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "capi-cluster", Namespace: "default"}},
+			IBMPowerVSCluster: &infrav1beta2.IBMPowerVSCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "capi-powervs-cluster", Namespace: "default"},
+				Spec:       infrav1beta2.IBMPowerVSClusterSpec{Tags: tags},
+				Status: infrav1beta2.IBMPowerVSClusterStatus{
+					Network: &infrav1beta2.ResourceReference{ID: ptr.To("networkID"), ControllerCreated: ptr.To(false)},
+					VPCSubnet: map[string]infrav1beta2.ResourceReference{
+						"capi-cluster-vpcsubnet-us-south-1": {ID: ptr.To("subnetID"), ControllerCreated: ptr.To(true)},
+					},
+					LoadBalancers: map[string]infrav1beta2.VPCLoadBalancerStatus{
+						"capi-cluster-loadbalancer": {ID: ptr.To("loadBalancerID")},
+					},
+				},
+			},
+			IBMPowerVSClient:    mockPowerVS,
+			IBMVPCClient:        mockVPC,
+			GlobalTaggingClient: mockGlobalTagging,
+		}
+	}
+	t.Run("When global tagging client is not set", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope(nil)
+		clusterScope.GlobalTaggingClient = nil
+		g.Expect(clusterScope.ReconcileTags()).To(Succeed())
+	})
+	t.Run("When the tags of the resources created by the controller are reconciled", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope(&infrav1beta2.ResourceTags{UserTags: []string{"env:prod"}})
+		mockVPC.EXPECT().GetSubnet(gomock.Any()).Return(&vpcv1.Subnet{CRN: ptr.To("subnetCRN")}, nil, nil)
+		mockGlobalTagging.EXPECT().GetAttachedTags("subnetCRN").Return([]string{"capibm-cluster:default_capi-cluster", "env:dev", "cmdb:ci-1234"}, nil)
+		mockGlobalTagging.EXPECT().GetTagByName("env:prod").Return(&globaltaggingv1.Tag{Name: ptr.To("env:prod")}, nil)
+		mockGlobalTagging.EXPECT().AttachTag(gomock.Any()).Return(nil, nil, nil)
+		mockGlobalTagging.EXPECT().DetachTag(gomock.Any()).DoAndReturn(func(options *globaltaggingv1.DetachTagOptions) (*globaltaggingv1.TagResults, *core.DetailedResponse, error) {
+			g.Expect(options.TagNames).To(Equal([]string{"env:dev"}))
+			return nil, nil, nil
+		})
+		g.Expect(clusterScope.ReconcileTags()).To(Succeed())
+	})
+	t.Run("When the tags attached by other systems are pruned", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope(&infrav1beta2.ResourceTags{Prune: true})
+		mockVPC.EXPECT().GetSubnet(gomock.Any()).Return(&vpcv1.Subnet{CRN: ptr.To("subnetCRN")}, nil, nil)
+		mockGlobalTagging.EXPECT().GetAttachedTags("subnetCRN").Return([]string{"capibm-cluster:default_capi-cluster", "cmdb:ci-1234"}, nil)
+		mockGlobalTagging.EXPECT().DetachTag(gomock.Any()).DoAndReturn(func(options *globaltaggingv1.DetachTagOptions) (*globaltaggingv1.TagResults, *core.DetailedResponse, error) {
+			g.Expect(options.TagNames).To(Equal([]string{"cmdb:ci-1234"}))
+			return nil, nil, nil
+		})
+		g.Expect(clusterScope.ReconcileTags()).To(Succeed())
+	})
+	t.Run("When the attached tags can't be listed", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope(nil)
+		mockVPC.EXPECT().GetSubnet(gomock.Any()).Return(&vpcv1.Subnet{CRN: ptr.To("subnetCRN")}, nil, nil)
+		mockGlobalTagging.EXPECT().GetAttachedTags("subnetCRN").Return(nil, errors.New("failed to list tags"))
+		g.Expect(clusterScope.ReconcileTags()).ToNot(Succeed())
+	})
+}
+
+func TestDeleteOrphanTaggedResources(t *testing.T) {
+	var (
+		mockPowerVS      *mockP.MockPowerVS
+		mockVPC          *mock.MockVpc
+		mockGlobalSearch *mockgs.MockGlobalSearch
+		mockCtrl         *gomock.Controller
+	)
+	instanceCRN := func(id string) string {
+		return fmt.Sprintf("crn:v1:bluemix:public:power-iaas:dal10:a/accountID:serviceInstanceID:pvm-instance:%s", id)
+	}
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockPowerVS = mockP.NewMockPowerVS(mockCtrl)
+		mockVPC = mock.NewMockVpc(mockCtrl)
+		mockGlobalSearch = mockgs.NewMockGlobalSearch(mockCtrl)
+	}
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+	newClusterScope := func(objects ...client.Object) *PowerVSClusterScope {
+		scheme := runtime.NewScheme()
+		_ = infrav1beta2.AddToScheme(scheme)
+		return &PowerVSClusterScope{
+			Client:  fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build(),
+			Logger:  klog.Background(),
+			Cluster: &capiv1beta1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "capi-cluster", Namespace: "default"}},
+			IBMPowerVSCluster: &infrav1beta2.IBMPowerVSCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "capi-powervs-cluster", Namespace: "default", UID: "clusterUID"},
+				Status: infrav1beta2.IBMPowerVSClusterStatus{
+					ServiceInstance: &infrav1beta2.ResourceReference{ID: ptr.To("serviceInstanceID"), ControllerCreated: ptr.To(false)},
+					VPC:             &infrav1beta2.ResourceReference{ID: ptr.To("vpcID")},
+					LoadBalancers: map[string]infrav1beta2.VPCLoadBalancerStatus{
+						"capi-cluster-loadbalancer": {ID: ptr.To("loadBalancerID")},
+					},
+				},
+			},
+			IBMPowerVSClient:   mockPowerVS,
+			IBMVPCClient:       mockVPC,
+			GlobalSearchClient: mockGlobalSearch,
+		}
+	}
+	t.Run("When global search client is not set", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope()
+		clusterScope.GlobalSearchClient = nil
+		requeue, err := clusterScope.DeleteOrphanTaggedResources()
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeFalse())
+	})
+	t.Run("When the UID of the cluster is not known", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope()
+		clusterScope.IBMPowerVSCluster.UID = ""
+		requeue, err := clusterScope.DeleteOrphanTaggedResources()
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeFalse())
+	})
+	t.Run("When no resource carries the cluster UID tag", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope()
+		mockGlobalSearch.EXPECT().GetResourcesByTag("capibm-cluster-uid:clusterUID").Return(nil, nil)
+		requeue, err := clusterScope.DeleteOrphanTaggedResources()
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeFalse())
+	})
+	t.Run("When searching the resources carrying the cluster tag fails", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope()
+		mockGlobalSearch.EXPECT().GetResourcesByTag(gomock.Any()).Return(nil, errors.New("failed to search resources"))
+		requeue, err := clusterScope.DeleteOrphanTaggedResources()
+		g.Expect(err).ToNot(BeNil())
+		g.Expect(requeue).To(BeFalse())
+	})
+	t.Run("When an orphan instance carries the cluster tag", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		machine := &infrav1beta2.IBMPowerVSMachine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "capi-machine",
+				Namespace: "default",
+				Labels:    map[string]string{capiv1beta1.ClusterNameLabel: "capi-cluster"},
+			},
+			Status: infrav1beta2.IBMPowerVSMachineStatus{InstanceID: "trackedInstanceID"},
+		}
+		clusterScope := newClusterScope(machine)
+		mockGlobalSearch.EXPECT().GetResourcesByTag(gomock.Any()).Return([]string{
+			instanceCRN("trackedInstanceID"),
+			instanceCRN("orphanInstanceID"),
+			instanceCRN("deletedInstanceID"),
+			"crn:v1:bluemix:public:is:us-south:a/accountID::load-balancer:loadBalancerID",
+		}, nil)
+		mockPowerVS.EXPECT().GetAllInstance().Return(&models.PVMInstances{PvmInstances: []*models.PVMInstanceReference{
+			{PvmInstanceID: ptr.To("trackedInstanceID"), Status: ptr.To("ACTIVE")},
+			{PvmInstanceID: ptr.To("orphanInstanceID"), Status: ptr.To("ACTIVE")},
+		}}, nil)
+		mockPowerVS.EXPECT().GetAllVolumes().Return(&models.Volumes{}, nil)
+		mockPowerVS.EXPECT().GetAllImage().Return(&models.Images{}, nil)
+		mockPowerVS.EXPECT().DeleteInstance("orphanInstanceID").Return(nil)
+		requeue, err := clusterScope.DeleteOrphanTaggedResources()
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
+	})
+	t.Run("When an orphan volume carries the cluster tag while an orphan instance is being deleted", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope()
+		mockGlobalSearch.EXPECT().GetResourcesByTag(gomock.Any()).Return([]string{
+			instanceCRN("orphanInstanceID"),
+			"crn:v1:bluemix:public:power-iaas:dal10:a/accountID:serviceInstanceID:volume:orphanVolumeID",
+		}, nil)
+		mockPowerVS.EXPECT().GetAllInstance().Return(&models.PVMInstances{PvmInstances: []*models.PVMInstanceReference{
+			{PvmInstanceID: ptr.To("orphanInstanceID"), Status: ptr.To("DELETING")},
+		}}, nil)
+		mockPowerVS.EXPECT().GetAllVolumes().Return(&models.Volumes{Volumes: []*models.VolumeReference{
+			{VolumeID: ptr.To("orphanVolumeID"), State: ptr.To("in-use")},
+		}}, nil)
+		mockPowerVS.EXPECT().GetAllImage().Return(&models.Images{}, nil)
+		requeue, err := clusterScope.DeleteOrphanTaggedResources()
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
+	})
+	t.Run("When images of IBMPowerVSImages carry the cluster tag", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		imported := &infrav1beta2.IBMPowerVSImage{
+			ObjectMeta: metav1.ObjectMeta{Name: "imported-image", Namespace: "default"},
+			Status:     infrav1beta2.IBMPowerVSImageStatus{ImageID: "importedImageID"},
+		}
+		importing := &infrav1beta2.IBMPowerVSImage{ObjectMeta: metav1.ObjectMeta{Name: "importing-image", Namespace: "default"}}
+		clusterScope := newClusterScope(imported, importing)
+		imageCRN := func(id string) string {
+			return fmt.Sprintf("crn:v1:bluemix:public:power-iaas:dal10:a/accountID:serviceInstanceID:image:%s", id)
+		}
+		mockGlobalSearch.EXPECT().GetResourcesByTag(gomock.Any()).Return([]string{
+			imageCRN("importedImageID"),
+			imageCRN("importingImageID"),
+			imageCRN("orphanImageID"),
+		}, nil)
+		mockPowerVS.EXPECT().GetAllInstance().Return(&models.PVMInstances{}, nil)
+		mockPowerVS.EXPECT().GetAllVolumes().Return(&models.Volumes{}, nil)
+		mockPowerVS.EXPECT().GetAllImage().Return(&models.Images{Images: []*models.ImageReference{
+			{ImageID: ptr.To("importedImageID"), Name: ptr.To("imported-image"), State: ptr.To("active")},
+			{ImageID: ptr.To("importingImageID"), Name: ptr.To("importing-image"), State: ptr.To("queued")},
+			{ImageID: ptr.To("orphanImageID"), Name: ptr.To("orphan-image"), State: ptr.To("active")},
+		}}, nil)
+		mockPowerVS.EXPECT().DeleteImage("orphanImageID").Return(nil)
+		requeue, err := clusterScope.DeleteOrphanTaggedResources()
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
+	})
+	t.Run("When an orphan subnet carries the cluster tag", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope()
+		mockGlobalSearch.EXPECT().GetResourcesByTag(gomock.Any()).Return([]string{
+			"crn:v1:bluemix:public:is:us-south-1:a/accountID::subnet:orphanSubnetID",
+			"crn:v1:bluemix:public:is:us-south-1:a/accountID::subnet:deletedSubnetID",
+			"crn:v1:bluemix:public:is:us-south-1:a/accountID::subnet:otherVPCSubnetID",
+		}, nil)
+		mockVPC.EXPECT().GetSubnet(&vpcv1.GetSubnetOptions{ID: ptr.To("orphanSubnetID")}).Return(&vpcv1.Subnet{Status: ptr.To("available"), VPC: &vpcv1.VPCReference{ID: ptr.To("vpcID")}}, &core.DetailedResponse{}, nil)
+		mockVPC.EXPECT().GetSubnet(&vpcv1.GetSubnetOptions{ID: ptr.To("deletedSubnetID")}).Return(nil, &core.DetailedResponse{StatusCode: ResourceNotFoundCode}, errors.New("subnet not found"))
+		mockVPC.EXPECT().GetSubnet(&vpcv1.GetSubnetOptions{ID: ptr.To("otherVPCSubnetID")}).Return(&vpcv1.Subnet{Status: ptr.To("available"), VPC: &vpcv1.VPCReference{ID: ptr.To("otherVPCID")}}, &core.DetailedResponse{}, nil)
+		mockVPC.EXPECT().DeleteSubnet(&vpcv1.DeleteSubnetOptions{ID: ptr.To("orphanSubnetID")}).Return(&core.DetailedResponse{}, nil)
+		requeue, err := clusterScope.DeleteOrphanTaggedResources()
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
+	})
+	t.Run("When orphan load balancers carry the cluster UID tag", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope()
+		mockGlobalSearch.EXPECT().GetResourcesByTag(gomock.Any()).Return([]string{
+			"crn:v1:bluemix:public:is:us-south:a/accountID::load-balancer:orphanLoadBalancerID",
+			"crn:v1:bluemix:public:is:us-south:a/accountID::load-balancer:otherVPCLoadBalancerID",
+		}, nil)
+		mockVPC.EXPECT().GetLoadBalancer(&vpcv1.GetLoadBalancerOptions{ID: ptr.To("orphanLoadBalancerID")}).Return(&vpcv1.LoadBalancer{
+			ProvisioningStatus: ptr.To("active"),
+			Subnets:            []vpcv1.SubnetReference{{ID: ptr.To("subnetID")}},
+		}, &core.DetailedResponse{}, nil)
+		mockVPC.EXPECT().GetSubnet(&vpcv1.GetSubnetOptions{ID: ptr.To("subnetID")}).Return(&vpcv1.Subnet{VPC: &vpcv1.VPCReference{ID: ptr.To("vpcID")}}, &core.DetailedResponse{}, nil)
+		mockVPC.EXPECT().GetLoadBalancer(&vpcv1.GetLoadBalancerOptions{ID: ptr.To("otherVPCLoadBalancerID")}).Return(&vpcv1.LoadBalancer{
+			ProvisioningStatus: ptr.To("active"),
+			Subnets:            []vpcv1.SubnetReference{{ID: ptr.To("otherVPCSubnetID")}},
+		}, &core.DetailedResponse{}, nil)
+		mockVPC.EXPECT().GetSubnet(&vpcv1.GetSubnetOptions{ID: ptr.To("otherVPCSubnetID")}).Return(&vpcv1.Subnet{VPC: &vpcv1.VPCReference{ID: ptr.To("otherVPCID")}}, &core.DetailedResponse{}, nil)
+		mockVPC.EXPECT().DeleteLoadBalancer(&vpcv1.DeleteLoadBalancerOptions{ID: ptr.To("orphanLoadBalancerID")}).Return(&core.DetailedResponse{}, nil)
+		requeue, err := clusterScope.DeleteOrphanTaggedResources()
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
+	})
+	t.Run("When deleting an orphan resource fails", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope()
+		mockGlobalSearch.EXPECT().GetResourcesByTag(gomock.Any()).Return([]string{
+			"crn:v1:bluemix:public:power-iaas:dal10:a/accountID:serviceInstanceID:image:orphanImageID",
+		}, nil)
+		mockPowerVS.EXPECT().GetAllInstance().Return(&models.PVMInstances{}, nil)
+		mockPowerVS.EXPECT().GetAllVolumes().Return(&models.Volumes{}, nil)
+		mockPowerVS.EXPECT().GetAllImage().Return(&models.Images{Images: []*models.ImageReference{
+			{ImageID: ptr.To("orphanImageID"), State: ptr.To("active")},
+		}}, nil)
+		mockPowerVS.EXPECT().DeleteImage("orphanImageID").Return(errors.New("failed to delete image"))
+		requeue, err := clusterScope.DeleteOrphanTaggedResources()
+		g.Expect(err).ToNot(BeNil())
+		g.Expect(requeue).To(BeFalse())
+	})
+	t.Run("When the workspace is created by controller", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope()
+		clusterScope.IBMPowerVSCluster.Status.ServiceInstance.ControllerCreated = ptr.To(true)
+		mockGlobalSearch.EXPECT().GetResourcesByTag(gomock.Any()).Return([]string{instanceCRN("orphanInstanceID")}, nil)
+		requeue, err := clusterScope.DeleteOrphanTaggedResources()
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeFalse())
+	})
+}
+
+func TestDeleteTransitGatewayConnections(t *testing.T) {
+	var (
+		mockTransitGateway *tgmock.MockTransitGateway
+		mockCtrl           *gomock.Controller
+	)
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockTransitGateway = tgmock.NewMockTransitGateway(mockCtrl)
+	}
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+	t.Run("When PowerVS connection of transit gateway is in deleting state", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := PowerVSClusterScope{
+			IBMPowerVSCluster: &infrav1beta2.IBMPowerVSCluster{
+				Status: infrav1beta2.IBMPowerVSClusterStatus{
+					TransitGateway: &infrav1beta2.TransitGatewayStatus{
+						PowerVSConnection: &infrav1beta2.ResourceReference{
+							ControllerCreated: ptr.To(true),
+						},
+					},
+				},
+			},
+			TransitGatewayClient: mockTransitGateway,
+		}
+		tgResponse := &tgapiv1.TransitGatewayConnectionCust{Status: ptr.To(string(infrav1beta2.TransitGatewayConnectionStateDeleting))}
+		tg := &tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID")}
+		mockTransitGateway.EXPECT().GetTransitGatewayConnection(gomock.Any()).Return(tgResponse, &core.DetailedResponse{StatusCode: 200}, nil)
+		requeue, err := clusterScope.deleteTransitGatewayConnections(tg)
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
+	})
+
+	t.Run("When DeleteTransitGatewayConnection for PowerVS connection returns error", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := PowerVSClusterScope{
+			IBMPowerVSCluster: &infrav1beta2.IBMPowerVSCluster{
+				Status: infrav1beta2.IBMPowerVSClusterStatus{
+					TransitGateway: &infrav1beta2.TransitGatewayStatus{
+						PowerVSConnection: &infrav1beta2.ResourceReference{
+							ControllerCreated: ptr.To(true),
+						},
+					},
+				},
+			},
+			TransitGatewayClient: mockTransitGateway,
+		}
+		tgResponse := &tgapiv1.TransitGatewayConnectionCust{Status: ptr.To(string(infrav1beta2.TransitGatewayConnectionStateAttached))}
+		tg := &tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID")}
+		mockTransitGateway.EXPECT().GetTransitGatewayConnection(gomock.Any()).Return(tgResponse, &core.DetailedResponse{StatusCode: 200}, nil)
+		mockTransitGateway.EXPECT().DeleteTransitGatewayConnection(gomock.Any()).Return(nil, fmt.Errorf("error deleting transit gateway connection"))
+		requeue, err := clusterScope.deleteTransitGatewayConnections(tg)
+		g.Expect(err.Error()).To(Equal("failed to delete transit gateway connection: error deleting transit gateway connection"))
+		g.Expect(requeue).To(BeFalse())
+	})
+
+	t.Run("When DeleteTransitGatewayConnection for PowerVS connection succeeds", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := PowerVSClusterScope{
+			IBMPowerVSCluster: &infrav1beta2.IBMPowerVSCluster{
+				Status: infrav1beta2.IBMPowerVSClusterStatus{
+					TransitGateway: &infrav1beta2.TransitGatewayStatus{
+						PowerVSConnection: &infrav1beta2.ResourceReference{
+							ControllerCreated: ptr.To(true),
+						},
 					},
 				},
 			},
@@ -6900,6 +7908,83 @@ func TestCheckAndUpdateTransitGatewayConnections(t *testing.T) {
 	})
 }
 
+func TestCheckTransitGatewayRoutes(t *testing.T) {
+	var (
+		mockResourceController *mockRC.MockResourceController
+		mockVPC                *mock.MockVpc
+		mockTransitGateway     *tgmock.MockTransitGateway
+		mockCtrl               *gomock.Controller
+	)
+
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockTransitGateway = tgmock.NewMockTransitGateway(mockCtrl)
+		mockVPC = mock.NewMockVpc(mockCtrl)
+		mockResourceController = mockRC.NewMockResourceController(mockCtrl)
+	}
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+	makeScope := func() PowerVSClusterScope {
+		clusterScope := makePowerVSClusterScope(mockTransitGateway, mockVPC, mockResourceController)
+		clusterScope.IBMPowerVSCluster.Status.TransitGateway = &infrav1beta2.TransitGatewayStatus{
+			ID:                ptr.To("transitGatewayID"),
+			PowerVSConnection: &infrav1beta2.ResourceReference{ID: ptr.To("pvs-connID")},
+			VPCConnection:     &infrav1beta2.ResourceReference{ID: ptr.To("vpc-connID")},
+		}
+		return clusterScope
+	}
+	connections := func(vpcStatus, powerVSStatus infrav1beta2.TransitGatewayConnectionState) *tgapiv1.TransitGatewayConnectionCollection {
+		return &tgapiv1.TransitGatewayConnectionCollection{Connections: []tgapiv1.TransitGatewayConnectionCust{
+			{Name: ptr.To("vpc"), ID: ptr.To("vpc-connID"), NetworkType: ptr.To("vpc"), Status: ptr.To(string(vpcStatus))},
+			{Name: ptr.To("pvs"), ID: ptr.To("pvs-connID"), NetworkType: ptr.To("power_virtual_server"), Status: ptr.To(string(powerVSStatus))},
+		}}
+	}
+
+	t.Run("Returns error when the transit gateway is not set in the status", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := makePowerVSClusterScope(mockTransitGateway, mockVPC, mockResourceController)
+		clusterScope.IBMPowerVSCluster.Status.TransitGateway = nil
+
+		g.Expect(clusterScope.CheckTransitGatewayRoutes()).To(MatchError(ContainSubstring("transit gateway is not set")))
+	})
+
+	t.Run("Returns error when the transit gateway is not available", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := makeScope()
+
+		mockTransitGateway.EXPECT().GetTransitGateway(gomock.Any()).Return(&tgapiv1.TransitGateway{Status: ptr.To(string(infrav1beta2.TransitGatewayStatePending))}, nil, nil)
+		g.Expect(clusterScope.CheckTransitGatewayRoutes()).To(MatchError(ContainSubstring("is not available")))
+	})
+
+	t.Run("Returns error when the VPC connection is not attached", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := makeScope()
+
+		mockTransitGateway.EXPECT().GetTransitGateway(gomock.Any()).Return(&tgapiv1.TransitGateway{Status: ptr.To(string(infrav1beta2.TransitGatewayStateAvailable))}, nil, nil)
+		mockTransitGateway.EXPECT().ListTransitGatewayConnections(gomock.Any()).Return(connections(infrav1beta2.TransitGatewayConnectionStateFailed, infrav1beta2.TransitGatewayConnectionStateAttached), nil, nil)
+		g.Expect(clusterScope.CheckTransitGatewayRoutes()).To(MatchError(ContainSubstring("no vpc connection is attached")))
+	})
+
+	t.Run("Returns nil when the transit gateway is available and both connections are attached", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := makeScope()
+
+		mockTransitGateway.EXPECT().GetTransitGateway(gomock.Any()).Return(&tgapiv1.TransitGateway{Status: ptr.To(string(infrav1beta2.TransitGatewayStateAvailable))}, nil, nil)
+		mockTransitGateway.EXPECT().ListTransitGatewayConnections(gomock.Any()).Return(connections(infrav1beta2.TransitGatewayConnectionStateAttached, infrav1beta2.TransitGatewayConnectionStateAttached), nil, nil)
+		g.Expect(clusterScope.CheckTransitGatewayRoutes()).To(Succeed())
+	})
+}
+
 func TestCreateTransitGateway(t *testing.T) {
 	var (
 		mockResourceController *mockRC.MockResourceController
@@ -9558,3 +10643,322 @@ func TestCreateVPCSecurityGroup(t *testing.T) {
 		g.Expect(err).ToNot(BeNil())
 	})
 }
+
+func TestReconcileAddons(t *testing.T) {
+	newClusterScope := func(addons *infrav1beta2.ClusterAddons) *PowerVSClusterScope {
+		cluster := &capiv1beta1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "capi-cluster",
+				Namespace: "default",
+			},
+		}
+		powerVSCluster := &infrav1beta2.IBMPowerVSCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "capi-powervs-cluster",
+				Namespace: "default",
+			},
+			Spec: infrav1beta2.IBMPowerVSClusterSpec{
+				ServiceInstanceID: "serviceInstanceID",
+				Zone:              ptr.To("dal10"),
+				Addons:            addons,
+			},
+		}
+		testScheme := runtime.NewScheme()
+		_ = corev1.AddToScheme(testScheme)
+		_ = infrav1beta2.AddToScheme(testScheme)
+		_ = capiv1beta1.AddToScheme(testScheme)
+		_ = addonsv1beta1.AddToScheme(testScheme)
+		return &PowerVSClusterScope{
+			Client:            fake.NewClientBuilder().WithScheme(testScheme).WithObjects(cluster, powerVSCluster).Build(),
+			Logger:            klog.Background(),
+			Cluster:           cluster,
+			IBMPowerVSCluster: powerVSCluster,
+		}
+	}
+
+	t.Run("When addons are not set", func(t *testing.T) {
+		g := NewWithT(t)
+		clusterScope := newClusterScope(nil)
+		g.Expect(clusterScope.ReconcileAddons()).To(Succeed())
+		crsList := &addonsv1beta1.ClusterResourceSetList{}
+		g.Expect(clusterScope.Client.List(context.TODO(), crsList)).To(Succeed())
+		g.Expect(crsList.Items).To(BeEmpty())
+	})
+	t.Run("When calico and CSI driver addons are set", func(t *testing.T) {
+		g := NewWithT(t)
+		clusterScope := newClusterScope(&infrav1beta2.ClusterAddons{
+			Calico: &infrav1beta2.CalicoAddonSpec{
+				AddonSpec: infrav1beta2.AddonSpec{
+					Resources: []infrav1beta2.AddonResourceReference{{Kind: "ConfigMap", Name: "calico"}},
+				},
+			},
+			CSIDriver: &infrav1beta2.AddonSpec{
+				Resources: []infrav1beta2.AddonResourceReference{{Kind: "Secret", Name: "csi-driver"}},
+			},
+		})
+		g.Expect(clusterScope.ReconcileAddons()).To(Succeed())
+
+		crs := &addonsv1beta1.ClusterResourceSet{}
+		g.Expect(clusterScope.Client.Get(context.TODO(), client.ObjectKey{Namespace: "default", Name: "capi-powervs-cluster-addons"}, crs)).To(Succeed())
+		g.Expect(crs.Spec.Strategy).To(Equal(string(addonsv1beta1.ClusterResourceSetStrategyApplyOnce)))
+		g.Expect(crs.Spec.ClusterSelector.MatchLabels).To(HaveKeyWithValue(infrav1beta2.ClusterAddonsLabel, "capi-powervs-cluster"))
+		g.Expect(crs.Spec.Resources).To(Equal([]addonsv1beta1.ResourceRef{
+			{Kind: "ConfigMap", Name: "capi-powervs-cluster-calico-config"},
+			{Kind: "ConfigMap", Name: "calico"},
+			{Kind: "Secret", Name: "csi-driver"},
+		}))
+
+		cm := &corev1.ConfigMap{}
+		g.Expect(clusterScope.Client.Get(context.TODO(), client.ObjectKey{Namespace: "default", Name: "capi-powervs-cluster-calico-config"}, cm)).To(Succeed())
+		g.Expect(cm.Data["calico-config.yaml"]).To(ContainSubstring(`veth_mtu: "1400"`))
+
+		cluster := &capiv1beta1.Cluster{}
+		g.Expect(clusterScope.Client.Get(context.TODO(), client.ObjectKey{Namespace: "default", Name: "capi-cluster"}, cluster)).To(Succeed())
+		g.Expect(cluster.Labels).To(HaveKeyWithValue(infrav1beta2.ClusterAddonsLabel, "capi-powervs-cluster"))
+	})
+	t.Run("When calico addon is set and the Power VS network reports its MTU", func(t *testing.T) {
+		g := NewWithT(t)
+		mockCtrl := gomock.NewController(t)
+		mockPowerVS := mockP.NewMockPowerVS(mockCtrl)
+		clusterScope := newClusterScope(&infrav1beta2.ClusterAddons{
+			Calico: &infrav1beta2.CalicoAddonSpec{},
+		})
+		clusterScope.IBMPowerVSClient = mockPowerVS
+		clusterScope.IBMPowerVSCluster.Status.Network = &infrav1beta2.ResourceReference{ID: ptr.To("networkID")}
+		mockPowerVS.EXPECT().GetNetworkByID("networkID").Return(&models.Network{Mtu: ptr.To[int64](9000)}, nil)
+		g.Expect(clusterScope.ReconcileAddons()).To(Succeed())
+
+		cm := &corev1.ConfigMap{}
+		g.Expect(clusterScope.Client.Get(context.TODO(), client.ObjectKey{Namespace: "default", Name: "capi-powervs-cluster-calico-config"}, cm)).To(Succeed())
+		g.Expect(cm.Data["calico-config.yaml"]).To(ContainSubstring(`veth_mtu: "8950"`))
+	})
+	t.Run("When calico addon is set and the Power VS network does not report its MTU", func(t *testing.T) {
+		g := NewWithT(t)
+		mockCtrl := gomock.NewController(t)
+		mockPowerVS := mockP.NewMockPowerVS(mockCtrl)
+		clusterScope := newClusterScope(&infrav1beta2.ClusterAddons{
+			Calico: &infrav1beta2.CalicoAddonSpec{},
+		})
+		clusterScope.IBMPowerVSClient = mockPowerVS
+		clusterScope.IBMPowerVSCluster.Status.Network = &infrav1beta2.ResourceReference{ID: ptr.To("networkID")}
+		mockPowerVS.EXPECT().GetNetworkByID("networkID").Return(&models.Network{}, nil)
+		g.Expect(clusterScope.ReconcileAddons()).To(Succeed())
+
+		cm := &corev1.ConfigMap{}
+		g.Expect(clusterScope.Client.Get(context.TODO(), client.ObjectKey{Namespace: "default", Name: "capi-powervs-cluster-calico-config"}, cm)).To(Succeed())
+		g.Expect(cm.Data["calico-config.yaml"]).To(ContainSubstring(`veth_mtu: "1400"`))
+	})
+	t.Run("When calico addon is set and GetNetworkByID returns error", func(t *testing.T) {
+		g := NewWithT(t)
+		mockCtrl := gomock.NewController(t)
+		mockPowerVS := mockP.NewMockPowerVS(mockCtrl)
+		clusterScope := newClusterScope(&infrav1beta2.ClusterAddons{
+			Calico: &infrav1beta2.CalicoAddonSpec{},
+		})
+		clusterScope.IBMPowerVSClient = mockPowerVS
+		clusterScope.IBMPowerVSCluster.Status.Network = &infrav1beta2.ResourceReference{ID: ptr.To("networkID")}
+		mockPowerVS.EXPECT().GetNetworkByID("networkID").Return(nil, fmt.Errorf("GetNetworkByID error"))
+		g.Expect(clusterScope.ReconcileAddons()).ToNot(Succeed())
+	})
+	t.Run("When calico addon MTU is set", func(t *testing.T) {
+		g := NewWithT(t)
+		clusterScope := newClusterScope(&infrav1beta2.ClusterAddons{
+			Calico: &infrav1beta2.CalicoAddonSpec{MTU: ptr.To[int32](1300)},
+		})
+		clusterScope.IBMPowerVSCluster.Status.Network = &infrav1beta2.ResourceReference{ID: ptr.To("networkID")}
+		g.Expect(clusterScope.ReconcileAddons()).To(Succeed())
+
+		cm := &corev1.ConfigMap{}
+		g.Expect(clusterScope.Client.Get(context.TODO(), client.ObjectKey{Namespace: "default", Name: "capi-powervs-cluster-calico-config"}, cm)).To(Succeed())
+		g.Expect(cm.Data["calico-config.yaml"]).To(ContainSubstring(`veth_mtu: "1300"`))
+	})
+	t.Run("When cloud controller manager addon is set", func(t *testing.T) {
+		g := NewWithT(t)
+		getAccountIDFunc := serviceutils.GetAccountIDFunc
+		serviceutils.GetAccountIDFunc = func() (string, error) {
+			return "accountID", nil
+		}
+		t.Cleanup(func() { serviceutils.GetAccountIDFunc = getAccountIDFunc })
+		clusterScope := newClusterScope(&infrav1beta2.ClusterAddons{
+			CloudControllerManager: &infrav1beta2.AddonSpec{},
+			Strategy:               "Reconcile",
+		})
+		g.Expect(clusterScope.ReconcileAddons()).To(Succeed())
+
+		crs := &addonsv1beta1.ClusterResourceSet{}
+		g.Expect(clusterScope.Client.Get(context.TODO(), client.ObjectKey{Namespace: "default", Name: "capi-powervs-cluster-addons"}, crs)).To(Succeed())
+		g.Expect(crs.Spec.Strategy).To(Equal("Reconcile"))
+
+		cm := &corev1.ConfigMap{}
+		g.Expect(clusterScope.Client.Get(context.TODO(), client.ObjectKey{Namespace: "default", Name: "capi-powervs-cluster-ibmpowervs-cfg"}, cm)).To(Succeed())
+		g.Expect(cm.Data["ibmpowervs-cloud-conf.yaml"]).To(ContainSubstring("powerVSCloudInstanceID = serviceInstanceID"))
+		g.Expect(cm.Data["ibmpowervs-cloud-conf.yaml"]).To(ContainSubstring("accountID = accountID"))
+	})
+}
+
+func TestReconcileNetworkRef(t *testing.T) {
+	newClusterScope := func(network *infrav1beta2.IBMPowerVSNetwork) *PowerVSClusterScope {
+		powerVSCluster := &infrav1beta2.IBMPowerVSCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "capi-powervs-cluster",
+				Namespace: "default",
+			},
+			Spec: infrav1beta2.IBMPowerVSClusterSpec{
+				ServiceInstanceID: "serviceInstanceID",
+				NetworkRef:        &corev1.LocalObjectReference{Name: "capi-net"},
+			},
+		}
+		testScheme := runtime.NewScheme()
+		_ = infrav1beta2.AddToScheme(testScheme)
+		mockClient := fake.NewClientBuilder().WithScheme(testScheme).WithObjects(powerVSCluster)
+		if network != nil {
+			mockClient = mockClient.WithObjects(network)
+		}
+		return &PowerVSClusterScope{
+			Client:            mockClient.Build(),
+			Logger:            klog.Background(),
+			IBMPowerVSCluster: powerVSCluster,
+		}
+	}
+	newNetwork := func(ready bool) *infrav1beta2.IBMPowerVSNetwork {
+		return &infrav1beta2.IBMPowerVSNetwork{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "capi-net",
+				Namespace: "default",
+			},
+			Status: infrav1beta2.IBMPowerVSNetworkStatus{
+				Ready:     ready,
+				NetworkID: "capi-net-id",
+			},
+		}
+	}
+
+	t.Run("When the referenced IBMPowerVSNetwork does not exist", func(t *testing.T) {
+		g := NewWithT(t)
+		clusterScope := newClusterScope(nil)
+		ready, err := clusterScope.ReconcileNetwork()
+		g.Expect(err).To(Not(BeNil()))
+		g.Expect(ready).To(BeFalse())
+	})
+	t.Run("When the referenced IBMPowerVSNetwork is not ready", func(t *testing.T) {
+		g := NewWithT(t)
+		clusterScope := newClusterScope(newNetwork(false))
+		ready, err := clusterScope.ReconcileNetwork()
+		g.Expect(err).To(BeNil())
+		g.Expect(ready).To(BeFalse())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.Network).To(BeNil())
+	})
+	t.Run("When the referenced IBMPowerVSNetwork is ready", func(t *testing.T) {
+		g := NewWithT(t)
+		clusterScope := newClusterScope(newNetwork(true))
+		ready, err := clusterScope.ReconcileNetwork()
+		g.Expect(err).To(BeNil())
+		g.Expect(ready).To(BeTrue())
+		g.Expect(*clusterScope.IBMPowerVSCluster.Status.Network.ID).To(Equal("capi-net-id"))
+		g.Expect(*clusterScope.IBMPowerVSCluster.Status.Network.ControllerCreated).To(BeFalse())
+	})
+}
+
+func TestReconcileTransitGatewayRef(t *testing.T) {
+	var (
+		mockResourceController *mockRC.MockResourceController
+		mockVPC                *mock.MockVpc
+		mockTransitGateway     *tgmock.MockTransitGateway
+		mockCtrl               *gomock.Controller
+	)
+
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockTransitGateway = tgmock.NewMockTransitGateway(mockCtrl)
+		mockVPC = mock.NewMockVpc(mockCtrl)
+		mockResourceController = mockRC.NewMockResourceController(mockCtrl)
+	}
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+	newClusterScope := func(transitGateway *infrav1beta2.IBMTransitGateway) *PowerVSClusterScope {
+		powerVSCluster := &infrav1beta2.IBMPowerVSCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "capi-powervs-cluster",
+				Namespace: "default",
+			},
+			Spec: infrav1beta2.IBMPowerVSClusterSpec{
+				ServiceInstanceID: "serviceInstanceID",
+				VPC:               &infrav1beta2.VPCResourceReference{ID: ptr.To("vpcID"), Region: ptr.To("us-south")},
+				TransitGatewayRef: &corev1.LocalObjectReference{Name: "capi-tg"},
+			},
+		}
+		testScheme := runtime.NewScheme()
+		_ = infrav1beta2.AddToScheme(testScheme)
+		mockClient := fake.NewClientBuilder().WithScheme(testScheme).WithObjects(powerVSCluster)
+		if transitGateway != nil {
+			mockClient = mockClient.WithObjects(transitGateway)
+		}
+		return &PowerVSClusterScope{
+			Client:               mockClient.Build(),
+			Logger:               klog.Background(),
+			IBMPowerVSCluster:    powerVSCluster,
+			TransitGatewayClient: mockTransitGateway,
+			IBMVPCClient:         mockVPC,
+			ResourceClient:       mockResourceController,
+		}
+	}
+	newTransitGateway := func(ready bool) *infrav1beta2.IBMTransitGateway {
+		return &infrav1beta2.IBMTransitGateway{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "capi-tg",
+				Namespace: "default",
+			},
+			Status: infrav1beta2.IBMTransitGatewayStatus{
+				Ready:            ready,
+				TransitGatewayID: "capi-tg-id",
+			},
+		}
+	}
+
+	t.Run("When the referenced IBMTransitGateway does not exist", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := newClusterScope(nil)
+		requeue, err := clusterScope.ReconcileTransitGateway()
+		g.Expect(err).To(Not(BeNil()))
+		g.Expect(requeue).To(BeFalse())
+	})
+	t.Run("When the referenced IBMTransitGateway is not ready", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := newClusterScope(newTransitGateway(false))
+		requeue, err := clusterScope.ReconcileTransitGateway()
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.TransitGateway).To(BeNil())
+	})
+	t.Run("When the referenced IBMTransitGateway is ready, connections named after the cluster are created", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := newClusterScope(newTransitGateway(true))
+		mockTransitGateway.EXPECT().GetTransitGateway(gomock.Any()).Return(&tgapiv1.TransitGateway{ID: ptr.To("capi-tg-id"), Name: ptr.To("shared-tg"), Status: ptr.To(string(infrav1beta2.TransitGatewayStateAvailable))}, nil, nil)
+		mockTransitGateway.EXPECT().ListTransitGatewayConnections(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCollection{}, nil, nil)
+		mockVPC.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{CRN: ptr.To("vpc-crn")}, nil, nil)
+		mockResourceController.EXPECT().GetResourceInstance(gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{CRN: ptr.To("pvs-crn")}, nil, nil)
+		mockTransitGateway.EXPECT().CreateTransitGatewayConnection(gomock.Any()).DoAndReturn(func(options *tgapiv1.CreateTransitGatewayConnectionOptions) (*tgapiv1.TransitGatewayConnectionCust, *core.DetailedResponse, error) {
+			g.Expect(*options.Name).To(Equal("capi-powervs-cluster-transitgateway-pvs-con"))
+			return &tgapiv1.TransitGatewayConnectionCust{ID: ptr.To("pvs-connID")}, nil, nil
+		})
+		mockTransitGateway.EXPECT().CreateTransitGatewayConnection(gomock.Any()).DoAndReturn(func(options *tgapiv1.CreateTransitGatewayConnectionOptions) (*tgapiv1.TransitGatewayConnectionCust, *core.DetailedResponse, error) {
+			g.Expect(*options.Name).To(Equal("capi-powervs-cluster-transitgateway-vpc-con"))
+			return &tgapiv1.TransitGatewayConnectionCust{ID: ptr.To("vpc-connID")}, nil, nil
+		})
+		requeue, err := clusterScope.ReconcileTransitGateway()
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
+		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.ID).To(Equal("capi-tg-id"))
+		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.ControllerCreated).To(BeFalse())
+		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.PowerVSConnection.ControllerCreated).To(BeTrue())
+		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.VPCConnection.ControllerCreated).To(BeTrue())
+	})
+}
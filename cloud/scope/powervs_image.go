@@ -18,23 +18,35 @@ package scope
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/go-logr/logr"
 
 	"github.com/IBM-Cloud/power-go-client/ibmpisession"
+	"github.com/IBM-Cloud/power-go-client/power/client/p_cloud_jobs"
 	"github.com/IBM-Cloud/power-go-client/power/models"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
 
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	capiv1beta1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
 	"sigs.k8s.io/cluster-api/util/patch"
 
 	infrav1beta2 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/globaltagging"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/powervs"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/resourcecontroller"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/endpoints"
@@ -44,6 +56,16 @@ import (
 // BucketAccess indicates if the bucket has public or private access public access.
 const BucketAccess = "public"
 
+const (
+	// privateBucketAccess indicates that the bucket has private access and requires HMAC credentials.
+	privateBucketAccess = "private"
+
+	// COSAccessKey is the key in the credentials secret holding the HMAC access key of the Cloud Object Storage bucket.
+	COSAccessKey = "accessKey"
+	// COSSecretKey is the key in the credentials secret holding the HMAC secret key of the Cloud Object Storage bucket.
+	COSSecretKey = "secretKey"
+)
+
 // PowerVSImageScopeParams defines the input parameters used to create a new PowerVSImageScope.
 type PowerVSImageScopeParams struct {
 	Client          client.Client
@@ -51,6 +73,17 @@ type PowerVSImageScopeParams struct {
 	IBMPowerVSImage *infrav1beta2.IBMPowerVSImage
 	ServiceEndpoint []endpoints.ServiceEndpoint
 	Zone            *string
+	// CredentialsSecretRef is the reference to the credentials secret of the cluster the image belongs to.
+	CredentialsSecretRef *corev1.LocalObjectReference
+	// EnterpriseAccount is the child account of the enterprise the cluster the image belongs to is provisioned into.
+	EnterpriseAccount *infrav1beta2.EnterpriseAccount
+	// PrivateCloud is the Power VS private cloud of the cluster the image belongs to.
+	PrivateCloud *infrav1beta2.PowerVSPrivateCloud
+	// ServiceInstanceID is the ID of the workspace of the cluster the image belongs to, it is used for Power VS private clouds
+	// when the image does not reference a workspace by ID.
+	ServiceInstanceID string
+	// ClusterUID is the UID of the cluster the image belongs to, it identifies the images of the cluster along with the cluster tag.
+	ClusterUID types.UID
 }
 
 // PowerVSImageScope defines a scope defined around a Power VS Cluster.
@@ -60,10 +93,34 @@ type PowerVSImageScope struct {
 	patchHelper *patch.Helper
 
 	IBMPowerVSClient powervs.PowerVS
-	IBMPowerVSImage  *infrav1beta2.IBMPowerVSImage
-	ServiceEndpoint  []endpoints.ServiceEndpoint
+	// SourcePowerVSClient is the client of the workspace the source image is cloned from, it is only set when
+	// the image is cloned from another workspace.
+	SourcePowerVSClient powervs.PowerVS
+	IBMPowerVSImage     *infrav1beta2.IBMPowerVSImage
+	ServiceEndpoint     []endpoints.ServiceEndpoint
+	// ServiceInstanceID is the ID of the workspace the image is imported into.
+	ServiceInstanceID string
+	// ClusterUID is the UID of the cluster the image belongs to, empty when the cluster is not known.
+	ClusterUID types.UID
 }
 
+// importSlotTimeout is the duration after which the import slot of a workspace is taken over by another image,
+// so that the slot of an image whose job can no longer be tracked is not held forever.
+const importSlotTimeout = 3 * time.Hour
+
+// importSlot is the image holding the import slot of a workspace along with the time the slot was acquired.
+type importSlot struct {
+	holder   string
+	acquired time.Time
+}
+
+// importSlots tracks the image importing into each workspace, keyed by the workspace ID, as a workspace runs
+// a single COS import job at a time.
+var importSlots = struct {
+	sync.Mutex
+	holders map[string]importSlot
+}{holders: map[string]importSlot{}}
+
 // NewPowerVSImageScope creates a new PowerVSImageScope from the supplied parameters.
 func NewPowerVSImageScope(params PowerVSImageScopeParams) (scope *PowerVSImageScope, err error) {
 	scope = &PowerVSImageScope{}
@@ -73,6 +130,7 @@ func NewPowerVSImageScope(params PowerVSImageScopeParams) (scope *PowerVSImageSc
 		return nil, err
 	}
 	scope.Client = params.Client
+	scope.ClusterUID = params.ClusterUID
 
 	if params.IBMPowerVSImage == nil {
 		err = errors.New("failed to generate new scope from nil IBMPowerVSImage")
@@ -92,8 +150,17 @@ func NewPowerVSImageScope(params PowerVSImageScopeParams) (scope *PowerVSImageSc
 	}
 	scope.patchHelper = helper
 
+	auth, err := getAuthenticator(params.Client, params.IBMPowerVSImage.Namespace, params.CredentialsSecretRef, params.EnterpriseAccount)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create authenticator: %w", err)
+	}
+
 	// Create Resource Controller client.
-	var serviceOption resourcecontroller.ServiceOptions
+	serviceOption := resourcecontroller.ServiceOptions{
+		ResourceControllerV2Options: &resourcecontrollerv2.ResourceControllerV2Options{
+			Authenticator: auth,
+		},
+	}
 	// Fetch the resource controller endpoint.
 	rcEndpoint := endpoints.FetchEndpoints(string(endpoints.RC), params.ServiceEndpoint)
 	if rcEndpoint != "" {
@@ -112,6 +179,11 @@ func NewPowerVSImageScope(params PowerVSImageScopeParams) (scope *PowerVSImageSc
 		serviceInstanceID = spec.ServiceInstanceID
 	} else if params.IBMPowerVSImage.Spec.ServiceInstance != nil && params.IBMPowerVSImage.Spec.ServiceInstance.ID != nil {
 		serviceInstanceID = *params.IBMPowerVSImage.Spec.ServiceInstance.ID
+	} else if params.PrivateCloud != nil {
+		if params.ServiceInstanceID == "" {
+			return nil, fmt.Errorf("serviceInstanceID or serviceInstance.id must be set for images of the Power VS private cloud")
+		}
+		serviceInstanceID = params.ServiceInstanceID
 	} else {
 		name := fmt.Sprintf("%s-%s", params.IBMPowerVSImage.Spec.ClusterName, "serviceInstance")
 		if params.IBMPowerVSImage.Spec.ServiceInstance != nil && params.IBMPowerVSImage.Spec.ServiceInstance.Name != nil {
@@ -131,26 +203,55 @@ func NewPowerVSImageScope(params PowerVSImageScopeParams) (scope *PowerVSImageSc
 		serviceInstanceID = *serviceInstance.GUID
 	}
 
-	res, _, err := rc.GetResourceInstance(
-		&resourcecontrollerv2.GetResourceInstanceOptions{
-			ID: &serviceInstanceID,
-		})
+	c, err := newPowerVSImageClient(params, auth, rc, serviceInstanceID)
 	if err != nil {
-		err = fmt.Errorf("failed to get resource instance: %w", err)
 		return nil, err
 	}
+	scope.IBMPowerVSClient = c
+	scope.ServiceInstanceID = serviceInstanceID
+
+	// The source image cloned from another workspace is exported from that workspace.
+	if source := spec.SourceServiceInstance; source != nil && source.ID != nil {
+		if scope.SourcePowerVSClient, err = newPowerVSImageClient(params, auth, rc, *source.ID); err != nil {
+			return nil, fmt.Errorf("failed to create client of source workspace %s: %w", *source.ID, err)
+		}
+	}
+	return scope, nil
+}
+
+// newPowerVSImageClient creates the Power VS client of the workspace with the given ID.
+func newPowerVSImageClient(params PowerVSImageScopeParams, auth core.Authenticator, rc resourcecontroller.ResourceController, serviceInstanceID string) (powervs.PowerVS, error) {
+	var zone string
+	if params.PrivateCloud != nil {
+		// the workspaces of Power VS private clouds are not listed in IBM Cloud, hence the zone of the cluster is used.
+		zone = ptr.Deref(params.Zone, "")
+	} else {
+		res, _, err := rc.GetResourceInstance(
+			&resourcecontrollerv2.GetResourceInstanceOptions{
+				ID: &serviceInstanceID,
+			})
+		if err != nil {
+			err = fmt.Errorf("failed to get resource instance: %w", err)
+			return nil, err
+		}
+		zone = *res.RegionID
+	}
 
 	options := powervs.ServiceOptions{
 		IBMPIOptions: &ibmpisession.IBMPIOptions{
-			Debug: params.Logger.V(DEBUGLEVEL).Enabled(),
-			Zone:  *res.RegionID,
+			Authenticator: auth,
+			Debug:         params.Logger.V(DEBUGLEVEL).Enabled(),
+			Zone:          zone,
 		},
 	}
 
 	// Fetch the service endpoint.
-	if svcEndpoint := endpoints.FetchPVSEndpoint(endpoints.ConstructRegionFromZone(*res.RegionID), params.ServiceEndpoint); svcEndpoint != "" {
+	if svcEndpoint := endpoints.FetchPVSEndpoint(endpoints.ConstructRegionFromZone(zone), params.ServiceEndpoint); svcEndpoint != "" {
 		options.IBMPIOptions.URL = svcEndpoint
-		scope.Logger.V(3).Info("overriding the default powervs service endpoint")
+		params.Logger.V(3).Info("overriding the default powervs service endpoint")
+	}
+	if err := applyPowerVSPrivateCloud(params.Client, params.IBMPowerVSImage.Namespace, params.CredentialsSecretRef, params.PrivateCloud, options.IBMPIOptions); err != nil {
+		return nil, err
 	}
 
 	c, err := powervs.NewService(options)
@@ -161,10 +262,11 @@ func NewPowerVSImageScope(params PowerVSImageScopeParams) (scope *PowerVSImageSc
 
 	options.CloudInstanceID = serviceInstanceID
 	c.WithClients(options)
-	scope.IBMPowerVSClient = c
-	return scope, nil
+	return c, nil
 }
 
+// ensureImageUnique lists the images of the workspace instead of using the name index of GetImageByName, the index may miss
+// the image of a job which completed since it was refreshed and a second job would then be created.
 func (i *PowerVSImageScope) ensureImageUnique(imageName string) (*models.ImageReference, error) {
 	images, err := i.IBMPowerVSClient.GetAllImage()
 	if err != nil {
@@ -178,6 +280,19 @@ func (i *PowerVSImageScope) ensureImageUnique(imageName string) (*models.ImageRe
 	return nil, nil
 }
 
+// userTags returns the user tags of the images imported or captured for the IBMPowerVSImage. The images retained beyond the
+// lifecycle of the cluster don't carry the cluster tag, so that they are not swept along with the orphan resources of the cluster.
+func (i *PowerVSImageScope) userTags() models.Tags {
+	if i.IBMPowerVSImage.Spec.DeletePolicy == string(infrav1beta2.DeletePolicyRetain) {
+		return nil
+	}
+	tags := models.Tags{globaltagging.ClusterTag(i.IBMPowerVSImage.Namespace, i.IBMPowerVSImage.Spec.ClusterName)}
+	if i.ClusterUID != "" {
+		tags = append(tags, globaltagging.ClusterUIDTag(string(i.ClusterUID)))
+	}
+	return tags
+}
+
 // CreateImageCOSBucket creates a power vs image.
 func (i *PowerVSImageScope) CreateImageCOSBucket() (*models.ImageReference, *models.JobReference, error) {
 	s := i.IBMPowerVSImage.Spec
@@ -192,33 +307,482 @@ func (i *PowerVSImageScope) CreateImageCOSBucket() (*models.ImageReference, *mod
 		return imageReply, nil, nil
 	}
 
-	if lastJob, _ := i.GetImportJob(); lastJob != nil {
+	bucketAccess := BucketAccess
+	if s.BucketAccess != "" {
+		bucketAccess = s.BucketAccess
+	}
+	jobRef, err := i.createImageImportJob(s.Object, bucketAccess)
+	return nil, jobRef, err
+}
+
+// createImageImportJob creates the job importing the object of the Cloud Object Storage bucket in the IBMPowerVSImage spec
+// into an image named after the IBMPowerVSImage, no job is created while another job is in flight in the workspace.
+func (i *PowerVSImageScope) createImageImportJob(object *string, bucketAccess string) (*models.JobReference, error) {
+	s := i.IBMPowerVSImage.Spec
+	m := i.IBMPowerVSImage.ObjectMeta
+
+	// The last import job of the workspace may belong to an image created outside of the controller or before its restart.
+	if lastJob, _ := i.GetImportJob(); lastJob != nil && lastJob.Status != nil && lastJob.Status.State != nil {
 		if *lastJob.Status.State != "completed" && *lastJob.Status.State != "failed" {
 			i.Info("Previous import job not yet finished", "state", *lastJob.Status.State)
-			return nil, nil, nil
+			conditions.MarkFalse(i.IBMPowerVSImage, infrav1beta2.ImportJobRunningCondition, infrav1beta2.ImportJobPendingReason, capiv1beta1.ConditionSeverityInfo, "import job %s of the workspace is in flight", ptr.Deref(lastJob.ID, ""))
+			return nil, nil
 		}
 	}
+	if holder, ok := i.acquireImportSlot(); !ok {
+		i.Info("Import job of another image is in flight in the workspace", "image", holder)
+		conditions.MarkFalse(i.IBMPowerVSImage, infrav1beta2.ImportJobRunningCondition, infrav1beta2.ImportJobPendingReason, capiv1beta1.ConditionSeverityInfo, "import job of IBMPowerVSImage %s is in flight in the workspace", holder)
+		return nil, nil
+	}
 
 	body := &models.CreateCosImageImportJob{
 		ImageName:     &m.Name,
 		BucketName:    s.Bucket,
-		BucketAccess:  core.StringPtr(BucketAccess),
+		BucketAccess:  core.StringPtr(bucketAccess),
 		Region:        s.Region,
-		ImageFilename: s.Object,
+		ImageFilename: object,
 		StorageType:   s.StorageType,
+		UserTags:      i.userTags(),
+	}
+
+	if bucketAccess == privateBucketAccess {
+		accessKey, secretKey, err := i.getBucketCredentials(i.IBMPowerVSImage.Spec.CredentialsSecretRef)
+		if err != nil {
+			i.ReleaseImportSlot()
+			record.Warnf(i.IBMPowerVSImage, "FailedRetrieveBucketCredentials", "Failed to retrieve bucket credentials - %v", err)
+			return nil, err
+		}
+		body.AccessKey = accessKey
+		body.SecretKey = secretKey
 	}
 
 	jobRef, err := i.IBMPowerVSClient.CreateCosImage(body)
 	if err != nil {
 		i.Info("Unable to create new import job request")
+		i.ReleaseImportSlot()
 		record.Warnf(i.IBMPowerVSImage, "FailedCreateImageImportJob", "Failed image import job creation - %v", err)
-		return nil, nil, err
+		return nil, err
 	}
 	i.Info("New import job request created")
 	record.Eventf(i.IBMPowerVSImage, "SuccessfulCreateImageImportJob", "Created image import job %q", *jobRef.ID)
+	return jobRef, nil
+}
+
+// CopyStockImage copies the stock image referenced in the IBMPowerVSImage spec into the Power VS workspace.
+func (i *PowerVSImageScope) CopyStockImage() (*models.ImageReference, error) {
+	if imageID := i.GetImageID(); imageID != "" {
+		i.V(3).Info("Stock image already copied", "imageID", imageID)
+		return &models.ImageReference{ImageID: &imageID}, nil
+	}
+
+	stockImage, err := i.getStockImage()
+	if err != nil {
+		record.Warnf(i.IBMPowerVSImage, "FailedRetrieveStockImage", "Failed to retrieve stock image - %v", err)
+		return nil, err
+	}
+
+	// A stock image copied into the workspace retains the name of the stock image.
+	imageReply, err := i.ensureImageUnique(*stockImage.Name)
+	if err != nil {
+		record.Warnf(i.IBMPowerVSImage, "FailedRetrieveImage", "Failed to retrieve image %q", *stockImage.Name)
+		return nil, err
+	} else if imageReply != nil {
+		i.Info("Image already exists", "name", *stockImage.Name)
+		return imageReply, nil
+	}
+
+	body := &models.CreateImage{
+		ImageID: *stockImage.ImageID,
+		Source:  core.StringPtr(models.CreateImageSourceRootDashProject),
+	}
+	image, err := i.IBMPowerVSClient.CreateImage(body)
+	if err != nil {
+		record.Warnf(i.IBMPowerVSImage, "FailedCopyStockImage", "Failed to copy stock image %q - %v", *stockImage.Name, err)
+		return nil, err
+	}
+	i.Info("Copied stock image", "name", *stockImage.Name, "imageID", *image.ImageID)
+	record.Eventf(i.IBMPowerVSImage, "SuccessfulCopyStockImage", "Copied stock image %q", *stockImage.Name)
+	return &models.ImageReference{ImageID: image.ImageID, Name: image.Name}, nil
+}
+
+// CloneWorkspaceImage clones the source image of another workspace referenced in the IBMPowerVSImage spec into an image
+// named after the IBMPowerVSImage. As images can't be copied across workspaces, the source image is exported from its
+// workspace to the Cloud Object Storage bucket in the IBMPowerVSImage spec first, the export job is tracked in the status
+// of the source export, and the exported image is then imported from the bucket.
+func (i *PowerVSImageScope) CloneWorkspaceImage() (*models.ImageReference, *models.JobReference, error) {
+	m := i.IBMPowerVSImage.ObjectMeta
+	if i.SourcePowerVSClient == nil {
+		return nil, nil, fmt.Errorf("sourceServiceInstance ID must be set")
+	}
+
+	imageReply, err := i.ensureImageUnique(m.Name)
+	if err != nil {
+		record.Warnf(i.IBMPowerVSImage, "FailedRetrieveImage", "Failed to retrieve image %q", m.Name)
+		return nil, nil, err
+	} else if imageReply != nil {
+		i.Info("Image already exists")
+		return imageReply, nil, nil
+	}
+
+	sourceImage, err := i.getWorkspaceSourceImage()
+	if err != nil {
+		record.Warnf(i.IBMPowerVSImage, "FailedRetrieveSourceImage", "Failed to retrieve source image - %v", err)
+		return nil, nil, err
+	}
+
+	status := i.IBMPowerVSImage.Status.SourceExport
+	if status == nil {
+		return nil, nil, i.exportSourceImage(sourceImage)
+	}
+	if status.State != "completed" {
+		job, err := i.SourcePowerVSClient.GetJob(status.JobID)
+		if err != nil {
+			var notFound *p_cloud_jobs.PcloudCloudinstancesJobsGetNotFound
+			if !errors.As(err, &notFound) {
+				return nil, nil, err
+			}
+			// The job no longer exists, the source image is exported again.
+			i.Info("Source image export job not found, exporting the source image again", "jobID", status.JobID)
+			i.IBMPowerVSImage.Status.SourceExport = nil
+			return nil, nil, nil
+		}
+		if job.Status != nil && job.Status.State != nil {
+			status.State = *job.Status.State
+			status.Message = job.Status.Message
+		}
+		switch status.State {
+		case "completed":
+			if err := i.deleteSourceExportJob(); err != nil {
+				return nil, nil, err
+			}
+			record.Eventf(i.IBMPowerVSImage, "SuccessfulExportSourceImage", "Exported source image %q to bucket %q", *sourceImage.Name, status.Bucket)
+		case "failed":
+			if err := i.deleteSourceExportJob(); err != nil {
+				return nil, nil, err
+			}
+			record.Warnf(i.IBMPowerVSImage, "FailedExportSourceImage", "Failed to export source image %q to bucket %q - %s", *sourceImage.Name, status.Bucket, status.Message)
+			// The source image is exported again on the next reconcile.
+			i.IBMPowerVSImage.Status.SourceExport = nil
+			return nil, nil, fmt.Errorf("failed to export source image %s, message: %s", *sourceImage.Name, status.Message)
+		default:
+			i.Info("Source image export job not yet finished", "state", status.State)
+			return nil, nil, nil
+		}
+	}
+
+	// The source image is exported with the HMAC credentials, hence the bucket is read with them as well.
+	jobRef, err := i.createImageImportJob(ptr.To(*sourceImage.Name+".ova.gz"), privateBucketAccess)
+	return nil, jobRef, err
+}
+
+// exportSourceImage exports the source image from its workspace to the Cloud Object Storage bucket in the IBMPowerVSImage spec.
+func (i *PowerVSImageScope) exportSourceImage(sourceImage *models.ImageReference) error {
+	s := i.IBMPowerVSImage.Spec
+	accessKey, secretKey, err := i.getBucketCredentials(s.CredentialsSecretRef)
+	if err != nil {
+		record.Warnf(i.IBMPowerVSImage, "FailedRetrieveBucketCredentials", "Failed to retrieve bucket credentials - %v", err)
+		return err
+	}
+	body := &models.ExportImage{
+		AccessKey:  &accessKey,
+		SecretKey:  secretKey,
+		BucketName: s.Bucket,
+		Region:     ptr.Deref(s.Region, ""),
+	}
+	jobRef, err := i.SourcePowerVSClient.ExportImage(*sourceImage.ImageID, body)
+	if err != nil {
+		record.Warnf(i.IBMPowerVSImage, "FailedCreateSourceImageExportJob", "Failed source image export job creation - %v", err)
+		return err
+	}
+	i.Info("New source image export job request created", "sourceImage", *sourceImage.Name, "bucket", *s.Bucket)
+	record.Eventf(i.IBMPowerVSImage, "SuccessfulCreateSourceImageExportJob", "Created source image export job %q to bucket %q", *jobRef.ID, *s.Bucket)
+	i.IBMPowerVSImage.Status.SourceExport = &infrav1beta2.PowerVSImageExportStatus{
+		Bucket: *s.Bucket,
+		Region: ptr.Deref(s.Region, ""),
+		JobID:  *jobRef.ID,
+		State:  "queued",
+	}
+	return nil
+}
+
+// getWorkspaceSourceImage returns the image of the source workspace matching the source image reference in the IBMPowerVSImage spec.
+func (i *PowerVSImageScope) getWorkspaceSourceImage() (*models.ImageReference, error) {
+	source := i.IBMPowerVSImage.Spec.SourceImage
+	if source.ID != nil {
+		image, err := i.SourcePowerVSClient.GetImage(*source.ID)
+		if err != nil {
+			return nil, err
+		}
+		return &models.ImageReference{ImageID: image.ImageID, Name: image.Name}, nil
+	}
+
+	if source.Name == nil {
+		return nil, fmt.Errorf("sourceImage ID or Name must be set")
+	}
+	images, err := i.SourcePowerVSClient.GetAllImage()
+	if err != nil {
+		return nil, err
+	}
+	for _, image := range images.Images {
+		if *image.Name == *source.Name {
+			return image, nil
+		}
+	}
+	return nil, fmt.Errorf("image with name %s not found in the source workspace", *source.Name)
+}
+
+// DeleteSourceExportJob deletes the export job of the source image, which cancels the export when the job is still in flight.
+func (i *PowerVSImageScope) DeleteSourceExportJob() error {
+	status := i.IBMPowerVSImage.Status.SourceExport
+	if status == nil || status.JobID == "" || i.SourcePowerVSClient == nil {
+		return nil
+	}
+	return i.deleteSourceExportJob()
+}
+
+func (i *PowerVSImageScope) deleteSourceExportJob() error {
+	status := i.IBMPowerVSImage.Status.SourceExport
+	if err := i.SourcePowerVSClient.DeleteJob(status.JobID); err != nil {
+		var notFound *p_cloud_jobs.PcloudCloudinstancesJobsDeleteNotFound
+		if !errors.As(err, &notFound) {
+			record.Warnf(i.IBMPowerVSImage, "FailedDeleteSourceImageExportJob", "Failed source image export job deletion - %v", err)
+			return err
+		}
+		i.Info("Source image export job not found, skipping deletion", "jobID", status.JobID)
+	}
+	status.JobID = ""
+	return nil
+}
+
+// CaptureInstance captures the boot volume of the instance referenced in the IBMPowerVSImage spec into an image
+// of the Power VS workspace, the image is named after the IBMPowerVSImage.
+func (i *PowerVSImageScope) CaptureInstance() (*models.ImageReference, *models.JobReference, error) {
+	m := i.IBMPowerVSImage.ObjectMeta
+
+	imageReply, err := i.ensureImageUnique(m.Name)
+	if err != nil {
+		record.Warnf(i.IBMPowerVSImage, "FailedRetrieveImage", "Failed to retrieve image %q", m.Name)
+		return nil, nil, err
+	} else if imageReply != nil {
+		i.Info("Image already exists")
+		return imageReply, nil, nil
+	}
+
+	instanceID, err := i.getCaptureInstanceID()
+	if err != nil {
+		record.Warnf(i.IBMPowerVSImage, "FailedRetrieveInstance", "Failed to retrieve instance to capture - %v", err)
+		return nil, nil, err
+	}
+	// Capture jobs share the job slot of the workspace with the import jobs.
+	if holder, ok := i.acquireImportSlot(); !ok {
+		i.Info("Job of another image is in flight in the workspace", "image", holder)
+		conditions.MarkFalse(i.IBMPowerVSImage, infrav1beta2.ImportJobRunningCondition, infrav1beta2.ImportJobPendingReason, capiv1beta1.ConditionSeverityInfo, "job of IBMPowerVSImage %s is in flight in the workspace", holder)
+		return nil, nil, nil
+	}
+
+	body := &models.PVMInstanceCapture{
+		CaptureDestination: ptr.To(models.PVMInstanceCaptureCaptureDestinationImageDashCatalog),
+		CaptureName:        &m.Name,
+		UserTags:           i.userTags(),
+	}
+	jobRef, err := i.IBMPowerVSClient.CaptureInstance(instanceID, body)
+	if err != nil {
+		i.ReleaseImportSlot()
+		record.Warnf(i.IBMPowerVSImage, "FailedCreateImageCaptureJob", "Failed image capture job creation - %v", err)
+		return nil, nil, err
+	}
+	i.Info("New capture job request created", "instanceID", instanceID)
+	record.Eventf(i.IBMPowerVSImage, "SuccessfulCreateImageCaptureJob", "Created image capture job %q of instance %q", *jobRef.ID, instanceID)
 	return nil, jobRef, nil
 }
 
+// getCaptureInstanceID returns the ID of the instance matching the capture instance reference in the IBMPowerVSImage spec.
+func (i *PowerVSImageScope) getCaptureInstanceID() (string, error) {
+	ref := i.IBMPowerVSImage.Spec.CaptureInstance
+	if ref.ID != nil {
+		return *ref.ID, nil
+	}
+	if ref.Name == nil {
+		return "", fmt.Errorf("captureInstance ID or Name must be set")
+	}
+	instance, err := i.IBMPowerVSClient.GetInstanceByName(*ref.Name)
+	if err != nil {
+		return "", err
+	}
+	if instance != nil && instance.PvmInstanceID != nil {
+		return *instance.PvmInstanceID, nil
+	}
+	return "", fmt.Errorf("instance with name %s not found", *ref.Name)
+}
+
+// ExportImage exports the image to the Cloud Object Storage bucket of the export in the IBMPowerVSImage spec,
+// the export job is recorded in the status of the export.
+func (i *PowerVSImageScope) ExportImage() (*models.JobReference, error) {
+	export := i.IBMPowerVSImage.Spec.Export
+	// Export jobs share the job slot of the workspace with the import jobs.
+	if holder, ok := i.acquireImportSlot(); !ok {
+		i.Info("Job of another image is in flight in the workspace", "image", holder)
+		conditions.MarkFalse(i.IBMPowerVSImage, infrav1beta2.ImageExportedCondition, infrav1beta2.ImageExportPendingReason, capiv1beta1.ConditionSeverityInfo, "job of IBMPowerVSImage %s is in flight in the workspace", holder)
+		return nil, nil
+	}
+
+	accessKey, secretKey, err := i.getBucketCredentials(&export.CredentialsSecretRef)
+	if err != nil {
+		i.ReleaseImportSlot()
+		record.Warnf(i.IBMPowerVSImage, "FailedRetrieveBucketCredentials", "Failed to retrieve bucket credentials - %v", err)
+		return nil, err
+	}
+	body := &models.ExportImage{
+		AccessKey:  &accessKey,
+		SecretKey:  secretKey,
+		BucketName: &export.Bucket,
+		Region:     export.Region,
+	}
+	jobRef, err := i.IBMPowerVSClient.ExportImage(i.GetImageID(), body)
+	if err != nil {
+		i.ReleaseImportSlot()
+		record.Warnf(i.IBMPowerVSImage, "FailedCreateImageExportJob", "Failed image export job creation - %v", err)
+		return nil, err
+	}
+	i.Info("New export job request created", "bucket", export.Bucket)
+	record.Eventf(i.IBMPowerVSImage, "SuccessfulCreateImageExportJob", "Created image export job %q to bucket %q", *jobRef.ID, export.Bucket)
+	i.IBMPowerVSImage.Status.Export = &infrav1beta2.PowerVSImageExportStatus{
+		Bucket: export.Bucket,
+		Region: export.Region,
+		JobID:  *jobRef.ID,
+		State:  "queued",
+	}
+	conditions.MarkFalse(i.IBMPowerVSImage, infrav1beta2.ImageExportedCondition, infrav1beta2.ImageExportRunningReason, capiv1beta1.ConditionSeverityInfo, "exporting image to bucket %s", export.Bucket)
+	return jobRef, nil
+}
+
+// IsExportRequested returns true when the image is not yet exported to the bucket of the export in the IBMPowerVSImage spec.
+func (i *PowerVSImageScope) IsExportRequested() bool {
+	export := i.IBMPowerVSImage.Spec.Export
+	status := i.IBMPowerVSImage.Status.Export
+	if export == nil {
+		return false
+	}
+	return status == nil || status.Bucket != export.Bucket || status.Region != export.Region
+}
+
+// RecordExport records the export status of the image in the ExportAnnotation.
+func (i *PowerVSImageScope) RecordExport() error {
+	status := i.IBMPowerVSImage.Status.Export
+	if status == nil {
+		delete(i.IBMPowerVSImage.Annotations, infrav1beta2.ExportAnnotation)
+		return nil
+	}
+	// The message of the job may be long, it is restored from the job in flight.
+	data, err := json.Marshal(infrav1beta2.PowerVSImageExportStatus{Bucket: status.Bucket, Region: status.Region, JobID: status.JobID, State: status.State})
+	if err != nil {
+		return fmt.Errorf("failed to marshal export status: %w", err)
+	}
+	if i.IBMPowerVSImage.Annotations == nil {
+		i.IBMPowerVSImage.Annotations = map[string]string{}
+	}
+	i.IBMPowerVSImage.Annotations[infrav1beta2.ExportAnnotation] = string(data)
+	return nil
+}
+
+// RestoreExport restores the export status of the image from the ExportAnnotation when the status is empty,
+// e.g. after the image was moved to another management cluster, and reserves the import slot of the workspace
+// for the export job in flight.
+func (i *PowerVSImageScope) RestoreExport() error {
+	data := i.IBMPowerVSImage.GetAnnotations()[infrav1beta2.ExportAnnotation]
+	if i.IBMPowerVSImage.Status.Export != nil || data == "" {
+		return nil
+	}
+	status := &infrav1beta2.PowerVSImageExportStatus{}
+	if err := json.Unmarshal([]byte(data), status); err != nil {
+		return fmt.Errorf("failed to unmarshal %s annotation: %w", infrav1beta2.ExportAnnotation, err)
+	}
+	i.Info("Restoring export of the image", "bucket", status.Bucket, "jobID", status.JobID)
+	i.IBMPowerVSImage.Status.Export = status
+	switch {
+	case status.JobID != "":
+		if holder, ok := i.acquireImportSlot(); !ok {
+			i.Info("Import slot of the workspace is held by another image", "image", holder)
+		}
+	case status.State == "completed":
+		conditions.MarkTrue(i.IBMPowerVSImage, infrav1beta2.ImageExportedCondition)
+	case status.State == "failed":
+		conditions.MarkFalse(i.IBMPowerVSImage, infrav1beta2.ImageExportedCondition, infrav1beta2.ImageExportFailedReason, capiv1beta1.ConditionSeverityError, "export job failed")
+	}
+	return nil
+}
+
+// DeleteExportJob deletes the image export job, which cancels the export when the job is still in flight.
+func (i *PowerVSImageScope) DeleteExportJob() error {
+	status := i.IBMPowerVSImage.Status.Export
+	if status == nil || status.JobID == "" {
+		return nil
+	}
+	if err := i.IBMPowerVSClient.DeleteJob(status.JobID); err != nil {
+		var notFound *p_cloud_jobs.PcloudCloudinstancesJobsDeleteNotFound
+		if !errors.As(err, &notFound) {
+			record.Warnf(i.IBMPowerVSImage, "FailedDeleteImageExportJob", "Failed image export job deletion - %v", err)
+			return err
+		}
+		i.Info("Image export job not found, skipping deletion", "jobID", status.JobID)
+	}
+	i.ReleaseImportSlot()
+	status.JobID = ""
+	return nil
+}
+
+// getStockImage returns the stock image matching the source image reference in the IBMPowerVSImage spec.
+func (i *PowerVSImageScope) getStockImage() (*models.ImageReference, error) {
+	source := i.IBMPowerVSImage.Spec.SourceImage
+	if source.ID != nil {
+		image, err := i.IBMPowerVSClient.GetStockImage(*source.ID)
+		if err != nil {
+			return nil, err
+		}
+		return &models.ImageReference{ImageID: image.ImageID, Name: image.Name}, nil
+	}
+
+	if source.Name == nil {
+		return nil, fmt.Errorf("sourceImage ID or Name must be set")
+	}
+	images, err := i.IBMPowerVSClient.GetAllStockImages()
+	if err != nil {
+		return nil, err
+	}
+	for _, image := range images.Images {
+		if *image.Name == *source.Name {
+			return image, nil
+		}
+	}
+	return nil, fmt.Errorf("stock image with name %s not found", *source.Name)
+}
+
+// getBucketCredentials returns the HMAC access key and secret key from the secret referenced in the IBMPowerVSImage spec.
+func (i *PowerVSImageScope) getBucketCredentials(secretRef *corev1.LocalObjectReference) (string, string, error) {
+	if secretRef == nil || secretRef.Name == "" {
+		return "", "", fmt.Errorf("credentialsSecretRef must be set when bucket access is %s", privateBucketAccess)
+	}
+
+	secret := &corev1.Secret{}
+	key := client.ObjectKey{
+		Namespace: i.IBMPowerVSImage.Namespace,
+		Name:      secretRef.Name,
+	}
+	if err := i.Client.Get(context.TODO(), key, secret); err != nil {
+		return "", "", fmt.Errorf("failed to get credentials secret %s: %w", key, err)
+	}
+
+	accessKey, ok := secret.Data[COSAccessKey]
+	if !ok || len(accessKey) == 0 {
+		return "", "", fmt.Errorf("credentials secret %s does not contain %s", key, COSAccessKey)
+	}
+	secretKey, ok := secret.Data[COSSecretKey]
+	if !ok || len(secretKey) == 0 {
+		return "", "", fmt.Errorf("credentials secret %s does not contain %s", key, COSSecretKey)
+	}
+	return string(accessKey), string(secretKey), nil
+}
+
 // PatchObject persists the cluster configuration and status.
 func (i *PowerVSImageScope) PatchObject() error {
 	return i.patchHelper.Patch(context.TODO(), i.IBMPowerVSImage)
@@ -239,14 +803,129 @@ func (i *PowerVSImageScope) DeleteImage() error {
 	return nil
 }
 
-// GetImportJob will get the image import job.
+// ReconcileUsage records the IBMPowerVSMachines and IBMPowerVSMachineTemplates referencing the image in the image status.
+func (i *PowerVSImageScope) ReconcileUsage(ctx context.Context) error {
+	usedBy := []infrav1beta2.ImageReferrer{}
+
+	machines := &infrav1beta2.IBMPowerVSMachineList{}
+	if err := i.Client.List(ctx, machines, client.InNamespace(i.IBMPowerVSImage.Namespace)); err != nil {
+		return fmt.Errorf("failed to list IBMPowerVSMachines: %w", err)
+	}
+	for _, machine := range machines.Items {
+		if machine.Spec.ImageRef != nil && machine.Spec.ImageRef.Name == i.IBMPowerVSImage.Name {
+			usedBy = append(usedBy, infrav1beta2.ImageReferrer{Kind: "IBMPowerVSMachine", Name: machine.Name})
+		}
+	}
+
+	templates := &infrav1beta2.IBMPowerVSMachineTemplateList{}
+	if err := i.Client.List(ctx, templates, client.InNamespace(i.IBMPowerVSImage.Namespace)); err != nil {
+		return fmt.Errorf("failed to list IBMPowerVSMachineTemplates: %w", err)
+	}
+	for _, template := range templates.Items {
+		if template.Spec.Template.Spec.ImageRef != nil && template.Spec.Template.Spec.ImageRef.Name == i.IBMPowerVSImage.Name {
+			usedBy = append(usedBy, infrav1beta2.ImageReferrer{Kind: "IBMPowerVSMachineTemplate", Name: template.Name})
+		}
+	}
+
+	sort.Slice(usedBy, func(a, b int) bool {
+		if usedBy[a].Kind != usedBy[b].Kind {
+			return usedBy[a].Kind < usedBy[b].Kind
+		}
+		return usedBy[a].Name < usedBy[b].Name
+	})
+	if len(usedBy) == 0 {
+		usedBy = nil
+	}
+	i.IBMPowerVSImage.Status.UsedBy = usedBy
+	return nil
+}
+
+// IsInUse returns true when the image is referenced by machines or machine templates.
+func (i *PowerVSImageScope) IsInUse() bool {
+	return len(i.IBMPowerVSImage.Status.UsedBy) > 0
+}
+
+// IsForceDelete returns true when the image should be deleted even though it is still in use.
+func (i *PowerVSImageScope) IsForceDelete() bool {
+	_, ok := i.IBMPowerVSImage.Annotations[infrav1beta2.ForceDeleteAnnotation]
+	return ok
+}
+
+// GetUsage returns a human readable list of the objects referencing the image.
+func (i *PowerVSImageScope) GetUsage() string {
+	referrers := make([]string, 0, len(i.IBMPowerVSImage.Status.UsedBy))
+	for _, referrer := range i.IBMPowerVSImage.Status.UsedBy {
+		referrers = append(referrers, fmt.Sprintf("%s/%s", referrer.Kind, referrer.Name))
+	}
+	return strings.Join(referrers, ", ")
+}
+
+// GetImportJob will get the last image import job of the workspace, which may belong to another image.
 func (i *PowerVSImageScope) GetImportJob() (*models.Job, error) {
-	return i.IBMPowerVSClient.GetCosImages(i.IBMPowerVSImage.Spec.ServiceInstanceID)
+	return i.IBMPowerVSClient.GetCosImages(i.serviceInstanceID())
+}
+
+// serviceInstanceID returns the ID of the workspace the image is imported into.
+func (i *PowerVSImageScope) serviceInstanceID() string {
+	if i.ServiceInstanceID != "" {
+		return i.ServiceInstanceID
+	}
+	return i.IBMPowerVSImage.Spec.ServiceInstanceID
+}
+
+// acquireImportSlot reserves the import slot of the workspace for the image, it returns the image holding the slot
+// along with false when the import job of another image is in flight. The slot held for longer than importSlotTimeout
+// is taken over.
+func (i *PowerVSImageScope) acquireImportSlot() (string, bool) {
+	key := client.ObjectKeyFromObject(i.IBMPowerVSImage).String()
+	importSlots.Lock()
+	defer importSlots.Unlock()
+	slot, ok := importSlots.holders[i.serviceInstanceID()]
+	if ok && slot.holder == key {
+		return key, true
+	}
+	if ok && time.Since(slot.acquired) < importSlotTimeout {
+		return slot.holder, false
+	}
+	if ok {
+		i.Info("Taking over the import slot of the workspace held for too long", "image", slot.holder, "acquired", slot.acquired)
+	}
+	importSlots.holders[i.serviceInstanceID()] = importSlot{holder: key, acquired: time.Now()}
+	return key, true
+}
+
+// ReleaseImportSlot releases the import slot of the workspace once the import job of the image is finished,
+// allowing the import of the next image.
+func (i *PowerVSImageScope) ReleaseImportSlot() {
+	key := client.ObjectKeyFromObject(i.IBMPowerVSImage).String()
+	importSlots.Lock()
+	defer importSlots.Unlock()
+	if importSlots.holders[i.serviceInstanceID()].holder == key {
+		delete(importSlots.holders, i.serviceInstanceID())
+	}
+}
+
+// GetJob returns the job of the workspace with the given ID, nil when the job no longer exists.
+func (i *PowerVSImageScope) GetJob(id string) (*models.Job, error) {
+	job, err := i.IBMPowerVSClient.GetJob(id)
+	if err != nil {
+		var notFound *p_cloud_jobs.PcloudCloudinstancesJobsGetNotFound
+		if errors.As(err, &notFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return job, nil
 }
 
 // DeleteImportJob will delete the image import job.
 func (i *PowerVSImageScope) DeleteImportJob() error {
 	if err := i.IBMPowerVSClient.DeleteJob(i.IBMPowerVSImage.Status.JobID); err != nil {
+		var notFound *p_cloud_jobs.PcloudCloudinstancesJobsDeleteNotFound
+		if errors.As(err, &notFound) {
+			i.Info("Image import job not found, skipping deletion", "jobID", i.IBMPowerVSImage.Status.JobID)
+			return nil
+		}
 		record.Warnf(i.IBMPowerVSImage, "FailedDeleteImageImportJob", "Failed image import job deletion - %v", err)
 		return err
 	}
@@ -254,6 +933,21 @@ func (i *PowerVSImageScope) DeleteImportJob() error {
 	return nil
 }
 
+// IsImportJobFinished returns true if the image import job has either completed or failed.
+func (i *PowerVSImageScope) IsImportJobFinished() bool {
+	switch conditions.GetReason(i.IBMPowerVSImage, infrav1beta2.ImportJobRunningCondition) {
+	case infrav1beta2.ImportJobCompletedReason, infrav1beta2.ImportJobFailedReason:
+		return true
+	}
+	return false
+}
+
+// GetImportedImage returns the image created by the import job, it is used to find the image
+// when the import was interrupted before the image ID was recorded in the status.
+func (i *PowerVSImageScope) GetImportedImage() (*models.ImageReference, error) {
+	return i.ensureImageUnique(i.IBMPowerVSImage.Name)
+}
+
 // SetReady will set the status as ready for the image.
 func (i *PowerVSImageScope) SetReady() {
 	i.IBMPowerVSImage.Status.Ready = true
@@ -291,12 +985,51 @@ func (i *PowerVSImageScope) GetImageState() infrav1beta2.PowerVSImageState {
 	return i.IBMPowerVSImage.Status.ImageState
 }
 
-// SetJobID will set the id for the import image job.
+// SetJobID will set the id for the import image job, it is recorded in the ImportJobAnnotation as well.
 func (i *PowerVSImageScope) SetJobID(id string) {
 	i.IBMPowerVSImage.Status.JobID = id
+	if id == "" {
+		delete(i.IBMPowerVSImage.Annotations, infrav1beta2.ImportJobAnnotation)
+		return
+	}
+	if i.IBMPowerVSImage.Annotations == nil {
+		i.IBMPowerVSImage.Annotations = map[string]string{}
+	}
+	i.IBMPowerVSImage.Annotations[infrav1beta2.ImportJobAnnotation] = id
+}
+
+// RestoreImportJob restores the import job of the image from the ImportJobAnnotation when the status is empty,
+// e.g. after the image was moved to another management cluster, and reserves the import slot of the workspace
+// for the job as the slots are not kept across restarts of the controller.
+func (i *PowerVSImageScope) RestoreImportJob() {
+	jobID := i.GetJobID()
+	if jobID == "" {
+		if jobID = i.IBMPowerVSImage.GetAnnotations()[infrav1beta2.ImportJobAnnotation]; jobID == "" {
+			return
+		}
+		i.Info("Restoring import job of the image", "jobID", jobID)
+	}
+	i.SetJobID(jobID)
+	if i.IsImportJobFinished() {
+		return
+	}
+	if holder, ok := i.acquireImportSlot(); !ok {
+		i.Info("Import slot of the workspace is held by another image", "image", holder)
+	}
 }
 
 // GetJobID will get the id for the import image job.
 func (i *PowerVSImageScope) GetJobID() string {
 	return i.IBMPowerVSImage.Status.JobID
 }
+
+// SetJobStatus will set the progress and message reported by the import image job.
+func (i *PowerVSImageScope) SetJobStatus(status *models.Status) {
+	if status == nil {
+		return
+	}
+	if status.Progress != nil {
+		i.IBMPowerVSImage.Status.JobProgress = *status.Progress
+	}
+	i.IBMPowerVSImage.Status.JobMessage = status.Message
+}
@@ -17,21 +17,35 @@ limitations under the License.
 package scope
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/IBM-Cloud/power-go-client/ibmpisession"
+	"github.com/IBM-Cloud/power-go-client/power/client/p_cloud_jobs"
 	"github.com/IBM-Cloud/power-go-client/power/models"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/klog/v2"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	capiv1beta1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+
 	infrav1beta2 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/powervs"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/powervs/mock"
 
 	. "github.com/onsi/gomega"
@@ -69,6 +83,18 @@ func setupPowerVSImageScope(imageName string, mockpowervs *mock.MockPowerVS) *Po
 	}
 }
 
+// instanceByName looks up the virtual machines of instances by name as GetInstanceByName does.
+func instanceByName(instances *models.PVMInstances) func(string) (*models.PVMInstanceReference, error) {
+	return func(name string) (*models.PVMInstanceReference, error) {
+		for _, ins := range instances.PvmInstances {
+			if *ins.ServerName == name {
+				return ins, nil
+			}
+		}
+		return nil, nil
+	}
+}
+
 func TestNewPowerVSImageScope(t *testing.T) {
 	testCases := []struct {
 		name   string
@@ -189,8 +215,37 @@ func TestCreateImageCOSBucket(t *testing.T) {
 			scope := setupPowerVSImageScope(pvsImage, mockpowervs)
 			mockpowervs.EXPECT().GetAllImage().Return(images, nil)
 			mockpowervs.EXPECT().GetCosImages(gomock.AssignableToTypeOf(serviceInstanceID)).Return(job, nil)
-			_, _, err := scope.CreateImageCOSBucket()
+			_, out, err := scope.CreateImageCOSBucket()
+			g.Expect(err).To(BeNil())
+			g.Expect(out).To(BeNil())
+			g.Expect(conditions.GetReason(scope.IBMPowerVSImage, infrav1beta2.ImportJobRunningCondition)).To(Equal(infrav1beta2.ImportJobPendingReason))
+		})
+
+		t.Run("Import job of another image in-progress in the workspace", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t)
+			t.Cleanup(teardown)
+			scope := setupPowerVSImageScope(pvsImage, mockpowervs)
+			scope.ServiceInstanceID = "foo-service-instance-id"
+			other := setupPowerVSImageScope("foo-image-2", mockpowervs)
+			other.ServiceInstanceID = "foo-service-instance-id"
+			mockpowervs.EXPECT().GetAllImage().Return(images, nil).Times(3)
+			mockpowervs.EXPECT().GetCosImages("foo-service-instance-id").Return(job, nil).Times(3)
+			mockpowervs.EXPECT().CreateCosImage(gomock.AssignableToTypeOf(body)).Return(jobReference, nil).Times(2)
+			_, out, err := scope.CreateImageCOSBucket()
+			g.Expect(err).To(BeNil())
+			g.Expect(out).To(Equal(jobReference))
+
+			_, out, err = other.CreateImageCOSBucket()
 			g.Expect(err).To(BeNil())
+			g.Expect(out).To(BeNil())
+			g.Expect(conditions.GetReason(other.IBMPowerVSImage, infrav1beta2.ImportJobRunningCondition)).To(Equal(infrav1beta2.ImportJobPendingReason))
+
+			scope.ReleaseImportSlot()
+			_, out, err = other.CreateImageCOSBucket()
+			g.Expect(err).To(BeNil())
+			g.Expect(out).To(Equal(jobReference))
+			other.ReleaseImportSlot()
 		})
 
 		t.Run("Error while creating import job", func(t *testing.T) {
@@ -204,6 +259,592 @@ func TestCreateImageCOSBucket(t *testing.T) {
 			_, _, err := scope.CreateImageCOSBucket()
 			g.Expect(err).To((Not(BeNil())))
 		})
+
+		t.Run("Should create image import job from private bucket", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t)
+			t.Cleanup(teardown)
+			scope := setupPowerVSImageScope(pvsImage, mockpowervs)
+			scope.IBMPowerVSImage.Spec.BucketAccess = "private"
+			scope.IBMPowerVSImage.Spec.CredentialsSecretRef = &corev1.LocalObjectReference{Name: "cos-hmac"}
+			secret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "cos-hmac",
+					Namespace: "default",
+				},
+				Data: map[string][]byte{
+					COSAccessKey: []byte("foo-access-key"),
+					COSSecretKey: []byte("foo-secret-key"),
+				},
+			}
+			g.Expect(scope.Client.Create(context.TODO(), secret)).To(Succeed())
+			mockpowervs.EXPECT().GetAllImage().Return(images, nil)
+			mockpowervs.EXPECT().GetCosImages(gomock.AssignableToTypeOf(serviceInstanceID)).Return(job, nil)
+			mockpowervs.EXPECT().CreateCosImage(gomock.AssignableToTypeOf(body)).DoAndReturn(func(body *models.CreateCosImageImportJob) (*models.JobReference, error) {
+				g.Expect(*body.BucketAccess).To(Equal("private"))
+				g.Expect(body.AccessKey).To(Equal("foo-access-key"))
+				g.Expect(body.SecretKey).To(Equal("foo-secret-key"))
+				return jobReference, nil
+			})
+			_, out, err := scope.CreateImageCOSBucket()
+			g.Expect(err).To(BeNil())
+			require.Equal(t, jobReference, out)
+		})
+
+		t.Run("Error when credentials secret of private bucket is missing", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t)
+			t.Cleanup(teardown)
+			scope := setupPowerVSImageScope(pvsImage, mockpowervs)
+			scope.IBMPowerVSImage.Spec.BucketAccess = "private"
+			scope.IBMPowerVSImage.Spec.CredentialsSecretRef = &corev1.LocalObjectReference{Name: "cos-hmac"}
+			mockpowervs.EXPECT().GetAllImage().Return(images, nil)
+			mockpowervs.EXPECT().GetCosImages(gomock.AssignableToTypeOf(serviceInstanceID)).Return(job, nil)
+			_, _, err := scope.CreateImageCOSBucket()
+			g.Expect(err).To(Not(BeNil()))
+		})
+	})
+}
+
+func TestCopyStockImage(t *testing.T) {
+	var (
+		mockpowervs *mock.MockPowerVS
+		mockCtrl    *gomock.Controller
+	)
+
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockpowervs = mock.NewMockPowerVS(mockCtrl)
+	}
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+
+	t.Run("Copy Stock Image", func(t *testing.T) {
+		stockImages := &models.Images{
+			Images: []*models.ImageReference{
+				{
+					ImageID: core.StringPtr("stock-image-id"),
+					Name:    core.StringPtr("stock-image"),
+				},
+			},
+		}
+		images := &models.Images{
+			Images: []*models.ImageReference{
+				{
+					ImageID: core.StringPtr("foo-image-1-id"),
+					Name:    core.StringPtr("foo-image-1"),
+				},
+			},
+		}
+		image := &models.Image{
+			ImageID: core.StringPtr("copied-image-id"),
+			Name:    core.StringPtr("stock-image"),
+		}
+
+		t.Run("Should copy stock image referenced by name", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t)
+			t.Cleanup(teardown)
+			scope := setupPowerVSImageScope(pvsImage, mockpowervs)
+			scope.IBMPowerVSImage.Spec.SourceImage = &infrav1beta2.IBMPowerVSResourceReference{Name: core.StringPtr("stock-image")}
+			mockpowervs.EXPECT().GetAllStockImages().Return(stockImages, nil)
+			mockpowervs.EXPECT().GetAllImage().Return(images, nil)
+			mockpowervs.EXPECT().CreateImage(&models.CreateImage{
+				ImageID: "stock-image-id",
+				Source:  core.StringPtr(models.CreateImageSourceRootDashProject),
+			}).Return(image, nil)
+			out, err := scope.CopyStockImage()
+			g.Expect(err).To(BeNil())
+			g.Expect(out.ImageID).To(Equal(image.ImageID))
+		})
+
+		t.Run("Should copy stock image referenced by ID", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t)
+			t.Cleanup(teardown)
+			scope := setupPowerVSImageScope(pvsImage, mockpowervs)
+			scope.IBMPowerVSImage.Spec.SourceImage = &infrav1beta2.IBMPowerVSResourceReference{ID: core.StringPtr("stock-image-id")}
+			mockpowervs.EXPECT().GetStockImage("stock-image-id").Return(&models.Image{ImageID: core.StringPtr("stock-image-id"), Name: core.StringPtr("stock-image")}, nil)
+			mockpowervs.EXPECT().GetAllImage().Return(images, nil)
+			mockpowervs.EXPECT().CreateImage(gomock.AssignableToTypeOf(&models.CreateImage{})).Return(image, nil)
+			out, err := scope.CopyStockImage()
+			g.Expect(err).To(BeNil())
+			g.Expect(out.ImageID).To(Equal(image.ImageID))
+		})
+
+		t.Run("Return existing image when stock image is already copied into the workspace", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t)
+			t.Cleanup(teardown)
+			scope := setupPowerVSImageScope(pvsImage, mockpowervs)
+			scope.IBMPowerVSImage.Spec.SourceImage = &infrav1beta2.IBMPowerVSResourceReference{Name: core.StringPtr("foo-image-1")}
+			mockpowervs.EXPECT().GetAllStockImages().Return(&models.Images{Images: []*models.ImageReference{{ImageID: core.StringPtr("stock-id"), Name: core.StringPtr("foo-image-1")}}}, nil)
+			mockpowervs.EXPECT().GetAllImage().Return(images, nil)
+			out, err := scope.CopyStockImage()
+			g.Expect(err).To(BeNil())
+			g.Expect(out).To(Equal(images.Images[0]))
+		})
+
+		t.Run("Return image ID from status when stock image is already copied", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t)
+			t.Cleanup(teardown)
+			scope := setupPowerVSImageScope(pvsImage, mockpowervs)
+			scope.IBMPowerVSImage.Spec.SourceImage = &infrav1beta2.IBMPowerVSResourceReference{Name: core.StringPtr("stock-image")}
+			scope.IBMPowerVSImage.Status.ImageID = "copied-image-id"
+			out, err := scope.CopyStockImage()
+			g.Expect(err).To(BeNil())
+			g.Expect(*out.ImageID).To(Equal("copied-image-id"))
+		})
+
+		t.Run("Error when stock image is not found", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t)
+			t.Cleanup(teardown)
+			scope := setupPowerVSImageScope(pvsImage, mockpowervs)
+			scope.IBMPowerVSImage.Spec.SourceImage = &infrav1beta2.IBMPowerVSResourceReference{Name: core.StringPtr("unknown-image")}
+			mockpowervs.EXPECT().GetAllStockImages().Return(stockImages, nil)
+			_, err := scope.CopyStockImage()
+			g.Expect(err).To(Not(BeNil()))
+		})
+
+		t.Run("Error while copying stock image", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t)
+			t.Cleanup(teardown)
+			scope := setupPowerVSImageScope(pvsImage, mockpowervs)
+			scope.IBMPowerVSImage.Spec.SourceImage = &infrav1beta2.IBMPowerVSResourceReference{Name: core.StringPtr("stock-image")}
+			mockpowervs.EXPECT().GetAllStockImages().Return(stockImages, nil)
+			mockpowervs.EXPECT().GetAllImage().Return(images, nil)
+			mockpowervs.EXPECT().CreateImage(gomock.AssignableToTypeOf(&models.CreateImage{})).Return(nil, errors.New("failed to copy image"))
+			_, err := scope.CopyStockImage()
+			g.Expect(err).To(Not(BeNil()))
+		})
+	})
+}
+
+func TestCloneWorkspaceImage(t *testing.T) {
+	var (
+		mockpowervs       *mock.MockPowerVS
+		mocksourcepowervs *mock.MockPowerVS
+		mockCtrl          *gomock.Controller
+	)
+
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockpowervs = mock.NewMockPowerVS(mockCtrl)
+		mocksourcepowervs = mock.NewMockPowerVS(mockCtrl)
+	}
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+	setupCloneScope := func(t *testing.T) *PowerVSImageScope {
+		t.Helper()
+		scope := setupPowerVSImageScope(pvsImage, mockpowervs)
+		scope.SourcePowerVSClient = mocksourcepowervs
+		scope.IBMPowerVSImage.Spec.Object = nil
+		scope.IBMPowerVSImage.Spec.SourceImage = &infrav1beta2.IBMPowerVSResourceReference{Name: core.StringPtr("source-image")}
+		scope.IBMPowerVSImage.Spec.SourceServiceInstance = &infrav1beta2.IBMPowerVSResourceReference{ID: core.StringPtr("source-workspace-id")}
+		scope.IBMPowerVSImage.Spec.CredentialsSecretRef = &corev1.LocalObjectReference{Name: "cos-hmac"}
+		secret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "cos-hmac",
+				Namespace: "default",
+			},
+			Data: map[string][]byte{
+				COSAccessKey: []byte("foo-access-key"),
+				COSSecretKey: []byte("foo-secret-key"),
+			},
+		}
+		require.NoError(t, scope.Client.Create(context.TODO(), secret))
+		return scope
+	}
+
+	t.Run("Clone Workspace Image", func(t *testing.T) {
+		images := &models.Images{
+			Images: []*models.ImageReference{
+				{
+					ImageID: core.StringPtr("foo-image-1-id"),
+					Name:    core.StringPtr("foo-image-1"),
+				},
+			},
+		}
+		sourceImages := &models.Images{
+			Images: []*models.ImageReference{
+				{
+					ImageID: core.StringPtr("source-image-id"),
+					Name:    core.StringPtr("source-image"),
+				},
+			},
+		}
+
+		t.Run("Should export the source image from its workspace", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t)
+			t.Cleanup(teardown)
+			scope := setupCloneScope(t)
+			mockpowervs.EXPECT().GetAllImage().Return(images, nil)
+			mocksourcepowervs.EXPECT().GetAllImage().Return(sourceImages, nil)
+			mocksourcepowervs.EXPECT().ExportImage("source-image-id", gomock.AssignableToTypeOf(&models.ExportImage{})).DoAndReturn(func(_ string, body *models.ExportImage) (*models.JobReference, error) {
+				g.Expect(*body.BucketName).To(Equal("foo-bucket"))
+				g.Expect(body.Region).To(Equal("foo-zone"))
+				g.Expect(*body.AccessKey).To(Equal("foo-access-key"))
+				g.Expect(body.SecretKey).To(Equal("foo-secret-key"))
+				return &models.JobReference{ID: core.StringPtr("export-job-id")}, nil
+			})
+			image, job, err := scope.CloneWorkspaceImage()
+			g.Expect(err).To(BeNil())
+			g.Expect(image).To(BeNil())
+			g.Expect(job).To(BeNil())
+			g.Expect(scope.IBMPowerVSImage.Status.SourceExport).To(Equal(&infrav1beta2.PowerVSImageExportStatus{
+				Bucket: "foo-bucket",
+				Region: "foo-zone",
+				JobID:  "export-job-id",
+				State:  "queued",
+			}))
+		})
+
+		t.Run("Should wait for the export of the source image", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t)
+			t.Cleanup(teardown)
+			scope := setupCloneScope(t)
+			scope.IBMPowerVSImage.Status.SourceExport = &infrav1beta2.PowerVSImageExportStatus{Bucket: "foo-bucket", Region: "foo-zone", JobID: "export-job-id", State: "queued"}
+			mockpowervs.EXPECT().GetAllImage().Return(images, nil)
+			mocksourcepowervs.EXPECT().GetAllImage().Return(sourceImages, nil)
+			mocksourcepowervs.EXPECT().GetJob("export-job-id").Return(&models.Job{Status: &models.Status{State: core.StringPtr("running"), Message: "exporting"}}, nil)
+			image, job, err := scope.CloneWorkspaceImage()
+			g.Expect(err).To(BeNil())
+			g.Expect(image).To(BeNil())
+			g.Expect(job).To(BeNil())
+			g.Expect(scope.IBMPowerVSImage.Status.SourceExport.State).To(Equal("running"))
+			g.Expect(scope.IBMPowerVSImage.Status.SourceExport.Message).To(Equal("exporting"))
+		})
+
+		t.Run("Should import the exported source image from the bucket", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t)
+			t.Cleanup(teardown)
+			scope := setupCloneScope(t)
+			t.Cleanup(scope.ReleaseImportSlot)
+			scope.IBMPowerVSImage.Status.SourceExport = &infrav1beta2.PowerVSImageExportStatus{Bucket: "foo-bucket", Region: "foo-zone", JobID: "export-job-id", State: "queued"}
+			jobReference := &models.JobReference{ID: core.StringPtr("import-job-id")}
+			mockpowervs.EXPECT().GetAllImage().Return(images, nil)
+			mocksourcepowervs.EXPECT().GetAllImage().Return(sourceImages, nil)
+			mocksourcepowervs.EXPECT().GetJob("export-job-id").Return(&models.Job{Status: &models.Status{State: core.StringPtr("completed")}}, nil)
+			mocksourcepowervs.EXPECT().DeleteJob("export-job-id").Return(nil)
+			mockpowervs.EXPECT().GetCosImages(gomock.Any()).Return(nil, nil)
+			mockpowervs.EXPECT().CreateCosImage(gomock.AssignableToTypeOf(&models.CreateCosImageImportJob{})).DoAndReturn(func(body *models.CreateCosImageImportJob) (*models.JobReference, error) {
+				g.Expect(*body.ImageName).To(Equal(pvsImage))
+				g.Expect(*body.ImageFilename).To(Equal("source-image.ova.gz"))
+				g.Expect(*body.BucketAccess).To(Equal(privateBucketAccess))
+				g.Expect(body.AccessKey).To(Equal("foo-access-key"))
+				return jobReference, nil
+			})
+			_, job, err := scope.CloneWorkspaceImage()
+			g.Expect(err).To(BeNil())
+			require.Equal(t, jobReference, job)
+			g.Expect(scope.IBMPowerVSImage.Status.SourceExport.State).To(Equal("completed"))
+			g.Expect(scope.IBMPowerVSImage.Status.SourceExport.JobID).To(BeEmpty())
+		})
+
+		t.Run("Should export the source image again when the export failed", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t)
+			t.Cleanup(teardown)
+			scope := setupCloneScope(t)
+			scope.IBMPowerVSImage.Status.SourceExport = &infrav1beta2.PowerVSImageExportStatus{Bucket: "foo-bucket", Region: "foo-zone", JobID: "export-job-id", State: "queued"}
+			mockpowervs.EXPECT().GetAllImage().Return(images, nil)
+			mocksourcepowervs.EXPECT().GetAllImage().Return(sourceImages, nil)
+			mocksourcepowervs.EXPECT().GetJob("export-job-id").Return(&models.Job{Status: &models.Status{State: core.StringPtr("failed"), Message: "access denied"}}, nil)
+			mocksourcepowervs.EXPECT().DeleteJob("export-job-id").Return(nil)
+			_, _, err := scope.CloneWorkspaceImage()
+			g.Expect(err).To(Not(BeNil()))
+			g.Expect(scope.IBMPowerVSImage.Status.SourceExport).To(BeNil())
+		})
+
+		t.Run("Return existing image when the source image is already cloned", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t)
+			t.Cleanup(teardown)
+			scope := setupCloneScope(t)
+			scope.IBMPowerVSImage.Name = "foo-image-1"
+			mockpowervs.EXPECT().GetAllImage().Return(images, nil)
+			out, _, err := scope.CloneWorkspaceImage()
+			g.Expect(err).To(BeNil())
+			g.Expect(out).To(Equal(images.Images[0]))
+		})
+
+		t.Run("Error when the source image is not found in its workspace", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t)
+			t.Cleanup(teardown)
+			scope := setupCloneScope(t)
+			scope.IBMPowerVSImage.Spec.SourceImage = &infrav1beta2.IBMPowerVSResourceReference{Name: core.StringPtr("unknown-image")}
+			mockpowervs.EXPECT().GetAllImage().Return(images, nil)
+			mocksourcepowervs.EXPECT().GetAllImage().Return(sourceImages, nil)
+			_, _, err := scope.CloneWorkspaceImage()
+			g.Expect(err).To(Not(BeNil()))
+		})
+	})
+}
+
+func TestCaptureInstance(t *testing.T) {
+	var (
+		mockpowervs *mock.MockPowerVS
+		mockCtrl    *gomock.Controller
+	)
+
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockpowervs = mock.NewMockPowerVS(mockCtrl)
+	}
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+
+	t.Run("Capture Instance", func(t *testing.T) {
+		images := &models.Images{
+			Images: []*models.ImageReference{
+				{
+					ImageID: core.StringPtr("foo-image-1-id"),
+					Name:    core.StringPtr("foo-image-1"),
+				},
+			},
+		}
+		instances := &models.PVMInstances{
+			PvmInstances: []*models.PVMInstanceReference{
+				{
+					PvmInstanceID: core.StringPtr("foo-instance-id"),
+					ServerName:    core.StringPtr("foo-instance"),
+				},
+			},
+		}
+		jobReference := &models.JobReference{ID: core.StringPtr("capture-job-id")}
+
+		t.Run("Should capture instance referenced by ID", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t)
+			t.Cleanup(teardown)
+			scope := setupPowerVSImageScope(pvsImage, mockpowervs)
+			t.Cleanup(scope.ReleaseImportSlot)
+			scope.IBMPowerVSImage.Spec.CaptureInstance = &infrav1beta2.IBMPowerVSResourceReference{ID: core.StringPtr("foo-instance-id")}
+			mockpowervs.EXPECT().GetAllImage().Return(images, nil)
+			mockpowervs.EXPECT().CaptureInstance("foo-instance-id", gomock.AssignableToTypeOf(&models.PVMInstanceCapture{})).DoAndReturn(func(_ string, body *models.PVMInstanceCapture) (*models.JobReference, error) {
+				g.Expect(*body.CaptureDestination).To(Equal(models.PVMInstanceCaptureCaptureDestinationImageDashCatalog))
+				g.Expect(*body.CaptureName).To(Equal(pvsImage))
+				return jobReference, nil
+			})
+			_, out, err := scope.CaptureInstance()
+			g.Expect(err).To(BeNil())
+			require.Equal(t, jobReference, out)
+		})
+
+		t.Run("Should capture instance referenced by name", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t)
+			t.Cleanup(teardown)
+			scope := setupPowerVSImageScope(pvsImage, mockpowervs)
+			t.Cleanup(scope.ReleaseImportSlot)
+			scope.IBMPowerVSImage.Spec.CaptureInstance = &infrav1beta2.IBMPowerVSResourceReference{Name: core.StringPtr("foo-instance")}
+			mockpowervs.EXPECT().GetAllImage().Return(images, nil)
+			mockpowervs.EXPECT().GetInstanceByName(gomock.Any()).DoAndReturn(instanceByName(instances))
+			mockpowervs.EXPECT().CaptureInstance("foo-instance-id", gomock.AssignableToTypeOf(&models.PVMInstanceCapture{})).Return(jobReference, nil)
+			_, out, err := scope.CaptureInstance()
+			g.Expect(err).To(BeNil())
+			require.Equal(t, jobReference, out)
+		})
+
+		t.Run("Return existing image when instance is already captured", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t)
+			t.Cleanup(teardown)
+			scope := setupPowerVSImageScope("foo-image-1", mockpowervs)
+			scope.IBMPowerVSImage.Spec.CaptureInstance = &infrav1beta2.IBMPowerVSResourceReference{ID: core.StringPtr("foo-instance-id")}
+			mockpowervs.EXPECT().GetAllImage().Return(images, nil)
+			out, job, err := scope.CaptureInstance()
+			g.Expect(err).To(BeNil())
+			g.Expect(job).To(BeNil())
+			g.Expect(out).To(Equal(images.Images[0]))
+		})
+
+		t.Run("Error when instance is not found", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t)
+			t.Cleanup(teardown)
+			scope := setupPowerVSImageScope(pvsImage, mockpowervs)
+			scope.IBMPowerVSImage.Spec.CaptureInstance = &infrav1beta2.IBMPowerVSResourceReference{Name: core.StringPtr("unknown-instance")}
+			mockpowervs.EXPECT().GetAllImage().Return(images, nil)
+			mockpowervs.EXPECT().GetInstanceByName(gomock.Any()).DoAndReturn(instanceByName(instances))
+			_, _, err := scope.CaptureInstance()
+			g.Expect(err).To(Not(BeNil()))
+		})
+
+		t.Run("Error while capturing instance", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t)
+			t.Cleanup(teardown)
+			scope := setupPowerVSImageScope(pvsImage, mockpowervs)
+			scope.IBMPowerVSImage.Spec.CaptureInstance = &infrav1beta2.IBMPowerVSResourceReference{ID: core.StringPtr("foo-instance-id")}
+			mockpowervs.EXPECT().GetAllImage().Return(images, nil)
+			mockpowervs.EXPECT().CaptureInstance("foo-instance-id", gomock.AssignableToTypeOf(&models.PVMInstanceCapture{})).Return(nil, errors.New("failed to capture instance"))
+			_, _, err := scope.CaptureInstance()
+			g.Expect(err).To(Not(BeNil()))
+			_, ok := scope.acquireImportSlot()
+			g.Expect(ok).To(BeTrue())
+			scope.ReleaseImportSlot()
+		})
+	})
+}
+
+func TestExportImage(t *testing.T) {
+	var (
+		mockpowervs *mock.MockPowerVS
+		mockCtrl    *gomock.Controller
+	)
+
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockpowervs = mock.NewMockPowerVS(mockCtrl)
+	}
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+
+	t.Run("Export Image", func(t *testing.T) {
+		export := &infrav1beta2.PowerVSImageExport{
+			Bucket:               "export-bucket",
+			Region:               "us-south",
+			CredentialsSecretRef: corev1.LocalObjectReference{Name: "cos-hmac"},
+		}
+		secret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "cos-hmac",
+				Namespace: "default",
+			},
+			Data: map[string][]byte{
+				COSAccessKey: []byte("foo-access-key"),
+				COSSecretKey: []byte("foo-secret-key"),
+			},
+		}
+		jobReference := &models.JobReference{ID: core.StringPtr("export-job-id")}
+
+		t.Run("Should create image export job", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t)
+			t.Cleanup(teardown)
+			scope := setupPowerVSImageScope(pvsImage, mockpowervs)
+			t.Cleanup(scope.ReleaseImportSlot)
+			scope.IBMPowerVSImage.Spec.Export = export
+			scope.IBMPowerVSImage.Status.ImageID = "foo-image-id"
+			g.Expect(scope.Client.Create(context.TODO(), secret.DeepCopy())).To(Succeed())
+			mockpowervs.EXPECT().ExportImage("foo-image-id", gomock.AssignableToTypeOf(&models.ExportImage{})).DoAndReturn(func(_ string, body *models.ExportImage) (*models.JobReference, error) {
+				g.Expect(*body.BucketName).To(Equal("export-bucket"))
+				g.Expect(body.Region).To(Equal("us-south"))
+				g.Expect(*body.AccessKey).To(Equal("foo-access-key"))
+				g.Expect(body.SecretKey).To(Equal("foo-secret-key"))
+				return jobReference, nil
+			})
+			out, err := scope.ExportImage()
+			g.Expect(err).To(BeNil())
+			require.Equal(t, jobReference, out)
+			g.Expect(scope.IBMPowerVSImage.Status.Export).To(Equal(&infrav1beta2.PowerVSImageExportStatus{
+				Bucket: "export-bucket",
+				Region: "us-south",
+				JobID:  "export-job-id",
+				State:  "queued",
+			}))
+			g.Expect(scope.IsExportRequested()).To(BeFalse())
+			g.Expect(conditions.GetReason(scope.IBMPowerVSImage, infrav1beta2.ImageExportedCondition)).To(Equal(infrav1beta2.ImageExportRunningReason))
+		})
+
+		t.Run("Error when credentials secret of export is missing", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t)
+			t.Cleanup(teardown)
+			scope := setupPowerVSImageScope(pvsImage, mockpowervs)
+			scope.IBMPowerVSImage.Spec.Export = export
+			_, err := scope.ExportImage()
+			g.Expect(err).To(Not(BeNil()))
+			g.Expect(scope.IBMPowerVSImage.Status.Export).To(BeNil())
+		})
+
+		t.Run("Error while creating image export job", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t)
+			t.Cleanup(teardown)
+			scope := setupPowerVSImageScope(pvsImage, mockpowervs)
+			scope.IBMPowerVSImage.Spec.Export = export
+			g.Expect(scope.Client.Create(context.TODO(), secret.DeepCopy())).To(Succeed())
+			mockpowervs.EXPECT().ExportImage(gomock.Any(), gomock.Any()).Return(nil, errors.New("failed to export image"))
+			_, err := scope.ExportImage()
+			g.Expect(err).To(Not(BeNil()))
+			g.Expect(scope.IsExportRequested()).To(BeTrue())
+		})
+	})
+}
+
+func TestDeleteExportJob(t *testing.T) {
+	var (
+		mockpowervs *mock.MockPowerVS
+		mockCtrl    *gomock.Controller
+	)
+
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockpowervs = mock.NewMockPowerVS(mockCtrl)
+	}
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+
+	t.Run("Delete Export Job", func(t *testing.T) {
+		t.Run("Should skip deletion when there is no export job", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t)
+			t.Cleanup(teardown)
+			scope := setupPowerVSImageScope(pvsImage, mockpowervs)
+			g.Expect(scope.DeleteExportJob()).To(Succeed())
+		})
+
+		t.Run("Should delete image export job", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t)
+			t.Cleanup(teardown)
+			scope := setupPowerVSImageScope(pvsImage, mockpowervs)
+			scope.IBMPowerVSImage.Status.Export = &infrav1beta2.PowerVSImageExportStatus{Bucket: "export-bucket", JobID: "export-job-id"}
+			mockpowervs.EXPECT().DeleteJob("export-job-id").Return(nil)
+			g.Expect(scope.DeleteExportJob()).To(Succeed())
+			g.Expect(scope.IBMPowerVSImage.Status.Export.JobID).To(BeEmpty())
+		})
+
+		t.Run("Should ignore image export job which is not found", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t)
+			t.Cleanup(teardown)
+			scope := setupPowerVSImageScope(pvsImage, mockpowervs)
+			scope.IBMPowerVSImage.Status.Export = &infrav1beta2.PowerVSImageExportStatus{Bucket: "export-bucket", JobID: "export-job-id"}
+			mockpowervs.EXPECT().DeleteJob("export-job-id").Return(fmt.Errorf("failed to delete job: %w", p_cloud_jobs.NewPcloudCloudinstancesJobsDeleteNotFound()))
+			g.Expect(scope.DeleteExportJob()).To(Succeed())
+			g.Expect(scope.IBMPowerVSImage.Status.Export.JobID).To(BeEmpty())
+		})
+
+		t.Run("Error while deleting image export job", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t)
+			t.Cleanup(teardown)
+			scope := setupPowerVSImageScope(pvsImage, mockpowervs)
+			scope.IBMPowerVSImage.Status.Export = &infrav1beta2.PowerVSImageExportStatus{Bucket: "export-bucket", JobID: "export-job-id"}
+			mockpowervs.EXPECT().DeleteJob("export-job-id").Return(errors.New("failed to delete job"))
+			g.Expect(scope.DeleteExportJob()).To(Not(Succeed()))
+			g.Expect(scope.IBMPowerVSImage.Status.Export.JobID).To(Equal("export-job-id"))
+		})
 	})
 }
 
@@ -286,5 +927,275 @@ func TestDeleteImportJob(t *testing.T) {
 			err := scope.DeleteImportJob()
 			g.Expect(err).To(Not(BeNil()))
 		})
+
+		t.Run("Should ignore image import job which is not found", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t)
+			t.Cleanup(teardown)
+			scope := setupPowerVSImageScope(pvsImage, mockpowervs)
+			scope.IBMPowerVSImage.Status.JobID = "foo-job-id"
+			mockpowervs.EXPECT().DeleteJob(gomock.AssignableToTypeOf(id)).Return(fmt.Errorf("failed to delete job: %w", p_cloud_jobs.NewPcloudCloudinstancesJobsDeleteNotFound()))
+			err := scope.DeleteImportJob()
+			g.Expect(err).To(BeNil())
+		})
 	})
 }
+
+func TestIsImportJobFinished(t *testing.T) {
+	testCases := []struct {
+		name     string
+		reason   string
+		expected bool
+	}{
+		{name: "import job is completed", reason: infrav1beta2.ImportJobCompletedReason, expected: true},
+		{name: "import job is failed", reason: infrav1beta2.ImportJobFailedReason, expected: true},
+		{name: "import job is queued", reason: infrav1beta2.ImportJobQueuedReason},
+		{name: "import job is running"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			scope := setupPowerVSImageScope(pvsImage, nil)
+			if tc.reason != "" {
+				conditions.MarkFalse(scope.IBMPowerVSImage, infrav1beta2.ImportJobRunningCondition, tc.reason, capiv1beta1.ConditionSeverityInfo, "")
+			} else {
+				conditions.MarkTrue(scope.IBMPowerVSImage, infrav1beta2.ImportJobRunningCondition)
+			}
+			g.Expect(scope.IsImportJobFinished()).To(Equal(tc.expected))
+		})
+	}
+}
+
+func TestRestoreImportJob(t *testing.T) {
+	holder := func(scope *PowerVSImageScope) string {
+		importSlots.Lock()
+		defer importSlots.Unlock()
+		return importSlots.holders[scope.serviceInstanceID()].holder
+	}
+
+	t.Run("Should restore the import job from the annotation and reserve the import slot", func(t *testing.T) {
+		g := NewWithT(t)
+		scope := setupPowerVSImageScope(pvsImage, nil)
+		scope.IBMPowerVSImage.Annotations = map[string]string{infrav1beta2.ImportJobAnnotation: "foo-job-id"}
+		t.Cleanup(scope.ReleaseImportSlot)
+		scope.RestoreImportJob()
+		g.Expect(scope.GetJobID()).To(Equal("foo-job-id"))
+		g.Expect(holder(scope)).To(Equal(client.ObjectKeyFromObject(scope.IBMPowerVSImage).String()))
+	})
+
+	t.Run("Should record the import job of the status in the annotation", func(t *testing.T) {
+		g := NewWithT(t)
+		scope := setupPowerVSImageScope(pvsImage, nil)
+		scope.IBMPowerVSImage.Status.JobID = "foo-job-id"
+		t.Cleanup(scope.ReleaseImportSlot)
+		scope.RestoreImportJob()
+		g.Expect(scope.IBMPowerVSImage.Annotations).To(HaveKeyWithValue(infrav1beta2.ImportJobAnnotation, "foo-job-id"))
+	})
+
+	t.Run("Should not reserve the import slot for a finished import job", func(t *testing.T) {
+		g := NewWithT(t)
+		scope := setupPowerVSImageScope(pvsImage, nil)
+		scope.IBMPowerVSImage.Annotations = map[string]string{infrav1beta2.ImportJobAnnotation: "foo-job-id"}
+		conditions.MarkFalse(scope.IBMPowerVSImage, infrav1beta2.ImportJobRunningCondition, infrav1beta2.ImportJobCompletedReason, capiv1beta1.ConditionSeverityInfo, "")
+		scope.RestoreImportJob()
+		g.Expect(scope.GetJobID()).To(Equal("foo-job-id"))
+		g.Expect(holder(scope)).To(BeEmpty())
+	})
+
+	t.Run("Should do nothing when the image has no import job", func(t *testing.T) {
+		g := NewWithT(t)
+		scope := setupPowerVSImageScope(pvsImage, nil)
+		scope.RestoreImportJob()
+		g.Expect(scope.GetJobID()).To(BeEmpty())
+		g.Expect(scope.IBMPowerVSImage.Annotations).ToNot(HaveKey(infrav1beta2.ImportJobAnnotation))
+		g.Expect(holder(scope)).To(BeEmpty())
+	})
+
+	t.Run("Should remove the annotation along with the import job", func(t *testing.T) {
+		g := NewWithT(t)
+		scope := setupPowerVSImageScope(pvsImage, nil)
+		scope.SetJobID("foo-job-id")
+		g.Expect(scope.IBMPowerVSImage.Annotations).To(HaveKeyWithValue(infrav1beta2.ImportJobAnnotation, "foo-job-id"))
+		scope.SetJobID("")
+		g.Expect(scope.GetJobID()).To(BeEmpty())
+		g.Expect(scope.IBMPowerVSImage.Annotations).ToNot(HaveKey(infrav1beta2.ImportJobAnnotation))
+	})
+}
+
+func TestRestoreExport(t *testing.T) {
+	t.Run("Should restore the finished export from the annotation", func(t *testing.T) {
+		g := NewWithT(t)
+		scope := setupPowerVSImageScope(pvsImage, nil)
+		scope.IBMPowerVSImage.Annotations = map[string]string{infrav1beta2.ExportAnnotation: `{"bucket":"foo-bucket","region":"us-south","state":"completed"}`}
+		g.Expect(scope.RestoreExport()).To(Succeed())
+		g.Expect(scope.IBMPowerVSImage.Status.Export).To(Equal(&infrav1beta2.PowerVSImageExportStatus{Bucket: "foo-bucket", Region: "us-south", State: "completed"}))
+		g.Expect(conditions.IsTrue(scope.IBMPowerVSImage, infrav1beta2.ImageExportedCondition)).To(BeTrue())
+	})
+
+	t.Run("Should not override the export status", func(t *testing.T) {
+		g := NewWithT(t)
+		scope := setupPowerVSImageScope(pvsImage, nil)
+		scope.IBMPowerVSImage.Status.Export = &infrav1beta2.PowerVSImageExportStatus{Bucket: "bar-bucket", Region: "us-south"}
+		scope.IBMPowerVSImage.Annotations = map[string]string{infrav1beta2.ExportAnnotation: `{"bucket":"foo-bucket","region":"us-south"}`}
+		g.Expect(scope.RestoreExport()).To(Succeed())
+		g.Expect(scope.IBMPowerVSImage.Status.Export.Bucket).To(Equal("bar-bucket"))
+	})
+
+	t.Run("Error when the annotation is malformed", func(t *testing.T) {
+		g := NewWithT(t)
+		scope := setupPowerVSImageScope(pvsImage, nil)
+		scope.IBMPowerVSImage.Annotations = map[string]string{infrav1beta2.ExportAnnotation: "foo-bucket"}
+		g.Expect(scope.RestoreExport()).ToNot(Succeed())
+	})
+
+	t.Run("Should remove the annotation along with the export", func(t *testing.T) {
+		g := NewWithT(t)
+		scope := setupPowerVSImageScope(pvsImage, nil)
+		scope.IBMPowerVSImage.Annotations = map[string]string{infrav1beta2.ExportAnnotation: `{"bucket":"foo-bucket","region":"us-south"}`}
+		g.Expect(scope.RecordExport()).To(Succeed())
+		g.Expect(scope.IBMPowerVSImage.Annotations).ToNot(HaveKey(infrav1beta2.ExportAnnotation))
+	})
+}
+
+func TestAcquireImportSlot(t *testing.T) {
+	t.Run("Should not acquire the import slot held by another image", func(t *testing.T) {
+		g := NewWithT(t)
+		holder := setupPowerVSImageScope(pvsImage, nil)
+		scope := setupPowerVSImageScope("bar-image", nil)
+		t.Cleanup(holder.ReleaseImportSlot)
+		_, ok := holder.acquireImportSlot()
+		g.Expect(ok).To(BeTrue())
+		key, ok := scope.acquireImportSlot()
+		g.Expect(ok).To(BeFalse())
+		g.Expect(key).To(Equal("default/foo-image"))
+		_, ok = holder.acquireImportSlot()
+		g.Expect(ok).To(BeTrue())
+	})
+
+	t.Run("Should take over the import slot held for longer than the timeout", func(t *testing.T) {
+		g := NewWithT(t)
+		scope := setupPowerVSImageScope("bar-image", nil)
+		t.Cleanup(scope.ReleaseImportSlot)
+		importSlots.Lock()
+		importSlots.holders[scope.serviceInstanceID()] = importSlot{holder: "default/foo-image", acquired: time.Now().Add(-importSlotTimeout)}
+		importSlots.Unlock()
+		key, ok := scope.acquireImportSlot()
+		g.Expect(ok).To(BeTrue())
+		g.Expect(key).To(Equal("default/bar-image"))
+	})
+}
+
+func TestGetJob(t *testing.T) {
+	var (
+		mockpowervs *mock.MockPowerVS
+		mockCtrl    *gomock.Controller
+	)
+
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockpowervs = mock.NewMockPowerVS(mockCtrl)
+	}
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+
+	t.Run("Should return the job", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := setupPowerVSImageScope(pvsImage, mockpowervs)
+		mockpowervs.EXPECT().GetJob("foo-job-id").Return(&models.Job{ID: core.StringPtr("foo-job-id")}, nil)
+		job, err := scope.GetJob("foo-job-id")
+		g.Expect(err).To(BeNil())
+		g.Expect(job.ID).To(Equal(core.StringPtr("foo-job-id")))
+	})
+
+	t.Run("Should return nil when the job is not found", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := setupPowerVSImageScope(pvsImage, mockpowervs)
+		mockpowervs.EXPECT().GetJob("foo-job-id").Return(nil, fmt.Errorf("failed to get job: %w", p_cloud_jobs.NewPcloudCloudinstancesJobsGetNotFound()))
+		job, err := scope.GetJob("foo-job-id")
+		g.Expect(err).To(BeNil())
+		g.Expect(job).To(BeNil())
+	})
+
+	t.Run("Error while getting the job", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := setupPowerVSImageScope(pvsImage, mockpowervs)
+		mockpowervs.EXPECT().GetJob("foo-job-id").Return(nil, errors.New("failed to get job"))
+		_, err := scope.GetJob("foo-job-id")
+		g.Expect(err).To(Not(BeNil()))
+	})
+}
+
+func TestCreateImageCOSBucketAfterJobCompletion(t *testing.T) {
+	const serviceInstanceID = "job-completion-service-instance-id"
+	var (
+		imported atomic.Bool
+		jobs     atomic.Int32
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && strings.HasSuffix(r.URL.Path, "/images"):
+			images := `{"images":[{"imageID":"other-image-id","name":"other-image"}]}`
+			if imported.Load() {
+				images = `{"images":[{"imageID":"other-image-id","name":"other-image"},{"imageID":"foo-image-id","name":"foo-image"}]}`
+			}
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(images))
+		case r.Method == http.MethodGet && strings.HasSuffix(r.URL.Path, "/cos-images"):
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"id":"job-id","status":{"state":"completed"}}`))
+		case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/cos-images"):
+			jobs.Add(1)
+			w.WriteHeader(http.StatusAccepted)
+			_, _ = w.Write([]byte(`{"id":"job-id"}`))
+		default:
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte("{}"))
+		}
+	}))
+	defer server.Close()
+
+	options := powervs.ServiceOptions{
+		IBMPIOptions: &ibmpisession.IBMPIOptions{
+			Authenticator: &core.BearerTokenAuthenticator{BearerToken: "token"},
+			UserAccount:   "account",
+			Zone:          "dal10",
+			URL:           server.URL,
+		},
+		CloudInstanceID: serviceInstanceID,
+	}
+	service, err := powervs.NewService(options)
+	require.NoError(t, err)
+	client := service.WithClients(options)
+
+	g := NewWithT(t)
+	scope := setupPowerVSImageScope(pvsImage, nil)
+	scope.IBMPowerVSClient = client
+	scope.ServiceInstanceID = serviceInstanceID
+	t.Cleanup(scope.ReleaseImportSlot)
+
+	_, job, err := scope.CreateImageCOSBucket()
+	g.Expect(err).To(BeNil())
+	g.Expect(job).ToNot(BeNil())
+	g.Expect(jobs.Load()).To(Equal(int32(1)))
+
+	// A lookup of another image of the workspace refreshes the name index while the import job is in flight.
+	other, err := client.GetImageByName("other-image")
+	g.Expect(err).To(BeNil())
+	g.Expect(other).ToNot(BeNil())
+
+	imported.Store(true)
+	image, job, err := scope.CreateImageCOSBucket()
+	g.Expect(err).To(BeNil())
+	g.Expect(job).To(BeNil())
+	g.Expect(*image.ImageID).To(Equal("foo-image-id"))
+	g.Expect(jobs.Load()).To(Equal(int32(1)))
+}
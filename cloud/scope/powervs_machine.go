@@ -20,27 +20,28 @@ import (
 	"bytes"
 	"context"
 	"encoding/base64"
-	"encoding/json"
 	"errors"
 	"fmt"
 	"net/url"
 	"path"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
+	"time"
 
-	"github.com/blang/semver/v4"
-	ignV3Types "github.com/coreos/ignition/v2/config/v3_4/types"
 	"github.com/go-logr/logr"
 	regionUtil "github.com/ppc64le-cloud/powervs-utils"
 
 	"github.com/IBM-Cloud/power-go-client/ibmpisession"
 	"github.com/IBM-Cloud/power-go-client/power/client/p_cloud_p_vm_instances"
+	"github.com/IBM-Cloud/power-go-client/power/client/p_cloud_volumes"
 	"github.com/IBM-Cloud/power-go-client/power/models"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/ibm-cos-sdk-go/aws"
 	cosSession "github.com/IBM/ibm-cos-sdk-go/aws/session"
 	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 
 	corev1 "k8s.io/api/core/v1"
@@ -54,16 +55,22 @@ import (
 
 	capiv1beta1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	"sigs.k8s.io/cluster-api/util"
+	"sigs.k8s.io/cluster-api/util/conditions"
 	"sigs.k8s.io/cluster-api/util/patch"
 
 	infrav1beta2 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/v1beta2"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/authenticator"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/cos"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/globaltagging"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/powervs"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/resourcecontroller"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/vpc"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloudevents"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloudinit"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/debugdump"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/endpoints"
-	ignV2Types "sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/ignition"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/ignition"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/names"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/options"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/record"
 )
@@ -79,6 +86,7 @@ type PowerVSMachineScopeParams struct {
 	IBMPowerVSCluster *infrav1beta2.IBMPowerVSCluster
 	IBMPowerVSMachine *infrav1beta2.IBMPowerVSMachine
 	IBMPowerVSImage   *infrav1beta2.IBMPowerVSImage
+	IBMPowerVSNetwork *infrav1beta2.IBMPowerVSNetwork
 	ServiceEndpoint   []endpoints.ServiceEndpoint
 	DHCPIPCacheStore  cache.Store
 }
@@ -97,6 +105,7 @@ type PowerVSMachineScope struct {
 	IBMPowerVSCluster *infrav1beta2.IBMPowerVSCluster
 	IBMPowerVSMachine *infrav1beta2.IBMPowerVSMachine
 	IBMPowerVSImage   *infrav1beta2.IBMPowerVSImage
+	IBMPowerVSNetwork *infrav1beta2.IBMPowerVSNetwork
 	ServiceEndpoint   []endpoints.ServiceEndpoint
 	DHCPIPCacheStore  cache.Store
 }
@@ -130,6 +139,7 @@ func NewPowerVSMachineScope(params PowerVSMachineScopeParams) (scope *PowerVSMac
 	scope.IBMPowerVSMachine = params.IBMPowerVSMachine
 	scope.IBMPowerVSCluster = params.IBMPowerVSCluster
 	scope.IBMPowerVSImage = params.IBMPowerVSImage
+	scope.IBMPowerVSNetwork = params.IBMPowerVSNetwork
 	scope.ServiceEndpoint = params.ServiceEndpoint
 
 	if params.Logger == (logr.Logger{}) {
@@ -147,8 +157,17 @@ func NewPowerVSMachineScope(params PowerVSMachineScopeParams) (scope *PowerVSMac
 	}
 	scope.patchHelper = helper
 
+	auth, err := getAuthenticator(params.Client, params.IBMPowerVSCluster.Namespace, params.IBMPowerVSCluster.Spec.CredentialsSecretRef, params.IBMPowerVSCluster.Spec.EnterpriseAccount)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create authenticator: %w", err)
+	}
+
 	// Create Resource Controller client.
-	var serviceOption resourcecontroller.ServiceOptions
+	serviceOption := resourcecontroller.ServiceOptions{
+		ResourceControllerV2Options: &resourcecontrollerv2.ResourceControllerV2Options{
+			Authenticator: auth,
+		},
+	}
 	// Fetch the resource controller endpoint.
 	rcEndpoint := endpoints.FetchEndpoints(string(endpoints.RC), params.ServiceEndpoint)
 	if rcEndpoint != "" {
@@ -170,38 +189,50 @@ func NewPowerVSMachineScope(params PowerVSMachineScopeParams) (scope *PowerVSMac
 	}
 	scope.ResourceClient = rc
 
-	var serviceInstanceID, serviceInstanceName string
+	var serviceInstanceID, serviceInstanceName, zone string
+	privateCloud := params.IBMPowerVSCluster.Spec.PrivateCloud
 	if params.IBMPowerVSMachine.Spec.ServiceInstanceID != "" {
 		serviceInstanceID = params.IBMPowerVSMachine.Spec.ServiceInstanceID
 	} else if params.IBMPowerVSMachine.Spec.ServiceInstance != nil && params.IBMPowerVSMachine.Spec.ServiceInstance.ID != nil {
 		serviceInstanceID = *params.IBMPowerVSMachine.Spec.ServiceInstance.ID
+	} else if privateCloud != nil {
+		if serviceInstanceID, err = privateCloudServiceInstanceID(params.IBMPowerVSCluster); err != nil {
+			return nil, err
+		}
 	} else {
 		serviceInstanceName = fmt.Sprintf("%s-%s", params.IBMPowerVSCluster.GetName(), "serviceInstance")
 		if params.IBMPowerVSCluster.Spec.ServiceInstance != nil && params.IBMPowerVSCluster.Spec.ServiceInstance.Name != nil {
 			serviceInstanceName = *params.IBMPowerVSCluster.Spec.ServiceInstance.Name
 		}
 	}
-	serviceInstance, err := rc.GetServiceInstance(serviceInstanceID, serviceInstanceName, params.IBMPowerVSCluster.Spec.Zone)
-	if err != nil {
-		params.Logger.Error(err, "failed to get PowerVS service instance details", "name", serviceInstanceName, "id", serviceInstanceID)
-		return nil, err
-	}
-	if serviceInstance == nil {
-		return nil, fmt.Errorf("PowerVS service instance %s is not yet created", serviceInstanceName)
-	}
-	if *serviceInstance.State != string(infrav1beta2.ServiceInstanceStateActive) {
-		return nil, fmt.Errorf("PowerVS service instance name: %s id: %s is not in active state", serviceInstanceName, serviceInstanceID)
+	if privateCloud != nil {
+		// the workspaces of Power VS private clouds are not listed in IBM Cloud, hence the zone of the cluster is used.
+		zone = ptr.Deref(params.IBMPowerVSCluster.Spec.Zone, "")
+	} else {
+		serviceInstance, err := rc.GetServiceInstance(serviceInstanceID, serviceInstanceName, params.IBMPowerVSCluster.Spec.Zone)
+		if err != nil {
+			params.Logger.Error(err, "failed to get PowerVS service instance details", "name", serviceInstanceName, "id", serviceInstanceID)
+			return nil, err
+		}
+		if serviceInstance == nil {
+			return nil, fmt.Errorf("PowerVS service instance %s is not yet created", serviceInstanceName)
+		}
+		if *serviceInstance.State != string(infrav1beta2.ServiceInstanceStateActive) {
+			return nil, fmt.Errorf("PowerVS service instance name: %s id: %s is not in active state", serviceInstanceName, serviceInstanceID)
+		}
+		serviceInstanceID = *serviceInstance.GUID
+		zone = *serviceInstance.RegionID
 	}
-	serviceInstanceID = *serviceInstance.GUID
 
-	region := endpoints.ConstructRegionFromZone(*serviceInstance.RegionID)
+	region := endpoints.ConstructRegionFromZone(zone)
 	scope.SetRegion(region)
-	scope.SetZone(*serviceInstance.RegionID)
+	scope.SetZone(zone)
 
 	serviceOptions := powervs.ServiceOptions{
 		IBMPIOptions: &ibmpisession.IBMPIOptions{
-			Debug: params.Logger.V(DEBUGLEVEL).Enabled(),
-			Zone:  *serviceInstance.RegionID,
+			Authenticator: auth,
+			Debug:         params.Logger.V(DEBUGLEVEL).Enabled(),
+			Zone:          zone,
 		},
 		CloudInstanceID: serviceInstanceID,
 	}
@@ -211,6 +242,9 @@ func NewPowerVSMachineScope(params PowerVSMachineScopeParams) (scope *PowerVSMac
 		serviceOptions.IBMPIOptions.URL = svcEndpoint
 		scope.Logger.V(3).Info("Overriding the default PowerVS service endpoint")
 	}
+	if err := applyPowerVSPrivateCloud(params.Client, params.IBMPowerVSCluster.Namespace, params.IBMPowerVSCluster.Spec.CredentialsSecretRef, privateCloud, serviceOptions.IBMPIOptions); err != nil {
+		return nil, err
+	}
 
 	c, err := powervs.NewService(serviceOptions)
 	if err != nil {
@@ -223,6 +257,11 @@ func NewPowerVSMachineScope(params PowerVSMachineScopeParams) (scope *PowerVSMac
 	scope.DHCPIPCacheStore = params.DHCPIPCacheStore
 
 	var vpcRegion string
+	if privateCloud != nil && (params.IBMPowerVSCluster.Spec.VPC == nil || params.IBMPowerVSCluster.Spec.VPC.Region == nil) {
+		// the zones of Power VS private clouds are not mapped to a VPC region, the VPC load balancers are only
+		// managed along with the infrastructure created by the controller which is not supported for private clouds.
+		return scope, nil
+	}
 	if params.IBMPowerVSCluster.Spec.VPC == nil || params.IBMPowerVSCluster.Spec.VPC.Region == nil {
 		vpcRegion, err = regionUtil.VPCRegionForPowerVSRegion(scope.GetRegion())
 		if err != nil {
@@ -232,7 +271,7 @@ func NewPowerVSMachineScope(params PowerVSMachineScopeParams) (scope *PowerVSMac
 		vpcRegion = *params.IBMPowerVSCluster.Spec.VPC.Region
 	}
 	svcEndpoint := endpoints.FetchVPCEndpoint(vpcRegion, params.ServiceEndpoint)
-	vpcClient, err := vpc.NewService(svcEndpoint)
+	vpcClient, err := vpc.NewServiceWithAuthenticator(svcEndpoint, auth)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create IBM VPC client: %w", err)
 	}
@@ -240,6 +279,8 @@ func NewPowerVSMachineScope(params PowerVSMachineScopeParams) (scope *PowerVSMac
 	return scope, nil
 }
 
+// ensureInstanceUnique lists the instances of the workspace instead of using the name index of GetInstanceByName, the index
+// may miss an instance created since it was refreshed and a second instance would then be created.
 func (m *PowerVSMachineScope) ensureInstanceUnique(instanceName string) (*models.PVMInstanceReference, error) {
 	instances, err := m.IBMPowerVSClient.GetAllInstance()
 	if err != nil {
@@ -253,11 +294,132 @@ func (m *PowerVSMachineScope) ensureInstanceUnique(instanceName string) (*models
 	return nil, nil
 }
 
+// IsWaitingForPreviousControlPlaneMachines returns true when the control plane machines of the cluster are provisioned sequentially
+// and any of the control plane machines created before the machine is not yet ready.
+func (m *PowerVSMachineScope) IsWaitingForPreviousControlPlaneMachines() (bool, error) {
+	if m.IBMPowerVSCluster.Spec.ControlPlaneProvisioningPolicy != infrav1beta2.ControlPlaneProvisioningPolicySequential {
+		return false, nil
+	}
+	if !util.IsControlPlaneMachine(m.Machine) {
+		return false, nil
+	}
+	// the instance creation is already triggered for the machine.
+	if m.IBMPowerVSMachine.Status.InstanceID != "" {
+		return false, nil
+	}
+	for _, con := range m.IBMPowerVSMachine.Status.Conditions {
+		if con.Type == infrav1beta2.InstanceReadyCondition && con.Status == corev1.ConditionUnknown {
+			return false, nil
+		}
+	}
+
+	machineList := &capiv1beta1.MachineList{}
+	if err := m.Client.List(context.TODO(), machineList, client.InNamespace(m.Machine.Namespace), client.MatchingLabels{
+		capiv1beta1.ClusterNameLabel:         m.Cluster.Name,
+		capiv1beta1.MachineControlPlaneLabel: "",
+	}); err != nil {
+		return false, fmt.Errorf("failed to list control plane machines: %w", err)
+	}
+
+	for _, machine := range machineList.Items {
+		if machine.Name == m.Machine.Name || !machine.DeletionTimestamp.IsZero() {
+			continue
+		}
+		// only the machines created before the machine are considered.
+		if machine.CreationTimestamp.After(m.Machine.CreationTimestamp.Time) ||
+			(machine.CreationTimestamp.Equal(&m.Machine.CreationTimestamp) && machine.Name > m.Machine.Name) {
+			continue
+		}
+		if !machine.Status.InfrastructureReady {
+			m.V(3).Info("Control plane machine is not yet ready", "machineName", machine.Name)
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// ExceededGuardrails returns the message describing the guardrails of the cluster exceeded by creating the instance
+// of the machine, or an empty string when the instance fits within them. The instances of the other machines of the
+// cluster, including the machines being deleted, are counted once their creation is triggered, along with the pending
+// instances of the machines created before the machine, so machines reconciled at the same time don't exceed the
+// guardrails. An existing instance of the machine is adopted regardless of the guardrails.
+func (m *PowerVSMachineScope) ExceededGuardrails() (string, error) {
+	if m.IBMPowerVSCluster == nil || m.IBMPowerVSCluster.Spec.Guardrails == nil {
+		return "", nil
+	}
+	if powerVSInstanceCreationTriggered(m.IBMPowerVSMachine) {
+		return "", nil
+	}
+
+	instance, err := powerVSInstanceBudget(m.IBMPowerVSMachine)
+	if err != nil {
+		return "", err
+	}
+	machineList := &infrav1beta2.IBMPowerVSMachineList{}
+	if err := m.Client.List(context.TODO(), machineList, client.InNamespace(m.IBMPowerVSMachine.Namespace), client.MatchingLabels{
+		capiv1beta1.ClusterNameLabel: m.Cluster.Name,
+	}); err != nil {
+		return "", fmt.Errorf("failed to list the machines of the cluster: %w", err)
+	}
+
+	var used instanceBudget
+	for i := range machineList.Items {
+		machine := &machineList.Items[i]
+		if machine.Name == m.IBMPowerVSMachine.Name {
+			continue
+		}
+		if !powerVSInstanceCreationTriggered(machine) && !isPendingBefore(machine, m.IBMPowerVSMachine) {
+			continue
+		}
+		budget, err := powerVSInstanceBudget(machine)
+		if err != nil {
+			return "", err
+		}
+		used.instances += budget.instances
+		used.cores += budget.cores
+		used.memoryGiB += budget.memoryGiB
+	}
+	exceeded, err := exceededGuardrails(m.IBMPowerVSCluster.Spec.Guardrails, used, instance)
+	if err != nil || exceeded == "" {
+		return exceeded, err
+	}
+	// The instance may already exist, e.g. when the status of the machine was lost, it is then adopted rather than created.
+	existing, err := m.ensureInstanceUnique(m.instanceName())
+	if err != nil {
+		return "", fmt.Errorf("failed to check if the instance of the machine exists: %w", err)
+	}
+	if existing != nil {
+		return "", nil
+	}
+	return exceeded, nil
+}
+
+// powerVSInstanceCreationTriggered returns true when the instance of the machine is created or its creation is in progress.
+func powerVSInstanceCreationTriggered(machine *infrav1beta2.IBMPowerVSMachine) bool {
+	if machine.Status.InstanceID != "" {
+		return true
+	}
+	for _, con := range machine.Status.Conditions {
+		if con.Type == infrav1beta2.InstanceReadyCondition && con.Status == corev1.ConditionUnknown {
+			return true
+		}
+	}
+	return false
+}
+
+func powerVSInstanceBudget(machine *infrav1beta2.IBMPowerVSMachine) (instanceBudget, error) {
+	processors, err := coresValue(machine.Spec.Processors)
+	if err != nil {
+		return instanceBudget{}, fmt.Errorf("failed to get the processors of machine %s: %w", machine.Name, err)
+	}
+	return instanceBudget{instances: 1, cores: processors, memoryGiB: float64(machine.Spec.MemoryGiB)}, nil
+}
+
 // CreateMachine creates a powervs machine.
 func (m *PowerVSMachineScope) CreateMachine() (*models.PVMInstanceReference, error) {
 	s := m.IBMPowerVSMachine.Spec
 
-	instanceReply, err := m.ensureInstanceUnique(m.IBMPowerVSMachine.Name)
+	instanceReply, err := m.ensureInstanceUnique(m.instanceName())
 	if err != nil {
 		return nil, err
 	} else if instanceReply != nil {
@@ -273,6 +435,11 @@ func (m *PowerVSMachineScope) CreateMachine() (*models.PVMInstanceReference, err
 		}
 	}
 
+	// Clean up the boot volumes left behind by the previous failed attempts before retrying the instance creation.
+	if err := m.DeleteOrphanBootVolumes(); err != nil {
+		m.Error(err, "failed to delete orphan boot volumes")
+	}
+
 	// TODO(karthik-k-n): Fix this
 	userData, userDataErr := m.resolveUserData()
 	if userDataErr != nil {
@@ -303,7 +470,9 @@ func (m *PowerVSMachineScope) CreateMachine() (*models.PVMInstanceReference, err
 		}
 	}
 	network := s.Network
-	if network.ID == nil && network.Name == nil && network.RegEx == nil {
+	if m.IBMPowerVSNetwork != nil {
+		network.ID = &m.IBMPowerVSNetwork.Status.NetworkID
+	} else if network.ID == nil && network.Name == nil && network.RegEx == nil {
 		// if the network is nil, Fetch from cluster.
 		if m.IBMPowerVSCluster.Status.Network != nil && m.IBMPowerVSCluster.Status.Network.ID != nil {
 			network.ID = m.IBMPowerVSCluster.Status.Network.ID
@@ -316,34 +485,76 @@ func (m *PowerVSMachineScope) CreateMachine() (*models.PVMInstanceReference, err
 		return nil, fmt.Errorf("error getting network ID: %v", err)
 	}
 
+	networks := []*models.PVMInstanceAddNetwork{
+		{
+			NetworkID: networkID,
+			IPAddress: s.NetworkIPAddress,
+		},
+	}
+	additionalNetworks, err := m.additionalNetworks()
+	if err != nil {
+		record.Warnf(m.IBMPowerVSMachine, "FailedRetrieveNetwork", "Failed additional network retrieval - %v", err)
+		return nil, fmt.Errorf("error getting additional network IDs: %w", err)
+	}
+	networks = append(networks, additionalNetworks...)
+
 	procType := strings.ToLower(string(s.ProcessorType))
 
 	params := &p_cloud_p_vm_instances.PcloudPvminstancesPostParams{
 		Body: &models.PVMInstanceCreate{
-			ImageID: imageID,
-			Networks: []*models.PVMInstanceAddNetwork{
-				{
-					NetworkID: networkID,
-					//IPAddress: address,
-				},
-			},
-			ServerName: &m.IBMPowerVSMachine.Name,
+			ImageID:    imageID,
+			Networks:   networks,
+			ServerName: ptr.To(m.instanceName()),
 			Memory:     &memory,
 			Processors: &processors,
 			ProcType:   &procType,
 			SysType:    s.SystemType,
 			UserData:   userData,
+			UserTags:   m.userTags(),
 		},
 	}
 	if s.SSHKey != "" {
 		params.Body.KeyPairName = s.SSHKey
+	} else if sshKey := m.IBMPowerVSCluster.Status.SSHKey; sshKey != nil && sshKey.ID != nil {
+		params.Body.KeyPairName = *sshKey.ID
+	}
+	if s.SharedProcessorPool != nil {
+		sharedProcessorPoolID, err := getSharedProcessorPoolID(*s.SharedProcessorPool, m)
+		if err != nil {
+			record.Warnf(m.IBMPowerVSMachine, "FailedRetrieveSharedProcessorPool", "Failed shared processor pool retrieval - %v", err)
+			return nil, fmt.Errorf("error getting shared processor pool ID: %v", err)
+		}
+		params.Body.SharedProcessorPool = *sharedProcessorPoolID
+	}
+	if s.PlacementGroup != nil {
+		placementGroupID, err := getPlacementGroupID(*s.PlacementGroup, m)
+		if err != nil {
+			record.Warnf(m.IBMPowerVSMachine, "FailedRetrievePlacementGroup", "Failed placement group retrieval - %v", err)
+			return nil, fmt.Errorf("error getting placement group ID: %v", err)
+		}
+		params.Body.PlacementGroup = *placementGroupID
+	}
+	volumeIDs, err := m.dataVolumeIDs()
+	if err != nil {
+		record.Warnf(m.IBMPowerVSMachine, "FailedRetrieveDataVolumes", "Failed data volumes retrieval - %v", err)
+		return nil, fmt.Errorf("error getting data volume IDs: %w", err)
+	}
+	if len(volumeIDs) > 0 {
+		params.Body.VolumeIDs = volumeIDs
 	}
-	_, err = m.IBMPowerVSClient.CreateInstance(params.Body)
+	instanceList, err := m.IBMPowerVSClient.CreateInstance(params.Body)
 	if err != nil {
 		record.Warnf(m.IBMPowerVSMachine, "FailedCreateInstance", "Failed instance creation - %v", err)
+		m.recordAction(infrav1beta2.MachineActionCreate, "", "failed to create instance", err)
 		return nil, err
 	}
 	record.Eventf(m.IBMPowerVSMachine, "SuccessfulCreateInstance", "Created Instance %q", m.IBMPowerVSMachine.Name)
+	// the instance is looked up by name on the next reconciliation, the ID returned on creation is only recorded in the action.
+	var instanceID string
+	if instanceList != nil && len(*instanceList) > 0 && (*instanceList)[0] != nil && (*instanceList)[0].PvmInstanceID != nil {
+		instanceID = *(*instanceList)[0].PvmInstanceID
+	}
+	m.recordAction(infrav1beta2.MachineActionCreate, instanceID, fmt.Sprintf("created instance %s", m.IBMPowerVSMachine.Name), nil)
 	return nil, nil
 }
 
@@ -353,13 +564,35 @@ func (m *PowerVSMachineScope) resolveUserData() (string, error) {
 		return "", err
 	}
 	if m.UseIgnition() {
+		if len(m.IBMPowerVSMachine.Spec.NodeLabels) > 0 || len(m.IBMPowerVSMachine.Spec.NodeTaints) > 0 {
+			record.Warnf(m.IBMPowerVSMachine, "SkippedNodeRegistration", "Skipped node labels and taints as they are only supported with cloud-config bootstrap data")
+		}
 		data, err := m.ignitionUserData(userData)
 		if err != nil {
 			return "", err
 		}
 		return base64.StdEncoding.EncodeToString(data), nil
 	}
-	return base64.StdEncoding.EncodeToString(userData), err
+	if len(m.IBMPowerVSMachine.Spec.NTPServers) > 0 {
+		var injected bool
+		if userData, injected = cloudinit.InjectChrony(userData, m.IBMPowerVSMachine.Spec.NTPServers); !injected {
+			m.V(3).Info("Skipping chrony configuration as bootstrap data is not a cloud-config or already configures NTP")
+		}
+	}
+	nodeRegistration := cloudinit.NodeRegistrationBoothook(m.IBMPowerVSMachine.Spec.NodeLabels, m.IBMPowerVSMachine.Spec.NodeTaints)
+	if wrapped, injected := injectBoothooks(userData, m.IBMPowerVSMachine.Spec.ReportBootstrapResult, nodeRegistration); injected && base64.StdEncoding.EncodedLen(len(wrapped)) <= powerVSUserDataLimit {
+		return base64.StdEncoding.EncodeToString(wrapped), nil
+	}
+	if nodeRegistration != "" {
+		record.Warnf(m.IBMPowerVSMachine, "SkippedNodeRegistration", "Skipped node labels and taints as bootstrap data is not a cloud-config or exceeds the user data limit")
+	}
+	if m.IBMPowerVSMachine.Spec.ReportBootstrapResult {
+		record.Warnf(m.IBMPowerVSMachine, "SkippedBootstrapResult", "Skipped bootstrap result reporting as bootstrap data is not a cloud-config or exceeds the user data limit")
+	}
+	if size := base64.StdEncoding.EncodedLen(len(userData)); size > powerVSUserDataLimit {
+		return "", fmt.Errorf("base64 encoded bootstrap data of %d bytes exceeds the user data limit of %d bytes", size, powerVSUserDataLimit)
+	}
+	return base64.StdEncoding.EncodeToString(userData), nil
 }
 
 func getIgnitionVersion(scope *PowerVSMachineScope) string {
@@ -390,6 +623,29 @@ func (m *PowerVSMachineScope) Name() string {
 	return m.IBMPowerVSMachine.Name
 }
 
+// clusterTags returns the user Tags attached to the instance and the data volumes of the machine, which identify their cluster.
+func (m *PowerVSMachineScope) clusterTags() models.Tags {
+	if m.Cluster == nil {
+		return nil
+	}
+	tags := models.Tags{globaltagging.ClusterTag(m.Cluster.Namespace, m.Cluster.Name)}
+	if m.IBMPowerVSCluster != nil && m.IBMPowerVSCluster.UID != "" {
+		tags = append(tags, globaltagging.ClusterUIDTag(string(m.IBMPowerVSCluster.UID)))
+	}
+	return tags
+}
+
+// userTags returns the user tags of the instance and the data volumes of the machine, the tag of the cluster along with
+// the user tags set in the spec of the machine.
+func (m *PowerVSMachineScope) userTags() models.Tags {
+	return append(m.clusterTags(), m.IBMPowerVSMachine.Spec.UserTags...)
+}
+
+// instanceName returns the name of the instance of the machine, truncated to the maximum length of the Power VS instance names.
+func (m *PowerVSMachineScope) instanceName() string {
+	return names.Truncate(m.IBMPowerVSMachine.Name, names.PowerVSInstanceMaxLength)
+}
+
 func (m *PowerVSMachineScope) createIgnitionData(data []byte) (string, error) {
 	if len(data) == 0 {
 		return "", fmt.Errorf("user data is empty")
@@ -418,7 +674,7 @@ func (m *PowerVSMachineScope) createIgnitionData(data []byte) (string, error) {
 
 	objHost := fmt.Sprintf("%s.s3.%s.%s", bucket, region, cosURLDomain)
 
-	cosServiceEndpoint := endpoints.FetchEndpoints(string(endpoints.COS), m.ServiceEndpoint)
+	cosServiceEndpoint := endpoints.FetchRegionalEndpoint(string(endpoints.COS), region, m.ServiceEndpoint)
 	if cosServiceEndpoint != "" {
 		m.Logger.V(3).Info("Overriding the default COS endpoint in ignition URL", "cosEndpoint", cosServiceEndpoint)
 		cosURL, _ := url.Parse(cosServiceEndpoint)
@@ -438,72 +694,62 @@ func (m *PowerVSMachineScope) createIgnitionData(data []byte) (string, error) {
 	return objectURL.String(), nil
 }
 
-func (m *PowerVSMachineScope) ignitionUserData(userData []byte) ([]byte, error) {
-	objectURL, err := m.createIgnitionData(userData)
+// getIAMToken returns the IAM token for the credentials secret referenced by the cluster,
+// or for the credentials configured for the controller.
+func (m *PowerVSMachineScope) getIAMToken() (string, error) {
+	secretRef := resolveCredentialsSecretRef(m.IBMPowerVSCluster.Spec.CredentialsSecretRef)
+	account := m.IBMPowerVSCluster.Spec.EnterpriseAccount
+	if secretRef == nil && account == nil && !authenticator.IsTrustedProfileConfigured() {
+		auth, err := authenticator.GetIAMAuthenticator()
+		if err != nil {
+			return "", err
+		}
+		return auth.GetToken()
+	}
+	auth, err := getAuthenticator(m.Client, m.IBMPowerVSCluster.Namespace, secretRef, account)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create user data object %w", err)
+		return "", err
 	}
+	return authenticator.GetToken(auth)
+}
 
-	auth, err := authenticator.GetIAMAuthenticator()
-	if err != nil {
+func (m *PowerVSMachineScope) ignitionUserData(userData []byte) ([]byte, error) {
+	ignVersion := getIgnitionVersion(m)
+
+	// Fetch the bootstrap data with a pre-signed URL when the credentials secret holds the HMAC credentials of the COS,
+	// so the user data doesn't carry an IAM token.
+	accessKey, secretKey, err := getCOSHMACCredentials(m.Client, m.IBMPowerVSCluster.Namespace, m.IBMPowerVSCluster.Spec.CredentialsSecretRef)
+	if err == nil {
+		region := m.bucketRegion()
+		if region == "" {
+			return nil, fmt.Errorf("failed to determine COS bucket region, both bucket region and VPC region not set")
+		}
+		cosClient, err := newCOSHMACClient(region, m.ServiceEndpoint, accessKey, secretKey)
+		if err != nil {
+			return nil, err
+		}
+		objectURL, err := stageBootstrapData(cosClient, m.bucketName(), m.bootstrapDataKey(), userData)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create user data object %w", err)
+		}
+		return ignition.ReplaceConfig(ignVersion, objectURL, "")
+	} else if !errors.Is(err, errCOSHMACCredentialsNotFound) {
 		return nil, err
 	}
 
-	iamtoken, err := auth.GetToken()
+	objectURL, err := m.createIgnitionData(userData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create user data object %w", err)
+	}
+
+	iamtoken, err := m.getIAMToken()
 	if err != nil {
 		return nil, err
 	}
 	if iamtoken == "" {
 		return nil, fmt.Errorf("IAM token is empty")
 	}
-	token := "Bearer " + iamtoken
-
-	ignVersion := getIgnitionVersion(m)
-	semver, err := semver.ParseTolerant(ignVersion)
-	if err != nil {
-		return nil, fmt.Errorf("failed to parse ignition version %q: %w", ignVersion, err)
-	}
-
-	switch semver.Major {
-	case 2:
-		ignData := &ignV2Types.Config{
-			Ignition: ignV2Types.Ignition{
-				Version: semver.String(),
-				Config: ignV2Types.IgnitionConfig{
-					Replace: &ignV2Types.ConfigReference{
-						Source: objectURL,
-						HTTPHeaders: ignV2Types.HTTPHeaders{
-							{
-								Name:  "Authorization",
-								Value: token,
-							},
-						},
-					},
-				},
-			},
-		}
-		return json.Marshal(ignData)
-	case 3:
-		ignData := &ignV3Types.Config{
-			Ignition: ignV3Types.Ignition{
-				Version: semver.String(),
-				Config: ignV3Types.IgnitionConfig{
-					Replace: ignV3Types.Resource{
-						Source: aws.String(objectURL),
-						HTTPHeaders: ignV3Types.HTTPHeaders{
-							{
-								Name:  "Authorization",
-								Value: aws.String(token),
-							},
-						},
-					},
-				},
-			},
-		}
-		return json.Marshal(ignData)
-	default:
-		return nil, fmt.Errorf("unsupported ignition version %q", ignVersion)
-	}
+	return ignition.ReplaceConfig(ignVersion, objectURL, "Bearer "+iamtoken)
 }
 
 // UseIgnition returns true if Ignition is set in IBMPowerVSCluster.
@@ -516,6 +762,11 @@ func (m *PowerVSMachineScope) Close() error {
 	return m.PatchObject()
 }
 
+// recordAction records an action performed against the instance in the action history of the IBMPowerVSMachine.
+func (m *PowerVSMachineScope) recordAction(actionType infrav1beta2.MachineActionType, instanceID, message string, err error) {
+	m.IBMPowerVSMachine.Status.ActionHistory = appendMachineAction(m.IBMPowerVSMachine.Status.ActionHistory, actionType, instanceID, message, err)
+}
+
 // PatchObject persists the cluster configuration and status.
 func (m *PowerVSMachineScope) PatchObject() error {
 	return m.patchHelper.Patch(context.TODO(), m.IBMPowerVSMachine)
@@ -525,12 +776,325 @@ func (m *PowerVSMachineScope) PatchObject() error {
 func (m *PowerVSMachineScope) DeleteMachine() error {
 	if err := m.IBMPowerVSClient.DeleteInstance(m.IBMPowerVSMachine.Status.InstanceID); err != nil {
 		record.Warnf(m.IBMPowerVSMachine, "FailedDeleteInstance", "Failed instance deletion - %v", err)
+		m.recordAction(infrav1beta2.MachineActionDelete, m.IBMPowerVSMachine.Status.InstanceID, "failed to delete instance", err)
 		return err
 	}
 	record.Eventf(m.IBMPowerVSMachine, "SuccessfulDeleteInstance", "Deleted Instance %q", m.IBMPowerVSMachine.Name)
+	m.recordAction(infrav1beta2.MachineActionDelete, m.IBMPowerVSMachine.Status.InstanceID, "deleted instance", nil)
 	return nil
 }
 
+// ReconcileInstanceShutdown shuts down the operating system of the instance gracefully before it gets deleted,
+// it returns true while the instance is shutting down within the shutdown timeout of the machine.
+func (m *PowerVSMachineScope) ReconcileInstanceShutdown() (bool, error) {
+	if isInstanceShutdownDone(m.IBMPowerVSMachine) {
+		return false, nil
+	}
+	timeout := shutdownTimeout(m.IBMPowerVSMachine.Spec.ShutdownTimeout)
+	if timeout == 0 {
+		return false, nil
+	}
+	instanceID := m.IBMPowerVSMachine.Status.InstanceID
+	instance, err := m.IBMPowerVSClient.GetInstance(instanceID)
+	if err != nil {
+		var notFound *p_cloud_p_vm_instances.PcloudPvminstancesGetNotFound
+		if errors.As(err, &notFound) {
+			m.Info("instance not found, skipping shutdown", "instanceID", instanceID)
+			conditions.MarkTrue(m.IBMPowerVSMachine, infrav1beta2.InstanceShutdownCondition)
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to get instance %s: %w", instanceID, err)
+	}
+	switch infrav1beta2.PowerVSInstanceState(ptr.Deref(instance.Status, "")) {
+	case infrav1beta2.PowerVSInstanceStateSHUTOFF:
+		conditions.MarkTrue(m.IBMPowerVSMachine, infrav1beta2.InstanceShutdownCondition)
+		return false, nil
+	case infrav1beta2.PowerVSInstanceStateERROR:
+		// The operating system of an instance in the ERROR state can't be shut down.
+		conditions.MarkFalse(m.IBMPowerVSMachine, infrav1beta2.InstanceShutdownCondition, infrav1beta2.InstanceErroredReason, capiv1beta1.ConditionSeverityWarning, "")
+		return false, nil
+	}
+	m.V(3).Info("Shutting down instance", "instanceID", instanceID)
+	return reconcileInstanceShutdown(m.IBMPowerVSMachine, timeout, func() error {
+		if err := m.IBMPowerVSClient.InstanceAction(instanceID, &models.PVMInstanceAction{Action: ptr.To(models.PVMInstanceActionActionStop)}); err != nil {
+			m.recordAction(infrav1beta2.MachineActionStop, instanceID, "failed to stop instance", err)
+			return err
+		}
+		m.recordAction(infrav1beta2.MachineActionStop, instanceID, "stopping instance before deletion", nil)
+		return nil
+	})
+}
+
+// ReconcileVolumeDetach detaches the data volumes from the instance before it gets deleted, it returns true
+// while data volumes are attached to the instance within the volume detach timeout.
+func (m *PowerVSMachineScope) ReconcileVolumeDetach() (bool, error) {
+	if isVolumeDetachDone(m.IBMPowerVSMachine) {
+		return false, nil
+	}
+	instanceID := m.IBMPowerVSMachine.Status.InstanceID
+	volumes, err := m.IBMPowerVSClient.GetAllInstanceVolumes(instanceID)
+	if err != nil {
+		var notFound *p_cloud_volumes.PcloudPvminstancesVolumesGetallNotFound
+		if errors.As(err, &notFound) {
+			m.Info("instance not found, skipping volume detachment", "instanceID", instanceID)
+			conditions.MarkTrue(m.IBMPowerVSMachine, infrav1beta2.VolumesDetachedCondition)
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to get volumes of instance %s: %w", instanceID, err)
+	}
+	// The detachment is requested once, Power VS keeps reporting the volumes until they are detached.
+	detaching := conditions.GetReason(m.IBMPowerVSMachine, infrav1beta2.VolumesDetachedCondition) == infrav1beta2.DetachingVolumesReason
+	attached := 0
+	for _, volume := range volumes.Volumes {
+		if volume == nil || volume.VolumeID == nil || ptr.Deref(volume.BootVolume, false) {
+			continue
+		}
+		attached++
+		if detaching {
+			continue
+		}
+		m.Info("Detaching volume from instance", "volumeID", *volume.VolumeID, "instanceID", instanceID)
+		if err := m.IBMPowerVSClient.DetachVolume(instanceID, *volume.VolumeID); err != nil {
+			record.Warnf(m.IBMPowerVSMachine, "FailedDetachVolume", "Failed volume %s detachment - %v", *volume.VolumeID, err)
+			return false, err
+		}
+		record.Eventf(m.IBMPowerVSMachine, "SuccessfulDetachVolume", "Detached volume %q", ptr.Deref(volume.Name, *volume.VolumeID))
+	}
+	if attached == 0 {
+		conditions.MarkTrue(m.IBMPowerVSMachine, infrav1beta2.VolumesDetachedCondition)
+		return false, nil
+	}
+	return markVolumesDetaching(m.IBMPowerVSMachine, attached), nil
+}
+
+// ReconcileDataVolumes creates the additional data volumes of the machine which don't exist yet, and records their IDs in status.
+// The instance is created with the data volumes attached, hence it returns true while a data volume is still being created.
+func (m *PowerVSMachineScope) ReconcileDataVolumes() (bool, error) {
+	if len(m.IBMPowerVSMachine.Spec.AdditionalVolumes) == 0 {
+		return false, nil
+	}
+	requeue := false
+	for i, volume := range m.IBMPowerVSMachine.Spec.AdditionalVolumes {
+		if volume == nil {
+			continue
+		}
+		name := m.dataVolumeName(i, volume)
+		if id := m.dataVolumeID(name); id != "" {
+			v, err := m.IBMPowerVSClient.GetVolume(id)
+			if err != nil {
+				return false, fmt.Errorf("failed to get data volume %s: %w", name, err)
+			}
+			switch state := v.State; state {
+			case "available", "in-use":
+			case "error":
+				return false, fmt.Errorf("data volume %s is in error state", name)
+			default:
+				m.V(3).Info("Waiting for data volume to be available", "volumeName", name, "state", state)
+				requeue = true
+			}
+			continue
+		}
+		m.Info("Creating data volume", "volumeName", name, "sizeGiB", volume.SizeGiB, "tier", volume.Tier)
+		body := &models.CreateDataVolume{
+			Name:     ptr.To(name),
+			Size:     ptr.To(float64(volume.SizeGiB)),
+			DiskType: volume.Tier,
+			UserTags: m.userTags(),
+		}
+		v, err := m.IBMPowerVSClient.CreateVolume(body)
+		if err != nil {
+			record.Warnf(m.IBMPowerVSMachine, "FailedCreateVolume", "Failed data volume %q creation - %v", name, err)
+			return false, fmt.Errorf("failed to create data volume %s: %w", name, err)
+		}
+		if v == nil || v.VolumeID == nil {
+			return false, fmt.Errorf("created data volume %s has no ID", name)
+		}
+		m.setDataVolumeID(name, *v.VolumeID)
+		record.Eventf(m.IBMPowerVSMachine, "SuccessfulCreateVolume", "Created data volume %q", name)
+		requeue = true
+	}
+	return requeue, nil
+}
+
+// DeleteDataVolumes deletes the additional data volumes created for the machine which are not kept on instance deletion.
+// It returns true while a data volume is still being created or detached from the instance, the volumes which did not
+// detach within the volume detach timeout, or which are attached to other instances, are left behind.
+func (m *PowerVSMachineScope) DeleteDataVolumes() (bool, error) {
+	instanceID := m.IBMPowerVSMachine.Status.InstanceID
+	detaching := instanceID != "" &&
+		conditions.GetReason(m.IBMPowerVSMachine, infrav1beta2.VolumesDetachedCondition) != infrav1beta2.VolumeDetachTimeoutReason
+	requeue := false
+	var errs []error
+	for i, volume := range m.IBMPowerVSMachine.Spec.AdditionalVolumes {
+		if volume == nil || !ptr.Deref(volume.DeleteVolumeOnInstanceDelete, true) {
+			continue
+		}
+		name := m.dataVolumeName(i, volume)
+		id := m.dataVolumeID(name)
+		if id == "" {
+			continue
+		}
+		v, err := m.IBMPowerVSClient.GetVolume(id)
+		if err != nil {
+			var notFound *p_cloud_volumes.PcloudCloudinstancesVolumesGetNotFound
+			if errors.As(err, &notFound) {
+				m.setDataVolumeID(name, "")
+				continue
+			}
+			errs = append(errs, fmt.Errorf("failed to get data volume %s: %w", name, err))
+			continue
+		}
+		attachedElsewhere := slices.ContainsFunc(v.PvmInstanceIDs, func(id string) bool { return id != instanceID })
+		switch state := v.State; {
+		case attachedElsewhere:
+			record.Warnf(m.IBMPowerVSMachine, "SkippedDeleteVolume", "Skipped deletion of data volume %q which is attached to another instance", name)
+			m.setDataVolumeID(name, "")
+			continue
+		case state == "creating", state == "in-use" && detaching:
+			m.V(3).Info("Waiting for data volume to be available before deleting it", "volumeName", name, "state", state)
+			requeue = true
+			continue
+		case state == "in-use" || len(v.PvmInstanceIDs) != 0:
+			record.Warnf(m.IBMPowerVSMachine, "SkippedDeleteVolume", "Skipped deletion of data volume %q which is still attached", name)
+			m.setDataVolumeID(name, "")
+			continue
+		}
+		m.Info("Deleting data volume", "volumeName", name, "volumeID", id)
+		if err := m.IBMPowerVSClient.DeleteVolume(id); err != nil {
+			record.Warnf(m.IBMPowerVSMachine, "FailedDeleteVolume", "Failed data volume %q deletion - %v", name, err)
+			errs = append(errs, fmt.Errorf("failed to delete data volume %s: %w", name, err))
+			continue
+		}
+		m.setDataVolumeID(name, "")
+		record.Eventf(m.IBMPowerVSMachine, "SuccessfulDeleteVolume", "Deleted data volume %q", name)
+	}
+	return requeue, errors.Join(errs...)
+}
+
+// dataVolumeIDs returns the IDs of the additional data volumes of the machine in the order of the spec.
+func (m *PowerVSMachineScope) dataVolumeIDs() ([]string, error) {
+	ids := make([]string, 0, len(m.IBMPowerVSMachine.Spec.AdditionalVolumes))
+	for i, volume := range m.IBMPowerVSMachine.Spec.AdditionalVolumes {
+		if volume == nil {
+			continue
+		}
+		name := m.dataVolumeName(i, volume)
+		id := m.dataVolumeID(name)
+		if id == "" {
+			return nil, fmt.Errorf("data volume %s not found", name)
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// dataVolumeName returns the name of the data volume at index i of the machine.
+func (m *PowerVSMachineScope) dataVolumeName(i int, volume *infrav1beta2.PowerVSVolume) string {
+	return dataVolumeName(m.Machine.Spec.ClusterName, m.IBMPowerVSMachine.Name, i, volume.Name)
+}
+
+// dataVolumeID returns the ID of the data volume with the given name recorded in status, it is empty when the
+// volume is not created yet.
+func (m *PowerVSMachineScope) dataVolumeID(name string) string {
+	for _, volume := range m.IBMPowerVSMachine.Status.DataVolumes {
+		if volume.Name == name {
+			return volume.VolumeID
+		}
+	}
+	return ""
+}
+
+// setDataVolumeID records the ID of the data volume with the given name in status, the volume is removed from status
+// when the ID is empty.
+func (m *PowerVSMachineScope) setDataVolumeID(name, id string) {
+	volumes := slices.DeleteFunc(m.IBMPowerVSMachine.Status.DataVolumes, func(volume infrav1beta2.PowerVSMachineVolumeStatus) bool {
+		return volume.Name == name
+	})
+	if id != "" {
+		volumes = append(volumes, infrav1beta2.PowerVSMachineVolumeStatus{Name: name, VolumeID: id})
+	}
+	m.IBMPowerVSMachine.Status.DataVolumes = volumes
+}
+
+// ShouldAutoRepair returns true when the Machine should be replaced because its instance is in the ERROR state or unhealthy.
+func (m *PowerVSMachineScope) ShouldAutoRepair() bool {
+	return shouldAutoRepair(m.Machine, m.IBMPowerVSMachine.Spec.AutoRepairPolicy)
+}
+
+// DeleteOwnerMachine deletes the Machine owning the IBMPowerVSMachine, so it gets replaced by its MachineSet or MachinePool.
+// The reason is recorded in the action history of the IBMPowerVSMachine.
+func (m *PowerVSMachineScope) DeleteOwnerMachine(reason string) error {
+	return remediateMachine(m.Client, m.Machine, &m.IBMPowerVSMachine.Status.ActionHistory, m.IBMPowerVSMachine.Status.InstanceID, reason)
+}
+
+// DeleteOrphanBootVolumes deletes the boot volumes left behind by the failed instance creations of the machine,
+// it does nothing unless a failed instance creation is recorded in the action history of the machine.
+func (m *PowerVSMachineScope) DeleteOrphanBootVolumes() error {
+	if !hasFailedInstanceCreation(m.IBMPowerVSMachine.Status.ActionHistory) {
+		return nil
+	}
+	namePattern := fmt.Sprintf("^%s-[0-9a-fA-F]+-[0-9a-fA-F]+-boot-[0-9]+$", regexp.QuoteMeta(m.instanceName()))
+	return deleteOrphanBootVolumes(m.IBMPowerVSClient, m.IBMPowerVSMachine, namePattern, m.Logger)
+}
+
+// hasFailedInstanceCreation returns true when the action history contains a failed instance creation.
+func hasFailedInstanceCreation(history []infrav1beta2.MachineAction) bool {
+	for _, action := range history {
+		if action.Type == infrav1beta2.MachineActionCreate && !action.Succeeded {
+			return true
+		}
+	}
+	return false
+}
+
+// deleteOrphanBootVolumes deletes the boot volumes which are not attached to any instance and whose name matches namePattern.
+// Power VS names the boot volume of an instance as <instance name>-<id>-<id>-boot-<index>, when the instance creation
+// fails the boot volume may not be cleaned up and is left in available state without any instance attached to it.
+func deleteOrphanBootVolumes(powerVSClient powervs.PowerVS, obj *infrav1beta2.IBMPowerVSMachine, namePattern string, log logr.Logger) error {
+	re, err := regexp.Compile(namePattern)
+	if err != nil {
+		return fmt.Errorf("failed to compile boot volume name pattern %s: %w", namePattern, err)
+	}
+	volumes, err := powerVSClient.GetAllVolumes()
+	if err != nil {
+		return fmt.Errorf("failed to fetch volumes: %w", err)
+	}
+	if volumes == nil {
+		return nil
+	}
+
+	var errs []error
+	for _, volume := range volumes.Volumes {
+		if !isOrphanBootVolume(volume, re) {
+			continue
+		}
+		log.Info("Deleting orphan boot volume", "volumeName", *volume.Name, "volumeID", *volume.VolumeID)
+		if err := powerVSClient.DeleteVolume(*volume.VolumeID); err != nil {
+			record.Warnf(obj, "FailedDeleteOrphanBootVolume", "Failed orphan boot volume %q deletion - %v", *volume.Name, err)
+			errs = append(errs, fmt.Errorf("failed to delete orphan boot volume %s: %w", *volume.Name, err))
+			continue
+		}
+		record.Eventf(obj, "SuccessfulDeleteOrphanBootVolume", "Deleted orphan boot volume %q", *volume.Name)
+	}
+	return errors.Join(errs...)
+}
+
+func isOrphanBootVolume(volume *models.VolumeReference, re *regexp.Regexp) bool {
+	if volume == nil || volume.Name == nil || volume.VolumeID == nil {
+		return false
+	}
+	if len(volume.PvmInstanceIDs) != 0 {
+		return false
+	}
+	if volume.State == nil || *volume.State != "available" {
+		return false
+	}
+	if volume.Bootable == nil || !*volume.Bootable {
+		return false
+	}
+	return re.MatchString(*volume.Name)
+}
+
 // DeleteMachineIgnition deletes the ignition associated with machine.
 func (m *PowerVSMachineScope) DeleteMachineIgnition() error {
 	_, err := m.GetRawBootstrapData()
@@ -588,12 +1152,15 @@ func (m *PowerVSMachineScope) createCOSClient() (*cos.Service, error) {
 		return nil, fmt.Errorf("COS service instance is not in active state, current state: %s", *serviceInstance.State)
 	}
 
-	props, err := authenticator.GetProperties()
+	apiKey, err := getAPIKey(m.Client, m.IBMPowerVSCluster.Namespace, m.IBMPowerVSCluster.Spec.CredentialsSecretRef, m.IBMPowerVSCluster.Spec.EnterpriseAccount)
+	if err != nil {
+		return nil, err
+	}
+	trustedProfileAuth, err := getTrustedProfileAuthenticator(m.Client, m.IBMPowerVSCluster.Namespace, m.IBMPowerVSCluster.Spec.CredentialsSecretRef, m.IBMPowerVSCluster.Spec.EnterpriseAccount)
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch service properties: %w", err)
+		return nil, err
 	}
-	apiKey := props["APIKEY"]
-	if apiKey == "" {
+	if apiKey == "" && trustedProfileAuth == nil {
 		fmt.Printf("IBM Cloud API key is not provided, set %s environmental variable", "IBMCLOUD_API_KEY")
 	}
 
@@ -604,7 +1171,7 @@ func (m *PowerVSMachineScope) createCOSClient() (*cos.Service, error) {
 
 	serviceEndpoint := fmt.Sprintf("s3.%s.%s", region, cosURLDomain)
 	// Fetch the COS service endpoint.
-	cosServiceEndpoint := endpoints.FetchEndpoints(string(endpoints.COS), m.ServiceEndpoint)
+	cosServiceEndpoint := endpoints.FetchRegionalEndpoint(string(endpoints.COS), region, m.ServiceEndpoint)
 	if cosServiceEndpoint != "" {
 		m.Logger.V(3).Info("Overriding the default COS endpoint", "cosEndpoint", cosServiceEndpoint)
 		serviceEndpoint = cosServiceEndpoint
@@ -619,7 +1186,12 @@ func (m *PowerVSMachineScope) createCOSClient() (*cos.Service, error) {
 		},
 	}
 
-	cosClient, err := cos.NewService(cosOptions, apiKey, *serviceInstance.GUID)
+	var cosClient *cos.Service
+	if trustedProfileAuth != nil {
+		cosClient, err = cos.NewServiceWithAuthenticator(cosOptions, trustedProfileAuth, *serviceInstance.GUID)
+	} else {
+		cosClient, err = cos.NewService(cosOptions, apiKey, *serviceInstance.GUID)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to create COS client: %w", err)
 	}
@@ -650,16 +1222,14 @@ func getImageID(image *infrav1beta2.IBMPowerVSResourceReference, m *PowerVSMachi
 	if image.ID != nil {
 		return image.ID, nil
 	} else if image.Name != nil {
-		images, err := m.GetImages()
+		img, err := m.IBMPowerVSClient.GetImageByName(*image.Name)
 		if err != nil {
 			m.Logger.Error(err, "Failed to get images")
 			return nil, err
 		}
-		for _, img := range images.Images {
-			if *image.Name == *img.Name {
-				m.Logger.Info("Image found with ID", "Image", *image.Name, "ID", *img.ImageID)
-				return img.ImageID, nil
-			}
+		if img != nil {
+			m.Logger.Info("Image found with ID", "Image", *image.Name, "ID", *img.ImageID)
+			return img.ImageID, nil
 		}
 	} else {
 		return nil, fmt.Errorf("both ID and Name can't be nil")
@@ -711,6 +1281,62 @@ func getNetworkID(network infrav1beta2.IBMPowerVSResourceReference, m *PowerVSMa
 	return nil, fmt.Errorf("ID, Name and RegEx can't be nil")
 }
 
+// additionalNetworks returns the additional networks attached to the instance along with their static IP addresses.
+func (m *PowerVSMachineScope) additionalNetworks() ([]*models.PVMInstanceAddNetwork, error) {
+	var networks []*models.PVMInstanceAddNetwork
+	for i, attachment := range m.IBMPowerVSMachine.Spec.AdditionalNetworks {
+		networkID, err := getNetworkID(attachment.Network, m)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get ID of additional network %d: %w", i, err)
+		}
+		networks = append(networks, &models.PVMInstanceAddNetwork{
+			NetworkID: networkID,
+			IPAddress: attachment.IPAddress,
+		})
+	}
+	return networks, nil
+}
+
+func getSharedProcessorPoolID(pool infrav1beta2.IBMPowerVSResourceReference, m *PowerVSMachineScope) (*string, error) {
+	if pool.ID != nil {
+		return pool.ID, nil
+	} else if pool.Name != nil {
+		pools, err := m.IBMPowerVSClient.GetAllSharedProcessorPools()
+		if err != nil {
+			m.Logger.Error(err, "Failed to get shared processor pools")
+			return nil, err
+		}
+		for _, sp := range pools.SharedProcessorPools {
+			if *pool.Name == *sp.Name {
+				m.Logger.Info("Shared processor pool found with ID", "SharedProcessorPool", *pool.Name, "ID", *sp.ID)
+				return sp.ID, nil
+			}
+		}
+		return nil, fmt.Errorf("failed to find a shared processor pool ID with name %s", *pool.Name)
+	}
+	return nil, fmt.Errorf("both ID and Name can't be nil")
+}
+
+func getPlacementGroupID(placementGroup infrav1beta2.IBMPowerVSResourceReference, m *PowerVSMachineScope) (*string, error) {
+	if placementGroup.ID != nil {
+		return placementGroup.ID, nil
+	} else if placementGroup.Name != nil {
+		placementGroups, err := m.IBMPowerVSClient.GetAllPlacementGroups()
+		if err != nil {
+			m.Logger.Error(err, "Failed to get placement groups")
+			return nil, err
+		}
+		for _, pg := range placementGroups.PlacementGroups {
+			if *placementGroup.Name == *pg.Name {
+				m.Logger.Info("Placement group found with ID", "PlacementGroup", *placementGroup.Name, "ID", *pg.ID, "policy", ptr.Deref(pg.Policy, ""))
+				return pg.ID, nil
+			}
+		}
+		return nil, fmt.Errorf("failed to find a placement group ID with name %s", *placementGroup.Name)
+	}
+	return nil, fmt.Errorf("both ID and Name can't be nil")
+}
+
 // GetNetworks will get list of networks for the powervs service instance.
 func (m *PowerVSMachineScope) GetNetworks() (*models.Networks, error) {
 	return m.IBMPowerVSClient.GetAllNetwork()
@@ -787,11 +1413,15 @@ func (m *PowerVSMachineScope) SetAddresses(instance *models.PVMInstance) { //nol
 		}
 	}
 	m.IBMPowerVSMachine.Status.Addresses = addresses
-	if len(addresses) > 2 {
-		// If the address length is more than 2 means either NodeInternalIP or NodeExternalIP is updated so return
+	m.IBMPowerVSMachine.Status.Networks = instanceNetworkStatuses(instance.Networks)
+	if len(instance.Networks) > 0 && !slices.ContainsFunc(instance.Networks, func(network *models.PVMInstanceNetwork) bool {
+		return strings.TrimSpace(network.IPAddress) == "" && strings.TrimSpace(network.ExternalIP) == ""
+	}) {
+		// The IPs of all the networks are reported, so there is nothing to look up.
 		return
 	}
-	// In this case there is no IP found under instance.Networks, So try to fetch the IP from cache or DHCP server
+	// In this case the IP of a network is not found under instance.Networks, which is the case of the primary network
+	// served by a DHCP server, So try to fetch its IP from cache or DHCP server
 	// Look for DHCP IP from the cache
 	obj, exists, err := m.DHCPIPCacheStore.GetByKey(*instance.ServerName)
 	if err != nil {
@@ -799,16 +1429,21 @@ func (m *PowerVSMachineScope) SetAddresses(instance *models.PVMInstance) { //nol
 	}
 	if exists {
 		m.V(3).Info("Found IP for VM from DHCP cache", "IP", obj.(powervs.VMip).IP, "VM", *instance.ServerName)
-		addresses = append(addresses, corev1.NodeAddress{
+		address := corev1.NodeAddress{
 			Type:    corev1.NodeInternalIP,
 			Address: obj.(powervs.VMip).IP,
-		})
+		}
+		if !slices.Contains(addresses, address) {
+			addresses = append(addresses, address)
+		}
 		m.IBMPowerVSMachine.Status.Addresses = addresses
 		return
 	}
 	// Fetch the VM network ID
 	network := m.IBMPowerVSMachine.Spec.Network
-	if network.ID == nil && network.Name == nil && network.RegEx == nil {
+	if m.IBMPowerVSNetwork != nil {
+		network.ID = &m.IBMPowerVSNetwork.Status.NetworkID
+	} else if network.ID == nil && network.Name == nil && network.RegEx == nil {
 		// if the network is nil, Fetch from cluster.
 		if m.IBMPowerVSCluster.Status.Network != nil && m.IBMPowerVSCluster.Status.Network.ID != nil {
 			network.ID = m.IBMPowerVSCluster.Status.Network.ID
@@ -831,6 +1466,10 @@ func (m *PowerVSMachineScope) SetAddresses(instance *models.PVMInstance) { //nol
 		m.V(3).Info("Failed to get network attached to VM", "VM", *instance.ServerName, "Network ID", *networkID)
 		return
 	}
+	if strings.TrimSpace(pvmNetwork.IPAddress) != "" || strings.TrimSpace(pvmNetwork.ExternalIP) != "" {
+		// The IP of the primary network is reported, only the IP of an additional network is missing.
+		return
+	}
 	// Get all the DHCP servers
 	dhcpServer, err := m.IBMPowerVSClient.GetAllDHCPServers()
 	if err != nil {
@@ -880,6 +1519,11 @@ func (m *PowerVSMachineScope) SetAddresses(instance *models.PVMInstance) { //nol
 		Type:    corev1.NodeInternalIP,
 		Address: *internalIP,
 	})
+	for i := range m.IBMPowerVSMachine.Status.Networks {
+		if m.IBMPowerVSMachine.Status.Networks[i].NetworkID == *networkID {
+			m.IBMPowerVSMachine.Status.Networks[i].IPAddress = *internalIP
+		}
+	}
 	// Update the cache with the ip and VM name
 	err = m.DHCPIPCacheStore.Add(powervs.VMip{
 		Name: *instance.ServerName,
@@ -891,6 +1535,24 @@ func (m *PowerVSMachineScope) SetAddresses(instance *models.PVMInstance) { //nol
 	m.IBMPowerVSMachine.Status.Addresses = addresses
 }
 
+// instanceNetworkStatuses returns the state of the networks attached to the instance in the order of its interfaces.
+func instanceNetworkStatuses(networks []*models.PVMInstanceNetwork) []infrav1beta2.PowerVSMachineNetworkStatus {
+	var statuses []infrav1beta2.PowerVSMachineNetworkStatus
+	for _, network := range networks {
+		if network == nil {
+			continue
+		}
+		statuses = append(statuses, infrav1beta2.PowerVSMachineNetworkStatus{
+			NetworkID:   network.NetworkID,
+			NetworkName: network.NetworkName,
+			IPAddress:   strings.TrimSpace(network.IPAddress),
+			ExternalIP:  strings.TrimSpace(network.ExternalIP),
+			MACAddress:  network.MacAddress,
+		})
+	}
+	return statuses
+}
+
 // SetInstanceState will set the state for the machine.
 func (m *PowerVSMachineScope) SetInstanceState(status *string) {
 	m.IBMPowerVSMachine.Status.InstanceState = infrav1beta2.PowerVSInstanceState(*status)
@@ -942,11 +1604,20 @@ func (m *PowerVSMachineScope) GetServiceInstanceID() (string, error) {
 	if m.IBMPowerVSCluster.Spec.ServiceInstance != nil && m.IBMPowerVSCluster.Spec.ServiceInstance.Name == nil {
 		return "", fmt.Errorf("failed to find service instance id as both name and id are not set")
 	}
-	serviceInstance, err := m.ResourceClient.GetServiceInstance("", *m.IBMPowerVSCluster.Spec.ServiceInstance.Name, ptr.To(m.GetZone()))
+	// The status of the cluster is empty until it is reconciled again, e.g. after clusterctl move, fall back to
+	// the name of the service instance created by the cluster controller.
+	serviceInstanceName := fmt.Sprintf("%s-%s", m.IBMPowerVSCluster.GetName(), "serviceInstance")
+	if m.IBMPowerVSCluster.Spec.ServiceInstance != nil {
+		serviceInstanceName = *m.IBMPowerVSCluster.Spec.ServiceInstance.Name
+	}
+	serviceInstance, err := m.ResourceClient.GetServiceInstance("", serviceInstanceName, ptr.To(m.GetZone()))
 	if err != nil {
-		m.Error(err, "failed to get Power VS service instance id", "serviceInstanceName", *m.IBMPowerVSCluster.Spec.ServiceInstance.Name)
+		m.Error(err, "failed to get Power VS service instance id", "serviceInstanceName", serviceInstanceName)
 		return "", err
 	}
+	if serviceInstance == nil {
+		return "", fmt.Errorf("failed to find service instance %s", serviceInstanceName)
+	}
 	// It's safe to directly dereference GUID as its already done in NewPowerVSMachineScope
 	return *serviceInstance.GUID, nil
 }
@@ -983,14 +1654,14 @@ func (m *PowerVSMachineScope) CreateVPCLoadBalancerPoolMember() (*vpcv1.LoadBala
 	loadBalancers := make([]infrav1beta2.VPCLoadBalancerSpec, 0)
 	if len(m.IBMPowerVSCluster.Spec.LoadBalancers) == 0 {
 		loadBalancer := infrav1beta2.VPCLoadBalancerSpec{
-			Name:   fmt.Sprintf("%s-loadbalancer", m.IBMPowerVSCluster.Name),
+			Name:   names.Normalize(fmt.Sprintf("%s-loadbalancer", m.IBMPowerVSCluster.Name), names.VPCMaxLength),
 			Public: ptr.To(true),
 		}
 		loadBalancers = append(loadBalancers, loadBalancer)
 	}
 	for index, loadBalancer := range m.IBMPowerVSCluster.Spec.LoadBalancers {
 		if loadBalancer.Name == "" {
-			loadBalancer.Name = fmt.Sprintf("%s-loadbalancer-%d", m.IBMPowerVSCluster.Name, index)
+			loadBalancer.Name = names.Normalize(fmt.Sprintf("%s-%d", names.Normalize(fmt.Sprintf("%s-loadbalancer", m.IBMPowerVSCluster.Name), names.VPCMaxLength), index), names.VPCMaxLength)
 		}
 		loadBalancers = append(loadBalancers, loadBalancer)
 	}
@@ -1108,7 +1779,7 @@ func (m *PowerVSMachineScope) bucketName() string {
 	if m.IBMPowerVSCluster.Spec.CosInstance != nil && m.IBMPowerVSCluster.Spec.CosInstance.BucketName != "" {
 		return m.IBMPowerVSCluster.Spec.CosInstance.BucketName
 	}
-	return fmt.Sprintf("%s-%s", m.IBMPowerVSCluster.GetName(), "cosbucket")
+	return names.Normalize(fmt.Sprintf("%s-%s", m.IBMPowerVSCluster.GetName(), "cosbucket"), names.VPCMaxLength)
 }
 
 // TODO: duplicate function, optimize it.
@@ -1123,3 +1794,117 @@ func (m *PowerVSMachineScope) bucketRegion() string {
 	}
 	return ""
 }
+
+// ReconcileDebugDump writes the sanitized dump of the Power VS instance into the debug dump ConfigMap of the IBMPowerVSMachine
+// when the debug dump annotation is set on it.
+func (m *PowerVSMachineScope) ReconcileDebugDump(instance *models.PVMInstance) error {
+	if !debugdump.Requested(m.IBMPowerVSMachine) {
+		return nil
+	}
+	return debugdump.Reconcile(context.TODO(), m.Client, m.IBMPowerVSMachine, map[string]interface{}{
+		"instance.json": instance,
+	})
+}
+
+const (
+	// defaultInstanceHealthCheckInterval is the default interval at which the health of the instance of a machine is checked.
+	defaultInstanceHealthCheckInterval = 5 * time.Minute
+	// defaultInstanceUnhealthyTimeout is the default time the instance of a machine can be unhealthy before it is reported as failed.
+	defaultInstanceUnhealthyTimeout = 5 * time.Minute
+	// instanceHealthStatusOK is the health status reported by Power VS for the healthy instances.
+	instanceHealthStatusOK = "OK"
+	// instanceHealthStatusCritical is the health status which makes an instance unhealthy by default.
+	instanceHealthStatusCritical = "CRITICAL"
+)
+
+// ReconcileInstanceHealth checks the health of the instance from its state and health status last reported by Power VS
+// when the health check of the IBMPowerVSMachine is configured, and sets the InstanceHealthy condition accordingly.
+// Once the instance is unhealthy for longer than the unhealthy timeout, the condition gets the Error severity and
+// the failure reason and message of the machine are set if the health check fails the unhealthy machines, and the Machine
+// is deleted when its auto-repair policy replaces it.
+// It returns the time after which the health of the instance should be checked again, zero when it is not checked.
+func (m *PowerVSMachineScope) ReconcileInstanceHealth() (time.Duration, error) {
+	check := m.IBMPowerVSMachine.Spec.HealthCheck
+	if check == nil {
+		conditions.Delete(m.IBMPowerVSMachine, infrav1beta2.InstanceHealthyCondition)
+		return 0, nil
+	}
+	if m.IBMPowerVSMachine.Status.InstanceState == "" {
+		return 0, nil
+	}
+	interval := defaultInstanceHealthCheckInterval
+	if check.Interval != nil {
+		interval = check.Interval.Duration
+	}
+	timeout := defaultInstanceUnhealthyTimeout
+	if check.UnhealthyTimeout != nil {
+		timeout = check.UnhealthyTimeout.Duration
+	}
+
+	status, reason, message := instanceHealth(m.IBMPowerVSMachine.Status.InstanceState, m.IBMPowerVSMachine.Status.Health, check.UnhealthyHealthStatuses)
+	switch status {
+	case corev1.ConditionTrue:
+		conditions.MarkTrue(m.IBMPowerVSMachine, infrav1beta2.InstanceHealthyCondition)
+		return interval, nil
+	case corev1.ConditionUnknown:
+		conditions.MarkUnknown(m.IBMPowerVSMachine, infrav1beta2.InstanceHealthyCondition, reason, "%s", message)
+		return interval, nil
+	}
+
+	// The unhealthy timeout is counted from the transition of the condition to False with the Warning severity.
+	if condition := conditions.Get(m.IBMPowerVSMachine, infrav1beta2.InstanceHealthyCondition); condition == nil ||
+		condition.Status != corev1.ConditionFalse || condition.Severity != capiv1beta1.ConditionSeverityError {
+		conditions.MarkFalse(m.IBMPowerVSMachine, infrav1beta2.InstanceHealthyCondition, reason, capiv1beta1.ConditionSeverityWarning, "%s", message)
+		unhealthySince := conditions.GetLastTransitionTime(m.IBMPowerVSMachine, infrav1beta2.InstanceHealthyCondition)
+		if remaining := timeout - time.Since(unhealthySince.Time); remaining > 0 {
+			return min(interval, remaining), nil
+		}
+		record.Warnf(m.IBMPowerVSMachine, "InstanceUnhealthy", "Instance %s is unhealthy for more than %s - %s", m.GetInstanceID(), timeout, message)
+	}
+	message = fmt.Sprintf("%s for more than %s", message, timeout)
+	conditions.MarkFalse(m.IBMPowerVSMachine, infrav1beta2.InstanceHealthyCondition, reason, capiv1beta1.ConditionSeverityError, "%s", message)
+
+	if !check.FailOnUnhealthy {
+		return interval, nil
+	}
+	if m.IBMPowerVSMachine.Status.FailureReason == nil {
+		m.Info("Failing the machine as its instance is unhealthy", "reason", reason, "message", message)
+		cloudevents.Publish(m.IBMPowerVSMachine, cloudevents.MachineFailed, message)
+		m.SetFailureReason(infrav1beta2.UpdateMachineError)
+		m.SetFailureMessage(message)
+	}
+	// Replace the Machine without waiting for a MachineHealthCheck when the auto-repair policy allows it.
+	if m.ShouldAutoRepair() {
+		if err := m.DeleteOwnerMachine("the instance is unhealthy"); err != nil {
+			return interval, err
+		}
+	}
+	return interval, nil
+}
+
+// instanceHealth returns the status, the reason and the message of the InstanceHealthy condition for the state and the
+// health status of an instance, an ACTIVE instance is unhealthy with the given health statuses, CRITICAL by default.
+func instanceHealth(state infrav1beta2.PowerVSInstanceState, health string, unhealthyStatuses []string) (corev1.ConditionStatus, string, string) {
+	switch state {
+	case infrav1beta2.PowerVSInstanceStateERROR:
+		return corev1.ConditionFalse, infrav1beta2.InstanceErroredReason, "instance is in ERROR state"
+	case infrav1beta2.PowerVSInstanceStateSHUTOFF:
+		return corev1.ConditionFalse, infrav1beta2.InstanceStoppedReason, "instance is in SHUTOFF state"
+	case infrav1beta2.PowerVSInstanceStateACTIVE:
+		if len(unhealthyStatuses) == 0 {
+			unhealthyStatuses = []string{instanceHealthStatusCritical}
+		}
+		if slices.Contains(unhealthyStatuses, strings.ToUpper(health)) {
+			return corev1.ConditionFalse, infrav1beta2.InstanceUnhealthyReason, fmt.Sprintf("instance health status is %s", health)
+		}
+		if strings.EqualFold(health, instanceHealthStatusOK) {
+			return corev1.ConditionTrue, "", ""
+		}
+	}
+	return corev1.ConditionUnknown, infrav1beta2.InstanceHealthUnknownReason, fmt.Sprintf("instance is in %s state with health status %q", state, health)
+}
+
+// ReconcileBootstrapSucceeded sets the BootstrapSucceeded condition of the IBMPowerVSMachine from whether the Machine joined the cluster.
+func (m *PowerVSMachineScope) ReconcileBootstrapSucceeded() {
+	reconcileBootstrapSucceeded(m.IBMPowerVSMachine, m.Machine)
+}
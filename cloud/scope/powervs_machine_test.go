@@ -18,12 +18,17 @@ package scope
 
 import (
 	"context"
+	"encoding/base64"
 	"errors"
 	"fmt"
 	"path"
+	"slices"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/IBM-Cloud/power-go-client/ibmpisession"
+	"github.com/IBM-Cloud/power-go-client/power/client/p_cloud_volumes"
 	"github.com/IBM-Cloud/power-go-client/power/models"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
@@ -43,8 +48,11 @@ import (
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/resourcecontroller"
 	resourcecontrollermock "sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/resourcecontroller/mock"
 	vpcmock "sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/vpc/mock"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloudinit"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/names"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/options"
 	capiv1beta1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
 	"sigs.k8s.io/cluster-api/util/patch"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -121,6 +129,18 @@ func newPowerVSInstance(name, networkID, mac string) *models.PVMInstance {
 	}
 }
 
+// imageByName looks up the images of images by name as GetImageByName does.
+func imageByName(images *models.Images) func(string) (*models.ImageReference, error) {
+	return func(name string) (*models.ImageReference, error) {
+		for _, img := range images.Images {
+			if *img.Name == name {
+				return img, nil
+			}
+		}
+		return nil, nil
+	}
+}
+
 func newDHCPServer(serverID, networkID string) models.DHCPServers {
 	return models.DHCPServers{
 		&models.DHCPServer{
@@ -432,6 +452,29 @@ func TestGetServiceInstanceIDForMachineScope(t *testing.T) {
 		g.Expect(err).To(BeNil())
 	})
 
+	t.Run("Returns service instance ID of the default service instance when the cluster status is empty", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := PowerVSMachineScope{
+			IBMPowerVSCluster: &infrav1beta2.IBMPowerVSCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "foo-cluster",
+				},
+			},
+			IBMPowerVSMachine: &infrav1beta2.IBMPowerVSMachine{
+				Status: infrav1beta2.IBMPowerVSMachineStatus{
+					Zone: ptr.To("us-south-1"),
+				},
+			},
+		}
+		mockResourceController.EXPECT().GetServiceInstance("", "foo-cluster-serviceInstance", gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{GUID: ptr.To("foo-id")}, nil)
+		scope.ResourceClient = mockResourceController
+		serviceInstanceID, err := scope.GetServiceInstanceID()
+		g.Expect(serviceInstanceID).To(Equal("foo-id"))
+		g.Expect(err).To(BeNil())
+	})
+
 	t.Run("Failed to get Power VS service instance id", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
@@ -1349,6 +1392,110 @@ func TestCreateMachinePVS(t *testing.T) {
 			g.Expect(err).To(BeNil())
 		})
 
+		t.Run("Should record the ID of the created instance", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t)
+			t.Cleanup(teardown)
+			scope := setupPowerVSMachineScope(clusterName, machineName, ptr.To(pvsImage), ptr.To(pvsNetwork), true, mockpowervs)
+			mockpowervs.EXPECT().GetAllInstance().Return(pvmInstances, nil)
+			mockpowervs.EXPECT().CreateInstance(gomock.AssignableToTypeOf(pvmInstanceCreate)).Return(&models.PVMInstanceList{{PvmInstanceID: ptr.To("instance-id")}}, nil)
+			_, err := scope.CreateMachine()
+			g.Expect(err).To(BeNil())
+			g.Expect(scope.IBMPowerVSMachine.Status.ActionHistory).To(HaveLen(1))
+			g.Expect(scope.IBMPowerVSMachine.Status.ActionHistory[0].InstanceID).To(Equal("instance-id"))
+		})
+
+		t.Run("Should create Machine with additional networks and static IP addresses", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t)
+			t.Cleanup(teardown)
+			scope := setupPowerVSMachineScope(clusterName, machineName, ptr.To(pvsImage), ptr.To(pvsNetwork), true, mockpowervs)
+			scope.IBMPowerVSMachine.Spec.NetworkIPAddress = "192.168.0.10"
+			scope.IBMPowerVSMachine.Spec.AdditionalNetworks = []infrav1beta2.PowerVSNetworkAttachment{
+				{Network: infrav1beta2.IBMPowerVSResourceReference{Name: ptr.To(pvsNetwork)}, IPAddress: "10.0.0.10"},
+				{Network: infrav1beta2.IBMPowerVSResourceReference{ID: ptr.To("storage-network-id")}},
+			}
+			mockpowervs.EXPECT().GetAllInstance().Return(pvmInstances, nil)
+			mockpowervs.EXPECT().GetAllNetwork().Return(networks, nil)
+			mockpowervs.EXPECT().CreateInstance(gomock.AssignableToTypeOf(pvmInstanceCreate)).DoAndReturn(func(body *models.PVMInstanceCreate) (*models.PVMInstanceList, error) {
+				g.Expect(body.Networks).To(Equal([]*models.PVMInstanceAddNetwork{
+					{NetworkID: ptr.To(pvsNetwork), IPAddress: "192.168.0.10"},
+					{NetworkID: ptr.To(pvsNetwork + idSuffix), IPAddress: "10.0.0.10"},
+					{NetworkID: ptr.To("storage-network-id")},
+				}))
+				return pvmInstanceList, nil
+			})
+			_, err := scope.CreateMachine()
+			g.Expect(err).To(BeNil())
+		})
+
+		t.Run("Error when the additional network does not exist", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t)
+			t.Cleanup(teardown)
+			scope := setupPowerVSMachineScope(clusterName, machineName, ptr.To(pvsImage), ptr.To(pvsNetwork), true, mockpowervs)
+			scope.IBMPowerVSMachine.Spec.AdditionalNetworks = []infrav1beta2.PowerVSNetworkAttachment{
+				{Network: infrav1beta2.IBMPowerVSResourceReference{Name: ptr.To("data-plane")}},
+			}
+			mockpowervs.EXPECT().GetAllInstance().Return(pvmInstances, nil)
+			mockpowervs.EXPECT().GetAllNetwork().Return(networks, nil)
+			_, err := scope.CreateMachine()
+			g.Expect(err).To(Not(BeNil()))
+		})
+
+		t.Run("Should create Machine in a placement group looked up by name", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t)
+			t.Cleanup(teardown)
+			scope := setupPowerVSMachineScope(clusterName, machineName, ptr.To(pvsImage), ptr.To(pvsNetwork), true, mockpowervs)
+			scope.IBMPowerVSMachine.Spec.PlacementGroup = &infrav1beta2.IBMPowerVSResourceReference{Name: ptr.To("control-plane")}
+			placementGroups := &models.PlacementGroups{
+				PlacementGroups: []*models.PlacementGroup{
+					{
+						ID:     ptr.To("control-plane-id"),
+						Name:   ptr.To("control-plane"),
+						Policy: ptr.To("anti-affinity"),
+					},
+				},
+			}
+			mockpowervs.EXPECT().GetAllInstance().Return(pvmInstances, nil)
+			mockpowervs.EXPECT().GetAllPlacementGroups().Return(placementGroups, nil)
+			mockpowervs.EXPECT().CreateInstance(gomock.AssignableToTypeOf(pvmInstanceCreate)).DoAndReturn(func(body *models.PVMInstanceCreate) (*models.PVMInstanceList, error) {
+				g.Expect(body.PlacementGroup).To(Equal("control-plane-id"))
+				g.Expect(body.SharedProcessorPool).To(BeEmpty())
+				return pvmInstanceList, nil
+			})
+			_, err := scope.CreateMachine()
+			g.Expect(err).To(BeNil())
+		})
+
+		t.Run("Should create Machine in a shared processor pool", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t)
+			t.Cleanup(teardown)
+			scope := setupPowerVSMachineScope(clusterName, machineName, ptr.To(pvsImage), ptr.To(pvsNetwork), true, mockpowervs)
+			scope.IBMPowerVSMachine.Spec.SharedProcessorPool = &infrav1beta2.IBMPowerVSResourceReference{ID: ptr.To("pool-id")}
+			mockpowervs.EXPECT().GetAllInstance().Return(pvmInstances, nil)
+			mockpowervs.EXPECT().CreateInstance(gomock.AssignableToTypeOf(pvmInstanceCreate)).DoAndReturn(func(body *models.PVMInstanceCreate) (*models.PVMInstanceList, error) {
+				g.Expect(body.SharedProcessorPool).To(Equal("pool-id"))
+				return pvmInstanceList, nil
+			})
+			_, err := scope.CreateMachine()
+			g.Expect(err).To(BeNil())
+		})
+
+		t.Run("Error when the shared processor pool does not exist", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t)
+			t.Cleanup(teardown)
+			scope := setupPowerVSMachineScope(clusterName, machineName, ptr.To(pvsImage), ptr.To(pvsNetwork), true, mockpowervs)
+			scope.IBMPowerVSMachine.Spec.SharedProcessorPool = &infrav1beta2.IBMPowerVSResourceReference{Name: ptr.To("pool")}
+			mockpowervs.EXPECT().GetAllInstance().Return(pvmInstances, nil)
+			mockpowervs.EXPECT().GetAllSharedProcessorPools().Return(&models.SharedProcessorPools{}, nil)
+			_, err := scope.CreateMachine()
+			g.Expect(err).To(Not(BeNil()))
+		})
+
 		t.Run("Return exsisting Machine", func(t *testing.T) {
 			g := NewWithT(t)
 			setup(t)
@@ -1466,7 +1613,7 @@ func TestCreateMachinePVS(t *testing.T) {
 			t.Cleanup(teardown)
 			scope := setupPowerVSMachineScope(clusterName, machineName, ptr.To(pvsImage), ptr.To(pvsNetwork), false, mockpowervs)
 			mockpowervs.EXPECT().GetAllInstance().Return(pvmInstances, nil)
-			mockpowervs.EXPECT().GetAllImage().Return(images, nil)
+			mockpowervs.EXPECT().GetImageByName(gomock.Any()).DoAndReturn(imageByName(images))
 			mockpowervs.EXPECT().GetAllNetwork().Return(networks, nil)
 			mockpowervs.EXPECT().CreateInstance(gomock.AssignableToTypeOf(pvmInstanceCreate)).Return(pvmInstanceList, nil)
 			_, err := scope.CreateMachine()
@@ -1489,7 +1636,7 @@ func TestCreateMachinePVS(t *testing.T) {
 			t.Cleanup(teardown)
 			scope := setupPowerVSMachineScope(clusterName, machineName, ptr.To(pvsImage+"-temp"), ptr.To(pvsNetwork), false, mockpowervs)
 			mockpowervs.EXPECT().GetAllInstance().Return(pvmInstances, nil)
-			mockpowervs.EXPECT().GetAllImage().Return(images, nil)
+			mockpowervs.EXPECT().GetImageByName(gomock.Any()).DoAndReturn(imageByName(images))
 			_, err := scope.CreateMachine()
 			g.Expect(err).To((Not(BeNil())))
 		})
@@ -1500,7 +1647,7 @@ func TestCreateMachinePVS(t *testing.T) {
 			t.Cleanup(teardown)
 			scope := setupPowerVSMachineScope(clusterName, machineName, ptr.To(pvsImage), ptr.To(pvsNetwork+"-temp"), false, mockpowervs)
 			mockpowervs.EXPECT().GetAllInstance().Return(pvmInstances, nil)
-			mockpowervs.EXPECT().GetAllImage().Return(images, nil)
+			mockpowervs.EXPECT().GetImageByName(gomock.Any()).DoAndReturn(imageByName(images))
 			mockpowervs.EXPECT().GetAllNetwork().Return(networks, nil)
 			_, err := scope.CreateMachine()
 			g.Expect(err).To((Not(BeNil())))
@@ -1854,6 +2001,23 @@ func TestDeleteMachinePVS(t *testing.T) {
 			err := scope.DeleteMachine()
 			g.Expect(err).To(Not(BeNil()))
 		})
+
+		t.Run("Should record the deletion in the action history", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t)
+			t.Cleanup(teardown)
+			scope := setupPowerVSMachineScope(clusterName, machineName, ptr.To(pvsImage), ptr.To(pvsNetwork), true, mockpowervs)
+			scope.IBMPowerVSMachine.Status.InstanceID = machineName + idSuffix
+			mockpowervs.EXPECT().DeleteInstance(gomock.AssignableToTypeOf(id)).Return(errors.New("Failed to delete machine"))
+			err := scope.DeleteMachine()
+			g.Expect(err).To(Not(BeNil()))
+			g.Expect(scope.IBMPowerVSMachine.Status.ActionHistory).To(HaveLen(1))
+			action := scope.IBMPowerVSMachine.Status.ActionHistory[0]
+			g.Expect(action.Type).To(Equal(infrav1beta2.MachineActionDelete))
+			g.Expect(action.InstanceID).To(Equal(machineName + idSuffix))
+			g.Expect(action.Succeeded).To(BeFalse())
+			g.Expect(action.Message).To(ContainSubstring("Failed to delete machine"))
+		})
 	})
 }
 
@@ -2123,3 +2287,1276 @@ func TestSetAddresses(t *testing.T) {
 		})
 	}
 }
+
+func TestSetAddressesOfMultipleNetworks(t *testing.T) {
+	g := NewWithT(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	scope := setupPowerVSMachineScope("test-cluster", "test-machine-0", ptr.To("test-image-ID"), ptr.To("management-net-ID"), true, mock.NewMockPowerVS(ctrl))
+	scope.DHCPIPCacheStore = cache.NewTTLStore(powervs.CacheKeyFunc, powervs.CacheTTL)
+	scope.SetAddresses(&models.PVMInstance{
+		Networks: []*models.PVMInstanceNetwork{
+			{
+				NetworkID:   "management-net-ID",
+				NetworkName: "management",
+				IPAddress:   "192.168.0.10",
+				MacAddress:  "ff:11:33:dd:00:22",
+			},
+			{
+				NetworkID:   "data-plane-net-ID",
+				NetworkName: "data-plane",
+				IPAddress:   "10.0.0.10 ",
+				ExternalIP:  "52.116.0.10",
+				MacAddress:  "ff:11:33:dd:00:23",
+			},
+		},
+		ServerName: ptr.To("test_vm"),
+	})
+	g.Expect(scope.IBMPowerVSMachine.Status.Addresses).To(ContainElements(
+		corev1.NodeAddress{Type: corev1.NodeInternalIP, Address: "192.168.0.10"},
+		corev1.NodeAddress{Type: corev1.NodeInternalIP, Address: "10.0.0.10"},
+		corev1.NodeAddress{Type: corev1.NodeExternalIP, Address: "52.116.0.10"},
+	))
+	g.Expect(scope.IBMPowerVSMachine.Status.Networks).To(Equal([]infrav1beta2.PowerVSMachineNetworkStatus{
+		{
+			NetworkID:   "management-net-ID",
+			NetworkName: "management",
+			IPAddress:   "192.168.0.10",
+			MACAddress:  "ff:11:33:dd:00:22",
+		},
+		{
+			NetworkID:   "data-plane-net-ID",
+			NetworkName: "data-plane",
+			IPAddress:   "10.0.0.10",
+			ExternalIP:  "52.116.0.10",
+			MACAddress:  "ff:11:33:dd:00:23",
+		},
+	}))
+}
+
+func TestSetAddressesOfPrimaryDHCPNetworkWithStaticAdditionalNetwork(t *testing.T) {
+	g := NewWithT(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPowerVSClient := mock.NewMockPowerVS(ctrl)
+	mockPowerVSClient.EXPECT().GetAllDHCPServers().Return(newDHCPServer("test-server-id", "management-net-ID"), nil)
+	mockPowerVSClient.EXPECT().GetDHCPServer("test-server-id").Return(newDHCPServerDetails("test-server-id", "192.168.0.10", "ff:11:33:dd:00:22"), nil)
+	scope := setupPowerVSMachineScope("test-cluster", "test-machine-0", ptr.To("test-image-ID"), ptr.To("management-net-ID"), true, mockPowerVSClient)
+	scope.DHCPIPCacheStore = cache.NewTTLStore(powervs.CacheKeyFunc, powervs.CacheTTL)
+	scope.SetAddresses(&models.PVMInstance{
+		Networks: []*models.PVMInstanceNetwork{
+			{
+				NetworkID:   "management-net-ID",
+				NetworkName: "management",
+				MacAddress:  "ff:11:33:dd:00:22",
+			},
+			{
+				NetworkID:   "data-plane-net-ID",
+				NetworkName: "data-plane",
+				IPAddress:   "10.0.0.10",
+				MacAddress:  "ff:11:33:dd:00:23",
+			},
+		},
+		ServerName: ptr.To("test_vm"),
+	})
+	g.Expect(scope.IBMPowerVSMachine.Status.Addresses).To(ContainElements(
+		corev1.NodeAddress{Type: corev1.NodeInternalIP, Address: "192.168.0.10"},
+		corev1.NodeAddress{Type: corev1.NodeInternalIP, Address: "10.0.0.10"},
+	))
+	g.Expect(scope.IBMPowerVSMachine.Status.Networks[0].IPAddress).To(Equal("192.168.0.10"))
+}
+
+func TestDeleteOrphanBootVolumes(t *testing.T) {
+	var (
+		mockpowervs *mock.MockPowerVS
+		mockCtrl    *gomock.Controller
+	)
+
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockpowervs = mock.NewMockPowerVS(mockCtrl)
+	}
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+
+	volume := func(name string, state string, bootable bool, instanceIDs ...string) *models.VolumeReference {
+		return &models.VolumeReference{
+			Name:           ptr.To(name),
+			VolumeID:       ptr.To(name + idSuffix),
+			State:          ptr.To(state),
+			Bootable:       ptr.To(bootable),
+			PvmInstanceIDs: instanceIDs,
+		}
+	}
+
+	failedCreation := []infrav1beta2.MachineAction{{Type: infrav1beta2.MachineActionCreate, Succeeded: false}}
+
+	t.Run("Should not look up the volumes when no instance creation failed", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := setupPowerVSMachineScope(clusterName, machineName, ptr.To(pvsImage), ptr.To(pvsNetwork), true, mockpowervs)
+		scope.IBMPowerVSMachine.Status.ActionHistory = []infrav1beta2.MachineAction{{Type: infrav1beta2.MachineActionCreate, Succeeded: true}}
+		err := scope.DeleteOrphanBootVolumes()
+		g.Expect(err).To(BeNil())
+	})
+
+	t.Run("Should match the boot volumes by the truncated instance name", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		longName := strings.Repeat("a", names.PowerVSInstanceMaxLength) + "-md-0-abcde"
+		scope := setupPowerVSMachineScope(clusterName, longName, ptr.To(pvsImage), ptr.To(pvsNetwork), true, mockpowervs)
+		scope.IBMPowerVSMachine.Status.ActionHistory = failedCreation
+		instanceName := names.Truncate(longName, names.PowerVSInstanceMaxLength)
+		volumes := &models.Volumes{
+			Volumes: []*models.VolumeReference{
+				volume(instanceName+"-c3c9a9bc-00011b1c-boot-0", "available", true),
+				volume(longName+"-c3c9a9bd-00011b1d-boot-0", "available", true),
+			},
+		}
+		mockpowervs.EXPECT().GetAllVolumes().Return(volumes, nil)
+		mockpowervs.EXPECT().DeleteVolume(instanceName + "-c3c9a9bc-00011b1c-boot-0" + idSuffix).Return(nil)
+		err := scope.DeleteOrphanBootVolumes()
+		g.Expect(err).To(BeNil())
+	})
+
+	t.Run("Should delete only the orphan boot volumes of the machine", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := setupPowerVSMachineScope(clusterName, machineName, ptr.To(pvsImage), ptr.To(pvsNetwork), true, mockpowervs)
+		scope.IBMPowerVSMachine.Status.ActionHistory = failedCreation
+		volumes := &models.Volumes{
+			Volumes: []*models.VolumeReference{
+				volume(machineName+"-c3c9a9bc-00011b1c-boot-0", "available", true),
+				volume(machineName+"-c3c9a9bd-00011b1d-boot-0", "in-use", true, "instance-id"),
+				volume(machineName+"-c3c9a9be-00011b1e-boot-0", "creating", true),
+				volume(machineName+"-data", "available", false),
+				volume("foo-machine-1-c3c9a9bf-00011b1f-boot-0", "available", true),
+			},
+		}
+		mockpowervs.EXPECT().GetAllVolumes().Return(volumes, nil)
+		mockpowervs.EXPECT().DeleteVolume(machineName + "-c3c9a9bc-00011b1c-boot-0" + idSuffix).Return(nil)
+		err := scope.DeleteOrphanBootVolumes()
+		g.Expect(err).To(BeNil())
+	})
+
+	t.Run("Error while getting volumes", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := setupPowerVSMachineScope(clusterName, machineName, ptr.To(pvsImage), ptr.To(pvsNetwork), true, mockpowervs)
+		scope.IBMPowerVSMachine.Status.ActionHistory = failedCreation
+		mockpowervs.EXPECT().GetAllVolumes().Return(nil, errors.New("failed to get volumes"))
+		err := scope.DeleteOrphanBootVolumes()
+		g.Expect(err).To(Not(BeNil()))
+	})
+
+	t.Run("Error while deleting volume", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := setupPowerVSMachineScope(clusterName, machineName, ptr.To(pvsImage), ptr.To(pvsNetwork), true, mockpowervs)
+		scope.IBMPowerVSMachine.Status.ActionHistory = failedCreation
+		volumes := &models.Volumes{
+			Volumes: []*models.VolumeReference{
+				volume(machineName+"-c3c9a9bc-00011b1c-boot-0", "available", true),
+				volume(machineName+"-c3c9a9bd-00011b1d-boot-1", "available", true),
+			},
+		}
+		mockpowervs.EXPECT().GetAllVolumes().Return(volumes, nil)
+		mockpowervs.EXPECT().DeleteVolume(machineName + "-c3c9a9bc-00011b1c-boot-0" + idSuffix).Return(errors.New("failed to delete volume"))
+		mockpowervs.EXPECT().DeleteVolume(machineName + "-c3c9a9bd-00011b1d-boot-1" + idSuffix).Return(nil)
+		err := scope.DeleteOrphanBootVolumes()
+		g.Expect(err).To(Not(BeNil()))
+	})
+}
+
+func TestIsWaitingForPreviousControlPlaneMachines(t *testing.T) {
+	controlPlaneMachine := func(name string, creationTime time.Time, infrastructureReady bool) *capiv1beta1.Machine {
+		machine := newMachine(name)
+		machine.CreationTimestamp = metav1.NewTime(creationTime)
+		machine.Labels = map[string]string{
+			capiv1beta1.ClusterNameLabel:         clusterName,
+			capiv1beta1.MachineControlPlaneLabel: "",
+		}
+		machine.Status.InfrastructureReady = infrastructureReady
+		return machine
+	}
+	now := time.Now().Truncate(time.Second)
+
+	testCases := []struct {
+		name     string
+		policy   infrav1beta2.ControlPlaneProvisioningPolicy
+		machine  *capiv1beta1.Machine
+		machines []client.Object
+		waiting  bool
+	}{
+		{
+			name:     "Should not wait when control plane machines are provisioned in parallel",
+			machine:  controlPlaneMachine("machine-1", now, false),
+			machines: []client.Object{controlPlaneMachine("machine-0", now.Add(-time.Minute), false)},
+		},
+		{
+			name:     "Should not wait for worker machines",
+			policy:   infrav1beta2.ControlPlaneProvisioningPolicySequential,
+			machine:  newMachine("machine-1"),
+			machines: []client.Object{controlPlaneMachine("machine-0", now.Add(-time.Minute), false)},
+		},
+		{
+			name:     "Should wait when previously created control plane machine is not ready",
+			policy:   infrav1beta2.ControlPlaneProvisioningPolicySequential,
+			machine:  controlPlaneMachine("machine-1", now, false),
+			machines: []client.Object{controlPlaneMachine("machine-0", now.Add(-time.Minute), false)},
+			waiting:  true,
+		},
+		{
+			name:    "Should not wait when previously created control plane machines are ready",
+			policy:  infrav1beta2.ControlPlaneProvisioningPolicySequential,
+			machine: controlPlaneMachine("machine-1", now, false),
+			machines: []client.Object{
+				controlPlaneMachine("machine-0", now.Add(-time.Minute), true),
+				controlPlaneMachine("machine-2", now.Add(time.Minute), false),
+			},
+		},
+		{
+			name:     "Should order control plane machines created at the same time by name",
+			policy:   infrav1beta2.ControlPlaneProvisioningPolicySequential,
+			machine:  controlPlaneMachine("machine-a", now, false),
+			machines: []client.Object{controlPlaneMachine("machine-b", now, false)},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			objects := append([]client.Object{tc.machine}, tc.machines...)
+			powervsCluster := newPowerVSCluster(clusterName)
+			powervsCluster.Spec.ControlPlaneProvisioningPolicy = tc.policy
+			scope := &PowerVSMachineScope{
+				Client:            fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(objects...).Build(),
+				Logger:            klog.Background(),
+				Cluster:           newCluster(clusterName),
+				Machine:           tc.machine,
+				IBMPowerVSCluster: powervsCluster,
+				IBMPowerVSMachine: newPowerVSMachine(clusterName, tc.machine.Name, nil, nil, true),
+			}
+			waiting, err := scope.IsWaitingForPreviousControlPlaneMachines()
+			g.Expect(err).To(BeNil())
+			g.Expect(waiting).To(Equal(tc.waiting))
+		})
+	}
+}
+
+func TestPowerVSMachineExceededGuardrails(t *testing.T) {
+	powerVSMachine := func(name, processors string, memoryGiB int32, instanceID string) *infrav1beta2.IBMPowerVSMachine {
+		machine := newPowerVSMachine(clusterName, name, nil, nil, true)
+		machine.Spec.Processors = intstr.FromString(processors)
+		machine.Spec.MemoryGiB = memoryGiB
+		machine.Status.InstanceID = instanceID
+		return machine
+	}
+	creatingMachine := func(name string) *infrav1beta2.IBMPowerVSMachine {
+		machine := powerVSMachine(name, "1", 8, "")
+		conditions.MarkUnknown(machine, infrav1beta2.InstanceReadyCondition, "", "")
+		return machine
+	}
+	deletingMachine := func(name string) *infrav1beta2.IBMPowerVSMachine {
+		machine := powerVSMachine(name, "1", 8, "")
+		machine.Finalizers = []string{infrav1beta2.IBMPowerVSMachineFinalizer}
+		machine.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+		return machine
+	}
+
+	testCases := []struct {
+		name       string
+		guardrails *infrav1beta2.ClusterGuardrails
+		machine    *infrav1beta2.IBMPowerVSMachine
+		machines   []client.Object
+		instances  []*models.PVMInstanceReference
+		exceeded   string
+	}{
+		{
+			name:     "Should not check guardrails when the cluster has none",
+			machine:  powerVSMachine("machine-1", "1", 8, ""),
+			machines: []client.Object{powerVSMachine("machine-0", "1", 8, "instance-0")},
+		},
+		{
+			name:       "Should allow the instance within the guardrails",
+			guardrails: &infrav1beta2.ClusterGuardrails{MaxInstances: ptr.To[int32](2), MaxTotalCores: ptr.To(intstr.FromString("1.5")), MaxTotalMemoryGiB: ptr.To[int32](16)},
+			machine:    powerVSMachine("machine-1", "0.75", 8, ""),
+			machines:   []client.Object{powerVSMachine("machine-0", "0.75", 8, "instance-0"), powerVSMachine("machine-2", "1", 8, "")},
+		},
+		{
+			name:       "Should count the instances being created",
+			guardrails: &infrav1beta2.ClusterGuardrails{MaxInstances: ptr.To[int32](2)},
+			machine:    powerVSMachine("machine-1", "1", 8, ""),
+			machines:   []client.Object{powerVSMachine("machine-0", "1", 8, "instance-0"), creatingMachine("machine-2")},
+			exceeded:   "3 instances exceed maxInstances 2",
+		},
+		{
+			name:       "Should report all the exceeded guardrails",
+			guardrails: &infrav1beta2.ClusterGuardrails{MaxInstances: ptr.To[int32](2), MaxTotalCores: ptr.To(intstr.FromInt32(1)), MaxTotalMemoryGiB: ptr.To[int32](12)},
+			machine:    powerVSMachine("machine-1", "0.5", 8, ""),
+			machines:   []client.Object{powerVSMachine("machine-0", "0.75", 8, "instance-0")},
+			exceeded:   "1.25 cores exceed maxTotalCores 1, 16 GiB of memory exceed maxTotalMemoryGiB 12",
+		},
+		{
+			name:       "Should count the pending instances of the machines created before the machine",
+			guardrails: &infrav1beta2.ClusterGuardrails{MaxInstances: ptr.To[int32](1)},
+			machine:    powerVSMachine("machine-1", "1", 8, ""),
+			machines:   []client.Object{powerVSMachine("machine-0", "1", 8, "")},
+			exceeded:   "2 instances exceed maxInstances 1",
+		},
+		{
+			name:       "Should not count the pending instances of the machines being deleted",
+			guardrails: &infrav1beta2.ClusterGuardrails{MaxInstances: ptr.To[int32](1)},
+			machine:    powerVSMachine("machine-1", "1", 8, ""),
+			machines:   []client.Object{deletingMachine("machine-0")},
+		},
+		{
+			name:       "Should adopt the existing instance of the machine exceeding the guardrails",
+			guardrails: &infrav1beta2.ClusterGuardrails{MaxInstances: ptr.To[int32](1)},
+			machine:    powerVSMachine("machine-1", "1", 8, ""),
+			machines:   []client.Object{powerVSMachine("machine-0", "1", 8, "instance-0")},
+			instances:  []*models.PVMInstanceReference{{PvmInstanceID: ptr.To("instance-1"), ServerName: ptr.To("machine-1")}},
+		},
+		{
+			name:       "Should not check guardrails once the instance is created",
+			guardrails: &infrav1beta2.ClusterGuardrails{MaxInstances: ptr.To[int32](1)},
+			machine:    powerVSMachine("machine-1", "1", 8, "instance-1"),
+			machines:   []client.Object{powerVSMachine("machine-0", "1", 8, "instance-0")},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			mockpowervs := mock.NewMockPowerVS(mockCtrl)
+			objects := append([]client.Object{tc.machine}, tc.machines...)
+			powervsCluster := newPowerVSCluster(clusterName)
+			powervsCluster.Spec.Guardrails = tc.guardrails
+			scope := &PowerVSMachineScope{
+				Client:            fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(objects...).Build(),
+				Logger:            klog.Background(),
+				Cluster:           newCluster(clusterName),
+				Machine:           newMachine(tc.machine.Name),
+				IBMPowerVSClient:  mockpowervs,
+				IBMPowerVSCluster: powervsCluster,
+				IBMPowerVSMachine: tc.machine,
+			}
+			if tc.exceeded != "" || tc.instances != nil {
+				mockpowervs.EXPECT().GetAllInstance().Return(&models.PVMInstances{PvmInstances: tc.instances}, nil)
+			}
+			exceeded, err := scope.ExceededGuardrails()
+			g.Expect(err).To(BeNil())
+			g.Expect(exceeded).To(Equal(tc.exceeded))
+		})
+	}
+}
+
+func TestReconcileBootstrapSucceeded(t *testing.T) {
+	testCases := []struct {
+		name              string
+		nodeRef           *corev1.ObjectReference
+		runningFor        time.Duration
+		expectedCondition *capiv1beta1.Condition
+	}{
+		{
+			name: "Should wait for the machine to join the cluster when the instance is not running",
+			expectedCondition: &capiv1beta1.Condition{
+				Type:     infrav1beta2.BootstrapSucceededCondition,
+				Status:   corev1.ConditionFalse,
+				Severity: capiv1beta1.ConditionSeverityInfo,
+				Reason:   infrav1beta2.WaitingForNodeJoinReason,
+			},
+		},
+		{
+			name:       "Should wait for the machine to join the cluster within the bootstrap timeout",
+			runningFor: 10 * time.Minute,
+			expectedCondition: &capiv1beta1.Condition{
+				Type:     infrav1beta2.BootstrapSucceededCondition,
+				Status:   corev1.ConditionFalse,
+				Severity: capiv1beta1.ConditionSeverityInfo,
+				Reason:   infrav1beta2.WaitingForNodeJoinReason,
+			},
+		},
+		{
+			name:       "Should set condition to false when machine did not join the cluster within the bootstrap timeout",
+			runningFor: time.Hour,
+			expectedCondition: &capiv1beta1.Condition{
+				Type:     infrav1beta2.BootstrapSucceededCondition,
+				Status:   corev1.ConditionFalse,
+				Severity: capiv1beta1.ConditionSeverityWarning,
+				Reason:   infrav1beta2.NodeNotJoinedReason,
+				Message:  "instance is running for more than 30m0s without the machine joining the cluster",
+			},
+		},
+		{
+			name:    "Should set condition to true when machine joined the cluster",
+			nodeRef: &corev1.ObjectReference{Name: "foo-node"},
+			expectedCondition: &capiv1beta1.Condition{
+				Type:   infrav1beta2.BootstrapSucceededCondition,
+				Status: corev1.ConditionTrue,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			machine := newMachine("foo-machine")
+			machine.Status.NodeRef = tc.nodeRef
+			scope := &PowerVSMachineScope{
+				Logger:            klog.Background(),
+				Machine:           machine,
+				IBMPowerVSMachine: newPowerVSMachine(clusterName, "foo-machine", nil, nil, true),
+			}
+			if tc.runningFor > 0 {
+				scope.IBMPowerVSMachine.Status.Conditions = capiv1beta1.Conditions{{
+					Type:               infrav1beta2.InstanceReadyCondition,
+					Status:             corev1.ConditionTrue,
+					LastTransitionTime: metav1.NewTime(time.Now().Add(-tc.runningFor)),
+				}}
+			}
+			scope.ReconcileBootstrapSucceeded()
+			condition := conditions.Get(scope.IBMPowerVSMachine, infrav1beta2.BootstrapSucceededCondition)
+			g.Expect(condition).ToNot(BeNil())
+			condition.LastTransitionTime = metav1.Time{}
+			g.Expect(condition).To(Equal(tc.expectedCondition))
+		})
+	}
+}
+
+func TestReconcileInstanceHealth(t *testing.T) {
+	unhealthyFor := func(d time.Duration, severity capiv1beta1.ConditionSeverity, reason string) *capiv1beta1.Condition {
+		return &capiv1beta1.Condition{
+			Type:               infrav1beta2.InstanceHealthyCondition,
+			Status:             corev1.ConditionFalse,
+			Severity:           severity,
+			Reason:             reason,
+			Message:            "instance health status is CRITICAL",
+			LastTransitionTime: metav1.NewTime(time.Now().Add(-d)),
+		}
+	}
+
+	testCases := []struct {
+		name              string
+		healthCheck       *infrav1beta2.PowerVSInstanceHealthCheck
+		state             infrav1beta2.PowerVSInstanceState
+		health            string
+		condition         *capiv1beta1.Condition
+		expectedCondition *capiv1beta1.Condition
+		expectedRequeue   time.Duration
+		expectFailure     bool
+	}{
+		{
+			name:      "Should remove condition when health check is not configured",
+			state:     infrav1beta2.PowerVSInstanceStateSHUTOFF,
+			condition: unhealthyFor(time.Minute, capiv1beta1.ConditionSeverityWarning, infrav1beta2.InstanceStoppedReason),
+		},
+		{
+			name:        "Should not set condition when instance state is not known yet",
+			healthCheck: &infrav1beta2.PowerVSInstanceHealthCheck{},
+		},
+		{
+			name:        "Should set condition to true when instance is active and healthy",
+			healthCheck: &infrav1beta2.PowerVSInstanceHealthCheck{},
+			state:       infrav1beta2.PowerVSInstanceStateACTIVE,
+			health:      "OK",
+			expectedCondition: &capiv1beta1.Condition{
+				Type:   infrav1beta2.InstanceHealthyCondition,
+				Status: corev1.ConditionTrue,
+			},
+			expectedRequeue: 5 * time.Minute,
+		},
+		{
+			name:        "Should set condition to unknown when instance is building",
+			healthCheck: &infrav1beta2.PowerVSInstanceHealthCheck{Interval: &metav1.Duration{Duration: time.Minute}},
+			state:       infrav1beta2.PowerVSInstanceStateBUILD,
+			health:      "PENDING",
+			expectedCondition: &capiv1beta1.Condition{
+				Type:    infrav1beta2.InstanceHealthyCondition,
+				Status:  corev1.ConditionUnknown,
+				Reason:  infrav1beta2.InstanceHealthUnknownReason,
+				Message: `instance is in BUILD state with health status "PENDING"`,
+			},
+			expectedRequeue: time.Minute,
+		},
+		{
+			name:        "Should set condition to unknown when active instance has a health status not configured as unhealthy",
+			healthCheck: &infrav1beta2.PowerVSInstanceHealthCheck{},
+			state:       infrav1beta2.PowerVSInstanceStateACTIVE,
+			health:      "WARNING",
+			expectedCondition: &capiv1beta1.Condition{
+				Type:    infrav1beta2.InstanceHealthyCondition,
+				Status:  corev1.ConditionUnknown,
+				Reason:  infrav1beta2.InstanceHealthUnknownReason,
+				Message: `instance is in ACTIVE state with health status "WARNING"`,
+			},
+			expectedRequeue: 5 * time.Minute,
+		},
+		{
+			name:        "Should set condition to false with warning severity when active instance has a configured unhealthy health status",
+			healthCheck: &infrav1beta2.PowerVSInstanceHealthCheck{UnhealthyHealthStatuses: []string{"WARNING", "CRITICAL"}, UnhealthyTimeout: &metav1.Duration{Duration: 2 * time.Minute}},
+			state:       infrav1beta2.PowerVSInstanceStateACTIVE,
+			health:      "WARNING",
+			expectedCondition: &capiv1beta1.Condition{
+				Type:     infrav1beta2.InstanceHealthyCondition,
+				Status:   corev1.ConditionFalse,
+				Severity: capiv1beta1.ConditionSeverityWarning,
+				Reason:   infrav1beta2.InstanceUnhealthyReason,
+				Message:  "instance health status is WARNING",
+			},
+			expectedRequeue: 2 * time.Minute,
+		},
+		{
+			name:        "Should set condition to false with warning severity when instance is stopped",
+			healthCheck: &infrav1beta2.PowerVSInstanceHealthCheck{FailOnUnhealthy: true},
+			state:       infrav1beta2.PowerVSInstanceStateSHUTOFF,
+			expectedCondition: &capiv1beta1.Condition{
+				Type:     infrav1beta2.InstanceHealthyCondition,
+				Status:   corev1.ConditionFalse,
+				Severity: capiv1beta1.ConditionSeverityWarning,
+				Reason:   infrav1beta2.InstanceStoppedReason,
+				Message:  "instance is in SHUTOFF state",
+			},
+			expectedRequeue: 5 * time.Minute,
+		},
+		{
+			name:        "Should set condition to false with error severity when instance is unhealthy for longer than the timeout",
+			healthCheck: &infrav1beta2.PowerVSInstanceHealthCheck{},
+			state:       infrav1beta2.PowerVSInstanceStateACTIVE,
+			health:      "CRITICAL",
+			condition:   unhealthyFor(6*time.Minute, capiv1beta1.ConditionSeverityWarning, infrav1beta2.InstanceUnhealthyReason),
+			expectedCondition: &capiv1beta1.Condition{
+				Type:     infrav1beta2.InstanceHealthyCondition,
+				Status:   corev1.ConditionFalse,
+				Severity: capiv1beta1.ConditionSeverityError,
+				Reason:   infrav1beta2.InstanceUnhealthyReason,
+				Message:  "instance health status is CRITICAL for more than 5m0s",
+			},
+			expectedRequeue: 5 * time.Minute,
+		},
+		{
+			name:        "Should fail machine when instance is unhealthy for longer than the timeout",
+			healthCheck: &infrav1beta2.PowerVSInstanceHealthCheck{FailOnUnhealthy: true, UnhealthyTimeout: &metav1.Duration{}},
+			state:       infrav1beta2.PowerVSInstanceStateACTIVE,
+			health:      "CRITICAL",
+			expectedCondition: &capiv1beta1.Condition{
+				Type:     infrav1beta2.InstanceHealthyCondition,
+				Status:   corev1.ConditionFalse,
+				Severity: capiv1beta1.ConditionSeverityError,
+				Reason:   infrav1beta2.InstanceUnhealthyReason,
+				Message:  "instance health status is CRITICAL for more than 0s",
+			},
+			expectedRequeue: 5 * time.Minute,
+			expectFailure:   true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			powerVSMachine := newPowerVSMachine(clusterName, "foo-machine", nil, nil, true)
+			powerVSMachine.Spec.HealthCheck = tc.healthCheck
+			powerVSMachine.Status.InstanceState = tc.state
+			powerVSMachine.Status.Health = tc.health
+			if tc.condition != nil {
+				powerVSMachine.Status.Conditions = capiv1beta1.Conditions{*tc.condition}
+			}
+			scope := &PowerVSMachineScope{
+				Logger:            klog.Background(),
+				Machine:           newMachine("foo-machine"),
+				IBMPowerVSMachine: powerVSMachine,
+			}
+			g.Expect(scope.ReconcileInstanceHealth()).To(BeNumerically("~", tc.expectedRequeue, time.Second))
+			if tc.expectFailure {
+				g.Expect(scope.IBMPowerVSMachine.Status.FailureReason).To(Equal(ptr.To(infrav1beta2.UpdateMachineError)))
+				g.Expect(scope.IBMPowerVSMachine.Status.FailureMessage).To(Equal(ptr.To(tc.expectedCondition.Message)))
+			} else {
+				g.Expect(scope.IBMPowerVSMachine.Status.FailureReason).To(BeNil())
+			}
+			condition := conditions.Get(scope.IBMPowerVSMachine, infrav1beta2.InstanceHealthyCondition)
+			if tc.expectedCondition == nil {
+				g.Expect(condition).To(BeNil())
+				return
+			}
+			g.Expect(condition).ToNot(BeNil())
+			condition.LastTransitionTime = metav1.Time{}
+			g.Expect(condition).To(Equal(tc.expectedCondition))
+		})
+	}
+}
+
+func TestResolveUserDataWithNTPServers(t *testing.T) {
+	g := NewWithT(t)
+	bootstrapSecret := newBootstrapSecret(clusterName, "foo-machine")
+	bootstrapSecret.Data["value"] = []byte("#cloud-config\nruncmd:\n- kubeadm join\n")
+	powervsMachine := newPowerVSMachine(clusterName, "foo-machine", nil, nil, true)
+	powervsMachine.Spec.NTPServers = []string{"time.example.com"}
+	powervsMachine.Spec.ReportBootstrapResult = true
+	scope := &PowerVSMachineScope{
+		Client:            fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(bootstrapSecret).Build(),
+		Logger:            klog.Background(),
+		Machine:           newMachine("foo-machine"),
+		IBMPowerVSCluster: newPowerVSCluster(clusterName),
+		IBMPowerVSMachine: powervsMachine,
+	}
+	userData, err := scope.resolveUserData()
+	g.Expect(err).To(BeNil())
+	data, err := base64.StdEncoding.DecodeString(userData)
+	g.Expect(err).To(BeNil())
+	g.Expect(string(data)).To(ContainSubstring("#cloud-config\nruncmd:\n- kubeadm join\nntp:\n  enabled: true\n  ntp_client: chrony\n  servers:\n  - \"time.example.com\"\n"))
+	g.Expect(string(data)).To(ContainSubstring(cloudinit.BootstrapSucceededSentinel))
+}
+
+func TestResolveUserDataWithNodeRegistration(t *testing.T) {
+	g := NewWithT(t)
+	bootstrapSecret := newBootstrapSecret(clusterName, "foo-machine")
+	bootstrapSecret.Data["value"] = []byte("#cloud-config\nruncmd:\n- kubeadm join\n")
+	powervsMachine := newPowerVSMachine(clusterName, "foo-machine", nil, nil, true)
+	powervsMachine.Spec.NodeLabels = map[string]string{"pool": "storage", "node.kubernetes.io/disk": "ssd"}
+	powervsMachine.Spec.NodeTaints = []corev1.Taint{{Key: "storage", Effect: corev1.TaintEffectNoExecute}}
+	scope := &PowerVSMachineScope{
+		Client:            fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(bootstrapSecret).Build(),
+		Logger:            klog.Background(),
+		Machine:           newMachine("foo-machine"),
+		IBMPowerVSCluster: newPowerVSCluster(clusterName),
+		IBMPowerVSMachine: powervsMachine,
+	}
+	userData, err := scope.resolveUserData()
+	g.Expect(err).To(BeNil())
+	data, err := base64.StdEncoding.DecodeString(userData)
+	g.Expect(err).To(BeNil())
+	g.Expect(string(data)).To(ContainSubstring("args='--node-labels=node.kubernetes.io/disk=ssd,pool=storage --register-with-taints=storage:NoExecute'"))
+	g.Expect(string(data)).To(ContainSubstring("#cloud-config\nruncmd:\n- kubeadm join\n"))
+	g.Expect(string(data)).ToNot(ContainSubstring(cloudinit.BootstrapSucceededSentinel))
+}
+
+func TestResolveUserDataWithinUserDataLimit(t *testing.T) {
+	newScope := func(bootstrapData string) *PowerVSMachineScope {
+		bootstrapSecret := newBootstrapSecret(clusterName, "foo-machine")
+		bootstrapSecret.Data["value"] = []byte(bootstrapData)
+		powervsMachine := newPowerVSMachine(clusterName, "foo-machine", nil, nil, true)
+		powervsMachine.Spec.ReportBootstrapResult = true
+		return &PowerVSMachineScope{
+			Client:            fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(bootstrapSecret).Build(),
+			Logger:            klog.Background(),
+			Machine:           newMachine("foo-machine"),
+			IBMPowerVSCluster: newPowerVSCluster(clusterName),
+			IBMPowerVSMachine: powervsMachine,
+		}
+	}
+	cloudConfig := func(size int) string {
+		header := "#cloud-config\nruncmd:\n- "
+		return header + strings.Repeat("a", size-len(header))
+	}
+
+	t.Run("Should leave the bootstrap data unwrapped when the wrapped data exceeds the user data limit", func(t *testing.T) {
+		g := NewWithT(t)
+		bootstrapData := cloudConfig(powerVSUserDataLimit * 3 / 4)
+		userData, err := newScope(bootstrapData).resolveUserData()
+		g.Expect(err).To(BeNil())
+		g.Expect(userData).To(Equal(base64.StdEncoding.EncodeToString([]byte(bootstrapData))))
+	})
+
+	t.Run("Should fail when the bootstrap data exceeds the user data limit", func(t *testing.T) {
+		g := NewWithT(t)
+		_, err := newScope(cloudConfig(powerVSUserDataLimit)).resolveUserData()
+		g.Expect(err).To(HaveOccurred())
+		g.Expect(err.Error()).To(ContainSubstring("exceeds the user data limit"))
+	})
+}
+
+func TestDeleteOwnerMachinePVS(t *testing.T) {
+	t.Run("Delete owner machine", func(t *testing.T) {
+		t.Run("Should delete the Machine owned by a MachineSet", func(t *testing.T) {
+			g := NewWithT(t)
+			scope := setupPowerVSMachineScope(clusterName, machineName, ptr.To(pvsImage), ptr.To(pvsNetwork), true, nil)
+			scope.IBMPowerVSMachine.Spec.AutoRepairPolicy = infrav1beta2.MachineAutoRepairPolicyReplace
+			scope.IBMPowerVSMachine.Status.InstanceID = "foo-instance-id"
+			scope.Machine.OwnerReferences = []metav1.OwnerReference{
+				{
+					APIVersion: capiv1beta1.GroupVersion.String(),
+					Kind:       "MachineSet",
+					Name:       "foo-machine-set",
+				},
+			}
+			g.Expect(scope.ShouldAutoRepair()).To(BeTrue())
+			err := scope.DeleteOwnerMachine("the instance is in ERROR state")
+			g.Expect(err).To(BeNil())
+			err = scope.Client.Get(context.TODO(), client.ObjectKeyFromObject(scope.Machine), &capiv1beta1.Machine{})
+			g.Expect(err).To(Not(BeNil()))
+			g.Expect(scope.IBMPowerVSMachine.Status.ActionHistory).To(HaveLen(1))
+			g.Expect(scope.IBMPowerVSMachine.Status.ActionHistory[0].Type).To(Equal(infrav1beta2.MachineActionRemediate))
+			g.Expect(scope.IBMPowerVSMachine.Status.ActionHistory[0].Succeeded).To(BeTrue())
+		})
+
+		t.Run("Should not auto repair a control plane Machine", func(t *testing.T) {
+			g := NewWithT(t)
+			scope := setupPowerVSMachineScope(clusterName, machineName, ptr.To(pvsImage), ptr.To(pvsNetwork), true, nil)
+			scope.IBMPowerVSMachine.Spec.AutoRepairPolicy = infrav1beta2.MachineAutoRepairPolicyReplace
+			scope.Machine.Labels = map[string]string{capiv1beta1.MachineControlPlaneLabel: ""}
+			g.Expect(scope.ShouldAutoRepair()).To(BeFalse())
+		})
+
+		t.Run("Should delete the Machine of an unhealthy instance", func(t *testing.T) {
+			g := NewWithT(t)
+			scope := setupPowerVSMachineScope(clusterName, machineName, ptr.To(pvsImage), ptr.To(pvsNetwork), true, nil)
+			scope.IBMPowerVSMachine.Spec.AutoRepairPolicy = infrav1beta2.MachineAutoRepairPolicyReplace
+			scope.IBMPowerVSMachine.Spec.HealthCheck = &infrav1beta2.PowerVSInstanceHealthCheck{UnhealthyTimeout: &metav1.Duration{}, FailOnUnhealthy: true}
+			scope.IBMPowerVSMachine.Status.InstanceState = infrav1beta2.PowerVSInstanceStateERROR
+			scope.Machine.OwnerReferences = []metav1.OwnerReference{
+				{
+					APIVersion: capiv1beta1.GroupVersion.String(),
+					Kind:       "MachinePool",
+					Name:       "foo-machine-pool",
+				},
+			}
+			_, err := scope.ReconcileInstanceHealth()
+			g.Expect(err).To(BeNil())
+			g.Expect(scope.IBMPowerVSMachine.Status.FailureReason).To(Equal(ptr.To(infrav1beta2.UpdateMachineError)))
+			err = scope.Client.Get(context.TODO(), client.ObjectKeyFromObject(scope.Machine), &capiv1beta1.Machine{})
+			g.Expect(err).To(Not(BeNil()))
+			g.Expect(scope.IBMPowerVSMachine.Status.ActionHistory).To(HaveLen(1))
+			g.Expect(scope.IBMPowerVSMachine.Status.ActionHistory[0].Message).To(ContainSubstring("the instance is unhealthy"))
+		})
+	})
+}
+
+func TestReconcileInstanceShutdownPVS(t *testing.T) {
+	var (
+		mockpowervs *mock.MockPowerVS
+		mockCtrl    *gomock.Controller
+	)
+
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockpowervs = mock.NewMockPowerVS(mockCtrl)
+	}
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+
+	t.Run("Should request the shutdown of an active instance and wait for it", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := setupPowerVSMachineScope(clusterName, machineName, ptr.To(pvsImage), ptr.To(pvsNetwork), true, mockpowervs)
+		scope.IBMPowerVSMachine.Status.InstanceID = "foo-instance-id"
+		scope.IBMPowerVSMachine.Spec.ShutdownTimeout = &metav1.Duration{Duration: time.Minute}
+		mockpowervs.EXPECT().GetInstance("foo-instance-id").Return(&models.PVMInstance{Status: ptr.To("ACTIVE")}, nil).Times(2)
+		mockpowervs.EXPECT().InstanceAction("foo-instance-id", &models.PVMInstanceAction{Action: ptr.To(models.PVMInstanceActionActionStop)}).Return(nil)
+		requeue, err := scope.ReconcileInstanceShutdown()
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
+		g.Expect(conditions.GetReason(scope.IBMPowerVSMachine, infrav1beta2.InstanceShutdownCondition)).To(Equal(infrav1beta2.InstanceShuttingDownReason))
+
+		// the shutdown is requested only once.
+		requeue, err = scope.ReconcileInstanceShutdown()
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
+		g.Expect(scope.IBMPowerVSMachine.Status.ActionHistory).To(HaveLen(1))
+		g.Expect(scope.IBMPowerVSMachine.Status.ActionHistory[0].Type).To(Equal(infrav1beta2.MachineActionStop))
+		g.Expect(scope.IBMPowerVSMachine.Status.ActionHistory[0].Succeeded).To(BeTrue())
+	})
+
+	t.Run("Should stop waiting once the instance is shut off", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := setupPowerVSMachineScope(clusterName, machineName, ptr.To(pvsImage), ptr.To(pvsNetwork), true, mockpowervs)
+		scope.IBMPowerVSMachine.Status.InstanceID = "foo-instance-id"
+		scope.IBMPowerVSMachine.Spec.ShutdownTimeout = &metav1.Duration{Duration: time.Minute}
+		mockpowervs.EXPECT().GetInstance("foo-instance-id").Return(&models.PVMInstance{Status: ptr.To("SHUTOFF")}, nil)
+		requeue, err := scope.ReconcileInstanceShutdown()
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeFalse())
+		g.Expect(conditions.IsTrue(scope.IBMPowerVSMachine, infrav1beta2.InstanceShutdownCondition)).To(BeTrue())
+	})
+
+	t.Run("Should stop waiting once the shutdown timeout elapsed", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := setupPowerVSMachineScope(clusterName, machineName, ptr.To(pvsImage), ptr.To(pvsNetwork), true, mockpowervs)
+		scope.IBMPowerVSMachine.Status.InstanceID = "foo-instance-id"
+		scope.IBMPowerVSMachine.Spec.ShutdownTimeout = &metav1.Duration{Duration: time.Minute}
+		scope.IBMPowerVSMachine.Status.Conditions = capiv1beta1.Conditions{
+			{
+				Type:               infrav1beta2.InstanceShutdownCondition,
+				Status:             corev1.ConditionFalse,
+				Reason:             infrav1beta2.InstanceShuttingDownReason,
+				LastTransitionTime: metav1.NewTime(time.Now().Add(-2 * time.Minute)),
+			},
+		}
+		mockpowervs.EXPECT().GetInstance("foo-instance-id").Return(&models.PVMInstance{Status: ptr.To("ACTIVE")}, nil)
+		requeue, err := scope.ReconcileInstanceShutdown()
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeFalse())
+		g.Expect(conditions.GetReason(scope.IBMPowerVSMachine, infrav1beta2.InstanceShutdownCondition)).To(Equal(infrav1beta2.InstanceShutdownTimeoutReason))
+
+		// the instance is not shut down again once timed out.
+		requeue, err = scope.ReconcileInstanceShutdown()
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeFalse())
+	})
+
+	t.Run("Should not shut down the instance when the shutdown timeout is not set", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := setupPowerVSMachineScope(clusterName, machineName, ptr.To(pvsImage), ptr.To(pvsNetwork), true, mockpowervs)
+		scope.IBMPowerVSMachine.Status.InstanceID = "foo-instance-id"
+		requeue, err := scope.ReconcileInstanceShutdown()
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeFalse())
+	})
+
+	t.Run("Should not shut down the instance when the shutdown timeout is zero", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := setupPowerVSMachineScope(clusterName, machineName, ptr.To(pvsImage), ptr.To(pvsNetwork), true, mockpowervs)
+		scope.IBMPowerVSMachine.Status.InstanceID = "foo-instance-id"
+		scope.IBMPowerVSMachine.Spec.ShutdownTimeout = &metav1.Duration{}
+		requeue, err := scope.ReconcileInstanceShutdown()
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeFalse())
+	})
+
+	t.Run("Should return an error when the shutdown request fails", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := setupPowerVSMachineScope(clusterName, machineName, ptr.To(pvsImage), ptr.To(pvsNetwork), true, mockpowervs)
+		scope.IBMPowerVSMachine.Status.InstanceID = "foo-instance-id"
+		scope.IBMPowerVSMachine.Spec.ShutdownTimeout = &metav1.Duration{Duration: time.Minute}
+		mockpowervs.EXPECT().GetInstance("foo-instance-id").Return(&models.PVMInstance{Status: ptr.To("ACTIVE")}, nil)
+		mockpowervs.EXPECT().InstanceAction("foo-instance-id", gomock.Any()).Return(errors.New("failed to stop instance"))
+		_, err := scope.ReconcileInstanceShutdown()
+		g.Expect(err).To(Not(BeNil()))
+		g.Expect(conditions.Has(scope.IBMPowerVSMachine, infrav1beta2.InstanceShutdownCondition)).To(BeFalse())
+		g.Expect(scope.IBMPowerVSMachine.Status.ActionHistory).To(HaveLen(1))
+		g.Expect(scope.IBMPowerVSMachine.Status.ActionHistory[0].Type).To(Equal(infrav1beta2.MachineActionStop))
+		g.Expect(scope.IBMPowerVSMachine.Status.ActionHistory[0].Succeeded).To(BeFalse())
+	})
+}
+
+func TestReconcileVolumeDetachPVS(t *testing.T) {
+	var (
+		mockpowervs *mock.MockPowerVS
+		mockCtrl    *gomock.Controller
+	)
+
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockpowervs = mock.NewMockPowerVS(mockCtrl)
+	}
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+
+	volumes := &models.Volumes{
+		Volumes: []*models.VolumeReference{
+			{VolumeID: ptr.To("boot-volume-id"), Name: ptr.To("boot-volume"), BootVolume: ptr.To(true)},
+			{VolumeID: ptr.To("data-volume-id"), Name: ptr.To("data-volume"), BootVolume: ptr.To(false)},
+		},
+	}
+
+	t.Run("Should detach the data volumes once and wait for them", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := setupPowerVSMachineScope(clusterName, machineName, ptr.To(pvsImage), ptr.To(pvsNetwork), true, mockpowervs)
+		scope.IBMPowerVSMachine.Status.InstanceID = "foo-instance-id"
+		mockpowervs.EXPECT().GetAllInstanceVolumes("foo-instance-id").Return(volumes, nil).Times(2)
+		mockpowervs.EXPECT().DetachVolume("foo-instance-id", "data-volume-id").Return(nil)
+		requeue, err := scope.ReconcileVolumeDetach()
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
+		g.Expect(conditions.GetReason(scope.IBMPowerVSMachine, infrav1beta2.VolumesDetachedCondition)).To(Equal(infrav1beta2.DetachingVolumesReason))
+
+		requeue, err = scope.ReconcileVolumeDetach()
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
+	})
+
+	t.Run("Should stop waiting once only the boot volume is attached", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := setupPowerVSMachineScope(clusterName, machineName, ptr.To(pvsImage), ptr.To(pvsNetwork), true, mockpowervs)
+		scope.IBMPowerVSMachine.Status.InstanceID = "foo-instance-id"
+		mockpowervs.EXPECT().GetAllInstanceVolumes("foo-instance-id").Return(&models.Volumes{Volumes: volumes.Volumes[:1]}, nil)
+		requeue, err := scope.ReconcileVolumeDetach()
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeFalse())
+		g.Expect(conditions.IsTrue(scope.IBMPowerVSMachine, infrav1beta2.VolumesDetachedCondition)).To(BeTrue())
+	})
+
+	t.Run("Should stop waiting once the volume detach timeout elapsed", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := setupPowerVSMachineScope(clusterName, machineName, ptr.To(pvsImage), ptr.To(pvsNetwork), true, mockpowervs)
+		scope.IBMPowerVSMachine.Status.InstanceID = "foo-instance-id"
+		scope.IBMPowerVSMachine.Status.Conditions = capiv1beta1.Conditions{
+			{
+				Type:               infrav1beta2.VolumesDetachedCondition,
+				Status:             corev1.ConditionFalse,
+				Reason:             infrav1beta2.DetachingVolumesReason,
+				LastTransitionTime: metav1.NewTime(time.Now().Add(-volumeDetachTimeout)),
+			},
+		}
+		mockpowervs.EXPECT().GetAllInstanceVolumes("foo-instance-id").Return(volumes, nil)
+		requeue, err := scope.ReconcileVolumeDetach()
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeFalse())
+		g.Expect(conditions.GetReason(scope.IBMPowerVSMachine, infrav1beta2.VolumesDetachedCondition)).To(Equal(infrav1beta2.VolumeDetachTimeoutReason))
+	})
+}
+
+func TestReconcileDataVolumesPVS(t *testing.T) {
+	var (
+		mockpowervs *mock.MockPowerVS
+		mockCtrl    *gomock.Controller
+	)
+
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockpowervs = mock.NewMockPowerVS(mockCtrl)
+	}
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+
+	additionalVolumes := []*infrav1beta2.PowerVSVolume{
+		{SizeGiB: 100, Tier: "tier1"},
+		{Name: "etcd", SizeGiB: 20},
+	}
+	dataVolumeName := clusterName + "-" + machineName + "-data-0"
+	etcdVolumeName := clusterName + "-" + machineName + "-etcd"
+
+	newScope := func() *PowerVSMachineScope {
+		scope := setupPowerVSMachineScope(clusterName, machineName, ptr.To(pvsImage), ptr.To(pvsNetwork), true, mockpowervs)
+		scope.Machine.Spec.ClusterName = clusterName
+		return scope
+	}
+
+	t.Run("Should not fetch the volumes when no additional volumes are set", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := newScope()
+		requeue, err := scope.ReconcileDataVolumes()
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeFalse())
+	})
+
+	t.Run("Should create the missing data volumes, record their IDs and wait for them", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := newScope()
+		scope.IBMPowerVSMachine.Spec.AdditionalVolumes = additionalVolumes
+		scope.IBMPowerVSMachine.Status.DataVolumes = []infrav1beta2.PowerVSMachineVolumeStatus{{Name: etcdVolumeName, VolumeID: "etcd-volume-id"}}
+		mockpowervs.EXPECT().GetVolume("etcd-volume-id").Return(&models.Volume{VolumeID: ptr.To("etcd-volume-id"), State: "available"}, nil)
+		mockpowervs.EXPECT().CreateVolume(&models.CreateDataVolume{Name: ptr.To(dataVolumeName), Size: ptr.To(float64(100)), DiskType: "tier1", UserTags: models.Tags{"capibm-cluster:default_" + clusterName}}).Return(&models.Volume{VolumeID: ptr.To("data-volume-id")}, nil)
+		requeue, err := scope.ReconcileDataVolumes()
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
+		g.Expect(scope.IBMPowerVSMachine.Status.DataVolumes).To(ConsistOf(
+			infrav1beta2.PowerVSMachineVolumeStatus{Name: etcdVolumeName, VolumeID: "etcd-volume-id"},
+			infrav1beta2.PowerVSMachineVolumeStatus{Name: dataVolumeName, VolumeID: "data-volume-id"},
+		))
+	})
+
+	t.Run("Should not adopt a volume of the workspace with the same name", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := newScope()
+		scope.IBMPowerVSMachine.Spec.AdditionalVolumes = additionalVolumes[:1]
+		mockpowervs.EXPECT().GetAllVolumes().Times(0)
+		mockpowervs.EXPECT().CreateVolume(gomock.AssignableToTypeOf(&models.CreateDataVolume{})).Return(&models.Volume{VolumeID: ptr.To("data-volume-id")}, nil)
+		requeue, err := scope.ReconcileDataVolumes()
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
+	})
+
+	t.Run("Should attach the user tags of the machine to the data volumes", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := newScope()
+		scope.IBMPowerVSMachine.Spec.AdditionalVolumes = additionalVolumes[:1]
+		scope.IBMPowerVSMachine.Spec.UserTags = []string{"pool:storage"}
+		mockpowervs.EXPECT().CreateVolume(&models.CreateDataVolume{Name: ptr.To(dataVolumeName), Size: ptr.To(float64(100)), DiskType: "tier1", UserTags: models.Tags{"capibm-cluster:default_" + clusterName, "pool:storage"}}).Return(&models.Volume{VolumeID: ptr.To("data-volume-id")}, nil)
+		requeue, err := scope.ReconcileDataVolumes()
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
+	})
+
+	t.Run("Should stop waiting once the data volumes are available", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := newScope()
+		scope.IBMPowerVSMachine.Spec.AdditionalVolumes = additionalVolumes
+		scope.IBMPowerVSMachine.Status.DataVolumes = []infrav1beta2.PowerVSMachineVolumeStatus{
+			{Name: etcdVolumeName, VolumeID: "etcd-volume-id"},
+			{Name: dataVolumeName, VolumeID: "data-volume-id"},
+		}
+		mockpowervs.EXPECT().GetVolume("data-volume-id").Return(&models.Volume{VolumeID: ptr.To("data-volume-id"), State: "available"}, nil)
+		mockpowervs.EXPECT().GetVolume("etcd-volume-id").Return(&models.Volume{VolumeID: ptr.To("etcd-volume-id"), State: "available"}, nil)
+		requeue, err := scope.ReconcileDataVolumes()
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeFalse())
+
+		ids, err := scope.dataVolumeIDs()
+		g.Expect(err).To(BeNil())
+		g.Expect(ids).To(Equal([]string{"data-volume-id", "etcd-volume-id"}))
+	})
+
+	t.Run("Should return error when a data volume is in error state", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := newScope()
+		scope.IBMPowerVSMachine.Spec.AdditionalVolumes = additionalVolumes[1:]
+		scope.IBMPowerVSMachine.Status.DataVolumes = []infrav1beta2.PowerVSMachineVolumeStatus{{Name: etcdVolumeName, VolumeID: "etcd-volume-id"}}
+		mockpowervs.EXPECT().GetVolume("etcd-volume-id").Return(&models.Volume{VolumeID: ptr.To("etcd-volume-id"), State: "error"}, nil)
+		_, err := scope.ReconcileDataVolumes()
+		g.Expect(err).ToNot(BeNil())
+	})
+
+	t.Run("Should return error when the volume creation fails", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := newScope()
+		scope.IBMPowerVSMachine.Spec.AdditionalVolumes = additionalVolumes[1:]
+		mockpowervs.EXPECT().CreateVolume(gomock.AssignableToTypeOf(&models.CreateDataVolume{})).Return(nil, errors.New("error creating volume"))
+		_, err := scope.ReconcileDataVolumes()
+		g.Expect(err).ToNot(BeNil())
+		g.Expect(scope.IBMPowerVSMachine.Status.DataVolumes).To(BeEmpty())
+	})
+}
+
+func TestDataVolumeName(t *testing.T) {
+	g := NewWithT(t)
+	g.Expect(dataVolumeName("foo-cluster", "foo-machine", 0, "")).To(Equal("foo-cluster-foo-machine-data-0"))
+	g.Expect(dataVolumeName("foo-cluster", "foo-machine", 1, "etcd")).To(Equal("foo-cluster-foo-machine-etcd"))
+	g.Expect(dataVolumeName("foo-cluster", "foo-cluster-md-0-abcde", 0, "")).To(Equal("foo-cluster-md-0-abcde-data-0"))
+	g.Expect(dataVolumeName("bar-cluster", "foo-cluster-md-0-abcde", 0, "")).To(Equal("bar-cluster-foo-cluster-md-0-abcde-data-0"))
+}
+
+func TestDeleteDataVolumesPVS(t *testing.T) {
+	var (
+		mockpowervs *mock.MockPowerVS
+		mockCtrl    *gomock.Controller
+	)
+
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockpowervs = mock.NewMockPowerVS(mockCtrl)
+	}
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+
+	additionalVolumes := []*infrav1beta2.PowerVSVolume{
+		{SizeGiB: 100},
+		{Name: "etcd", SizeGiB: 20, DeleteVolumeOnInstanceDelete: ptr.To(false)},
+	}
+	dataVolumes := []infrav1beta2.PowerVSMachineVolumeStatus{
+		{Name: clusterName + "-" + machineName + "-data-0", VolumeID: "data-volume-id"},
+		{Name: clusterName + "-" + machineName + "-etcd", VolumeID: "etcd-volume-id"},
+	}
+
+	newScope := func() *PowerVSMachineScope {
+		scope := setupPowerVSMachineScope(clusterName, machineName, ptr.To(pvsImage), ptr.To(pvsNetwork), true, mockpowervs)
+		scope.Machine.Spec.ClusterName = clusterName
+		scope.IBMPowerVSMachine.Spec.AdditionalVolumes = additionalVolumes
+		scope.IBMPowerVSMachine.Status.DataVolumes = slices.Clone(dataVolumes)
+		return scope
+	}
+
+	t.Run("Should delete only the data volumes which are not kept", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := newScope()
+		mockpowervs.EXPECT().GetVolume("data-volume-id").Return(&models.Volume{VolumeID: ptr.To("data-volume-id"), State: "available"}, nil)
+		mockpowervs.EXPECT().DeleteVolume("data-volume-id").Return(nil)
+		requeue, err := scope.DeleteDataVolumes()
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeFalse())
+		g.Expect(scope.IBMPowerVSMachine.Status.DataVolumes).To(Equal(dataVolumes[1:]))
+	})
+
+	t.Run("Should skip the data volumes already deleted", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := newScope()
+		mockpowervs.EXPECT().GetVolume("data-volume-id").Return(nil, fmt.Errorf("failed to get volume: %w", p_cloud_volumes.NewPcloudCloudinstancesVolumesGetNotFound()))
+		requeue, err := scope.DeleteDataVolumes()
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeFalse())
+		g.Expect(scope.IBMPowerVSMachine.Status.DataVolumes).To(Equal(dataVolumes[1:]))
+	})
+
+	t.Run("Should wait for the data volumes still attached to the instance", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := newScope()
+		scope.IBMPowerVSMachine.Status.InstanceID = "foo-instance-id"
+		mockpowervs.EXPECT().GetVolume("data-volume-id").Return(&models.Volume{VolumeID: ptr.To("data-volume-id"), State: "in-use", PvmInstanceIDs: []string{"foo-instance-id"}}, nil)
+		requeue, err := scope.DeleteDataVolumes()
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
+	})
+
+	t.Run("Should skip the data volumes attached to another instance", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := newScope()
+		scope.IBMPowerVSMachine.Status.InstanceID = "foo-instance-id"
+		mockpowervs.EXPECT().GetVolume("data-volume-id").Return(&models.Volume{VolumeID: ptr.To("data-volume-id"), State: "in-use", PvmInstanceIDs: []string{"bar-instance-id"}}, nil)
+		requeue, err := scope.DeleteDataVolumes()
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeFalse())
+		g.Expect(scope.IBMPowerVSMachine.Status.DataVolumes).To(Equal(dataVolumes[1:]))
+	})
+
+	t.Run("Should skip the data volumes which did not detach within the timeout", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := newScope()
+		scope.IBMPowerVSMachine.Status.InstanceID = "foo-instance-id"
+		conditions.MarkFalse(scope.IBMPowerVSMachine, infrav1beta2.VolumesDetachedCondition, infrav1beta2.VolumeDetachTimeoutReason, capiv1beta1.ConditionSeverityWarning, "")
+		mockpowervs.EXPECT().GetVolume("data-volume-id").Return(&models.Volume{VolumeID: ptr.To("data-volume-id"), State: "in-use", PvmInstanceIDs: []string{"foo-instance-id"}}, nil)
+		requeue, err := scope.DeleteDataVolumes()
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeFalse())
+	})
+
+	t.Run("Should return error when the volume deletion fails", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := newScope()
+		mockpowervs.EXPECT().GetVolume("data-volume-id").Return(&models.Volume{VolumeID: ptr.To("data-volume-id"), State: "available"}, nil)
+		mockpowervs.EXPECT().DeleteVolume("data-volume-id").Return(errors.New("error deleting volume"))
+		_, err := scope.DeleteDataVolumes()
+		g.Expect(err).ToNot(BeNil())
+		g.Expect(scope.IBMPowerVSMachine.Status.DataVolumes).To(Equal(dataVolumes))
+	})
+}
+
+func TestApplyPowerVSPrivateCloud(t *testing.T) {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "private-cloud-credentials",
+			Namespace: "default",
+		},
+		Data: map[string][]byte{
+			"apiKey": []byte("private-cloud-api-key"),
+		},
+	}
+	secretRef := &corev1.LocalObjectReference{Name: secret.Name}
+
+	t.Run("Should leave the options untouched for the public Power VS", func(t *testing.T) {
+		g := NewWithT(t)
+		auth := &core.NoAuthAuthenticator{}
+		options := &ibmpisession.IBMPIOptions{Authenticator: auth, URL: "https://dal.power-iaas.cloud.ibm.com"}
+		g.Expect(applyPowerVSPrivateCloud(fake.NewClientBuilder().Build(), "default", secretRef, nil, options)).To(Succeed())
+		g.Expect(options.URL).To(Equal("https://dal.power-iaas.cloud.ibm.com"))
+		g.Expect(options.Authenticator).To(BeIdenticalTo(auth))
+	})
+
+	t.Run("Should point the options to the endpoint and account of the private cloud", func(t *testing.T) {
+		g := NewWithT(t)
+		auth := &core.NoAuthAuthenticator{}
+		options := &ibmpisession.IBMPIOptions{Authenticator: auth}
+		privateCloud := &infrav1beta2.PowerVSPrivateCloud{
+			Endpoint:  "https://power.private-cloud.example.com",
+			AccountID: "private-cloud-account",
+		}
+		c := fake.NewClientBuilder().WithObjects(secret.DeepCopy()).Build()
+		g.Expect(applyPowerVSPrivateCloud(c, "default", secretRef, privateCloud, options)).To(Succeed())
+		g.Expect(options.URL).To(Equal("https://power.private-cloud.example.com"))
+		g.Expect(options.UserAccount).To(Equal("private-cloud-account"))
+		iamAuth, ok := options.Authenticator.(*core.IamAuthenticator)
+		g.Expect(ok).To(BeTrue())
+		g.Expect(iamAuth.ApiKey).To(Equal("private-cloud-api-key"))
+	})
+
+	t.Run("Error when the cluster does not reference a credentials secret", func(t *testing.T) {
+		g := NewWithT(t)
+		auth := &core.NoAuthAuthenticator{}
+		options := &ibmpisession.IBMPIOptions{Authenticator: auth}
+		privateCloud := &infrav1beta2.PowerVSPrivateCloud{
+			Endpoint:    "https://power.private-cloud.example.com",
+			IAMEndpoint: "https://iam.private-cloud.example.com",
+		}
+		g.Expect(applyPowerVSPrivateCloud(fake.NewClientBuilder().Build(), "default", nil, privateCloud, options)).ToNot(Succeed())
+		g.Expect(options.URL).To(BeEmpty())
+		g.Expect(options.Authenticator).To(BeIdenticalTo(auth))
+	})
+
+	t.Run("Should authenticate against the IAM endpoint of the private cloud", func(t *testing.T) {
+		g := NewWithT(t)
+		options := &ibmpisession.IBMPIOptions{Authenticator: &core.NoAuthAuthenticator{}}
+		privateCloud := &infrav1beta2.PowerVSPrivateCloud{
+			Endpoint:    "https://power.private-cloud.example.com",
+			IAMEndpoint: "https://iam.private-cloud.example.com",
+		}
+		c := fake.NewClientBuilder().WithObjects(secret.DeepCopy()).Build()
+		g.Expect(applyPowerVSPrivateCloud(c, "default", secretRef, privateCloud, options)).To(Succeed())
+		iamAuth, ok := options.Authenticator.(*core.IamAuthenticator)
+		g.Expect(ok).To(BeTrue())
+		g.Expect(iamAuth.ApiKey).To(Equal("private-cloud-api-key"))
+		g.Expect(iamAuth.URL).To(Equal("https://iam.private-cloud.example.com"))
+	})
+
+	t.Run("Error when the credentials secret of the private cloud does not exist", func(t *testing.T) {
+		g := NewWithT(t)
+		options := &ibmpisession.IBMPIOptions{}
+		privateCloud := &infrav1beta2.PowerVSPrivateCloud{
+			Endpoint:    "https://power.private-cloud.example.com",
+			IAMEndpoint: "https://iam.private-cloud.example.com",
+		}
+		g.Expect(applyPowerVSPrivateCloud(fake.NewClientBuilder().Build(), "default", secretRef, privateCloud, options)).ToNot(Succeed())
+	})
+}
+
+func TestPrivateCloudServiceInstanceID(t *testing.T) {
+	g := NewWithT(t)
+	id, err := privateCloudServiceInstanceID(&infrav1beta2.IBMPowerVSCluster{Spec: infrav1beta2.IBMPowerVSClusterSpec{ServiceInstanceID: "service-instance-id"}})
+	g.Expect(err).To(BeNil())
+	g.Expect(id).To(Equal("service-instance-id"))
+
+	id, err = privateCloudServiceInstanceID(&infrav1beta2.IBMPowerVSCluster{Spec: infrav1beta2.IBMPowerVSClusterSpec{ServiceInstance: &infrav1beta2.IBMPowerVSResourceReference{ID: ptr.To("service-instance-ref-id")}}})
+	g.Expect(err).To(BeNil())
+	g.Expect(id).To(Equal("service-instance-ref-id"))
+
+	_, err = privateCloudServiceInstanceID(&infrav1beta2.IBMPowerVSCluster{Spec: infrav1beta2.IBMPowerVSClusterSpec{ServiceInstance: &infrav1beta2.IBMPowerVSResourceReference{Name: ptr.To("service-instance")}}})
+	g.Expect(err).ToNot(BeNil())
+}
@@ -0,0 +1,427 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scope
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/go-logr/logr"
+
+	"github.com/IBM-Cloud/power-go-client/ibmpisession"
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"sigs.k8s.io/cluster-api/util/patch"
+
+	infrav1beta2 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/powervs"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/resourcecontroller"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/endpoints"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/record"
+)
+
+// PowerVSNetworkScopeParams defines the input parameters used to create a new PowerVSNetworkScope.
+type PowerVSNetworkScopeParams struct {
+	Client            client.Client
+	Logger            logr.Logger
+	IBMPowerVSNetwork *infrav1beta2.IBMPowerVSNetwork
+	ServiceEndpoint   []endpoints.ServiceEndpoint
+}
+
+// PowerVSNetworkScope defines a scope defined around a Power VS network.
+type PowerVSNetworkScope struct {
+	logr.Logger
+	Client      client.Client
+	patchHelper *patch.Helper
+
+	IBMPowerVSClient  powervs.PowerVS
+	IBMPowerVSNetwork *infrav1beta2.IBMPowerVSNetwork
+	ServiceEndpoint   []endpoints.ServiceEndpoint
+}
+
+// NewPowerVSNetworkScope creates a new PowerVSNetworkScope from the supplied parameters.
+func NewPowerVSNetworkScope(params PowerVSNetworkScopeParams) (scope *PowerVSNetworkScope, err error) {
+	scope = &PowerVSNetworkScope{}
+
+	if params.Client == nil {
+		err = errors.New("failed to generate new scope from nil Client")
+		return nil, err
+	}
+	scope.Client = params.Client
+
+	if params.IBMPowerVSNetwork == nil {
+		err = errors.New("failed to generate new scope from nil IBMPowerVSNetwork")
+		return nil, err
+	}
+	scope.IBMPowerVSNetwork = params.IBMPowerVSNetwork
+
+	if params.Logger == (logr.Logger{}) {
+		params.Logger = klog.Background()
+	}
+	scope.Logger = params.Logger
+
+	helper, err := patch.NewHelper(params.IBMPowerVSNetwork, params.Client)
+	if err != nil {
+		err = fmt.Errorf("failed to init patch helper: %w", err)
+		return nil, err
+	}
+	scope.patchHelper = helper
+
+	auth, err := getAuthenticator(params.Client, params.IBMPowerVSNetwork.Namespace, params.IBMPowerVSNetwork.Spec.CredentialsSecretRef, params.IBMPowerVSNetwork.Spec.EnterpriseAccount)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create authenticator: %w", err)
+	}
+
+	// Create Resource Controller client.
+	serviceOption := resourcecontroller.ServiceOptions{
+		ResourceControllerV2Options: &resourcecontrollerv2.ResourceControllerV2Options{
+			Authenticator: auth,
+		},
+	}
+	// Fetch the resource controller endpoint.
+	rcEndpoint := endpoints.FetchEndpoints(string(endpoints.RC), params.ServiceEndpoint)
+	if rcEndpoint != "" {
+		serviceOption.URL = rcEndpoint
+		params.Logger.V(3).Info("Overriding the default resource controller endpoint", "ResourceControllerEndpoint", rcEndpoint)
+	}
+
+	rc, err := resourcecontroller.NewService(serviceOption)
+	if err != nil {
+		return nil, err
+	}
+
+	var serviceInstanceID string
+	spec := params.IBMPowerVSNetwork.Spec
+	if spec.ServiceInstance.ID != nil {
+		serviceInstanceID = *spec.ServiceInstance.ID
+	} else if spec.ServiceInstance.Name != nil {
+		name := *spec.ServiceInstance.Name
+		serviceInstance, err := rc.GetServiceInstance("", name, spec.Zone)
+		if err != nil {
+			params.Logger.Error(err, "error failed to get service instance id from name", "name", name)
+			return nil, err
+		}
+		if serviceInstance == nil {
+			return nil, fmt.Errorf("service instance %s is not yet created", name)
+		}
+		if *serviceInstance.State != string(infrav1beta2.ServiceInstanceStateActive) {
+			return nil, fmt.Errorf("service instance %s is not in active state", name)
+		}
+		serviceInstanceID = *serviceInstance.GUID
+	} else {
+		return nil, errors.New("one of service instance ID or name must be set")
+	}
+
+	res, _, err := rc.GetResourceInstance(
+		&resourcecontrollerv2.GetResourceInstanceOptions{
+			ID: &serviceInstanceID,
+		})
+	if err != nil {
+		err = fmt.Errorf("failed to get resource instance: %w", err)
+		return nil, err
+	}
+
+	options := powervs.ServiceOptions{
+		IBMPIOptions: &ibmpisession.IBMPIOptions{
+			Authenticator: auth,
+			Debug:         params.Logger.V(DEBUGLEVEL).Enabled(),
+			Zone:          *res.RegionID,
+		},
+	}
+
+	// Fetch the service endpoint.
+	if svcEndpoint := endpoints.FetchPVSEndpoint(endpoints.ConstructRegionFromZone(*res.RegionID), params.ServiceEndpoint); svcEndpoint != "" {
+		options.IBMPIOptions.URL = svcEndpoint
+		scope.Logger.V(3).Info("overriding the default powervs service endpoint")
+	}
+
+	c, err := powervs.NewService(options)
+	if err != nil {
+		err = fmt.Errorf("failed to create NewIBMPowerVSClient error %w", err)
+		return nil, err
+	}
+
+	options.CloudInstanceID = serviceInstanceID
+	c.WithClients(options)
+	scope.IBMPowerVSClient = c
+	scope.ServiceEndpoint = params.ServiceEndpoint
+	return scope, nil
+}
+
+// NetworkName returns the name of the network in the Power VS workspace.
+func (n *PowerVSNetworkScope) NetworkName() string {
+	if n.IBMPowerVSNetwork.Spec.Name != nil {
+		return *n.IBMPowerVSNetwork.Spec.Name
+	}
+	return n.IBMPowerVSNetwork.Name
+}
+
+// dhcpServerName returns the name of the DHCP server of the network.
+func (n *PowerVSNetworkScope) dhcpServerName() string {
+	if dhcpServer := n.IBMPowerVSNetwork.Spec.DHCPServer; dhcpServer != nil && dhcpServer.Name != nil {
+		return *dhcpServer.Name
+	}
+	return n.NetworkName()
+}
+
+// ReconcileNetwork reconciles the network of the IBMPowerVSNetwork, it returns true when the network is ready to be used.
+// If IBMPowerVSNetwork.Spec.DHCPServer is set, the network is created along with a DHCP server, an existing DHCP server matching the ID or
+// the name is used as is.
+// If IBMPowerVSNetwork.Spec.DHCPServer is not set, the network is created with the given type, CIDR, gateway and DNS servers, an existing
+// network matching the name is used as is.
+func (n *PowerVSNetworkScope) ReconcileNetwork() (bool, error) {
+	if n.GetNetworkID() != "" {
+		if _, err := n.IBMPowerVSClient.GetNetworkByID(n.GetNetworkID()); err != nil {
+			return false, err
+		}
+		if n.GetDHCPServerID() == "" {
+			return true, nil
+		}
+		n.V(3).Info("DHCP server ID is set, fetching details", "dhcpServerID", n.GetDHCPServerID())
+		dhcpServer, err := n.IBMPowerVSClient.GetDHCPServer(n.GetDHCPServerID())
+		if err != nil {
+			return false, err
+		}
+		return n.checkDHCPServerStatus(*dhcpServer)
+	}
+
+	if n.IBMPowerVSNetwork.Spec.DHCPServer != nil {
+		return n.reconcileDHCPServer()
+	}
+
+	networkName := n.NetworkName()
+	network, err := n.IBMPowerVSClient.GetNetworkByName(networkName)
+	if err != nil {
+		return false, err
+	}
+	if network != nil && network.NetworkID != nil {
+		n.V(3).Info("Found PowerVS network in IBM Cloud", "networkID", *network.NetworkID)
+		n.SetNetworkID(network.NetworkID, false)
+		return true, nil
+	}
+
+	spec := n.IBMPowerVSNetwork.Spec
+	body := &models.NetworkCreate{
+		Name:       networkName,
+		Type:       ptr.To(string(spec.Type)),
+		DNSServers: spec.DNSServers,
+	}
+	if spec.CIDR != nil {
+		body.Cidr = *spec.CIDR
+	}
+	if spec.Gateway != nil {
+		body.Gateway = *spec.Gateway
+	}
+	n.V(3).Info("Creating a new PowerVS network", "name", networkName)
+	createdNetwork, err := n.IBMPowerVSClient.CreateNetwork(body)
+	if err != nil {
+		record.Warnf(n.IBMPowerVSNetwork, "FailedCreateNetwork", "Failed network creation - %v", err)
+		return false, err
+	}
+	if createdNetwork == nil || createdNetwork.NetworkID == nil {
+		return false, fmt.Errorf("created network is nil")
+	}
+	n.Info("Created PowerVS network", "networkID", *createdNetwork.NetworkID)
+	record.Eventf(n.IBMPowerVSNetwork, "SuccessfulCreateNetwork", "Created network %q", networkName)
+	n.SetNetworkID(createdNetwork.NetworkID, true)
+	return true, nil
+}
+
+// reconcileDHCPServer finds or creates the DHCP server whose network is used for the IBMPowerVSNetwork.
+func (n *PowerVSNetworkScope) reconcileDHCPServer() (bool, error) {
+	dhcpServerSpec := n.IBMPowerVSNetwork.Spec.DHCPServer
+	if dhcpServerSpec.ID != nil {
+		dhcpServer, err := n.IBMPowerVSClient.GetDHCPServer(*dhcpServerSpec.ID)
+		if err != nil {
+			return false, err
+		}
+		if dhcpServer.Network == nil {
+			return false, fmt.Errorf("found DHCP server with ID `%s`, but network is nil", *dhcpServerSpec.ID)
+		}
+		n.SetDHCPServerID(dhcpServer.ID)
+		n.SetNetworkID(dhcpServer.Network.ID, false)
+		return n.checkDHCPServerStatus(*dhcpServer)
+	}
+
+	networkName := dhcpNetworkName(n.dhcpServerName())
+	n.V(3).Info("Checking DHCP server's network list by network name", "name", networkName)
+	dhcpServers, err := n.IBMPowerVSClient.GetAllDHCPServers()
+	if err != nil {
+		return false, err
+	}
+	for _, dhcpServer := range dhcpServers {
+		if dhcpServer.Network != nil && dhcpServer.Network.Name != nil && *dhcpServer.Network.Name == networkName {
+			n.V(3).Info("Found DHCP server in IBM Cloud", "dhcpServerID", dhcpServer.ID)
+			n.SetDHCPServerID(dhcpServer.ID)
+			n.SetNetworkID(dhcpServer.Network.ID, false)
+			return dhcpServer.Status != nil && *dhcpServer.Status == string(infrav1beta2.DHCPServerStateActive), nil
+		}
+	}
+
+	dhcpServerCreateParams := &models.DHCPServerCreate{
+		Name:        ptr.To(n.dhcpServerName()),
+		Cidr:        dhcpServerSpec.Cidr,
+		DNSServer:   dhcpServerSpec.DNSServer,
+		SnatEnabled: dhcpServerSpec.Snat,
+	}
+	n.V(3).Info("Creating a new DHCP server with name", "name", dhcpServerCreateParams.Name)
+	dhcpServer, err := n.IBMPowerVSClient.CreateDHCPServer(dhcpServerCreateParams)
+	if err != nil {
+		record.Warnf(n.IBMPowerVSNetwork, "FailedCreateDHCPServer", "Failed DHCP server creation - %v", err)
+		return false, err
+	}
+	if dhcpServer == nil {
+		return false, fmt.Errorf("created DHCP server is nil")
+	}
+	if dhcpServer.Network == nil {
+		return false, fmt.Errorf("created DHCP server network is nil")
+	}
+	n.Info("Created DHCP Server", "dhcpServerID", *dhcpServer.ID)
+	record.Eventf(n.IBMPowerVSNetwork, "SuccessfulCreateDHCPServer", "Created DHCP server %q", *dhcpServerCreateParams.Name)
+	n.SetDHCPServerID(dhcpServer.ID)
+	n.SetNetworkID(dhcpServer.Network.ID, true)
+	return false, nil
+}
+
+// checkDHCPServerStatus checks the state of a DHCP server.
+// If state is active, true is returned.
+// In all other cases, it returns false.
+func (n *PowerVSNetworkScope) checkDHCPServerStatus(dhcpServer models.DHCPServerDetail) (bool, error) {
+	if dhcpServer.Status == nil {
+		return false, nil
+	}
+	switch *dhcpServer.Status {
+	case string(infrav1beta2.DHCPServerStateActive):
+		return true, nil
+	case string(infrav1beta2.DHCPServerStateError):
+		return false, fmt.Errorf("DHCP server creation failed and is in error state")
+	}
+	return false, nil
+}
+
+// DeleteNetwork deletes the network of the IBMPowerVSNetwork, it returns true when the deletion is in progress and requires requeue.
+// The network is deleted only when it is created by the controller and the delete policy is not set to retain.
+func (n *PowerVSNetworkScope) DeleteNetwork() (bool, error) {
+	if n.IBMPowerVSNetwork.Status.ControllerCreated == nil || !*n.IBMPowerVSNetwork.Status.ControllerCreated {
+		n.Info("Skipping network deletion as resource is not created by controller")
+		return false, nil
+	}
+	if n.IBMPowerVSNetwork.Spec.DeletePolicy == string(infrav1beta2.DeletePolicyRetain) {
+		n.Info("Skipping network deletion as delete policy is set to retain")
+		return false, nil
+	}
+
+	if dhcpServerID := n.GetDHCPServerID(); dhcpServerID != "" {
+		if _, err := n.IBMPowerVSClient.GetDHCPServer(dhcpServerID); err != nil {
+			if strings.Contains(err.Error(), string(DHCPServerNotFound)) {
+				n.Info("DHCP server successfully deleted")
+				return false, nil
+			}
+			return false, fmt.Errorf("failed to fetch DHCP server: %w", err)
+		}
+		if err := n.IBMPowerVSClient.DeleteDHCPServer(dhcpServerID); err != nil {
+			record.Warnf(n.IBMPowerVSNetwork, "FailedDeleteDHCPServer", "Failed DHCP server deletion - %v", err)
+			return false, fmt.Errorf("failed to delete DHCP server: %w", err)
+		}
+		record.Eventf(n.IBMPowerVSNetwork, "SuccessfulDeleteDHCPServer", "Deleted DHCP server %q", dhcpServerID)
+		return true, nil
+	}
+
+	networkID := n.GetNetworkID()
+	if networkID == "" {
+		return false, nil
+	}
+	networks, err := n.IBMPowerVSClient.GetAllNetwork()
+	if err != nil {
+		return false, fmt.Errorf("failed to fetch networks: %w", err)
+	}
+	found := false
+	for _, network := range networks.Networks {
+		if network.NetworkID != nil && *network.NetworkID == networkID {
+			found = true
+			break
+		}
+	}
+	if !found {
+		n.Info("Network successfully deleted")
+		return false, nil
+	}
+	if err := n.IBMPowerVSClient.DeleteNetwork(networkID); err != nil {
+		record.Warnf(n.IBMPowerVSNetwork, "FailedDeleteNetwork", "Failed network deletion - %v", err)
+		return false, fmt.Errorf("failed to delete network: %w", err)
+	}
+	record.Eventf(n.IBMPowerVSNetwork, "SuccessfulDeleteNetwork", "Deleted network %q", networkID)
+	return true, nil
+}
+
+// PatchObject persists the network configuration and status.
+func (n *PowerVSNetworkScope) PatchObject() error {
+	return n.patchHelper.Patch(context.TODO(), n.IBMPowerVSNetwork)
+}
+
+// Close closes the current scope persisting the network configuration and status.
+func (n *PowerVSNetworkScope) Close() error {
+	return n.PatchObject()
+}
+
+// SetReady will set the status as ready for the network.
+func (n *PowerVSNetworkScope) SetReady() {
+	n.IBMPowerVSNetwork.Status.Ready = true
+}
+
+// SetNotReady will set the status as not ready for the network.
+func (n *PowerVSNetworkScope) SetNotReady() {
+	n.IBMPowerVSNetwork.Status.Ready = false
+}
+
+// IsReady will return the status for the network.
+func (n *PowerVSNetworkScope) IsReady() bool {
+	return n.IBMPowerVSNetwork.Status.Ready
+}
+
+// SetNetworkID will set the id for the network along with whether it is created by the controller.
+func (n *PowerVSNetworkScope) SetNetworkID(id *string, controllerCreated bool) {
+	if id != nil {
+		n.IBMPowerVSNetwork.Status.NetworkID = *id
+		n.IBMPowerVSNetwork.Status.ControllerCreated = ptr.To(controllerCreated)
+	}
+}
+
+// GetNetworkID will get the id for the network.
+func (n *PowerVSNetworkScope) GetNetworkID() string {
+	return n.IBMPowerVSNetwork.Status.NetworkID
+}
+
+// SetDHCPServerID will set the id for the DHCP server of the network.
+func (n *PowerVSNetworkScope) SetDHCPServerID(id *string) {
+	if id != nil {
+		n.IBMPowerVSNetwork.Status.DHCPServerID = *id
+	}
+}
+
+// GetDHCPServerID will get the id for the DHCP server of the network.
+func (n *PowerVSNetworkScope) GetDHCPServerID() string {
+	return n.IBMPowerVSNetwork.Status.DHCPServerID
+}
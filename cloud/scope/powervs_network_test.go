@@ -0,0 +1,239 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scope
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"go.uber.org/mock/gomock"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+
+	infrav1beta2 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/powervs/mock"
+
+	. "github.com/onsi/gomega"
+)
+
+func newPowerVSNetwork() *infrav1beta2.IBMPowerVSNetwork {
+	return &infrav1beta2.IBMPowerVSNetwork{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "capi-net",
+			Namespace: "default",
+		},
+		Spec: infrav1beta2.IBMPowerVSNetworkSpec{
+			ServiceInstance: infrav1beta2.IBMPowerVSResourceReference{ID: ptr.To("capi-si-id")},
+			Type:            infrav1beta2.PowerVSNetworkTypeVLAN,
+			CIDR:            ptr.To("192.168.0.0/24"),
+			DeletePolicy:    string(infrav1beta2.DeletePolicyDelete),
+		},
+	}
+}
+
+func setupPowerVSNetworkScope(mockpowervs *mock.MockPowerVS) *PowerVSNetworkScope {
+	return &PowerVSNetworkScope{
+		Logger:            klog.Background(),
+		IBMPowerVSClient:  mockpowervs,
+		IBMPowerVSNetwork: newPowerVSNetwork(),
+	}
+}
+
+func TestNewPowerVSNetworkScope(t *testing.T) {
+	testCases := []struct {
+		name   string
+		params PowerVSNetworkScopeParams
+	}{
+		{
+			name: "Error when Client in nil",
+			params: PowerVSNetworkScopeParams{
+				Client: nil,
+			},
+		},
+	}
+	for _, tc := range testCases {
+		g := NewWithT(t)
+		t.Run(tc.name, func(_ *testing.T) {
+			_, err := NewPowerVSNetworkScope(tc.params)
+			g.Expect(err).To(Not(BeNil()))
+		})
+	}
+}
+
+func TestPowerVSNetworkScope_ReconcileNetwork(t *testing.T) {
+	var (
+		mockpowervs *mock.MockPowerVS
+		mockCtrl    *gomock.Controller
+	)
+
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockpowervs = mock.NewMockPowerVS(mockCtrl)
+	}
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+
+	t.Run("Should create the network when it does not exist", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := setupPowerVSNetworkScope(mockpowervs)
+		mockpowervs.EXPECT().GetNetworkByName("capi-net").Return(nil, nil)
+		mockpowervs.EXPECT().CreateNetwork(gomock.Any()).DoAndReturn(func(body *models.NetworkCreate) (*models.Network, error) {
+			g.Expect(body.Name).To(Equal("capi-net"))
+			g.Expect(*body.Type).To(Equal("vlan"))
+			g.Expect(body.Cidr).To(Equal("192.168.0.0/24"))
+			return &models.Network{NetworkID: ptr.To("capi-net-id")}, nil
+		})
+		ready, err := scope.ReconcileNetwork()
+		g.Expect(err).To(BeNil())
+		g.Expect(ready).To(BeTrue())
+		g.Expect(scope.GetNetworkID()).To(Equal("capi-net-id"))
+		g.Expect(*scope.IBMPowerVSNetwork.Status.ControllerCreated).To(BeTrue())
+	})
+
+	t.Run("Should use the existing network with the same name", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := setupPowerVSNetworkScope(mockpowervs)
+		mockpowervs.EXPECT().GetNetworkByName("capi-net").Return(&models.NetworkReference{NetworkID: ptr.To("capi-net-id")}, nil)
+		ready, err := scope.ReconcileNetwork()
+		g.Expect(err).To(BeNil())
+		g.Expect(ready).To(BeTrue())
+		g.Expect(scope.GetNetworkID()).To(Equal("capi-net-id"))
+		g.Expect(*scope.IBMPowerVSNetwork.Status.ControllerCreated).To(BeFalse())
+	})
+
+	t.Run("Should create the DHCP server and wait for it to be active", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := setupPowerVSNetworkScope(mockpowervs)
+		scope.IBMPowerVSNetwork.Spec.CIDR = nil
+		scope.IBMPowerVSNetwork.Spec.DHCPServer = &infrav1beta2.DHCPServer{}
+		mockpowervs.EXPECT().GetAllDHCPServers().Return(models.DHCPServers{}, nil)
+		mockpowervs.EXPECT().CreateDHCPServer(gomock.Any()).Return(&models.DHCPServer{ID: ptr.To("capi-dhcp-id"), Network: &models.DHCPServerNetwork{ID: ptr.To("capi-net-id")}}, nil)
+		ready, err := scope.ReconcileNetwork()
+		g.Expect(err).To(BeNil())
+		g.Expect(ready).To(BeFalse())
+		g.Expect(scope.GetNetworkID()).To(Equal("capi-net-id"))
+		g.Expect(scope.GetDHCPServerID()).To(Equal("capi-dhcp-id"))
+
+		mockpowervs.EXPECT().GetNetworkByID("capi-net-id").Return(&models.Network{}, nil)
+		mockpowervs.EXPECT().GetDHCPServer("capi-dhcp-id").Return(&models.DHCPServerDetail{Status: ptr.To(string(infrav1beta2.DHCPServerStateActive))}, nil)
+		ready, err = scope.ReconcileNetwork()
+		g.Expect(err).To(BeNil())
+		g.Expect(ready).To(BeTrue())
+	})
+
+	t.Run("Error while creating the network", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := setupPowerVSNetworkScope(mockpowervs)
+		mockpowervs.EXPECT().GetNetworkByName("capi-net").Return(nil, nil)
+		mockpowervs.EXPECT().CreateNetwork(gomock.Any()).Return(nil, errors.New("failed to create network"))
+		_, err := scope.ReconcileNetwork()
+		g.Expect(err).To(Not(BeNil()))
+	})
+}
+
+func TestPowerVSNetworkScope_DeleteNetwork(t *testing.T) {
+	var (
+		mockpowervs *mock.MockPowerVS
+		mockCtrl    *gomock.Controller
+	)
+
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockpowervs = mock.NewMockPowerVS(mockCtrl)
+	}
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+
+	t.Run("Should skip deleting the network which is not created by the controller", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := setupPowerVSNetworkScope(mockpowervs)
+		scope.IBMPowerVSNetwork.Status.NetworkID = "capi-net-id"
+		scope.IBMPowerVSNetwork.Status.ControllerCreated = ptr.To(false)
+		requeue, err := scope.DeleteNetwork()
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeFalse())
+	})
+
+	t.Run("Should skip deleting the network when delete policy is retain", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := setupPowerVSNetworkScope(mockpowervs)
+		scope.IBMPowerVSNetwork.Spec.DeletePolicy = string(infrav1beta2.DeletePolicyRetain)
+		scope.IBMPowerVSNetwork.Status.NetworkID = "capi-net-id"
+		scope.IBMPowerVSNetwork.Status.ControllerCreated = ptr.To(true)
+		requeue, err := scope.DeleteNetwork()
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeFalse())
+	})
+
+	t.Run("Should delete the network and requeue until it is gone", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := setupPowerVSNetworkScope(mockpowervs)
+		scope.IBMPowerVSNetwork.Status.NetworkID = "capi-net-id"
+		scope.IBMPowerVSNetwork.Status.ControllerCreated = ptr.To(true)
+		mockpowervs.EXPECT().GetAllNetwork().Return(&models.Networks{Networks: []*models.NetworkReference{{NetworkID: ptr.To("capi-net-id")}}}, nil)
+		mockpowervs.EXPECT().DeleteNetwork("capi-net-id").Return(nil)
+		requeue, err := scope.DeleteNetwork()
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
+
+		mockpowervs.EXPECT().GetAllNetwork().Return(&models.Networks{}, nil)
+		requeue, err = scope.DeleteNetwork()
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeFalse())
+	})
+
+	t.Run("Should delete the DHCP server of the network", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := setupPowerVSNetworkScope(mockpowervs)
+		scope.IBMPowerVSNetwork.Status.NetworkID = "capi-net-id"
+		scope.IBMPowerVSNetwork.Status.DHCPServerID = "capi-dhcp-id"
+		scope.IBMPowerVSNetwork.Status.ControllerCreated = ptr.To(true)
+		mockpowervs.EXPECT().GetDHCPServer("capi-dhcp-id").Return(&models.DHCPServerDetail{ID: ptr.To("capi-dhcp-id")}, nil)
+		mockpowervs.EXPECT().DeleteDHCPServer("capi-dhcp-id").Return(nil)
+		requeue, err := scope.DeleteNetwork()
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
+
+		mockpowervs.EXPECT().GetDHCPServer("capi-dhcp-id").Return(nil, errors.New(string(DHCPServerNotFound)))
+		requeue, err = scope.DeleteNetwork()
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeFalse())
+	})
+}
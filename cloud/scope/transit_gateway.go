@@ -0,0 +1,510 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scope
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/go-logr/logr"
+
+	tgapiv1 "github.com/IBM/networking-go-sdk/transitgatewayapisv1"
+	"github.com/IBM/platform-services-go-sdk/resourcemanagerv2"
+
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"sigs.k8s.io/cluster-api/util/patch"
+
+	infrav1beta2 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/resourcemanager"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/transitgateway"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/endpoints"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/record"
+)
+
+// TransitGatewayScopeParams defines the input parameters used to create a new TransitGatewayScope.
+type TransitGatewayScopeParams struct {
+	Client            client.Client
+	Logger            logr.Logger
+	IBMTransitGateway *infrav1beta2.IBMTransitGateway
+	ServiceEndpoint   []endpoints.ServiceEndpoint
+}
+
+// TransitGatewayScope defines a scope defined around a transit gateway.
+type TransitGatewayScope struct {
+	logr.Logger
+	Client      client.Client
+	patchHelper *patch.Helper
+
+	TransitGatewayClient  transitgateway.TransitGateway
+	ResourceManagerClient resourcemanager.ResourceManager
+	IBMTransitGateway     *infrav1beta2.IBMTransitGateway
+	ServiceEndpoint       []endpoints.ServiceEndpoint
+}
+
+// NewTransitGatewayScope creates a new TransitGatewayScope from the supplied parameters.
+func NewTransitGatewayScope(params TransitGatewayScopeParams) (scope *TransitGatewayScope, err error) {
+	scope = &TransitGatewayScope{}
+
+	if params.Client == nil {
+		err = errors.New("failed to generate new scope from nil Client")
+		return nil, err
+	}
+	scope.Client = params.Client
+
+	if params.IBMTransitGateway == nil {
+		err = errors.New("failed to generate new scope from nil IBMTransitGateway")
+		return nil, err
+	}
+	scope.IBMTransitGateway = params.IBMTransitGateway
+
+	if params.Logger == (logr.Logger{}) {
+		params.Logger = klog.Background()
+	}
+	scope.Logger = params.Logger
+
+	helper, err := patch.NewHelper(params.IBMTransitGateway, params.Client)
+	if err != nil {
+		err = fmt.Errorf("failed to init patch helper: %w", err)
+		return nil, err
+	}
+	scope.patchHelper = helper
+
+	auth, err := getAuthenticator(params.Client, params.IBMTransitGateway.Namespace, params.IBMTransitGateway.Spec.CredentialsSecretRef, params.IBMTransitGateway.Spec.EnterpriseAccount)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create authenticator: %w", err)
+	}
+
+	// Create Transit Gateway client.
+	tgOptions := &tgapiv1.TransitGatewayApisV1Options{
+		Authenticator: auth,
+	}
+	// Fetch the TransitGateway service endpoint.
+	if tgEndpoint := endpoints.FetchEndpoints(string(endpoints.TransitGateway), params.ServiceEndpoint); tgEndpoint != "" {
+		tgOptions.URL = tgEndpoint
+		params.Logger.V(3).Info("Overriding the default TransitGateway endpoint", "transitGatewayEndpoint", tgEndpoint)
+	}
+	tgClient, err := transitgateway.NewService(tgOptions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create transit gateway client: %w", err)
+	}
+	scope.TransitGatewayClient = tgClient
+
+	// Create Resource Manager client.
+	rmOptions := &resourcemanagerv2.ResourceManagerV2Options{
+		Authenticator: auth,
+	}
+	// Fetch the resource manager endpoint.
+	if rmEndpoint := endpoints.FetchEndpoints(string(endpoints.RM), params.ServiceEndpoint); rmEndpoint != "" {
+		rmOptions.URL = rmEndpoint
+		params.Logger.V(3).Info("Overriding the default resource manager endpoint", "ResourceManagerEndpoint", rmEndpoint)
+	}
+	rmClient, err := resourcemanager.NewService(rmOptions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create resource manager client: %w", err)
+	}
+	scope.ResourceManagerClient = rmClient
+	scope.ServiceEndpoint = params.ServiceEndpoint
+	return scope, nil
+}
+
+// TransitGatewayName returns the name of the transit gateway.
+func (t *TransitGatewayScope) TransitGatewayName() string {
+	if t.IBMTransitGateway.Spec.Name != nil {
+		return *t.IBMTransitGateway.Spec.Name
+	}
+	return t.IBMTransitGateway.Name
+}
+
+// ReconcileTransitGateway reconciles the transit gateway of the IBMTransitGateway, it returns true when the transit gateway
+// and its connections are ready to be used.
+// An existing transit gateway matching the ID or the name is used as is, otherwise the transit gateway is created.
+// The connections listed in IBMTransitGateway.Spec.Connections are created when missing in the transit gateway and the
+// connections created by the controller are deleted once removed from the list.
+func (t *TransitGatewayScope) ReconcileTransitGateway() (bool, error) {
+	transitGateway, err := t.fetchTransitGateway()
+	if err != nil {
+		return false, err
+	}
+	if transitGateway == nil {
+		if err := t.createTransitGateway(); err != nil {
+			return false, err
+		}
+		return false, nil
+	}
+
+	ready, err := t.checkTransitGatewayStatus(transitGateway)
+	if err != nil || !ready {
+		return false, err
+	}
+	return t.reconcileConnections(transitGateway)
+}
+
+// fetchTransitGateway returns the transit gateway of the IBMTransitGateway, nil is returned when the transit gateway does not exist.
+func (t *TransitGatewayScope) fetchTransitGateway() (*tgapiv1.TransitGateway, error) {
+	if t.GetTransitGatewayID() != "" {
+		t.V(3).Info("Transit gateway ID is set, fetching details", "tgID", t.GetTransitGatewayID())
+		transitGateway, _, err := t.TransitGatewayClient.GetTransitGateway(&tgapiv1.GetTransitGatewayOptions{
+			ID: ptr.To(t.GetTransitGatewayID()),
+		})
+		return transitGateway, err
+	}
+
+	if t.IBMTransitGateway.Spec.ID != nil {
+		transitGateway, _, err := t.TransitGatewayClient.GetTransitGateway(&tgapiv1.GetTransitGatewayOptions{
+			ID: t.IBMTransitGateway.Spec.ID,
+		})
+		if err != nil {
+			return nil, err
+		}
+		t.SetTransitGatewayID(transitGateway.ID, false)
+		return transitGateway, nil
+	}
+
+	transitGateway, err := t.TransitGatewayClient.GetTransitGatewayByName(t.TransitGatewayName())
+	if err != nil {
+		return nil, err
+	}
+	if transitGateway == nil || transitGateway.ID == nil {
+		t.V(3).Info("Transit gateway not found in IBM Cloud", "name", t.TransitGatewayName())
+		return nil, nil
+	}
+	t.V(3).Info("Found transit gateway in IBM Cloud", "tgID", *transitGateway.ID)
+	t.SetTransitGatewayID(transitGateway.ID, false)
+	return transitGateway, nil
+}
+
+// createTransitGateway creates the transit gateway and sets the transit gateway status.
+func (t *TransitGatewayScope) createTransitGateway() error {
+	resourceGroupID, err := t.fetchResourceGroupID()
+	if err != nil {
+		return fmt.Errorf("failed to fetch resource group ID: %w", err)
+	}
+
+	name := t.TransitGatewayName()
+	t.V(3).Info("Creating a new transit gateway", "name", name)
+	transitGateway, _, err := t.TransitGatewayClient.CreateTransitGateway(&tgapiv1.CreateTransitGatewayOptions{
+		Location:      ptr.To(t.IBMTransitGateway.Spec.Location),
+		Name:          ptr.To(name),
+		Global:        ptr.To(ptr.Deref(t.IBMTransitGateway.Spec.GlobalRouting, false)),
+		ResourceGroup: &tgapiv1.ResourceGroupIdentity{ID: ptr.To(resourceGroupID)},
+	})
+	if err != nil {
+		record.Warnf(t.IBMTransitGateway, "FailedCreateTransitGateway", "Failed transit gateway creation - %v", err)
+		return fmt.Errorf("failed to create transit gateway: %w", err)
+	}
+	if transitGateway == nil || transitGateway.ID == nil {
+		return fmt.Errorf("created transit gateway is nil")
+	}
+	t.Info("Created transit gateway", "tgID", *transitGateway.ID)
+	record.Eventf(t.IBMTransitGateway, "SuccessfulCreateTransitGateway", "Created transit gateway %q", name)
+	t.SetTransitGatewayID(transitGateway.ID, true)
+	return nil
+}
+
+// fetchResourceGroupID returns the ID of the resource group in which the transit gateway is created.
+func (t *TransitGatewayScope) fetchResourceGroupID() (string, error) {
+	resourceGroup := t.IBMTransitGateway.Spec.ResourceGroup
+	if resourceGroup == nil {
+		return "", fmt.Errorf("resource group is not set")
+	}
+	if resourceGroup.ID != nil {
+		return *resourceGroup.ID, nil
+	}
+	if resourceGroup.Name == nil {
+		return "", fmt.Errorf("resource group name is not set")
+	}
+	rg, err := t.ResourceManagerClient.GetResourceGroupByName(*resourceGroup.Name)
+	if err != nil {
+		return "", err
+	}
+	if rg.ID == nil {
+		return "", fmt.Errorf("could not retrieve resource group ID for %s", *resourceGroup.Name)
+	}
+	return *rg.ID, nil
+}
+
+// checkTransitGatewayStatus checks the state of the transit gateway.
+// If state is available, true is returned.
+// In all other cases, it returns false.
+func (t *TransitGatewayScope) checkTransitGatewayStatus(transitGateway *tgapiv1.TransitGateway) (bool, error) {
+	if transitGateway.Status == nil {
+		return false, nil
+	}
+	switch *transitGateway.Status {
+	case string(infrav1beta2.TransitGatewayStateAvailable):
+		return true, nil
+	case string(infrav1beta2.TransitGatewayStateFailed):
+		return false, fmt.Errorf("failed to create transit gateway, current status: %s", *transitGateway.Status)
+	}
+	t.V(3).Info("Transit gateway is not yet available", "status", *transitGateway.Status)
+	return false, nil
+}
+
+// reconcileConnections creates the connections listed in the spec which are missing in the transit gateway and deletes the connections
+// created by the controller which are removed from the spec, it returns true when all the connections are attached.
+func (t *TransitGatewayScope) reconcileConnections(transitGateway *tgapiv1.TransitGateway) (bool, error) {
+	tgConnections, _, err := t.TransitGatewayClient.ListTransitGatewayConnections(&tgapiv1.ListTransitGatewayConnectionsOptions{
+		TransitGatewayID: transitGateway.ID,
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to list transit gateway connections: %w", err)
+	}
+	connectionsByName := make(map[string]tgapiv1.TransitGatewayConnectionCust)
+	connectionsByID := make(map[string]tgapiv1.TransitGatewayConnectionCust)
+	for _, conn := range tgConnections.Connections {
+		if conn.Name != nil {
+			connectionsByName[*conn.Name] = conn
+		}
+		if conn.ID != nil {
+			connectionsByID[*conn.ID] = conn
+		}
+	}
+
+	ready := true
+	desired := make(map[string]bool, len(t.IBMTransitGateway.Spec.Connections))
+	for _, connection := range t.IBMTransitGateway.Spec.Connections {
+		desired[connection.Name] = true
+		conn, ok := connectionsByName[connection.Name]
+		if !ok {
+			if err := t.createConnection(transitGateway.ID, connection); err != nil {
+				return false, err
+			}
+			ready = false
+			continue
+		}
+		if _, ok := t.IBMTransitGateway.Status.Connections[connection.Name]; !ok {
+			t.SetConnectionStatus(connection.Name, infrav1beta2.ResourceReference{ID: conn.ID, ControllerCreated: ptr.To(false)})
+		}
+		attached, err := t.checkConnectionStatus(conn)
+		if err != nil {
+			return false, err
+		}
+		ready = ready && attached
+	}
+
+	for _, name := range t.connectionStatusNames() {
+		if desired[name] {
+			continue
+		}
+		status := t.IBMTransitGateway.Status.Connections[name]
+		if status.ID != nil && status.ControllerCreated != nil && *status.ControllerCreated {
+			if conn, ok := connectionsByID[*status.ID]; ok {
+				ready = false
+				if conn.Status != nil && *conn.Status == string(infrav1beta2.TransitGatewayConnectionStateDeleting) {
+					t.V(3).Info("Transit gateway connection is in deleting state", "name", name)
+					continue
+				}
+				if err := t.deleteConnection(transitGateway.ID, name, status.ID); err != nil {
+					return false, err
+				}
+				continue
+			}
+		}
+		delete(t.IBMTransitGateway.Status.Connections, name)
+	}
+	return ready, nil
+}
+
+// createConnection creates the transit gateway connection and sets the connection status.
+func (t *TransitGatewayScope) createConnection(transitGatewayID *string, connection infrav1beta2.TransitGatewayConnection) error {
+	t.V(3).Info("Creating transit gateway connection", "tgID", transitGatewayID, "type", connection.NetworkType, "name", connection.Name)
+	conn, _, err := t.TransitGatewayClient.CreateTransitGatewayConnection(&tgapiv1.CreateTransitGatewayConnectionOptions{
+		TransitGatewayID: transitGatewayID,
+		NetworkType:      ptr.To(string(connection.NetworkType)),
+		NetworkID:        ptr.To(connection.NetworkID),
+		Name:             ptr.To(connection.Name),
+	})
+	if err != nil {
+		record.Warnf(t.IBMTransitGateway, "FailedCreateTransitGatewayConnection", "Failed transit gateway connection %q creation - %v", connection.Name, err)
+		return fmt.Errorf("failed to create transit gateway connection %s: %w", connection.Name, err)
+	}
+	record.Eventf(t.IBMTransitGateway, "SuccessfulCreateTransitGatewayConnection", "Created transit gateway connection %q", connection.Name)
+	t.SetConnectionStatus(connection.Name, infrav1beta2.ResourceReference{ID: conn.ID, ControllerCreated: ptr.To(true)})
+	return nil
+}
+
+// deleteConnection deletes the transit gateway connection created by the controller.
+func (t *TransitGatewayScope) deleteConnection(transitGatewayID *string, name string, connectionID *string) error {
+	t.V(3).Info("Deleting transit gateway connection", "tgID", transitGatewayID, "name", name)
+	if _, err := t.TransitGatewayClient.DeleteTransitGatewayConnection(&tgapiv1.DeleteTransitGatewayConnectionOptions{
+		TransitGatewayID: transitGatewayID,
+		ID:               connectionID,
+	}); err != nil {
+		record.Warnf(t.IBMTransitGateway, "FailedDeleteTransitGatewayConnection", "Failed transit gateway connection %q deletion - %v", name, err)
+		return fmt.Errorf("failed to delete transit gateway connection %s: %w", name, err)
+	}
+	record.Eventf(t.IBMTransitGateway, "SuccessfulDeleteTransitGatewayConnection", "Deleted transit gateway connection %q", name)
+	return nil
+}
+
+// checkConnectionStatus checks the state of a transit gateway connection.
+// If state is attached, true is returned.
+// In all other cases, it returns false.
+func (t *TransitGatewayScope) checkConnectionStatus(conn tgapiv1.TransitGatewayConnectionCust) (bool, error) {
+	if conn.Status == nil {
+		return false, nil
+	}
+	switch *conn.Status {
+	case string(infrav1beta2.TransitGatewayConnectionStateAttached):
+		return true, nil
+	case string(infrav1beta2.TransitGatewayConnectionStateFailed):
+		return false, fmt.Errorf("failed to attach connection %s to transit gateway, current status: %s", ptr.Deref(conn.Name, ""), *conn.Status)
+	}
+	t.V(3).Info("Transit gateway connection is not yet attached", "name", ptr.Deref(conn.Name, ""), "status", *conn.Status)
+	return false, nil
+}
+
+// connectionStatusNames returns the sorted names of the connections set in the status.
+func (t *TransitGatewayScope) connectionStatusNames() []string {
+	names := make([]string, 0, len(t.IBMTransitGateway.Status.Connections))
+	for name := range t.IBMTransitGateway.Status.Connections {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// DeleteTransitGateway deletes the transit gateway of the IBMTransitGateway, it returns true when the deletion is in progress and requires requeue.
+// The transit gateway and its connections are preserved when the delete policy is set to retain, otherwise the connections created by
+// the controller are deleted and the transit gateway is deleted only when it is created by the controller.
+func (t *TransitGatewayScope) DeleteTransitGateway() (bool, error) {
+	if t.IBMTransitGateway.Spec.DeletePolicy == string(infrav1beta2.DeletePolicyRetain) {
+		t.Info("Skipping transit gateway deletion as delete policy is set to retain")
+		return false, nil
+	}
+	transitGatewayID := t.GetTransitGatewayID()
+	if transitGatewayID == "" {
+		return false, nil
+	}
+
+	transitGateway, resp, err := t.TransitGatewayClient.GetTransitGateway(&tgapiv1.GetTransitGatewayOptions{
+		ID: ptr.To(transitGatewayID),
+	})
+	if err != nil {
+		if resp != nil && resp.StatusCode == ResourceNotFoundCode {
+			t.Info("Transit gateway successfully deleted")
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to fetch transit gateway: %w", err)
+	}
+	if transitGateway.Status != nil && *transitGateway.Status == string(infrav1beta2.TransitGatewayStateDeletePending) {
+		t.V(3).Info("Transit gateway is being deleted")
+		return true, nil
+	}
+
+	requeue, err := t.deleteConnections(transitGateway.ID)
+	if err != nil || requeue {
+		return requeue, err
+	}
+
+	if t.IBMTransitGateway.Status.ControllerCreated == nil || !*t.IBMTransitGateway.Status.ControllerCreated {
+		t.Info("Skipping transit gateway deletion as resource is not created by controller")
+		return false, nil
+	}
+	if _, err := t.TransitGatewayClient.DeleteTransitGateway(&tgapiv1.DeleteTransitGatewayOptions{
+		ID: ptr.To(transitGatewayID),
+	}); err != nil {
+		record.Warnf(t.IBMTransitGateway, "FailedDeleteTransitGateway", "Failed transit gateway deletion - %v", err)
+		return false, fmt.Errorf("failed to delete transit gateway: %w", err)
+	}
+	record.Eventf(t.IBMTransitGateway, "SuccessfulDeleteTransitGateway", "Deleted transit gateway %q", transitGatewayID)
+	return true, nil
+}
+
+// deleteConnections deletes the connections created by the controller, it returns true until all of them are deleted.
+func (t *TransitGatewayScope) deleteConnections(transitGatewayID *string) (bool, error) {
+	requeue := false
+	for _, name := range t.connectionStatusNames() {
+		status := t.IBMTransitGateway.Status.Connections[name]
+		if status.ID == nil || status.ControllerCreated == nil || !*status.ControllerCreated {
+			continue
+		}
+		conn, resp, err := t.TransitGatewayClient.GetTransitGatewayConnection(&tgapiv1.GetTransitGatewayConnectionOptions{
+			TransitGatewayID: transitGatewayID,
+			ID:               status.ID,
+		})
+		if err != nil {
+			if resp != nil && resp.StatusCode == ResourceNotFoundCode {
+				t.V(3).Info("Connection deleted in transit gateway", "name", name)
+				delete(t.IBMTransitGateway.Status.Connections, name)
+				continue
+			}
+			return false, fmt.Errorf("failed to get transit gateway connection %s: %w", name, err)
+		}
+		requeue = true
+		if conn.Status != nil && *conn.Status == string(infrav1beta2.TransitGatewayConnectionStateDeleting) {
+			t.V(3).Info("Transit gateway connection is in deleting state", "name", name)
+			continue
+		}
+		if err := t.deleteConnection(transitGatewayID, name, status.ID); err != nil {
+			return false, err
+		}
+	}
+	return requeue, nil
+}
+
+// PatchObject persists the transit gateway configuration and status.
+func (t *TransitGatewayScope) PatchObject() error {
+	return t.patchHelper.Patch(context.TODO(), t.IBMTransitGateway)
+}
+
+// Close closes the current scope persisting the transit gateway configuration and status.
+func (t *TransitGatewayScope) Close() error {
+	return t.PatchObject()
+}
+
+// SetReady will set the status as ready for the transit gateway.
+func (t *TransitGatewayScope) SetReady() {
+	t.IBMTransitGateway.Status.Ready = true
+}
+
+// SetNotReady will set the status as not ready for the transit gateway.
+func (t *TransitGatewayScope) SetNotReady() {
+	t.IBMTransitGateway.Status.Ready = false
+}
+
+// IsReady will return the status for the transit gateway.
+func (t *TransitGatewayScope) IsReady() bool {
+	return t.IBMTransitGateway.Status.Ready
+}
+
+// SetTransitGatewayID will set the id for the transit gateway along with whether it is created by the controller.
+func (t *TransitGatewayScope) SetTransitGatewayID(id *string, controllerCreated bool) {
+	if id != nil {
+		t.IBMTransitGateway.Status.TransitGatewayID = *id
+		t.IBMTransitGateway.Status.ControllerCreated = ptr.To(controllerCreated)
+	}
+}
+
+// GetTransitGatewayID will get the id for the transit gateway.
+func (t *TransitGatewayScope) GetTransitGatewayID() string {
+	return t.IBMTransitGateway.Status.TransitGatewayID
+}
+
+// SetConnectionStatus will set the status of the transit gateway connection with the given name.
+func (t *TransitGatewayScope) SetConnectionStatus(name string, resource infrav1beta2.ResourceReference) {
+	if t.IBMTransitGateway.Status.Connections == nil {
+		t.IBMTransitGateway.Status.Connections = make(map[string]infrav1beta2.ResourceReference)
+	}
+	t.IBMTransitGateway.Status.Connections[name] = resource
+}
@@ -0,0 +1,283 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scope
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	tgapiv1 "github.com/IBM/networking-go-sdk/transitgatewayapisv1"
+	"github.com/IBM/platform-services-go-sdk/resourcemanagerv2"
+	"go.uber.org/mock/gomock"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+
+	infrav1beta2 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/v1beta2"
+	rmmock "sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/resourcemanager/mock"
+	tgmock "sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/transitgateway/mock"
+
+	. "github.com/onsi/gomega"
+)
+
+func newIBMTransitGateway() *infrav1beta2.IBMTransitGateway {
+	return &infrav1beta2.IBMTransitGateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "capi-tg",
+			Namespace: "default",
+		},
+		Spec: infrav1beta2.IBMTransitGatewaySpec{
+			Location:      "us-south",
+			GlobalRouting: ptr.To(false),
+			ResourceGroup: &infrav1beta2.IBMPowerVSResourceReference{Name: ptr.To("capi-rg")},
+			DeletePolicy:  string(infrav1beta2.DeletePolicyDelete),
+		},
+	}
+}
+
+func setupTransitGatewayScope(mocktg *tgmock.MockTransitGateway, mockrm *rmmock.MockResourceManager) *TransitGatewayScope {
+	return &TransitGatewayScope{
+		Logger:                klog.Background(),
+		TransitGatewayClient:  mocktg,
+		ResourceManagerClient: mockrm,
+		IBMTransitGateway:     newIBMTransitGateway(),
+	}
+}
+
+func TestNewTransitGatewayScope(t *testing.T) {
+	testCases := []struct {
+		name   string
+		params TransitGatewayScopeParams
+	}{
+		{
+			name: "Error when Client in nil",
+			params: TransitGatewayScopeParams{
+				Client: nil,
+			},
+		},
+	}
+	for _, tc := range testCases {
+		g := NewWithT(t)
+		t.Run(tc.name, func(_ *testing.T) {
+			_, err := NewTransitGatewayScope(tc.params)
+			g.Expect(err).To(Not(BeNil()))
+		})
+	}
+}
+
+func TestTransitGatewayScope_ReconcileTransitGateway(t *testing.T) {
+	var (
+		mocktg   *tgmock.MockTransitGateway
+		mockrm   *rmmock.MockResourceManager
+		mockCtrl *gomock.Controller
+	)
+
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mocktg = tgmock.NewMockTransitGateway(mockCtrl)
+		mockrm = rmmock.NewMockResourceManager(mockCtrl)
+	}
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+
+	t.Run("Should create the transit gateway when it does not exist", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := setupTransitGatewayScope(mocktg, mockrm)
+		mocktg.EXPECT().GetTransitGatewayByName("capi-tg").Return(nil, nil)
+		mockrm.EXPECT().GetResourceGroupByName("capi-rg").Return(&resourcemanagerv2.ResourceGroup{ID: ptr.To("capi-rg-id")}, nil)
+		mocktg.EXPECT().CreateTransitGateway(gomock.Any()).DoAndReturn(func(options *tgapiv1.CreateTransitGatewayOptions) (*tgapiv1.TransitGateway, *core.DetailedResponse, error) {
+			g.Expect(*options.Name).To(Equal("capi-tg"))
+			g.Expect(*options.Location).To(Equal("us-south"))
+			g.Expect(*options.Global).To(BeFalse())
+			g.Expect(*options.ResourceGroup.ID).To(Equal("capi-rg-id"))
+			return &tgapiv1.TransitGateway{ID: ptr.To("capi-tg-id")}, nil, nil
+		})
+		ready, err := scope.ReconcileTransitGateway()
+		g.Expect(err).To(BeNil())
+		g.Expect(ready).To(BeFalse())
+		g.Expect(scope.GetTransitGatewayID()).To(Equal("capi-tg-id"))
+		g.Expect(*scope.IBMTransitGateway.Status.ControllerCreated).To(BeTrue())
+	})
+
+	t.Run("Should use the existing transit gateway with the same name", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := setupTransitGatewayScope(mocktg, mockrm)
+		mocktg.EXPECT().GetTransitGatewayByName("capi-tg").Return(&tgapiv1.TransitGateway{ID: ptr.To("capi-tg-id"), Status: ptr.To(string(infrav1beta2.TransitGatewayStateAvailable))}, nil)
+		mocktg.EXPECT().ListTransitGatewayConnections(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCollection{}, nil, nil)
+		ready, err := scope.ReconcileTransitGateway()
+		g.Expect(err).To(BeNil())
+		g.Expect(ready).To(BeTrue())
+		g.Expect(scope.GetTransitGatewayID()).To(Equal("capi-tg-id"))
+		g.Expect(*scope.IBMTransitGateway.Status.ControllerCreated).To(BeFalse())
+	})
+
+	t.Run("Should wait for the transit gateway to be available", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := setupTransitGatewayScope(mocktg, mockrm)
+		scope.IBMTransitGateway.Status.TransitGatewayID = "capi-tg-id"
+		mocktg.EXPECT().GetTransitGateway(gomock.Any()).Return(&tgapiv1.TransitGateway{ID: ptr.To("capi-tg-id"), Status: ptr.To(string(infrav1beta2.TransitGatewayStatePending))}, nil, nil)
+		ready, err := scope.ReconcileTransitGateway()
+		g.Expect(err).To(BeNil())
+		g.Expect(ready).To(BeFalse())
+	})
+
+	t.Run("Should create the missing connections and wait for them to be attached", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := setupTransitGatewayScope(mocktg, mockrm)
+		scope.IBMTransitGateway.Status.TransitGatewayID = "capi-tg-id"
+		scope.IBMTransitGateway.Spec.Connections = []infrav1beta2.TransitGatewayConnection{
+			{Name: "capi-vpc", NetworkType: infrav1beta2.TransitGatewayConnectionNetworkTypeVPC, NetworkID: "vpc-crn"},
+			{Name: "capi-dl", NetworkType: infrav1beta2.TransitGatewayConnectionNetworkTypeDirectLink, NetworkID: "dl-crn"},
+		}
+		mocktg.EXPECT().GetTransitGateway(gomock.Any()).Return(&tgapiv1.TransitGateway{ID: ptr.To("capi-tg-id"), Status: ptr.To(string(infrav1beta2.TransitGatewayStateAvailable))}, nil, nil)
+		mocktg.EXPECT().ListTransitGatewayConnections(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCollection{
+			Connections: []tgapiv1.TransitGatewayConnectionCust{
+				{ID: ptr.To("vpc-conn-id"), Name: ptr.To("capi-vpc"), Status: ptr.To(string(infrav1beta2.TransitGatewayConnectionStateAttached))},
+			},
+		}, nil, nil)
+		mocktg.EXPECT().CreateTransitGatewayConnection(gomock.Any()).DoAndReturn(func(options *tgapiv1.CreateTransitGatewayConnectionOptions) (*tgapiv1.TransitGatewayConnectionCust, *core.DetailedResponse, error) {
+			g.Expect(*options.Name).To(Equal("capi-dl"))
+			g.Expect(*options.NetworkType).To(Equal("directlink"))
+			g.Expect(*options.NetworkID).To(Equal("dl-crn"))
+			return &tgapiv1.TransitGatewayConnectionCust{ID: ptr.To("dl-conn-id")}, nil, nil
+		})
+		ready, err := scope.ReconcileTransitGateway()
+		g.Expect(err).To(BeNil())
+		g.Expect(ready).To(BeFalse())
+		g.Expect(*scope.IBMTransitGateway.Status.Connections["capi-vpc"].ControllerCreated).To(BeFalse())
+		g.Expect(*scope.IBMTransitGateway.Status.Connections["capi-dl"].ID).To(Equal("dl-conn-id"))
+		g.Expect(*scope.IBMTransitGateway.Status.Connections["capi-dl"].ControllerCreated).To(BeTrue())
+	})
+
+	t.Run("Should delete the connections created by the controller once removed from the spec", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := setupTransitGatewayScope(mocktg, mockrm)
+		scope.IBMTransitGateway.Status.TransitGatewayID = "capi-tg-id"
+		scope.SetConnectionStatus("capi-dl", infrav1beta2.ResourceReference{ID: ptr.To("dl-conn-id"), ControllerCreated: ptr.To(true)})
+		scope.SetConnectionStatus("capi-vpc", infrav1beta2.ResourceReference{ID: ptr.To("vpc-conn-id"), ControllerCreated: ptr.To(false)})
+		mocktg.EXPECT().GetTransitGateway(gomock.Any()).Return(&tgapiv1.TransitGateway{ID: ptr.To("capi-tg-id"), Status: ptr.To(string(infrav1beta2.TransitGatewayStateAvailable))}, nil, nil)
+		mocktg.EXPECT().ListTransitGatewayConnections(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCollection{
+			Connections: []tgapiv1.TransitGatewayConnectionCust{
+				{ID: ptr.To("dl-conn-id"), Name: ptr.To("capi-dl"), Status: ptr.To(string(infrav1beta2.TransitGatewayConnectionStateAttached))},
+				{ID: ptr.To("vpc-conn-id"), Name: ptr.To("capi-vpc"), Status: ptr.To(string(infrav1beta2.TransitGatewayConnectionStateAttached))},
+			},
+		}, nil, nil)
+		mocktg.EXPECT().DeleteTransitGatewayConnection(gomock.Any()).DoAndReturn(func(options *tgapiv1.DeleteTransitGatewayConnectionOptions) (*core.DetailedResponse, error) {
+			g.Expect(*options.ID).To(Equal("dl-conn-id"))
+			return nil, nil
+		})
+		ready, err := scope.ReconcileTransitGateway()
+		g.Expect(err).To(BeNil())
+		g.Expect(ready).To(BeFalse())
+		g.Expect(scope.IBMTransitGateway.Status.Connections).To(HaveKey("capi-dl"))
+		g.Expect(scope.IBMTransitGateway.Status.Connections).ToNot(HaveKey("capi-vpc"))
+	})
+
+	t.Run("Error while creating the transit gateway", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := setupTransitGatewayScope(mocktg, mockrm)
+		scope.IBMTransitGateway.Spec.ResourceGroup = &infrav1beta2.IBMPowerVSResourceReference{ID: ptr.To("capi-rg-id")}
+		mocktg.EXPECT().GetTransitGatewayByName("capi-tg").Return(nil, nil)
+		mocktg.EXPECT().CreateTransitGateway(gomock.Any()).Return(nil, nil, errors.New("failed to create transit gateway"))
+		_, err := scope.ReconcileTransitGateway()
+		g.Expect(err).To(Not(BeNil()))
+	})
+}
+
+func TestTransitGatewayScope_DeleteTransitGateway(t *testing.T) {
+	var (
+		mocktg   *tgmock.MockTransitGateway
+		mockCtrl *gomock.Controller
+	)
+
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mocktg = tgmock.NewMockTransitGateway(mockCtrl)
+	}
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+
+	t.Run("Should skip deleting the transit gateway when delete policy is retain", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := setupTransitGatewayScope(mocktg, nil)
+		scope.IBMTransitGateway.Spec.DeletePolicy = string(infrav1beta2.DeletePolicyRetain)
+		scope.SetTransitGatewayID(ptr.To("capi-tg-id"), true)
+		requeue, err := scope.DeleteTransitGateway()
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeFalse())
+	})
+
+	t.Run("Should only delete the connections of the transit gateway which is not created by the controller", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := setupTransitGatewayScope(mocktg, nil)
+		scope.SetTransitGatewayID(ptr.To("capi-tg-id"), false)
+		scope.SetConnectionStatus("capi-dl", infrav1beta2.ResourceReference{ID: ptr.To("dl-conn-id"), ControllerCreated: ptr.To(true)})
+		mocktg.EXPECT().GetTransitGateway(gomock.Any()).Return(&tgapiv1.TransitGateway{ID: ptr.To("capi-tg-id"), Status: ptr.To(string(infrav1beta2.TransitGatewayStateAvailable))}, nil, nil)
+		mocktg.EXPECT().GetTransitGatewayConnection(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCust{ID: ptr.To("dl-conn-id"), Status: ptr.To(string(infrav1beta2.TransitGatewayConnectionStateAttached))}, nil, nil)
+		mocktg.EXPECT().DeleteTransitGatewayConnection(gomock.Any()).Return(nil, nil)
+		requeue, err := scope.DeleteTransitGateway()
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
+
+		mocktg.EXPECT().GetTransitGateway(gomock.Any()).Return(&tgapiv1.TransitGateway{ID: ptr.To("capi-tg-id"), Status: ptr.To(string(infrav1beta2.TransitGatewayStateAvailable))}, nil, nil)
+		mocktg.EXPECT().GetTransitGatewayConnection(gomock.Any()).Return(nil, &core.DetailedResponse{StatusCode: ResourceNotFoundCode}, errors.New("not found"))
+		requeue, err = scope.DeleteTransitGateway()
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeFalse())
+		g.Expect(scope.IBMTransitGateway.Status.Connections).To(BeEmpty())
+	})
+
+	t.Run("Should delete the transit gateway and requeue until it is gone", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := setupTransitGatewayScope(mocktg, nil)
+		scope.SetTransitGatewayID(ptr.To("capi-tg-id"), true)
+		mocktg.EXPECT().GetTransitGateway(gomock.Any()).Return(&tgapiv1.TransitGateway{ID: ptr.To("capi-tg-id"), Status: ptr.To(string(infrav1beta2.TransitGatewayStateAvailable))}, nil, nil)
+		mocktg.EXPECT().DeleteTransitGateway(gomock.Any()).Return(nil, nil)
+		requeue, err := scope.DeleteTransitGateway()
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
+
+		mocktg.EXPECT().GetTransitGateway(gomock.Any()).Return(nil, &core.DetailedResponse{StatusCode: ResourceNotFoundCode}, errors.New("not found"))
+		requeue, err = scope.DeleteTransitGateway()
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeFalse())
+	})
+}
@@ -18,15 +18,608 @@ package scope
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net"
+	"slices"
 	"strconv"
 	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM-Cloud/power-go-client/ibmpisession"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/dnsrecordsv1"
+	"github.com/IBM/networking-go-sdk/dnssvcsv1"
+	"github.com/IBM/platform-services-go-sdk/enterprisemanagementv1"
+	"github.com/IBM/platform-services-go-sdk/globaltaggingv1"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	capiv1beta1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util"
+	"sigs.k8s.io/cluster-api/util/conditions"
+
 	infrav1beta2 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/authenticator"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/dns"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/enterprisemanagement"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/globaltagging"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/utils"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/vpc"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloudinit"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/endpoints"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/record"
 )
 
+// resolveCredentialsSecretRef returns the credentials secret referenced by the cluster, or the namespace credentials
+// secret set via the namespace-credentials-secret flag when the cluster does not reference a credentials secret.
+func resolveCredentialsSecretRef(credentialsSecretRef *corev1.LocalObjectReference) *corev1.LocalObjectReference {
+	if credentialsSecretRef == nil && authenticator.NamespaceCredentialsSecret != "" {
+		return &corev1.LocalObjectReference{Name: authenticator.NamespaceCredentialsSecret}
+	}
+	return credentialsSecretRef
+}
+
+// getAuthenticator returns the authenticator for the credentials secret referenced by the cluster, or
+// the authenticator configured for the controller when the cluster does not reference a credentials secret.
+// When the cluster is provisioned into a child account of an enterprise, the authenticator assumes the trusted
+// profile of the child account with these credentials.
+func getAuthenticator(c client.Client, namespace string, credentialsSecretRef *corev1.LocalObjectReference, account *infrav1beta2.EnterpriseAccount) (core.Authenticator, error) {
+	auth, err := getCredentialsAuthenticator(c, namespace, credentialsSecretRef)
+	if err != nil || account == nil {
+		return auth, err
+	}
+	accountID, err := getEnterpriseAccountID(auth, account)
+	if err != nil {
+		return nil, err
+	}
+	return authenticator.GetAssumeAuthenticator(auth, account.TrustedProfileName, accountID)
+}
+
+func getCredentialsAuthenticator(c client.Client, namespace string, credentialsSecretRef *corev1.LocalObjectReference) (core.Authenticator, error) {
+	credentialsSecretRef = resolveCredentialsSecretRef(credentialsSecretRef)
+	if credentialsSecretRef == nil {
+		return authenticator.GetAuthenticator()
+	}
+	return authenticator.GetAuthenticatorFromSecret(context.TODO(), c, namespace, credentialsSecretRef.Name)
+}
+
+// getAPIKey returns the API key of the credentials secret referenced by the cluster, or
+// the API key configured for the controller when the cluster does not reference a credentials secret.
+// It is empty for clusters provisioned into a child account of an enterprise, as the API key belongs to another account.
+func getAPIKey(c client.Client, namespace string, credentialsSecretRef *corev1.LocalObjectReference, account *infrav1beta2.EnterpriseAccount) (string, error) {
+	if account != nil {
+		return "", nil
+	}
+	credentialsSecretRef = resolveCredentialsSecretRef(credentialsSecretRef)
+	if credentialsSecretRef != nil {
+		return authenticator.GetAPIKeyFromSecret(context.TODO(), c, namespace, credentialsSecretRef.Name)
+	}
+	props, err := authenticator.GetProperties()
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch service properties: %w", err)
+	}
+	return props["APIKEY"], nil
+}
+
+// getTrustedProfileAuthenticator returns the authenticator when the credentials secret referenced by the cluster,
+// or the credentials configured for the controller, are an IAM trusted profile, or when the cluster is provisioned
+// into a child account of an enterprise, and nil otherwise.
+func getTrustedProfileAuthenticator(c client.Client, namespace string, credentialsSecretRef *corev1.LocalObjectReference, account *infrav1beta2.EnterpriseAccount) (core.Authenticator, error) {
+	credentialsSecretRef = resolveCredentialsSecretRef(credentialsSecretRef)
+	if credentialsSecretRef == nil && account == nil && !authenticator.IsTrustedProfileConfigured() {
+		return nil, nil
+	}
+	auth, err := getAuthenticator(c, namespace, credentialsSecretRef, account)
+	if err != nil {
+		return nil, err
+	}
+	if !authenticator.IsTrustedProfile(auth) {
+		return nil, nil
+	}
+	return auth, nil
+}
+
+// getAccountID returns the ID of the account owning the credentials secret referenced by the cluster, or
+// the ID of the account configured for the controller when the cluster does not reference a credentials secret.
+// It is the ID of the child account for clusters provisioned into a child account of an enterprise.
+func getAccountID(c client.Client, namespace string, credentialsSecretRef *corev1.LocalObjectReference, account *infrav1beta2.EnterpriseAccount) (string, error) {
+	credentialsSecretRef = resolveCredentialsSecretRef(credentialsSecretRef)
+	if credentialsSecretRef == nil && account == nil {
+		return utils.GetAccountIDWrapper()
+	}
+	auth, err := getCredentialsAuthenticator(c, namespace, credentialsSecretRef)
+	if err != nil {
+		return "", err
+	}
+	if account != nil {
+		return getEnterpriseAccountID(auth, account)
+	}
+	return utils.GetAccount(auth)
+}
+
+// enterpriseAccountKey identifies a child account looked up in its account group.
+type enterpriseAccountKey struct {
+	id             string
+	name           string
+	accountGroupID string
+}
+
+// enterpriseAccountIDCacheTTL is the duration the IDs of the child accounts looked up in their account group are cached,
+// so the accounts moved to another account group are eventually refused.
+const enterpriseAccountIDCacheTTL = 30 * time.Minute
+
+// cachedEnterpriseAccountID holds the ID of a child account looked up in its account group until it expires.
+type cachedEnterpriseAccountID struct {
+	id      string
+	expires time.Time
+}
+
+var (
+	// enterpriseAccountIDCache caches the IDs of the child accounts looked up in their account group.
+	enterpriseAccountIDCache     = map[enterpriseAccountKey]cachedEnterpriseAccountID{}
+	enterpriseAccountIDCacheLock sync.Mutex
+)
+
+// getEnterpriseAccountID returns the ID of the child account of the enterprise, which is the account owning the project
+// when the project is set, and is looked up among the accounts of its account group with the Enterprise Management API
+// authenticated with auth when the account group is set.
+func getEnterpriseAccountID(auth core.Authenticator, account *infrav1beta2.EnterpriseAccount) (string, error) {
+	if account.ProjectCRN != nil {
+		projectAccountID, err := getProjectAccountID(*account.ProjectCRN)
+		if err != nil {
+			return "", err
+		}
+		account = &infrav1beta2.EnterpriseAccount{ID: &projectAccountID, AccountGroupID: account.AccountGroupID}
+	}
+	if account.AccountGroupID == nil {
+		if account.ID == nil {
+			return "", errors.New("enterprise account must set id, projectCRN or accountGroupID")
+		}
+		return *account.ID, nil
+	}
+	key := enterpriseAccountKey{id: ptr.Deref(account.ID, ""), name: ptr.Deref(account.Name, ""), accountGroupID: *account.AccountGroupID}
+
+	enterpriseAccountIDCacheLock.Lock()
+	defer enterpriseAccountIDCacheLock.Unlock()
+	now := time.Now()
+	for k, cached := range enterpriseAccountIDCache {
+		if now.After(cached.expires) {
+			delete(enterpriseAccountIDCache, k)
+		}
+	}
+	if cached, ok := enterpriseAccountIDCache[key]; ok {
+		return cached.id, nil
+	}
+
+	emClient, err := enterprisemanagement.NewService(&enterprisemanagementv1.EnterpriseManagementV1Options{Authenticator: auth})
+	if err != nil {
+		return "", fmt.Errorf("failed to create enterprise management client: %w", err)
+	}
+	id, err := lookupEnterpriseAccountID(emClient, account)
+	if err != nil {
+		return "", err
+	}
+	enterpriseAccountIDCache[key] = cachedEnterpriseAccountID{id: id, expires: now.Add(enterpriseAccountIDCacheTTL)}
+	return id, nil
+}
+
+// getProjectAccountID returns the ID of the account owning the IBM Cloud project with the given CRN.
+func getProjectAccountID(projectCRN string) (string, error) {
+	crn, err := ParseCRN(projectCRN)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse project CRN %s: %w", projectCRN, err)
+	}
+	if crn == nil || crn.ServiceName != "project" || crn.ScopeType != "a" || crn.Scope == "" {
+		return "", fmt.Errorf("CRN %s is not the CRN of a project owned by an account", projectCRN)
+	}
+	return crn.Scope, nil
+}
+
+// lookupEnterpriseAccountID returns the ID of the child account matching the ID or the name of the account
+// among the direct children of its account group.
+func lookupEnterpriseAccountID(emClient enterprisemanagement.EnterpriseManagement, account *infrav1beta2.EnterpriseAccount) (string, error) {
+	accounts, err := emClient.ListAccountGroupAccounts(*account.AccountGroupID)
+	if err != nil {
+		return "", err
+	}
+	for _, child := range accounts {
+		if child.ID == nil {
+			continue
+		}
+		if account.ID != nil && *child.ID == *account.ID {
+			return *child.ID, nil
+		}
+		if account.ID == nil && account.Name != nil && ptr.Deref(child.Name, "") == *account.Name {
+			return *child.ID, nil
+		}
+	}
+	if account.ID != nil {
+		return "", fmt.Errorf("account %s is not a child of account group %s", *account.ID, *account.AccountGroupID)
+	}
+	return "", fmt.Errorf("account %s not found in account group %s", ptr.Deref(account.Name, ""), *account.AccountGroupID)
+}
+
+// applyPowerVSPrivateCloud points the Power VS options to the Power VS private cloud of the cluster, which is authenticated
+// with the credentials secret referenced by the cluster, against the IAM endpoint of the private cloud when set.
+// The credentials of the namespace or of the controller are never used, as they would be sent to the user-provided endpoints.
+// The options are left untouched for clusters provisioned in the public Power VS.
+func applyPowerVSPrivateCloud(c client.Client, namespace string, credentialsSecretRef *corev1.LocalObjectReference, privateCloud *infrav1beta2.PowerVSPrivateCloud, options *ibmpisession.IBMPIOptions) error {
+	if privateCloud == nil {
+		return nil
+	}
+	if credentialsSecretRef == nil || credentialsSecretRef.Name == "" {
+		return fmt.Errorf("credentialsSecretRef is required to authenticate against the Power VS private cloud %s", privateCloud.Endpoint)
+	}
+	options.URL = privateCloud.Endpoint
+	if privateCloud.AccountID != "" {
+		options.UserAccount = privateCloud.AccountID
+	}
+	if privateCloud.IAMEndpoint == "" {
+		auth, err := authenticator.GetAuthenticatorFromSecret(context.TODO(), c, namespace, credentialsSecretRef.Name)
+		if err != nil {
+			return fmt.Errorf("failed to create authenticator for the Power VS private cloud: %w", err)
+		}
+		options.Authenticator = auth
+		return nil
+	}
+
+	apiKey, err := authenticator.GetAPIKeyFromSecret(context.TODO(), c, namespace, credentialsSecretRef.Name)
+	if err != nil {
+		return err
+	}
+	if apiKey == "" {
+		return fmt.Errorf("API key is required to authenticate against the IAM endpoint %s of the Power VS private cloud", privateCloud.IAMEndpoint)
+	}
+	auth, err := core.NewIamAuthenticatorBuilder().
+		SetApiKey(apiKey).
+		SetURL(privateCloud.IAMEndpoint).
+		Build()
+	if err != nil {
+		return fmt.Errorf("failed to create authenticator for the Power VS private cloud: %w", err)
+	}
+	options.Authenticator = auth
+	return nil
+}
+
+// privateCloudServiceInstanceID returns the ID of the workspace of a cluster provisioned in a Power VS private cloud,
+// the workspace can't be looked up by name as the workspaces of private clouds are not listed in IBM Cloud.
+func privateCloudServiceInstanceID(cluster *infrav1beta2.IBMPowerVSCluster) (string, error) {
+	if cluster.Spec.ServiceInstanceID != "" {
+		return cluster.Spec.ServiceInstanceID, nil
+	}
+	if cluster.Spec.ServiceInstance != nil && cluster.Spec.ServiceInstance.ID != nil {
+		return *cluster.Spec.ServiceInstance.ID, nil
+	}
+	return "", fmt.Errorf("serviceInstanceID or serviceInstance.id of IBMPowerVSCluster %s/%s must be set for the Power VS private cloud", cluster.Namespace, cluster.Name)
+}
+
+// appendMachineAction appends an action performed against the instance of a machine to its action history,
+// only the last MachineActionHistoryLimit actions are kept.
+func appendMachineAction(history []infrav1beta2.MachineAction, actionType infrav1beta2.MachineActionType, instanceID, message string, err error) []infrav1beta2.MachineAction {
+	action := infrav1beta2.MachineAction{
+		Type:       actionType,
+		Time:       metav1.Now(),
+		InstanceID: instanceID,
+		Succeeded:  err == nil,
+		Message:    message,
+	}
+	if err != nil {
+		action.Message = fmt.Sprintf("%s: %v", message, err)
+	}
+
+	history = append(history, action)
+	if len(history) > infrav1beta2.MachineActionHistoryLimit {
+		history = history[len(history)-infrav1beta2.MachineActionHistoryLimit:]
+	}
+	return history
+}
+
+// isReplacedOnDeletion returns true when the Machine gets replaced by its MachineSet or MachinePool once deleted.
+// Control plane Machines are left to the remediation of the control plane provider.
+func isReplacedOnDeletion(machine *capiv1beta1.Machine) bool {
+	if util.IsControlPlaneMachine(machine) {
+		return false
+	}
+	for _, ref := range machine.OwnerReferences {
+		if (ref.Kind == "MachineSet" || ref.Kind == "MachinePool") && strings.HasPrefix(ref.APIVersion, capiv1beta1.GroupVersion.Group+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// shouldAutoRepair returns true when the Machine uses the Replace auto-repair policy and gets replaced once deleted.
+func shouldAutoRepair(machine *capiv1beta1.Machine, policy infrav1beta2.MachineAutoRepairPolicy) bool {
+	return policy == infrav1beta2.MachineAutoRepairPolicyReplace && isReplacedOnDeletion(machine)
+}
+
+// remediateMachine deletes the Machine, so it gets replaced by its MachineSet or MachinePool, and records the deletion
+// with the reason in the action history. Machines which are not replaced once deleted, or already being deleted, are left untouched.
+func remediateMachine(c client.Client, machine *capiv1beta1.Machine, history *[]infrav1beta2.MachineAction, instanceID, reason string) error {
+	if !isReplacedOnDeletion(machine) || !machine.DeletionTimestamp.IsZero() {
+		return nil
+	}
+	if err := c.Delete(context.TODO(), machine); err != nil && !apierrors.IsNotFound(err) {
+		*history = appendMachineAction(*history, infrav1beta2.MachineActionRemediate, instanceID, fmt.Sprintf("failed to delete machine %s, %s", machine.Name, reason), err)
+		return fmt.Errorf("error deleting machine %s/%s: %w", machine.Namespace, machine.Name, err)
+	}
+	*history = appendMachineAction(*history, infrav1beta2.MachineActionRemediate, instanceID, fmt.Sprintf("deleted machine %s, %s", machine.Name, reason), nil)
+	return nil
+}
+
+// volumeDetachTimeout is the time to wait for the data volumes to detach from the instance of a machine before it gets deleted.
+const volumeDetachTimeout = 5 * time.Minute
+
+// shutdownTimeout returns the time to wait for the instance to shut down, zero when it is not shut down before it gets deleted.
+func shutdownTimeout(timeout *metav1.Duration) time.Duration {
+	if timeout == nil || timeout.Duration < 0 {
+		return 0
+	}
+	return timeout.Duration
+}
+
+// isInstanceShutdownDone returns true when the instance of the machine is shut down or is not shut down any longer,
+// because it timed out or can't be shut down.
+func isInstanceShutdownDone(machine conditions.Getter) bool {
+	condition := conditions.Get(machine, infrav1beta2.InstanceShutdownCondition)
+	return condition != nil && condition.Reason != infrav1beta2.InstanceShuttingDownReason
+}
+
+// reconcileInstanceShutdown requests the graceful shutdown of the instance of the machine with stop and returns true
+// until the instance is shut down or the timeout elapsed, the shutdown is requested once.
+func reconcileInstanceShutdown(machine conditions.Setter, timeout time.Duration, stop func() error) (bool, error) {
+	condition := conditions.Get(machine, infrav1beta2.InstanceShutdownCondition)
+	if condition == nil || condition.Reason != infrav1beta2.InstanceShuttingDownReason {
+		if err := stop(); err != nil {
+			record.Warnf(machine, "FailedShutdownInstance", "Failed instance shutdown - %v", err)
+			return false, err
+		}
+		record.Eventf(machine, "ShutdownInstance", "Shutting down instance before deletion")
+		conditions.MarkFalse(machine, infrav1beta2.InstanceShutdownCondition, infrav1beta2.InstanceShuttingDownReason, capiv1beta1.ConditionSeverityInfo, "waiting up to %s for the instance to shut down", timeout)
+		return true, nil
+	}
+	if time.Since(condition.LastTransitionTime.Time) < timeout {
+		return true, nil
+	}
+	record.Warnf(machine, "InstanceShutdownTimeout", "Instance did not shut down within %s, deleting it anyway", timeout)
+	conditions.MarkFalse(machine, infrav1beta2.InstanceShutdownCondition, infrav1beta2.InstanceShutdownTimeoutReason, capiv1beta1.ConditionSeverityWarning, "instance did not shut down within %s", timeout)
+	return false, nil
+}
+
+// isVolumeDetachDone returns true when the data volumes are detached from the instance of the machine or the detachment timed out.
+func isVolumeDetachDone(machine conditions.Getter) bool {
+	condition := conditions.Get(machine, infrav1beta2.VolumesDetachedCondition)
+	return condition != nil && condition.Reason != infrav1beta2.DetachingVolumesReason
+}
+
+// markVolumesDetaching records the data volumes still attached to the instance of the machine and returns true
+// until the volume detach timeout elapsed.
+func markVolumesDetaching(machine conditions.Setter, attached int) bool {
+	condition := conditions.Get(machine, infrav1beta2.VolumesDetachedCondition)
+	if condition != nil && condition.Reason == infrav1beta2.DetachingVolumesReason && time.Since(condition.LastTransitionTime.Time) >= volumeDetachTimeout {
+		record.Warnf(machine, "VolumeDetachTimeout", "%d data volumes did not detach within %s, deleting instance anyway", attached, volumeDetachTimeout)
+		conditions.MarkFalse(machine, infrav1beta2.VolumesDetachedCondition, infrav1beta2.VolumeDetachTimeoutReason, capiv1beta1.ConditionSeverityWarning, "%d data volumes did not detach within %s", attached, volumeDetachTimeout)
+		return false
+	}
+	if condition == nil || condition.Reason != infrav1beta2.DetachingVolumesReason {
+		conditions.MarkFalse(machine, infrav1beta2.VolumesDetachedCondition, infrav1beta2.DetachingVolumesReason, capiv1beta1.ConditionSeverityInfo, "waiting for the data volumes to detach from the instance")
+	}
+	return true
+}
+
+// injectBoothooks wraps the cloud-config user data along with the boothooks, and with the bootstrap sentinel when the result of
+// the bootstrap is reported. The user data is returned unchanged along with false when there is nothing to inject.
+func injectBoothooks(userData []byte, reportBootstrapResult bool, boothooks ...string) ([]byte, bool) {
+	if reportBootstrapResult {
+		return cloudinit.InjectBootstrapSentinel(userData, boothooks...)
+	}
+	return cloudinit.InjectBoothooks(userData, boothooks...)
+}
+
+// bootstrapTimeout is the duration after which the machine of a running instance is expected to have joined the cluster.
+const bootstrapTimeout = 30 * time.Minute
+
+// reconcileBootstrapSucceeded sets the BootstrapSucceeded condition of the infrastructure machine, which is true once the Machine
+// joined the cluster and turns false once the instance is running for longer than bootstrapTimeout without the Machine having joined.
+func reconcileBootstrapSucceeded(ibmMachine conditions.Setter, machine *capiv1beta1.Machine) {
+	if machine.Status.NodeRef != nil {
+		conditions.MarkTrue(ibmMachine, infrav1beta2.BootstrapSucceededCondition)
+		return
+	}
+	if !conditions.IsTrue(ibmMachine, infrav1beta2.InstanceReadyCondition) || time.Since(conditions.GetLastTransitionTime(ibmMachine, infrav1beta2.InstanceReadyCondition).Time) < bootstrapTimeout {
+		conditions.MarkFalse(ibmMachine, infrav1beta2.BootstrapSucceededCondition, infrav1beta2.WaitingForNodeJoinReason, capiv1beta1.ConditionSeverityInfo, "")
+		return
+	}
+	if conditions.GetReason(ibmMachine, infrav1beta2.BootstrapSucceededCondition) != infrav1beta2.NodeNotJoinedReason {
+		record.Warnf(ibmMachine, "NodeNotJoined", "Machine did not join the cluster within %s of its instance running", bootstrapTimeout)
+	}
+	conditions.MarkFalse(ibmMachine, infrav1beta2.BootstrapSucceededCondition, infrav1beta2.NodeNotJoinedReason, capiv1beta1.ConditionSeverityWarning, "instance is running for more than %s without the machine joining the cluster", bootstrapTimeout)
+}
+
+// attachTag attaches the user Tag to the resource, the Tag is created when it doesn't exist.
+func attachTag(c globaltagging.GlobalTagging, tagName, resourceCRN string) error {
+	tag, err := c.GetTagByName(tagName)
+	if err != nil {
+		return fmt.Errorf("failed checking for tag: %w", err)
+	}
+	if tag == nil {
+		createOptions := &globaltaggingv1.CreateTagOptions{}
+		createOptions.SetTagNames([]string{tagName})
+		if _, _, err := c.CreateTag(createOptions); err != nil {
+			return fmt.Errorf("failure creating tag: %w", err)
+		}
+	}
+
+	tagOptions := &globaltaggingv1.AttachTagOptions{}
+	tagOptions.SetResources([]globaltaggingv1.Resource{
+		{
+			ResourceID: ptr.To(resourceCRN),
+		},
+	})
+	tagOptions.SetTagName(tagName)
+	tagOptions.SetTagType(globaltaggingv1.AttachTagOptionsTagTypeUserConst)
+	if _, _, err := c.AttachTag(tagOptions); err != nil {
+		return fmt.Errorf("failure tagging resource: %w", err)
+	}
+	return nil
+}
+
+// reconcileTags attaches the desired user Tags missing from the resource and detaches the attached Tags selected by globaltagging.DiffTags.
+func reconcileTags(c globaltagging.GlobalTagging, resourceCRN string, desired []string, prune bool) error {
+	attached, err := c.GetAttachedTags(resourceCRN)
+	if err != nil {
+		return err
+	}
+	toAttach, toDetach := globaltagging.DiffTags(attached, desired, prune)
+	for _, tag := range toAttach {
+		if err := attachTag(c, tag, resourceCRN); err != nil {
+			return err
+		}
+	}
+	if len(toDetach) == 0 {
+		return nil
+	}
+	detachOptions := &globaltaggingv1.DetachTagOptions{}
+	detachOptions.SetResources([]globaltaggingv1.Resource{
+		{
+			ResourceID: ptr.To(resourceCRN),
+		},
+	})
+	detachOptions.SetTagNames(toDetach)
+	detachOptions.SetTagType(globaltaggingv1.DetachTagOptionsTagTypeUserConst)
+	if _, _, err := c.DetachTag(detachOptions); err != nil {
+		return fmt.Errorf("failure detaching tags %s from resource: %w", strings.Join(toDetach, ", "), err)
+	}
+	return nil
+}
+
+// defaultDNSRecordTTL is the time to live in seconds of the DNS record of the control plane endpoint when not set.
+const defaultDNSRecordTTL int64 = 300
+
+// ErrDNSRecordConflict is returned when a record of the hostname exists in the zone, not created for the cluster and not adopted with DNSSpec.AdoptExistingRecord.
+var ErrDNSRecordConflict = errors.New("a record of the hostname not created for the cluster exists in the zone")
+
+// newDNSClient returns the client managing the records of the zone of spec, hosted in DNS Services or CIS.
+func newDNSClient(spec *infrav1beta2.DNSSpec, auth core.Authenticator, serviceEndpoint []endpoints.ServiceEndpoint) (dns.DNS, error) {
+	switch spec.Provider {
+	case infrav1beta2.DNSProviderDNSServices:
+		options := &dnssvcsv1.DnsSvcsV1Options{
+			Authenticator: auth,
+		}
+		if url := endpoints.FetchEndpoints(string(endpoints.DNSServices), serviceEndpoint); url != "" {
+			options.URL = url
+		}
+		return dns.NewDNSServicesService(options, spec.InstanceID, spec.ZoneID)
+	case infrav1beta2.DNSProviderCIS:
+		options := &dnsrecordsv1.DnsRecordsV1Options{
+			Authenticator:  auth,
+			Crn:            ptr.To(spec.InstanceID),
+			ZoneIdentifier: ptr.To(spec.ZoneID),
+		}
+		if url := endpoints.FetchEndpoints(string(endpoints.CIS), serviceEndpoint); url != "" {
+			options.URL = url
+		}
+		return dns.NewCISService(options)
+	}
+	return nil, fmt.Errorf("unsupported DNS provider %s", spec.Provider)
+}
+
+// matchesResourceSelector reports whether the resource with resourceCRN has all the tags of selector attached, user tags
+// being case insensitive. Any resource matches a nil selector.
+func matchesResourceSelector(c globaltagging.GlobalTagging, selector *infrav1beta2.ResourceSelector, resourceCRN *string) (bool, error) {
+	if selector == nil {
+		return true, nil
+	}
+	if resourceCRN == nil {
+		return false, errors.New("crn is not available")
+	}
+	attachedTags, err := c.GetAttachedTags(*resourceCRN)
+	if err != nil {
+		return false, err
+	}
+	for _, tag := range selector.Tags {
+		if !slices.ContainsFunc(attachedTags, func(attachedTag string) bool { return strings.EqualFold(tag, attachedTag) }) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// reconcileDNSRecord makes the record of spec resolve to target, the hostname or the IP address of the load balancer,
+// and returns the reference to the record to set in the status of the cluster.
+// The record is looked up with the ID of status and then by name, it is updated in place or recreated when its type changes.
+// A record found by name is taken over only when spec allows its adoption, otherwise ErrDNSRecordConflict is returned.
+func reconcileDNSRecord(c dns.DNS, spec *infrav1beta2.DNSSpec, status *infrav1beta2.ResourceReference, target string) (*infrav1beta2.ResourceReference, error) {
+	desired := &dns.Record{
+		Name:    spec.Hostname,
+		Type:    dns.RecordTypeCNAME,
+		Content: target,
+		TTL:     ptr.Deref(spec.TTL, defaultDNSRecordTTL),
+	}
+	if net.ParseIP(target) != nil {
+		desired.Type = dns.RecordTypeA
+	}
+
+	var record *dns.Record
+	var err error
+	controllerCreated := false
+	if status != nil && status.ID != nil {
+		if record, err = c.GetRecord(*status.ID); err != nil {
+			return nil, err
+		}
+		controllerCreated = ptr.Deref(status.ControllerCreated, false)
+	}
+	if record == nil {
+		if record, err = c.GetRecordByName(spec.Hostname); err != nil {
+			return nil, err
+		}
+		if record != nil && !spec.AdoptExistingRecord {
+			return nil, fmt.Errorf("%w: record %s of type %s", ErrDNSRecordConflict, record.ID, record.Type)
+		}
+		controllerCreated = false
+	}
+
+	if record != nil && record.Type != desired.Type {
+		if err := c.DeleteRecord(record.ID); err != nil {
+			return nil, err
+		}
+		record = nil
+	}
+	switch {
+	case record == nil:
+		if record, err = c.CreateRecord(desired); err != nil {
+			return nil, err
+		}
+		controllerCreated = true
+	case !dns.SameName(record.Name, desired.Name) || !dns.SameName(record.Content, desired.Content) || record.TTL != desired.TTL:
+		desired.ID = record.ID
+		if record, err = c.UpdateRecord(desired); err != nil {
+			return nil, err
+		}
+	}
+	return &infrav1beta2.ResourceReference{
+		ID:                ptr.To(record.ID),
+		ControllerCreated: ptr.To(controllerCreated),
+	}, nil
+}
+
+// deleteDNSRecord deletes the record referenced by status when it is created by the controller.
+func deleteDNSRecord(c dns.DNS, status *infrav1beta2.ResourceReference) error {
+	if status == nil || status.ID == nil || !ptr.Deref(status.ControllerCreated, false) {
+		return nil
+	}
+	return c.DeleteRecord(*status.ID)
+}
+
 // GetClusterByName finds and return a Cluster object using the specified params.
 func GetClusterByName(ctx context.Context, c client.Client, namespace, name string) (*infrav1beta2.IBMPowerVSCluster, error) {
 	cluster := &infrav1beta2.IBMPowerVSCluster{}
@@ -59,6 +652,37 @@ func CheckCreateInfraAnnotation(cluster infrav1beta2.IBMPowerVSCluster) bool {
 	return createInfra
 }
 
+// CheckManageDHCPNetwork checks if the DHCP network of IBMPowerVSCluster should be managed by the controller
+// when the create-infra annotation is not set, the field is ignored when the network is referenced via NetworkRef.
+func CheckManageDHCPNetwork(cluster infrav1beta2.IBMPowerVSCluster) bool {
+	if CheckCreateInfraAnnotation(cluster) || cluster.Spec.NetworkRef != nil {
+		return false
+	}
+	return cluster.Spec.ManageDHCPNetwork != nil && *cluster.Spec.ManageDHCPNetwork
+}
+
+// CheckManageLoadBalancer checks if the VPC load balancers of IBMPowerVSCluster should be managed by the controller
+// when the create-infra annotation is not set.
+func CheckManageLoadBalancer(cluster infrav1beta2.IBMPowerVSCluster) bool {
+	if CheckCreateInfraAnnotation(cluster) {
+		return false
+	}
+	return cluster.Spec.ManageLoadBalancer != nil && *cluster.Spec.ManageLoadBalancer
+}
+
+// CheckManageTransitGateway checks if the transit gateway of IBMPowerVSCluster should be managed by the controller
+// when the create-infra annotation is not set, the connections of the cluster are always managed when the transit gateway
+// is referenced via TransitGatewayRef.
+func CheckManageTransitGateway(cluster infrav1beta2.IBMPowerVSCluster) bool {
+	if CheckCreateInfraAnnotation(cluster) {
+		return false
+	}
+	if cluster.Spec.TransitGatewayRef != nil {
+		return true
+	}
+	return cluster.Spec.ManageTransitGateway != nil && *cluster.Spec.ManageTransitGateway
+}
+
 // CRN is a local duplicate of IBM Cloud CRN for parsing and references.
 type CRN struct {
 	Scheme          string
@@ -113,3 +737,174 @@ func ParseCRN(s string) (*CRN, error) {
 
 	return crn, nil
 }
+
+// dataVolumeName returns the name of the data volume at index i of a machine, volumeName defaults to data-<index>.
+// The name is prefixed with the names of the cluster and of the machine to keep the volumes of the machines created
+// from the same template, and of the clusters sharing a workspace or a VPC, unique.
+func dataVolumeName(clusterName, machineName string, i int, volumeName string) string {
+	if volumeName == "" {
+		volumeName = fmt.Sprintf("data-%d", i)
+	}
+	if clusterName != "" && !strings.HasPrefix(machineName, clusterName+"-") {
+		machineName = fmt.Sprintf("%s-%s", clusterName, machineName)
+	}
+	return fmt.Sprintf("%s-%s", machineName, volumeName)
+}
+
+// instanceBudget holds the resources of instances counted against the guardrails of a cluster.
+type instanceBudget struct {
+	instances int32
+	cores     float64
+	memoryGiB float64
+}
+
+// isPendingBefore returns true when the instance of the machine is yet to be created and the machine precedes the other
+// machine, the machines are ordered by their creation time and then by their name. The machines being deleted don't
+// create their instance.
+func isPendingBefore(machine, other client.Object) bool {
+	if !machine.GetDeletionTimestamp().IsZero() {
+		return false
+	}
+	created, otherCreated := machine.GetCreationTimestamp(), other.GetCreationTimestamp()
+	if !created.Equal(&otherCreated) {
+		return created.Before(&otherCreated)
+	}
+	return machine.GetName() < other.GetName()
+}
+
+// coresValue returns the number of cores held by value, either an integer or a string holding a floating-point number.
+func coresValue(value intstr.IntOrString) (float64, error) {
+	if value.Type == intstr.String {
+		cores, err := strconv.ParseFloat(value.StrVal, 64)
+		if err != nil {
+			return 0, fmt.Errorf("failed to convert %s to float64", value.StrVal)
+		}
+		return cores, nil
+	}
+	return float64(value.IntVal), nil
+}
+
+// exceededGuardrails returns the message describing the guardrails exceeded by adding the instance to the instances
+// already counted in used, or an empty string when the instance fits within the guardrails.
+func exceededGuardrails(guardrails *infrav1beta2.ClusterGuardrails, used, instance instanceBudget) (string, error) {
+	if guardrails == nil {
+		return "", nil
+	}
+	var exceeded []string
+	if guardrails.MaxInstances != nil {
+		if total := used.instances + instance.instances; total > *guardrails.MaxInstances {
+			exceeded = append(exceeded, fmt.Sprintf("%d instances exceed maxInstances %d", total, *guardrails.MaxInstances))
+		}
+	}
+	if guardrails.MaxTotalCores != nil {
+		maxCores, err := coresValue(*guardrails.MaxTotalCores)
+		if err != nil {
+			return "", fmt.Errorf("invalid guardrails maxTotalCores: %w", err)
+		}
+		if total := used.cores + instance.cores; total > maxCores {
+			exceeded = append(exceeded, fmt.Sprintf("%s cores exceed maxTotalCores %s", strconv.FormatFloat(total, 'f', -1, 64), guardrails.MaxTotalCores.String()))
+		}
+	}
+	if guardrails.MaxTotalMemoryGiB != nil {
+		if total := used.memoryGiB + instance.memoryGiB; total > float64(*guardrails.MaxTotalMemoryGiB) {
+			exceeded = append(exceeded, fmt.Sprintf("%s GiB of memory exceed maxTotalMemoryGiB %d", strconv.FormatFloat(total, 'f', -1, 64), *guardrails.MaxTotalMemoryGiB))
+		}
+	}
+	return strings.Join(exceeded, ", "), nil
+}
+
+// registeredPoolMembers tracks the addresses of the load balancer pool members registered by the controller, as recorded
+// in loadBalancerPoolMembers of the IBMVPCCluster status, along with the addresses observed in the pools.
+type registeredPoolMembers struct {
+	registered map[string]bool
+	observed   map[string]bool
+}
+
+func newRegisteredPoolMembers(addresses []string) *registeredPoolMembers {
+	r := &registeredPoolMembers{registered: map[string]bool{}, observed: map[string]bool{}}
+	for _, address := range addresses {
+		r.registered[address] = true
+	}
+	return r
+}
+
+// has returns true when the address is registered by the controller.
+func (r *registeredPoolMembers) has(address string) bool {
+	return r.registered[address]
+}
+
+// add registers the address.
+func (r *registeredPoolMembers) add(address string) {
+	r.registered[address] = true
+	r.observed[address] = true
+}
+
+// observe records the address as found in a pool.
+func (r *registeredPoolMembers) observe(address string) {
+	r.observed[address] = true
+}
+
+// prune drops the registered addresses which were not observed, once all the pools are observed.
+func (r *registeredPoolMembers) prune() {
+	for address := range r.registered {
+		if !r.observed[address] {
+			delete(r.registered, address)
+		}
+	}
+}
+
+// list returns the sorted registered addresses.
+func (r *registeredPoolMembers) list() []string {
+	if len(r.registered) == 0 {
+		return nil
+	}
+	addresses := make([]string, 0, len(r.registered))
+	for address := range r.registered {
+		addresses = append(addresses, address)
+	}
+	slices.Sort(addresses)
+	return addresses
+}
+
+// checkLoadBalancerMembersHealth checks the pools of the load balancers, keyed by their name, have members and all
+// of them are healthy.
+func checkLoadBalancerMembersHealth(c vpc.Vpc, loadBalancerIDs map[string]string) error {
+	names := make([]string, 0, len(loadBalancerIDs))
+	for name := range loadBalancerIDs {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	var unhealthy []string
+	for _, name := range names {
+		loadBalancer, _, err := c.GetLoadBalancer(&vpcv1.GetLoadBalancerOptions{ID: ptr.To(loadBalancerIDs[name])})
+		if err != nil {
+			return fmt.Errorf("failed to get load balancer %s: %w", name, err)
+		}
+		for _, pool := range loadBalancer.Pools {
+			members, _, err := c.ListLoadBalancerPoolMembers(&vpcv1.ListLoadBalancerPoolMembersOptions{
+				LoadBalancerID: loadBalancer.ID,
+				PoolID:         pool.ID,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to list members of pool %s of load balancer %s: %w", ptr.Deref(pool.Name, ""), name, err)
+			}
+			if len(members.Members) == 0 {
+				unhealthy = append(unhealthy, fmt.Sprintf("pool %s of load balancer %s has no members", ptr.Deref(pool.Name, ""), name))
+				continue
+			}
+			for _, member := range members.Members {
+				if health := ptr.Deref(member.Health, ""); health != vpcv1.LoadBalancerPoolMemberHealthOkConst {
+					address := ptr.Deref(member.ID, "")
+					if target, ok := member.Target.(*vpcv1.LoadBalancerPoolMemberTarget); ok && target.Address != nil {
+						address = *target.Address
+					}
+					unhealthy = append(unhealthy, fmt.Sprintf("member %s of pool %s of load balancer %s is %s", address, ptr.Deref(pool.Name, ""), name, health))
+				}
+			}
+		}
+	}
+	if len(unhealthy) != 0 {
+		return errors.New(strings.Join(unhealthy, ", "))
+	}
+	return nil
+}
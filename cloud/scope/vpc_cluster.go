@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"net/http"
 	"reflect"
+	"sort"
 
 	"github.com/go-logr/logr"
 
@@ -31,6 +32,7 @@ import (
 	"github.com/IBM/platform-services-go-sdk/resourcemanagerv2"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/klog/v2/textlogger"
 	"k8s.io/utils/ptr"
 
@@ -40,13 +42,15 @@ import (
 	"sigs.k8s.io/cluster-api/util/patch"
 
 	infrav1beta2 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/v1beta2"
-	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/authenticator"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/cos"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/dns"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/globaltagging"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/resourcecontroller"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/resourcemanager"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/vpc"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/endpoints"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/names"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/record"
 )
 
 const (
@@ -84,6 +88,7 @@ type VPCClusterScope struct {
 	ResourceControllerClient resourcecontroller.ResourceController
 	ResourceManagerClient    resourcemanager.ResourceManager
 	VPCClient                vpc.Vpc
+	DNSClient                dns.DNS
 
 	Cluster         *capiv1beta1.Cluster
 	IBMVPCCluster   *infrav1beta2.IBMVPCCluster
@@ -113,8 +118,13 @@ func NewVPCClusterScope(params VPCClusterScopeParams) (*VPCClusterScope, error)
 		return nil, fmt.Errorf("error failed to init patch helper: %w", err)
 	}
 
+	auth, err := getAuthenticator(params.Client, params.IBMVPCCluster.Namespace, params.IBMVPCCluster.Spec.CredentialsSecretRef, params.IBMVPCCluster.Spec.EnterpriseAccount)
+	if err != nil {
+		return nil, fmt.Errorf("error failed to create authenticator: %w", err)
+	}
+
 	vpcEndpoint := endpoints.FetchVPCEndpoint(params.IBMVPCCluster.Spec.Region, params.ServiceEndpoint)
-	vpcClient, err := vpc.NewService(vpcEndpoint)
+	vpcClient, err := vpc.NewServiceWithAuthenticator(vpcEndpoint, auth)
 	if err != nil {
 		return nil, fmt.Errorf("error failed to create IBM VPC client: %w", err)
 	}
@@ -127,11 +137,6 @@ func NewVPCClusterScope(params VPCClusterScopeParams) (*VPCClusterScope, error)
 		core.SetLoggingLevel(core.LevelDebug)
 	}
 
-	auth, err := authenticator.GetAuthenticator()
-	if err != nil {
-		return nil, fmt.Errorf("error failed to create authenticator: %w", err)
-	}
-
 	// Create Global Tagging client.
 	gtOptions := globaltagging.ServiceOptions{
 		GlobalTaggingV1Options: &globaltaggingv1.GlobalTaggingV1Options{
@@ -190,6 +195,15 @@ func NewVPCClusterScope(params VPCClusterScopeParams) (*VPCClusterScope, error)
 		ResourceManagerClient:    resourceManagerClient,
 		VPCClient:                vpcClient,
 	}
+
+	// Create DNS client when the control plane endpoint is registered in DNS.
+	if params.IBMVPCCluster.Spec.DNS != nil {
+		dnsClient, err := newDNSClient(params.IBMVPCCluster.Spec.DNS, auth, params.ServiceEndpoint)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create DNS client: %w", err)
+		}
+		clusterScope.DNSClient = dnsClient
+	}
 	return clusterScope, nil
 }
 
@@ -218,6 +232,19 @@ func (s *VPCClusterScope) NetworkStatus() *infrav1beta2.VPCNetworkStatus {
 	return s.IBMVPCCluster.Status.Network
 }
 
+// CheckLoadBalancerMembers checks the members of the pools of the load balancers of the cluster are healthy.
+func (s *VPCClusterScope) CheckLoadBalancerMembers() error {
+	loadBalancerIDs := map[string]string{}
+	if s.NetworkStatus() != nil {
+		for name, loadBalancer := range s.NetworkStatus().LoadBalancers {
+			if loadBalancer != nil && loadBalancer.ID != nil {
+				loadBalancerIDs[name] = *loadBalancer.ID
+			}
+		}
+	}
+	return checkLoadBalancerMembersHealth(s.VPCClient, loadBalancerIDs)
+}
+
 // CheckTagExists checks whether a user tag already exists.
 func (s *VPCClusterScope) CheckTagExists(tagName string) (bool, error) {
 	exists, err := s.GlobalTaggingClient.GetTagByName(tagName)
@@ -297,14 +324,21 @@ func (s *VPCClusterScope) GetLoadBalancerHostName() (*string, error) {
 			if loadBalancer.Public != nil && !*loadBalancer.Public {
 				lbSuffix = privateLBSuffix
 			}
-			name = fmt.Sprintf("%s-%s", *s.GetServiceName(infrav1beta2.ResourceTypeLoadBalancer), lbSuffix)
+			name = names.Normalize(fmt.Sprintf("%s-%s", *s.GetServiceName(infrav1beta2.ResourceTypeLoadBalancer), lbSuffix), names.VPCMaxLength)
 		}
 
 		// Retrieve the Load Balancer hostname from API.
 		lbDetails, err := s.VPCClient.GetLoadBalancerByName(name)
 		if err != nil {
 			return nil, fmt.Errorf("error retrieving load balancer hostname for %s: %w", name, err)
-		} else if lbDetails == nil {
+		} else if lbDetails != nil {
+			if matched, err := s.MatchesResourceSelector(infrav1beta2.ResourceTypeLoadBalancer, name, lbDetails.CRN); err != nil {
+				return nil, err
+			} else if !matched {
+				lbDetails = nil
+			}
+		}
+		if lbDetails == nil {
 			return nil, fmt.Errorf("error retrieving load balancer hostname, %s load balancer not found", name)
 		}
 		return lbDetails.Hostname, nil
@@ -409,6 +443,9 @@ func (s *VPCClusterScope) GetSecurityGroupID(name string) (*string, error) {
 	if securityGroup == nil {
 		return nil, nil
 	}
+	if matched, err := s.MatchesResourceSelector(infrav1beta2.ResourceTypeSecurityGroup, name, securityGroup.CRN); err != nil || !matched {
+		return nil, err
+	}
 	return securityGroup.ID, nil
 }
 
@@ -429,23 +466,23 @@ func (s *VPCClusterScope) GetServiceName(resourceType infrav1beta2.ResourceType)
 	case infrav1beta2.ResourceTypeVPC:
 		// Generate a name based off cluster name if no VPC defined in Spec, or no VPC name nor ID.
 		if s.NetworkSpec().VPC == nil || (s.NetworkSpec().VPC.Name == nil && s.NetworkSpec().VPC.ID == nil) {
-			return ptr.To(fmt.Sprintf("%s-vpc", s.Name()))
+			return ptr.To(names.Normalize(fmt.Sprintf("%s-vpc", s.Name()), names.VPCMaxLength))
 		}
 		if s.NetworkSpec().VPC.Name != nil {
 			return s.NetworkSpec().VPC.Name
 		}
 	case infrav1beta2.ResourceTypeSubnet:
 		// Generate a generic subnet name based off the cluster name, which can be extended as necessary (for Zones).
-		return ptr.To(fmt.Sprintf("%s-subnet", s.IBMVPCCluster.Name))
+		return ptr.To(names.Normalize(fmt.Sprintf("%s-subnet", s.IBMVPCCluster.Name), names.VPCMaxLength))
 	case infrav1beta2.ResourceTypePublicGateway:
 		// Generate a generic public gateway name based off the cluster name, which can be extedned as necessary (for Zone).
-		return ptr.To(fmt.Sprintf("%s-pgateway", s.IBMVPCCluster.Name))
+		return ptr.To(names.Normalize(fmt.Sprintf("%s-pgateway", s.IBMVPCCluster.Name), names.VPCMaxLength))
 	case infrav1beta2.ResourceTypeLoadBalancer:
 		// Generate a generic load balancer name based off the cluster name, which can be extended as necessary (for public vs private).
-		return ptr.To(fmt.Sprintf("%s-lb", s.IBMVPCCluster.Name))
+		return ptr.To(names.Normalize(fmt.Sprintf("%s-lb", s.IBMVPCCluster.Name), names.VPCMaxLength))
 	case infrav1beta2.ResourceTypeLoadBalancerPool:
 		// Generate a generic load balancer pool name based off the cluster name, which can be extended as necessary (for LB).
-		return ptr.To(fmt.Sprintf("%s-lbpool", s.IBMVPCCluster.Name))
+		return ptr.To(names.Normalize(fmt.Sprintf("%s-lbpool", s.IBMVPCCluster.Name), names.VPCMaxLength))
 	default:
 		s.V(3).Info("unsupported resource type", "resourceType", resourceType)
 	}
@@ -475,6 +512,9 @@ func (s *VPCClusterScope) GetSubnetID(name string) (*string, error) {
 	if subnet == nil {
 		return nil, nil
 	}
+	if matched, err := s.MatchesResourceSelector(infrav1beta2.ResourceTypeSubnet, name, subnet.CRN); err != nil || !matched {
+		return nil, err
+	}
 	return subnet.ID, nil
 }
 
@@ -496,6 +536,9 @@ func (s *VPCClusterScope) GetVPCID() (*string, error) {
 
 			// Check if the VPC was found and has an ID
 			if vpcDetails != nil && vpcDetails.ID != nil {
+				if matched, err := s.MatchesResourceSelector(infrav1beta2.ResourceTypeVPC, *s.NetworkSpec().VPC.Name, vpcDetails.CRN); err != nil || !matched {
+					return nil, err
+				}
 				// Set VPC ID in Status to shortcut future lookups, prior to returning the ID.
 				s.SetResourceStatus(infrav1beta2.ResourceTypeVPC, &infrav1beta2.ResourceStatus{
 					ID:    *vpcDetails.ID,
@@ -598,6 +641,18 @@ func (s *VPCClusterScope) SetResourceStatus(resourceType infrav1beta2.ResourceTy
 		} else {
 			s.IBMVPCCluster.Status.Network.SecurityGroups[*resource.Name] = resource
 		}
+	case infrav1beta2.ResourceTypePublicGateway:
+		if s.NetworkStatus() == nil {
+			s.IBMVPCCluster.Status.Network = &infrav1beta2.VPCNetworkStatus{}
+		}
+		if s.IBMVPCCluster.Status.Network.PublicGateways == nil {
+			s.IBMVPCCluster.Status.Network.PublicGateways = make(map[string]*infrav1beta2.ResourceStatus)
+		}
+		if publicGateway, ok := s.IBMVPCCluster.Status.Network.PublicGateways[*resource.Name]; ok {
+			publicGateway.Set(*resource)
+		} else {
+			s.IBMVPCCluster.Status.Network.PublicGateways[*resource.Name] = resource
+		}
 	default:
 		s.V(3).Info("unsupported resource type", "resourceType", resourceType)
 	}
@@ -637,6 +692,115 @@ func (s *VPCClusterScope) TagResource(tagName string, resourceCRN string) error
 	return nil
 }
 
+// MatchesResourceSelector reports whether a resource found by name has all the tags of the ResourceSelector attached,
+// the lookups by name ignore the resources not matching the ResourceSelector.
+func (s *VPCClusterScope) MatchesResourceSelector(resourceType infrav1beta2.ResourceType, name string, resourceCRN *string) (bool, error) {
+	matched, err := matchesResourceSelector(s.GlobalTaggingClient, s.IBMVPCCluster.Spec.ResourceSelector, resourceCRN)
+	if err != nil {
+		return false, fmt.Errorf("error checking resource selector for %s %s: %w", resourceType, name, err)
+	}
+	if !matched {
+		s.V(3).Info("Ignoring resource found by name not matching the resource selector", "resourceType", resourceType, "name", name)
+	}
+	return matched, nil
+}
+
+// userTags returns the tags of the ResourceSelector, so the resources created by the controller keep matching the ResourceSelector,
+// along with the user tags of the cluster.
+func (s *VPCClusterScope) userTags() []string {
+	var tags []string
+	if s.IBMVPCCluster.Spec.ResourceSelector != nil {
+		tags = append(tags, s.IBMVPCCluster.Spec.ResourceSelector.Tags...)
+	}
+	if s.IBMVPCCluster.Spec.Tags != nil {
+		tags = append(tags, s.IBMVPCCluster.Spec.Tags.UserTags...)
+	}
+	return tags
+}
+
+// tagUserTags attaches the user tags to a resource created by the controller.
+func (s *VPCClusterScope) tagUserTags(resourceCRN string) error {
+	for _, tag := range s.userTags() {
+		if err := s.TagResource(tag, resourceCRN); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ReconcileTags reconciles the tags of the VPC, subnets, public gateways, security groups and load balancers created by the controller,
+// the tags attached by other systems are preserved unless the prune option of the tags is set.
+func (s *VPCClusterScope) ReconcileTags() error {
+	if s.IBMVPCCluster.Status.Network == nil {
+		return nil
+	}
+	network := s.IBMVPCCluster.Status.Network
+	// The resources keep the name tag attached on their creation: the VPC is tagged with the name of the cluster, the other
+	// resources with the name of the IBMVPCCluster.
+	resources := map[string]string{}
+	if vpc := network.VPC; vpc != nil && ptr.Deref(vpc.ControllerCreated, false) {
+		vpcDetails, _, err := s.VPCClient.GetVPC(&vpcv1.GetVPCOptions{ID: ptr.To(vpc.ID)})
+		if err != nil {
+			return fmt.Errorf("failed to get vpc %s: %w", vpc.ID, err)
+		}
+		resources[ptr.Deref(vpcDetails.CRN, "")] = s.Name()
+	}
+	for _, subnets := range []map[string]*infrav1beta2.ResourceStatus{network.ControlPlaneSubnets, network.WorkerSubnets} {
+		for _, subnet := range subnets {
+			if subnet == nil || !ptr.Deref(subnet.ControllerCreated, false) {
+				continue
+			}
+			subnetDetails, _, err := s.VPCClient.GetSubnet(&vpcv1.GetSubnetOptions{ID: ptr.To(subnet.ID)})
+			if err != nil {
+				return fmt.Errorf("failed to get subnet %s: %w", subnet.ID, err)
+			}
+			resources[ptr.Deref(subnetDetails.CRN, "")] = s.IBMVPCCluster.Name
+		}
+	}
+	for _, publicGateway := range network.PublicGateways {
+		if publicGateway == nil || !ptr.Deref(publicGateway.ControllerCreated, false) {
+			continue
+		}
+		publicGatewayDetails, _, err := s.VPCClient.GetPublicGateway(&vpcv1.GetPublicGatewayOptions{ID: ptr.To(publicGateway.ID)})
+		if err != nil {
+			return fmt.Errorf("failed to get public gateway %s: %w", publicGateway.ID, err)
+		}
+		resources[ptr.Deref(publicGatewayDetails.CRN, "")] = s.IBMVPCCluster.Name
+	}
+	for _, securityGroup := range network.SecurityGroups {
+		if securityGroup == nil || !ptr.Deref(securityGroup.ControllerCreated, false) {
+			continue
+		}
+		securityGroupDetails, _, err := s.VPCClient.GetSecurityGroup(&vpcv1.GetSecurityGroupOptions{ID: ptr.To(securityGroup.ID)})
+		if err != nil {
+			return fmt.Errorf("failed to get security group %s: %w", securityGroup.ID, err)
+		}
+		resources[ptr.Deref(securityGroupDetails.CRN, "")] = s.IBMVPCCluster.Name
+	}
+	for _, loadBalancer := range network.LoadBalancers {
+		if loadBalancer == nil || loadBalancer.ID == nil || !ptr.Deref(loadBalancer.ControllerCreated, false) {
+			continue
+		}
+		loadBalancerDetails, _, err := s.VPCClient.GetLoadBalancer(&vpcv1.GetLoadBalancerOptions{ID: loadBalancer.ID})
+		if err != nil {
+			return fmt.Errorf("failed to get load balancer %s: %w", *loadBalancer.ID, err)
+		}
+		resources[ptr.Deref(loadBalancerDetails.CRN, "")] = s.IBMVPCCluster.Name
+	}
+
+	prune := s.IBMVPCCluster.Spec.Tags != nil && s.IBMVPCCluster.Spec.Tags.Prune
+	var errs []error
+	for crn, nameTag := range resources {
+		if crn == "" {
+			continue
+		}
+		if err := reconcileTags(s.GlobalTaggingClient, crn, append([]string{nameTag}, s.userTags()...), prune); err != nil {
+			errs = append(errs, fmt.Errorf("failed to reconcile tags of resource %s: %w", crn, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
 // ReconcileVPC reconciles the cluster's VPC.
 func (s *VPCClusterScope) ReconcileVPC() (bool, error) {
 	// If VPC id is set, that indicates the VPC already exists.
@@ -714,13 +878,17 @@ func (s *VPCClusterScope) createVPC() error {
 		ID:   *vpcDetails.ID,
 		Name: vpcDetails.Name,
 		// We wait for a followup reconcile loop to set as Ready, to confirm the VPC can be found.
-		Ready: false,
+		Ready:             false,
+		ControllerCreated: ptr.To(true),
 	})
 
 	// NOTE: This tagging is only attempted once. We may wish to refactor in case this single attempt fails.
 	if err = s.TagResource(s.Name(), *vpcDetails.CRN); err != nil {
 		return fmt.Errorf("error tagging vpc: %w", err)
 	}
+	if err = s.tagUserTags(*vpcDetails.CRN); err != nil {
+		return fmt.Errorf("error tagging vpc: %w", err)
+	}
 
 	return nil
 }
@@ -748,7 +916,14 @@ func (s *VPCClusterScope) ReconcileVPCCustomImage() (bool, error) {
 		imageDetails, err := s.VPCClient.GetImageByName(*s.IBMVPCCluster.Spec.Image.Name)
 		if err != nil {
 			return false, fmt.Errorf("error checking vpc custom image by name: %w", err)
-		} else if imageDetails != nil && imageDetails.ID != nil {
+		} else if imageDetails != nil {
+			if matched, err := s.MatchesResourceSelector(infrav1beta2.ResourceTypeCustomImage, *s.IBMVPCCluster.Spec.Image.Name, imageDetails.CRN); err != nil {
+				return false, err
+			} else if !matched {
+				imageDetails = nil
+			}
+		}
+		if imageDetails != nil && imageDetails.ID != nil {
 			// Prevent relookup (API request) of VPC Custom Image if we already have the necessary data
 			requeue := true
 			if imageDetails.Status != nil && *imageDetails.Status == string(vpcv1.ImageStatusAvailableConst) {
@@ -1007,7 +1182,14 @@ func (s *VPCClusterScope) reconcileSubnet(subnet infrav1beta2.Subnet, isControlP
 			subnetDetails, err := s.VPCClient.GetVPCSubnetByName(*subnetName)
 			if err != nil {
 				return false, fmt.Errorf("error retrieving existing subnet by name %s: %w", *subnetName, err)
-			} else if subnetDetails == nil {
+			} else if subnetDetails != nil {
+				if matched, err := s.MatchesResourceSelector(infrav1beta2.ResourceTypeSubnet, *subnetName, subnetDetails.CRN); err != nil {
+					return false, err
+				} else if !matched {
+					subnetDetails = nil
+				}
+			}
+			if subnetDetails == nil {
 				return false, fmt.Errorf("error failed to find existing subnet by name: %s", *subnetName)
 			}
 			return s.updateSubnetStatus(subnetDetails, isControlPlane)
@@ -1033,8 +1215,12 @@ func (s *VPCClusterScope) reconcileSubnet(subnet infrav1beta2.Subnet, isControlP
 		if err != nil {
 			return false, fmt.Errorf("error retrieving subnet by name %s: %w", *subnet.Name, err)
 		} else if subnetDetails != nil {
-			// Update status if subnet was found.
-			return s.updateSubnetStatus(subnetDetails, isControlPlane)
+			if matched, err := s.MatchesResourceSelector(infrav1beta2.ResourceTypeSubnet, *subnet.Name, subnetDetails.CRN); err != nil {
+				return false, err
+			} else if matched {
+				// Update status if subnet was found.
+				return s.updateSubnetStatus(subnetDetails, isControlPlane)
+			}
 		}
 		// If subnet was not found, expect that it needs to be created.
 	}
@@ -1062,7 +1248,7 @@ func (s *VPCClusterScope) buildSubnetsForZones() ([]infrav1beta2.Subnet, error)
 		return subnets, fmt.Errorf("error retrieving subnet zones, no zones found in %s", s.IBMVPCCluster.Spec.Region)
 	}
 	for _, zone := range zones {
-		name := fmt.Sprintf("%s-%s", *s.GetServiceName(infrav1beta2.ResourceTypeSubnet), zone)
+		name := names.Normalize(fmt.Sprintf("%s-%s", *s.GetServiceName(infrav1beta2.ResourceTypeSubnet), zone), names.VPCMaxLength)
 		subnets = append(subnets, infrav1beta2.Subnet{
 			Name: ptr.To(name),
 			Zone: ptr.To(zone),
@@ -1089,9 +1275,23 @@ func (s *VPCClusterScope) updateSubnetStatus(subnetDetails *vpcv1.Subnet, isCont
 	} else {
 		s.SetResourceStatus(infrav1beta2.ResourceTypeWorkerSubnet, resourceStatus)
 	}
+	if subnetDetails.Zone != nil && subnetDetails.Zone.Name != nil {
+		s.setFailureDomain(*subnetDetails.Zone.Name, isControlPlane)
+	}
 	return requeue, nil
 }
 
+// setFailureDomain records the zone of a subnet as a failure domain of the cluster.
+// A zone remains eligible for Control Plane machines once any Control Plane subnet was found in it.
+func (s *VPCClusterScope) setFailureDomain(zone string, isControlPlane bool) {
+	if s.IBMVPCCluster.Status.FailureDomains == nil {
+		s.IBMVPCCluster.Status.FailureDomains = make(capiv1beta1.FailureDomains)
+	}
+	failureDomain := s.IBMVPCCluster.Status.FailureDomains[zone]
+	failureDomain.ControlPlane = failureDomain.ControlPlane || isControlPlane
+	s.IBMVPCCluster.Status.FailureDomains[zone] = failureDomain
+}
+
 // createSubnet creates a new VPC subnet.
 func (s *VPCClusterScope) createSubnet(subnet infrav1beta2.Subnet, isControlPlane bool) error {
 	// TODO(cjschaef): Move to webhook validation.
@@ -1155,9 +1355,10 @@ func (s *VPCClusterScope) createSubnet(subnet infrav1beta2.Subnet, isControlPlan
 
 	// Initially populate subnet's status.
 	resourceStatus := &infrav1beta2.ResourceStatus{
-		ID:    *subnetDetails.ID,
-		Name:  subnetDetails.Name,
-		Ready: false,
+		ID:                *subnetDetails.ID,
+		Name:              subnetDetails.Name,
+		Ready:             false,
+		ControllerCreated: ptr.To(true),
 	}
 	if isControlPlane {
 		s.SetResourceStatus(infrav1beta2.ResourceTypeControlPlaneSubnet, resourceStatus)
@@ -1170,13 +1371,16 @@ func (s *VPCClusterScope) createSubnet(subnet infrav1beta2.Subnet, isControlPlan
 	if err != nil {
 		return fmt.Errorf("error failed to tag subnet %s: %w", *subnetDetails.Name, err)
 	}
+	if err = s.tagUserTags(*subnetDetails.CRN); err != nil {
+		return fmt.Errorf("error failed to tag subnet %s: %w", *subnetDetails.Name, err)
+	}
 
 	return nil
 }
 
 // findOrCreatePublicGateway will attempt to find if there is an existing Public Gateway for a specific zone, for the cluster (in cluster's Resource Group and VPC), or create a new one. Only one Public Gateway is required in each zone, for any subnets in that zone.
 func (s *VPCClusterScope) findOrCreatePublicGateway(zone string) (*vpcv1.PublicGateway, error) {
-	publicGatewayName := fmt.Sprintf("%s-%s", *s.GetServiceName(infrav1beta2.ResourceTypePublicGateway), zone)
+	publicGatewayName := names.Normalize(fmt.Sprintf("%s-%s", *s.GetServiceName(infrav1beta2.ResourceTypePublicGateway), zone), names.VPCMaxLength)
 	// We will use the cluster Resource Group ID, as we expect to create all resources (Public Gateways and Subnets) in that Resource Group.
 	resourceGroupID, err := s.GetResourceGroupID()
 	if err != nil {
@@ -1189,7 +1393,19 @@ func (s *VPCClusterScope) findOrCreatePublicGateway(zone string) (*vpcv1.PublicG
 
 	// If we found the Public Gateway, with an ID, for the zone, return it.
 	// NOTE(cjschaef): We may wish to confirm the PublicGateway, by checking Tags (Global Tagging), but this might be sufficient, as we don't expect to have duplicate PG's or existing PG's, as we wouldn't create subnets and PG's for existing Network Infrastructure.
+	if publicGateway != nil {
+		if matched, err := s.MatchesResourceSelector(infrav1beta2.ResourceTypePublicGateway, publicGatewayName, publicGateway.CRN); err != nil {
+			return nil, err
+		} else if !matched {
+			publicGateway = nil
+		}
+	}
 	if publicGateway != nil && publicGateway.ID != nil {
+		s.SetResourceStatus(infrav1beta2.ResourceTypePublicGateway, &infrav1beta2.ResourceStatus{
+			ID:    *publicGateway.ID,
+			Name:  ptr.To(publicGatewayName),
+			Ready: true,
+		})
 		return publicGateway, nil
 	}
 
@@ -1222,12 +1438,21 @@ func (s *VPCClusterScope) findOrCreatePublicGateway(zone string) (*vpcv1.PublicG
 	}
 
 	s.V(3).Info("created public gateway", "id", publicGatewayDetails.ID)
+	s.SetResourceStatus(infrav1beta2.ResourceTypePublicGateway, &infrav1beta2.ResourceStatus{
+		ID:                *publicGatewayDetails.ID,
+		Name:              ptr.To(publicGatewayName),
+		Ready:             true,
+		ControllerCreated: ptr.To(true),
+	})
 
 	// Add a tag to the public gateway for the cluster
 	err = s.TagResource(s.IBMVPCCluster.Name, *publicGatewayDetails.CRN)
 	if err != nil {
 		return nil, fmt.Errorf("error failed to tag public gateway %s: %w", *publicGatewayDetails.Name, err)
 	}
+	if err = s.tagUserTags(*publicGatewayDetails.CRN); err != nil {
+		return nil, fmt.Errorf("error failed to tag public gateway %s: %w", *publicGatewayDetails.Name, err)
+	}
 
 	return publicGatewayDetails, nil
 }
@@ -1281,14 +1506,20 @@ func (s *VPCClusterScope) reconcileSecurityGroup(securityGroup infrav1beta2.VPCS
 					return fmt.Errorf("error failed lookup of security group by name: %w", err)
 				}
 			} else if securityGroupDetails != nil {
-				// If the Security Group was found, update Status with current details.
-				// Security Groups do not have a status, so we assume if it exists, it is ready.
-				s.SetResourceStatus(infrav1beta2.ResourceTypeSecurityGroup, &infrav1beta2.ResourceStatus{
-					ID:    *securityGroupDetails.ID,
-					Name:  securityGroupDetails.Name,
-					Ready: true,
-				})
-				return nil
+				matched, err := s.MatchesResourceSelector(infrav1beta2.ResourceTypeSecurityGroup, *securityGroup.Name, securityGroupDetails.CRN)
+				if err != nil {
+					return err
+				}
+				if matched {
+					// If the Security Group was found, update Status with current details.
+					// Security Groups do not have a status, so we assume if it exists, it is ready.
+					s.SetResourceStatus(infrav1beta2.ResourceTypeSecurityGroup, &infrav1beta2.ResourceStatus{
+						ID:    *securityGroupDetails.ID,
+						Name:  securityGroupDetails.Name,
+						Ready: true,
+					})
+					return nil
+				}
 			}
 		}
 	}
@@ -1346,9 +1577,10 @@ func (s *VPCClusterScope) reconcileSecurityGroup(securityGroup infrav1beta2.VPCS
 
 	// Security Groups do not have a status, so just assume they are ready immediately after creation.
 	s.SetResourceStatus(infrav1beta2.ResourceTypeSecurityGroup, &infrav1beta2.ResourceStatus{
-		ID:    *securityGroupDetails.ID,
-		Name:  securityGroupDetails.Name,
-		Ready: true,
+		ID:                *securityGroupDetails.ID,
+		Name:              securityGroupDetails.Name,
+		Ready:             true,
+		ControllerCreated: ptr.To(true),
 	})
 
 	// NOTE: This tagging is only attempted once. We may wish to refactor in case this single attempt fails.
@@ -1357,6 +1589,9 @@ func (s *VPCClusterScope) reconcileSecurityGroup(securityGroup infrav1beta2.VPCS
 	if err != nil {
 		return fmt.Errorf("error failed to tag security group %s: %w", *securityGroupDetails.CRN, err)
 	}
+	if err = s.tagUserTags(*securityGroupDetails.CRN); err != nil {
+		return fmt.Errorf("error failed to tag security group %s: %w", *securityGroupDetails.CRN, err)
+	}
 
 	return nil
 }
@@ -1578,6 +1813,12 @@ func (s *VPCClusterScope) checkSecurityGroupRulePrototypeRemote(securityGroupRul
 			return false, nil
 		}
 		subnetDetails, err := s.VPCClient.GetVPCSubnetByName(*securityGroupRuleRemote.CIDRSubnetName)
+		if err == nil && subnetDetails != nil {
+			var matched bool
+			if matched, err = s.MatchesResourceSelector(infrav1beta2.ResourceTypeSubnet, *securityGroupRuleRemote.CIDRSubnetName, subnetDetails.CRN); err == nil && !matched {
+				subnetDetails = nil
+			}
+		}
 		if err != nil {
 			return false, fmt.Errorf("error failed getting subnet by name for security group rule: %w", err)
 		} else if subnetDetails == nil {
@@ -1626,6 +1867,12 @@ func (s *VPCClusterScope) checkSecurityGroupRulePrototypeRemote(securityGroupRul
 			})
 		} else {
 			securityGroupDetails, err = s.VPCClient.GetSecurityGroupByName(*securityGroupRuleRemote.SecurityGroupName)
+			if err == nil && securityGroupDetails != nil {
+				var matched bool
+				if matched, err = s.MatchesResourceSelector(infrav1beta2.ResourceTypeSecurityGroup, *securityGroupRuleRemote.SecurityGroupName, securityGroupDetails.CRN); err == nil && !matched {
+					securityGroupDetails = nil
+				}
+			}
 		}
 		if err != nil {
 			return false, fmt.Errorf("error failed getting security group by name for security group rule: %w", err)
@@ -1760,6 +2007,12 @@ func (s *VPCClusterScope) createSecurityGroupRuleRemote(remote infrav1beta2.VPCS
 	case infrav1beta2.VPCSecurityGroupRuleRemoteTypeCIDR:
 		// As we nned the Subnet CIDR block, we have to perform an IBM Cloud API call either way, so simply make the call using the item we know, the Name
 		subnetDetails, err := s.VPCClient.GetVPCSubnetByName(*remote.CIDRSubnetName)
+		if err == nil && subnetDetails != nil {
+			var matched bool
+			if matched, err = s.MatchesResourceSelector(infrav1beta2.ResourceTypeSubnet, *remote.CIDRSubnetName, subnetDetails.CRN); err == nil && !matched {
+				subnetDetails = nil
+			}
+		}
 		if err != nil {
 			return nil, fmt.Errorf("error failed lookup of subnet during security group rule remote creation: %w", err)
 		} else if subnetDetails == nil {
@@ -1771,6 +2024,12 @@ func (s *VPCClusterScope) createSecurityGroupRuleRemote(remote infrav1beta2.VPCS
 	case infrav1beta2.VPCSecurityGroupRuleRemoteTypeSG:
 		// As we need the Security Group CRN, we have to perform an IBM Cloud API call either way, so simply make the call using the item we know, the Name
 		securityGroupDetails, err := s.VPCClient.GetSecurityGroupByName(*remote.SecurityGroupName)
+		if err == nil && securityGroupDetails != nil {
+			var matched bool
+			if matched, err = s.MatchesResourceSelector(infrav1beta2.ResourceTypeSecurityGroup, *remote.SecurityGroupName, securityGroupDetails.CRN); err == nil && !matched {
+				securityGroupDetails = nil
+			}
+		}
 		if err != nil {
 			return nil, fmt.Errorf("error failed lookup of security group during security group rule remote creation: %w", err)
 		} else if securityGroupDetails == nil {
@@ -1827,6 +2086,91 @@ func (s *VPCClusterScope) ReconcileLoadBalancers() (bool, error) {
 	return requeue, nil
 }
 
+// ReconcileLoadBalancerPoolMembers removes the members of the pools of the load balancers of the cluster left behind by machines
+// which no longer exist, as the machines add themselves to the pools and only remove their members when they get deleted.
+// Only the members registered for the machines of the cluster are removed, members added to the pools by other means are left
+// untouched. A single member is removed at a time, requeue is true until all the stale members are removed.
+func (s *VPCClusterScope) ReconcileLoadBalancerPoolMembers() (bool, error) {
+	machineList := &infrav1beta2.IBMVPCMachineList{}
+	if err := s.Client.List(context.TODO(), machineList, client.InNamespace(s.IBMVPCCluster.Namespace), client.MatchingLabels{capiv1beta1.ClusterNameLabel: s.Cluster.Name}); err != nil {
+		return false, fmt.Errorf("failed to list IBMVPCMachines: %w", err)
+	}
+
+	registered := newRegisteredPoolMembers(s.IBMVPCCluster.Status.LoadBalancerPoolMembers)
+	defer func() {
+		s.IBMVPCCluster.Status.LoadBalancerPoolMembers = registered.list()
+	}()
+
+	addresses := map[string]bool{}
+	for i := range machineList.Items {
+		if internalIP := machineInternalIP(&machineList.Items[i]); internalIP != "" {
+			addresses[internalIP] = true
+			registered.add(internalIP)
+		}
+	}
+	stale := false
+	for _, address := range registered.list() {
+		if !addresses[address] {
+			stale = true
+		}
+	}
+	if !stale || s.NetworkStatus() == nil {
+		return false, nil
+	}
+
+	loadBalancerNames := make([]string, 0, len(s.NetworkStatus().LoadBalancers))
+	for name := range s.NetworkStatus().LoadBalancers {
+		loadBalancerNames = append(loadBalancerNames, name)
+	}
+	sort.Strings(loadBalancerNames)
+	for _, name := range loadBalancerNames {
+		lbStatus := s.NetworkStatus().LoadBalancers[name]
+		if lbStatus == nil || lbStatus.ID == nil {
+			continue
+		}
+		loadBalancer, _, err := s.VPCClient.GetLoadBalancer(&vpcv1.GetLoadBalancerOptions{ID: lbStatus.ID})
+		if err != nil {
+			return false, fmt.Errorf("failed to get load balancer %s: %w", name, err)
+		}
+		if *loadBalancer.ProvisioningStatus != string(infrav1beta2.VPCLoadBalancerStateActive) {
+			s.V(3).Info("Load balancer is not active, waiting to reconcile its pool members", "loadBalancerID", *loadBalancer.ID, "state", *loadBalancer.ProvisioningStatus)
+			return true, nil
+		}
+		for _, pool := range loadBalancer.Pools {
+			members, _, err := s.VPCClient.ListLoadBalancerPoolMembers(&vpcv1.ListLoadBalancerPoolMembersOptions{
+				LoadBalancerID: loadBalancer.ID,
+				PoolID:         pool.ID,
+			})
+			if err != nil {
+				return false, fmt.Errorf("failed to list members of load balancer pool %s: %w", *pool.Name, err)
+			}
+			for _, member := range members.Members {
+				target, ok := member.Target.(*vpcv1.LoadBalancerPoolMemberTarget)
+				if !ok || target.Address == nil {
+					continue
+				}
+				registered.observe(*target.Address)
+				if addresses[*target.Address] || !registered.has(*target.Address) {
+					continue
+				}
+				if _, err := s.VPCClient.DeleteLoadBalancerPoolMember(&vpcv1.DeleteLoadBalancerPoolMemberOptions{
+					LoadBalancerID: loadBalancer.ID,
+					PoolID:         pool.ID,
+					ID:             member.ID,
+				}); err != nil {
+					record.Warnf(s.IBMVPCCluster, "FailedDeleteLoadBalancerPoolMember", "Failed to remove %s from load balancer pool %s - %v", *target.Address, *pool.Name, err)
+					return false, fmt.Errorf("failed to delete member %s of load balancer pool %s: %w", *member.ID, *pool.Name, err)
+				}
+				record.Eventf(s.IBMVPCCluster, "SuccessfulDeleteLoadBalancerPoolMember", "Removed %s from load balancer pool %s", *target.Address, *pool.Name)
+				return true, nil
+			}
+		}
+	}
+	// All the pools are listed, the registered members which are no longer in any pool were removed.
+	registered.prune()
+	return false, nil
+}
+
 // isLoadBalancerReady checks the state of a Load Balancer.
 // If state is active, true is returned, in all other cases, it returns false.
 // NOTE(cjschaef): May wish to extend this function to check all Load Balancer details (pools, listeners, etc.) as part of a Load Balancer being ready.
@@ -1864,9 +2208,16 @@ func (s *VPCClusterScope) getLoadBalancer(lb infrav1beta2.VPCLoadBalancerSpec) (
 			if lb.Public != nil && !*lb.Public {
 				lbSuffix = privateLBSuffix
 			}
-			name = fmt.Sprintf("%s-%s", *s.GetServiceName(infrav1beta2.ResourceTypeLoadBalancer), lbSuffix)
+			name = names.Normalize(fmt.Sprintf("%s-%s", *s.GetServiceName(infrav1beta2.ResourceTypeLoadBalancer), lbSuffix), names.VPCMaxLength)
 		}
 		loadBalancer, err = s.VPCClient.GetLoadBalancerByName(name)
+		if err == nil && loadBalancer != nil {
+			if matched, err := s.MatchesResourceSelector(infrav1beta2.ResourceTypeLoadBalancer, name, loadBalancer.CRN); err != nil {
+				return nil, err
+			} else if !matched {
+				loadBalancer = nil
+			}
+		}
 	}
 	if err != nil {
 		return nil, fmt.Errorf("error attempting to retrieve load balancer: %w", err)
@@ -1909,7 +2260,7 @@ func (s *VPCClusterScope) createLoadBalancer(loadBalancer infrav1beta2.VPCLoadBa
 		if !isPublic {
 			lbSuffix = privateLBSuffix
 		}
-		name = fmt.Sprintf("%s-%s", *s.GetServiceName(infrav1beta2.ResourceTypeLoadBalancer), lbSuffix)
+		name = names.Normalize(fmt.Sprintf("%s-%s", *s.GetServiceName(infrav1beta2.ResourceTypeLoadBalancer), lbSuffix), names.VPCMaxLength)
 	}
 	options.SetName(name)
 
@@ -1996,6 +2347,9 @@ func (s *VPCClusterScope) createLoadBalancer(loadBalancer infrav1beta2.VPCLoadBa
 	if err = s.TagResource(s.IBMVPCCluster.Name, *loadBalancerDetails.CRN); err != nil {
 		return fmt.Errorf("error tagging load balancer: %w", err)
 	}
+	if err = s.tagUserTags(*loadBalancerDetails.CRN); err != nil {
+		return fmt.Errorf("error tagging load balancer: %w", err)
+	}
 
 	return nil
 }
@@ -2168,3 +2522,275 @@ func (s *VPCClusterScope) getDefaultLoadBalancerListeners(defaultBackendPool boo
 	defaultListeners = append(defaultListeners, s.buildLoadBalancerListener(defaultListener))
 	return defaultListeners
 }
+
+// DNS returns the DNS record registering the control plane endpoint of the cluster.
+func (s *VPCClusterScope) DNS() *infrav1beta2.DNSSpec {
+	return s.IBMVPCCluster.Spec.DNS
+}
+
+// ReconcileDNSRecord registers the control plane endpoint in the DNS zone, the record resolving to the hostname of the Load Balancer.
+func (s *VPCClusterScope) ReconcileDNSRecord(loadBalancerHostName string) error {
+	if s.DNS() == nil {
+		return nil
+	}
+	status, err := reconcileDNSRecord(s.DNSClient, s.DNS(), s.IBMVPCCluster.Status.DNSRecord, loadBalancerHostName)
+	if err != nil {
+		return fmt.Errorf("failed to reconcile DNS record %s: %w", s.DNS().Hostname, err)
+	}
+	s.V(3).Info("DNS record reconciled", "hostname", s.DNS().Hostname, "id", *status.ID)
+	s.IBMVPCCluster.Status.DNSRecord = status
+	return nil
+}
+
+// DeleteDNSRecord deletes the DNS record registering the control plane endpoint when it is created by the controller.
+func (s *VPCClusterScope) DeleteDNSRecord() error {
+	if s.DNS() == nil || s.DNSClient == nil {
+		return nil
+	}
+	if record := s.IBMVPCCluster.Status.DNSRecord; record == nil || !ptr.Deref(record.ControllerCreated, false) {
+		s.Info("Skipping DNS record deletion as resource is not created by controller")
+		return nil
+	}
+	if err := deleteDNSRecord(s.DNSClient, s.IBMVPCCluster.Status.DNSRecord); err != nil {
+		return fmt.Errorf("failed to delete DNS record %s: %w", s.DNS().Hostname, err)
+	}
+	s.Info("DNS record successfully deleted", "hostname", s.DNS().Hostname)
+	return nil
+}
+
+// DeleteLoadBalancers deletes the VPC Load Balancers created by the controller, returns true if deletion is still in progress.
+func (s *VPCClusterScope) DeleteLoadBalancers() (bool, error) {
+	if s.NetworkStatus() == nil {
+		return false, nil
+	}
+	errs := []error{}
+	requeue := false
+	for id, lb := range s.NetworkStatus().LoadBalancers {
+		if lb.ID == nil || lb.ControllerCreated == nil || !*lb.ControllerCreated {
+			s.Info("Skipping VPC load balancer deletion as resource is not created by controller", "id", id)
+			continue
+		}
+
+		loadBalancer, resp, err := s.VPCClient.GetLoadBalancer(&vpcv1.GetLoadBalancerOptions{
+			ID: lb.ID,
+		})
+		if err != nil {
+			if resp != nil && resp.StatusCode == ResourceNotFoundCode {
+				s.Info("VPC load balancer successfully deleted", "id", id)
+				delete(s.NetworkStatus().LoadBalancers, id)
+				continue
+			}
+			errs = append(errs, fmt.Errorf("failed to fetch VPC load balancer %s: %w", id, err))
+			continue
+		}
+
+		requeue = true
+		if loadBalancer != nil && loadBalancer.ProvisioningStatus != nil && *loadBalancer.ProvisioningStatus == string(infrav1beta2.VPCLoadBalancerStateDeletePending) {
+			s.V(3).Info("VPC load balancer is currently being deleted", "id", id)
+			continue
+		}
+
+		if _, err = s.VPCClient.DeleteLoadBalancer(&vpcv1.DeleteLoadBalancerOptions{
+			ID: lb.ID,
+		}); err != nil {
+			errs = append(errs, fmt.Errorf("failed to delete VPC load balancer %s: %w", id, err))
+		}
+	}
+	if len(errs) > 0 {
+		return false, kerrors.NewAggregate(errs)
+	}
+	return requeue, nil
+}
+
+// DeleteSecurityGroups deletes the VPC Security Groups created by the controller.
+func (s *VPCClusterScope) DeleteSecurityGroups() error {
+	if s.NetworkStatus() == nil {
+		return nil
+	}
+	for name, securityGroup := range s.NetworkStatus().SecurityGroups {
+		if securityGroup.ControllerCreated == nil || !*securityGroup.ControllerCreated {
+			s.Info("Skipping VPC security group deletion as resource is not created by controller", "name", name)
+			continue
+		}
+		if _, resp, err := s.VPCClient.GetSecurityGroup(&vpcv1.GetSecurityGroupOptions{
+			ID: ptr.To(securityGroup.ID),
+		}); err != nil {
+			if resp != nil && resp.StatusCode == ResourceNotFoundCode {
+				s.Info("VPC security group has been already deleted", "securityGroupID", securityGroup.ID)
+				delete(s.NetworkStatus().SecurityGroups, name)
+				continue
+			}
+			return fmt.Errorf("failed to fetch VPC security group '%s': %w", securityGroup.ID, err)
+		}
+
+		s.V(3).Info("Deleting VPC security group", "securityGroupID", securityGroup.ID)
+		if _, err := s.VPCClient.DeleteSecurityGroup(&vpcv1.DeleteSecurityGroupOptions{
+			ID: ptr.To(securityGroup.ID),
+		}); err != nil {
+			return fmt.Errorf("failed to delete VPC security group '%s': %w", securityGroup.ID, err)
+		}
+		s.Info("VPC security group successfully deleted", "securityGroupID", securityGroup.ID)
+		delete(s.NetworkStatus().SecurityGroups, name)
+	}
+	return nil
+}
+
+// DeleteSubnets deletes the VPC Subnets created by the controller, returns true if deletion is still in progress.
+func (s *VPCClusterScope) DeleteSubnets() (bool, error) {
+	if s.NetworkStatus() == nil {
+		return false, nil
+	}
+	errs := []error{}
+	requeue := false
+	// When no Worker subnets were supplied, the Control Plane and Worker subnets are the same subnets, so each subnet is only deleted once.
+	// The map tracks whether an already processed subnet was found to be deleted.
+	processed := make(map[string]bool)
+	for _, subnets := range []map[string]*infrav1beta2.ResourceStatus{s.NetworkStatus().ControlPlaneSubnets, s.NetworkStatus().WorkerSubnets} {
+		for name, subnet := range subnets {
+			if subnet.ControllerCreated == nil || !*subnet.ControllerCreated {
+				s.Info("Skipping VPC subnet deletion as resource is not created by controller", "name", name)
+				continue
+			}
+			if deleted, ok := processed[subnet.ID]; ok {
+				if deleted {
+					delete(subnets, name)
+				}
+				continue
+			}
+			processed[subnet.ID] = false
+
+			subnetDetails, resp, err := s.VPCClient.GetSubnet(&vpcv1.GetSubnetOptions{
+				ID: ptr.To(subnet.ID),
+			})
+			if err != nil {
+				if resp != nil && resp.StatusCode == ResourceNotFoundCode {
+					s.Info("VPC subnet successfully deleted", "name", name)
+					processed[subnet.ID] = true
+					delete(subnets, name)
+					continue
+				}
+				errs = append(errs, fmt.Errorf("failed to fetch VPC subnet %s: %w", name, err))
+				continue
+			}
+
+			requeue = true
+			if subnetDetails != nil && subnetDetails.Status != nil && *subnetDetails.Status == string(infrav1beta2.VPCSubnetStateDeleting) {
+				s.V(3).Info("VPC subnet is currently being deleted", "name", name)
+				continue
+			}
+
+			if _, err = s.VPCClient.DeleteSubnet(&vpcv1.DeleteSubnetOptions{
+				ID: ptr.To(subnet.ID),
+			}); err != nil {
+				errs = append(errs, fmt.Errorf("failed to delete VPC subnet %s: %w", name, err))
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return false, kerrors.NewAggregate(errs)
+	}
+	return requeue, nil
+}
+
+// DeletePublicGateways deletes the VPC Public Gateways created by the controller, returns true if deletion is still in progress.
+func (s *VPCClusterScope) DeletePublicGateways() (bool, error) {
+	if s.NetworkStatus() == nil {
+		return false, nil
+	}
+	errs := []error{}
+	requeue := false
+	for name, publicGateway := range s.NetworkStatus().PublicGateways {
+		if publicGateway.ControllerCreated == nil || !*publicGateway.ControllerCreated {
+			s.Info("Skipping VPC public gateway deletion as resource is not created by controller", "name", name)
+			continue
+		}
+
+		publicGatewayDetails, resp, err := s.VPCClient.GetPublicGateway(&vpcv1.GetPublicGatewayOptions{
+			ID: ptr.To(publicGateway.ID),
+		})
+		if err != nil {
+			if resp != nil && resp.StatusCode == ResourceNotFoundCode {
+				s.Info("VPC public gateway successfully deleted", "name", name)
+				delete(s.NetworkStatus().PublicGateways, name)
+				continue
+			}
+			errs = append(errs, fmt.Errorf("failed to fetch VPC public gateway %s: %w", name, err))
+			continue
+		}
+
+		requeue = true
+		if publicGatewayDetails != nil && publicGatewayDetails.Status != nil && *publicGatewayDetails.Status == vpcv1.PublicGatewayStatusDeletingConst {
+			s.V(3).Info("VPC public gateway is currently being deleted", "name", name)
+			continue
+		}
+
+		if _, err = s.VPCClient.DeletePublicGateway(&vpcv1.DeletePublicGatewayOptions{
+			ID: ptr.To(publicGateway.ID),
+		}); err != nil {
+			errs = append(errs, fmt.Errorf("failed to delete VPC public gateway %s: %w", name, err))
+		}
+	}
+	if len(errs) > 0 {
+		return false, kerrors.NewAggregate(errs)
+	}
+	return requeue, nil
+}
+
+// HasClusterInstances returns true while instances of the cluster exist in the VPC. A VPC created by the controller can't be
+// deleted while it has instances, all of them are therefore waited for. A VPC provided by the user may be shared with other
+// workloads, only the IBMVPCMachines of the cluster, which are kept until their instance is deleted, are waited for.
+func (s *VPCClusterScope) HasClusterInstances() (bool, error) {
+	if s.NetworkStatus() == nil || s.NetworkStatus().VPC == nil {
+		return false, nil
+	}
+	if vpcStatus := s.NetworkStatus().VPC; ptr.Deref(vpcStatus.ControllerCreated, false) {
+		vsis, _, err := s.VPCClient.ListInstances(&vpcv1.ListInstancesOptions{
+			VPCID: ptr.To(vpcStatus.ID),
+		})
+		if err != nil {
+			return false, fmt.Errorf("error when listing VSIs: %w", err)
+		}
+		return vsis != nil && vsis.TotalCount != nil && *vsis.TotalCount != int64(0), nil
+	}
+
+	machines := &infrav1beta2.IBMVPCMachineList{}
+	if err := s.Client.List(context.TODO(), machines, client.InNamespace(s.IBMVPCCluster.Namespace), client.MatchingLabels{capiv1beta1.ClusterNameLabel: s.Name()}); err != nil {
+		return false, fmt.Errorf("failed to list IBMVPCMachines: %w", err)
+	}
+	return len(machines.Items) != 0, nil
+}
+
+// DeleteVPC deletes the VPC if it was created by the controller, returns true if deletion is still in progress.
+func (s *VPCClusterScope) DeleteVPC() (bool, error) {
+	if s.NetworkStatus() == nil || s.NetworkStatus().VPC == nil {
+		return false, nil
+	}
+	vpcStatus := s.NetworkStatus().VPC
+	if vpcStatus.ControllerCreated == nil || !*vpcStatus.ControllerCreated {
+		s.Info("Skipping VPC deletion as resource is not created by controller")
+		return false, nil
+	}
+
+	vpcDetails, resp, err := s.VPCClient.GetVPC(&vpcv1.GetVPCOptions{
+		ID: ptr.To(vpcStatus.ID),
+	})
+	if err != nil {
+		if resp != nil && resp.StatusCode == ResourceNotFoundCode {
+			s.Info("VPC successfully deleted")
+			s.NetworkStatus().VPC = nil
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to fetch VPC: %w", err)
+	}
+
+	if vpcDetails != nil && vpcDetails.Status != nil && *vpcDetails.Status == string(infrav1beta2.VPCStateDeleting) {
+		return true, nil
+	}
+
+	if _, err = s.VPCClient.DeleteVPC(&vpcv1.DeleteVPCOptions{
+		ID: ptr.To(vpcStatus.ID),
+	}); err != nil {
+		return false, fmt.Errorf("failed to delete VPC: %w", err)
+	}
+	return true, nil
+}
@@ -0,0 +1,361 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scope
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/globaltaggingv1"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"go.uber.org/mock/gomock"
+
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1beta2 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/v1beta2"
+	gtmock "sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/globaltagging/mock"
+	vpcmock "sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/vpc/mock"
+
+	. "github.com/onsi/gomega"
+)
+
+func setupVPCClusterScope(t *testing.T, resourceSelector *infrav1beta2.ResourceSelector) (*VPCClusterScope, *vpcmock.MockVpc, *gtmock.MockGlobalTagging) {
+	mockController := gomock.NewController(t)
+	t.Cleanup(mockController.Finish)
+	mockVPC := vpcmock.NewMockVpc(mockController)
+	mockGT := gtmock.NewMockGlobalTagging(mockController)
+
+	vpcCluster := newVPCCluster("foo-cluster")
+	vpcCluster.Spec.ResourceSelector = resourceSelector
+	return &VPCClusterScope{
+		Logger:              klog.Background(),
+		Cluster:             newCluster("foo-cluster"),
+		IBMVPCCluster:       vpcCluster,
+		VPCClient:           mockVPC,
+		GlobalTaggingClient: mockGT,
+	}, mockVPC, mockGT
+}
+
+func TestMatchesResourceSelector(t *testing.T) {
+	resourceSelector := &infrav1beta2.ResourceSelector{
+		Tags: []string{"env:prod", "team:infra"},
+	}
+
+	t.Run("Should match any resource when no resource selector is set", func(t *testing.T) {
+		g := NewWithT(t)
+		scope, _, _ := setupVPCClusterScope(t, nil)
+		g.Expect(scope.MatchesResourceSelector(infrav1beta2.ResourceTypeVPC, "foo-vpc", ptr.To("foo-vpc-crn"))).To(BeTrue())
+	})
+
+	t.Run("Should match when the resource has all the tags attached", func(t *testing.T) {
+		g := NewWithT(t)
+		scope, _, mockGT := setupVPCClusterScope(t, resourceSelector)
+		mockGT.EXPECT().GetAttachedTags("foo-vpc-crn").Return([]string{"foo-cluster", "env:prod", "Team:Infra"}, nil)
+		g.Expect(scope.MatchesResourceSelector(infrav1beta2.ResourceTypeVPC, "foo-vpc", ptr.To("foo-vpc-crn"))).To(BeTrue())
+	})
+
+	t.Run("Should not match when the resource is missing a tag", func(t *testing.T) {
+		g := NewWithT(t)
+		scope, _, mockGT := setupVPCClusterScope(t, resourceSelector)
+		mockGT.EXPECT().GetAttachedTags("foo-vpc-crn").Return([]string{"env:prod"}, nil)
+		g.Expect(scope.MatchesResourceSelector(infrav1beta2.ResourceTypeVPC, "foo-vpc", ptr.To("foo-vpc-crn"))).To(BeFalse())
+	})
+
+	t.Run("Should fail when the resource has no crn", func(t *testing.T) {
+		g := NewWithT(t)
+		scope, _, _ := setupVPCClusterScope(t, resourceSelector)
+		_, err := scope.MatchesResourceSelector(infrav1beta2.ResourceTypeVPC, "foo-vpc", nil)
+		g.Expect(err).ToNot(BeNil())
+	})
+
+	t.Run("Should fail when listing the attached tags fails", func(t *testing.T) {
+		g := NewWithT(t)
+		scope, _, mockGT := setupVPCClusterScope(t, resourceSelector)
+		mockGT.EXPECT().GetAttachedTags("foo-vpc-crn").Return(nil, errors.New("failed to list tags"))
+		_, err := scope.MatchesResourceSelector(infrav1beta2.ResourceTypeVPC, "foo-vpc", ptr.To("foo-vpc-crn"))
+		g.Expect(err).ToNot(BeNil())
+	})
+}
+
+func TestGetVPCIDWithResourceSelector(t *testing.T) {
+	resourceSelector := &infrav1beta2.ResourceSelector{
+		Tags: []string{"env:prod"},
+	}
+	vpcDetails := &vpcv1.VPC{
+		ID:   ptr.To("foo-vpc-id"),
+		CRN:  ptr.To("foo-vpc-crn"),
+		Name: ptr.To("foo-vpc"),
+	}
+
+	t.Run("Should return the vpc id when the vpc found by name matches the resource selector", func(t *testing.T) {
+		g := NewWithT(t)
+		scope, mockVPC, mockGT := setupVPCClusterScope(t, resourceSelector)
+		scope.IBMVPCCluster.Spec.Network = &infrav1beta2.VPCNetworkSpec{
+			VPC: &infrav1beta2.VPCResource{Name: ptr.To("foo-vpc")},
+		}
+		mockVPC.EXPECT().GetVPCByName("foo-vpc").Return(vpcDetails, nil)
+		mockGT.EXPECT().GetAttachedTags("foo-vpc-crn").Return([]string{"env:prod"}, nil)
+
+		vpcID, err := scope.GetVPCID()
+		g.Expect(err).To(BeNil())
+		g.Expect(vpcID).To(Equal(ptr.To("foo-vpc-id")))
+		g.Expect(scope.IBMVPCCluster.Status.Network.VPC.ID).To(Equal("foo-vpc-id"))
+	})
+
+	t.Run("Should ignore the vpc found by name not matching the resource selector", func(t *testing.T) {
+		g := NewWithT(t)
+		scope, mockVPC, mockGT := setupVPCClusterScope(t, resourceSelector)
+		scope.IBMVPCCluster.Spec.Network = &infrav1beta2.VPCNetworkSpec{
+			VPC: &infrav1beta2.VPCResource{Name: ptr.To("foo-vpc")},
+		}
+		mockVPC.EXPECT().GetVPCByName("foo-vpc").Return(vpcDetails, nil)
+		mockGT.EXPECT().GetAttachedTags("foo-vpc-crn").Return([]string{"env:dev"}, nil)
+
+		vpcID, err := scope.GetVPCID()
+		g.Expect(err).To(BeNil())
+		g.Expect(vpcID).To(BeNil())
+		g.Expect(scope.IBMVPCCluster.Status.Network).To(BeNil())
+	})
+}
+
+func TestVPCClusterReconcileTags(t *testing.T) {
+	resourceSelector := &infrav1beta2.ResourceSelector{
+		Tags: []string{"team:infra"},
+	}
+	networkStatus := func() *infrav1beta2.VPCNetworkStatus {
+		return &infrav1beta2.VPCNetworkStatus{
+			VPC: &infrav1beta2.ResourceStatus{ID: "foo-vpc-id", ControllerCreated: ptr.To(true)},
+			PublicGateways: map[string]*infrav1beta2.ResourceStatus{
+				"us-south-1": {ID: "foo-pgw-id", ControllerCreated: ptr.To(false)},
+			},
+		}
+	}
+
+	t.Run("Should skip when the network is not reconciled", func(t *testing.T) {
+		g := NewWithT(t)
+		scope, _, _ := setupVPCClusterScope(t, resourceSelector)
+		g.Expect(scope.ReconcileTags()).To(Succeed())
+	})
+
+	t.Run("Should attach the missing tags and replace the tags with the key of a user tag", func(t *testing.T) {
+		g := NewWithT(t)
+		scope, mockVPC, mockGT := setupVPCClusterScope(t, resourceSelector)
+		scope.IBMVPCCluster.Spec.Tags = &infrav1beta2.ResourceTags{UserTags: []string{"env:prod"}}
+		scope.IBMVPCCluster.Status.Network = networkStatus()
+		mockVPC.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{CRN: ptr.To("foo-vpc-crn")}, nil, nil)
+		mockGT.EXPECT().GetAttachedTags("foo-vpc-crn").Return([]string{"foo-cluster", "team:infra", "env:dev", "cost-center:1234"}, nil)
+		mockGT.EXPECT().GetTagByName("env:prod").Return(nil, nil)
+		mockGT.EXPECT().CreateTag(gomock.Any()).Return(nil, nil, nil)
+		mockGT.EXPECT().AttachTag(gomock.Any()).Return(nil, nil, nil)
+		mockGT.EXPECT().DetachTag(gomock.Any()).DoAndReturn(func(options *globaltaggingv1.DetachTagOptions) (*globaltaggingv1.TagResults, *core.DetailedResponse, error) {
+			g.Expect(options.TagNames).To(Equal([]string{"env:dev"}))
+			g.Expect(*options.Resources[0].ResourceID).To(Equal("foo-vpc-crn"))
+			return nil, nil, nil
+		})
+		g.Expect(scope.ReconcileTags()).To(Succeed())
+	})
+
+	t.Run("Should detach the tags attached by other systems when pruning", func(t *testing.T) {
+		g := NewWithT(t)
+		scope, mockVPC, mockGT := setupVPCClusterScope(t, resourceSelector)
+		scope.IBMVPCCluster.Spec.Tags = &infrav1beta2.ResourceTags{Prune: true}
+		scope.IBMVPCCluster.Status.Network = networkStatus()
+		mockVPC.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{CRN: ptr.To("foo-vpc-crn")}, nil, nil)
+		mockGT.EXPECT().GetAttachedTags("foo-vpc-crn").Return([]string{"foo-cluster", "team:infra", "cost-center:1234"}, nil)
+		mockGT.EXPECT().DetachTag(gomock.Any()).DoAndReturn(func(options *globaltaggingv1.DetachTagOptions) (*globaltaggingv1.TagResults, *core.DetailedResponse, error) {
+			g.Expect(options.TagNames).To(Equal([]string{"cost-center:1234"}))
+			return nil, nil, nil
+		})
+		g.Expect(scope.ReconcileTags()).To(Succeed())
+	})
+
+	t.Run("Should keep the name tags attached on creation when the names of the cluster and the IBMVPCCluster differ", func(t *testing.T) {
+		g := NewWithT(t)
+		scope, mockVPC, mockGT := setupVPCClusterScope(t, nil)
+		scope.Cluster.Name = "bar-cluster"
+		scope.IBMVPCCluster.Spec.Tags = &infrav1beta2.ResourceTags{Prune: true}
+		scope.IBMVPCCluster.Status.Network = &infrav1beta2.VPCNetworkStatus{
+			VPC: &infrav1beta2.ResourceStatus{ID: "foo-vpc-id", ControllerCreated: ptr.To(true)},
+			ControlPlaneSubnets: map[string]*infrav1beta2.ResourceStatus{
+				"foo-subnet": {ID: "foo-subnet-id", ControllerCreated: ptr.To(true)},
+			},
+			PublicGateways: map[string]*infrav1beta2.ResourceStatus{
+				"us-south-1": {ID: "foo-pgw-id", ControllerCreated: ptr.To(true)},
+			},
+			SecurityGroups: map[string]*infrav1beta2.ResourceStatus{
+				"foo-sg": {ID: "foo-sg-id", ControllerCreated: ptr.To(true)},
+			},
+		}
+		mockVPC.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{CRN: ptr.To("foo-vpc-crn")}, nil, nil)
+		mockVPC.EXPECT().GetSubnet(gomock.Any()).Return(&vpcv1.Subnet{CRN: ptr.To("foo-subnet-crn")}, nil, nil)
+		mockVPC.EXPECT().GetPublicGateway(gomock.Any()).Return(&vpcv1.PublicGateway{CRN: ptr.To("foo-pgw-crn")}, nil, nil)
+		mockVPC.EXPECT().GetSecurityGroup(gomock.Any()).Return(&vpcv1.SecurityGroup{CRN: ptr.To("foo-sg-crn")}, nil, nil)
+		mockGT.EXPECT().GetAttachedTags("foo-vpc-crn").Return([]string{"bar-cluster"}, nil)
+		mockGT.EXPECT().GetAttachedTags("foo-subnet-crn").Return([]string{"foo-cluster"}, nil)
+		mockGT.EXPECT().GetAttachedTags("foo-pgw-crn").Return([]string{"foo-cluster"}, nil)
+		mockGT.EXPECT().GetAttachedTags("foo-sg-crn").Return([]string{"foo-cluster"}, nil)
+		g.Expect(scope.ReconcileTags()).To(Succeed())
+	})
+
+	t.Run("Should fail when the vpc can't be fetched", func(t *testing.T) {
+		g := NewWithT(t)
+		scope, mockVPC, _ := setupVPCClusterScope(t, resourceSelector)
+		scope.IBMVPCCluster.Status.Network = networkStatus()
+		mockVPC.EXPECT().GetVPC(gomock.Any()).Return(nil, nil, errors.New("failed to get vpc"))
+		g.Expect(scope.ReconcileTags()).ToNot(Succeed())
+	})
+
+	t.Run("Should fail when the tags can't be detached", func(t *testing.T) {
+		g := NewWithT(t)
+		scope, mockVPC, mockGT := setupVPCClusterScope(t, nil)
+		scope.IBMVPCCluster.Status.Network = networkStatus()
+		mockVPC.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{CRN: ptr.To("foo-vpc-crn")}, nil, nil)
+		mockGT.EXPECT().GetAttachedTags("foo-vpc-crn").Return([]string{"foo-cluster", "capibm-cluster:default_bar"}, nil)
+		mockGT.EXPECT().DetachTag(gomock.Any()).Return(nil, nil, errors.New("failed to detach tags"))
+		g.Expect(scope.ReconcileTags()).To(MatchError(ContainSubstring("capibm-cluster:default_bar")))
+	})
+}
+
+func TestVPCClusterReconcileLoadBalancerPoolMembers(t *testing.T) {
+	setup := func(t *testing.T, objects ...client.Object) (*VPCClusterScope, *vpcmock.MockVpc) {
+		t.Helper()
+		scope, mockVPC, _ := setupVPCClusterScope(t, nil)
+		scope.Client = fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(objects...).Build()
+		scope.IBMVPCCluster.Status.Network = &infrav1beta2.VPCNetworkStatus{
+			LoadBalancers: map[string]*infrav1beta2.VPCLoadBalancerStatus{
+				"foo-load-balancer": {ID: ptr.To("foo-load-balancer-id")},
+			},
+		}
+		return scope, mockVPC
+	}
+	loadBalancer := &vpcv1.LoadBalancer{
+		ID:                 ptr.To("foo-load-balancer-id"),
+		ProvisioningStatus: ptr.To(string(infrav1beta2.VPCLoadBalancerStateActive)),
+		Pools:              []vpcv1.LoadBalancerPoolReference{{ID: ptr.To("foo-pool-id"), Name: ptr.To("foo-pool")}},
+	}
+	_, runningIBM := newControlPlaneMachine("foo-machine-1", "10.0.0.1", vpcv1.InstanceStatusRunningConst, false)
+
+	t.Run("Should not list the pools without stale members", func(t *testing.T) {
+		g := NewWithT(t)
+		scope, _ := setup(t, runningIBM)
+		requeue, err := scope.ReconcileLoadBalancerPoolMembers()
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeFalse())
+		g.Expect(scope.IBMVPCCluster.Status.LoadBalancerPoolMembers).To(Equal([]string{"10.0.0.1"}))
+	})
+
+	t.Run("Should remove the registered members of machines which no longer exist", func(t *testing.T) {
+		g := NewWithT(t)
+		scope, mockVPC := setup(t, runningIBM)
+		scope.IBMVPCCluster.Status.LoadBalancerPoolMembers = []string{"10.0.0.1", "10.0.0.2"}
+		mockVPC.EXPECT().GetLoadBalancer(gomock.AssignableToTypeOf(&vpcv1.GetLoadBalancerOptions{})).Return(loadBalancer, &core.DetailedResponse{}, nil)
+		mockVPC.EXPECT().ListLoadBalancerPoolMembers(gomock.AssignableToTypeOf(&vpcv1.ListLoadBalancerPoolMembersOptions{})).Return(&vpcv1.LoadBalancerPoolMemberCollection{
+			Members: []vpcv1.LoadBalancerPoolMember{
+				newLoadBalancerPoolMember("foo-member-1", "10.0.0.1", 6443),
+				newLoadBalancerPoolMember("foo-member-3", "192.168.0.1", 6443),
+				newLoadBalancerPoolMember("foo-member-2", "10.0.0.2", 6443),
+			},
+		}, &core.DetailedResponse{}, nil)
+		mockVPC.EXPECT().DeleteLoadBalancerPoolMember(gomock.AssignableToTypeOf(&vpcv1.DeleteLoadBalancerPoolMemberOptions{})).DoAndReturn(func(options *vpcv1.DeleteLoadBalancerPoolMemberOptions) (*core.DetailedResponse, error) {
+			g.Expect(*options.ID).To(Equal("foo-member-2"))
+			return &core.DetailedResponse{}, nil
+		})
+		requeue, err := scope.ReconcileLoadBalancerPoolMembers()
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
+	})
+
+	t.Run("Should forget the registered members which are no longer in the pools", func(t *testing.T) {
+		g := NewWithT(t)
+		scope, mockVPC := setup(t, runningIBM)
+		scope.IBMVPCCluster.Status.LoadBalancerPoolMembers = []string{"10.0.0.1", "10.0.0.2"}
+		mockVPC.EXPECT().GetLoadBalancer(gomock.AssignableToTypeOf(&vpcv1.GetLoadBalancerOptions{})).Return(loadBalancer, &core.DetailedResponse{}, nil)
+		mockVPC.EXPECT().ListLoadBalancerPoolMembers(gomock.AssignableToTypeOf(&vpcv1.ListLoadBalancerPoolMembersOptions{})).Return(&vpcv1.LoadBalancerPoolMemberCollection{
+			Members: []vpcv1.LoadBalancerPoolMember{newLoadBalancerPoolMember("foo-member-1", "10.0.0.1", 6443)},
+		}, &core.DetailedResponse{}, nil)
+		requeue, err := scope.ReconcileLoadBalancerPoolMembers()
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeFalse())
+		g.Expect(scope.IBMVPCCluster.Status.LoadBalancerPoolMembers).To(Equal([]string{"10.0.0.1"}))
+	})
+
+	t.Run("Should return error when the pool members can't be listed", func(t *testing.T) {
+		g := NewWithT(t)
+		scope, mockVPC := setup(t, runningIBM)
+		scope.IBMVPCCluster.Status.LoadBalancerPoolMembers = []string{"10.0.0.2"}
+		mockVPC.EXPECT().GetLoadBalancer(gomock.AssignableToTypeOf(&vpcv1.GetLoadBalancerOptions{})).Return(loadBalancer, &core.DetailedResponse{}, nil)
+		mockVPC.EXPECT().ListLoadBalancerPoolMembers(gomock.AssignableToTypeOf(&vpcv1.ListLoadBalancerPoolMembersOptions{})).Return(nil, &core.DetailedResponse{}, errors.New("failed to list members"))
+		_, err := scope.ReconcileLoadBalancerPoolMembers()
+		g.Expect(err).ToNot(BeNil())
+	})
+}
+
+func TestVPCClusterCheckLoadBalancerMembers(t *testing.T) {
+	loadBalancer := &vpcv1.LoadBalancer{
+		ID:    ptr.To("foo-load-balancer-id"),
+		Pools: []vpcv1.LoadBalancerPoolReference{{ID: ptr.To("foo-pool-id"), Name: ptr.To("foo-pool")}},
+	}
+	member := func(address, health string) vpcv1.LoadBalancerPoolMember {
+		m := newLoadBalancerPoolMember("foo-member", address, 6443)
+		m.Health = ptr.To(health)
+		return m
+	}
+	testCases := []struct {
+		name    string
+		members []vpcv1.LoadBalancerPoolMember
+		wantErr string
+	}{
+		{
+			name:    "Should pass when all the members are healthy",
+			members: []vpcv1.LoadBalancerPoolMember{member("10.0.0.1", vpcv1.LoadBalancerPoolMemberHealthOkConst)},
+		},
+		{
+			name:    "Should report the members which are not healthy",
+			members: []vpcv1.LoadBalancerPoolMember{member("10.0.0.1", vpcv1.LoadBalancerPoolMemberHealthOkConst), member("10.0.0.2", vpcv1.LoadBalancerPoolMemberHealthFaultedConst)},
+			wantErr: "member 10.0.0.2 of pool foo-pool of load balancer foo-load-balancer is faulted",
+		},
+		{
+			name:    "Should report the pools without members",
+			wantErr: "pool foo-pool of load balancer foo-load-balancer has no members",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			scope, mockVPC, _ := setupVPCClusterScope(t, nil)
+			scope.IBMVPCCluster.Status.Network = &infrav1beta2.VPCNetworkStatus{
+				LoadBalancers: map[string]*infrav1beta2.VPCLoadBalancerStatus{
+					"foo-load-balancer": {ID: ptr.To("foo-load-balancer-id")},
+				},
+			}
+			mockVPC.EXPECT().GetLoadBalancer(gomock.AssignableToTypeOf(&vpcv1.GetLoadBalancerOptions{})).Return(loadBalancer, &core.DetailedResponse{}, nil)
+			mockVPC.EXPECT().ListLoadBalancerPoolMembers(gomock.AssignableToTypeOf(&vpcv1.ListLoadBalancerPoolMembersOptions{})).Return(&vpcv1.LoadBalancerPoolMemberCollection{Members: tc.members}, &core.DetailedResponse{}, nil)
+			err := scope.CheckLoadBalancerMembers()
+			if tc.wantErr == "" {
+				g.Expect(err).To(BeNil())
+				return
+			}
+			g.Expect(err).To(MatchError(tc.wantErr))
+		})
+	}
+}
@@ -0,0 +1,33 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// Commands initialises and returns cluster command.
+func Commands() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cluster",
+		Short: "Commands for operations on the clusters of the management cluster",
+	}
+
+	cmd.AddCommand(ExportCommand())
+
+	return cmd
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	capiv1beta1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	logf "sigs.k8s.io/cluster-api/cmd/clusterctl/log"
+
+	infrav1beta2 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/blueprint"
+)
+
+type exportOptions struct {
+	name       string
+	namespace  string
+	kubeconfig string
+}
+
+// ExportCommand cluster export command.
+func ExportCommand() *cobra.Command {
+	opts := exportOptions{}
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export the blueprint of a provisioned cluster",
+		Long: `Export the specs of the Cluster, its infrastructure cluster, machine templates, images and networks as YAML.
+The infrastructure of the cluster is referenced by the IDs reported in the status, so that the exported cluster reuses it.`,
+		Example: `
+# Export the blueprint of a cluster
+capibmadm cluster export --name <cluster-name> --namespace <namespace> > blueprint.yaml`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return exportCluster(cmd.Context(), opts, os.Stdout)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.name, "name", "", "Name of the Cluster (Required)")
+	cmd.Flags().StringVar(&opts.namespace, "namespace", "default", "Namespace of the Cluster")
+	cmd.Flags().StringVar(&opts.kubeconfig, "kubeconfig", "", "Path of the kubeconfig of the management cluster, defaults to the KUBECONFIG environment variable or ~/.kube/config")
+	_ = cmd.MarkFlagRequired("name")
+
+	return cmd
+}
+
+func exportCluster(ctx context.Context, opts exportOptions, out io.Writer) error {
+	log := logf.Log
+	log.Info("Exporting cluster", "name", opts.name, "namespace", opts.namespace)
+
+	loadingRules := clientcmd.NewDefaultClientConfigLoadingRules()
+	loadingRules.ExplicitPath = opts.kubeconfig
+	config, err := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loadingRules, &clientcmd.ConfigOverrides{}).ClientConfig()
+	if err != nil {
+		return fmt.Errorf("failed to load kubeconfig: %w", err)
+	}
+
+	scheme := runtime.NewScheme()
+	if err := capiv1beta1.AddToScheme(scheme); err != nil {
+		return err
+	}
+	if err := infrav1beta2.AddToScheme(scheme); err != nil {
+		return err
+	}
+	c, err := client.New(config, client.Options{Scheme: scheme})
+	if err != nil {
+		return fmt.Errorf("failed to create client: %w", err)
+	}
+
+	objects, err := blueprint.NewExporter(c).Export(ctx, client.ObjectKey{Namespace: opts.namespace, Name: opts.name})
+	if err != nil {
+		return err
+	}
+	return blueprint.Write(out, objects)
+}
@@ -28,6 +28,7 @@ import (
 
 	logf "sigs.k8s.io/cluster-api/cmd/clusterctl/log"
 
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/cmd/cluster"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/cmd/powervs"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/cmd/version"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/cmd/vpc"
@@ -42,10 +43,11 @@ func rootCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "capibmadm",
 		Short: "Kubernetes Cluster API Provider IBM Cloud Management Utility",
-		Long:  `capibmadm provides helpers for completing the prerequisite operations for creating IBM Cloud Power VS or VPC clusters.`,
+		Long:  `capibmadm provides helpers for completing the prerequisite operations for creating IBM Cloud Power VS or VPC clusters, and for operating on the provisioned clusters.`,
 	}
 
 	cmd.PersistentFlags().AddGoFlagSet(flag.CommandLine)
+	cmd.AddCommand(cluster.Commands())
 	cmd.AddCommand(powervs.Commands())
 	cmd.AddCommand(vpc.Commands())
 	cmd.AddCommand(version.Commands(os.Stdout))
@@ -0,0 +1,153 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	capiv1beta1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+
+	infrav1beta2 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/connectivity"
+	capibmrecord "sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/record"
+)
+
+// defaultConnectivityRetryInterval is the interval at which the connectivity self-test is run again while a probe fails.
+const defaultConnectivityRetryInterval = time.Minute
+
+// connectivityConditions are the conditions reported by the connectivity self-test of a cluster.
+var connectivityConditions = []capiv1beta1.ConditionType{
+	infrav1beta2.ControlPlaneEndpointResolvedCondition,
+	infrav1beta2.ControlPlaneEndpointReachableCondition,
+	infrav1beta2.TransitGatewayRoutesReadyCondition,
+	infrav1beta2.LoadBalancerMembersHealthyCondition,
+}
+
+// connectivitySelfTest is the connectivity self-test of a cluster run from the management cluster once
+// its infrastructure is ready.
+type connectivitySelfTest struct {
+	prober   connectivity.Prober
+	cluster  conditions.Setter
+	check    *infrav1beta2.ConnectivityCheck
+	ready    bool
+	endpoint capiv1beta1.APIEndpoint
+	// transitGatewayRoutes checks the routes of the transit gateway of Power VS clusters, nil for the other clusters.
+	transitGatewayRoutes func() error
+	// loadBalancerMembers checks the health of the members of the load balancers of the cluster, nil when the
+	// cluster has no load balancer managed by the controller.
+	loadBalancerMembers func() error
+}
+
+// reconcile runs the self-test until all its probes passed and reports the results in the conditions of the cluster,
+// the result of the reconciliation of the cluster is returned with a requeue after the retry interval while a probe fails.
+// The probes of the endpoint are bound by twice the timeout of the check so that the reconciliation is not held up.
+func (t connectivitySelfTest) reconcile(ctx context.Context, result ctrl.Result) ctrl.Result {
+	if t.check == nil {
+		for _, condition := range connectivityConditions {
+			conditions.Delete(t.cluster, condition)
+		}
+		return result
+	}
+	if !t.ready || t.passed() {
+		return result
+	}
+
+	prober := t.prober
+	if prober == nil {
+		prober = connectivity.NewProber()
+	}
+	timeout := connectivity.DefaultTimeout
+	if t.check.Timeout != nil {
+		timeout = t.check.Timeout.Duration
+	}
+	if timeout <= 0 {
+		timeout = connectivity.DefaultTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, 2*timeout)
+	defer cancel()
+
+	// Only the probes which started failing are reported in the event, the others are reported by their conditions.
+	failed, newFailures := false, []string{}
+	markFailed := func(condition capiv1beta1.ConditionType, err error) {
+		if !conditions.IsFalse(t.cluster, condition) || conditions.GetReason(t.cluster, condition) != infrav1beta2.ConnectivityCheckFailedReason {
+			newFailures = append(newFailures, err.Error())
+		}
+		conditions.MarkFalse(t.cluster, condition, infrav1beta2.ConnectivityCheckFailedReason, capiv1beta1.ConditionSeverityWarning, "%s", err.Error())
+		failed = true
+	}
+
+	probe := connectivity.CheckEndpoint(ctx, prober, t.endpoint.Host, t.endpoint.Port, timeout)
+	switch {
+	case probe.ResolveError != nil:
+		markFailed(infrav1beta2.ControlPlaneEndpointResolvedCondition, probe.ResolveError)
+		conditions.MarkFalse(t.cluster, infrav1beta2.ControlPlaneEndpointReachableCondition, infrav1beta2.ConnectivityCheckSkippedReason, capiv1beta1.ConditionSeverityInfo, "Host of the control plane endpoint could not be resolved")
+	case probe.DialError != nil:
+		conditions.MarkTrue(t.cluster, infrav1beta2.ControlPlaneEndpointResolvedCondition)
+		markFailed(infrav1beta2.ControlPlaneEndpointReachableCondition, probe.DialError)
+	default:
+		conditions.MarkTrue(t.cluster, infrav1beta2.ControlPlaneEndpointResolvedCondition)
+		conditions.MarkTrue(t.cluster, infrav1beta2.ControlPlaneEndpointReachableCondition)
+	}
+
+	if t.transitGatewayRoutes != nil {
+		if err := t.transitGatewayRoutes(); err != nil {
+			markFailed(infrav1beta2.TransitGatewayRoutesReadyCondition, err)
+		} else {
+			conditions.MarkTrue(t.cluster, infrav1beta2.TransitGatewayRoutesReadyCondition)
+		}
+	}
+
+	if t.loadBalancerMembers != nil {
+		if err := t.loadBalancerMembers(); err != nil {
+			markFailed(infrav1beta2.LoadBalancerMembersHealthyCondition, err)
+		} else {
+			conditions.MarkTrue(t.cluster, infrav1beta2.LoadBalancerMembersHealthyCondition)
+		}
+	}
+
+	if !failed {
+		return result
+	}
+	if len(newFailures) != 0 {
+		capibmrecord.Warnf(t.cluster, "FailedConnectivityCheck", "Connectivity check of the cluster failed - %s", strings.Join(newFailures, "; "))
+	}
+	retry := defaultConnectivityRetryInterval
+	if t.check.RetryInterval != nil {
+		retry = t.check.RetryInterval.Duration
+	}
+	if result.RequeueAfter == 0 || result.RequeueAfter > retry {
+		result.RequeueAfter = retry
+	}
+	return result
+}
+
+// passed returns true once all the probes of the self-test passed.
+func (t connectivitySelfTest) passed() bool {
+	if !conditions.IsTrue(t.cluster, infrav1beta2.ControlPlaneEndpointResolvedCondition) ||
+		!conditions.IsTrue(t.cluster, infrav1beta2.ControlPlaneEndpointReachableCondition) {
+		return false
+	}
+	if t.transitGatewayRoutes != nil && !conditions.IsTrue(t.cluster, infrav1beta2.TransitGatewayRoutesReadyCondition) {
+		return false
+	}
+	return t.loadBalancerMembers == nil || conditions.IsTrue(t.cluster, infrav1beta2.LoadBalancerMembersHealthyCondition)
+}
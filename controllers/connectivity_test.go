@@ -0,0 +1,154 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	capiv1beta1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+
+	infrav1beta2 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/v1beta2"
+
+	. "github.com/onsi/gomega"
+)
+
+type fakeProber struct {
+	addresses []string
+	resolved  error
+	dialed    error
+	// block blocks the dials until their context is done.
+	block bool
+}
+
+func (p *fakeProber) LookupHost(_ context.Context, _ string) ([]string, error) {
+	return p.addresses, p.resolved
+}
+
+func (p *fakeProber) Dial(ctx context.Context, _ string) error {
+	if p.block {
+		<-ctx.Done()
+		return ctx.Err()
+	}
+	return p.dialed
+}
+
+func TestConnectivitySelfTest(t *testing.T) {
+	newTest := func(prober *fakeProber, transitGatewayRoutes func() error) connectivitySelfTest {
+		return connectivitySelfTest{
+			prober:               prober,
+			cluster:              &infrav1beta2.IBMPowerVSCluster{},
+			check:                &infrav1beta2.ConnectivityCheck{},
+			ready:                true,
+			endpoint:             capiv1beta1.APIEndpoint{Host: "lb.example.com", Port: 6443},
+			transitGatewayRoutes: transitGatewayRoutes,
+		}
+	}
+
+	t.Run("Should report the passed probes and keep the result", func(t *testing.T) {
+		g := NewWithT(t)
+		test := newTest(&fakeProber{addresses: []string{"10.0.0.1"}}, func() error { return nil })
+		g.Expect(test.reconcile(context.Background(), ctrl.Result{})).To(Equal(ctrl.Result{}))
+		g.Expect(conditions.IsTrue(test.cluster, infrav1beta2.ControlPlaneEndpointResolvedCondition)).To(BeTrue())
+		g.Expect(conditions.IsTrue(test.cluster, infrav1beta2.ControlPlaneEndpointReachableCondition)).To(BeTrue())
+		g.Expect(conditions.IsTrue(test.cluster, infrav1beta2.TransitGatewayRoutesReadyCondition)).To(BeTrue())
+	})
+
+	t.Run("Should skip the connection when the host can't be resolved and retry", func(t *testing.T) {
+		g := NewWithT(t)
+		test := newTest(&fakeProber{resolved: errors.New("no such host")}, nil)
+		g.Expect(test.reconcile(context.Background(), ctrl.Result{})).To(Equal(ctrl.Result{RequeueAfter: defaultConnectivityRetryInterval}))
+		g.Expect(conditions.GetReason(test.cluster, infrav1beta2.ControlPlaneEndpointResolvedCondition)).To(Equal(infrav1beta2.ConnectivityCheckFailedReason))
+		g.Expect(conditions.GetReason(test.cluster, infrav1beta2.ControlPlaneEndpointReachableCondition)).To(Equal(infrav1beta2.ConnectivityCheckSkippedReason))
+		g.Expect(conditions.Has(test.cluster, infrav1beta2.TransitGatewayRoutesReadyCondition)).To(BeFalse())
+	})
+
+	t.Run("Should retry after the retry interval when the endpoint is unreachable", func(t *testing.T) {
+		g := NewWithT(t)
+		test := newTest(&fakeProber{addresses: []string{"10.0.0.1"}, dialed: errors.New("connection refused")}, nil)
+		test.check.RetryInterval = &metav1.Duration{Duration: 10 * time.Second}
+		g.Expect(test.reconcile(context.Background(), ctrl.Result{RequeueAfter: time.Minute})).To(Equal(ctrl.Result{RequeueAfter: 10 * time.Second}))
+		g.Expect(conditions.IsTrue(test.cluster, infrav1beta2.ControlPlaneEndpointResolvedCondition)).To(BeTrue())
+		g.Expect(conditions.GetMessage(test.cluster, infrav1beta2.ControlPlaneEndpointReachableCondition)).To(ContainSubstring("connection refused"))
+	})
+
+	t.Run("Should report the transit gateway routes which are not ready", func(t *testing.T) {
+		g := NewWithT(t)
+		test := newTest(&fakeProber{addresses: []string{"10.0.0.1"}}, func() error { return errors.New("no vpc connection is attached") })
+		g.Expect(test.reconcile(context.Background(), ctrl.Result{RequeueAfter: time.Second})).To(Equal(ctrl.Result{RequeueAfter: time.Second}))
+		g.Expect(conditions.IsFalse(test.cluster, infrav1beta2.TransitGatewayRoutesReadyCondition)).To(BeTrue())
+	})
+
+	t.Run("Should report the load balancer members which are not healthy until they are healthy", func(t *testing.T) {
+		g := NewWithT(t)
+		test := newTest(&fakeProber{addresses: []string{"10.0.0.1"}}, nil)
+		test.loadBalancerMembers = func() error {
+			return errors.New("member 10.0.0.5 of pool capi-pool of load balancer capi-lb is faulted")
+		}
+		g.Expect(test.reconcile(context.Background(), ctrl.Result{})).To(Equal(ctrl.Result{RequeueAfter: defaultConnectivityRetryInterval}))
+		g.Expect(conditions.GetMessage(test.cluster, infrav1beta2.LoadBalancerMembersHealthyCondition)).To(ContainSubstring("faulted"))
+		g.Expect(test.passed()).To(BeFalse())
+		test.loadBalancerMembers = func() error { return nil }
+		g.Expect(test.reconcile(context.Background(), ctrl.Result{})).To(Equal(ctrl.Result{}))
+		g.Expect(conditions.IsTrue(test.cluster, infrav1beta2.LoadBalancerMembersHealthyCondition)).To(BeTrue())
+		g.Expect(test.passed()).To(BeTrue())
+	})
+
+	t.Run("Should bound the probes by twice the timeout", func(t *testing.T) {
+		g := NewWithT(t)
+		test := newTest(&fakeProber{addresses: []string{"10.0.0.1"}, block: true}, nil)
+		test.check.Timeout = &metav1.Duration{Duration: 10 * time.Millisecond}
+		start := time.Now()
+		test.reconcile(context.Background(), ctrl.Result{})
+		g.Expect(time.Since(start)).To(BeNumerically("<", time.Second))
+		g.Expect(conditions.IsFalse(test.cluster, infrav1beta2.ControlPlaneEndpointReachableCondition)).To(BeTrue())
+	})
+
+	t.Run("Should not run the probes again once they passed", func(t *testing.T) {
+		g := NewWithT(t)
+		test := newTest(&fakeProber{addresses: []string{"10.0.0.1"}}, nil)
+		test.reconcile(context.Background(), ctrl.Result{})
+		test.prober = &fakeProber{resolved: errors.New("no such host")}
+		g.Expect(test.reconcile(context.Background(), ctrl.Result{})).To(Equal(ctrl.Result{}))
+		g.Expect(conditions.IsTrue(test.cluster, infrav1beta2.ControlPlaneEndpointResolvedCondition)).To(BeTrue())
+	})
+
+	t.Run("Should not run the probes before the cluster is ready", func(t *testing.T) {
+		g := NewWithT(t)
+		test := newTest(&fakeProber{resolved: errors.New("no such host")}, nil)
+		test.ready = false
+		g.Expect(test.reconcile(context.Background(), ctrl.Result{})).To(Equal(ctrl.Result{}))
+		g.Expect(conditions.Has(test.cluster, infrav1beta2.ControlPlaneEndpointResolvedCondition)).To(BeFalse())
+	})
+
+	t.Run("Should remove the conditions when the check is disabled", func(t *testing.T) {
+		g := NewWithT(t)
+		test := newTest(&fakeProber{addresses: []string{"10.0.0.1"}}, func() error { return nil })
+		test.reconcile(context.Background(), ctrl.Result{})
+		test.check = nil
+		test.reconcile(context.Background(), ctrl.Result{})
+		for _, condition := range connectivityConditions {
+			g.Expect(conditions.Has(test.cluster, condition)).To(BeFalse())
+		}
+	})
+}
@@ -42,13 +42,20 @@ import (
 
 	capiv1beta1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	"sigs.k8s.io/cluster-api/util"
+	"sigs.k8s.io/cluster-api/util/annotations"
 	"sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/cluster-api/util/patch"
 	"sigs.k8s.io/cluster-api/util/predicates"
 
 	infrav1beta2 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/v1beta2"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/cloud/scope"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/powervs"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/utils"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloudevents"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/connectivity"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/endpoints"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/metrics"
+	capibmrecord "sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/record"
 )
 
 // IBMPowerVSClusterReconciler reconciles a IBMPowerVSCluster object.
@@ -59,10 +66,18 @@ type IBMPowerVSClusterReconciler struct {
 	Scheme          *runtime.Scheme
 
 	ClientFactory scope.ClientFactory
+	// Intervals are the intervals at which IBMPowerVSClusters are reconciled again.
+	Intervals Intervals
+	// Prober probes the control plane endpoints of the clusters enabling the connectivity self-test.
+	Prober connectivity.Prober
 }
 
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=ibmpowervsclusters,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=ibmpowervsclusters/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=clusters,verbs=get;list;watch;patch
+// +kubebuilder:rbac:groups=addons.cluster.x-k8s.io,resources=clusterresourcesets,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
 
 // Reconcile implements controller runtime Reconciler interface and handles reconcileation logic for IBMPowerVSCluster.
 func (r *IBMPowerVSClusterReconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Result, reterr error) {
@@ -78,6 +93,16 @@ func (r *IBMPowerVSClusterReconciler) Reconcile(ctx context.Context, req ctrl.Re
 		return ctrl.Result{}, err
 	}
 
+	start := time.Now()
+	defer func() {
+		metrics.ObserveReconcile("IBMPowerVSCluster", ibmCluster, start, reterr)
+	}()
+
+	// The infrastructure of externally managed clusters is provisioned outside of Cluster API.
+	if annotations.IsExternallyManaged(ibmCluster) {
+		return r.reconcileExternallyManaged(ctx, ibmCluster)
+	}
+
 	// Fetch the Cluster.
 	cluster, err := util.GetOwnerCluster(ctx, r.Client, ibmCluster.ObjectMeta)
 	if err != nil {
@@ -89,6 +114,12 @@ func (r *IBMPowerVSClusterReconciler) Reconcile(ctx context.Context, req ctrl.Re
 	}
 	log = log.WithValues("cluster", klog.KObj(cluster))
 
+	// Skip the reconciliation while the Cluster is paused, e.g. during clusterctl move.
+	if annotations.IsPaused(cluster, ibmCluster) {
+		log.Info("IBMPowerVSCluster or linked Cluster is marked as paused, not reconciling")
+		return ctrl.Result{}, nil
+	}
+
 	// Create the scope.
 	clusterScope, err := scope.NewPowerVSClusterScope(scope.PowerVSClusterScopeParams{
 		Client:            r.Client,
@@ -104,10 +135,14 @@ func (r *IBMPowerVSClusterReconciler) Reconcile(ctx context.Context, req ctrl.Re
 	}
 
 	// Always close the scope when exiting this function so we can persist any IBMPowerVSCluster changes.
+	wasReady := ibmCluster.Status.Ready
 	defer func() {
 		if err := clusterScope.Close(); err != nil && reterr == nil {
 			reterr = err
 		}
+		if reterr == nil && !wasReady && ibmCluster.Status.Ready {
+			cloudevents.Publish(ibmCluster, cloudevents.ClusterProvisioned, "cluster infrastructure is ready")
+		}
 	}()
 
 	// Handle deleted clusters.
@@ -115,7 +150,60 @@ func (r *IBMPowerVSClusterReconciler) Reconcile(ctx context.Context, req ctrl.Re
 		return r.reconcileDelete(ctx, clusterScope)
 	}
 
-	return r.reconcile(clusterScope)
+	result, err := r.reconcile(clusterScope)
+	if err == nil {
+		result = r.connectivitySelfTest(clusterScope).reconcile(ctx, result)
+	}
+	return r.Intervals.withOverrides(clusterIntervals(ibmCluster.Spec.ReconcileIntervals)).apply(result, err)
+}
+
+// connectivitySelfTest returns the connectivity self-test of the cluster, checking the routes of its transit gateway
+// and the members of its load balancers when the cluster has them.
+func (r *IBMPowerVSClusterReconciler) connectivitySelfTest(clusterScope *scope.PowerVSClusterScope) connectivitySelfTest {
+	test := connectivitySelfTest{
+		prober:   r.Prober,
+		cluster:  clusterScope.IBMPowerVSCluster,
+		check:    clusterScope.IBMPowerVSCluster.Spec.ConnectivityCheck,
+		ready:    clusterScope.IBMPowerVSCluster.Status.Ready,
+		endpoint: clusterScope.IBMPowerVSCluster.Spec.ControlPlaneEndpoint,
+	}
+	if clusterScope.GetTransitGatewayID() != nil {
+		test.transitGatewayRoutes = clusterScope.CheckTransitGatewayRoutes
+	}
+	if clusterScope.IBMVPCClient != nil && len(clusterScope.IBMPowerVSCluster.Status.LoadBalancers) != 0 {
+		test.loadBalancerMembers = clusterScope.CheckLoadBalancerMembers
+	}
+	return test
+}
+
+// reconcileExternallyManaged skips the infrastructure reconciliation of an externally managed IBMPowerVSCluster,
+// the cluster is reported ready as soon as the control plane endpoint is set in its spec.
+func (r *IBMPowerVSClusterReconciler) reconcileExternallyManaged(ctx context.Context, ibmCluster *infrav1beta2.IBMPowerVSCluster) (_ ctrl.Result, reterr error) {
+	log := ctrl.LoggerFrom(ctx)
+
+	patchHelper, err := patch.NewHelper(ibmCluster, r.Client)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to init patch helper: %w", err)
+	}
+	defer func() {
+		if err := patchHelper.Patch(ctx, ibmCluster); err != nil && reterr == nil {
+			reterr = err
+		}
+	}()
+
+	if !ibmCluster.DeletionTimestamp.IsZero() {
+		log.Info("Removing finalizer of externally managed IBMPowerVSCluster without deleting its infrastructure")
+		controllerutil.RemoveFinalizer(ibmCluster, infrav1beta2.IBMPowerVSClusterFinalizer)
+		return ctrl.Result{}, nil
+	}
+
+	if !ibmCluster.Spec.ControlPlaneEndpoint.IsValid() {
+		log.Info("Waiting for the control plane endpoint of externally managed IBMPowerVSCluster to be set")
+		ibmCluster.Status.Ready = false
+		return ctrl.Result{}, nil
+	}
+	ibmCluster.Status.Ready = true
+	return ctrl.Result{}, nil
 }
 
 type powerVSCluster struct {
@@ -128,6 +216,38 @@ type reconcileResult struct {
 	error
 }
 
+// degradedComponents tracks the optional components of the cluster, like the transit gateway and the COS instance,
+// whose service could not be reached during the reconciliation.
+type degradedComponents struct {
+	cluster  *infrav1beta2.IBMPowerVSCluster
+	degraded bool
+	blocking bool
+}
+
+// reconcile runs the reconciliation of an optional component reporting on condition. When the service of the component
+// can't be reached, the component is marked degraded and no error is returned, so the remaining components are still reconciled.
+// A degraded component which was never ready keeps the cluster from being ready.
+func (d *degradedComponents) reconcile(condition capiv1beta1.ConditionType, reconcileComponent func() (ctrl.Result, error)) (ctrl.Result, error) {
+	wasReady := conditions.IsTrue(d.cluster, condition)
+	result, err := reconcileComponent()
+	if err == nil || !utils.IsServiceUnreachable(err) {
+		return result, err
+	}
+	capibmrecord.Warnf(d.cluster, "ServiceUnreachable", "Service of %s is unreachable, reconciling the remaining components - %v", condition, err)
+	conditions.MarkFalse(d.cluster, condition, infrav1beta2.ServiceUnreachableReason, capiv1beta1.ConditionSeverityWarning, "%s", err.Error())
+	d.degraded = true
+	d.blocking = d.blocking || !wasReady
+	return ctrl.Result{}, nil
+}
+
+// result returns the result of a reconciliation which completed, the degraded components are retried shortly.
+func (d *degradedComponents) result() ctrl.Result {
+	if d.degraded {
+		return ctrl.Result{RequeueAfter: 1 * time.Minute}
+	}
+	return ctrl.Result{}
+}
+
 func (update *powerVSCluster) updateCondition(condition capiv1beta1.Condition) {
 	update.mu.Lock()
 	defer update.mu.Unlock()
@@ -282,11 +402,47 @@ func (r *IBMPowerVSClusterReconciler) reconcile(clusterScope *scope.PowerVSClust
 		return ctrl.Result{}, nil
 	}
 
+	clusterScope.SetFailureDomains()
+
+	degraded := &degradedComponents{cluster: clusterScope.IBMPowerVSCluster}
+
 	// check for annotation set for cluster resource and decide on proceeding with infra creation.
 	// do not proceed further if "powervs.cluster.x-k8s.io/create-infra=true" annotation is not set.
 	if !scope.CheckCreateInfraAnnotation(*clusterScope.IBMPowerVSCluster) {
+		if clusterScope.IBMPowerVSCluster.Spec.NetworkRef != nil {
+			if result, err := r.reconcileNetworkRef(clusterScope); err != nil || !result.IsZero() {
+				return result, err
+			}
+		}
+		if scope.CheckManageDHCPNetwork(*clusterScope.IBMPowerVSCluster) {
+			if result, err := r.reconcileManagedDHCPNetwork(clusterScope); err != nil || !result.IsZero() {
+				return result, err
+			}
+		}
+		if scope.CheckManageTransitGateway(*clusterScope.IBMPowerVSCluster) {
+			if result, err := degraded.reconcile(infrav1beta2.TransitGatewayReadyCondition, func() (ctrl.Result, error) {
+				return r.reconcileManagedTransitGateway(clusterScope)
+			}); err != nil || !result.IsZero() {
+				return result, err
+			}
+		}
+		if scope.CheckManageLoadBalancer(*clusterScope.IBMPowerVSCluster) {
+			if result, err := r.reconcileManagedLoadBalancer(clusterScope); err != nil || !result.IsZero() {
+				return result, err
+			}
+		}
+		if result, err := r.reconcileSSHKey(clusterScope); err != nil || !result.IsZero() {
+			return result, err
+		}
+		if err := r.reconcileAddons(clusterScope); err != nil {
+			return reconcile.Result{}, err
+		}
+		if degraded.blocking {
+			clusterScope.Info("Degraded components were never ready, requeuing")
+			return ctrl.Result{RequeueAfter: 1 * time.Minute}, nil
+		}
 		clusterScope.IBMPowerVSCluster.Status.Ready = true
-		return ctrl.Result{}, nil
+		return degraded.result(), nil
 	}
 
 	// validate PER availability for the PowerVS zone, proceed further only if PowerVS zone support PER.
@@ -345,25 +501,29 @@ func (r *IBMPowerVSClusterReconciler) reconcile(clusterScope *scope.PowerVSClust
 	}
 
 	// reconcile Transit Gateway
-	clusterScope.Info("Reconciling Transit Gateway")
-	if requeue, err := clusterScope.ReconcileTransitGateway(); err != nil {
-		clusterScope.Error(err, "failed to reconcile transit gateway")
-		conditions.MarkFalse(powerVSCluster.cluster, infrav1beta2.TransitGatewayReadyCondition, infrav1beta2.TransitGatewayReconciliationFailedReason, capiv1beta1.ConditionSeverityError, "%s", err.Error())
-		return reconcile.Result{}, err
-	} else if requeue {
-		clusterScope.Info("Setting up Transit gateway is pending, requeuing")
-		return reconcile.Result{RequeueAfter: 1 * time.Minute}, nil
+	if result, err := degraded.reconcile(infrav1beta2.TransitGatewayReadyCondition, func() (ctrl.Result, error) {
+		return r.reconcileTransitGateway(clusterScope)
+	}); err != nil || !result.IsZero() {
+		return result, err
 	}
-	conditions.MarkTrue(powerVSCluster.cluster, infrav1beta2.TransitGatewayReadyCondition)
 
 	// reconcile COSInstance
 	if clusterScope.IBMPowerVSCluster.Spec.Ignition != nil {
-		clusterScope.Info("Reconciling COS service instance")
-		if err := clusterScope.ReconcileCOSInstance(); err != nil {
-			conditions.MarkFalse(powerVSCluster.cluster, infrav1beta2.COSInstanceReadyCondition, infrav1beta2.COSInstanceReconciliationFailedReason, capiv1beta1.ConditionSeverityError, "%s", err.Error())
-			return reconcile.Result{}, err
+		if result, err := degraded.reconcile(infrav1beta2.COSInstanceReadyCondition, func() (ctrl.Result, error) {
+			return r.reconcileCOSInstance(clusterScope)
+		}); err != nil || !result.IsZero() {
+			return result, err
 		}
-		conditions.MarkTrue(powerVSCluster.cluster, infrav1beta2.COSInstanceReadyCondition)
+	}
+
+	// reconcile SSH key
+	if result, err := r.reconcileSSHKey(clusterScope); err != nil || !result.IsZero() {
+		return result, err
+	}
+
+	// reconcile cluster addons
+	if err := r.reconcileAddons(clusterScope); err != nil {
+		return reconcile.Result{}, err
 	}
 
 	var networkReady, loadBalancerReady bool
@@ -391,11 +551,277 @@ func (r *IBMPowerVSClusterReconciler) reconcile(clusterScope *scope.PowerVSClust
 		return reconcile.Result{RequeueAfter: time.Minute}, nil
 	}
 
+	if err := clusterScope.ReconcileDebugDump(); err != nil {
+		clusterScope.Error(err, "failed to reconcile debug dump of the load balancers")
+	}
+
+	if err := clusterScope.ReconcileTags(); err != nil {
+		clusterScope.Error(err, "failed to reconcile tags of the cluster resources")
+		capibmrecord.Warnf(clusterScope.IBMPowerVSCluster, "FailedReconcileTags", "Failed to reconcile tags of the cluster resources - %v", err)
+	}
+
+	endpointHost, err := r.reconcileDNSRecord(clusterScope, *hostName)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
 	// update cluster object with loadbalancer host name
-	clusterScope.IBMPowerVSCluster.Spec.ControlPlaneEndpoint.Host = *hostName
-	clusterScope.IBMPowerVSCluster.Spec.ControlPlaneEndpoint.Port = clusterScope.APIServerPort()
+	if err := r.reconcileControlPlaneEndpoint(clusterScope, endpointHost); err != nil {
+		return reconcile.Result{}, err
+	}
+	if degraded.blocking {
+		clusterScope.Info("Degraded components were never ready, requeuing")
+		return ctrl.Result{RequeueAfter: 1 * time.Minute}, nil
+	}
 	clusterScope.IBMPowerVSCluster.Status.Ready = true
-	return ctrl.Result{}, nil
+	return degraded.result(), nil
+}
+
+// reconcileTransitGateway reconciles the transit gateway connecting the Power VS workspace and the VPC.
+func (r *IBMPowerVSClusterReconciler) reconcileTransitGateway(clusterScope *scope.PowerVSClusterScope) (ctrl.Result, error) {
+	clusterScope.Info("Reconciling Transit Gateway")
+	if requeue, err := clusterScope.ReconcileTransitGateway(); err != nil {
+		clusterScope.Error(err, "failed to reconcile transit gateway")
+		conditions.MarkFalse(clusterScope.IBMPowerVSCluster, infrav1beta2.TransitGatewayReadyCondition, infrav1beta2.TransitGatewayReconciliationFailedReason, capiv1beta1.ConditionSeverityError, "%s", err.Error())
+		return reconcile.Result{}, err
+	} else if requeue {
+		clusterScope.Info("Setting up Transit gateway is pending, requeuing")
+		return reconcile.Result{RequeueAfter: 1 * time.Minute}, nil
+	}
+	conditions.MarkTrue(clusterScope.IBMPowerVSCluster, infrav1beta2.TransitGatewayReadyCondition)
+	return reconcile.Result{}, nil
+}
+
+// reconcileCOSInstance reconciles the COS instance storing the ignition of the machines.
+func (r *IBMPowerVSClusterReconciler) reconcileCOSInstance(clusterScope *scope.PowerVSClusterScope) (ctrl.Result, error) {
+	clusterScope.Info("Reconciling COS service instance")
+	if err := clusterScope.ReconcileCOSInstance(); err != nil {
+		conditions.MarkFalse(clusterScope.IBMPowerVSCluster, infrav1beta2.COSInstanceReadyCondition, infrav1beta2.COSInstanceReconciliationFailedReason, capiv1beta1.ConditionSeverityError, "%s", err.Error())
+		return reconcile.Result{}, err
+	}
+	conditions.MarkTrue(clusterScope.IBMPowerVSCluster, infrav1beta2.COSInstanceReadyCondition)
+	return reconcile.Result{}, nil
+}
+
+// reconcileSSHKey registers the SSH key set on the instances of the machines.
+func (r *IBMPowerVSClusterReconciler) reconcileSSHKey(clusterScope *scope.PowerVSClusterScope) (ctrl.Result, error) {
+	if clusterScope.IBMPowerVSCluster.Spec.SSHKey == nil {
+		return reconcile.Result{}, nil
+	}
+	clusterScope.Info("Reconciling SSH key")
+	if err := clusterScope.ReconcileSSHKey(); err != nil {
+		conditions.MarkFalse(clusterScope.IBMPowerVSCluster, infrav1beta2.SSHKeyReadyCondition, infrav1beta2.SSHKeyReconciliationFailedReason, capiv1beta1.ConditionSeverityError, "%s", err.Error())
+		return reconcile.Result{}, err
+	}
+	conditions.MarkTrue(clusterScope.IBMPowerVSCluster, infrav1beta2.SSHKeyReadyCondition)
+	return reconcile.Result{}, nil
+}
+
+// reconcileNetworkRef sets the network of the referenced IBMPowerVSNetwork as the cluster network when the create-infra annotation is not set.
+func (r *IBMPowerVSClusterReconciler) reconcileNetworkRef(clusterScope *scope.PowerVSClusterScope) (ctrl.Result, error) {
+	clusterScope.Info("Reconciling network reference")
+	ready, err := clusterScope.ReconcileNetworkRef()
+	if err != nil {
+		clusterScope.Error(err, "failed to reconcile IBMPowerVSNetwork reference")
+		conditions.MarkFalse(clusterScope.IBMPowerVSCluster, infrav1beta2.NetworkReadyCondition, infrav1beta2.NetworkReconciliationFailedReason, capiv1beta1.ConditionSeverityError, "%s", err.Error())
+		return reconcile.Result{}, err
+	}
+	if !ready {
+		clusterScope.Info("IBMPowerVSNetwork is not ready yet, requeuing")
+		conditions.MarkFalse(clusterScope.IBMPowerVSCluster, infrav1beta2.NetworkReadyCondition, infrav1beta2.WaitingForIBMPowerVSNetworkReason, capiv1beta1.ConditionSeverityInfo, "")
+		return reconcile.Result{RequeueAfter: 30 * time.Second}, nil
+	}
+	conditions.MarkTrue(clusterScope.IBMPowerVSCluster, infrav1beta2.NetworkReadyCondition)
+	return reconcile.Result{}, nil
+}
+
+// reconcileManagedDHCPNetwork reconciles the DHCP network in the existing Power VS workspace when the create-infra annotation is not set.
+func (r *IBMPowerVSClusterReconciler) reconcileManagedDHCPNetwork(clusterScope *scope.PowerVSClusterScope) (ctrl.Result, error) {
+	clusterScope.Info("Reconciling network")
+	networkActive, err := clusterScope.ReconcileNetwork()
+	if err != nil {
+		clusterScope.Error(err, "failed to reconcile PowerVS network")
+		conditions.MarkFalse(clusterScope.IBMPowerVSCluster, infrav1beta2.NetworkReadyCondition, infrav1beta2.NetworkReconciliationFailedReason, capiv1beta1.ConditionSeverityError, "%s", err.Error())
+		return reconcile.Result{}, err
+	}
+	if !networkActive {
+		clusterScope.Info("PowerVS network creation is pending, requeuing")
+		return reconcile.Result{RequeueAfter: 30 * time.Second}, nil
+	}
+	conditions.MarkTrue(clusterScope.IBMPowerVSCluster, infrav1beta2.NetworkReadyCondition)
+	return reconcile.Result{}, nil
+}
+
+// reconcileManagedTransitGateway reconciles the transit gateway and attaches the existing Power VS workspace and VPC to it
+// when the create-infra annotation is not set.
+func (r *IBMPowerVSClusterReconciler) reconcileManagedTransitGateway(clusterScope *scope.PowerVSClusterScope) (ctrl.Result, error) {
+	// the resource group is required only to create the transit gateway, which is owned by the IBMTransitGateway when referenced.
+	if clusterScope.IBMPowerVSCluster.Spec.TransitGatewayRef == nil {
+		clusterScope.Info("Reconciling resource group")
+		if err := clusterScope.ReconcileResourceGroup(); err != nil {
+			clusterScope.Error(err, "failed to reconcile resource group")
+			return reconcile.Result{}, err
+		}
+	}
+
+	clusterScope.Info("Reconciling transit gateway")
+	requeue, err := clusterScope.ReconcileTransitGateway()
+	if err != nil {
+		clusterScope.Error(err, "failed to reconcile transit gateway")
+		conditions.MarkFalse(clusterScope.IBMPowerVSCluster, infrav1beta2.TransitGatewayReadyCondition, infrav1beta2.TransitGatewayReconciliationFailedReason, capiv1beta1.ConditionSeverityError, "%s", err.Error())
+		return reconcile.Result{}, err
+	}
+	if requeue {
+		clusterScope.Info("Transit gateway creation is pending, requeuing")
+		if clusterScope.IBMPowerVSCluster.Spec.TransitGatewayRef != nil {
+			conditions.MarkFalse(clusterScope.IBMPowerVSCluster, infrav1beta2.TransitGatewayReadyCondition, infrav1beta2.WaitingForIBMTransitGatewayReason, capiv1beta1.ConditionSeverityInfo, "")
+		}
+		return reconcile.Result{RequeueAfter: 1 * time.Minute}, nil
+	}
+	conditions.MarkTrue(clusterScope.IBMPowerVSCluster, infrav1beta2.TransitGatewayReadyCondition)
+	return reconcile.Result{}, nil
+}
+
+// reconcileManagedLoadBalancer reconciles the VPC load balancers in the existing VPC when the create-infra annotation is not set
+// and sets the control plane endpoint with the hostname of the public load balancer.
+func (r *IBMPowerVSClusterReconciler) reconcileManagedLoadBalancer(clusterScope *scope.PowerVSClusterScope) (ctrl.Result, error) {
+	clusterScope.Info("Reconciling resource group")
+	if err := clusterScope.ReconcileResourceGroup(); err != nil {
+		clusterScope.Error(err, "failed to reconcile resource group")
+		return reconcile.Result{}, err
+	}
+
+	clusterScope.Info("Reconciling VPC subnets")
+	if err := clusterScope.ReconcileExistingVPCSubnets(); err != nil {
+		clusterScope.Error(err, "failed to reconcile VPC subnets")
+		conditions.MarkFalse(clusterScope.IBMPowerVSCluster, infrav1beta2.VPCSubnetReadyCondition, infrav1beta2.VPCSubnetReconciliationFailedReason, capiv1beta1.ConditionSeverityError, "%s", err.Error())
+		return reconcile.Result{}, err
+	}
+	conditions.MarkTrue(clusterScope.IBMPowerVSCluster, infrav1beta2.VPCSubnetReadyCondition)
+
+	clusterScope.Info("Reconciling VPC load balancers")
+	loadBalancerReady, err := clusterScope.ReconcileLoadBalancers()
+	if err != nil {
+		clusterScope.Error(err, "failed to reconcile VPC load balancers")
+		conditions.MarkFalse(clusterScope.IBMPowerVSCluster, infrav1beta2.LoadBalancerReadyCondition, infrav1beta2.LoadBalancerReconciliationFailedReason, capiv1beta1.ConditionSeverityError, "%s", err.Error())
+		return reconcile.Result{}, err
+	}
+	if !loadBalancerReady {
+		clusterScope.Info("VPC load balancer creation is pending, requeuing")
+		return reconcile.Result{RequeueAfter: 30 * time.Second}, nil
+	}
+	conditions.MarkTrue(clusterScope.IBMPowerVSCluster, infrav1beta2.LoadBalancerReadyCondition)
+
+	clusterScope.Info("Getting load balancer host")
+	hostName, err := clusterScope.GetPublicLoadBalancerHostName()
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed to fetch public loadbalancer: %w", err)
+	}
+	if hostName == nil || *hostName == "" {
+		clusterScope.Info("LoadBalancer hostname is not yet available, requeuing")
+		return reconcile.Result{RequeueAfter: time.Minute}, nil
+	}
+
+	if err := clusterScope.ReconcileDebugDump(); err != nil {
+		clusterScope.Error(err, "failed to reconcile debug dump of the load balancers")
+	}
+
+	if err := clusterScope.ReconcileTags(); err != nil {
+		clusterScope.Error(err, "failed to reconcile tags of the cluster resources")
+		capibmrecord.Warnf(clusterScope.IBMPowerVSCluster, "FailedReconcileTags", "Failed to reconcile tags of the cluster resources - %v", err)
+	}
+
+	endpointHost, err := r.reconcileDNSRecord(clusterScope, *hostName)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	// update cluster object with loadbalancer host name
+	if err := r.reconcileControlPlaneEndpoint(clusterScope, endpointHost); err != nil {
+		return reconcile.Result{}, err
+	}
+	return reconcile.Result{}, nil
+}
+
+// reconcileDNSRecord registers the hostname of the public load balancer in the DNS zone when DNS is set, and returns
+// the host of the control plane endpoint, the hostname of the DNS record or else the hostname of the load balancer.
+func (r *IBMPowerVSClusterReconciler) reconcileDNSRecord(clusterScope *scope.PowerVSClusterScope, hostName string) (string, error) {
+	if clusterScope.DNS() == nil {
+		return hostName, nil
+	}
+	clusterScope.Info("Reconciling DNS record")
+	if err := clusterScope.ReconcileDNSRecord(hostName); err != nil {
+		clusterScope.Error(err, "failed to reconcile DNS record")
+		reason := infrav1beta2.DNSRecordReconciliationFailedReason
+		if errors.Is(err, scope.ErrDNSRecordConflict) {
+			reason = infrav1beta2.DNSRecordConflictReason
+		}
+		conditions.MarkFalse(clusterScope.IBMPowerVSCluster, infrav1beta2.DNSRecordReadyCondition, reason, capiv1beta1.ConditionSeverityError, "%s", err.Error())
+		capibmrecord.Warnf(clusterScope.IBMPowerVSCluster, "FailedReconcileDNSRecord", "Failed to reconcile DNS record %q - %v", clusterScope.DNS().Hostname, err)
+		return "", err
+	}
+	conditions.MarkTrue(clusterScope.IBMPowerVSCluster, infrav1beta2.DNSRecordReadyCondition)
+	return clusterScope.DNS().Hostname, nil
+}
+
+// reconcileControlPlaneEndpoint sets the control plane endpoint with the hostname of the public load balancer.
+// As the certificates of the API server and the kubeconfig of the cluster are bound to the endpoint, the endpoint is only
+// moved to the hostname of a recreated load balancer once approved with the update-control-plane-endpoint annotation.
+func (r *IBMPowerVSClusterReconciler) reconcileControlPlaneEndpoint(clusterScope *scope.PowerVSClusterScope, hostName string) error {
+	powerVSCluster := clusterScope.IBMPowerVSCluster
+	endpoint := &powerVSCluster.Spec.ControlPlaneEndpoint
+	if endpoint.Host == "" || endpoint.Host == hostName {
+		if conditions.GetReason(powerVSCluster, infrav1beta2.ControlPlaneEndpointReadyCondition) == infrav1beta2.LoadBalancerRecreatingReason {
+			capibmrecord.Eventf(powerVSCluster, "ControlPlaneEndpointRecovered", "Control plane endpoint %q is served by the recreated load balancer", hostName)
+		}
+		endpoint.Host = hostName
+		endpoint.Port = clusterScope.APIServerPort()
+		conditions.MarkTrue(powerVSCluster, infrav1beta2.ControlPlaneEndpointReadyCondition)
+		return nil
+	}
+
+	if _, ok := powerVSCluster.Annotations[infrav1beta2.ControlPlaneEndpointUpdateAnnotation]; !ok {
+		if conditions.GetReason(powerVSCluster, infrav1beta2.ControlPlaneEndpointReadyCondition) != infrav1beta2.ControlPlaneEndpointChangedReason {
+			capibmrecord.Warnf(powerVSCluster, "ControlPlaneEndpointChanged", "Hostname %q of the load balancer differs from the control plane endpoint %q, set the %s annotation to update the endpoint", hostName, endpoint.Host, infrav1beta2.ControlPlaneEndpointUpdateAnnotation)
+		}
+		conditions.MarkFalse(powerVSCluster, infrav1beta2.ControlPlaneEndpointReadyCondition, infrav1beta2.ControlPlaneEndpointChangedReason, capiv1beta1.ConditionSeverityWarning,
+			"hostname %s of the load balancer differs from the control plane endpoint %s, set the %s annotation to update the endpoint", hostName, endpoint.Host, infrav1beta2.ControlPlaneEndpointUpdateAnnotation)
+		return nil
+	}
+
+	// The endpoint of the Cluster is only copied from the infrastructure cluster while it is unset, hence it is updated here as well.
+	clusterScope.Info("Updating control plane endpoint", "from", endpoint.Host, "to", hostName)
+	if cluster := clusterScope.Cluster; cluster != nil && cluster.Spec.ControlPlaneEndpoint.Host != hostName {
+		patchHelper, err := patch.NewHelper(cluster, r.Client)
+		if err != nil {
+			return fmt.Errorf("failed to init patch helper for cluster %s/%s: %w", cluster.Namespace, cluster.Name, err)
+		}
+		cluster.Spec.ControlPlaneEndpoint.Host = hostName
+		cluster.Spec.ControlPlaneEndpoint.Port = clusterScope.APIServerPort()
+		if err := patchHelper.Patch(context.TODO(), cluster); err != nil {
+			return fmt.Errorf("failed to update control plane endpoint of cluster %s/%s: %w", cluster.Namespace, cluster.Name, err)
+		}
+	}
+	capibmrecord.Eventf(powerVSCluster, "ControlPlaneEndpointUpdated", "Updated control plane endpoint from %q to %q", endpoint.Host, hostName)
+	endpoint.Host = hostName
+	endpoint.Port = clusterScope.APIServerPort()
+	delete(powerVSCluster.Annotations, infrav1beta2.ControlPlaneEndpointUpdateAnnotation)
+	conditions.MarkTrue(powerVSCluster, infrav1beta2.ControlPlaneEndpointReadyCondition)
+	return nil
+}
+
+func (r *IBMPowerVSClusterReconciler) reconcileAddons(clusterScope *scope.PowerVSClusterScope) error {
+	if clusterScope.IBMPowerVSCluster.Spec.Addons == nil {
+		return nil
+	}
+	clusterScope.Info("Reconciling cluster addons")
+	if err := clusterScope.ReconcileAddons(); err != nil {
+		clusterScope.Error(err, "failed to reconcile cluster addons")
+		conditions.MarkFalse(clusterScope.IBMPowerVSCluster, infrav1beta2.AddonsReadyCondition, infrav1beta2.AddonsReconciliationFailedReason, capiv1beta1.ConditionSeverityError, "%s", err.Error())
+		return err
+	}
+	conditions.MarkTrue(clusterScope.IBMPowerVSCluster, infrav1beta2.AddonsReadyCondition)
+	return nil
 }
 
 func (r *IBMPowerVSClusterReconciler) reconcileDelete(ctx context.Context, clusterScope *scope.PowerVSClusterScope) (ctrl.Result, error) {
@@ -407,6 +833,43 @@ func (r *IBMPowerVSClusterReconciler) reconcileDelete(ctx context.Context, clust
 
 	// check for annotation set for cluster resource and decide on proceeding with infra deletion.
 	if !scope.CheckCreateInfraAnnotation(*clusterScope.IBMPowerVSCluster) {
+		if scope.CheckManageTransitGateway(*clusterScope.IBMPowerVSCluster) {
+			clusterScope.Info("Clean up Transit Gateway")
+			if requeue, err := clusterScope.DeleteTransitGateway(); err != nil {
+				clusterScope.Error(err, "failed to delete transit gateway")
+				return ctrl.Result{}, errors.Wrapf(err, "failed to delete transit gateway")
+			} else if requeue {
+				clusterScope.Info("Cleaning up transit gateway is pending, requeuing")
+				return reconcile.Result{RequeueAfter: 1 * time.Minute}, nil
+			}
+		}
+		if scope.CheckManageLoadBalancer(*clusterScope.IBMPowerVSCluster) {
+			clusterScope.Info("Deleting VPC load balancer")
+			if requeue, err := clusterScope.DeleteLoadBalancer(); err != nil {
+				clusterScope.Error(err, "failed to delete VPC load balancer")
+				return ctrl.Result{}, errors.Wrapf(err, "failed to delete VPC load balancer")
+			} else if requeue {
+				clusterScope.Info("VPC load balancer deletion is pending, requeuing")
+				return reconcile.Result{RequeueAfter: 1 * time.Minute}, nil
+			}
+		}
+		if scope.CheckManageDHCPNetwork(*clusterScope.IBMPowerVSCluster) {
+			clusterScope.Info("Deleting DHCP server")
+			if err := clusterScope.DeleteDHCPServer(); err != nil {
+				clusterScope.Error(err, "failed to delete DHCP server")
+				return ctrl.Result{}, errors.Wrapf(err, "failed to delete DHCP server")
+			}
+		}
+		clusterScope.Info("Deleting SSH key")
+		if err := clusterScope.DeleteSSHKey(); err != nil {
+			clusterScope.Error(err, "failed to delete SSH key")
+			return ctrl.Result{}, errors.Wrapf(err, "failed to delete SSH key")
+		}
+		clusterScope.Info("Deleting DNS record")
+		if err := clusterScope.DeleteDNSRecord(); err != nil {
+			clusterScope.Error(err, "failed to delete DNS record")
+			return ctrl.Result{}, errors.Wrapf(err, "failed to delete DNS record")
+		}
 		controllerutil.RemoveFinalizer(cluster, infrav1beta2.IBMPowerVSClusterFinalizer)
 		return ctrl.Result{}, nil
 	}
@@ -415,6 +878,14 @@ func (r *IBMPowerVSClusterReconciler) reconcileDelete(ctx context.Context, clust
 	allErrs := []error{}
 	clusterScope.IBMPowerVSClient.WithClients(powervs.ServiceOptions{CloudInstanceID: clusterScope.GetServiceInstanceID()})
 
+	clusterScope.Info("Deleting orphan resources carrying the cluster tag")
+	if requeue, err := clusterScope.DeleteOrphanTaggedResources(); err != nil {
+		allErrs = append(allErrs, errors.Wrapf(err, "failed to delete orphan resources carrying the cluster tag"))
+	} else if requeue {
+		clusterScope.Info("Orphan resources deletion is pending, requeuing")
+		return reconcile.Result{RequeueAfter: 15 * time.Second}, nil
+	}
+
 	clusterScope.Info("Clean up Transit Gateway")
 	if requeue, err := clusterScope.DeleteTransitGateway(); err != nil {
 		allErrs = append(allErrs, errors.Wrapf(err, "failed to delete transit gateway"))
@@ -457,6 +928,16 @@ func (r *IBMPowerVSClusterReconciler) reconcileDelete(ctx context.Context, clust
 		allErrs = append(allErrs, errors.Wrapf(err, "failed to delete DHCP server"))
 	}
 
+	clusterScope.Info("Deleting SSH key")
+	if err := clusterScope.DeleteSSHKey(); err != nil {
+		allErrs = append(allErrs, errors.Wrapf(err, "failed to delete SSH key"))
+	}
+
+	clusterScope.Info("Deleting DNS record")
+	if err := clusterScope.DeleteDNSRecord(); err != nil {
+		allErrs = append(allErrs, errors.Wrapf(err, "failed to delete DNS record"))
+	}
+
 	clusterScope.Info("Deleting Power VS service instance")
 	if requeue, err := clusterScope.DeleteServiceInstance(); err != nil {
 		allErrs = append(allErrs, errors.Wrapf(err, "failed to delete Power VS service instance"))
@@ -615,7 +1096,6 @@ func (c clusterDescendants) filterOwnedDescendants(cluster *infrav1beta2.IBMPowe
 func (r *IBMPowerVSClusterReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager) error {
 	controller, err := ctrl.NewControllerManagedBy(mgr).
 		For(&infrav1beta2.IBMPowerVSCluster{}).
-		WithEventFilter(predicates.ResourceIsNotExternallyManaged(r.Scheme, ctrl.LoggerFrom(ctx))).
 		WithEventFilter(predicates.ResourceNotPaused(r.Scheme, ctrl.LoggerFrom(ctx))).
 		Build(r)
 	if err != nil {
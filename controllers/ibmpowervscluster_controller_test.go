@@ -19,6 +19,7 @@ package controllers
 import (
 	"errors"
 	"fmt"
+	"net/url"
 	"sync"
 	"testing"
 	"time"
@@ -46,6 +47,7 @@ import (
 
 	capiv1beta1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	"sigs.k8s.io/cluster-api/util"
+	"sigs.k8s.io/cluster-api/util/conditions"
 
 	infrav1beta2 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/v1beta2"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/cloud/scope"
@@ -306,6 +308,55 @@ func TestIBMPowerVSClusterReconciler_Reconcile(t *testing.T) {
 	})
 }
 
+func TestIBMPowerVSClusterReconciler_reconcileExternallyManaged(t *testing.T) {
+	newPowerVSCluster := func() *infrav1beta2.IBMPowerVSCluster {
+		return &infrav1beta2.IBMPowerVSCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "powervs-test",
+				Namespace:   "default",
+				Annotations: map[string]string{capiv1beta1.ManagedByAnnotation: ""},
+				Finalizers:  []string{infrav1beta2.IBMPowerVSClusterFinalizer},
+			},
+			Spec: infrav1beta2.IBMPowerVSClusterSpec{ServiceInstanceID: "foo"},
+		}
+	}
+	reconcile := func(g *WithT, powerVSCluster *infrav1beta2.IBMPowerVSCluster) *infrav1beta2.IBMPowerVSCluster {
+		mockClient := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(powerVSCluster).WithStatusSubresource(powerVSCluster).Build()
+		reconciler := &IBMPowerVSClusterReconciler{
+			Client: mockClient,
+		}
+		_, err := reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(powerVSCluster)})
+		g.Expect(err).To(BeNil())
+
+		updatedCluster := &infrav1beta2.IBMPowerVSCluster{}
+		if err := mockClient.Get(ctx, client.ObjectKeyFromObject(powerVSCluster), updatedCluster); err != nil {
+			return nil
+		}
+		return updatedCluster
+	}
+
+	t.Run("Should not set cluster status as Ready if control plane endpoint is not set", func(t *testing.T) {
+		g := NewWithT(t)
+		powerVSCluster := reconcile(g, newPowerVSCluster())
+		g.Expect(powerVSCluster.Status.Ready).To(BeFalse())
+	})
+
+	t.Run("Should set cluster status as Ready without owner cluster if control plane endpoint is set", func(t *testing.T) {
+		g := NewWithT(t)
+		powerVSCluster := newPowerVSCluster()
+		powerVSCluster.Spec.ControlPlaneEndpoint = capiv1beta1.APIEndpoint{Host: "192.168.1.10", Port: 6443}
+		powerVSCluster = reconcile(g, powerVSCluster)
+		g.Expect(powerVSCluster.Status.Ready).To(BeTrue())
+	})
+
+	t.Run("Should remove the finalizer without deleting the infrastructure", func(t *testing.T) {
+		g := NewWithT(t)
+		powerVSCluster := newPowerVSCluster()
+		powerVSCluster.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+		g.Expect(reconcile(g, powerVSCluster)).To(BeNil())
+	})
+}
+
 func TestIBMPowerVSClusterReconciler_reconcile(t *testing.T) {
 	testCases := []struct {
 		name                string
@@ -753,6 +804,11 @@ func TestIBMPowerVSClusterReconciler_delete(t *testing.T) {
 	}
 	powervsClusterScope = func() *scope.PowerVSClusterScope {
 		return &scope.PowerVSClusterScope{
+			Cluster: &capiv1beta1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "capi-test",
+				},
+			},
 			IBMPowerVSCluster: &infrav1beta2.IBMPowerVSCluster{
 				TypeMeta: metav1.TypeMeta{
 					Kind:       "IBMPowerVSCluster",
@@ -895,6 +951,7 @@ func TestIBMPowerVSClusterReconciler_delete(t *testing.T) {
 			Status: ptr.To(string(infrav1beta2.TransitGatewayStateAvailable))}
 		mockPowerVS = powervsmock.NewMockPowerVS(gomock.NewController(t))
 		mockPowerVS.EXPECT().WithClients(gomock.Any())
+		mockPowerVS.EXPECT().GetAllVolumes().Return(&models.Volumes{}, nil)
 		clusterScope.IBMPowerVSClient = mockPowerVS
 		mockResourceClient = resourceclientmock.NewMockResourceController(gomock.NewController(t))
 		clusterScope.ResourceClient = mockResourceClient
@@ -955,6 +1012,7 @@ func TestIBMPowerVSClusterReconciler_delete(t *testing.T) {
 		}
 		mockPowerVS = powervsmock.NewMockPowerVS(gomock.NewController(t))
 		mockPowerVS.EXPECT().WithClients(gomock.Any())
+		mockPowerVS.EXPECT().GetAllVolumes().Return(&models.Volumes{}, nil)
 		clusterScope.IBMPowerVSClient = mockPowerVS
 		mockResourceClient = resourceclientmock.NewMockResourceController(gomock.NewController(t))
 		clusterScope.ResourceClient = mockResourceClient
@@ -1012,6 +1070,7 @@ func TestIBMPowerVSClusterReconciler_delete(t *testing.T) {
 		}
 		mockPowerVS = powervsmock.NewMockPowerVS(gomock.NewController(t))
 		mockPowerVS.EXPECT().WithClients(gomock.Any())
+		mockPowerVS.EXPECT().GetAllVolumes().Return(&models.Volumes{}, nil)
 		clusterScope.IBMPowerVSClient = mockPowerVS
 		mockResourceClient = resourceclientmock.NewMockResourceController(gomock.NewController(t))
 		clusterScope.ResourceClient = mockResourceClient
@@ -1040,6 +1099,7 @@ func TestIBMPowerVSClusterReconciler_delete(t *testing.T) {
 		}
 		mockPowerVS = powervsmock.NewMockPowerVS(gomock.NewController(t))
 		mockPowerVS.EXPECT().WithClients(gomock.Any())
+		mockPowerVS.EXPECT().GetAllVolumes().Return(&models.Volumes{}, nil)
 		clusterScope.IBMPowerVSClient = mockPowerVS
 		mockResourceClient = resourceclientmock.NewMockResourceController(gomock.NewController(t))
 		clusterScope.ResourceClient = mockResourceClient
@@ -1086,6 +1146,7 @@ func TestIBMPowerVSClusterReconciler_delete(t *testing.T) {
 		}
 		mockPowerVS = powervsmock.NewMockPowerVS(gomock.NewController(t))
 		mockPowerVS.EXPECT().WithClients(gomock.Any())
+		mockPowerVS.EXPECT().GetAllVolumes().Return(&models.Volumes{}, nil)
 		clusterScope.IBMPowerVSClient = mockPowerVS
 		mockResourceClient = resourceclientmock.NewMockResourceController(gomock.NewController(t))
 		clusterScope.ResourceClient = mockResourceClient
@@ -1136,6 +1197,7 @@ func TestIBMPowerVSClusterReconciler_delete(t *testing.T) {
 		}
 		mockPowerVS = powervsmock.NewMockPowerVS(gomock.NewController(t))
 		mockPowerVS.EXPECT().WithClients(gomock.Any())
+		mockPowerVS.EXPECT().GetAllVolumes().Return(&models.Volumes{}, nil)
 		mockPowerVS.EXPECT().GetDHCPServer(gomock.Any()).Return(&models.DHCPServerDetail{
 			ID:     ptr.To("dhcpID"),
 			Status: ptr.To(string(infrav1beta2.DHCPServerStateActive)),
@@ -1224,6 +1286,7 @@ func TestIBMPowerVSClusterReconciler_delete(t *testing.T) {
 		}
 		mockPowerVS = powervsmock.NewMockPowerVS(gomock.NewController(t))
 		mockPowerVS.EXPECT().WithClients(gomock.Any())
+		mockPowerVS.EXPECT().GetAllVolumes().Return(&models.Volumes{}, nil)
 		clusterScope.IBMPowerVSClient = mockPowerVS
 		mockResourceClient = resourceclientmock.NewMockResourceController(gomock.NewController(t))
 		mockResourceClient.EXPECT().GetResourceInstance(gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{
@@ -1256,6 +1319,7 @@ func TestIBMPowerVSClusterReconciler_delete(t *testing.T) {
 		}
 		mockPowerVS = powervsmock.NewMockPowerVS(gomock.NewController(t))
 		mockPowerVS.EXPECT().WithClients(gomock.Any())
+		mockPowerVS.EXPECT().GetAllVolumes().Return(&models.Volumes{}, nil)
 		clusterScope.IBMPowerVSClient = mockPowerVS
 		mockResourceClient = resourceclientmock.NewMockResourceController(gomock.NewController(t))
 		clusterScope.ResourceClient = mockResourceClient
@@ -1728,6 +1792,493 @@ func TestReconcilePowerVSResources(t *testing.T) {
 	}
 }
 
+func TestReconcileManagedDHCPNetwork(t *testing.T) {
+	testCases := []struct {
+		name                    string
+		powerVSClusterScopeFunc func() *scope.PowerVSClusterScope
+		expectedResult          reconcile.Result
+		expectError             bool
+		conditions              capiv1beta1.Conditions
+	}{
+		{
+			name: "When reconciling network returns error",
+			powerVSClusterScopeFunc: func() *scope.PowerVSClusterScope {
+				clusterScope := &scope.PowerVSClusterScope{
+					IBMPowerVSCluster: &infrav1beta2.IBMPowerVSCluster{
+						Spec: infrav1beta2.IBMPowerVSClusterSpec{
+							ServiceInstanceID: "serviceInstanceID",
+							ManageDHCPNetwork: ptr.To(true),
+						},
+						Status: infrav1beta2.IBMPowerVSClusterStatus{
+							Network: &infrav1beta2.ResourceReference{ID: ptr.To("netID")},
+						},
+					},
+				}
+				mockPowerVS := powervsmock.NewMockPowerVS(gomock.NewController(t))
+				mockPowerVS.EXPECT().GetNetworkByID(gomock.Any()).Return(nil, fmt.Errorf("error getting network"))
+				clusterScope.IBMPowerVSClient = mockPowerVS
+				return clusterScope
+			},
+			expectError: true,
+			conditions: capiv1beta1.Conditions{
+				capiv1beta1.Condition{
+					Type:     infrav1beta2.NetworkReadyCondition,
+					Status:   "False",
+					Severity: capiv1beta1.ConditionSeverityError,
+					Reason:   infrav1beta2.NetworkReconciliationFailedReason,
+					Message:  "error getting network",
+				},
+			},
+		},
+		{
+			name: "When DHCP server is created, requeue until network is active",
+			powerVSClusterScopeFunc: func() *scope.PowerVSClusterScope {
+				clusterScope := &scope.PowerVSClusterScope{
+					IBMPowerVSCluster: &infrav1beta2.IBMPowerVSCluster{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "capi-powervs-cluster",
+						},
+						Spec: infrav1beta2.IBMPowerVSClusterSpec{
+							ServiceInstanceID: "serviceInstanceID",
+							ManageDHCPNetwork: ptr.To(true),
+						},
+					},
+				}
+				mockPowerVS := powervsmock.NewMockPowerVS(gomock.NewController(t))
+				mockPowerVS.EXPECT().GetAllDHCPServers().Return(models.DHCPServers{}, nil)
+				mockPowerVS.EXPECT().CreateDHCPServer(gomock.Any()).Return(&models.DHCPServer{ID: ptr.To("dhcpID"), Network: &models.DHCPServerNetwork{ID: ptr.To("netID")}}, nil)
+				clusterScope.IBMPowerVSClient = mockPowerVS
+				return clusterScope
+			},
+			expectedResult: reconcile.Result{RequeueAfter: 30 * time.Second},
+		},
+		{
+			name: "When network is active",
+			powerVSClusterScopeFunc: func() *scope.PowerVSClusterScope {
+				clusterScope := &scope.PowerVSClusterScope{
+					IBMPowerVSCluster: &infrav1beta2.IBMPowerVSCluster{
+						Spec: infrav1beta2.IBMPowerVSClusterSpec{
+							ServiceInstanceID: "serviceInstanceID",
+							ManageDHCPNetwork: ptr.To(true),
+						},
+						Status: infrav1beta2.IBMPowerVSClusterStatus{
+							Network:    &infrav1beta2.ResourceReference{ID: ptr.To("netID")},
+							DHCPServer: &infrav1beta2.ResourceReference{ID: ptr.To("dhcpID"), ControllerCreated: ptr.To(true)},
+						},
+					},
+				}
+				mockPowerVS := powervsmock.NewMockPowerVS(gomock.NewController(t))
+				mockPowerVS.EXPECT().GetNetworkByID(gomock.Any()).Return(&models.Network{NetworkID: ptr.To("netID")}, nil)
+				mockPowerVS.EXPECT().GetDHCPServer(gomock.Any()).Return(&models.DHCPServerDetail{ID: ptr.To("dhcpID"), Status: ptr.To(string(infrav1beta2.DHCPServerStateActive))}, nil)
+				clusterScope.IBMPowerVSClient = mockPowerVS
+				return clusterScope
+			},
+			conditions: capiv1beta1.Conditions{
+				capiv1beta1.Condition{
+					Type:   infrav1beta2.NetworkReadyCondition,
+					Status: "True",
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			reconciler := &IBMPowerVSClusterReconciler{}
+			clusterScope := tc.powerVSClusterScopeFunc()
+			result, err := reconciler.reconcileManagedDHCPNetwork(clusterScope)
+			if tc.expectError {
+				g.Expect(err).ToNot(BeNil())
+			} else {
+				g.Expect(err).To(BeNil())
+			}
+			g.Expect(result).To(Equal(tc.expectedResult))
+			ignoreLastTransitionTime := cmp.Transformer("", func(metav1.Time) metav1.Time {
+				return metav1.Time{}
+			})
+			g.Expect(clusterScope.IBMPowerVSCluster.GetConditions()).To(BeComparableTo(tc.conditions, ignoreLastTransitionTime))
+		})
+	}
+}
+
+func TestReconcileManagedLoadBalancer(t *testing.T) {
+	testCases := []struct {
+		name                    string
+		powerVSClusterScopeFunc func() *scope.PowerVSClusterScope
+		expectedResult          reconcile.Result
+		expectError             bool
+		expectedEndpoint        capiv1beta1.APIEndpoint
+		conditions              capiv1beta1.Conditions
+	}{
+		{
+			name: "When VPC subnet does not exist",
+			powerVSClusterScopeFunc: func() *scope.PowerVSClusterScope {
+				clusterScope := &scope.PowerVSClusterScope{
+					IBMPowerVSCluster: &infrav1beta2.IBMPowerVSCluster{
+						Spec: infrav1beta2.IBMPowerVSClusterSpec{
+							ManageLoadBalancer: ptr.To(true),
+							ResourceGroup:      &infrav1beta2.IBMPowerVSResourceReference{ID: ptr.To("rgID")},
+							VPCSubnets:         []infrav1beta2.Subnet{{Name: ptr.To("subnet")}},
+						},
+					},
+				}
+				mockVPC := vpcmock.NewMockVpc(gomock.NewController(t))
+				mockVPC.EXPECT().GetVPCSubnetByName(gomock.Any()).Return(nil, nil)
+				clusterScope.IBMVPCClient = mockVPC
+				return clusterScope
+			},
+			expectError: true,
+			conditions: capiv1beta1.Conditions{
+				capiv1beta1.Condition{
+					Type:     infrav1beta2.VPCSubnetReadyCondition,
+					Status:   "False",
+					Severity: capiv1beta1.ConditionSeverityError,
+					Reason:   infrav1beta2.VPCSubnetReconciliationFailedReason,
+					Message:  "failed to find VPC subnet with name subnet",
+				},
+			},
+		},
+		{
+			name: "When load balancer is created, requeue until load balancer is active",
+			powerVSClusterScopeFunc: func() *scope.PowerVSClusterScope {
+				clusterScope := &scope.PowerVSClusterScope{
+					Cluster: &capiv1beta1.Cluster{},
+					IBMPowerVSCluster: &infrav1beta2.IBMPowerVSCluster{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "capi-powervs-cluster",
+						},
+						Spec: infrav1beta2.IBMPowerVSClusterSpec{
+							ManageLoadBalancer: ptr.To(true),
+							ResourceGroup:      &infrav1beta2.IBMPowerVSResourceReference{ID: ptr.To("rgID")},
+							VPCSubnets:         []infrav1beta2.Subnet{{ID: ptr.To("subnetID")}},
+						},
+					},
+				}
+				mockVPC := vpcmock.NewMockVpc(gomock.NewController(t))
+				mockVPC.EXPECT().GetSubnet(gomock.Any()).Return(&vpcv1.Subnet{ID: ptr.To("subnetID"), Name: ptr.To("subnet")}, nil, nil)
+				mockVPC.EXPECT().GetLoadBalancerByName(gomock.Any()).Return(nil, nil)
+				mockVPC.EXPECT().CreateLoadBalancer(gomock.Any()).Return(&vpcv1.LoadBalancer{ID: ptr.To("lbID"), ProvisioningStatus: ptr.To(string(infrav1beta2.VPCLoadBalancerStateCreatePending))}, nil, nil)
+				clusterScope.IBMVPCClient = mockVPC
+				return clusterScope
+			},
+			expectedResult: reconcile.Result{RequeueAfter: 30 * time.Second},
+			conditions: capiv1beta1.Conditions{
+				capiv1beta1.Condition{
+					Type:   infrav1beta2.VPCSubnetReadyCondition,
+					Status: "True",
+				},
+			},
+		},
+		{
+			name: "When load balancer is active, control plane endpoint is set",
+			powerVSClusterScopeFunc: func() *scope.PowerVSClusterScope {
+				clusterScope := &scope.PowerVSClusterScope{
+					Cluster: &capiv1beta1.Cluster{},
+					IBMPowerVSCluster: &infrav1beta2.IBMPowerVSCluster{
+						Spec: infrav1beta2.IBMPowerVSClusterSpec{
+							ManageLoadBalancer: ptr.To(true),
+							ResourceGroup:      &infrav1beta2.IBMPowerVSResourceReference{ID: ptr.To("rgID")},
+							VPCSubnets:         []infrav1beta2.Subnet{{ID: ptr.To("subnetID")}},
+							LoadBalancers:      []infrav1beta2.VPCLoadBalancerSpec{{Name: "lb", Public: ptr.To(true)}},
+						},
+						Status: infrav1beta2.IBMPowerVSClusterStatus{
+							LoadBalancers: map[string]infrav1beta2.VPCLoadBalancerStatus{
+								"lb": {ID: ptr.To("lbID"), ControllerCreated: ptr.To(true)},
+							},
+						},
+					},
+				}
+				mockVPC := vpcmock.NewMockVpc(gomock.NewController(t))
+				mockVPC.EXPECT().GetSubnet(gomock.Any()).Return(&vpcv1.Subnet{ID: ptr.To("subnetID"), Name: ptr.To("subnet")}, nil, nil)
+				mockVPC.EXPECT().GetLoadBalancer(gomock.Any()).Return(&vpcv1.LoadBalancer{ID: ptr.To("lbID"), Name: ptr.To("lb"), Hostname: ptr.To("lb.hostname"), ProvisioningStatus: ptr.To(string(infrav1beta2.VPCLoadBalancerStateActive))}, nil, nil)
+				clusterScope.IBMVPCClient = mockVPC
+				return clusterScope
+			},
+			expectedEndpoint: capiv1beta1.APIEndpoint{Host: "lb.hostname", Port: infrav1beta2.DefaultAPIServerPort},
+			conditions: capiv1beta1.Conditions{
+				capiv1beta1.Condition{
+					Type:   infrav1beta2.ControlPlaneEndpointReadyCondition,
+					Status: "True",
+				},
+				capiv1beta1.Condition{
+					Type:   infrav1beta2.LoadBalancerReadyCondition,
+					Status: "True",
+				},
+				capiv1beta1.Condition{
+					Type:   infrav1beta2.VPCSubnetReadyCondition,
+					Status: "True",
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			reconciler := &IBMPowerVSClusterReconciler{}
+			clusterScope := tc.powerVSClusterScopeFunc()
+			result, err := reconciler.reconcileManagedLoadBalancer(clusterScope)
+			if tc.expectError {
+				g.Expect(err).ToNot(BeNil())
+			} else {
+				g.Expect(err).To(BeNil())
+			}
+			g.Expect(result).To(Equal(tc.expectedResult))
+			g.Expect(clusterScope.IBMPowerVSCluster.Spec.ControlPlaneEndpoint).To(Equal(tc.expectedEndpoint))
+			ignoreLastTransitionTime := cmp.Transformer("", func(metav1.Time) metav1.Time {
+				return metav1.Time{}
+			})
+			g.Expect(clusterScope.IBMPowerVSCluster.GetConditions()).To(BeComparableTo(tc.conditions, ignoreLastTransitionTime))
+		})
+	}
+}
+
+func TestReconcileControlPlaneEndpoint(t *testing.T) {
+	newClusterScope := func(endpoint string, annotations map[string]string) *scope.PowerVSClusterScope {
+		return &scope.PowerVSClusterScope{
+			Cluster: &capiv1beta1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "capi-cluster", Namespace: "default"},
+				Spec: capiv1beta1.ClusterSpec{
+					ControlPlaneEndpoint: capiv1beta1.APIEndpoint{Host: endpoint, Port: infrav1beta2.DefaultAPIServerPort},
+				},
+			},
+			IBMPowerVSCluster: &infrav1beta2.IBMPowerVSCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "capi-powervs-cluster", Namespace: "default", Annotations: annotations},
+				Spec: infrav1beta2.IBMPowerVSClusterSpec{
+					ControlPlaneEndpoint: capiv1beta1.APIEndpoint{Host: endpoint, Port: infrav1beta2.DefaultAPIServerPort},
+				},
+			},
+		}
+	}
+
+	t.Run("When the control plane endpoint is not set, it is set with the hostname of the load balancer", func(t *testing.T) {
+		g := NewWithT(t)
+		reconciler := &IBMPowerVSClusterReconciler{}
+		clusterScope := newClusterScope("", nil)
+		g.Expect(reconciler.reconcileControlPlaneEndpoint(clusterScope, "lb.hostname")).To(Succeed())
+		g.Expect(clusterScope.IBMPowerVSCluster.Spec.ControlPlaneEndpoint).To(Equal(capiv1beta1.APIEndpoint{Host: "lb.hostname", Port: infrav1beta2.DefaultAPIServerPort}))
+		g.Expect(conditions.IsTrue(clusterScope.IBMPowerVSCluster, infrav1beta2.ControlPlaneEndpointReadyCondition)).To(BeTrue())
+	})
+
+	t.Run("When the recreated load balancer has a new hostname, the control plane endpoint is kept until approved", func(t *testing.T) {
+		g := NewWithT(t)
+		reconciler := &IBMPowerVSClusterReconciler{}
+		clusterScope := newClusterScope("old-lb.hostname", nil)
+		conditions.MarkFalse(clusterScope.IBMPowerVSCluster, infrav1beta2.ControlPlaneEndpointReadyCondition, infrav1beta2.LoadBalancerRecreatingReason, capiv1beta1.ConditionSeverityWarning, "")
+		g.Expect(reconciler.reconcileControlPlaneEndpoint(clusterScope, "new-lb.hostname")).To(Succeed())
+		g.Expect(clusterScope.IBMPowerVSCluster.Spec.ControlPlaneEndpoint.Host).To(Equal("old-lb.hostname"))
+		g.Expect(conditions.GetReason(clusterScope.IBMPowerVSCluster, infrav1beta2.ControlPlaneEndpointReadyCondition)).To(Equal(infrav1beta2.ControlPlaneEndpointChangedReason))
+	})
+
+	t.Run("When the update of the control plane endpoint is approved, the endpoint of the cluster is updated", func(t *testing.T) {
+		g := NewWithT(t)
+		clusterScope := newClusterScope("old-lb.hostname", map[string]string{infrav1beta2.ControlPlaneEndpointUpdateAnnotation: "true"})
+		reconciler := &IBMPowerVSClusterReconciler{
+			Client: fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(clusterScope.Cluster).Build(),
+		}
+		g.Expect(reconciler.reconcileControlPlaneEndpoint(clusterScope, "new-lb.hostname")).To(Succeed())
+		g.Expect(clusterScope.IBMPowerVSCluster.Spec.ControlPlaneEndpoint.Host).To(Equal("new-lb.hostname"))
+		g.Expect(clusterScope.IBMPowerVSCluster.Annotations).ToNot(HaveKey(infrav1beta2.ControlPlaneEndpointUpdateAnnotation))
+		g.Expect(conditions.IsTrue(clusterScope.IBMPowerVSCluster, infrav1beta2.ControlPlaneEndpointReadyCondition)).To(BeTrue())
+
+		cluster := &capiv1beta1.Cluster{}
+		g.Expect(reconciler.Client.Get(ctx, client.ObjectKeyFromObject(clusterScope.Cluster), cluster)).To(Succeed())
+		g.Expect(cluster.Spec.ControlPlaneEndpoint.Host).To(Equal("new-lb.hostname"))
+	})
+}
+
+func TestReconcileManagedTransitGateway(t *testing.T) {
+	testCases := []struct {
+		name                    string
+		powerVSClusterScopeFunc func() *scope.PowerVSClusterScope
+		expectedResult          reconcile.Result
+		expectError             bool
+		conditions              capiv1beta1.Conditions
+	}{
+		{
+			name: "When fetching transit gateway fails",
+			powerVSClusterScopeFunc: func() *scope.PowerVSClusterScope {
+				clusterScope := &scope.PowerVSClusterScope{
+					IBMPowerVSCluster: &infrav1beta2.IBMPowerVSCluster{
+						Spec: infrav1beta2.IBMPowerVSClusterSpec{
+							ManageTransitGateway: ptr.To(true),
+							ResourceGroup:        &infrav1beta2.IBMPowerVSResourceReference{ID: ptr.To("rgID")},
+							TransitGateway:       &infrav1beta2.TransitGateway{ID: ptr.To("tgID")},
+						},
+					},
+				}
+				mockTransitGateway := tgmock.NewMockTransitGateway(gomock.NewController(t))
+				mockTransitGateway.EXPECT().GetTransitGateway(gomock.Any()).Return(nil, nil, errors.New("failed to get transit gateway"))
+				clusterScope.TransitGatewayClient = mockTransitGateway
+				return clusterScope
+			},
+			expectError: true,
+			conditions: capiv1beta1.Conditions{
+				capiv1beta1.Condition{
+					Type:     infrav1beta2.TransitGatewayReadyCondition,
+					Status:   "False",
+					Severity: capiv1beta1.ConditionSeverityError,
+					Reason:   infrav1beta2.TransitGatewayReconciliationFailedReason,
+					Message:  "failed to get transit gateway",
+				},
+			},
+		},
+		{
+			name: "When existing transit gateway has no connections, workspace and VPC are attached to it",
+			powerVSClusterScopeFunc: func() *scope.PowerVSClusterScope {
+				clusterScope := &scope.PowerVSClusterScope{
+					IBMPowerVSCluster: &infrav1beta2.IBMPowerVSCluster{
+						Spec: infrav1beta2.IBMPowerVSClusterSpec{
+							ManageTransitGateway: ptr.To(true),
+							ServiceInstanceID:    "serviceInstanceID",
+							ResourceGroup:        &infrav1beta2.IBMPowerVSResourceReference{ID: ptr.To("rgID")},
+							VPC:                  &infrav1beta2.VPCResourceReference{ID: ptr.To("vpcID")},
+							TransitGateway:       &infrav1beta2.TransitGateway{ID: ptr.To("tgID")},
+						},
+					},
+				}
+				mockTransitGateway := tgmock.NewMockTransitGateway(gomock.NewController(t))
+				mockTransitGateway.EXPECT().GetTransitGateway(gomock.Any()).Return(&tgapiv1.TransitGateway{ID: ptr.To("tgID"), Name: ptr.To("tg"), Status: ptr.To(string(infrav1beta2.TransitGatewayStateAvailable))}, nil, nil)
+				mockTransitGateway.EXPECT().ListTransitGatewayConnections(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCollection{}, nil, nil)
+				mockTransitGateway.EXPECT().CreateTransitGatewayConnection(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCust{ID: ptr.To("connID")}, nil, nil).Times(2)
+				mockVPC := vpcmock.NewMockVpc(gomock.NewController(t))
+				mockVPC.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{CRN: ptr.To("vpcCRN")}, nil, nil)
+				mockResourceClient := resourceclientmock.NewMockResourceController(gomock.NewController(t))
+				mockResourceClient.EXPECT().GetResourceInstance(gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{CRN: ptr.To("serviceInstanceCRN")}, nil, nil)
+				clusterScope.TransitGatewayClient = mockTransitGateway
+				clusterScope.IBMVPCClient = mockVPC
+				clusterScope.ResourceClient = mockResourceClient
+				return clusterScope
+			},
+			expectedResult: reconcile.Result{RequeueAfter: 1 * time.Minute},
+		},
+		{
+			name: "When transit gateway connections are attached",
+			powerVSClusterScopeFunc: func() *scope.PowerVSClusterScope {
+				clusterScope := &scope.PowerVSClusterScope{
+					IBMPowerVSCluster: &infrav1beta2.IBMPowerVSCluster{
+						Spec: infrav1beta2.IBMPowerVSClusterSpec{
+							ManageTransitGateway: ptr.To(true),
+							ServiceInstanceID:    "serviceInstanceID",
+							ResourceGroup:        &infrav1beta2.IBMPowerVSResourceReference{ID: ptr.To("rgID")},
+							VPC:                  &infrav1beta2.VPCResourceReference{ID: ptr.To("vpcID")},
+						},
+						Status: infrav1beta2.IBMPowerVSClusterStatus{
+							TransitGateway: &infrav1beta2.TransitGatewayStatus{
+								ID:                ptr.To("tgID"),
+								ControllerCreated: ptr.To(true),
+								PowerVSConnection: &infrav1beta2.ResourceReference{ID: ptr.To("powervsConnID"), ControllerCreated: ptr.To(true)},
+								VPCConnection:     &infrav1beta2.ResourceReference{ID: ptr.To("vpcConnID"), ControllerCreated: ptr.To(true)},
+							},
+						},
+					},
+				}
+				mockTransitGateway := tgmock.NewMockTransitGateway(gomock.NewController(t))
+				mockTransitGateway.EXPECT().GetTransitGateway(gomock.Any()).Return(&tgapiv1.TransitGateway{ID: ptr.To("tgID"), Name: ptr.To("tg"), Status: ptr.To(string(infrav1beta2.TransitGatewayStateAvailable))}, nil, nil)
+				mockTransitGateway.EXPECT().ListTransitGatewayConnections(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCollection{
+					Connections: []tgapiv1.TransitGatewayConnectionCust{
+						{ID: ptr.To("powervsConnID"), Name: ptr.To("powervsConn"), NetworkType: ptr.To("power_virtual_server"), NetworkID: ptr.To("serviceInstanceCRN"), Status: ptr.To(string(infrav1beta2.TransitGatewayConnectionStateAttached))},
+						{ID: ptr.To("vpcConnID"), Name: ptr.To("vpcConn"), NetworkType: ptr.To("vpc"), NetworkID: ptr.To("vpcCRN"), Status: ptr.To(string(infrav1beta2.TransitGatewayConnectionStateAttached))},
+					},
+				}, nil, nil)
+				mockVPC := vpcmock.NewMockVpc(gomock.NewController(t))
+				mockVPC.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{CRN: ptr.To("vpcCRN")}, nil, nil)
+				mockResourceClient := resourceclientmock.NewMockResourceController(gomock.NewController(t))
+				mockResourceClient.EXPECT().GetResourceInstance(gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{CRN: ptr.To("serviceInstanceCRN")}, nil, nil)
+				clusterScope.TransitGatewayClient = mockTransitGateway
+				clusterScope.IBMVPCClient = mockVPC
+				clusterScope.ResourceClient = mockResourceClient
+				return clusterScope
+			},
+			conditions: capiv1beta1.Conditions{
+				capiv1beta1.Condition{
+					Type:   infrav1beta2.TransitGatewayReadyCondition,
+					Status: "True",
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			reconciler := &IBMPowerVSClusterReconciler{}
+			clusterScope := tc.powerVSClusterScopeFunc()
+			result, err := reconciler.reconcileManagedTransitGateway(clusterScope)
+			if tc.expectError {
+				g.Expect(err).ToNot(BeNil())
+			} else {
+				g.Expect(err).To(BeNil())
+			}
+			g.Expect(result).To(Equal(tc.expectedResult))
+			ignoreLastTransitionTime := cmp.Transformer("", func(metav1.Time) metav1.Time {
+				return metav1.Time{}
+			})
+			g.Expect(clusterScope.IBMPowerVSCluster.GetConditions()).To(BeComparableTo(tc.conditions, ignoreLastTransitionTime))
+		})
+	}
+}
+
+func TestDegradedComponents(t *testing.T) {
+	unreachableErr := &url.Error{Op: "Get", URL: "https://transit.cloud.ibm.com", Err: errors.New("connection refused")}
+	testCases := []struct {
+		name             string
+		conditions       capiv1beta1.Conditions
+		err              error
+		expectedResult   reconcile.Result
+		expectError      bool
+		expectedDegraded bool
+		expectedBlocking bool
+		expectedReason   string
+	}{
+		{
+			name:             "When the service of a component which was ready is unreachable",
+			conditions:       capiv1beta1.Conditions{{Type: infrav1beta2.TransitGatewayReadyCondition, Status: corev1.ConditionTrue}},
+			err:              fmt.Errorf("failed to get transit gateway: %w", unreachableErr),
+			expectedResult:   reconcile.Result{RequeueAfter: 1 * time.Minute},
+			expectedDegraded: true,
+			expectedReason:   infrav1beta2.ServiceUnreachableReason,
+		},
+		{
+			name:             "When the service of a component which was never ready is unreachable",
+			err:              unreachableErr,
+			expectedResult:   reconcile.Result{RequeueAfter: 1 * time.Minute},
+			expectedDegraded: true,
+			expectedBlocking: true,
+			expectedReason:   infrav1beta2.ServiceUnreachableReason,
+		},
+		{
+			name:        "When the reconciliation of a component fails",
+			conditions:  capiv1beta1.Conditions{{Type: infrav1beta2.TransitGatewayReadyCondition, Status: corev1.ConditionTrue}},
+			err:         errors.New("invalid transit gateway"),
+			expectError: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			degraded := &degradedComponents{cluster: &infrav1beta2.IBMPowerVSCluster{Status: infrav1beta2.IBMPowerVSClusterStatus{Conditions: tc.conditions}}}
+			result, err := degraded.reconcile(infrav1beta2.TransitGatewayReadyCondition, func() (ctrl.Result, error) {
+				return ctrl.Result{}, tc.err
+			})
+			if tc.expectError {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).ToNot(HaveOccurred())
+				g.Expect(result.IsZero()).To(BeTrue())
+			}
+			g.Expect(degraded.degraded).To(Equal(tc.expectedDegraded))
+			g.Expect(degraded.blocking).To(Equal(tc.expectedBlocking))
+			g.Expect(degraded.result()).To(Equal(tc.expectedResult))
+			if tc.expectedReason != "" {
+				g.Expect(degraded.cluster.Status.Conditions[0].Reason).To(Equal(tc.expectedReason))
+				g.Expect(degraded.cluster.Status.Conditions[0].Severity).To(Equal(capiv1beta1.ConditionSeverityWarning))
+			}
+		})
+	}
+}
+
 func getVPCReadyCondition() capiv1beta1.Condition {
 	return capiv1beta1.Condition{
 		Type:   infrav1beta2.VPCReadyCondition,
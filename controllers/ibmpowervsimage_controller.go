@@ -23,23 +23,30 @@ import (
 
 	"github.com/IBM-Cloud/power-go-client/power/models"
 
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
 
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 
 	capiv1beta1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	clusterv1util "sigs.k8s.io/cluster-api/util"
+	"sigs.k8s.io/cluster-api/util/annotations"
 	"sigs.k8s.io/cluster-api/util/conditions"
 
 	infrav1beta2 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/v1beta2"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloudevents"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/endpoints"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/metrics"
+	capibmrecord "sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/record"
 )
 
 // IBMPowerVSImageReconciler reconciles a IBMPowerVSImage object.
@@ -48,10 +55,13 @@ type IBMPowerVSImageReconciler struct {
 	Recorder        record.EventRecorder
 	ServiceEndpoint []endpoints.ServiceEndpoint
 	Scheme          *runtime.Scheme
+	// Intervals are the intervals at which IBMPowerVSImages are reconciled again.
+	Intervals Intervals
 }
 
 //+kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=ibmpowervsimages,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=ibmpowervsimages/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=ibmpowervsmachines;ibmpowervsmachinetemplates,verbs=get;list;watch
 
 // Reconcile implements controller runtime Reconciler interface and handles reconciliation logic for IBMPowerVSImage.
 func (r *IBMPowerVSImageReconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Result, reterr error) {
@@ -66,6 +76,11 @@ func (r *IBMPowerVSImageReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 		return ctrl.Result{}, err
 	}
 
+	start := time.Now()
+	defer func() {
+		metrics.ObserveReconcile("IBMPowerVSImage", ibmImage, start, reterr)
+	}()
+
 	var cluster *infrav1beta2.IBMPowerVSCluster
 	scopeParams := scope.PowerVSImageScopeParams{
 		Client:          r.Client,
@@ -80,7 +95,32 @@ func (r *IBMPowerVSImageReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 		if err != nil {
 			return ctrl.Result{}, err
 		}
+
+		// Skip the reconciliation while the Cluster is paused, e.g. during clusterctl move.
+		ownerCluster, err := clusterv1util.GetOwnerCluster(ctx, r.Client, cluster.ObjectMeta)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		paused := annotations.HasPaused(ibmImage)
+		if ownerCluster != nil {
+			paused = annotations.IsPaused(ownerCluster, ibmImage)
+		}
+		if paused {
+			log.Info("IBMPowerVSImage or linked Cluster is marked as paused, not reconciling")
+			return ctrl.Result{}, nil
+		}
+
 		scopeParams.Zone = cluster.Spec.Zone
+		scopeParams.CredentialsSecretRef = cluster.Spec.CredentialsSecretRef
+		scopeParams.EnterpriseAccount = cluster.Spec.EnterpriseAccount
+		scopeParams.ClusterUID = cluster.UID
+		setPrivateCloudImageScopeParams(&scopeParams, cluster)
+	} else if ibmCluster, err := scope.GetClusterByName(ctx, r.Client, ibmImage.Namespace, ibmImage.Spec.ClusterName); err == nil {
+		// Use the credentials of the cluster to delete the image as long as the cluster is still available.
+		scopeParams.CredentialsSecretRef = ibmCluster.Spec.CredentialsSecretRef
+		scopeParams.EnterpriseAccount = ibmCluster.Spec.EnterpriseAccount
+		scopeParams.ClusterUID = ibmCluster.UID
+		setPrivateCloudImageScopeParams(&scopeParams, ibmCluster)
 	}
 
 	// Create the scope
@@ -103,7 +143,7 @@ func (r *IBMPowerVSImageReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 		return r.reconcileDelete(imageScope)
 	}
 
-	return r.reconcile(cluster, imageScope)
+	return r.Intervals.withOverrides(imageIntervals(cluster.Spec.ReconcileIntervals)).apply(r.reconcile(cluster, imageScope))
 }
 
 func (r *IBMPowerVSImageReconciler) reconcile(cluster *infrav1beta2.IBMPowerVSCluster, imageScope *scope.PowerVSImageScope) (ctrl.Result, error) {
@@ -131,33 +171,68 @@ func (r *IBMPowerVSImageReconciler) reconcile(cluster *infrav1beta2.IBMPowerVSCl
 		return ctrl.Result{}, nil
 	}
 
-	if jobID := imageScope.GetJobID(); jobID != "" {
-		job, err := imageScope.IBMPowerVSClient.GetJob(jobID)
+	if err := imageScope.ReconcileUsage(context.TODO()); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to reconcile usage of IBMPowerVSImage %s/%s: %w", imageScope.IBMPowerVSImage.Namespace, imageScope.IBMPowerVSImage.Name, err)
+	}
+
+	imageScope.RestoreImportJob()
+	if jobID := imageScope.GetJobID(); jobID != "" && imageScope.IsImportJobFinished() {
+		// The result of the import job is recorded in the status, delete the job so that the finished jobs
+		// don't pile up in the workspace.
+		if err := imageScope.DeleteImportJob(); err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to delete finished import job of IBMPowerVSImage %s/%s: %w", imageScope.IBMPowerVSImage.Namespace, imageScope.IBMPowerVSImage.Name, err)
+		}
+		imageScope.SetJobID("")
+	} else if jobID != "" {
+		job, err := imageScope.GetJob(jobID)
 		if err != nil {
 			imageScope.Info("Unable to get job details")
-			return ctrl.Result{RequeueAfter: 2 * time.Minute}, err
+			return ctrl.Result{}, fmt.Errorf("failed to get import job %s of IBMPowerVSImage %s/%s: %w", jobID, imageScope.IBMPowerVSImage.Namespace, imageScope.IBMPowerVSImage.Name, err)
+		}
+		if job == nil {
+			// The job no longer exists, the image it created is looked up by its name, otherwise the image is imported again.
+			imageScope.Info("Import job not found, releasing the import slot of the workspace", "jobID", jobID)
+			imageScope.ReleaseImportSlot()
+			imageScope.SetJobID("")
+			conditions.Delete(imageScope.IBMPowerVSImage, infrav1beta2.ImportJobRunningCondition)
+			return ctrl.Result{Requeue: true}, nil
 		}
+		imageScope.SetJobStatus(job.Status)
 		switch *job.Status.State {
 		case "completed":
+			imageScope.ReleaseImportSlot()
+			if !conditions.IsTrue(imageScope.IBMPowerVSImage, infrav1beta2.ImageImportedCondition) {
+				cloudevents.Publish(imageScope.IBMPowerVSImage, cloudevents.ImageImported, job.Status.Message)
+			}
 			conditions.MarkTrue(imageScope.IBMPowerVSImage, infrav1beta2.ImageImportedCondition)
+			conditions.MarkFalse(imageScope.IBMPowerVSImage, infrav1beta2.ImportJobRunningCondition, infrav1beta2.ImportJobCompletedReason, capiv1beta1.ConditionSeverityInfo, "%s", job.Status.Message)
 		case "failed":
+			imageScope.ReleaseImportSlot()
 			imageScope.SetNotReady()
 			imageScope.SetImageState(string(infrav1beta2.PowerVSImageStateFailed))
 			conditions.MarkFalse(imageScope.IBMPowerVSImage, infrav1beta2.ImageImportedCondition, infrav1beta2.ImageImportFailedReason, capiv1beta1.ConditionSeverityError, "%s", job.Status.Message)
-			return ctrl.Result{RequeueAfter: 2 * time.Minute}, fmt.Errorf("failed to import image, message: %s", job.Status.Message)
+			conditions.MarkFalse(imageScope.IBMPowerVSImage, infrav1beta2.ImportJobRunningCondition, infrav1beta2.ImportJobFailedReason, capiv1beta1.ConditionSeverityError, "%s", job.Status.Message)
+			return ctrl.Result{}, fmt.Errorf("failed to import image, message: %s", job.Status.Message)
 		case "queued":
 			imageScope.SetNotReady()
 			imageScope.SetImageState(string(infrav1beta2.PowerVSImageStateQue))
 			conditions.MarkFalse(imageScope.IBMPowerVSImage, infrav1beta2.ImageImportedCondition, string(infrav1beta2.PowerVSImageStateQue), capiv1beta1.ConditionSeverityInfo, "%s", job.Status.Message)
+			conditions.MarkFalse(imageScope.IBMPowerVSImage, infrav1beta2.ImportJobRunningCondition, infrav1beta2.ImportJobQueuedReason, capiv1beta1.ConditionSeverityInfo, "%s", job.Status.Message)
 			return ctrl.Result{RequeueAfter: 2 * time.Minute}, nil
 		default:
 			imageScope.SetNotReady()
 			imageScope.SetImageState(string(infrav1beta2.PowerVSImageStateImporting))
 			conditions.MarkFalse(imageScope.IBMPowerVSImage, infrav1beta2.ImageImportedCondition, *job.Status.State, capiv1beta1.ConditionSeverityInfo, "%s", job.Status.Message)
+			conditions.MarkTrue(imageScope.IBMPowerVSImage, infrav1beta2.ImportJobRunningCondition)
 			return ctrl.Result{RequeueAfter: 2 * time.Minute}, nil
 		}
 	}
 
+	// The deleted job of a failed import is not recreated.
+	if conditions.GetReason(imageScope.IBMPowerVSImage, infrav1beta2.ImportJobRunningCondition) == infrav1beta2.ImportJobFailedReason {
+		return ctrl.Result{}, fmt.Errorf("failed to import image, message: %s", imageScope.IBMPowerVSImage.Status.JobMessage)
+	}
+
 	img, jobRef, err := r.getOrCreate(imageScope)
 	if err != nil {
 		imageScope.Error(err, "Unable to import image")
@@ -167,7 +242,10 @@ func (r *IBMPowerVSImageReconciler) reconcile(cluster *infrav1beta2.IBMPowerVSCl
 	if jobRef != nil {
 		imageScope.SetJobID(*jobRef.ID)
 	}
-	return reconcileImage(img, imageScope)
+	if result, err := reconcileImage(img, imageScope); err != nil || !imageScope.IsReady() {
+		return result, err
+	}
+	return reconcileExport(imageScope)
 }
 
 func reconcileImage(img *models.ImageReference, imageScope *scope.PowerVSImageScope) (_ ctrl.Result, reterr error) {
@@ -209,26 +287,145 @@ func reconcileImage(img *models.ImageReference, imageScope *scope.PowerVSImageSc
 	return ctrl.Result{}, nil
 }
 
+// reconcileExport exports the ready image to the Cloud Object Storage bucket of the export in the IBMPowerVSImage spec,
+// and tracks the export job in the status of the export.
+func reconcileExport(imageScope *scope.PowerVSImageScope) (_ ctrl.Result, reterr error) {
+	image := imageScope.IBMPowerVSImage
+	if err := imageScope.RestoreExport(); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to restore export of IBMPowerVSImage %s/%s: %w", image.Namespace, image.Name, err)
+	}
+	defer func() {
+		if err := imageScope.RecordExport(); err != nil && reterr == nil {
+			reterr = fmt.Errorf("failed to record export of IBMPowerVSImage %s/%s: %w", image.Namespace, image.Name, err)
+		}
+	}()
+	if image.Spec.Export == nil {
+		// Removing the export cancels the export in flight.
+		if err := imageScope.DeleteExportJob(); err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to delete export job of IBMPowerVSImage %s/%s: %w", image.Namespace, image.Name, err)
+		}
+		image.Status.Export = nil
+		conditions.Delete(image, infrav1beta2.ImageExportedCondition)
+		return ctrl.Result{}, nil
+	}
+
+	if imageScope.IsExportRequested() {
+		// The export to the previous bucket is superseded.
+		if err := imageScope.DeleteExportJob(); err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to delete export job of IBMPowerVSImage %s/%s: %w", image.Namespace, image.Name, err)
+		}
+		if _, err := imageScope.ExportImage(); err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to export IBMPowerVSImage %s/%s: %w", image.Namespace, image.Name, err)
+		}
+		return ctrl.Result{RequeueAfter: 2 * time.Minute}, nil
+	}
+
+	status := image.Status.Export
+	if status.JobID == "" {
+		// The export job is finished, the image is exported again once the bucket or the region are changed.
+		return ctrl.Result{}, nil
+	}
+	job, err := imageScope.GetJob(status.JobID)
+	if err != nil {
+		imageScope.Info("Unable to get export job details")
+		return ctrl.Result{}, fmt.Errorf("failed to get export job %s of IBMPowerVSImage %s/%s: %w", status.JobID, image.Namespace, image.Name, err)
+	}
+	if job == nil {
+		// The job no longer exists, the result of the export is unknown.
+		imageScope.Info("Export job not found, releasing the import slot of the workspace", "jobID", status.JobID)
+		imageScope.ReleaseImportSlot()
+		status.JobID = ""
+		status.State = "failed"
+		status.Message = "export job not found"
+		conditions.MarkFalse(image, infrav1beta2.ImageExportedCondition, infrav1beta2.ImageExportFailedReason, capiv1beta1.ConditionSeverityError, "%s", status.Message)
+		return ctrl.Result{}, nil
+	}
+	if job.Status != nil {
+		status.State = ptr.Deref(job.Status.State, "")
+		status.Message = job.Status.Message
+	}
+	switch status.State {
+	case "completed":
+		if err := imageScope.DeleteExportJob(); err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to delete finished export job of IBMPowerVSImage %s/%s: %w", image.Namespace, image.Name, err)
+		}
+		if !conditions.IsTrue(image, infrav1beta2.ImageExportedCondition) {
+			cloudevents.Publish(image, cloudevents.ImageExported, status.Bucket)
+		}
+		conditions.MarkTrue(image, infrav1beta2.ImageExportedCondition)
+		return ctrl.Result{}, nil
+	case "failed":
+		if err := imageScope.DeleteExportJob(); err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to delete finished export job of IBMPowerVSImage %s/%s: %w", image.Namespace, image.Name, err)
+		}
+		capibmrecord.Warnf(image, "FailedExportImage", "Failed to export image to bucket %q - %s", status.Bucket, status.Message)
+		conditions.MarkFalse(image, infrav1beta2.ImageExportedCondition, infrav1beta2.ImageExportFailedReason, capiv1beta1.ConditionSeverityError, "%s", status.Message)
+		return ctrl.Result{}, nil
+	default:
+		conditions.MarkFalse(image, infrav1beta2.ImageExportedCondition, infrav1beta2.ImageExportRunningReason, capiv1beta1.ConditionSeverityInfo, "%s", status.Message)
+		return ctrl.Result{RequeueAfter: 2 * time.Minute}, nil
+	}
+}
+
 func (r *IBMPowerVSImageReconciler) reconcileDelete(scope *scope.PowerVSImageScope) (_ ctrl.Result, reterr error) {
 	scope.Info("Handling deleted IBMPowerVSImage")
 
+	// Keep the image while machines still boot from it, unless the deletion is forced.
+	if err := scope.ReconcileUsage(context.TODO()); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to reconcile usage of IBMPowerVSImage %s/%s: %w", scope.IBMPowerVSImage.Namespace, scope.IBMPowerVSImage.Name, err)
+	}
+	if scope.IsInUse() && !scope.IsForceDelete() {
+		if conditions.GetReason(scope.IBMPowerVSImage, infrav1beta2.ImageReadyCondition) != infrav1beta2.ImageInUseReason {
+			capibmrecord.Warnf(scope.IBMPowerVSImage, "ImageInUse", "Deletion of image is blocked as it is used by %s", scope.GetUsage())
+		}
+		conditions.MarkFalse(scope.IBMPowerVSImage, infrav1beta2.ImageReadyCondition, infrav1beta2.ImageInUseReason, capiv1beta1.ConditionSeverityWarning, "image is used by %s", scope.GetUsage())
+		return ctrl.Result{RequeueAfter: 1 * time.Minute}, nil
+	}
+
 	defer func() {
 		if reterr == nil {
 			// Image is deleted so remove the finalizer.
+			scope.ReleaseImportSlot()
 			controllerutil.RemoveFinalizer(scope.IBMPowerVSImage, infrav1beta2.IBMPowerVSImageFinalizer)
 		}
 	}()
 
-	if scope.GetImageID() == "" {
-		scope.Info("ImageID is not yet set, hence not invoking the PowerVS API to delete the image")
-		if scope.GetJobID() == "" {
-			scope.Info("JobID is not yet set, hence not invoking the PowerVS API to delete the image import job")
-			return ctrl.Result{}, nil
-		}
+	scope.RestoreImportJob()
+	// Cancel the import job if it is still in flight, otherwise it keeps running and leaves behind an image
+	// which is no longer tracked by any IBMPowerVSImage.
+	if scope.GetJobID() != "" && !scope.IsImportJobFinished() {
 		if err := scope.DeleteImportJob(); err != nil {
 			scope.Error(err, "Error deleting IBMPowerVSImage Import Job")
 			return ctrl.Result{}, fmt.Errorf("error deleting IBMPowerVSImage Import Job: %w", err)
 		}
+	}
+
+	// Cancel the export job if it is still in flight, as the image is no longer available to the job.
+	if err := scope.RestoreExport(); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to restore export of IBMPowerVSImage %s/%s: %w", scope.IBMPowerVSImage.Namespace, scope.IBMPowerVSImage.Name, err)
+	}
+	if err := scope.DeleteExportJob(); err != nil {
+		scope.Error(err, "Error deleting IBMPowerVSImage Export Job")
+		return ctrl.Result{}, fmt.Errorf("error deleting IBMPowerVSImage Export Job: %w", err)
+	}
+	if err := scope.DeleteSourceExportJob(); err != nil {
+		scope.Error(err, "Error deleting IBMPowerVSImage Source Export Job")
+		return ctrl.Result{}, fmt.Errorf("error deleting IBMPowerVSImage Source Export Job: %w", err)
+	}
+
+	// The interrupted import may have already created the image before its ID was recorded in the status.
+	if scope.GetImageID() == "" && scope.GetJobID() != "" && scope.IBMPowerVSImage.Spec.DeletePolicy != string(infrav1beta2.DeletePolicyRetain) {
+		image, err := scope.GetImportedImage()
+		if err != nil {
+			return ctrl.Result{}, fmt.Errorf("error retrieving image imported for IBMPowerVSImage %v: %w", klog.KObj(scope.IBMPowerVSImage), err)
+		}
+		if image != nil {
+			scope.SetImageID(image.ImageID)
+		}
+	}
+
+	if scope.GetImageID() == "" {
+		scope.Info("ImageID is not yet set, hence not invoking the PowerVS API to delete the image")
 		return ctrl.Result{}, nil
 	}
 
@@ -242,6 +439,16 @@ func (r *IBMPowerVSImageReconciler) reconcileDelete(scope *scope.PowerVSImageSco
 }
 
 func (r *IBMPowerVSImageReconciler) getOrCreate(scope *scope.PowerVSImageScope) (*models.ImageReference, *models.JobReference, error) {
+	if scope.IBMPowerVSImage.Spec.SourceServiceInstance != nil {
+		return scope.CloneWorkspaceImage()
+	}
+	if scope.IBMPowerVSImage.Spec.SourceImage != nil {
+		image, err := scope.CopyStockImage()
+		return image, nil, err
+	}
+	if scope.IBMPowerVSImage.Spec.CaptureInstance != nil {
+		return scope.CaptureInstance()
+	}
 	image, job, err := scope.CreateImageCOSBucket()
 	return image, job, err
 }
@@ -250,9 +457,47 @@ func (r *IBMPowerVSImageReconciler) shouldAdopt(i infrav1beta2.IBMPowerVSImage)
 	return !clusterv1util.HasOwner(i.OwnerReferences, infrav1beta2.GroupVersion.String(), []string{"IBMPowerVSCluster"})
 }
 
+// setPrivateCloudImageScopeParams sets the Power VS private cloud and workspace of the cluster to the image scope parameters,
+// as the workspaces of private clouds can't be looked up in IBM Cloud.
+func setPrivateCloudImageScopeParams(params *scope.PowerVSImageScopeParams, cluster *infrav1beta2.IBMPowerVSCluster) {
+	if cluster.Spec.PrivateCloud == nil {
+		return
+	}
+	params.PrivateCloud = cluster.Spec.PrivateCloud
+	params.Zone = cluster.Spec.Zone
+	params.ServiceInstanceID = cluster.Spec.ServiceInstanceID
+	if params.ServiceInstanceID == "" && cluster.Spec.ServiceInstance != nil && cluster.Spec.ServiceInstance.ID != nil {
+		params.ServiceInstanceID = *cluster.Spec.ServiceInstance.ID
+	}
+}
+
+// ibmPowerVSMachineToIBMPowerVSImage is a handler.MapFunc to be used to enqueue requests for reconciliation
+// of the IBMPowerVSImage referenced by an IBMPowerVSMachine or IBMPowerVSMachineTemplate.
+func ibmPowerVSMachineToIBMPowerVSImage(_ context.Context, o client.Object) []ctrl.Request {
+	var imageRef *corev1.LocalObjectReference
+	switch obj := o.(type) {
+	case *infrav1beta2.IBMPowerVSMachine:
+		imageRef = obj.Spec.ImageRef
+	case *infrav1beta2.IBMPowerVSMachineTemplate:
+		imageRef = obj.Spec.Template.Spec.ImageRef
+	}
+	if imageRef == nil || imageRef.Name == "" {
+		return nil
+	}
+	return []ctrl.Request{{NamespacedName: client.ObjectKey{Namespace: o.GetNamespace(), Name: imageRef.Name}}}
+}
+
 // SetupWithManager sets up the controller with the Manager.
 func (r *IBMPowerVSImageReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&infrav1beta2.IBMPowerVSImage{}).
+		Watches(
+			&infrav1beta2.IBMPowerVSMachine{},
+			handler.EnqueueRequestsFromMapFunc(ibmPowerVSMachineToIBMPowerVSImage),
+		).
+		Watches(
+			&infrav1beta2.IBMPowerVSMachineTemplate{},
+			handler.EnqueueRequestsFromMapFunc(ibmPowerVSMachineToIBMPowerVSImage),
+		).
 		Complete(r)
 }
@@ -22,6 +22,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/IBM-Cloud/power-go-client/power/client/p_cloud_jobs"
 	"github.com/IBM-Cloud/power-go-client/power/models"
 	"go.uber.org/mock/gomock"
 
@@ -120,6 +121,78 @@ func TestIBMPowerVSImageReconciler_Reconcile(t *testing.T) {
 	}
 }
 
+func TestIBMPowerVSImageReconciler_ReconcilePaused(t *testing.T) {
+	newObjects := func(paused bool, imageAnnotations map[string]string) []client.Object {
+		cluster := &capiv1beta1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "capi-cluster",
+				Namespace: "default",
+			},
+			Spec: capiv1beta1.ClusterSpec{
+				Paused: paused,
+			},
+		}
+		powervsCluster := &infrav1beta2.IBMPowerVSCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "capi-powervs-cluster",
+				Namespace: "default",
+				OwnerReferences: []metav1.OwnerReference{
+					{
+						APIVersion: capiv1beta1.GroupVersion.String(),
+						Kind:       "Cluster",
+						Name:       cluster.Name,
+					},
+				},
+			},
+		}
+		powervsImage := &infrav1beta2.IBMPowerVSImage{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "capi-image",
+				Namespace:   "default",
+				Annotations: imageAnnotations,
+			},
+			Spec: infrav1beta2.IBMPowerVSImageSpec{
+				ClusterName: powervsCluster.Name,
+			},
+		}
+		return []client.Object{cluster, powervsCluster, powervsImage}
+	}
+
+	testCases := []struct {
+		name    string
+		objects []client.Object
+	}{
+		{
+			name:    "Should not reconcile IBMPowerVSImage when the Cluster is paused",
+			objects: newObjects(true, nil),
+		},
+		{
+			name:    "Should not reconcile IBMPowerVSImage annotated as paused",
+			objects: newObjects(false, map[string]string{capiv1beta1.PausedAnnotation: ""}),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			reconciler := &IBMPowerVSImageReconciler{
+				Client: fake.NewClientBuilder().WithObjects(tc.objects...).Build(),
+			}
+			result, err := reconciler.Reconcile(ctx, ctrl.Request{
+				NamespacedName: client.ObjectKey{
+					Namespace: "default",
+					Name:      "capi-image",
+				},
+			})
+			g.Expect(err).To(BeNil())
+			g.Expect(result).To(Equal(ctrl.Result{}))
+
+			image := &infrav1beta2.IBMPowerVSImage{}
+			g.Expect(reconciler.Get(ctx, client.ObjectKey{Namespace: "default", Name: "capi-image"}, image)).To(Succeed())
+			g.Expect(image.Finalizers).To(BeEmpty())
+		})
+	}
+}
+
 func TestIBMPowerVSImageReconciler_reconcile(t *testing.T) {
 	var (
 		mockpowervs *mock.MockPowerVS
@@ -215,9 +288,18 @@ func TestIBMPowerVSImageReconciler_reconcile(t *testing.T) {
 				mockpowervs.EXPECT().GetJob(gomock.AssignableToTypeOf(jobID)).Return(nil, errors.New("Error finding the job"))
 				result, err := reconciler.reconcile(powervsCluster, imageScope)
 				g.Expect(err).To(Not(BeNil()))
-				g.Expect(result.RequeueAfter).To(Not(BeZero()))
+				g.Expect(result.RequeueAfter).To(BeZero())
 				g.Expect(imageScope.IBMPowerVSImage.Finalizers).To(ContainElement(infrav1beta2.IBMPowerVSImageFinalizer))
 			})
+			t.Run("When the import job is not found", func(_ *testing.T) {
+				mockpowervs.EXPECT().GetJob(gomock.AssignableToTypeOf(jobID)).Return(nil, fmt.Errorf("failed to get job: %w", p_cloud_jobs.NewPcloudCloudinstancesJobsGetNotFound()))
+				result, err := reconciler.reconcile(powervsCluster, imageScope)
+				g.Expect(err).To(BeNil())
+				g.Expect(result.Requeue).To(BeTrue())
+				g.Expect(imageScope.IBMPowerVSImage.Status.JobID).To(BeEmpty())
+				g.Expect(imageScope.IBMPowerVSImage.Annotations).ToNot(HaveKey(infrav1beta2.ImportJobAnnotation))
+				imageScope.SetJobID(jobID)
+			})
 			job := &models.Job{
 				ID: ptr.To(jobID),
 				Status: &models.Status{
@@ -232,10 +314,13 @@ func TestIBMPowerVSImageReconciler_reconcile(t *testing.T) {
 				g.Expect(imageScope.IBMPowerVSImage.Status.Ready).To(Equal(false))
 				g.Expect(imageScope.IBMPowerVSImage.Status.ImageState).To(BeEquivalentTo(infrav1beta2.PowerVSImageStateQue))
 				expectConditionsImage(g, imageScope.IBMPowerVSImage, []conditionAssertion{{infrav1beta2.ImageImportedCondition, corev1.ConditionFalse, capiv1beta1.ConditionSeverityInfo, string(infrav1beta2.PowerVSImageStateQue)}})
+				expectConditionsImage(g, imageScope.IBMPowerVSImage, []conditionAssertion{{infrav1beta2.ImportJobRunningCondition, corev1.ConditionFalse, capiv1beta1.ConditionSeverityInfo, infrav1beta2.ImportJobQueuedReason}})
 				g.Expect(result.RequeueAfter).To(Not(BeZero()))
 			})
 			t.Run("When importing image is still in progress", func(_ *testing.T) {
 				job.Status.State = ptr.To("")
+				job.Status.Progress = ptr.To("40")
+				job.Status.Message = "importing image"
 				mockpowervs.EXPECT().GetJob(gomock.AssignableToTypeOf("job-1")).Return(job, nil)
 				result, err := reconciler.reconcile(powervsCluster, imageScope)
 				g.Expect(err).To(BeNil())
@@ -243,6 +328,9 @@ func TestIBMPowerVSImageReconciler_reconcile(t *testing.T) {
 				g.Expect(imageScope.IBMPowerVSImage.Status.Ready).To(Equal(false))
 				g.Expect(imageScope.IBMPowerVSImage.Status.ImageState).To(BeEquivalentTo(infrav1beta2.PowerVSImageStateImporting))
 				expectConditionsImage(g, imageScope.IBMPowerVSImage, []conditionAssertion{{infrav1beta2.ImageImportedCondition, corev1.ConditionFalse, capiv1beta1.ConditionSeverityInfo, *job.Status.State}})
+				expectConditionsImage(g, imageScope.IBMPowerVSImage, []conditionAssertion{{conditionType: infrav1beta2.ImportJobRunningCondition, status: corev1.ConditionTrue}})
+				g.Expect(imageScope.IBMPowerVSImage.Status.JobProgress).To(Equal("40"))
+				g.Expect(imageScope.IBMPowerVSImage.Status.JobMessage).To(Equal("importing image"))
 				g.Expect(result.RequeueAfter).To(Not(BeZero()))
 			})
 			t.Run("When import job status is failed", func(_ *testing.T) {
@@ -254,8 +342,19 @@ func TestIBMPowerVSImageReconciler_reconcile(t *testing.T) {
 				g.Expect(imageScope.IBMPowerVSImage.Status.Ready).To(Equal(false))
 				g.Expect(imageScope.IBMPowerVSImage.Status.ImageState).To(BeEquivalentTo(infrav1beta2.PowerVSImageStateFailed))
 				expectConditionsImage(g, imageScope.IBMPowerVSImage, []conditionAssertion{{infrav1beta2.ImageImportedCondition, corev1.ConditionFalse, capiv1beta1.ConditionSeverityError, infrav1beta2.ImageImportFailedReason}})
-				g.Expect(result.RequeueAfter).To(Not(BeZero()))
+				expectConditionsImage(g, imageScope.IBMPowerVSImage, []conditionAssertion{{infrav1beta2.ImportJobRunningCondition, corev1.ConditionFalse, capiv1beta1.ConditionSeverityError, infrav1beta2.ImportJobFailedReason}})
+				g.Expect(result.RequeueAfter).To(BeZero())
 			})
+			t.Run("When the failed import job is recorded", func(_ *testing.T) {
+				mockpowervs.EXPECT().DeleteJob("job-1").Return(nil)
+				result, err := reconciler.reconcile(powervsCluster, imageScope)
+				g.Expect(err).To(Not(BeNil()))
+				g.Expect(imageScope.IBMPowerVSImage.Status.JobID).To(BeEmpty())
+				expectConditionsImage(g, imageScope.IBMPowerVSImage, []conditionAssertion{{infrav1beta2.ImportJobRunningCondition, corev1.ConditionFalse, capiv1beta1.ConditionSeverityError, infrav1beta2.ImportJobFailedReason}})
+				g.Expect(result.RequeueAfter).To(BeZero())
+			})
+			imageScope.IBMPowerVSImage.Status.JobID = jobID
+			conditions.Delete(imageScope.IBMPowerVSImage, infrav1beta2.ImportJobRunningCondition)
 			job.Status.State = ptr.To("completed")
 			images := &models.Images{
 				Images: []*models.ImageReference{
@@ -281,6 +380,7 @@ func TestIBMPowerVSImageReconciler_reconcile(t *testing.T) {
 				State:   "queued",
 			}
 			t.Run("When import job status is completed and image state is queued", func(_ *testing.T) {
+				conditions.Delete(imageScope.IBMPowerVSImage, infrav1beta2.ImportJobRunningCondition)
 				mockpowervs.EXPECT().GetJob(gomock.AssignableToTypeOf("job-1")).Return(job, nil)
 				mockpowervs.EXPECT().GetAllImage().Return(images, nil)
 				mockpowervs.EXPECT().GetImage(gomock.AssignableToTypeOf("capi-image-id")).Return(image, nil)
@@ -292,6 +392,7 @@ func TestIBMPowerVSImageReconciler_reconcile(t *testing.T) {
 				g.Expect(result.RequeueAfter).To(Not(BeZero()))
 			})
 			t.Run("When import job status is completed and image state is undefined", func(_ *testing.T) {
+				conditions.Delete(imageScope.IBMPowerVSImage, infrav1beta2.ImportJobRunningCondition)
 				image.State = "unknown"
 				mockpowervs.EXPECT().GetJob(gomock.AssignableToTypeOf("job-1")).Return(job, nil)
 				mockpowervs.EXPECT().GetAllImage().Return(images, nil)
@@ -304,6 +405,7 @@ func TestIBMPowerVSImageReconciler_reconcile(t *testing.T) {
 				g.Expect(result.RequeueAfter).To(Not(BeZero()))
 			})
 			t.Run("When import job status is completed and image state is active", func(_ *testing.T) {
+				conditions.Delete(imageScope.IBMPowerVSImage, infrav1beta2.ImportJobRunningCondition)
 				image.State = "active"
 				mockpowervs.EXPECT().GetJob(gomock.AssignableToTypeOf("job-1")).Return(job, nil)
 				mockpowervs.EXPECT().GetAllImage().Return(images, nil)
@@ -315,6 +417,16 @@ func TestIBMPowerVSImageReconciler_reconcile(t *testing.T) {
 				g.Expect(imageScope.IBMPowerVSImage.Status.Ready).To(Equal(true))
 				g.Expect(result.RequeueAfter).To(BeZero())
 			})
+			t.Run("When the completed import job is recorded", func(_ *testing.T) {
+				mockpowervs.EXPECT().DeleteJob("job-1").Return(nil)
+				mockpowervs.EXPECT().GetAllImage().Return(images, nil)
+				mockpowervs.EXPECT().GetImage(gomock.AssignableToTypeOf("capi-image-id")).Return(image, nil)
+				result, err := reconciler.reconcile(powervsCluster, imageScope)
+				g.Expect(err).To(BeNil())
+				g.Expect(imageScope.IBMPowerVSImage.Status.JobID).To(BeEmpty())
+				g.Expect(imageScope.IBMPowerVSImage.Status.Ready).To(Equal(true))
+				g.Expect(result.RequeueAfter).To(BeZero())
+			})
 		})
 	})
 }
@@ -337,8 +449,14 @@ func TestIBMPowerVSImageReconciler_delete(t *testing.T) {
 			Recorder: recorder,
 		}
 		imageScope = &scope.PowerVSImageScope{
-			Logger:           klog.Background(),
-			IBMPowerVSImage:  &infrav1beta2.IBMPowerVSImage{},
+			Logger: klog.Background(),
+			Client: fake.NewClientBuilder().Build(),
+			IBMPowerVSImage: &infrav1beta2.IBMPowerVSImage{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "capi-image",
+					Namespace: "default",
+				},
+			},
 			IBMPowerVSClient: mockpowervs,
 		}
 	}
@@ -374,6 +492,79 @@ func TestIBMPowerVSImageReconciler_delete(t *testing.T) {
 			imageScope.IBMPowerVSImage.Status.JobID = "job-1"
 			imageScope.IBMPowerVSImage.Finalizers = []string{infrav1beta2.IBMPowerVSImageFinalizer}
 			mockpowervs.EXPECT().DeleteJob(gomock.AssignableToTypeOf("job-1")).Return(nil)
+			mockpowervs.EXPECT().GetAllImage().Return(&models.Images{}, nil)
+			_, err := reconciler.reconcileDelete(imageScope)
+			g.Expect(err).To(BeNil())
+			g.Expect(imageScope.IBMPowerVSImage.Finalizers).To(Not(ContainElement(infrav1beta2.IBMPowerVSImageFinalizer)))
+		})
+		t.Run("Should delete the import image job which is not found", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t)
+			t.Cleanup(teardown)
+			imageScope.IBMPowerVSImage.Status.JobID = "job-1"
+			imageScope.IBMPowerVSImage.Finalizers = []string{infrav1beta2.IBMPowerVSImageFinalizer}
+			mockpowervs.EXPECT().DeleteJob(gomock.AssignableToTypeOf("job-1")).Return(fmt.Errorf("failed to delete job: %w", p_cloud_jobs.NewPcloudCloudinstancesJobsDeleteNotFound()))
+			mockpowervs.EXPECT().GetAllImage().Return(&models.Images{}, nil)
+			_, err := reconciler.reconcileDelete(imageScope)
+			g.Expect(err).To(BeNil())
+			g.Expect(imageScope.IBMPowerVSImage.Finalizers).To(Not(ContainElement(infrav1beta2.IBMPowerVSImageFinalizer)))
+		})
+		t.Run("Should delete the image created by the interrupted import image job", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t)
+			t.Cleanup(teardown)
+			imageScope.IBMPowerVSImage.Status.JobID = "job-1"
+			imageScope.IBMPowerVSImage.Finalizers = []string{infrav1beta2.IBMPowerVSImageFinalizer}
+			images := &models.Images{
+				Images: []*models.ImageReference{
+					{
+						Name:    ptr.To("capi-image"),
+						ImageID: ptr.To("capi-image-id"),
+					},
+				},
+			}
+			mockpowervs.EXPECT().DeleteJob(gomock.AssignableToTypeOf("job-1")).Return(nil)
+			mockpowervs.EXPECT().GetAllImage().Return(images, nil)
+			mockpowervs.EXPECT().DeleteImage("capi-image-id").Return(nil)
+			_, err := reconciler.reconcileDelete(imageScope)
+			g.Expect(err).To(BeNil())
+			g.Expect(imageScope.IBMPowerVSImage.Finalizers).To(Not(ContainElement(infrav1beta2.IBMPowerVSImageFinalizer)))
+		})
+		t.Run("Should fail to retrieve the image created by the interrupted import image job", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t)
+			t.Cleanup(teardown)
+			imageScope.IBMPowerVSImage.Status.JobID = "job-1"
+			imageScope.IBMPowerVSImage.Finalizers = []string{infrav1beta2.IBMPowerVSImageFinalizer}
+			mockpowervs.EXPECT().DeleteJob(gomock.AssignableToTypeOf("job-1")).Return(nil)
+			mockpowervs.EXPECT().GetAllImage().Return(nil, errors.New("Failed to list the images"))
+			_, err := reconciler.reconcileDelete(imageScope)
+			g.Expect(err).To(Not(BeNil()))
+			g.Expect(imageScope.IBMPowerVSImage.Finalizers).To(ContainElement(infrav1beta2.IBMPowerVSImageFinalizer))
+		})
+		t.Run("Should delete the running import image job and the image", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t)
+			t.Cleanup(teardown)
+			imageScope.IBMPowerVSImage.Status.JobID = "job-1"
+			imageScope.IBMPowerVSImage.Status.ImageID = "capi-image-id"
+			imageScope.IBMPowerVSImage.Finalizers = []string{infrav1beta2.IBMPowerVSImageFinalizer}
+			conditions.MarkTrue(imageScope.IBMPowerVSImage, infrav1beta2.ImportJobRunningCondition)
+			mockpowervs.EXPECT().DeleteJob(gomock.AssignableToTypeOf("job-1")).Return(nil)
+			mockpowervs.EXPECT().DeleteImage(gomock.AssignableToTypeOf("capi-image-id")).Return(nil)
+			_, err := reconciler.reconcileDelete(imageScope)
+			g.Expect(err).To(BeNil())
+			g.Expect(imageScope.IBMPowerVSImage.Finalizers).To(Not(ContainElement(infrav1beta2.IBMPowerVSImageFinalizer)))
+		})
+		t.Run("Should not delete the import image job when it is completed", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t)
+			t.Cleanup(teardown)
+			imageScope.IBMPowerVSImage.Status.JobID = "job-1"
+			imageScope.IBMPowerVSImage.Status.ImageID = "capi-image-id"
+			imageScope.IBMPowerVSImage.Finalizers = []string{infrav1beta2.IBMPowerVSImageFinalizer}
+			conditions.MarkFalse(imageScope.IBMPowerVSImage, infrav1beta2.ImportJobRunningCondition, infrav1beta2.ImportJobCompletedReason, capiv1beta1.ConditionSeverityInfo, "")
+			mockpowervs.EXPECT().DeleteImage(gomock.AssignableToTypeOf("capi-image-id")).Return(nil)
 			_, err := reconciler.reconcileDelete(imageScope)
 			g.Expect(err).To(BeNil())
 			g.Expect(imageScope.IBMPowerVSImage.Finalizers).To(Not(ContainElement(infrav1beta2.IBMPowerVSImageFinalizer)))
@@ -400,9 +591,61 @@ func TestIBMPowerVSImageReconciler_delete(t *testing.T) {
 			g.Expect(err).To(BeNil())
 			g.Expect(imageScope.IBMPowerVSImage.Finalizers).To(Not(ContainElement(infrav1beta2.IBMPowerVSImageFinalizer)))
 		})
+		t.Run("Should not delete the image while it is used by machines", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t)
+			t.Cleanup(teardown)
+			imageScope.Client = fake.NewClientBuilder().WithObjects(inUseImageObjects()...).Build()
+			imageScope.IBMPowerVSImage.Status.ImageID = "capi-image-id"
+			imageScope.IBMPowerVSImage.Finalizers = []string{infrav1beta2.IBMPowerVSImageFinalizer}
+			result, err := reconciler.reconcileDelete(imageScope)
+			g.Expect(err).To(BeNil())
+			g.Expect(result.RequeueAfter).To(Not(BeZero()))
+			g.Expect(imageScope.IBMPowerVSImage.Finalizers).To(ContainElement(infrav1beta2.IBMPowerVSImageFinalizer))
+			g.Expect(imageScope.IBMPowerVSImage.Status.UsedBy).To(Equal([]infrav1beta2.ImageReferrer{
+				{Kind: "IBMPowerVSMachine", Name: "capi-machine"},
+				{Kind: "IBMPowerVSMachineTemplate", Name: "capi-machine-template"},
+			}))
+			g.Expect(conditions.GetReason(imageScope.IBMPowerVSImage, infrav1beta2.ImageReadyCondition)).To(Equal(infrav1beta2.ImageInUseReason))
+		})
+		t.Run("Should delete the image used by machines when the deletion is forced", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t)
+			t.Cleanup(teardown)
+			imageScope.Client = fake.NewClientBuilder().WithObjects(inUseImageObjects()...).Build()
+			imageScope.IBMPowerVSImage.Annotations = map[string]string{infrav1beta2.ForceDeleteAnnotation: ""}
+			imageScope.IBMPowerVSImage.Status.ImageID = "capi-image-id"
+			imageScope.IBMPowerVSImage.Finalizers = []string{infrav1beta2.IBMPowerVSImageFinalizer}
+			mockpowervs.EXPECT().DeleteImage(gomock.AssignableToTypeOf("capi-image-id")).Return(nil)
+			_, err := reconciler.reconcileDelete(imageScope)
+			g.Expect(err).To(BeNil())
+			g.Expect(imageScope.IBMPowerVSImage.Finalizers).To(Not(ContainElement(infrav1beta2.IBMPowerVSImageFinalizer)))
+		})
 	})
 }
 
+func inUseImageObjects() []client.Object {
+	imageRef := &corev1.LocalObjectReference{Name: "capi-image"}
+	return []client.Object{
+		&infrav1beta2.IBMPowerVSMachine{
+			ObjectMeta: metav1.ObjectMeta{Name: "capi-machine", Namespace: "default"},
+			Spec:       infrav1beta2.IBMPowerVSMachineSpec{ImageRef: imageRef},
+		},
+		&infrav1beta2.IBMPowerVSMachine{
+			ObjectMeta: metav1.ObjectMeta{Name: "capi-other-machine", Namespace: "default"},
+			Spec:       infrav1beta2.IBMPowerVSMachineSpec{ImageRef: &corev1.LocalObjectReference{Name: "capi-other-image"}},
+		},
+		&infrav1beta2.IBMPowerVSMachineTemplate{
+			ObjectMeta: metav1.ObjectMeta{Name: "capi-machine-template", Namespace: "default"},
+			Spec: infrav1beta2.IBMPowerVSMachineTemplateSpec{
+				Template: infrav1beta2.IBMPowerVSMachineTemplateResource{
+					Spec: infrav1beta2.IBMPowerVSMachineSpec{ImageRef: imageRef},
+				},
+			},
+		},
+	}
+}
+
 func expectConditionsImage(g *WithT, m *infrav1beta2.IBMPowerVSImage, expected []conditionAssertion) {
 	g.Expect(len(m.Status.Conditions)).To(BeNumerically(">=", len(expected)))
 	for _, c := range expected {
@@ -414,3 +657,181 @@ func expectConditionsImage(g *WithT, m *infrav1beta2.IBMPowerVSImage, expected [
 		g.Expect(actual.Reason).To(Equal(c.reason))
 	}
 }
+
+func TestReconcileExport(t *testing.T) {
+	var (
+		mockpowervs *mock.MockPowerVS
+		mockCtrl    *gomock.Controller
+	)
+
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockpowervs = mock.NewMockPowerVS(mockCtrl)
+	}
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+	newImageScope := func(export *infrav1beta2.PowerVSImageExport, status *infrav1beta2.PowerVSImageExportStatus) *scope.PowerVSImageScope {
+		return &scope.PowerVSImageScope{
+			Logger:           klog.Background(),
+			IBMPowerVSClient: mockpowervs,
+			IBMPowerVSImage: &infrav1beta2.IBMPowerVSImage{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "capi-image",
+					Namespace: "default",
+				},
+				Spec: infrav1beta2.IBMPowerVSImageSpec{
+					ServiceInstanceID: "service-instance-1",
+					Export:            export,
+				},
+				Status: infrav1beta2.IBMPowerVSImageStatus{
+					ImageID: "capi-image-id",
+					Export:  status,
+				},
+			},
+		}
+	}
+	export := &infrav1beta2.PowerVSImageExport{
+		Bucket:               "export-bucket",
+		Region:               "us-south",
+		CredentialsSecretRef: corev1.LocalObjectReference{Name: "cos-hmac"},
+	}
+
+	t.Run("Should do nothing when no export is requested", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		imageScope := newImageScope(nil, nil)
+		result, err := reconcileExport(imageScope)
+		g.Expect(err).To(BeNil())
+		g.Expect(result.RequeueAfter).To(BeZero())
+		g.Expect(conditions.Has(imageScope.IBMPowerVSImage, infrav1beta2.ImageExportedCondition)).To(BeFalse())
+	})
+
+	t.Run("Should cancel the export in flight when the export is removed", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		imageScope := newImageScope(nil, &infrav1beta2.PowerVSImageExportStatus{Bucket: "export-bucket", Region: "us-south", JobID: "export-job"})
+		conditions.MarkFalse(imageScope.IBMPowerVSImage, infrav1beta2.ImageExportedCondition, infrav1beta2.ImageExportRunningReason, capiv1beta1.ConditionSeverityInfo, "")
+		mockpowervs.EXPECT().DeleteJob("export-job").Return(nil)
+		_, err := reconcileExport(imageScope)
+		g.Expect(err).To(BeNil())
+		g.Expect(imageScope.IBMPowerVSImage.Status.Export).To(BeNil())
+		g.Expect(conditions.Has(imageScope.IBMPowerVSImage, infrav1beta2.ImageExportedCondition)).To(BeFalse())
+	})
+
+	t.Run("Should create the export job when the export is requested", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		imageScope := newImageScope(export, nil)
+		imageScope.Client = fake.NewClientBuilder().WithObjects(&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "cos-hmac", Namespace: "default"},
+			Data: map[string][]byte{
+				scope.COSAccessKey: []byte("access-key"),
+				scope.COSSecretKey: []byte("secret-key"),
+			},
+		}).Build()
+		t.Cleanup(imageScope.ReleaseImportSlot)
+		mockpowervs.EXPECT().ExportImage("capi-image-id", gomock.AssignableToTypeOf(&models.ExportImage{})).Return(&models.JobReference{ID: ptr.To("export-job")}, nil)
+		result, err := reconcileExport(imageScope)
+		g.Expect(err).To(BeNil())
+		g.Expect(result.RequeueAfter).To(Equal(2 * time.Minute))
+		g.Expect(imageScope.IBMPowerVSImage.Status.Export.JobID).To(Equal("export-job"))
+		g.Expect(conditions.GetReason(imageScope.IBMPowerVSImage, infrav1beta2.ImageExportedCondition)).To(Equal(infrav1beta2.ImageExportRunningReason))
+	})
+
+	t.Run("Should requeue while the export job is running", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		imageScope := newImageScope(export, &infrav1beta2.PowerVSImageExportStatus{Bucket: "export-bucket", Region: "us-south", JobID: "export-job"})
+		mockpowervs.EXPECT().GetJob("export-job").Return(&models.Job{Status: &models.Status{State: ptr.To("running"), Message: "uploading"}}, nil)
+		result, err := reconcileExport(imageScope)
+		g.Expect(err).To(BeNil())
+		g.Expect(result.RequeueAfter).To(Equal(2 * time.Minute))
+		g.Expect(imageScope.IBMPowerVSImage.Status.Export.State).To(Equal("running"))
+		g.Expect(conditions.GetReason(imageScope.IBMPowerVSImage, infrav1beta2.ImageExportedCondition)).To(Equal(infrav1beta2.ImageExportRunningReason))
+	})
+
+	t.Run("Should mark the image exported when the export job is completed", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		imageScope := newImageScope(export, &infrav1beta2.PowerVSImageExportStatus{Bucket: "export-bucket", Region: "us-south", JobID: "export-job"})
+		mockpowervs.EXPECT().GetJob("export-job").Return(&models.Job{Status: &models.Status{State: ptr.To("completed")}}, nil)
+		mockpowervs.EXPECT().DeleteJob("export-job").Return(nil)
+		result, err := reconcileExport(imageScope)
+		g.Expect(err).To(BeNil())
+		g.Expect(result.RequeueAfter).To(BeZero())
+		g.Expect(imageScope.IBMPowerVSImage.Status.Export.JobID).To(BeEmpty())
+		g.Expect(conditions.IsTrue(imageScope.IBMPowerVSImage, infrav1beta2.ImageExportedCondition)).To(BeTrue())
+	})
+
+	t.Run("Should mark the export failed when the export job is failed", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		imageScope := newImageScope(export, &infrav1beta2.PowerVSImageExportStatus{Bucket: "export-bucket", Region: "us-south", JobID: "export-job"})
+		mockpowervs.EXPECT().GetJob("export-job").Return(&models.Job{Status: &models.Status{State: ptr.To("failed"), Message: "access denied"}}, nil)
+		mockpowervs.EXPECT().DeleteJob("export-job").Return(nil)
+		result, err := reconcileExport(imageScope)
+		g.Expect(err).To(BeNil())
+		g.Expect(result.RequeueAfter).To(BeZero())
+		g.Expect(conditions.GetReason(imageScope.IBMPowerVSImage, infrav1beta2.ImageExportedCondition)).To(Equal(infrav1beta2.ImageExportFailedReason))
+		g.Expect(conditions.GetMessage(imageScope.IBMPowerVSImage, infrav1beta2.ImageExportedCondition)).To(Equal("access denied"))
+	})
+
+	t.Run("Error while getting the export job", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		imageScope := newImageScope(export, &infrav1beta2.PowerVSImageExportStatus{Bucket: "export-bucket", Region: "us-south", JobID: "export-job"})
+		mockpowervs.EXPECT().GetJob("export-job").Return(nil, errors.New("failed to get job"))
+		result, err := reconcileExport(imageScope)
+		g.Expect(err).ToNot(BeNil())
+		g.Expect(result.RequeueAfter).To(BeZero())
+	})
+
+	t.Run("Should resume the export job restored from the annotation", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		imageScope := newImageScope(export, nil)
+		imageScope.IBMPowerVSImage.Annotations = map[string]string{infrav1beta2.ExportAnnotation: `{"bucket":"export-bucket","region":"us-south","jobID":"export-job","state":"running"}`}
+		t.Cleanup(imageScope.ReleaseImportSlot)
+		mockpowervs.EXPECT().GetJob("export-job").Return(&models.Job{Status: &models.Status{State: ptr.To("running"), Message: "uploading"}}, nil)
+		result, err := reconcileExport(imageScope)
+		g.Expect(err).To(BeNil())
+		g.Expect(result.RequeueAfter).To(Equal(2 * time.Minute))
+		g.Expect(imageScope.IBMPowerVSImage.Status.Export.JobID).To(Equal("export-job"))
+		g.Expect(imageScope.IBMPowerVSImage.Status.Export.Message).To(Equal("uploading"))
+	})
+
+	t.Run("Should record the export in the annotation", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		imageScope := newImageScope(export, &infrav1beta2.PowerVSImageExportStatus{Bucket: "export-bucket", Region: "us-south", JobID: "export-job"})
+		mockpowervs.EXPECT().GetJob("export-job").Return(&models.Job{Status: &models.Status{State: ptr.To("completed")}}, nil)
+		mockpowervs.EXPECT().DeleteJob("export-job").Return(nil)
+		_, err := reconcileExport(imageScope)
+		g.Expect(err).To(BeNil())
+		g.Expect(imageScope.IBMPowerVSImage.Annotations).To(HaveKeyWithValue(infrav1beta2.ExportAnnotation, `{"bucket":"export-bucket","region":"us-south","state":"completed"}`))
+	})
+
+	t.Run("Should mark the export failed when the export job is not found", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		imageScope := newImageScope(export, &infrav1beta2.PowerVSImageExportStatus{Bucket: "export-bucket", Region: "us-south", JobID: "export-job"})
+		mockpowervs.EXPECT().GetJob("export-job").Return(nil, fmt.Errorf("failed to get job: %w", p_cloud_jobs.NewPcloudCloudinstancesJobsGetNotFound()))
+		result, err := reconcileExport(imageScope)
+		g.Expect(err).To(BeNil())
+		g.Expect(result.RequeueAfter).To(BeZero())
+		g.Expect(imageScope.IBMPowerVSImage.Status.Export.JobID).To(BeEmpty())
+		g.Expect(conditions.GetReason(imageScope.IBMPowerVSImage, infrav1beta2.ImageExportedCondition)).To(Equal(infrav1beta2.ImageExportFailedReason))
+	})
+}
@@ -40,13 +40,16 @@ import (
 
 	capiv1beta1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	"sigs.k8s.io/cluster-api/util"
+	"sigs.k8s.io/cluster-api/util/annotations"
 	"sigs.k8s.io/cluster-api/util/conditions"
 	"sigs.k8s.io/cluster-api/util/predicates"
 
 	infrav1beta2 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/v1beta2"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/cloud/scope"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/powervs"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloudevents"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/endpoints"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/metrics"
 	capibmrecord "sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/record"
 )
 
@@ -57,6 +60,8 @@ type IBMPowerVSMachineReconciler struct {
 	Recorder        record.EventRecorder
 	ServiceEndpoint []endpoints.ServiceEndpoint
 	Scheme          *runtime.Scheme
+	// Intervals are the intervals at which IBMPowerVSMachines are reconciled again.
+	Intervals Intervals
 }
 
 // dhcpCacheStore is a cache store to hold the Power VS VM DHCP IP.
@@ -68,6 +73,7 @@ func init() {
 
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=ibmpowervsmachines,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=ibmpowervsmachines/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=machines,verbs=delete
 
 // Reconcile implements controller runtime Reconciler interface and handles reconcileation logic for IBMPowerVSMachine.
 func (r *IBMPowerVSMachineReconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Result, reterr error) {
@@ -82,6 +88,11 @@ func (r *IBMPowerVSMachineReconciler) Reconcile(ctx context.Context, req ctrl.Re
 		return ctrl.Result{}, err
 	}
 
+	start := time.Now()
+	defer func() {
+		metrics.ObserveReconcile("IBMPowerVSMachine", ibmPowerVSMachine, start, reterr)
+	}()
+
 	// Fetch the Machine.
 	machine, err := util.GetOwnerMachine(ctx, r.Client, ibmPowerVSMachine.ObjectMeta)
 	if err != nil {
@@ -102,6 +113,12 @@ func (r *IBMPowerVSMachineReconciler) Reconcile(ctx context.Context, req ctrl.Re
 
 	log = log.WithValues("cluster", cluster.Name)
 
+	// Skip the reconciliation while the Cluster is paused, e.g. during clusterctl move.
+	if annotations.IsPaused(cluster, ibmPowerVSMachine) {
+		log.Info("IBMPowerVSMachine or linked Cluster is marked as paused, not reconciling")
+		return ctrl.Result{}, nil
+	}
+
 	ibmCluster := &infrav1beta2.IBMPowerVSCluster{}
 	ibmPowerVSClusterName := client.ObjectKey{
 		Namespace: ibmPowerVSMachine.Namespace,
@@ -125,6 +142,19 @@ func (r *IBMPowerVSMachineReconciler) Reconcile(ctx context.Context, req ctrl.Re
 		}
 	}
 
+	var ibmPowerVSNetwork *infrav1beta2.IBMPowerVSNetwork
+	if ibmPowerVSMachine.Spec.NetworkRef != nil {
+		ibmPowerVSNetwork = &infrav1beta2.IBMPowerVSNetwork{}
+		ibmPowerVSNetworkName := client.ObjectKey{
+			Namespace: ibmPowerVSMachine.Namespace,
+			Name:      ibmPowerVSMachine.Spec.NetworkRef.Name,
+		}
+		if err := r.Client.Get(ctx, ibmPowerVSNetworkName, ibmPowerVSNetwork); err != nil {
+			log.Info("IBMPowerVSNetwork is not available yet", "IBMPowerVSNetwork", klog.KObj(ibmPowerVSNetwork))
+			return ctrl.Result{}, nil
+		}
+	}
+
 	// Create the machine scope.
 	machineScope, err := scope.NewPowerVSMachineScope(scope.PowerVSMachineScopeParams{
 		Client:            r.Client,
@@ -134,6 +164,7 @@ func (r *IBMPowerVSMachineReconciler) Reconcile(ctx context.Context, req ctrl.Re
 		Machine:           machine,
 		IBMPowerVSMachine: ibmPowerVSMachine,
 		IBMPowerVSImage:   ibmPowerVSImage,
+		IBMPowerVSNetwork: ibmPowerVSNetwork,
 		ServiceEndpoint:   r.ServiceEndpoint,
 		DHCPIPCacheStore:  dhcpCacheStore,
 	})
@@ -156,12 +187,37 @@ func (r *IBMPowerVSMachineReconciler) Reconcile(ctx context.Context, req ctrl.Re
 	}
 
 	// Handle non-deleted machines.
-	return r.reconcileNormal(machineScope)
+	result, err := r.Intervals.withOverrides(machineIntervals(ibmCluster.Spec.ReconcileIntervals)).apply(r.reconcileNormal(machineScope))
+	if err != nil {
+		return result, err
+	}
+	// Check the health of the instance again at the interval of its health check, whether the machine is ready or not.
+	requeueAfter, err := machineScope.ReconcileInstanceHealth()
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if requeueAfter > 0 && (result.RequeueAfter == 0 || requeueAfter < result.RequeueAfter) {
+		result.RequeueAfter = requeueAfter
+	}
+	return result, nil
 }
 
 func (r *IBMPowerVSMachineReconciler) reconcileDelete(scope *scope.PowerVSMachineScope) (_ ctrl.Result, reterr error) {
 	scope.Info("Handling deleted IBMPowerVSMachine")
 
+	if scope.IBMPowerVSMachine.Status.InstanceID != "" {
+		if result, err := r.reconcileInstanceTeardown(scope); err != nil || !result.IsZero() {
+			return result, err
+		}
+	}
+
+	if requeue, err := scope.DeleteDataVolumes(); err != nil {
+		return ctrl.Result{}, fmt.Errorf("error deleting data volumes of IBMPowerVSMachine %v: %w", klog.KObj(scope.IBMPowerVSMachine), err)
+	} else if requeue {
+		scope.Info("Waiting for the data volumes to be available before deleting them")
+		return ctrl.Result{RequeueAfter: 15 * time.Second}, nil
+	}
+
 	defer func() {
 		if reterr == nil {
 			// VSI is deleted so remove the finalizer.
@@ -171,6 +227,15 @@ func (r *IBMPowerVSMachineReconciler) reconcileDelete(scope *scope.PowerVSMachin
 
 	if scope.IBMPowerVSMachine.Status.InstanceID == "" {
 		scope.Info("InstanceID is not yet set, hence not invoking the PowerVS API to delete the instance")
+		if err := scope.DeleteOrphanBootVolumes(); err != nil {
+			return ctrl.Result{}, fmt.Errorf("error deleting orphan boot volumes of IBMPowerVSMachine %v: %w", klog.KObj(scope.IBMPowerVSMachine), err)
+		}
+		// The ignition may have already been staged in COS before the instance creation was interrupted.
+		if scope.UseIgnition() && scope.Machine != nil && scope.Machine.Spec.Bootstrap.DataSecretName != nil {
+			if err := scope.DeleteMachineIgnition(); err != nil {
+				return ctrl.Result{}, fmt.Errorf("error deleting IBMPowerVSMachine ignition %v: %w", klog.KObj(scope.IBMPowerVSMachine), err)
+			}
+		}
 		return ctrl.Result{}, nil
 	}
 	if err := scope.DeleteMachine(); err != nil {
@@ -189,6 +254,27 @@ func (r *IBMPowerVSMachineReconciler) reconcileDelete(scope *scope.PowerVSMachin
 	return ctrl.Result{}, nil
 }
 
+// reconcileInstanceTeardown shuts down the instance and detaches its data volumes before the instance gets deleted,
+// so the data written to the volumes is not corrupted by the deletion of a running instance.
+func (r *IBMPowerVSMachineReconciler) reconcileInstanceTeardown(scope *scope.PowerVSMachineScope) (ctrl.Result, error) {
+	conditions.MarkFalse(scope.IBMPowerVSMachine, infrav1beta2.InstanceReadyCondition, capiv1beta1.DeletingReason, capiv1beta1.ConditionSeverityInfo, "")
+
+	if requeue, err := scope.ReconcileInstanceShutdown(); err != nil {
+		return ctrl.Result{}, fmt.Errorf("error shutting down instance of IBMPowerVSMachine %v: %w", klog.KObj(scope.IBMPowerVSMachine), err)
+	} else if requeue {
+		scope.Info("Waiting for the instance to shut down before deleting it")
+		return ctrl.Result{RequeueAfter: 15 * time.Second}, nil
+	}
+
+	if requeue, err := scope.ReconcileVolumeDetach(); err != nil {
+		return ctrl.Result{}, fmt.Errorf("error detaching volumes of IBMPowerVSMachine %v: %w", klog.KObj(scope.IBMPowerVSMachine), err)
+	} else if requeue {
+		scope.Info("Waiting for the data volumes to detach before deleting the instance")
+		return ctrl.Result{RequeueAfter: 15 * time.Second}, nil
+	}
+	return ctrl.Result{}, nil
+}
+
 func (r *IBMPowerVSMachineReconciler) getOrCreate(scope *scope.PowerVSMachineScope) (*models.PVMInstanceReference, error) {
 	instance, err := scope.CreateMachine()
 	return instance, err
@@ -224,6 +310,14 @@ func (r *IBMPowerVSMachineReconciler) reconcileNormal(machineScope *scope.PowerV
 		}
 	}
 
+	if machineScope.IBMPowerVSNetwork != nil {
+		if !machineScope.IBMPowerVSNetwork.Status.Ready {
+			machineScope.Info("IBMPowerVSNetwork is not ready yet")
+			conditions.MarkFalse(machineScope.IBMPowerVSMachine, infrav1beta2.InstanceReadyCondition, infrav1beta2.WaitingForIBMPowerVSNetworkReason, capiv1beta1.ConditionSeverityInfo, "")
+			return ctrl.Result{RequeueAfter: 1 * time.Minute}, nil
+		}
+	}
+
 	// Make sure bootstrap data is available and populated.
 	if machineScope.Machine.Spec.Bootstrap.DataSecretName == nil {
 		machineScope.Info("Bootstrap data secret reference is not yet available")
@@ -235,6 +329,41 @@ func (r *IBMPowerVSMachineReconciler) reconcileNormal(machineScope *scope.PowerV
 		return ctrl.Result{}, nil
 	}
 
+	waiting, err := machineScope.IsWaitingForPreviousControlPlaneMachines()
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if waiting {
+		machineScope.Info("Waiting for the previously created control plane machines to be ready")
+		conditions.MarkFalse(machineScope.IBMPowerVSMachine, infrav1beta2.InstanceReadyCondition, infrav1beta2.WaitingForControlPlaneMachinesReason, capiv1beta1.ConditionSeverityInfo, "")
+		return ctrl.Result{RequeueAfter: 30 * time.Second}, nil
+	}
+
+	exceeded, err := machineScope.ExceededGuardrails()
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to check guardrails for IBMPowerVSMachine %s/%s: %w", machineScope.IBMPowerVSMachine.Namespace, machineScope.IBMPowerVSMachine.Name, err)
+	}
+	if exceeded != "" {
+		machineScope.Info("Not creating the instance as it exceeds the guardrails of the cluster", "exceeded", exceeded)
+		if conditions.GetReason(machineScope.IBMPowerVSMachine, infrav1beta2.InstanceReadyCondition) != infrav1beta2.GuardrailsExceededReason {
+			capibmrecord.Warnf(machineScope.IBMPowerVSMachine, "GuardrailsExceeded", "Instance of machine %s is not created as it exceeds the guardrails of the cluster: %s", machineScope.IBMPowerVSMachine.Name, exceeded)
+		}
+		conditions.MarkFalse(machineScope.IBMPowerVSMachine, infrav1beta2.InstanceReadyCondition, infrav1beta2.GuardrailsExceededReason, capiv1beta1.ConditionSeverityWarning, "%s", exceeded)
+		return ctrl.Result{RequeueAfter: 1 * time.Minute}, nil
+	}
+
+	if machineScope.IBMPowerVSMachine.Status.InstanceID == "" {
+		if requeue, err := machineScope.ReconcileDataVolumes(); err != nil {
+			machineScope.Error(err, "Unable to create data volumes")
+			conditions.MarkFalse(machineScope.IBMPowerVSMachine, infrav1beta2.InstanceReadyCondition, infrav1beta2.InstanceProvisionFailedReason, capiv1beta1.ConditionSeverityError, "%s", err.Error())
+			return ctrl.Result{}, fmt.Errorf("failed to reconcile data volumes for IBMPowerVSMachine %s/%s: %w", machineScope.IBMPowerVSMachine.Namespace, machineScope.IBMPowerVSMachine.Name, err)
+		} else if requeue {
+			machineScope.Info("Waiting for the data volumes to be available before creating the instance")
+			conditions.MarkFalse(machineScope.IBMPowerVSMachine, infrav1beta2.InstanceReadyCondition, infrav1beta2.WaitingForDataVolumesReason, capiv1beta1.ConditionSeverityInfo, "")
+			return ctrl.Result{RequeueAfter: 15 * time.Second}, nil
+		}
+	}
+
 	ins, err := r.getOrCreate(machineScope)
 	if err != nil {
 		machineScope.Error(err, "Unable to create instance")
@@ -254,6 +383,10 @@ func (r *IBMPowerVSMachineReconciler) reconcileNormal(machineScope *scope.PowerV
 		machineScope.SetAddresses(instance)
 		machineScope.SetHealth(instance.Health)
 		machineScope.SetInstanceState(instance.Status)
+		if err := machineScope.ReconcileDebugDump(instance); err != nil {
+			machineScope.Error(err, "failed to reconcile debug dump of the instance")
+		}
+		machineScope.ReconcileBootstrapSucceeded()
 		switch machineScope.GetInstanceState() {
 		case infrav1beta2.PowerVSInstanceStateBUILD:
 			machineScope.SetNotReady()
@@ -270,11 +403,22 @@ func (r *IBMPowerVSMachineReconciler) reconcileNormal(machineScope *scope.PowerV
 			if instance.Fault != nil {
 				msg = instance.Fault.Details
 			}
+			// Publish the failure once, when the machine transitions into the failed state.
+			if machineScope.IBMPowerVSMachine.Status.FailureReason == nil {
+				cloudevents.Publish(machineScope.IBMPowerVSMachine, cloudevents.MachineFailed, msg)
+			}
 			machineScope.SetNotReady()
 			machineScope.SetFailureReason(infrav1beta2.UpdateMachineError)
 			machineScope.SetFailureMessage(msg)
 			conditions.MarkFalse(machineScope.IBMPowerVSMachine, infrav1beta2.InstanceReadyCondition, infrav1beta2.InstanceErroredReason, capiv1beta1.ConditionSeverityError, "%s", msg)
 			capibmrecord.Warnf(machineScope.IBMPowerVSMachine, "FailedBuildInstance", "Failed to build the instance - %s", msg)
+			// Replace the Machine without waiting for a MachineHealthCheck when the auto-repair policy allows it.
+			if machineScope.ShouldAutoRepair() {
+				capibmrecord.Warnf(machineScope.IBMPowerVSMachine, "AutoRepairInstance", "Instance %s is in ERROR state, deleting Machine %s", machineScope.GetInstanceID(), machineScope.Machine.Name)
+				if err := machineScope.DeleteOwnerMachine("the instance is in ERROR state"); err != nil {
+					return ctrl.Result{}, err
+				}
+			}
 			return ctrl.Result{}, nil
 		default:
 			machineScope.SetNotReady()
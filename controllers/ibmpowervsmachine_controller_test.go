@@ -290,6 +290,7 @@ func TestIBMPowerVSMachineReconciler_Delete(t *testing.T) {
 				IBMPowerVSMachine: pvsmachine,
 				IBMPowerVSCluster: &infrav1beta2.IBMPowerVSCluster{},
 			}
+			mockpowervs.EXPECT().GetAllVolumes().Return(&models.Volumes{}, nil)
 			_, err := reconciler.reconcileDelete(machineScope)
 			g.Expect(err).To(BeNil())
 			g.Expect(len(machineScope.IBMPowerVSMachine.Finalizers)).To(BeZero())
@@ -305,13 +306,17 @@ func TestIBMPowerVSMachineReconciler_Delete(t *testing.T) {
 					ObjectMeta: metav1.ObjectMeta{
 						Finalizers: []string{infrav1beta2.IBMPowerVSMachineFinalizer},
 					},
-					Spec: infrav1beta2.IBMPowerVSMachineSpec{},
+					Spec: infrav1beta2.IBMPowerVSMachineSpec{
+						ShutdownTimeout: &metav1.Duration{Duration: 5 * time.Minute},
+					},
 					Status: infrav1beta2.IBMPowerVSMachineStatus{
 						InstanceID: "powervs-instance-id",
 					},
 				},
 				IBMPowerVSCluster: &infrav1beta2.IBMPowerVSCluster{},
 			}
+			mockpowervs.EXPECT().GetInstance(machineScope.IBMPowerVSMachine.Status.InstanceID).Return(&models.PVMInstance{Status: ptr.To("SHUTOFF")}, nil)
+			mockpowervs.EXPECT().GetAllInstanceVolumes(machineScope.IBMPowerVSMachine.Status.InstanceID).Return(&models.Volumes{}, nil)
 			mockpowervs.EXPECT().DeleteInstance(machineScope.IBMPowerVSMachine.Status.InstanceID).Return(errors.New("Could not delete PowerVS instance"))
 			_, err := reconciler.reconcileDelete(machineScope)
 			g.Expect(err).To(Not(BeNil()))
@@ -333,7 +338,9 @@ func TestIBMPowerVSMachineReconciler_Delete(t *testing.T) {
 					ObjectMeta: metav1.ObjectMeta{
 						Finalizers: []string{infrav1beta2.IBMPowerVSMachineFinalizer},
 					},
-					Spec: infrav1beta2.IBMPowerVSMachineSpec{},
+					Spec: infrav1beta2.IBMPowerVSMachineSpec{
+						ShutdownTimeout: &metav1.Duration{Duration: 5 * time.Minute},
+					},
 					Status: infrav1beta2.IBMPowerVSMachineStatus{
 						InstanceID: "powervs-instance-id",
 					},
@@ -342,10 +349,42 @@ func TestIBMPowerVSMachineReconciler_Delete(t *testing.T) {
 				DHCPIPCacheStore:  cache.NewTTLStore(powervs.CacheKeyFunc, powervs.CacheTTL),
 				Machine:           machine,
 			}
+			mockpowervs.EXPECT().GetInstance(machineScope.IBMPowerVSMachine.Status.InstanceID).Return(&models.PVMInstance{Status: ptr.To("SHUTOFF")}, nil)
+			mockpowervs.EXPECT().GetAllInstanceVolumes(machineScope.IBMPowerVSMachine.Status.InstanceID).Return(&models.Volumes{}, nil)
 			mockpowervs.EXPECT().DeleteInstance(machineScope.IBMPowerVSMachine.Status.InstanceID).Return(nil)
 			_, err := reconciler.reconcileDelete(machineScope)
 			g.Expect(err).To(BeNil())
 			g.Expect(len(machineScope.IBMPowerVSMachine.Finalizers)).To(BeZero())
+			g.Expect(conditions.IsTrue(machineScope.IBMPowerVSMachine, infrav1beta2.InstanceShutdownCondition)).To(BeTrue())
+			g.Expect(conditions.IsTrue(machineScope.IBMPowerVSMachine, infrav1beta2.VolumesDetachedCondition)).To(BeTrue())
+		})
+		t.Run("Should shut down the PowerVS instance before deleting it", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t)
+			t.Cleanup(teardown)
+			machineScope = &scope.PowerVSMachineScope{
+				Logger:           klog.Background(),
+				IBMPowerVSClient: mockpowervs,
+				IBMPowerVSMachine: &infrav1beta2.IBMPowerVSMachine{
+					ObjectMeta: metav1.ObjectMeta{
+						Finalizers: []string{infrav1beta2.IBMPowerVSMachineFinalizer},
+					},
+					Spec: infrav1beta2.IBMPowerVSMachineSpec{
+						ShutdownTimeout: &metav1.Duration{Duration: 5 * time.Minute},
+					},
+					Status: infrav1beta2.IBMPowerVSMachineStatus{
+						InstanceID: "powervs-instance-id",
+					},
+				},
+				IBMPowerVSCluster: &infrav1beta2.IBMPowerVSCluster{},
+			}
+			mockpowervs.EXPECT().GetInstance(machineScope.IBMPowerVSMachine.Status.InstanceID).Return(&models.PVMInstance{Status: ptr.To("ACTIVE")}, nil)
+			mockpowervs.EXPECT().InstanceAction(machineScope.IBMPowerVSMachine.Status.InstanceID, &models.PVMInstanceAction{Action: ptr.To(models.PVMInstanceActionActionStop)}).Return(nil)
+			result, err := reconciler.reconcileDelete(machineScope)
+			g.Expect(err).To(BeNil())
+			g.Expect(result.RequeueAfter).To(Not(BeZero()))
+			g.Expect(machineScope.IBMPowerVSMachine.Finalizers).To(ContainElement(infrav1beta2.IBMPowerVSMachineFinalizer))
+			g.Expect(conditions.GetReason(machineScope.IBMPowerVSMachine, infrav1beta2.InstanceShutdownCondition)).To(Equal(infrav1beta2.InstanceShuttingDownReason))
 		})
 	})
 }
@@ -0,0 +1,192 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	capiv1beta1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/annotations"
+	"sigs.k8s.io/cluster-api/util/conditions"
+
+	infrav1beta2 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/endpoints"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/metrics"
+)
+
+// IBMPowerVSNetworkReconciler reconciles a IBMPowerVSNetwork object.
+type IBMPowerVSNetworkReconciler struct {
+	client.Client
+	Recorder        record.EventRecorder
+	ServiceEndpoint []endpoints.ServiceEndpoint
+	Scheme          *runtime.Scheme
+}
+
+//+kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=ibmpowervsnetworks,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=ibmpowervsnetworks/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=ibmpowervsclusters;ibmpowervsmachines,verbs=get;list;watch
+//+kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
+
+// Reconcile implements controller runtime Reconciler interface and handles reconciliation logic for IBMPowerVSNetwork.
+func (r *IBMPowerVSNetworkReconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Result, reterr error) {
+	log := ctrl.LoggerFrom(ctx)
+
+	ibmNetwork := &infrav1beta2.IBMPowerVSNetwork{}
+	err := r.Get(ctx, req.NamespacedName, ibmNetwork)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	start := time.Now()
+	defer func() {
+		metrics.ObserveReconcile("IBMPowerVSNetwork", ibmNetwork, start, reterr)
+	}()
+
+	// Standalone objects are not paused along with a Cluster, hence only the paused annotation is honored.
+	if annotations.HasPaused(ibmNetwork) {
+		log.Info("IBMPowerVSNetwork is marked as paused, not reconciling")
+		return ctrl.Result{}, nil
+	}
+
+	// Create the scope
+	networkScope, err := scope.NewPowerVSNetworkScope(scope.PowerVSNetworkScopeParams{
+		Client:            r.Client,
+		Logger:            log,
+		IBMPowerVSNetwork: ibmNetwork,
+		ServiceEndpoint:   r.ServiceEndpoint,
+	})
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to create scope: %w", err)
+	}
+
+	// Always close the scope when exiting this function so we can persist any IBMPowerVSNetwork changes.
+	defer func() {
+		if networkScope != nil {
+			if err := networkScope.Close(); err != nil && reterr == nil {
+				reterr = err
+			}
+		}
+	}()
+
+	// Handle deleted networks.
+	if !ibmNetwork.DeletionTimestamp.IsZero() {
+		return r.reconcileDelete(ctx, networkScope)
+	}
+
+	return r.reconcile(networkScope)
+}
+
+func (r *IBMPowerVSNetworkReconciler) reconcile(networkScope *scope.PowerVSNetworkScope) (ctrl.Result, error) {
+	if controllerutil.AddFinalizer(networkScope.IBMPowerVSNetwork, infrav1beta2.IBMPowerVSNetworkFinalizer) {
+		return ctrl.Result{}, nil
+	}
+
+	ready, err := networkScope.ReconcileNetwork()
+	if err != nil {
+		networkScope.Error(err, "failed to reconcile PowerVS network")
+		networkScope.SetNotReady()
+		conditions.MarkFalse(networkScope.IBMPowerVSNetwork, infrav1beta2.NetworkReadyCondition, infrav1beta2.NetworkReconciliationFailedReason, capiv1beta1.ConditionSeverityError, "%s", err.Error())
+		return ctrl.Result{}, fmt.Errorf("failed to reconcile network for IBMPowerVSNetwork %s/%s: %w", networkScope.IBMPowerVSNetwork.Namespace, networkScope.IBMPowerVSNetwork.Name, err)
+	}
+
+	// Requeue after 1 minute if network is not ready to update status of the network properly.
+	if !ready {
+		networkScope.Info("Network is not yet ready")
+		networkScope.SetNotReady()
+		conditions.MarkFalse(networkScope.IBMPowerVSNetwork, infrav1beta2.NetworkReadyCondition, infrav1beta2.NetworkNotReadyReason, capiv1beta1.ConditionSeverityInfo, "")
+		return ctrl.Result{RequeueAfter: 1 * time.Minute}, nil
+	}
+
+	networkScope.SetReady()
+	conditions.MarkTrue(networkScope.IBMPowerVSNetwork, infrav1beta2.NetworkReadyCondition)
+	return ctrl.Result{}, nil
+}
+
+func (r *IBMPowerVSNetworkReconciler) reconcileDelete(ctx context.Context, networkScope *scope.PowerVSNetworkScope) (ctrl.Result, error) {
+	networkScope.Info("Handling deleted IBMPowerVSNetwork")
+
+	// the network cannot be deleted while it is still used by clusters or machines.
+	users, err := r.networkUsers(ctx, networkScope.IBMPowerVSNetwork)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if len(users) != 0 {
+		networkScope.Info("Network is still referenced, waiting for the references to be removed", "referencedBy", users)
+		return ctrl.Result{RequeueAfter: 1 * time.Minute}, nil
+	}
+
+	requeue, err := networkScope.DeleteNetwork()
+	if err != nil {
+		networkScope.Error(err, "Error deleting IBMPowerVSNetwork")
+		return ctrl.Result{}, fmt.Errorf("error deleting IBMPowerVSNetwork %s/%s: %w", networkScope.IBMPowerVSNetwork.Namespace, networkScope.IBMPowerVSNetwork.Name, err)
+	}
+	if requeue {
+		networkScope.Info("Network deletion is pending, requeuing")
+		return ctrl.Result{RequeueAfter: 15 * time.Second}, nil
+	}
+
+	// Network is deleted so remove the finalizer.
+	controllerutil.RemoveFinalizer(networkScope.IBMPowerVSNetwork, infrav1beta2.IBMPowerVSNetworkFinalizer)
+	return ctrl.Result{}, nil
+}
+
+// networkUsers returns the names of the IBMPowerVSClusters and IBMPowerVSMachines referencing the network.
+func (r *IBMPowerVSNetworkReconciler) networkUsers(ctx context.Context, network *infrav1beta2.IBMPowerVSNetwork) ([]string, error) {
+	var users []string
+
+	clusters := &infrav1beta2.IBMPowerVSClusterList{}
+	if err := r.List(ctx, clusters, client.InNamespace(network.Namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list IBMPowerVSClusters: %w", err)
+	}
+	for _, cluster := range clusters.Items {
+		if cluster.Spec.NetworkRef != nil && cluster.Spec.NetworkRef.Name == network.Name {
+			users = append(users, "IBMPowerVSCluster/"+cluster.Name)
+		}
+	}
+
+	machines := &infrav1beta2.IBMPowerVSMachineList{}
+	if err := r.List(ctx, machines, client.InNamespace(network.Namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list IBMPowerVSMachines: %w", err)
+	}
+	for _, machine := range machines.Items {
+		if machine.Spec.NetworkRef != nil && machine.Spec.NetworkRef.Name == network.Name {
+			users = append(users, "IBMPowerVSMachine/"+machine.Name)
+		}
+	}
+	return users, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *IBMPowerVSNetworkReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&infrav1beta2.IBMPowerVSNetwork{}).
+		Complete(r)
+}
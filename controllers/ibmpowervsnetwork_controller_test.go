@@ -0,0 +1,172 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"go.uber.org/mock/gomock"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1beta2 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/powervs/mock"
+
+	. "github.com/onsi/gomega"
+)
+
+func newPowerVSNetworkScope(mockpowervs *mock.MockPowerVS) *scope.PowerVSNetworkScope {
+	return &scope.PowerVSNetworkScope{
+		Logger:           klog.Background(),
+		IBMPowerVSClient: mockpowervs,
+		IBMPowerVSNetwork: &infrav1beta2.IBMPowerVSNetwork{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       "capi-net",
+				Namespace:  "default",
+				Finalizers: []string{infrav1beta2.IBMPowerVSNetworkFinalizer},
+			},
+			Spec: infrav1beta2.IBMPowerVSNetworkSpec{
+				ServiceInstance: infrav1beta2.IBMPowerVSResourceReference{ID: ptr.To("capi-si-id")},
+				Type:            infrav1beta2.PowerVSNetworkTypeVLAN,
+				CIDR:            ptr.To("192.168.0.0/24"),
+				DeletePolicy:    string(infrav1beta2.DeletePolicyDelete),
+			},
+		},
+	}
+}
+
+func TestIBMPowerVSNetworkReconciler_reconcile(t *testing.T) {
+	var (
+		mockpowervs *mock.MockPowerVS
+		mockCtrl    *gomock.Controller
+	)
+
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockpowervs = mock.NewMockPowerVS(mockCtrl)
+	}
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+
+	t.Run("Should set the network ready once it is created", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		reconciler := IBMPowerVSNetworkReconciler{Recorder: record.NewFakeRecorder(2)}
+		networkScope := newPowerVSNetworkScope(mockpowervs)
+		mockpowervs.EXPECT().GetNetworkByName("capi-net").Return(nil, nil)
+		mockpowervs.EXPECT().CreateNetwork(gomock.Any()).Return(&models.Network{NetworkID: ptr.To("capi-net-id")}, nil)
+		result, err := reconciler.reconcile(networkScope)
+		g.Expect(err).To(BeNil())
+		g.Expect(result.RequeueAfter).To(BeZero())
+		g.Expect(networkScope.IsReady()).To(BeTrue())
+		g.Expect(conditions.IsTrue(networkScope.IBMPowerVSNetwork, infrav1beta2.NetworkReadyCondition)).To(BeTrue())
+	})
+
+	t.Run("Should set the network not ready when the reconciliation fails", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		reconciler := IBMPowerVSNetworkReconciler{Recorder: record.NewFakeRecorder(2)}
+		networkScope := newPowerVSNetworkScope(mockpowervs)
+		mockpowervs.EXPECT().GetNetworkByName("capi-net").Return(nil, errors.New("failed to list networks"))
+		_, err := reconciler.reconcile(networkScope)
+		g.Expect(err).To(Not(BeNil()))
+		g.Expect(networkScope.IsReady()).To(BeFalse())
+		g.Expect(conditions.GetReason(networkScope.IBMPowerVSNetwork, infrav1beta2.NetworkReadyCondition)).To(Equal(infrav1beta2.NetworkReconciliationFailedReason))
+	})
+}
+
+func TestIBMPowerVSNetworkReconciler_delete(t *testing.T) {
+	var (
+		mockpowervs *mock.MockPowerVS
+		mockCtrl    *gomock.Controller
+	)
+
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockpowervs = mock.NewMockPowerVS(mockCtrl)
+	}
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+
+	t.Run("Should wait for the clusters referencing the network to be deleted", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		powervsCluster := &infrav1beta2.IBMPowerVSCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "capi-cluster",
+				Namespace: "default",
+			},
+			Spec: infrav1beta2.IBMPowerVSClusterSpec{
+				NetworkRef: &corev1.LocalObjectReference{Name: "capi-net"},
+			},
+		}
+		reconciler := IBMPowerVSNetworkReconciler{
+			Client:   fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects([]client.Object{powervsCluster}...).Build(),
+			Recorder: record.NewFakeRecorder(2),
+		}
+		networkScope := newPowerVSNetworkScope(mockpowervs)
+		networkScope.IBMPowerVSNetwork.Status.NetworkID = "capi-net-id"
+		networkScope.IBMPowerVSNetwork.Status.ControllerCreated = ptr.To(true)
+		result, err := reconciler.reconcileDelete(ctx, networkScope)
+		g.Expect(err).To(BeNil())
+		g.Expect(result.RequeueAfter).To(Equal(1 * time.Minute))
+		g.Expect(networkScope.IBMPowerVSNetwork.Finalizers).To(ContainElement(infrav1beta2.IBMPowerVSNetworkFinalizer))
+	})
+
+	t.Run("Should delete the network and remove the finalizer once it is gone", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		reconciler := IBMPowerVSNetworkReconciler{
+			Client:   fake.NewClientBuilder().WithScheme(scheme.Scheme).Build(),
+			Recorder: record.NewFakeRecorder(2),
+		}
+		networkScope := newPowerVSNetworkScope(mockpowervs)
+		networkScope.IBMPowerVSNetwork.Status.NetworkID = "capi-net-id"
+		networkScope.IBMPowerVSNetwork.Status.ControllerCreated = ptr.To(true)
+		mockpowervs.EXPECT().GetAllNetwork().Return(&models.Networks{Networks: []*models.NetworkReference{{NetworkID: ptr.To("capi-net-id")}}}, nil)
+		mockpowervs.EXPECT().DeleteNetwork("capi-net-id").Return(nil)
+		result, err := reconciler.reconcileDelete(ctx, networkScope)
+		g.Expect(err).To(BeNil())
+		g.Expect(result.RequeueAfter).To(Equal(15 * time.Second))
+		g.Expect(networkScope.IBMPowerVSNetwork.Finalizers).To(ContainElement(infrav1beta2.IBMPowerVSNetworkFinalizer))
+
+		mockpowervs.EXPECT().GetAllNetwork().Return(&models.Networks{}, nil)
+		_, err = reconciler.reconcileDelete(ctx, networkScope)
+		g.Expect(err).To(BeNil())
+		g.Expect(networkScope.IBMPowerVSNetwork.Finalizers).To(Not(ContainElement(infrav1beta2.IBMPowerVSNetworkFinalizer)))
+	})
+}
@@ -0,0 +1,202 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+
+	capiv1beta1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/annotations"
+	"sigs.k8s.io/cluster-api/util/conditions"
+
+	infrav1beta2 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/endpoints"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/metrics"
+)
+
+// IBMTransitGatewayReconciler reconciles a IBMTransitGateway object.
+type IBMTransitGatewayReconciler struct {
+	client.Client
+	Recorder        record.EventRecorder
+	ServiceEndpoint []endpoints.ServiceEndpoint
+	Scheme          *runtime.Scheme
+}
+
+//+kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=ibmtransitgateways,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=ibmtransitgateways/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=ibmpowervsclusters,verbs=get;list;watch
+//+kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
+
+// Reconcile implements controller runtime Reconciler interface and handles reconciliation logic for IBMTransitGateway.
+func (r *IBMTransitGatewayReconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Result, reterr error) {
+	log := ctrl.LoggerFrom(ctx)
+
+	ibmTransitGateway := &infrav1beta2.IBMTransitGateway{}
+	err := r.Get(ctx, req.NamespacedName, ibmTransitGateway)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	start := time.Now()
+	defer func() {
+		metrics.ObserveReconcile("IBMTransitGateway", ibmTransitGateway, start, reterr)
+	}()
+
+	// Standalone objects are not paused along with a Cluster, hence only the paused annotation is honored.
+	if annotations.HasPaused(ibmTransitGateway) {
+		log.Info("IBMTransitGateway is marked as paused, not reconciling")
+		return ctrl.Result{}, nil
+	}
+
+	// Create the scope
+	transitGatewayScope, err := scope.NewTransitGatewayScope(scope.TransitGatewayScopeParams{
+		Client:            r.Client,
+		Logger:            log,
+		IBMTransitGateway: ibmTransitGateway,
+		ServiceEndpoint:   r.ServiceEndpoint,
+	})
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to create scope: %w", err)
+	}
+
+	// Always close the scope when exiting this function so we can persist any IBMTransitGateway changes.
+	defer func() {
+		if transitGatewayScope != nil {
+			if err := transitGatewayScope.Close(); err != nil && reterr == nil {
+				reterr = err
+			}
+		}
+	}()
+
+	// the clusters referencing the transit gateway are tracked to protect it from being deleted while in use.
+	referencedBy, err := r.transitGatewayUsers(ctx, ibmTransitGateway)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	ibmTransitGateway.Status.ReferencedBy = referencedBy
+
+	// Handle deleted transit gateways.
+	if !ibmTransitGateway.DeletionTimestamp.IsZero() {
+		return r.reconcileDelete(transitGatewayScope)
+	}
+
+	return r.reconcile(transitGatewayScope)
+}
+
+func (r *IBMTransitGatewayReconciler) reconcile(transitGatewayScope *scope.TransitGatewayScope) (ctrl.Result, error) {
+	if controllerutil.AddFinalizer(transitGatewayScope.IBMTransitGateway, infrav1beta2.IBMTransitGatewayFinalizer) {
+		return ctrl.Result{}, nil
+	}
+
+	ready, err := transitGatewayScope.ReconcileTransitGateway()
+	if err != nil {
+		transitGatewayScope.Error(err, "failed to reconcile transit gateway")
+		transitGatewayScope.SetNotReady()
+		conditions.MarkFalse(transitGatewayScope.IBMTransitGateway, infrav1beta2.TransitGatewayReadyCondition, infrav1beta2.TransitGatewayReconciliationFailedReason, capiv1beta1.ConditionSeverityError, "%s", err.Error())
+		return ctrl.Result{}, fmt.Errorf("failed to reconcile transit gateway for IBMTransitGateway %s/%s: %w", transitGatewayScope.IBMTransitGateway.Namespace, transitGatewayScope.IBMTransitGateway.Name, err)
+	}
+
+	// Requeue after 1 minute if transit gateway is not ready to update status of the transit gateway properly.
+	if !ready {
+		transitGatewayScope.Info("Transit gateway is not yet ready")
+		transitGatewayScope.SetNotReady()
+		conditions.MarkFalse(transitGatewayScope.IBMTransitGateway, infrav1beta2.TransitGatewayReadyCondition, infrav1beta2.TransitGatewayNotReadyReason, capiv1beta1.ConditionSeverityInfo, "")
+		return ctrl.Result{RequeueAfter: 1 * time.Minute}, nil
+	}
+
+	transitGatewayScope.SetReady()
+	conditions.MarkTrue(transitGatewayScope.IBMTransitGateway, infrav1beta2.TransitGatewayReadyCondition)
+	return ctrl.Result{}, nil
+}
+
+func (r *IBMTransitGatewayReconciler) reconcileDelete(transitGatewayScope *scope.TransitGatewayScope) (ctrl.Result, error) {
+	transitGatewayScope.Info("Handling deleted IBMTransitGateway")
+
+	// the transit gateway cannot be deleted while it is still referenced by clusters.
+	if users := transitGatewayScope.IBMTransitGateway.Status.ReferencedBy; len(users) != 0 {
+		transitGatewayScope.Info("Transit gateway is still referenced, waiting for the references to be removed", "referencedBy", users)
+		return ctrl.Result{RequeueAfter: 1 * time.Minute}, nil
+	}
+
+	requeue, err := transitGatewayScope.DeleteTransitGateway()
+	if err != nil {
+		transitGatewayScope.Error(err, "Error deleting IBMTransitGateway")
+		return ctrl.Result{}, fmt.Errorf("error deleting IBMTransitGateway %s/%s: %w", transitGatewayScope.IBMTransitGateway.Namespace, transitGatewayScope.IBMTransitGateway.Name, err)
+	}
+	if requeue {
+		transitGatewayScope.Info("Transit gateway deletion is pending, requeuing")
+		return ctrl.Result{RequeueAfter: 15 * time.Second}, nil
+	}
+
+	// Transit gateway is deleted so remove the finalizer.
+	controllerutil.RemoveFinalizer(transitGatewayScope.IBMTransitGateway, infrav1beta2.IBMTransitGatewayFinalizer)
+	return ctrl.Result{}, nil
+}
+
+// transitGatewayUsers returns the names of the IBMPowerVSClusters referencing the transit gateway.
+func (r *IBMTransitGatewayReconciler) transitGatewayUsers(ctx context.Context, transitGateway *infrav1beta2.IBMTransitGateway) ([]string, error) {
+	var users []string
+
+	clusters := &infrav1beta2.IBMPowerVSClusterList{}
+	if err := r.List(ctx, clusters, client.InNamespace(transitGateway.Namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list IBMPowerVSClusters: %w", err)
+	}
+	for _, cluster := range clusters.Items {
+		if cluster.Spec.TransitGatewayRef != nil && cluster.Spec.TransitGatewayRef.Name == transitGateway.Name {
+			users = append(users, "IBMPowerVSCluster/"+cluster.Name)
+		}
+	}
+	return users, nil
+}
+
+// IBMPowerVSClusterToIBMTransitGateway returns a handler.MapFunc that enqueues the IBMTransitGateway referenced by an IBMPowerVSCluster.
+func (r *IBMTransitGatewayReconciler) IBMPowerVSClusterToIBMTransitGateway() handler.MapFunc {
+	return func(_ context.Context, o client.Object) []ctrl.Request {
+		cluster, ok := o.(*infrav1beta2.IBMPowerVSCluster)
+		if !ok || cluster.Spec.TransitGatewayRef == nil {
+			return nil
+		}
+		name := client.ObjectKey{Namespace: cluster.Namespace, Name: cluster.Spec.TransitGatewayRef.Name}
+		return []ctrl.Request{{NamespacedName: name}}
+	}
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *IBMTransitGatewayReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&infrav1beta2.IBMTransitGateway{}).
+		Watches(
+			&infrav1beta2.IBMPowerVSCluster{},
+			handler.EnqueueRequestsFromMapFunc(r.IBMPowerVSClusterToIBMTransitGateway()),
+		).
+		Complete(r)
+}
@@ -0,0 +1,192 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	tgapiv1 "github.com/IBM/networking-go-sdk/transitgatewayapisv1"
+	"go.uber.org/mock/gomock"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1beta2 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cloud/scope"
+	tgmock "sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/transitgateway/mock"
+
+	. "github.com/onsi/gomega"
+)
+
+func newTransitGatewayScope(mocktg *tgmock.MockTransitGateway) *scope.TransitGatewayScope {
+	return &scope.TransitGatewayScope{
+		Logger:               klog.Background(),
+		TransitGatewayClient: mocktg,
+		IBMTransitGateway: &infrav1beta2.IBMTransitGateway{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       "capi-tg",
+				Namespace:  "default",
+				Finalizers: []string{infrav1beta2.IBMTransitGatewayFinalizer},
+			},
+			Spec: infrav1beta2.IBMTransitGatewaySpec{
+				Location:      "us-south",
+				ResourceGroup: &infrav1beta2.IBMPowerVSResourceReference{ID: ptr.To("capi-rg-id")},
+				DeletePolicy:  string(infrav1beta2.DeletePolicyDelete),
+			},
+		},
+	}
+}
+
+func TestIBMTransitGatewayReconciler_reconcile(t *testing.T) {
+	var (
+		mocktg   *tgmock.MockTransitGateway
+		mockCtrl *gomock.Controller
+	)
+
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mocktg = tgmock.NewMockTransitGateway(mockCtrl)
+	}
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+
+	t.Run("Should set the transit gateway not ready until it is available", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		reconciler := IBMTransitGatewayReconciler{Recorder: record.NewFakeRecorder(2)}
+		transitGatewayScope := newTransitGatewayScope(mocktg)
+		mocktg.EXPECT().GetTransitGatewayByName("capi-tg").Return(nil, nil)
+		mocktg.EXPECT().CreateTransitGateway(gomock.Any()).Return(&tgapiv1.TransitGateway{ID: ptr.To("capi-tg-id")}, nil, nil)
+		result, err := reconciler.reconcile(transitGatewayScope)
+		g.Expect(err).To(BeNil())
+		g.Expect(result.RequeueAfter).To(Equal(1 * time.Minute))
+		g.Expect(transitGatewayScope.IsReady()).To(BeFalse())
+		g.Expect(conditions.GetReason(transitGatewayScope.IBMTransitGateway, infrav1beta2.TransitGatewayReadyCondition)).To(Equal(infrav1beta2.TransitGatewayNotReadyReason))
+
+		mocktg.EXPECT().GetTransitGateway(gomock.Any()).Return(&tgapiv1.TransitGateway{ID: ptr.To("capi-tg-id"), Status: ptr.To(string(infrav1beta2.TransitGatewayStateAvailable))}, nil, nil)
+		mocktg.EXPECT().ListTransitGatewayConnections(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCollection{}, nil, nil)
+		result, err = reconciler.reconcile(transitGatewayScope)
+		g.Expect(err).To(BeNil())
+		g.Expect(result.RequeueAfter).To(BeZero())
+		g.Expect(transitGatewayScope.IsReady()).To(BeTrue())
+		g.Expect(conditions.IsTrue(transitGatewayScope.IBMTransitGateway, infrav1beta2.TransitGatewayReadyCondition)).To(BeTrue())
+	})
+
+	t.Run("Should set the transit gateway not ready when the reconciliation fails", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		reconciler := IBMTransitGatewayReconciler{Recorder: record.NewFakeRecorder(2)}
+		transitGatewayScope := newTransitGatewayScope(mocktg)
+		mocktg.EXPECT().GetTransitGatewayByName("capi-tg").Return(nil, errors.New("failed to list transit gateways"))
+		_, err := reconciler.reconcile(transitGatewayScope)
+		g.Expect(err).To(Not(BeNil()))
+		g.Expect(transitGatewayScope.IsReady()).To(BeFalse())
+		g.Expect(conditions.GetReason(transitGatewayScope.IBMTransitGateway, infrav1beta2.TransitGatewayReadyCondition)).To(Equal(infrav1beta2.TransitGatewayReconciliationFailedReason))
+	})
+}
+
+func TestIBMTransitGatewayReconciler_delete(t *testing.T) {
+	var (
+		mocktg   *tgmock.MockTransitGateway
+		mockCtrl *gomock.Controller
+	)
+
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mocktg = tgmock.NewMockTransitGateway(mockCtrl)
+	}
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+
+	t.Run("Should wait for the clusters referencing the transit gateway to be deleted", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		reconciler := IBMTransitGatewayReconciler{Recorder: record.NewFakeRecorder(2)}
+		transitGatewayScope := newTransitGatewayScope(mocktg)
+		transitGatewayScope.SetTransitGatewayID(ptr.To("capi-tg-id"), true)
+		transitGatewayScope.IBMTransitGateway.Status.ReferencedBy = []string{"IBMPowerVSCluster/capi-cluster"}
+		result, err := reconciler.reconcileDelete(transitGatewayScope)
+		g.Expect(err).To(BeNil())
+		g.Expect(result.RequeueAfter).To(Equal(1 * time.Minute))
+		g.Expect(transitGatewayScope.IBMTransitGateway.Finalizers).To(ContainElement(infrav1beta2.IBMTransitGatewayFinalizer))
+	})
+
+	t.Run("Should delete the transit gateway and remove the finalizer once it is gone", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		reconciler := IBMTransitGatewayReconciler{Recorder: record.NewFakeRecorder(2)}
+		transitGatewayScope := newTransitGatewayScope(mocktg)
+		transitGatewayScope.SetTransitGatewayID(ptr.To("capi-tg-id"), true)
+		mocktg.EXPECT().GetTransitGateway(gomock.Any()).Return(&tgapiv1.TransitGateway{ID: ptr.To("capi-tg-id"), Status: ptr.To(string(infrav1beta2.TransitGatewayStateAvailable))}, nil, nil)
+		mocktg.EXPECT().DeleteTransitGateway(gomock.Any()).Return(nil, nil)
+		result, err := reconciler.reconcileDelete(transitGatewayScope)
+		g.Expect(err).To(BeNil())
+		g.Expect(result.RequeueAfter).To(Equal(15 * time.Second))
+		g.Expect(transitGatewayScope.IBMTransitGateway.Finalizers).To(ContainElement(infrav1beta2.IBMTransitGatewayFinalizer))
+
+		mocktg.EXPECT().GetTransitGateway(gomock.Any()).Return(nil, &core.DetailedResponse{StatusCode: scope.ResourceNotFoundCode}, errors.New("not found"))
+		_, err = reconciler.reconcileDelete(transitGatewayScope)
+		g.Expect(err).To(BeNil())
+		g.Expect(transitGatewayScope.IBMTransitGateway.Finalizers).To(Not(ContainElement(infrav1beta2.IBMTransitGatewayFinalizer)))
+	})
+}
+
+func TestIBMTransitGatewayReconciler_transitGatewayUsers(t *testing.T) {
+	g := NewWithT(t)
+	clusters := []client.Object{
+		&infrav1beta2.IBMPowerVSCluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "capi-cluster-1", Namespace: "default"},
+			Spec:       infrav1beta2.IBMPowerVSClusterSpec{TransitGatewayRef: &corev1.LocalObjectReference{Name: "capi-tg"}},
+		},
+		&infrav1beta2.IBMPowerVSCluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "capi-cluster-2", Namespace: "default"},
+			Spec:       infrav1beta2.IBMPowerVSClusterSpec{TransitGatewayRef: &corev1.LocalObjectReference{Name: "other-tg"}},
+		},
+		&infrav1beta2.IBMPowerVSCluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "capi-cluster-3", Namespace: "default"},
+		},
+	}
+	reconciler := IBMTransitGatewayReconciler{
+		Client: fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(clusters...).Build(),
+	}
+	users, err := reconciler.transitGatewayUsers(ctx, newTransitGatewayScope(nil).IBMTransitGateway)
+	g.Expect(err).To(BeNil())
+	g.Expect(users).To(Equal([]string{"IBMPowerVSCluster/capi-cluster-1"}))
+
+	requests := reconciler.IBMPowerVSClusterToIBMTransitGateway()(ctx, clusters[0])
+	g.Expect(requests).To(HaveLen(1))
+	g.Expect(requests[0].Name).To(Equal("capi-tg"))
+	g.Expect(reconciler.IBMPowerVSClusterToIBMTransitGateway()(ctx, clusters[2])).To(BeEmpty())
+}
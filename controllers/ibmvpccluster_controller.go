@@ -18,6 +18,7 @@ package controllers
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -28,20 +29,27 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/klog/v2"
 
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	capiv1beta1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	"sigs.k8s.io/cluster-api/util"
+	"sigs.k8s.io/cluster-api/util/annotations"
 	"sigs.k8s.io/cluster-api/util/conditions"
-	"sigs.k8s.io/cluster-api/util/predicates"
+	"sigs.k8s.io/cluster-api/util/patch"
 
 	infrav1beta2 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/v1beta2"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloudevents"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/connectivity"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/endpoints"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/metrics"
+	capibmrecord "sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/record"
 )
 
 // IBMVPCClusterReconciler reconciles a IBMVPCCluster object.
@@ -51,11 +59,18 @@ type IBMVPCClusterReconciler struct {
 	Recorder        record.EventRecorder
 	ServiceEndpoint []endpoints.ServiceEndpoint
 	Scheme          *runtime.Scheme
+	// Intervals are the intervals at which IBMVPCClusters are reconciled again.
+	Intervals Intervals
+	// Prober probes the control plane endpoints of the clusters enabling the connectivity self-test.
+	Prober connectivity.Prober
 }
 
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=ibmvpcclusters,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=ibmvpcclusters/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=ibmvpcmachines,verbs=get;list;watch
 // +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=clusters;clusters/status,verbs=get;list;watch
+// +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=machines,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
 
 // Reconcile implements controller runtime Reconciler interface and handles reconcileation logic for IBMVPCCluster.
 func (r *IBMVPCClusterReconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Result, reterr error) {
@@ -71,6 +86,16 @@ func (r *IBMVPCClusterReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 		return ctrl.Result{}, err
 	}
 
+	start := time.Now()
+	defer func() {
+		metrics.ObserveReconcile("IBMVPCCluster", ibmCluster, start, reterr)
+	}()
+
+	// The infrastructure of externally managed clusters is provisioned outside of Cluster API.
+	if annotations.IsExternallyManaged(ibmCluster) {
+		return r.reconcileExternallyManaged(ctx, ibmCluster)
+	}
+
 	// Determine whether the Cluster is designed for extended Infrastructure support, implemented in a separate path.
 	if ibmCluster.Spec.Network != nil {
 		return r.reconcileV2(ctx, req)
@@ -86,6 +111,12 @@ func (r *IBMVPCClusterReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 		return ctrl.Result{}, nil
 	}
 
+	// Skip the reconciliation while the Cluster is paused, e.g. during clusterctl move.
+	if annotations.IsPaused(cluster, ibmCluster) {
+		log.Info("IBMVPCCluster or linked Cluster is marked as paused, not reconciling")
+		return ctrl.Result{}, nil
+	}
+
 	clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
 		Client:          r.Client,
 		Logger:          log,
@@ -95,11 +126,15 @@ func (r *IBMVPCClusterReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 	})
 
 	// Always close the scope when exiting this function so we can persist any IBMVPCCluster changes.
+	wasReady := ibmCluster.Status.Ready
 	defer func() {
 		if clusterScope != nil {
 			if err := clusterScope.Close(); err != nil && reterr == nil {
 				reterr = err
 			}
+			if reterr == nil && !wasReady && ibmCluster.Status.Ready {
+				cloudevents.Publish(ibmCluster, cloudevents.ClusterProvisioned, "cluster infrastructure is ready")
+			}
 		}
 	}()
 
@@ -111,7 +146,45 @@ func (r *IBMVPCClusterReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 	if err != nil {
 		return reconcile.Result{}, fmt.Errorf("failed to create scope: %w", err)
 	}
-	return r.reconcile(clusterScope)
+	result, err := r.reconcile(clusterScope)
+	if err == nil {
+		var loadBalancerMembers func() error
+		if clusterScope.GetLoadBalancerID() != "" {
+			loadBalancerMembers = clusterScope.CheckLoadBalancerMembers
+		}
+		result = r.connectivitySelfTest(ibmCluster, loadBalancerMembers).reconcile(ctx, result)
+	}
+	return r.Intervals.withOverrides(clusterIntervals(ibmCluster.Spec.ReconcileIntervals)).apply(result, err)
+}
+
+// reconcileExternallyManaged skips the infrastructure reconciliation of an externally managed IBMVPCCluster,
+// the cluster is reported ready as soon as the control plane endpoint is set in its spec.
+func (r *IBMVPCClusterReconciler) reconcileExternallyManaged(ctx context.Context, ibmCluster *infrav1beta2.IBMVPCCluster) (_ ctrl.Result, reterr error) {
+	log := r.Log.WithValues("ibmvpccluster", klog.KObj(ibmCluster))
+
+	patchHelper, err := patch.NewHelper(ibmCluster, r.Client)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to init patch helper: %w", err)
+	}
+	defer func() {
+		if err := patchHelper.Patch(ctx, ibmCluster); err != nil && reterr == nil {
+			reterr = err
+		}
+	}()
+
+	if !ibmCluster.DeletionTimestamp.IsZero() {
+		log.Info("Removing finalizer of externally managed IBMVPCCluster without deleting its infrastructure")
+		controllerutil.RemoveFinalizer(ibmCluster, infrav1beta2.ClusterFinalizer)
+		return ctrl.Result{}, nil
+	}
+
+	if !ibmCluster.Spec.ControlPlaneEndpoint.IsValid() {
+		log.Info("Waiting for the control plane endpoint of externally managed IBMVPCCluster to be set")
+		ibmCluster.Status.Ready = false
+		return ctrl.Result{}, nil
+	}
+	ibmCluster.Status.Ready = true
+	return ctrl.Result{}, nil
 }
 
 func (r *IBMVPCClusterReconciler) reconcileV2(ctx context.Context, req ctrl.Request) (_ ctrl.Result, reterr error) {
@@ -137,6 +210,12 @@ func (r *IBMVPCClusterReconciler) reconcileV2(ctx context.Context, req ctrl.Requ
 		return ctrl.Result{}, nil
 	}
 
+	// Skip the reconciliation while the Cluster is paused, e.g. during clusterctl move.
+	if annotations.IsPaused(cluster, ibmCluster) {
+		log.Info("IBMVPCCluster or linked Cluster is marked as paused, not reconciling")
+		return ctrl.Result{}, nil
+	}
+
 	clusterScope, err := scope.NewVPCClusterScope(scope.VPCClusterScopeParams{
 		Client:          r.Client,
 		Logger:          log,
@@ -146,23 +225,48 @@ func (r *IBMVPCClusterReconciler) reconcileV2(ctx context.Context, req ctrl.Requ
 	})
 
 	// Always close the scope when exiting this function so we can persist any IBMVPCCluster changes.
+	wasReady := ibmCluster.Status.Ready
 	defer func() {
 		if clusterScope != nil {
 			if err := clusterScope.Close(); err != nil && reterr == nil {
 				reterr = err
 			}
+			if reterr == nil && !wasReady && ibmCluster.Status.Ready {
+				cloudevents.Publish(ibmCluster, cloudevents.ClusterProvisioned, "cluster infrastructure is ready")
+			}
 		}
 	}()
 
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed to create scope: %w", err)
+	}
+
 	// Handle deleted clusters.
 	if !ibmCluster.DeletionTimestamp.IsZero() {
 		return r.reconcileDeleteV2(clusterScope)
 	}
+	result, err := r.reconcileCluster(clusterScope)
+	if err == nil {
+		var loadBalancerMembers func() error
+		if clusterScope.NetworkStatus() != nil && len(clusterScope.NetworkStatus().LoadBalancers) != 0 {
+			loadBalancerMembers = clusterScope.CheckLoadBalancerMembers
+		}
+		result = r.connectivitySelfTest(ibmCluster, loadBalancerMembers).reconcile(ctx, result)
+	}
+	return r.Intervals.withOverrides(clusterIntervals(ibmCluster.Spec.ReconcileIntervals)).apply(result, err)
+}
 
-	if err != nil {
-		return reconcile.Result{}, fmt.Errorf("failed to create scope: %w", err)
+// connectivitySelfTest returns the connectivity self-test of the cluster, checking the members of its load balancers
+// when loadBalancerMembers is set.
+func (r *IBMVPCClusterReconciler) connectivitySelfTest(ibmCluster *infrav1beta2.IBMVPCCluster, loadBalancerMembers func() error) connectivitySelfTest {
+	return connectivitySelfTest{
+		prober:              r.Prober,
+		cluster:             ibmCluster,
+		check:               ibmCluster.Spec.ConnectivityCheck,
+		ready:               ibmCluster.Status.Ready,
+		endpoint:            ibmCluster.Spec.ControlPlaneEndpoint,
+		loadBalancerMembers: loadBalancerMembers,
 	}
-	return r.reconcileCluster(clusterScope)
 }
 
 func (r *IBMVPCClusterReconciler) reconcile(clusterScope *scope.ClusterScope) (ctrl.Result, error) {
@@ -208,6 +312,14 @@ func (r *IBMVPCClusterReconciler) reconcile(clusterScope *scope.ClusterScope) (c
 			}
 		}
 	}
+	// The cluster's single subnet is the only failure domain available to its machines.
+	if clusterScope.IBMVPCCluster.Status.Subnet.Zone != nil {
+		clusterScope.IBMVPCCluster.Status.FailureDomains = capiv1beta1.FailureDomains{
+			*clusterScope.IBMVPCCluster.Status.Subnet.Zone: capiv1beta1.FailureDomainSpec{
+				ControlPlane: true,
+			},
+		}
+	}
 
 	if clusterScope.IBMVPCCluster.Spec.ControlPlaneLoadBalancer != nil && clusterScope.IBMVPCCluster.Spec.ControlPlaneEndpoint.Host == "" {
 		loadBalancer, err := r.getOrCreate(clusterScope)
@@ -226,6 +338,25 @@ func (r *IBMVPCClusterReconciler) reconcile(clusterScope *scope.ClusterScope) (c
 		clusterScope.Info("Cluster is not yet ready")
 		return ctrl.Result{RequeueAfter: 1 * time.Minute}, nil
 	}
+	return r.reconcileLoadBalancerPoolMembers(clusterScope)
+}
+
+// reconcileLoadBalancerPoolMembers keeps the members of the pools of the control plane load balancer in sync with the control plane machines.
+func (r *IBMVPCClusterReconciler) reconcileLoadBalancerPoolMembers(clusterScope *scope.ClusterScope) (ctrl.Result, error) {
+	if clusterScope.GetLoadBalancerID() == "" {
+		return ctrl.Result{}, nil
+	}
+
+	requeue, err := clusterScope.ReconcileControlPlaneLoadBalancerPoolMembers()
+	if err != nil {
+		conditions.MarkFalse(clusterScope.IBMVPCCluster, infrav1beta2.LoadBalancerPoolMembersReadyCondition, infrav1beta2.LoadBalancerPoolMembersReconciliationFailedReason, capiv1beta1.ConditionSeverityWarning, "%s", err.Error())
+		return ctrl.Result{}, fmt.Errorf("failed to reconcile control plane load balancer pool members for IBMVPCCluster %s/%s: %w", clusterScope.IBMVPCCluster.Namespace, clusterScope.IBMVPCCluster.Name, err)
+	}
+	if requeue {
+		conditions.MarkFalse(clusterScope.IBMVPCCluster, infrav1beta2.LoadBalancerPoolMembersReadyCondition, infrav1beta2.LoadBalancerPoolMembersNotReadyReason, capiv1beta1.ConditionSeverityInfo, "")
+		return ctrl.Result{RequeueAfter: 15 * time.Second}, nil
+	}
+	conditions.MarkTrue(clusterScope.IBMVPCCluster, infrav1beta2.LoadBalancerPoolMembersReadyCondition)
 	return ctrl.Result{}, nil
 }
 
@@ -259,6 +390,9 @@ func (r *IBMVPCClusterReconciler) reconcileCluster(clusterScope *scope.VPCCluste
 		return reconcile.Result{RequeueAfter: 15 * time.Second}, nil
 	}
 	clusterScope.Info("Reconciliation of VPC Custom Image complete")
+	if image := clusterScope.IBMVPCCluster.Spec.Image; image != nil && image.COSObject != nil && !conditions.IsTrue(clusterScope.IBMVPCCluster, infrav1beta2.ImageReadyCondition) {
+		cloudevents.Publish(clusterScope.IBMVPCCluster, cloudevents.ImageImported, fmt.Sprintf("VPC Custom Image imported from %s", *image.COSObject))
+	}
 	conditions.MarkTrue(clusterScope.IBMVPCCluster, infrav1beta2.ImageReadyCondition)
 
 	// Reconcile the cluster's VPC Subnets.
@@ -309,14 +443,93 @@ func (r *IBMVPCClusterReconciler) reconcileCluster(clusterScope *scope.VPCCluste
 		return reconcile.Result{RequeueAfter: 15 * time.Second}, nil
 	}
 
+	// Reconcile the tags of the resources created by the controller, failures do not block the cluster.
+	if err := clusterScope.ReconcileTags(); err != nil {
+		clusterScope.Error(err, "failed to reconcile tags of the cluster resources")
+	}
+
+	// Register the Load Balancer hostname in DNS, the hostname of the record is then used as the control plane endpoint.
+	if clusterScope.DNS() != nil {
+		clusterScope.Info("Reconciling DNS record")
+		if err := clusterScope.ReconcileDNSRecord(*hostName); err != nil {
+			clusterScope.Error(err, "failed to reconcile DNS record")
+			reason := infrav1beta2.DNSRecordReconciliationFailedReason
+			if errors.Is(err, scope.ErrDNSRecordConflict) {
+				reason = infrav1beta2.DNSRecordConflictReason
+			}
+			conditions.MarkFalse(clusterScope.IBMVPCCluster, infrav1beta2.DNSRecordReadyCondition, reason, capiv1beta1.ConditionSeverityError, "%s", err.Error())
+			return reconcile.Result{}, err
+		}
+		clusterScope.Info("Reconciliation of DNS record complete")
+		conditions.MarkTrue(clusterScope.IBMVPCCluster, infrav1beta2.DNSRecordReadyCondition)
+		hostName = &clusterScope.DNS().Hostname
+	}
+
+	if err := r.reconcileControlPlaneEndpoint(clusterScope, *hostName); err != nil {
+		return reconcile.Result{}, err
+	}
+
 	// Mark cluster as ready.
-	clusterScope.IBMVPCCluster.Spec.ControlPlaneEndpoint.Host = *hostName
-	clusterScope.IBMVPCCluster.Spec.ControlPlaneEndpoint.Port = clusterScope.GetAPIServerPort()
 	clusterScope.IBMVPCCluster.Status.Ready = true
 	clusterScope.Info("cluster infrastructure is now ready for cluster", "clusterName", clusterScope.IBMVPCCluster.Name)
+
+	// Remove the Load Balancer pool members left behind by machines which no longer exist.
+	requeue, err := clusterScope.ReconcileLoadBalancerPoolMembers()
+	if err != nil {
+		conditions.MarkFalse(clusterScope.IBMVPCCluster, infrav1beta2.LoadBalancerPoolMembersReadyCondition, infrav1beta2.LoadBalancerPoolMembersReconciliationFailedReason, capiv1beta1.ConditionSeverityWarning, "%s", err.Error())
+		return ctrl.Result{}, fmt.Errorf("failed to reconcile load balancer pool members for IBMVPCCluster %s/%s: %w", clusterScope.IBMVPCCluster.Namespace, clusterScope.IBMVPCCluster.Name, err)
+	}
+	if requeue {
+		conditions.MarkFalse(clusterScope.IBMVPCCluster, infrav1beta2.LoadBalancerPoolMembersReadyCondition, infrav1beta2.LoadBalancerPoolMembersNotReadyReason, capiv1beta1.ConditionSeverityInfo, "")
+		return ctrl.Result{RequeueAfter: 15 * time.Second}, nil
+	}
+	conditions.MarkTrue(clusterScope.IBMVPCCluster, infrav1beta2.LoadBalancerPoolMembersReadyCondition)
 	return ctrl.Result{}, nil
 }
 
+// reconcileControlPlaneEndpoint sets the control plane endpoint with hostName, the hostname of the DNS record or else of the Load Balancer.
+// As the certificates of the API server and the kubeconfig of the cluster are bound to the endpoint, a set endpoint is only
+// moved to another hostname once approved with the update-control-plane-endpoint annotation.
+func (r *IBMVPCClusterReconciler) reconcileControlPlaneEndpoint(clusterScope *scope.VPCClusterScope, hostName string) error {
+	vpcCluster := clusterScope.IBMVPCCluster
+	endpoint := &vpcCluster.Spec.ControlPlaneEndpoint
+	if endpoint.Host == "" || endpoint.Host == hostName {
+		endpoint.Host = hostName
+		endpoint.Port = clusterScope.GetAPIServerPort()
+		conditions.MarkTrue(vpcCluster, infrav1beta2.ControlPlaneEndpointReadyCondition)
+		return nil
+	}
+
+	if _, ok := vpcCluster.Annotations[infrav1beta2.ControlPlaneEndpointUpdateAnnotation]; !ok {
+		if conditions.GetReason(vpcCluster, infrav1beta2.ControlPlaneEndpointReadyCondition) != infrav1beta2.ControlPlaneEndpointChangedReason {
+			capibmrecord.Warnf(vpcCluster, "ControlPlaneEndpointChanged", "Hostname %q differs from the control plane endpoint %q, set the %s annotation to update the endpoint", hostName, endpoint.Host, infrav1beta2.ControlPlaneEndpointUpdateAnnotation)
+		}
+		conditions.MarkFalse(vpcCluster, infrav1beta2.ControlPlaneEndpointReadyCondition, infrav1beta2.ControlPlaneEndpointChangedReason, capiv1beta1.ConditionSeverityWarning,
+			"hostname %s differs from the control plane endpoint %s, set the %s annotation to update the endpoint", hostName, endpoint.Host, infrav1beta2.ControlPlaneEndpointUpdateAnnotation)
+		return nil
+	}
+
+	// The endpoint of the Cluster is only copied from the infrastructure cluster while it is unset, hence it is updated here as well.
+	clusterScope.Info("Updating control plane endpoint", "from", endpoint.Host, "to", hostName)
+	if cluster := clusterScope.Cluster; cluster != nil && cluster.Spec.ControlPlaneEndpoint.Host != hostName {
+		patchHelper, err := patch.NewHelper(cluster, r.Client)
+		if err != nil {
+			return fmt.Errorf("failed to init patch helper for cluster %s/%s: %w", cluster.Namespace, cluster.Name, err)
+		}
+		cluster.Spec.ControlPlaneEndpoint.Host = hostName
+		cluster.Spec.ControlPlaneEndpoint.Port = clusterScope.GetAPIServerPort()
+		if err := patchHelper.Patch(context.TODO(), cluster); err != nil {
+			return fmt.Errorf("failed to update control plane endpoint of cluster %s/%s: %w", cluster.Namespace, cluster.Name, err)
+		}
+	}
+	capibmrecord.Eventf(vpcCluster, "ControlPlaneEndpointUpdated", "Updated control plane endpoint from %q to %q", endpoint.Host, hostName)
+	endpoint.Host = hostName
+	endpoint.Port = clusterScope.GetAPIServerPort()
+	delete(vpcCluster.Annotations, infrav1beta2.ControlPlaneEndpointUpdateAnnotation)
+	conditions.MarkTrue(vpcCluster, infrav1beta2.ControlPlaneEndpointReadyCondition)
+	return nil
+}
+
 func (r *IBMVPCClusterReconciler) reconcileDelete(clusterScope *scope.ClusterScope) (ctrl.Result, error) {
 	// check if still have existing VSIs.
 	listVSIOpts := &vpcv1.ListInstancesOptions{
@@ -371,8 +584,61 @@ func (r *IBMVPCClusterReconciler) reconcileDelete(clusterScope *scope.ClusterSco
 	return handleFinalizerRemoval(clusterScope)
 }
 
-func (r *IBMVPCClusterReconciler) reconcileDeleteV2(_ *scope.VPCClusterScope) (ctrl.Result, error) {
-	return ctrl.Result{}, fmt.Errorf("not implemented")
+func (r *IBMVPCClusterReconciler) reconcileDeleteV2(clusterScope *scope.VPCClusterScope) (ctrl.Result, error) {
+	// Only resources created by the controller are deleted, any existing Network resources provided by the user are left untouched.
+	// skip deleting other resources if the instances of the cluster are still running.
+	if running, err := clusterScope.HasClusterInstances(); err != nil {
+		return ctrl.Result{}, err
+	} else if running {
+		clusterScope.Info("VSIs still exist in VPC, requeuing")
+		return ctrl.Result{RequeueAfter: 1 * time.Minute}, nil
+	}
+
+	clusterScope.Info("Deleting DNS record")
+	if err := clusterScope.DeleteDNSRecord(); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to delete DNS record: %w", err)
+	}
+
+	clusterScope.Info("Deleting Load Balancers")
+	if requeue, err := clusterScope.DeleteLoadBalancers(); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to delete load balancers: %w", err)
+	} else if requeue {
+		clusterScope.Info("Load Balancers deletion is pending, requeuing")
+		return ctrl.Result{RequeueAfter: 1 * time.Minute}, nil
+	}
+
+	clusterScope.Info("Deleting Security Groups")
+	if err := clusterScope.DeleteSecurityGroups(); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to delete security groups: %w", err)
+	}
+
+	clusterScope.Info("Deleting VPC Subnets")
+	if requeue, err := clusterScope.DeleteSubnets(); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to delete subnets: %w", err)
+	} else if requeue {
+		clusterScope.Info("VPC Subnets deletion is pending, requeuing")
+		return ctrl.Result{RequeueAfter: 15 * time.Second}, nil
+	}
+
+	clusterScope.Info("Deleting Public Gateways")
+	if requeue, err := clusterScope.DeletePublicGateways(); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to delete public gateways: %w", err)
+	} else if requeue {
+		clusterScope.Info("Public Gateways deletion is pending, requeuing")
+		return ctrl.Result{RequeueAfter: 15 * time.Second}, nil
+	}
+
+	clusterScope.Info("Deleting VPC")
+	if requeue, err := clusterScope.DeleteVPC(); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to delete VPC: %w", err)
+	} else if requeue {
+		clusterScope.Info("VPC deletion is pending, requeuing")
+		return ctrl.Result{RequeueAfter: 15 * time.Second}, nil
+	}
+
+	clusterScope.Info("Successfully deleted cluster infrastructure")
+	controllerutil.RemoveFinalizer(clusterScope.IBMVPCCluster, infrav1beta2.ClusterFinalizer)
+	return ctrl.Result{}, nil
 }
 
 func (r *IBMVPCClusterReconciler) getOrCreate(clusterScope *scope.ClusterScope) (*vpcv1.LoadBalancer, error) {
@@ -412,10 +678,42 @@ func (r *IBMVPCClusterReconciler) reconcileLBState(clusterScope *scope.ClusterSc
 	}
 }
 
+// IBMVPCMachineToIBMVPCCluster is a handler.ToRequestsFunc to be used to enqueue requests for reconciliation
+// of the IBMVPCCluster of a control plane IBMVPCMachine, so the pools of the control plane load balancer follow the machines.
+func (r *IBMVPCClusterReconciler) IBMVPCMachineToIBMVPCCluster(ctx context.Context) handler.MapFunc {
+	log := ctrl.LoggerFrom(ctx)
+	return func(mapCtx context.Context, o client.Object) []ctrl.Request {
+		m, ok := o.(*infrav1beta2.IBMVPCMachine)
+		if !ok {
+			log.Error(fmt.Errorf("expected a IBMVPCMachine but got a %T", o), "failed to get IBMVPCCluster for IBMVPCMachine")
+			return nil
+		}
+		if _, ok := m.Labels[capiv1beta1.MachineControlPlaneNameLabel]; !ok {
+			return nil
+		}
+
+		cluster, err := util.GetClusterFromMetadata(mapCtx, r.Client, m.ObjectMeta)
+		switch {
+		case apierrors.IsNotFound(err) || cluster == nil:
+			return nil
+		case err != nil:
+			log.Error(err, "failed to get cluster of IBMVPCMachine")
+			return nil
+		}
+		if cluster.Spec.InfrastructureRef == nil || cluster.Spec.InfrastructureRef.Kind != "IBMVPCCluster" {
+			return nil
+		}
+		return []ctrl.Request{{NamespacedName: client.ObjectKey{Namespace: cluster.Namespace, Name: cluster.Spec.InfrastructureRef.Name}}}
+	}
+}
+
 // SetupWithManager creates a new IBMVPCCluster controller for a manager.
 func (r *IBMVPCClusterReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&infrav1beta2.IBMVPCCluster{}).
-		WithEventFilter(predicates.ResourceIsNotExternallyManaged(r.Scheme, ctrl.LoggerFrom(ctx))).
+		Watches(
+			&infrav1beta2.IBMVPCMachine{},
+			handler.EnqueueRequestsFromMapFunc(r.IBMVPCMachineToIBMVPCCluster(ctx)),
+		).
 		Complete(r)
 }
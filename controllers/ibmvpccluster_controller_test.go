@@ -28,12 +28,15 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/klog/v2"
 	"k8s.io/utils/ptr"
 	capiv1beta1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	"sigs.k8s.io/cluster-api/util"
+	"sigs.k8s.io/cluster-api/util/conditions"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	infrav1beta2 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/v1beta2"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/cloud/scope"
@@ -140,6 +143,59 @@ func TestIBMVPCClusterReconciler_Reconcile(t *testing.T) {
 	}
 }
 
+func TestIBMVPCClusterReconciler_reconcileExternallyManaged(t *testing.T) {
+	newVPCCluster := func() *infrav1beta2.IBMVPCCluster {
+		return &infrav1beta2.IBMVPCCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "vpc-test",
+				Namespace:   "default",
+				Annotations: map[string]string{capiv1beta1.ManagedByAnnotation: ""},
+				Finalizers:  []string{infrav1beta2.ClusterFinalizer},
+			},
+			Spec: infrav1beta2.IBMVPCClusterSpec{
+				Region: "us-south",
+				VPC:    "capi-vpc",
+			},
+		}
+	}
+	reconcile := func(g *WithT, vpcCluster *infrav1beta2.IBMVPCCluster) *infrav1beta2.IBMVPCCluster {
+		mockClient := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(vpcCluster).WithStatusSubresource(vpcCluster).Build()
+		reconciler := &IBMVPCClusterReconciler{
+			Client: mockClient,
+			Log:    klog.Background(),
+		}
+		_, err := reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(vpcCluster)})
+		g.Expect(err).To(BeNil())
+
+		updatedCluster := &infrav1beta2.IBMVPCCluster{}
+		if err := mockClient.Get(ctx, client.ObjectKeyFromObject(vpcCluster), updatedCluster); err != nil {
+			return nil
+		}
+		return updatedCluster
+	}
+
+	t.Run("Should not set cluster status as Ready if control plane endpoint is not set", func(t *testing.T) {
+		g := NewWithT(t)
+		vpcCluster := reconcile(g, newVPCCluster())
+		g.Expect(vpcCluster.Status.Ready).To(BeFalse())
+	})
+
+	t.Run("Should set cluster status as Ready without owner cluster if control plane endpoint is set", func(t *testing.T) {
+		g := NewWithT(t)
+		vpcCluster := newVPCCluster()
+		vpcCluster.Spec.ControlPlaneEndpoint = capiv1beta1.APIEndpoint{Host: "192.168.1.10", Port: 6443}
+		vpcCluster = reconcile(g, vpcCluster)
+		g.Expect(vpcCluster.Status.Ready).To(BeTrue())
+	})
+
+	t.Run("Should remove the finalizer without deleting the infrastructure", func(t *testing.T) {
+		g := NewWithT(t)
+		vpcCluster := newVPCCluster()
+		vpcCluster.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+		g.Expect(reconcile(g, vpcCluster)).To(BeNil())
+	})
+}
+
 func TestIBMVPCClusterReconciler_reconcile(t *testing.T) {
 	var (
 		mockvpc      *mock.MockVpc
@@ -157,6 +213,7 @@ func TestIBMVPCClusterReconciler_reconcile(t *testing.T) {
 			Log:    klog.Background(),
 		}
 		clusterScope = &scope.ClusterScope{
+			Client:       testEnv.Client,
 			IBMVPCClient: mockvpc,
 			Cluster:      &capiv1beta1.Cluster{},
 			Logger:       klog.Background(),
@@ -247,6 +304,7 @@ func TestIBMVPCClusterReconciler_reconcile(t *testing.T) {
 			mockvpc.EXPECT().ListVpcs(listVpcsOptions).Return(vpclist, response, nil)
 			mockvpc.EXPECT().ListSubnets(subnetOptions).Return(subnets, response, nil)
 			mockvpc.EXPECT().ListLoadBalancers(loadBalancerOptions).Return(loadBalancers, response, nil)
+			mockvpc.EXPECT().GetLoadBalancer(&vpcv1.GetLoadBalancerOptions{ID: core.StringPtr("vpc-load-balancer-id")}).Return(&loadBalancers.LoadBalancers[0], response, nil)
 			_, err := reconciler.reconcile(clusterScope)
 			g.Expect(err).To(BeNil())
 			g.Expect(clusterScope.IBMVPCCluster.Finalizers).To(ContainElement(infrav1beta2.ClusterFinalizer))
@@ -262,6 +320,7 @@ func TestIBMVPCClusterReconciler_reconcile(t *testing.T) {
 			mockvpc.EXPECT().ListVpcs(listVpcsOptions).Return(vpclist, response, nil)
 			mockvpc.EXPECT().ListSubnets(subnetOptions).Return(subnets, response, nil)
 			mockvpc.EXPECT().ListLoadBalancers(loadBalancerOptions).Return(loadBalancers, response, nil)
+			mockvpc.EXPECT().GetLoadBalancer(&vpcv1.GetLoadBalancerOptions{ID: core.StringPtr("vpc-load-balancer-id")}).Return(&loadBalancers.LoadBalancers[0], response, nil)
 			_, err := reconciler.reconcile(clusterScope)
 			g.Expect(err).To(BeNil())
 			g.Expect(clusterScope.IBMVPCCluster.Finalizers).To(ContainElement(infrav1beta2.ClusterFinalizer))
@@ -276,6 +335,7 @@ func TestIBMVPCClusterReconciler_reconcile(t *testing.T) {
 			mockvpc.EXPECT().ListVpcs(listVpcsOptions).Return(vpclist, response, nil)
 			mockvpc.EXPECT().ListSubnets(subnetOptions).Return(subnets, response, nil)
 			mockvpc.EXPECT().ListLoadBalancers(loadBalancerOptions).Return(loadBalancers, response, nil)
+			mockvpc.EXPECT().GetLoadBalancer(&vpcv1.GetLoadBalancerOptions{ID: core.StringPtr("vpc-load-balancer-id")}).Return(&loadBalancers.LoadBalancers[0], response, nil)
 			_, err := reconciler.reconcile(clusterScope)
 			g.Expect(err).To(BeNil())
 			g.Expect(clusterScope.IBMVPCCluster.Finalizers).To(ContainElement(infrav1beta2.ClusterFinalizer))
@@ -294,6 +354,7 @@ func TestIBMVPCClusterLBReconciler_reconcile(t *testing.T) {
 			Log:    klog.Background(),
 		}
 		clusterScope := &scope.ClusterScope{
+			Client:       testEnv.Client,
 			IBMVPCClient: mockvpc,
 			Cluster:      &capiv1beta1.Cluster{},
 			Logger:       klog.Background(),
@@ -364,6 +425,7 @@ func TestIBMVPCClusterLBReconciler_reconcile(t *testing.T) {
 			mockvpc.EXPECT().ListVpcs(&vpcv1.ListVpcsOptions{}).Return(vpclist, &core.DetailedResponse{}, nil)
 			mockvpc.EXPECT().ListSubnets(&vpcv1.ListSubnetsOptions{}).Return(subnets, &core.DetailedResponse{}, nil)
 			mockvpc.EXPECT().ListLoadBalancers(&vpcv1.ListLoadBalancersOptions{}).Return(loadBalancerCollection, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().GetLoadBalancer(&vpcv1.GetLoadBalancerOptions{ID: core.StringPtr("vpc-load-balancer-id")}).Return(&loadBalancerCollection.LoadBalancers[0], &core.DetailedResponse{}, nil)
 			_, err := reconciler.reconcile(clusterScope)
 			g.Expect(err).To(BeNil())
 			g.Expect(clusterScope.IBMVPCCluster.Finalizers).To(ContainElement(infrav1beta2.ClusterFinalizer))
@@ -380,6 +442,7 @@ func TestIBMVPCClusterLBReconciler_reconcile(t *testing.T) {
 			mockvpc.EXPECT().ListVpcs(&vpcv1.ListVpcsOptions{}).Return(vpclist, &core.DetailedResponse{}, nil)
 			mockvpc.EXPECT().ListSubnets(&vpcv1.ListSubnetsOptions{}).Return(subnets, &core.DetailedResponse{}, nil)
 			mockvpc.EXPECT().ListLoadBalancers(&vpcv1.ListLoadBalancersOptions{}).Return(loadBalancerCollection, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().GetLoadBalancer(&vpcv1.GetLoadBalancerOptions{ID: core.StringPtr("vpc-load-balancer-id")}).Return(&loadBalancerCollection.LoadBalancers[0], &core.DetailedResponse{}, nil)
 			_, err := reconciler.reconcile(clusterScope)
 			g.Expect(err).To(BeNil())
 			g.Expect(clusterScope.IBMVPCCluster.Finalizers).To(ContainElement(infrav1beta2.ClusterFinalizer))
@@ -397,6 +460,7 @@ func TestIBMVPCClusterLBReconciler_reconcile(t *testing.T) {
 			mockvpc.EXPECT().ListVpcs(&vpcv1.ListVpcsOptions{}).Return(vpclist, &core.DetailedResponse{}, nil)
 			mockvpc.EXPECT().ListSubnets(&vpcv1.ListSubnetsOptions{}).Return(subnets, &core.DetailedResponse{}, nil)
 			mockvpc.EXPECT().ListLoadBalancers(&vpcv1.ListLoadBalancersOptions{}).Return(loadBalancerCollection, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().GetLoadBalancer(&vpcv1.GetLoadBalancerOptions{ID: core.StringPtr("vpc-load-balancer-id")}).Return(&loadBalancerCollection.LoadBalancers[0], &core.DetailedResponse{}, nil)
 			_, err := reconciler.reconcile(clusterScope)
 			g.Expect(err).To(BeNil())
 			g.Expect(clusterScope.IBMVPCCluster.Finalizers).To(ContainElement(infrav1beta2.ClusterFinalizer))
@@ -552,6 +616,166 @@ func TestIBMVPCClusterReconciler_delete(t *testing.T) {
 	})
 }
 
+func TestIBMVPCClusterReconciler_deleteV2(t *testing.T) {
+	var (
+		mockvpc      *mock.MockVpc
+		mockCtrl     *gomock.Controller
+		clusterScope *scope.VPCClusterScope
+		reconciler   IBMVPCClusterReconciler
+	)
+
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockvpc = mock.NewMockVpc(mockCtrl)
+		reconciler = IBMVPCClusterReconciler{
+			Log: klog.Background(),
+		}
+		clusterScope = &scope.VPCClusterScope{
+			Client:    fake.NewClientBuilder().WithScheme(scheme.Scheme).Build(),
+			VPCClient: mockvpc,
+			Logger:    klog.Background(),
+			Cluster:   &capiv1beta1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "capi-cluster", Namespace: "default"}},
+			IBMVPCCluster: &infrav1beta2.IBMVPCCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:  "default",
+					Finalizers: []string{infrav1beta2.ClusterFinalizer},
+				},
+				Spec: infrav1beta2.IBMVPCClusterSpec{
+					Network: &infrav1beta2.VPCNetworkSpec{},
+				},
+				Status: infrav1beta2.IBMVPCClusterStatus{
+					Network: &infrav1beta2.VPCNetworkStatus{
+						ControlPlaneSubnets: map[string]*infrav1beta2.ResourceStatus{
+							"capi-subnet": {ID: "capi-subnet-id", Name: ptr.To("capi-subnet"), Ready: true, ControllerCreated: ptr.To(true)},
+						},
+						WorkerSubnets: map[string]*infrav1beta2.ResourceStatus{
+							"capi-subnet": {ID: "capi-subnet-id", Name: ptr.To("capi-subnet"), Ready: true, ControllerCreated: ptr.To(true)},
+						},
+						PublicGateways: map[string]*infrav1beta2.ResourceStatus{
+							"capi-pgw": {ID: "capi-pgw-id", Name: ptr.To("capi-pgw"), Ready: true, ControllerCreated: ptr.To(true)},
+						},
+						VPC: &infrav1beta2.ResourceStatus{ID: "capi-vpc-id", Name: ptr.To("capi-vpc"), Ready: true, ControllerCreated: ptr.To(true)},
+					},
+				},
+			},
+		}
+	}
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+
+	listVSIOpts := &vpcv1.ListInstancesOptions{
+		VPCID: ptr.To("capi-vpc-id"),
+	}
+	response := &core.DetailedResponse{}
+	notFoundResponse := &core.DetailedResponse{StatusCode: 404}
+	t.Run("Reconciling deleting IBMVPCCluster with extended VPC Infrastructure support", func(t *testing.T) {
+		t.Run("Should skip deleting other resources if instances are still running", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t)
+			t.Cleanup(teardown)
+			mockvpc.EXPECT().ListInstances(listVSIOpts).Return(&vpcv1.InstanceCollection{TotalCount: ptr.To(int64(1))}, response, nil)
+			result, err := reconciler.reconcileDeleteV2(clusterScope)
+			g.Expect(err).To(BeNil())
+			g.Expect(result.RequeueAfter).To(Equal(1 * time.Minute))
+			g.Expect(clusterScope.IBMVPCCluster.Finalizers).To(ContainElement(infrav1beta2.ClusterFinalizer))
+		})
+		t.Run("Should delete the subnet only once and requeue", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t)
+			t.Cleanup(teardown)
+			mockvpc.EXPECT().ListInstances(listVSIOpts).Return(&vpcv1.InstanceCollection{TotalCount: ptr.To(int64(0))}, response, nil)
+			mockvpc.EXPECT().GetSubnet(&vpcv1.GetSubnetOptions{ID: ptr.To("capi-subnet-id")}).Return(&vpcv1.Subnet{ID: ptr.To("capi-subnet-id"), Status: ptr.To("available")}, response, nil)
+			mockvpc.EXPECT().DeleteSubnet(&vpcv1.DeleteSubnetOptions{ID: ptr.To("capi-subnet-id")}).Return(response, nil)
+			result, err := reconciler.reconcileDeleteV2(clusterScope)
+			g.Expect(err).To(BeNil())
+			g.Expect(result.RequeueAfter).To(Equal(15 * time.Second))
+			g.Expect(clusterScope.IBMVPCCluster.Finalizers).To(ContainElement(infrav1beta2.ClusterFinalizer))
+		})
+		t.Run("Should fail deleting the public gateway", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t)
+			t.Cleanup(teardown)
+			mockvpc.EXPECT().ListInstances(listVSIOpts).Return(&vpcv1.InstanceCollection{TotalCount: ptr.To(int64(0))}, response, nil)
+			mockvpc.EXPECT().GetSubnet(&vpcv1.GetSubnetOptions{ID: ptr.To("capi-subnet-id")}).Return(nil, notFoundResponse, errors.New("subnet not found"))
+			mockvpc.EXPECT().GetPublicGateway(&vpcv1.GetPublicGatewayOptions{ID: ptr.To("capi-pgw-id")}).Return(&vpcv1.PublicGateway{ID: ptr.To("capi-pgw-id"), Status: ptr.To("available")}, response, nil)
+			mockvpc.EXPECT().DeletePublicGateway(&vpcv1.DeletePublicGatewayOptions{ID: ptr.To("capi-pgw-id")}).Return(response, errors.New("failed to delete public gateway"))
+			_, err := reconciler.reconcileDeleteV2(clusterScope)
+			g.Expect(err).To(Not(BeNil()))
+			g.Expect(clusterScope.IBMVPCCluster.Status.Network.ControlPlaneSubnets).To(BeEmpty())
+			g.Expect(clusterScope.IBMVPCCluster.Status.Network.WorkerSubnets).To(BeEmpty())
+			g.Expect(clusterScope.IBMVPCCluster.Finalizers).To(ContainElement(infrav1beta2.ClusterFinalizer))
+		})
+		t.Run("Should skip deleting resources not created by the controller and remove the finalizer", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t)
+			t.Cleanup(teardown)
+			clusterScope.IBMVPCCluster.Status.Network.ControlPlaneSubnets["capi-subnet"].ControllerCreated = nil
+			clusterScope.IBMVPCCluster.Status.Network.WorkerSubnets["capi-subnet"].ControllerCreated = nil
+			clusterScope.IBMVPCCluster.Status.Network.PublicGateways["capi-pgw"].ControllerCreated = ptr.To(false)
+			clusterScope.IBMVPCCluster.Status.Network.VPC.ControllerCreated = nil
+			_, err := reconciler.reconcileDeleteV2(clusterScope)
+			g.Expect(err).To(BeNil())
+			g.Expect(clusterScope.IBMVPCCluster.Finalizers).To(Not(ContainElement(infrav1beta2.ClusterFinalizer)))
+		})
+		t.Run("Should only wait for the machines of the cluster in a VPC not created by the controller", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t)
+			t.Cleanup(teardown)
+			clusterScope.IBMVPCCluster.Status.Network.VPC.ControllerCreated = nil
+			machine := &infrav1beta2.IBMVPCMachine{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "capi-machine",
+					Namespace: "default",
+					Labels:    map[string]string{capiv1beta1.ClusterNameLabel: "capi-cluster"},
+				},
+			}
+			otherMachine := &infrav1beta2.IBMVPCMachine{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "other-machine",
+					Namespace: "default",
+					Labels:    map[string]string{capiv1beta1.ClusterNameLabel: "other-cluster"},
+				},
+			}
+			clusterScope.Client = fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(machine, otherMachine).Build()
+			result, err := reconciler.reconcileDeleteV2(clusterScope)
+			g.Expect(err).To(BeNil())
+			g.Expect(result.RequeueAfter).To(Equal(1 * time.Minute))
+			g.Expect(clusterScope.IBMVPCCluster.Finalizers).To(ContainElement(infrav1beta2.ClusterFinalizer))
+
+			g.Expect(clusterScope.Client.Delete(ctx, machine)).To(Succeed())
+			clusterScope.IBMVPCCluster.Status.Network.ControlPlaneSubnets = nil
+			clusterScope.IBMVPCCluster.Status.Network.WorkerSubnets = nil
+			clusterScope.IBMVPCCluster.Status.Network.PublicGateways = nil
+			_, err = reconciler.reconcileDeleteV2(clusterScope)
+			g.Expect(err).To(BeNil())
+			g.Expect(clusterScope.IBMVPCCluster.Finalizers).To(Not(ContainElement(infrav1beta2.ClusterFinalizer)))
+		})
+		t.Run("Should successfully delete the VPC and remove the finalizer", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t)
+			t.Cleanup(teardown)
+			clusterScope.IBMVPCCluster.Status.Network.ControlPlaneSubnets = nil
+			clusterScope.IBMVPCCluster.Status.Network.WorkerSubnets = nil
+			clusterScope.IBMVPCCluster.Status.Network.PublicGateways = nil
+			mockvpc.EXPECT().ListInstances(listVSIOpts).Return(&vpcv1.InstanceCollection{TotalCount: ptr.To(int64(0))}, response, nil).Times(2)
+			mockvpc.EXPECT().GetVPC(&vpcv1.GetVPCOptions{ID: ptr.To("capi-vpc-id")}).Return(&vpcv1.VPC{ID: ptr.To("capi-vpc-id"), Status: ptr.To("available")}, response, nil)
+			mockvpc.EXPECT().DeleteVPC(&vpcv1.DeleteVPCOptions{ID: ptr.To("capi-vpc-id")}).Return(response, nil)
+			result, err := reconciler.reconcileDeleteV2(clusterScope)
+			g.Expect(err).To(BeNil())
+			g.Expect(result.RequeueAfter).To(Equal(15 * time.Second))
+			g.Expect(clusterScope.IBMVPCCluster.Finalizers).To(ContainElement(infrav1beta2.ClusterFinalizer))
+
+			mockvpc.EXPECT().GetVPC(&vpcv1.GetVPCOptions{ID: ptr.To("capi-vpc-id")}).Return(nil, notFoundResponse, errors.New("vpc not found"))
+			_, err = reconciler.reconcileDeleteV2(clusterScope)
+			g.Expect(err).To(BeNil())
+			g.Expect(clusterScope.IBMVPCCluster.Status.Network.VPC).To(BeNil())
+			g.Expect(clusterScope.IBMVPCCluster.Finalizers).To(Not(ContainElement(infrav1beta2.ClusterFinalizer)))
+		})
+	})
+}
+
 func TestIBMVPCClusterLBReconciler_delete(t *testing.T) {
 	setup := func(t *testing.T) (*gomock.Controller, *mock.MockVpc, *scope.ClusterScope, IBMVPCClusterReconciler) {
 		t.Helper()
@@ -662,3 +886,57 @@ func cleanupVPCCluster(g *WithT, vpcCluster *infrav1beta2.IBMVPCCluster, namespa
 		}(vpcCluster, namespace)
 	}
 }
+
+func TestIBMVPCClusterReconciler_reconcileControlPlaneEndpoint(t *testing.T) {
+	newClusterScope := func(endpoint string, annotations map[string]string) *scope.VPCClusterScope {
+		return &scope.VPCClusterScope{
+			Logger: klog.Background(),
+			Cluster: &capiv1beta1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "capi-cluster", Namespace: "default"},
+				Spec: capiv1beta1.ClusterSpec{
+					ControlPlaneEndpoint: capiv1beta1.APIEndpoint{Host: endpoint, Port: infrav1beta2.DefaultAPIServerPort},
+				},
+			},
+			IBMVPCCluster: &infrav1beta2.IBMVPCCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "capi-vpc-cluster", Namespace: "default", Annotations: annotations},
+				Spec: infrav1beta2.IBMVPCClusterSpec{
+					ControlPlaneEndpoint: capiv1beta1.APIEndpoint{Host: endpoint, Port: infrav1beta2.DefaultAPIServerPort},
+				},
+			},
+		}
+	}
+
+	t.Run("When the control plane endpoint is not set, it is set with the hostname", func(t *testing.T) {
+		g := NewWithT(t)
+		reconciler := &IBMVPCClusterReconciler{}
+		clusterScope := newClusterScope("", nil)
+		g.Expect(reconciler.reconcileControlPlaneEndpoint(clusterScope, "api.capi-cluster.example.com")).To(Succeed())
+		g.Expect(clusterScope.IBMVPCCluster.Spec.ControlPlaneEndpoint).To(Equal(capiv1beta1.APIEndpoint{Host: "api.capi-cluster.example.com", Port: infrav1beta2.DefaultAPIServerPort}))
+		g.Expect(conditions.IsTrue(clusterScope.IBMVPCCluster, infrav1beta2.ControlPlaneEndpointReadyCondition)).To(BeTrue())
+	})
+
+	t.Run("When DNS is set on a cluster with an endpoint, the control plane endpoint is kept until approved", func(t *testing.T) {
+		g := NewWithT(t)
+		reconciler := &IBMVPCClusterReconciler{}
+		clusterScope := newClusterScope("lb.hostname", nil)
+		g.Expect(reconciler.reconcileControlPlaneEndpoint(clusterScope, "api.capi-cluster.example.com")).To(Succeed())
+		g.Expect(clusterScope.IBMVPCCluster.Spec.ControlPlaneEndpoint.Host).To(Equal("lb.hostname"))
+		g.Expect(conditions.GetReason(clusterScope.IBMVPCCluster, infrav1beta2.ControlPlaneEndpointReadyCondition)).To(Equal(infrav1beta2.ControlPlaneEndpointChangedReason))
+	})
+
+	t.Run("When the update of the control plane endpoint is approved, the endpoint of the cluster is updated", func(t *testing.T) {
+		g := NewWithT(t)
+		clusterScope := newClusterScope("lb.hostname", map[string]string{infrav1beta2.ControlPlaneEndpointUpdateAnnotation: "true"})
+		reconciler := &IBMVPCClusterReconciler{
+			Client: fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(clusterScope.Cluster).Build(),
+		}
+		g.Expect(reconciler.reconcileControlPlaneEndpoint(clusterScope, "api.capi-cluster.example.com")).To(Succeed())
+		g.Expect(clusterScope.IBMVPCCluster.Spec.ControlPlaneEndpoint.Host).To(Equal("api.capi-cluster.example.com"))
+		g.Expect(clusterScope.IBMVPCCluster.Annotations).ToNot(HaveKey(infrav1beta2.ControlPlaneEndpointUpdateAnnotation))
+		g.Expect(conditions.IsTrue(clusterScope.IBMVPCCluster, infrav1beta2.ControlPlaneEndpointReadyCondition)).To(BeTrue())
+
+		cluster := &capiv1beta1.Cluster{}
+		g.Expect(reconciler.Client.Get(ctx, client.ObjectKeyFromObject(clusterScope.Cluster), cluster)).To(Succeed())
+		g.Expect(cluster.Spec.ControlPlaneEndpoint.Host).To(Equal("api.capi-cluster.example.com"))
+	})
+}
@@ -35,11 +35,14 @@ import (
 
 	capiv1beta1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	"sigs.k8s.io/cluster-api/util"
+	"sigs.k8s.io/cluster-api/util/annotations"
 	"sigs.k8s.io/cluster-api/util/conditions"
 
 	infrav1beta2 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/v1beta2"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloudevents"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/endpoints"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/metrics"
 	capibmrecord "sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/record"
 )
 
@@ -50,11 +53,14 @@ type IBMVPCMachineReconciler struct {
 	Recorder        record.EventRecorder
 	ServiceEndpoint []endpoints.ServiceEndpoint
 	Scheme          *runtime.Scheme
+	// Intervals are the intervals at which IBMVPCMachines are reconciled again.
+	Intervals Intervals
 }
 
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=ibmvpcmachines,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=ibmvpcmachines/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=machines;machines/status,verbs=get;list;watch
+// +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=machines,verbs=delete
 // +kubebuilder:rbac:groups="",resources=secrets;,verbs=get;list;watch
 // +kubebuilder:rbac:groups="",resources=events,verbs=get;list;watch;create;update;patch
 
@@ -72,6 +78,12 @@ func (r *IBMVPCMachineReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 		}
 		return ctrl.Result{}, err
 	}
+
+	start := time.Now()
+	defer func() {
+		metrics.ObserveReconcile("IBMVPCMachine", ibmVpcMachine, start, reterr)
+	}()
+
 	// Fetch the Machine.
 	machine, err := util.GetOwnerMachine(ctx, r.Client, ibmVpcMachine.ObjectMeta)
 	if err != nil {
@@ -91,6 +103,12 @@ func (r *IBMVPCMachineReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 
 	log = log.WithValues("cluster", cluster.Name)
 
+	// Skip the reconciliation while the Cluster is paused, e.g. during clusterctl move.
+	if annotations.IsPaused(cluster, ibmVpcMachine) {
+		log.Info("IBMVPCMachine or linked Cluster is marked as paused, not reconciling")
+		return ctrl.Result{}, nil
+	}
+
 	ibmCluster := &infrav1beta2.IBMVPCCluster{}
 	ibmVpcClusterName := client.ObjectKey{
 		Namespace: ibmVpcMachine.Namespace,
@@ -130,7 +148,7 @@ func (r *IBMVPCMachineReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 	}
 
 	// Handle non-deleted machines.
-	return r.reconcileNormal(machineScope)
+	return r.Intervals.withOverrides(machineIntervals(ibmCluster.Spec.ReconcileIntervals)).apply(r.reconcileNormal(machineScope))
 }
 
 // SetupWithManager creates a new IBMVPCMachine controller for a manager.
@@ -157,15 +175,41 @@ func (r *IBMVPCMachineReconciler) reconcileNormal(machineScope *scope.MachineSco
 		}
 	}
 
+	exceeded, err := machineScope.ExceededGuardrails()
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to check guardrails for IBMVPCMachine %s/%s: %w", machineScope.IBMVPCMachine.Namespace, machineScope.IBMVPCMachine.Name, err)
+	}
+	if exceeded != "" {
+		machineScope.Info("Not creating the VSI as it exceeds the guardrails of the cluster", "exceeded", exceeded)
+		if conditions.GetReason(machineScope.IBMVPCMachine, infrav1beta2.InstanceReadyCondition) != infrav1beta2.GuardrailsExceededReason {
+			capibmrecord.Warnf(machineScope.IBMVPCMachine, "GuardrailsExceeded", "VSI of machine %s is not created as it exceeds the guardrails of the cluster: %s", machineScope.IBMVPCMachine.Name, exceeded)
+		}
+		conditions.MarkFalse(machineScope.IBMVPCMachine, infrav1beta2.InstanceReadyCondition, infrav1beta2.GuardrailsExceededReason, capiv1beta1.ConditionSeverityWarning, "%s", exceeded)
+		return ctrl.Result{RequeueAfter: 1 * time.Minute}, nil
+	}
+
 	instance, err := r.getOrCreate(machineScope)
 	if err != nil {
 		return ctrl.Result{}, fmt.Errorf("failed to reconcile VSI for IBMVPCMachine %s/%s: %w", machineScope.IBMVPCMachine.Namespace, machineScope.IBMVPCMachine.Name, err)
 	}
 
+	// If IBM Cloud reclaimed the instance, delete the Machine, so it gets replaced, instead of waiting for the instance to be started again.
+	if reason := machineScope.IsInstanceInterrupted(instance); reason != "" {
+		machineScope.SetNotReady()
+		if conditions.GetReason(machineScope.IBMVPCMachine, infrav1beta2.InstanceReadyCondition) != infrav1beta2.InstanceInterruptedReason {
+			capibmrecord.Warnf(machineScope.IBMVPCMachine, "InstanceInterrupted", "Instance %s was reclaimed by IBM Cloud (%s), deleting Machine %s", *instance.ID, reason, machineScope.Machine.Name)
+		}
+		conditions.MarkFalse(machineScope.IBMVPCMachine, infrav1beta2.InstanceReadyCondition, infrav1beta2.InstanceInterruptedReason, capiv1beta1.ConditionSeverityError, "instance %s was reclaimed by IBM Cloud: %s", *instance.ID, reason)
+		if err := machineScope.DeleteOwnerMachine(fmt.Sprintf("the instance was reclaimed by IBM Cloud: %s", reason)); err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{}, nil
+	}
+
 	machineRunning := false
 	if instance != nil {
 		// Attempt to tag the Instance.
-		if err := machineScope.TagResource(machineScope.IBMVPCCluster.Name, *instance.CRN); err != nil {
+		if err := machineScope.TagInstance(instance); err != nil {
 			return ctrl.Result{}, fmt.Errorf("error failed to tag machine: %w", err)
 		}
 
@@ -176,6 +220,10 @@ func (r *IBMVPCMachineReconciler) reconcileNormal(machineScope *scope.MachineSco
 		}
 		machineScope.SetAddresses(instance)
 		machineScope.SetInstanceStatus(*instance.Status)
+		if err := machineScope.ReconcileDebugDump(instance); err != nil {
+			machineScope.Error(err, "failed to reconcile debug dump of the instance")
+		}
+		machineScope.ReconcileBootstrapSucceeded()
 
 		// Depending on the state of the Machine, update status, conditions, etc.
 		switch machineScope.GetInstanceStatus() {
@@ -193,11 +241,22 @@ func (r *IBMVPCMachineReconciler) reconcileNormal(machineScope *scope.MachineSco
 				// TODO(cjschaef): Consider adding the MoreInfo field as well, as it contains a link to IBM Cloud docs.
 				msg = fmt.Sprintf("%s: %s", *instance.HealthReasons[healthReasonsLen-1].Code, *instance.HealthReasons[healthReasonsLen-1].Message)
 			}
+			// Publish the failure once, when the machine transitions into the failed state.
+			if machineScope.IBMVPCMachine.Status.FailureReason == nil {
+				cloudevents.Publish(machineScope.IBMVPCMachine, cloudevents.MachineFailed, msg)
+			}
 			machineScope.SetNotReady()
 			machineScope.SetFailureReason(infrav1beta2.UpdateMachineError)
 			machineScope.SetFailureMessage(msg)
 			conditions.MarkFalse(machineScope.IBMVPCMachine, infrav1beta2.InstanceReadyCondition, infrav1beta2.InstanceErroredReason, capiv1beta1.ConditionSeverityError, "%s", msg)
 			capibmrecord.Warnf(machineScope.IBMVPCMachine, "FailedBuildInstance", "Failed to build the instance - %s", msg)
+			// Replace the Machine without waiting for a MachineHealthCheck when the auto-repair policy allows it.
+			if machineScope.ShouldAutoRepair() {
+				capibmrecord.Warnf(machineScope.IBMVPCMachine, "AutoRepairInstance", "Instance %s failed, deleting Machine %s", machineScope.GetInstanceID(), machineScope.Machine.Name)
+				if err := machineScope.DeleteOwnerMachine("the instance failed"); err != nil {
+					return ctrl.Result{}, err
+				}
+			}
 			return ctrl.Result{}, nil
 		case vpcv1.InstanceStatusRunningConst:
 			machineRunning = true
@@ -239,7 +298,8 @@ func (r *IBMVPCMachineReconciler) reconcileNormal(machineScope *scope.MachineSco
 		if err = machineScope.SetProviderID(instance.ID); err != nil {
 			return ctrl.Result{}, fmt.Errorf("failed to set provider id IBMVPCMachine %s/%s: %w", machineScope.IBMVPCMachine.Namespace, machineScope.IBMVPCMachine.Name, err)
 		}
-		if ok {
+		// The Machine being deleted is removed from the pool by the IBMVPCCluster controller, hence it must not add itself back.
+		if ok && scope.IsLoadBalancerPoolMemberEligible(machineScope.Machine, machineScope.IBMVPCMachine) {
 			if instance.PrimaryNetworkInterface.PrimaryIP.Address == nil || *instance.PrimaryNetworkInterface.PrimaryIP.Address == "0.0.0.0" {
 				return ctrl.Result{}, fmt.Errorf("invalid primary ip address")
 			}
@@ -275,11 +335,21 @@ func (r *IBMVPCMachineReconciler) reconcileDelete(scope *scope.MachineScope) (_
 		}
 	}
 
+	if scope.IBMVPCMachine.Status.InstanceID != "" {
+		if result, err := r.reconcileInstanceTeardown(scope); err != nil || !result.IsZero() {
+			return result, err
+		}
+	}
+
 	if err := scope.DeleteMachine(); err != nil {
 		scope.Info("error deleting IBMVPCMachine")
 		return ctrl.Result{}, fmt.Errorf("error deleting IBMVPCMachine %s/%s: %w", scope.IBMVPCMachine.Namespace, scope.IBMVPCMachine.Spec.Name, err)
 	}
 
+	if err := scope.DeleteBootstrapData(); err != nil {
+		return ctrl.Result{}, fmt.Errorf("error deleting bootstrap data of IBMVPCMachine %s/%s: %w", scope.IBMVPCMachine.Namespace, scope.IBMVPCMachine.Name, err)
+	}
+
 	defer func() {
 		if reterr == nil {
 			// VSI is deleted so remove the finalizer.
@@ -289,3 +359,24 @@ func (r *IBMVPCMachineReconciler) reconcileDelete(scope *scope.MachineScope) (_
 
 	return ctrl.Result{}, nil
 }
+
+// reconcileInstanceTeardown stops the instance and detaches its data volumes before the instance gets deleted,
+// so the data written to the volumes is not corrupted by the deletion of a running instance.
+func (r *IBMVPCMachineReconciler) reconcileInstanceTeardown(scope *scope.MachineScope) (ctrl.Result, error) {
+	conditions.MarkFalse(scope.IBMVPCMachine, infrav1beta2.InstanceReadyCondition, capiv1beta1.DeletingReason, capiv1beta1.ConditionSeverityInfo, "")
+
+	if requeue, err := scope.ReconcileInstanceShutdown(); err != nil {
+		return ctrl.Result{}, fmt.Errorf("error stopping instance of IBMVPCMachine %s/%s: %w", scope.IBMVPCMachine.Namespace, scope.IBMVPCMachine.Name, err)
+	} else if requeue {
+		scope.Info("Waiting for the instance to stop before deleting it")
+		return ctrl.Result{RequeueAfter: 15 * time.Second}, nil
+	}
+
+	if requeue, err := scope.ReconcileVolumeDetach(); err != nil {
+		return ctrl.Result{}, fmt.Errorf("error detaching volumes of IBMVPCMachine %s/%s: %w", scope.IBMVPCMachine.Namespace, scope.IBMVPCMachine.Name, err)
+	} else if requeue {
+		scope.Info("Waiting for the data volumes to detach before deleting the instance")
+		return ctrl.Result{RequeueAfter: 15 * time.Second}, nil
+	}
+	return ctrl.Result{}, nil
+}
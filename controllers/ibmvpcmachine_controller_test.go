@@ -28,13 +28,17 @@ import (
 	"go.uber.org/mock/gomock"
 
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/klog/v2"
 	"k8s.io/utils/ptr"
 	capiv1beta1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	"sigs.k8s.io/cluster-api/util"
+	"sigs.k8s.io/cluster-api/util/conditions"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	infrav1beta2 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/v1beta2"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/cloud/scope"
@@ -229,6 +233,7 @@ func TestIBMVPCMachineReconciler_reconcile(t *testing.T) {
 			Log:    klog.Background(),
 		}
 		machineScope = &scope.MachineScope{
+			Client: testEnv.Client,
 			Logger: klog.Background(),
 			IBMVPCMachine: &infrav1beta2.IBMVPCMachine{
 				ObjectMeta: metav1.ObjectMeta{
@@ -275,6 +280,34 @@ func TestIBMVPCMachineReconciler_reconcile(t *testing.T) {
 			g.Expect(err).To(Not(BeNil()))
 			g.Expect(machineScope.IBMVPCMachine.Finalizers).To(ContainElement(infrav1beta2.MachineFinalizer))
 		})
+		t.Run("Should delete the Machine when the instance was reclaimed by IBM Cloud", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t)
+			t.Cleanup(teardown)
+			machineScope.Machine.ObjectMeta = metav1.ObjectMeta{
+				Name:      "capi-machine",
+				Namespace: "default",
+			}
+			machineScope.Client = fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(machineScope.Machine).Build()
+			machineScope.Machine.Spec.Bootstrap.DataSecretName = ptr.To("capi-machine")
+			machineScope.IBMVPCMachine.Spec.Capacity = &infrav1beta2.VPCMachineCapacity{
+				InterruptionPolicy: infrav1beta2.VPCMachineInterruptionPolicyDeleteMachine,
+			}
+			machineScope.IBMVPCMachine.Status.InstanceID = "capi-instance-id"
+			mockvpc.EXPECT().ListInstances(options).Return(&vpcv1.InstanceCollection{Instances: []vpcv1.Instance{{
+				Name:          ptr.To("capi-machine"),
+				ID:            ptr.To("capi-instance-id"),
+				Status:        ptr.To(vpcv1.InstanceStatusStoppedConst),
+				StatusReasons: []vpcv1.InstanceStatusReason{{Code: ptr.To(vpcv1.InstanceStatusReasonCodeCannotStartReservationExpiredConst)}},
+			}}}, response, nil)
+			result, err := reconciler.reconcileNormal(machineScope)
+			g.Expect(err).To(BeNil())
+			g.Expect(result.RequeueAfter).To(BeZero())
+			g.Expect(machineScope.IBMVPCMachine.Status.Ready).To(BeFalse())
+			g.Expect(conditions.GetReason(machineScope.IBMVPCMachine, infrav1beta2.InstanceReadyCondition)).To(Equal(infrav1beta2.InstanceInterruptedReason))
+			err = machineScope.Client.Get(ctx, client.ObjectKeyFromObject(machineScope.Machine), &capiv1beta1.Machine{})
+			g.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		})
 	})
 }
 
@@ -288,6 +321,7 @@ func TestIBMVPCMachineLBReconciler_reconcile(t *testing.T) {
 			Log:    klog.Background(),
 		}
 		machineScope := &scope.MachineScope{
+			Client: testEnv.Client,
 			Logger: klog.Background(),
 			IBMVPCMachine: &infrav1beta2.IBMVPCMachine{
 				ObjectMeta: metav1.ObjectMeta{
@@ -594,6 +628,9 @@ func TestIBMVPCMachineReconciler_Delete(t *testing.T) {
 					Name:       "capi-machine",
 					Finalizers: []string{infrav1beta2.MachineFinalizer},
 				},
+				Spec: infrav1beta2.IBMVPCMachineSpec{
+					ShutdownTimeout: &metav1.Duration{Duration: 5 * time.Minute},
+				},
 				Status: infrav1beta2.IBMVPCMachineStatus{
 					InstanceID: "capi-machine-id",
 				},
@@ -611,6 +648,8 @@ func TestIBMVPCMachineReconciler_Delete(t *testing.T) {
 			g := NewWithT(t)
 			setup(t)
 			t.Cleanup(teardown)
+			mockvpc.EXPECT().GetInstance(gomock.AssignableToTypeOf(&vpcv1.GetInstanceOptions{})).Return(&vpcv1.Instance{Status: ptr.To(vpcv1.InstanceStatusStoppedConst)}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().ListInstanceVolumeAttachments(gomock.AssignableToTypeOf(&vpcv1.ListInstanceVolumeAttachmentsOptions{})).Return(&vpcv1.VolumeAttachmentCollection{}, &core.DetailedResponse{}, nil)
 			mockvpc.EXPECT().DeleteInstance(gomock.AssignableToTypeOf(options)).Return(nil, errors.New("Failed to delete the VPC instance"))
 			_, err := reconciler.reconcileDelete(machineScope)
 			g.Expect(err).To(Not(BeNil()))
@@ -621,11 +660,55 @@ func TestIBMVPCMachineReconciler_Delete(t *testing.T) {
 			setup(t)
 			t.Cleanup(teardown)
 			response := &core.DetailedResponse{}
+			mockvpc.EXPECT().GetInstance(gomock.AssignableToTypeOf(&vpcv1.GetInstanceOptions{})).Return(&vpcv1.Instance{Status: ptr.To(vpcv1.InstanceStatusStoppedConst)}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().ListInstanceVolumeAttachments(gomock.AssignableToTypeOf(&vpcv1.ListInstanceVolumeAttachmentsOptions{})).Return(&vpcv1.VolumeAttachmentCollection{}, &core.DetailedResponse{}, nil)
 			mockvpc.EXPECT().DeleteInstance(gomock.AssignableToTypeOf(options)).Return(response, nil)
 			_, err := reconciler.reconcileDelete(machineScope)
 			g.Expect(err).To(BeNil())
 			g.Expect(machineScope.IBMVPCMachine.Finalizers).To(Not(ContainElement(infrav1beta2.MachineFinalizer)))
 		})
+		t.Run("Should stop the VPC instance and detach its data volumes before deleting it", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t)
+			t.Cleanup(teardown)
+			mockvpc.EXPECT().GetInstance(gomock.AssignableToTypeOf(&vpcv1.GetInstanceOptions{})).Return(&vpcv1.Instance{Status: ptr.To(vpcv1.InstanceStatusRunningConst)}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().CreateInstanceAction(gomock.AssignableToTypeOf(&vpcv1.CreateInstanceActionOptions{})).Return(&vpcv1.InstanceAction{}, &core.DetailedResponse{}, nil)
+			result, err := reconciler.reconcileDelete(machineScope)
+			g.Expect(err).To(BeNil())
+			g.Expect(result.RequeueAfter).To(Not(BeZero()))
+			g.Expect(conditions.GetReason(machineScope.IBMVPCMachine, infrav1beta2.InstanceShutdownCondition)).To(Equal(infrav1beta2.InstanceShuttingDownReason))
+
+			mockvpc.EXPECT().GetInstance(gomock.AssignableToTypeOf(&vpcv1.GetInstanceOptions{})).Return(&vpcv1.Instance{Status: ptr.To(vpcv1.InstanceStatusStoppedConst)}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().ListInstanceVolumeAttachments(gomock.AssignableToTypeOf(&vpcv1.ListInstanceVolumeAttachmentsOptions{})).Return(&vpcv1.VolumeAttachmentCollection{
+				VolumeAttachments: []vpcv1.VolumeAttachment{
+					{
+						ID:                           ptr.To("data-attachment-id"),
+						Type:                         ptr.To(vpcv1.VolumeAttachmentTypeDataConst),
+						Status:                       ptr.To(vpcv1.VolumeAttachmentStatusAttachedConst),
+						DeleteVolumeOnInstanceDelete: ptr.To(false),
+					},
+				},
+			}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().DeleteInstanceVolumeAttachment(gomock.AssignableToTypeOf(&vpcv1.DeleteInstanceVolumeAttachmentOptions{})).Return(&core.DetailedResponse{}, nil)
+			result, err = reconciler.reconcileDelete(machineScope)
+			g.Expect(err).To(BeNil())
+			g.Expect(result.RequeueAfter).To(Not(BeZero()))
+			g.Expect(conditions.IsTrue(machineScope.IBMVPCMachine, infrav1beta2.InstanceShutdownCondition)).To(BeTrue())
+			g.Expect(conditions.GetReason(machineScope.IBMVPCMachine, infrav1beta2.VolumesDetachedCondition)).To(Equal(infrav1beta2.DetachingVolumesReason))
+			g.Expect(machineScope.IBMVPCMachine.Finalizers).To(ContainElement(infrav1beta2.MachineFinalizer))
+		})
+		t.Run("Should remove the finalizer when the VPC machine was already deleted", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t)
+			t.Cleanup(teardown)
+			response := &core.DetailedResponse{StatusCode: 404}
+			mockvpc.EXPECT().GetInstance(gomock.AssignableToTypeOf(&vpcv1.GetInstanceOptions{})).Return(&vpcv1.Instance{Status: ptr.To(vpcv1.InstanceStatusStoppedConst)}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().ListInstanceVolumeAttachments(gomock.AssignableToTypeOf(&vpcv1.ListInstanceVolumeAttachmentsOptions{})).Return(&vpcv1.VolumeAttachmentCollection{}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().DeleteInstance(gomock.AssignableToTypeOf(options)).Return(response, errors.New("instance not found"))
+			_, err := reconciler.reconcileDelete(machineScope)
+			g.Expect(err).To(BeNil())
+			g.Expect(machineScope.IBMVPCMachine.Finalizers).To(Not(ContainElement(infrav1beta2.MachineFinalizer)))
+		})
 	})
 }
 
@@ -697,6 +780,7 @@ func TestIBMVPCMachineLBReconciler_Delete(t *testing.T) {
 			mockvpc.EXPECT().GetLoadBalancer(gomock.AssignableToTypeOf(&vpcv1.GetLoadBalancerOptions{})).Return(loadBalancer, &core.DetailedResponse{}, nil)
 			mockvpc.EXPECT().GetInstance(gomock.AssignableToTypeOf(&vpcv1.GetInstanceOptions{})).Return(&vpcv1.Instance{}, &core.DetailedResponse{}, nil)
 			mockvpc.EXPECT().ListLoadBalancerPoolMembers(gomock.AssignableToTypeOf(&vpcv1.ListLoadBalancerPoolMembersOptions{})).Return(&vpcv1.LoadBalancerPoolMemberCollection{}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().ListInstanceVolumeAttachments(gomock.AssignableToTypeOf(&vpcv1.ListInstanceVolumeAttachmentsOptions{})).Return(&vpcv1.VolumeAttachmentCollection{}, &core.DetailedResponse{}, nil)
 			mockvpc.EXPECT().DeleteInstance(gomock.AssignableToTypeOf(&vpcv1.DeleteInstanceOptions{})).Return(&core.DetailedResponse{}, nil)
 			_, err := reconciler.reconcileDelete(machineScope)
 			g.Expect(err).To(BeNil())
@@ -34,6 +34,7 @@ import (
 	"sigs.k8s.io/cluster-api/util/patch"
 
 	infrav1beta2 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/authenticator"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/vpc"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/endpoints"
 )
@@ -53,6 +54,7 @@ func (r *IBMVPCMachineTemplateReconciler) SetupWithManager(mgr ctrl.Manager) err
 
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=ibmvpcmachinetemplates,verbs=get;list;watch
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=ibmvpcmachinetemplates/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
 
 func (r *IBMVPCMachineTemplateReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	log := ctrl.LoggerFrom(ctx)
@@ -69,7 +71,12 @@ func (r *IBMVPCMachineTemplateReconciler) Reconcile(ctx context.Context, req ctr
 	// Fetch the service endpoint.
 	svcEndpoint := endpoints.FetchVPCEndpoint(region, r.ServiceEndpoint)
 
-	vpcClient, err := vpc.NewService(svcEndpoint)
+	auth, err := authenticator.GetNamespaceAuthenticator(ctx, r.Client, machineTemplate.Namespace)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to create authenticator: %w", err)
+	}
+
+	vpcClient, err := vpc.NewServiceWithAuthenticator(svcEndpoint, auth)
 	if err != nil {
 		return ctrl.Result{}, fmt.Errorf("failed to create IBM VPC client: %w", err)
 	}
@@ -0,0 +1,91 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"time"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	infrav1beta2 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/v1beta2"
+)
+
+// Intervals are the intervals at which a controller reconciles its objects again.
+// Zero values keep the intervals returned by the reconcile loop.
+type Intervals struct {
+	// RequeueInterval replaces the interval at which objects waiting on IBM Cloud are reconciled again.
+	RequeueInterval time.Duration
+	// SyncPeriod is the interval at which objects which are fully reconciled are reconciled again.
+	SyncPeriod time.Duration
+}
+
+// withOverrides returns the intervals overridden by the intervals set in the spec of the cluster.
+func (i Intervals) withOverrides(override *infrav1beta2.ReconcileInterval) Intervals {
+	if override == nil {
+		return i
+	}
+	if override.RequeueInterval != nil {
+		i.RequeueInterval = override.RequeueInterval.Duration
+	}
+	if override.SyncPeriod != nil {
+		i.SyncPeriod = override.SyncPeriod.Duration
+	}
+	return i
+}
+
+// apply applies the intervals to the result of a reconcile loop. Failed reconcile loops and results requeued
+// immediately are left to the rate limiter of the controller.
+func (i Intervals) apply(result ctrl.Result, err error) (ctrl.Result, error) {
+	if err != nil {
+		return result, err
+	}
+	switch {
+	case result.RequeueAfter > 0:
+		if i.RequeueInterval > 0 {
+			result.RequeueAfter = i.RequeueInterval
+		}
+	case result.IsZero():
+		if i.SyncPeriod > 0 {
+			result.RequeueAfter = i.SyncPeriod
+		}
+	}
+	return result, nil
+}
+
+// clusterIntervals returns the overrides of the intervals of the infrastructure cluster.
+func clusterIntervals(intervals *infrav1beta2.ReconcileIntervals) *infrav1beta2.ReconcileInterval {
+	if intervals == nil {
+		return nil
+	}
+	return intervals.Cluster
+}
+
+// machineIntervals returns the overrides of the intervals of the infrastructure machines.
+func machineIntervals(intervals *infrav1beta2.ReconcileIntervals) *infrav1beta2.ReconcileInterval {
+	if intervals == nil {
+		return nil
+	}
+	return intervals.Machines
+}
+
+// imageIntervals returns the overrides of the intervals of the IBMPowerVSImages.
+func imageIntervals(intervals *infrav1beta2.ReconcileIntervals) *infrav1beta2.ReconcileInterval {
+	if intervals == nil {
+		return nil
+	}
+	return intervals.Images
+}
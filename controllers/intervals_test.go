@@ -0,0 +1,112 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	infrav1beta2 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/v1beta2"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestIntervalsWithOverrides(t *testing.T) {
+	intervals := Intervals{RequeueInterval: time.Minute, SyncPeriod: time.Hour}
+
+	t.Run("Should keep the intervals of the controller without overrides", func(t *testing.T) {
+		g := NewWithT(t)
+		g.Expect(intervals.withOverrides(nil)).To(Equal(intervals))
+		g.Expect(intervals.withOverrides(clusterIntervals(nil))).To(Equal(intervals))
+	})
+
+	t.Run("Should override the intervals set in the spec", func(t *testing.T) {
+		g := NewWithT(t)
+		overrides := &infrav1beta2.ReconcileIntervals{
+			Images: &infrav1beta2.ReconcileInterval{
+				RequeueInterval: &metav1.Duration{Duration: 5 * time.Minute},
+			},
+			Machines: &infrav1beta2.ReconcileInterval{
+				RequeueInterval: &metav1.Duration{Duration: 5 * time.Second},
+				SyncPeriod:      &metav1.Duration{Duration: 10 * time.Minute},
+			},
+		}
+		g.Expect(intervals.withOverrides(clusterIntervals(overrides))).To(Equal(intervals))
+		g.Expect(intervals.withOverrides(imageIntervals(overrides))).To(Equal(Intervals{RequeueInterval: 5 * time.Minute, SyncPeriod: time.Hour}))
+		g.Expect(intervals.withOverrides(machineIntervals(overrides))).To(Equal(Intervals{RequeueInterval: 5 * time.Second, SyncPeriod: 10 * time.Minute}))
+	})
+}
+
+func TestIntervalsApply(t *testing.T) {
+	intervals := Intervals{RequeueInterval: 5 * time.Second, SyncPeriod: time.Hour}
+
+	testCases := []struct {
+		name      string
+		intervals Intervals
+		result    ctrl.Result
+		err       error
+		expected  ctrl.Result
+	}{
+		{
+			name:      "Should replace the interval of a requeued object",
+			intervals: intervals,
+			result:    ctrl.Result{RequeueAfter: time.Minute},
+			expected:  ctrl.Result{RequeueAfter: 5 * time.Second},
+		},
+		{
+			name:      "Should requeue a fully reconciled object after the sync period",
+			intervals: intervals,
+			result:    ctrl.Result{},
+			expected:  ctrl.Result{RequeueAfter: time.Hour},
+		},
+		{
+			name:      "Should leave an object requeued immediately to the rate limiter",
+			intervals: intervals,
+			result:    ctrl.Result{Requeue: true},
+			expected:  ctrl.Result{Requeue: true},
+		},
+		{
+			name:      "Should leave a failed reconcile to the rate limiter",
+			intervals: intervals,
+			result:    ctrl.Result{},
+			err:       errors.New("failed to reconcile"),
+			expected:  ctrl.Result{},
+		},
+		{
+			name:     "Should keep the result without intervals",
+			result:   ctrl.Result{RequeueAfter: time.Minute},
+			expected: ctrl.Result{RequeueAfter: time.Minute},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			result, err := tc.intervals.apply(tc.result, tc.err)
+			if tc.err != nil {
+				g.Expect(err).To(MatchError(tc.err))
+			} else {
+				g.Expect(err).ToNot(HaveOccurred())
+			}
+			g.Expect(result).To(Equal(tc.expected))
+		})
+	}
+}
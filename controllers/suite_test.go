@@ -79,6 +79,15 @@ func setup() {
 	if err := (&infrav1beta2.IBMPowerVSClusterTemplate{}).SetupWebhookWithManager(testEnv); err != nil {
 		panic(fmt.Sprintf("Unable to setup IBMPowerVSClusterTemplate webhook: %v", err))
 	}
+	if err := (&infrav1beta2.IBMVPCClusterTemplate{}).SetupWebhookWithManager(testEnv); err != nil {
+		panic(fmt.Sprintf("Unable to setup IBMVPCClusterTemplate webhook: %v", err))
+	}
+	if err := (&infrav1beta2.IBMPowerVSNetwork{}).SetupWebhookWithManager(testEnv); err != nil {
+		panic(fmt.Sprintf("Unable to setup IBMPowerVSNetwork webhook: %v", err))
+	}
+	if err := (&infrav1beta2.IBMTransitGateway{}).SetupWebhookWithManager(testEnv); err != nil {
+		panic(fmt.Sprintf("Unable to setup IBMTransitGateway webhook: %v", err))
+	}
 	go func() {
 		fmt.Println("Starting the manager")
 		if err := testEnv.StartManager(ctx); err != nil {
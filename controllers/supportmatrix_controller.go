@@ -0,0 +1,103 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+
+	"k8s.io/utils/ptr"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/cluster"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/supportmatrix"
+)
+
+// SupportMatrixReconciler reloads the region support matrix whenever the referenced ConfigMap changes.
+// It runs on every replica of the controller, as the support matrix is enforced by the webhooks of every replica.
+type SupportMatrixReconciler struct {
+	// Reader reads the ConfigMap, it is set to the cache of the namespace of the ConfigMap by SetupWithManager.
+	Reader client.Reader
+	// ConfigMap is the namespaced name of the ConfigMap holding the support matrix.
+	ConfigMap types.NamespacedName
+}
+
+func (r *SupportMatrixReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	// The ConfigMaps are only watched in the namespace of the support matrix ConfigMap, with a cache of its own.
+	configMapCluster, err := cluster.New(mgr.GetConfig(), func(o *cluster.Options) {
+		o.Scheme = mgr.GetScheme()
+		o.Cache.DefaultNamespaces = map[string]cache.Config{r.ConfigMap.Namespace: {}}
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create cache of namespace %s: %w", r.ConfigMap.Namespace, err)
+	}
+	if err := mgr.Add(configMapCluster); err != nil {
+		return fmt.Errorf("failed to add cache of namespace %s: %w", r.ConfigMap.Namespace, err)
+	}
+	r.Reader = configMapCluster.GetCache()
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("supportmatrix").
+		WithOptions(controller.Options{NeedLeaderElection: ptr.To(false)}).
+		WatchesRawSource(source.Kind(configMapCluster.GetCache(), &corev1.ConfigMap{}, &handler.TypedEnqueueRequestForObject[*corev1.ConfigMap]{},
+			predicate.NewTypedPredicateFuncs(func(obj *corev1.ConfigMap) bool {
+				return obj.GetName() == r.ConfigMap.Name
+			}))).
+		Complete(r)
+}
+
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch
+
+func (r *SupportMatrixReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+
+	configMap := &corev1.ConfigMap{}
+	if err := r.Reader.Get(ctx, req.NamespacedName, configMap); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.Info("Support matrix ConfigMap not found, falling back to the built-in support matrix")
+			supportmatrix.Reset()
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	data, ok := configMap.Data[supportmatrix.ConfigMapKey]
+	if !ok {
+		log.Info("Support matrix ConfigMap does not contain the matrix key, falling back to the built-in support matrix", "key", supportmatrix.ConfigMapKey)
+		supportmatrix.Reset()
+		return ctrl.Result{}, nil
+	}
+
+	if err := supportmatrix.Load([]byte(data)); err != nil {
+		// Keep serving the previously loaded matrix, the ConfigMap needs to be fixed by the user.
+		log.Error(err, "Failed to load support matrix from ConfigMap, keeping the current support matrix")
+		return ctrl.Result{}, fmt.Errorf("failed to load support matrix from ConfigMap %s: %w", req.NamespacedName, err)
+	}
+	log.Info("Loaded support matrix from ConfigMap")
+	return ctrl.Result{}, nil
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/supportmatrix"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestSupportMatrixReconciler_Reconcile(t *testing.T) {
+	configMapKey := types.NamespacedName{Namespace: "capi-ibmcloud-system", Name: "support-matrix"}
+	stubConfigMap := func(data map[string]string) *corev1.ConfigMap {
+		return &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: configMapKey.Namespace,
+				Name:      configMapKey.Name,
+			},
+			Data: data,
+		}
+	}
+
+	testCases := []struct {
+		name          string
+		configMap     *corev1.ConfigMap
+		expectError   bool
+		supportedZone string
+		unsupported   string
+	}{
+		{
+			name:          "Should use the built-in support matrix when ConfigMap is not found",
+			supportedZone: "dal10",
+		},
+		{
+			name:          "Should use the built-in support matrix when ConfigMap does not contain the matrix key",
+			configMap:     stubConfigMap(map[string]string{"foo": "bar"}),
+			supportedZone: "dal10",
+		},
+		{
+			name:          "Should load the support matrix from ConfigMap",
+			configMap:     stubConfigMap(map[string]string{supportmatrix.ConfigMapKey: "features:\n  PER:\n  - osa21\n"}),
+			supportedZone: "osa21",
+			unsupported:   "dal10",
+		},
+		{
+			name:          "Should return error and keep the current support matrix when ConfigMap contains invalid matrix",
+			configMap:     stubConfigMap(map[string]string{supportmatrix.ConfigMapKey: "invalid"}),
+			expectError:   true,
+			supportedZone: "dal10",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			supportmatrix.Reset()
+			defer supportmatrix.Reset()
+
+			objects := []client.Object{}
+			if tc.configMap != nil {
+				objects = append(objects, tc.configMap)
+			}
+			reconciler := &SupportMatrixReconciler{
+				Reader:    fake.NewClientBuilder().WithObjects(objects...).Build(),
+				ConfigMap: configMapKey,
+			}
+			_, err := reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: configMapKey})
+			if tc.expectError {
+				g.Expect(err).ToNot(BeNil())
+			} else {
+				g.Expect(err).To(BeNil())
+			}
+			g.Expect(supportmatrix.IsSupported(supportmatrix.PowerEdgeRouter, tc.supportedZone)).To(BeTrue())
+			if tc.unsupported != "" {
+				g.Expect(supportmatrix.IsSupported(supportmatrix.PowerEdgeRouter, tc.unsupported)).To(BeFalse())
+			}
+		})
+	}
+}
@@ -22,12 +22,15 @@ import (
 	"flag"
 	"fmt"
 	"os"
+	"strings"
 	"time"
 
 	// +kubebuilder:scaffold:imports
 	"github.com/spf13/pflag"
 
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	cgrecord "k8s.io/client-go/tools/record"
 	"k8s.io/component-base/logs"
@@ -40,13 +43,20 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	capiv1beta1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	addonsv1beta1 "sigs.k8s.io/cluster-api/exp/addons/api/v1beta1"
 	"sigs.k8s.io/cluster-api/util/flags"
 
 	infrav1beta1 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/v1beta1"
 	infrav1beta2 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cloud/scope"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/controllers"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/authenticator"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/vpc"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloudevents"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/connectivity"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/endpoints"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/options"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/ratelimit"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/record"
 
 	_ "k8s.io/client-go/plugin/pkg/client/auth/gcp"
@@ -54,7 +64,7 @@ import (
 )
 
 var (
-	watchNamespace       string
+	watchNamespaces      []string
 	enableLeaderElection bool
 	healthAddr           string
 	syncPeriod           time.Duration
@@ -62,6 +72,13 @@ var (
 	logOptions           = logs.NewOptions()
 	webhookPort          int
 	webhookCertDir       string
+	supportMatrixCM      string
+
+	vpcClusterIntervals     controllers.Intervals
+	vpcMachineIntervals     controllers.Intervals
+	powerVSClusterIntervals controllers.Intervals
+	powerVSMachineIntervals controllers.Intervals
+	powerVSImageIntervals   controllers.Intervals
 
 	scheme   = runtime.NewScheme()
 	setupLog = ctrl.Log.WithName("setup")
@@ -75,6 +92,7 @@ func init() {
 	_ = infrav1beta1.AddToScheme(scheme)
 	_ = infrav1beta2.AddToScheme(scheme)
 	_ = capiv1beta1.AddToScheme(scheme)
+	_ = addonsv1beta1.AddToScheme(scheme)
 	// +kubebuilder:scaffold:scheme
 }
 
@@ -87,11 +105,18 @@ func initFlags(fs *pflag.FlagSet) {
 			"Enabling this will ensure there is only one active controller manager.",
 	)
 
-	fs.StringVar(
-		&watchNamespace,
+	fs.StringSliceVar(
+		&watchNamespaces,
 		"namespace",
+		nil,
+		"Comma separated list of namespaces that the controller watches to reconcile cluster-api objects. If unspecified, the controller watches for cluster-api objects across all namespaces.",
+	)
+
+	fs.StringVar(
+		&authenticator.NamespaceCredentialsSecret,
+		"namespace-credentials-secret",
 		"",
-		"Namespace that the controller watches to reconcile cluster-api objects. If unspecified, the controller watches for cluster-api objects across all namespaces.",
+		"Name of the credentials secret used to reconcile the objects of a namespace which do not reference a credentials secret of their own. If unspecified, the credentials of the controller are used.",
 	)
 
 	fs.StringVar(
@@ -107,6 +132,13 @@ func initFlags(fs *pflag.FlagSet) {
 		10*time.Minute,
 		"The minimum interval at which watched resources are reconciled.",
 	)
+
+	addIntervalFlags(fs, &vpcClusterIntervals, "vpc-cluster", "IBMVPCClusters")
+	addIntervalFlags(fs, &vpcMachineIntervals, "vpc-machine", "IBMVPCMachines")
+	addIntervalFlags(fs, &powerVSClusterIntervals, "powervs-cluster", "IBMPowerVSClusters")
+	addIntervalFlags(fs, &powerVSMachineIntervals, "powervs-machine", "IBMPowerVSMachines")
+	addIntervalFlags(fs, &powerVSImageIntervals, "powervs-image", "IBMPowerVSImages")
+
 	fs.StringVar(
 		&options.ProviderIDFormat,
 		"provider-id-fmt",
@@ -121,6 +153,41 @@ func initFlags(fs *pflag.FlagSet) {
 		"Set custom service endpoint in semi-colon separated format: ${ServiceRegion1}:${ServiceID1}=${URL1},${ServiceID2}=${URL2};${ServiceRegion2}:${ServiceID1}=${URL1}",
 	)
 
+	fs.StringVar(
+		&endpoints.ServiceEndpointConfigFile,
+		"service-endpoint-config",
+		"",
+		"Path of the file holding the service endpoint overrides per region, reloaded whenever the file changes. The endpoints set with --service-endpoint take precedence.",
+	)
+
+	fs.StringVar(
+		&cloudevents.Sink,
+		"cloudevents-sink",
+		"",
+		"URL of the HTTP endpoint the CloudEvents for the cluster, machine and image lifecycle milestones are published to. If unspecified, no CloudEvents are published.",
+	)
+
+	fs.Float64Var(
+		&ratelimit.QPS,
+		"ibmcloud-api-qps",
+		ratelimit.QPS,
+		"Maximum number of requests per second sent to each of the Power VS, VPC and Resource Controller APIs. Set to 0 to disable the client-side rate limiting.",
+	)
+
+	fs.IntVar(
+		&ratelimit.Burst,
+		"ibmcloud-api-burst",
+		ratelimit.Burst,
+		"Maximum number of requests sent at once to each of the Power VS, VPC and Resource Controller APIs.",
+	)
+
+	fs.IntVar(
+		&ratelimit.MaxRetries,
+		"ibmcloud-api-max-retries",
+		ratelimit.MaxRetries,
+		"Maximum number of retries with exponential backoff of the requests to the Power VS, VPC and Resource Controller APIs which are throttled or fail with 502, 503 or 504.",
+	)
+
 	fs.IntVar(&webhookPort,
 		"webhook-port",
 		9443,
@@ -130,10 +197,34 @@ func initFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&webhookCertDir, "webhook-cert-dir", "/tmp/k8s-webhook-server/serving-certs/",
 		"The webhook certificate directory, where the server should find the TLS certificate and key.")
 
+	fs.StringVar(
+		&supportMatrixCM,
+		"support-matrix-configmap",
+		"",
+		"ConfigMap in <namespace>/<name> format holding the region support matrix under the matrix.yaml key. If unspecified, the built-in support matrix is used.",
+	)
+
 	logsv1.AddFlags(logOptions, fs)
 	flags.AddManagerOptions(fs, &managerOptions)
 }
 
+// addIntervalFlags adds the flags setting the intervals at which a controller reconciles its objects again.
+func addIntervalFlags(fs *pflag.FlagSet, intervals *controllers.Intervals, prefix, objects string) {
+	fs.DurationVar(
+		&intervals.RequeueInterval,
+		prefix+"-requeue-interval",
+		0,
+		fmt.Sprintf("The interval at which %s waiting on IBM Cloud are reconciled again. If unspecified, each wait uses the interval of the controller.", objects),
+	)
+
+	fs.DurationVar(
+		&intervals.SyncPeriod,
+		prefix+"-sync-period",
+		0,
+		fmt.Sprintf("The interval at which %s which are fully reconciled are reconciled again. If unspecified, they are reconciled again on changes and on the resync of --sync-period.", objects),
+	)
+}
+
 func validateFlags() error {
 	if options.ProviderIDFormatType(options.ProviderIDFormat) == options.ProviderIDFormatV2 {
 		setupLog.Info("Using v2 version of ProviderID format")
@@ -141,6 +232,52 @@ func validateFlags() error {
 		return fmt.Errorf("invalid value for flag provider-id-fmt: %s, Only supported value is %s", options.ProviderIDFormat, options.ProviderIDFormatV2)
 	}
 
+	for _, namespace := range watchNamespaces {
+		if errs := validation.IsDNS1123Label(namespace); len(errs) > 0 {
+			return fmt.Errorf("invalid value for flag namespace: %q is not a valid namespace: %s", namespace, strings.Join(errs, ", "))
+		}
+	}
+
+	if authenticator.NamespaceCredentialsSecret != "" {
+		if errs := validation.IsDNS1123Subdomain(authenticator.NamespaceCredentialsSecret); len(errs) > 0 {
+			return fmt.Errorf("invalid value for flag namespace-credentials-secret: %s", strings.Join(errs, ", "))
+		}
+	}
+
+	if ratelimit.QPS < 0 {
+		return fmt.Errorf("invalid value for flag ibmcloud-api-qps: %v, must not be negative", ratelimit.QPS)
+	}
+	if ratelimit.QPS > 0 && ratelimit.Burst < 1 {
+		return fmt.Errorf("invalid value for flag ibmcloud-api-burst: %d, must be at least 1", ratelimit.Burst)
+	}
+	if ratelimit.MaxRetries < 0 {
+		return fmt.Errorf("invalid value for flag ibmcloud-api-max-retries: %d, must not be negative", ratelimit.MaxRetries)
+	}
+
+	for _, flag := range []struct {
+		prefix    string
+		intervals controllers.Intervals
+	}{
+		{"vpc-cluster", vpcClusterIntervals},
+		{"vpc-machine", vpcMachineIntervals},
+		{"powervs-cluster", powerVSClusterIntervals},
+		{"powervs-machine", powerVSMachineIntervals},
+		{"powervs-image", powerVSImageIntervals},
+	} {
+		if flag.intervals.RequeueInterval < 0 {
+			return fmt.Errorf("invalid value for flag %s-requeue-interval: %v, must not be negative", flag.prefix, flag.intervals.RequeueInterval)
+		}
+		if flag.intervals.SyncPeriod < 0 {
+			return fmt.Errorf("invalid value for flag %s-sync-period: %v, must not be negative", flag.prefix, flag.intervals.SyncPeriod)
+		}
+	}
+
+	if supportMatrixCM != "" {
+		if _, err := parseNamespacedName(supportMatrixCM); err != nil {
+			return fmt.Errorf("invalid value for flag support-matrix-configmap: %w", err)
+		}
+	}
+
 	if err := logsv1.ValidateAndApply(logOptions, nil); err != nil {
 		setupLog.Error(err, "unable to validate and apply log options")
 		return err
@@ -149,6 +286,15 @@ func validateFlags() error {
 	return nil
 }
 
+// parseNamespacedName parses a <namespace>/<name> formatted string.
+func parseNamespacedName(value string) (types.NamespacedName, error) {
+	parts := strings.Split(value, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return types.NamespacedName{}, fmt.Errorf("%q is not in <namespace>/<name> format", value)
+	}
+	return types.NamespacedName{Namespace: parts[0], Name: parts[1]}, nil
+}
+
 // Add RBAC for the authorized diagnostics endpoint.
 // +kubebuilder:rbac:groups=authentication.k8s.io,resources=tokenreviews,verbs=create
 // +kubebuilder:rbac:groups=authorization.k8s.io,resources=subjectaccessreviews,verbs=create
@@ -166,14 +312,23 @@ func main() {
 		setupLog.Error(err, "unable to parse service endpoint flag", "controller", "cluster")
 		os.Exit(1)
 	}
+	var serviceEndpointConfigWatcher *endpoints.ConfigWatcher
+	if endpoints.ServiceEndpointConfigFile != "" {
+		serviceEndpointConfigWatcher, err = endpoints.NewConfigWatcher(endpoints.ServiceEndpointConfigFile)
+		if err != nil {
+			setupLog.Error(err, "unable to load service endpoint config", "path", endpoints.ServiceEndpointConfigFile)
+			os.Exit(1)
+		}
+	}
+	authenticator.IAMEndpoint = endpoints.FetchEndpoints(string(endpoints.IAM), serviceEndpoint)
 
 	if err := validateFlags(); err != nil {
 		setupLog.Error(err, "Flag validation failure")
 		os.Exit(1)
 	}
 
-	if watchNamespace != "" {
-		setupLog.Info("Watching cluster-api objects only in namespace for reconciliation", "namespace", watchNamespace)
+	if len(watchNamespaces) > 0 {
+		setupLog.Info("Watching cluster-api objects only in namespaces for reconciliation", "namespaces", watchNamespaces)
 	}
 
 	// Machine and cluster operations can create enough events to trigger the event recorder spam filter
@@ -188,10 +343,11 @@ func main() {
 		os.Exit(1)
 	}
 
-	var watchNamespaces map[string]cache.Config
-	if watchNamespace != "" {
-		watchNamespaces = map[string]cache.Config{
-			watchNamespace: {},
+	var defaultNamespaces map[string]cache.Config
+	if len(watchNamespaces) > 0 {
+		defaultNamespaces = map[string]cache.Config{}
+		for _, namespace := range watchNamespaces {
+			defaultNamespaces[namespace] = cache.Config{}
 		}
 	}
 
@@ -201,7 +357,7 @@ func main() {
 		Metrics:          *metricsOptions,
 		LeaderElectionID: "effcf9b8.cluster.x-k8s.io",
 		Cache: cache.Options{
-			DefaultNamespaces: watchNamespaces,
+			DefaultNamespaces: defaultNamespaces,
 			SyncPeriod:        &syncPeriod,
 		},
 		EventBroadcaster:       broadcaster,
@@ -228,11 +384,32 @@ func main() {
 	// Initialize event recorder.
 	record.InitFromRecorder(mgr.GetEventRecorderFor("ibmcloud-controller"))
 
+	if serviceEndpointConfigWatcher != nil {
+		if err := mgr.Add(serviceEndpointConfigWatcher); err != nil {
+			setupLog.Error(err, "unable to watch service endpoint config")
+			os.Exit(1)
+		}
+	}
+
+	// Initialize CloudEvents publisher.
+	if cloudevents.Sink != "" {
+		publisher, err := cloudevents.NewPublisher(cloudevents.Sink)
+		if err != nil {
+			setupLog.Error(err, "unable to create CloudEvents publisher")
+			os.Exit(1)
+		}
+		if err := mgr.Add(publisher); err != nil {
+			setupLog.Error(err, "unable to add CloudEvents publisher")
+			os.Exit(1)
+		}
+		cloudevents.InitFromPublisher(publisher)
+	}
+
 	// Setup the context that's going to be used in controllers and for the manager.
 	ctx := ctrl.SetupSignalHandler()
 
 	setupReconcilers(ctx, mgr, serviceEndpoint)
-	setupWebhooks(mgr)
+	setupWebhooks(mgr, serviceEndpoint)
 	setupChecks(mgr)
 
 	// +kubebuilder:scaffold:builder
@@ -250,6 +427,8 @@ func setupReconcilers(ctx context.Context, mgr ctrl.Manager, serviceEndpoint []e
 		Recorder:        mgr.GetEventRecorderFor("ibmvpccluster-controller"),
 		ServiceEndpoint: serviceEndpoint,
 		Scheme:          mgr.GetScheme(),
+		Intervals:       vpcClusterIntervals,
+		Prober:          connectivity.NewProber(),
 	}).SetupWithManager(ctx, mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "IBMVPCCluster")
 		os.Exit(1)
@@ -261,6 +440,7 @@ func setupReconcilers(ctx context.Context, mgr ctrl.Manager, serviceEndpoint []e
 		Recorder:        mgr.GetEventRecorderFor("ibmvpcmachine-controller"),
 		ServiceEndpoint: serviceEndpoint,
 		Scheme:          mgr.GetScheme(),
+		Intervals:       vpcMachineIntervals,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "IBMVPCMachine")
 		os.Exit(1)
@@ -271,6 +451,8 @@ func setupReconcilers(ctx context.Context, mgr ctrl.Manager, serviceEndpoint []e
 		Recorder:        mgr.GetEventRecorderFor("ibmpowervscluster-controller"),
 		ServiceEndpoint: serviceEndpoint,
 		Scheme:          mgr.GetScheme(),
+		Intervals:       powerVSClusterIntervals,
+		Prober:          connectivity.NewProber(),
 	}).SetupWithManager(ctx, mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "IBMPowerVSCluster")
 		os.Exit(1)
@@ -281,6 +463,7 @@ func setupReconcilers(ctx context.Context, mgr ctrl.Manager, serviceEndpoint []e
 		Recorder:        mgr.GetEventRecorderFor("ibmpowervsmachine-controller"),
 		ServiceEndpoint: serviceEndpoint,
 		Scheme:          mgr.GetScheme(),
+		Intervals:       powerVSMachineIntervals,
 	}).SetupWithManager(ctx, mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "IBMPowerVSMachine")
 		os.Exit(1)
@@ -291,11 +474,32 @@ func setupReconcilers(ctx context.Context, mgr ctrl.Manager, serviceEndpoint []e
 		Recorder:        mgr.GetEventRecorderFor("ibmpowervsimage-controller"),
 		ServiceEndpoint: serviceEndpoint,
 		Scheme:          mgr.GetScheme(),
+		Intervals:       powerVSImageIntervals,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "IBMPowerVSImage")
 		os.Exit(1)
 	}
 
+	if err := (&controllers.IBMPowerVSNetworkReconciler{
+		Client:          mgr.GetClient(),
+		Recorder:        mgr.GetEventRecorderFor("ibmpowervsnetwork-controller"),
+		ServiceEndpoint: serviceEndpoint,
+		Scheme:          mgr.GetScheme(),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "IBMPowerVSNetwork")
+		os.Exit(1)
+	}
+
+	if err := (&controllers.IBMTransitGatewayReconciler{
+		Client:          mgr.GetClient(),
+		Recorder:        mgr.GetEventRecorderFor("ibmtransitgateway-controller"),
+		ServiceEndpoint: serviceEndpoint,
+		Scheme:          mgr.GetScheme(),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "IBMTransitGateway")
+		os.Exit(1)
+	}
+
 	if err := (&controllers.IBMPowerVSMachineTemplateReconciler{
 		Client: mgr.GetClient(),
 		Scheme: mgr.GetScheme(),
@@ -312,9 +516,30 @@ func setupReconcilers(ctx context.Context, mgr ctrl.Manager, serviceEndpoint []e
 		setupLog.Error(err, "unable to create controller", "controller", "ibmvpcmachinetemplate")
 		os.Exit(1)
 	}
+
+	if supportMatrixCM != "" {
+		configMap, _ := parseNamespacedName(supportMatrixCM)
+		if err := (&controllers.SupportMatrixReconciler{
+			ConfigMap: configMap,
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "supportmatrix")
+			os.Exit(1)
+		}
+	}
 }
 
-func setupWebhooks(mgr ctrl.Manager) {
+func setupWebhooks(mgr ctrl.Manager, serviceEndpoint []endpoints.ServiceEndpoint) {
+	// the placement target of the machines can only be looked up with the credentials of the controller,
+	// not with the credentials of the namespace of the machine.
+	if authenticator.NamespaceCredentialsSecret == "" {
+		infrav1beta2.PlacementTargetZoneLookup = func(zone string, placementTarget infrav1beta2.VPCMachinePlacementTarget) (string, error) {
+			vpcClient, err := vpc.NewService(endpoints.FetchVPCEndpoint(endpoints.ConstructRegionFromZone(zone), serviceEndpoint))
+			if err != nil {
+				return "", fmt.Errorf("failed to create VPC client: %w", err)
+			}
+			return scope.PlacementTargetZone(vpcClient, placementTarget)
+		}
+	}
 	if err := (&infrav1beta2.IBMVPCCluster{}).SetupWebhookWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create webhook", "webhook", "IBMVPCCluster")
 		os.Exit(1)
@@ -347,6 +572,18 @@ func setupWebhooks(mgr ctrl.Manager) {
 		setupLog.Error(err, "unable to create webhook", "webhook", "IBMPowerVSClusterTemplate")
 		os.Exit(1)
 	}
+	if err := (&infrav1beta2.IBMVPCClusterTemplate{}).SetupWebhookWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create webhook", "webhook", "IBMVPCClusterTemplate")
+		os.Exit(1)
+	}
+	if err := (&infrav1beta2.IBMPowerVSNetwork{}).SetupWebhookWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create webhook", "webhook", "IBMPowerVSNetwork")
+		os.Exit(1)
+	}
+	if err := (&infrav1beta2.IBMTransitGateway{}).SetupWebhookWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create webhook", "webhook", "IBMTransitGateway")
+		os.Exit(1)
+	}
 }
 
 func setupChecks(mgr ctrl.Manager) {
@@ -0,0 +1,375 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package blueprint
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	capiv1beta1 "sigs.k8s.io/cluster-api/api/v1beta1"
+
+	infrav1beta2 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/v1beta2"
+)
+
+const (
+	vpcClusterKind             = "IBMVPCCluster"
+	powerVSClusterKind         = "IBMPowerVSCluster"
+	vpcMachineTemplateKind     = "IBMVPCMachineTemplate"
+	powerVSMachineTemplateKind = "IBMPowerVSMachineTemplate"
+)
+
+// Exporter exports the blueprint of the clusters, the scheme of its client must contain the types of Cluster API and of the provider.
+type Exporter struct {
+	client client.Reader
+}
+
+// NewExporter returns an Exporter reading the objects of the clusters with the client.
+func NewExporter(c client.Reader) *Exporter {
+	return &Exporter{client: c}
+}
+
+// Export returns the sanitized specs of the Cluster referenced by the key along with its infrastructure cluster, the infrastructure
+// machine templates of its control plane and MachineDeployments, its IBMPowerVSImages and the IBMPowerVSNetwork and IBMTransitGateway
+// it references. The metadata set by the API server and the controllers, and the status of the objects are dropped. The workspace,
+// the VPC, the subnets, the network, the transit gateway and the resource group of the infrastructure cluster are referenced by the
+// IDs reported in its status, so that the exported cluster reuses the infrastructure of the running one.
+func (e *Exporter) Export(ctx context.Context, key client.ObjectKey) ([]client.Object, error) {
+	cluster := &capiv1beta1.Cluster{}
+	if err := e.client.Get(ctx, key, cluster); err != nil {
+		return nil, fmt.Errorf("failed to get cluster %s: %w", key, err)
+	}
+	if cluster.Spec.InfrastructureRef == nil {
+		return nil, fmt.Errorf("cluster %s has no infrastructure reference", key)
+	}
+	objects := []client.Object{exportCluster(cluster)}
+
+	infraKey := client.ObjectKey{Namespace: key.Namespace, Name: cluster.Spec.InfrastructureRef.Name}
+	switch cluster.Spec.InfrastructureRef.Kind {
+	case vpcClusterKind:
+		vpcCluster := &infrav1beta2.IBMVPCCluster{}
+		if err := e.client.Get(ctx, infraKey, vpcCluster); err != nil {
+			return nil, fmt.Errorf("failed to get IBMVPCCluster %s: %w", infraKey, err)
+		}
+		objects = append(objects, exportVPCCluster(vpcCluster))
+	case powerVSClusterKind:
+		powerVSCluster := &infrav1beta2.IBMPowerVSCluster{}
+		if err := e.client.Get(ctx, infraKey, powerVSCluster); err != nil {
+			return nil, fmt.Errorf("failed to get IBMPowerVSCluster %s: %w", infraKey, err)
+		}
+		objects = append(objects, exportPowerVSCluster(powerVSCluster))
+		refs, err := e.powerVSClusterRefs(ctx, powerVSCluster)
+		if err != nil {
+			return nil, err
+		}
+		objects = append(objects, refs...)
+	default:
+		return nil, fmt.Errorf("infrastructure %s of cluster %s is not provided by the provider", cluster.Spec.InfrastructureRef.Kind, key)
+	}
+
+	templates, err := e.machineTemplates(ctx, cluster)
+	if err != nil {
+		return nil, err
+	}
+	objects = append(objects, templates...)
+
+	images, err := e.images(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	return append(objects, images...), nil
+}
+
+// Write writes the objects to w as a multi-document YAML, without their empty status and creation timestamp.
+func Write(w io.Writer, objects []client.Object) error {
+	for _, obj := range objects {
+		content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+		if err != nil {
+			return fmt.Errorf("failed to convert %s %s: %w", obj.GetObjectKind().GroupVersionKind().Kind, obj.GetName(), err)
+		}
+		unstructured.RemoveNestedField(content, "status")
+		unstructured.RemoveNestedField(content, "metadata", "creationTimestamp")
+		out, err := yaml.Marshal(content)
+		if err != nil {
+			return fmt.Errorf("failed to marshal %s %s: %w", obj.GetObjectKind().GroupVersionKind().Kind, obj.GetName(), err)
+		}
+		if _, err := fmt.Fprintf(w, "---\n%s", out); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *Exporter) powerVSClusterRefs(ctx context.Context, cluster *infrav1beta2.IBMPowerVSCluster) ([]client.Object, error) {
+	var objects []client.Object
+	if cluster.Spec.NetworkRef != nil {
+		key := client.ObjectKey{Namespace: cluster.Namespace, Name: cluster.Spec.NetworkRef.Name}
+		network := &infrav1beta2.IBMPowerVSNetwork{}
+		if err := e.client.Get(ctx, key, network); err != nil {
+			return nil, fmt.Errorf("failed to get IBMPowerVSNetwork %s: %w", key, err)
+		}
+		objects = append(objects, &infrav1beta2.IBMPowerVSNetwork{
+			TypeMeta:   typeMeta("IBMPowerVSNetwork"),
+			ObjectMeta: objectMeta(network.ObjectMeta),
+			Spec:       network.Spec,
+		})
+	}
+	if cluster.Spec.TransitGatewayRef != nil {
+		key := client.ObjectKey{Namespace: cluster.Namespace, Name: cluster.Spec.TransitGatewayRef.Name}
+		transitGateway := &infrav1beta2.IBMTransitGateway{}
+		if err := e.client.Get(ctx, key, transitGateway); err != nil {
+			return nil, fmt.Errorf("failed to get IBMTransitGateway %s: %w", key, err)
+		}
+		objects = append(objects, &infrav1beta2.IBMTransitGateway{
+			TypeMeta:   typeMeta("IBMTransitGateway"),
+			ObjectMeta: objectMeta(transitGateway.ObjectMeta),
+			Spec:       transitGateway.Spec,
+		})
+	}
+	return objects, nil
+}
+
+// machineTemplates returns the infrastructure machine templates referenced by the control plane and the MachineDeployments of the cluster.
+func (e *Exporter) machineTemplates(ctx context.Context, cluster *capiv1beta1.Cluster) ([]client.Object, error) {
+	refs := map[string]corev1.ObjectReference{}
+	if ref := cluster.Spec.ControlPlaneRef; ref != nil {
+		controlPlane := &unstructured.Unstructured{}
+		controlPlane.SetGroupVersionKind(schema.FromAPIVersionAndKind(ref.APIVersion, ref.Kind))
+		key := client.ObjectKey{Namespace: cluster.Namespace, Name: ref.Name}
+		if err := e.client.Get(ctx, key, controlPlane); err != nil {
+			return nil, fmt.Errorf("failed to get %s %s: %w", ref.Kind, key, err)
+		}
+		// The machines of the control planes implementing the contract of Cluster API are created from spec.machineTemplate.infrastructureRef.
+		infraRef, ok, err := unstructured.NestedStringMap(controlPlane.Object, "spec", "machineTemplate", "infrastructureRef")
+		if err != nil {
+			return nil, fmt.Errorf("failed to get infrastructure reference of %s %s: %w", ref.Kind, key, err)
+		}
+		if ok {
+			refs[infraRef["kind"]+"/"+infraRef["name"]] = corev1.ObjectReference{Kind: infraRef["kind"], Name: infraRef["name"]}
+		}
+	}
+
+	machineDeployments := &capiv1beta1.MachineDeploymentList{}
+	if err := e.client.List(ctx, machineDeployments, client.InNamespace(cluster.Namespace), client.MatchingLabels{capiv1beta1.ClusterNameLabel: cluster.Name}); err != nil {
+		return nil, fmt.Errorf("failed to list MachineDeployments of cluster %s: %w", cluster.Name, err)
+	}
+	for _, machineDeployment := range machineDeployments.Items {
+		ref := machineDeployment.Spec.Template.Spec.InfrastructureRef
+		refs[ref.Kind+"/"+ref.Name] = ref
+	}
+
+	keys := make([]string, 0, len(refs))
+	for k := range refs {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var objects []client.Object
+	for _, k := range keys {
+		ref := refs[k]
+		key := client.ObjectKey{Namespace: cluster.Namespace, Name: ref.Name}
+		switch ref.Kind {
+		case vpcMachineTemplateKind:
+			template := &infrav1beta2.IBMVPCMachineTemplate{}
+			if err := e.client.Get(ctx, key, template); err != nil {
+				return nil, fmt.Errorf("failed to get IBMVPCMachineTemplate %s: %w", key, err)
+			}
+			objects = append(objects, &infrav1beta2.IBMVPCMachineTemplate{
+				TypeMeta:   typeMeta(vpcMachineTemplateKind),
+				ObjectMeta: objectMeta(template.ObjectMeta),
+				Spec:       template.Spec,
+			})
+		case powerVSMachineTemplateKind:
+			template := &infrav1beta2.IBMPowerVSMachineTemplate{}
+			if err := e.client.Get(ctx, key, template); err != nil {
+				return nil, fmt.Errorf("failed to get IBMPowerVSMachineTemplate %s: %w", key, err)
+			}
+			objects = append(objects, &infrav1beta2.IBMPowerVSMachineTemplate{
+				TypeMeta:   typeMeta(powerVSMachineTemplateKind),
+				ObjectMeta: objectMeta(template.ObjectMeta),
+				Spec:       template.Spec,
+			})
+		}
+	}
+	return objects, nil
+}
+
+// images returns the IBMPowerVSImages of the cluster, sorted by name.
+func (e *Exporter) images(ctx context.Context, key client.ObjectKey) ([]client.Object, error) {
+	images := &infrav1beta2.IBMPowerVSImageList{}
+	if err := e.client.List(ctx, images, client.InNamespace(key.Namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list IBMPowerVSImages of cluster %s: %w", key, err)
+	}
+	sort.Slice(images.Items, func(i, j int) bool {
+		return images.Items[i].Name < images.Items[j].Name
+	})
+	var objects []client.Object
+	for _, image := range images.Items {
+		if image.Spec.ClusterName != key.Name {
+			continue
+		}
+		objects = append(objects, &infrav1beta2.IBMPowerVSImage{
+			TypeMeta:   typeMeta("IBMPowerVSImage"),
+			ObjectMeta: objectMeta(image.ObjectMeta),
+			Spec:       image.Spec,
+		})
+	}
+	return objects, nil
+}
+
+func exportCluster(cluster *capiv1beta1.Cluster) *capiv1beta1.Cluster {
+	spec := *cluster.Spec.DeepCopy()
+	// The endpoint of the control plane is reported by the infrastructure cluster.
+	spec.ControlPlaneEndpoint = capiv1beta1.APIEndpoint{}
+	return &capiv1beta1.Cluster{
+		TypeMeta:   metav1.TypeMeta{APIVersion: capiv1beta1.GroupVersion.String(), Kind: "Cluster"},
+		ObjectMeta: objectMeta(cluster.ObjectMeta),
+		Spec:       spec,
+	}
+}
+
+func exportVPCCluster(cluster *infrav1beta2.IBMVPCCluster) *infrav1beta2.IBMVPCCluster {
+	spec := *cluster.Spec.DeepCopy()
+	if status := cluster.Status.Network; status != nil && spec.Network != nil {
+		if status.VPC != nil && status.VPC.ID != "" {
+			spec.Network.VPC = &infrav1beta2.VPCResource{ID: ptr.To(status.VPC.ID)}
+		}
+		if len(status.ControlPlaneSubnets) > 0 {
+			spec.Network.ControlPlaneSubnets = pinVPCSubnets(spec.Network.ControlPlaneSubnets, status.ControlPlaneSubnets)
+		}
+		if len(status.WorkerSubnets) > 0 {
+			spec.Network.WorkerSubnets = pinVPCSubnets(spec.Network.WorkerSubnets, status.WorkerSubnets)
+		}
+		if status.ResourceGroup != nil && status.ResourceGroup.ID != "" {
+			spec.Network.ResourceGroup = &infrav1beta2.IBMCloudResourceReference{ID: status.ResourceGroup.ID}
+		}
+	}
+	return &infrav1beta2.IBMVPCCluster{
+		TypeMeta:   typeMeta(vpcClusterKind),
+		ObjectMeta: objectMeta(cluster.ObjectMeta),
+		Spec:       spec,
+	}
+}
+
+func exportPowerVSCluster(cluster *infrav1beta2.IBMPowerVSCluster) *infrav1beta2.IBMPowerVSCluster {
+	spec := *cluster.Spec.DeepCopy()
+	status := cluster.Status
+	if id := resourceID(status.ServiceInstance); id != nil && spec.ServiceInstanceID == "" {
+		spec.ServiceInstance = &infrav1beta2.IBMPowerVSResourceReference{ID: id}
+	}
+	if id := resourceID(status.Network); id != nil && spec.NetworkRef == nil {
+		spec.Network = infrav1beta2.IBMPowerVSResourceReference{ID: id}
+	}
+	if id := resourceID(status.ResourceGroup); id != nil {
+		spec.ResourceGroup = &infrav1beta2.IBMPowerVSResourceReference{ID: id}
+	}
+	if id := resourceID(status.VPC); id != nil {
+		vpc := &infrav1beta2.VPCResourceReference{}
+		if spec.VPC != nil {
+			vpc.Region = spec.VPC.Region
+		}
+		vpc.ID = id
+		spec.VPC = vpc
+	}
+	if len(status.VPCSubnet) > 0 {
+		subnets := make(map[string]*infrav1beta2.ResourceStatus, len(status.VPCSubnet))
+		for name, subnet := range status.VPCSubnet {
+			if subnet.ID != nil {
+				subnets[name] = &infrav1beta2.ResourceStatus{ID: *subnet.ID}
+			}
+		}
+		spec.VPCSubnets = pinVPCSubnets(spec.VPCSubnets, subnets)
+	}
+	if status.TransitGateway != nil && status.TransitGateway.ID != nil && spec.TransitGatewayRef == nil {
+		transitGateway := &infrav1beta2.TransitGateway{}
+		if spec.TransitGateway != nil {
+			transitGateway.GlobalRouting = spec.TransitGateway.GlobalRouting
+		}
+		transitGateway.ID = status.TransitGateway.ID
+		spec.TransitGateway = transitGateway
+	}
+	return &infrav1beta2.IBMPowerVSCluster{
+		TypeMeta:   typeMeta(powerVSClusterKind),
+		ObjectMeta: objectMeta(cluster.ObjectMeta),
+		Spec:       spec,
+	}
+}
+
+// pinVPCSubnets returns the subnets reported in the status sorted by name and referenced by ID, along with the CIDR and zone of the
+// subnets of the spec with the same name.
+func pinVPCSubnets(subnets []infrav1beta2.Subnet, status map[string]*infrav1beta2.ResourceStatus) []infrav1beta2.Subnet {
+	names := make([]string, 0, len(status))
+	for name, subnet := range status {
+		if subnet != nil && subnet.ID != "" {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	pinned := make([]infrav1beta2.Subnet, 0, len(names))
+	for _, name := range names {
+		subnet := infrav1beta2.Subnet{Name: ptr.To(name), ID: ptr.To(status[name].ID)}
+		for _, s := range subnets {
+			if ptr.Deref(s.Name, "") == name {
+				subnet.Ipv4CidrBlock = s.Ipv4CidrBlock
+				subnet.Zone = s.Zone
+			}
+		}
+		pinned = append(pinned, subnet)
+	}
+	return pinned
+}
+
+func resourceID(ref *infrav1beta2.ResourceReference) *string {
+	if ref == nil || ref.ID == nil || *ref.ID == "" {
+		return nil
+	}
+	return ptr.To(*ref.ID)
+}
+
+func typeMeta(kind string) metav1.TypeMeta {
+	return metav1.TypeMeta{APIVersion: infrav1beta2.GroupVersion.String(), Kind: kind}
+}
+
+// objectMeta returns the name, namespace, labels and annotations of the object, without the last applied configuration.
+func objectMeta(meta metav1.ObjectMeta) metav1.ObjectMeta {
+	exported := metav1.ObjectMeta{
+		Name:      meta.Name,
+		Namespace: meta.Namespace,
+		Labels:    meta.Labels,
+	}
+	for k, v := range meta.Annotations {
+		if k == corev1.LastAppliedConfigAnnotation {
+			continue
+		}
+		if exported.Annotations == nil {
+			exported.Annotations = map[string]string{}
+		}
+		exported.Annotations[k] = v
+	}
+	return exported
+}
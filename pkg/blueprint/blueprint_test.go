@@ -0,0 +1,266 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package blueprint
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	capiv1beta1 "sigs.k8s.io/cluster-api/api/v1beta1"
+
+	infrav1beta2 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/v1beta2"
+)
+
+func newExporter(t *testing.T, objs ...client.Object) *Exporter {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, capiv1beta1.AddToScheme(scheme))
+	require.NoError(t, infrav1beta2.AddToScheme(scheme))
+	return NewExporter(fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build())
+}
+
+func newCluster(kind, name string) *capiv1beta1.Cluster {
+	return &capiv1beta1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "capi-cluster",
+			Namespace:       "default",
+			UID:             "cluster-uid",
+			Finalizers:      []string{capiv1beta1.ClusterFinalizer},
+			Annotations:     map[string]string{corev1.LastAppliedConfigAnnotation: "{}", "foo": "bar"},
+			ResourceVersion: "1",
+		},
+		Spec: capiv1beta1.ClusterSpec{
+			ControlPlaneEndpoint: capiv1beta1.APIEndpoint{Host: "cluster.example.com", Port: 6443},
+			InfrastructureRef: &corev1.ObjectReference{
+				APIVersion: infrav1beta2.GroupVersion.String(),
+				Kind:       kind,
+				Name:       name,
+			},
+		},
+		Status: capiv1beta1.ClusterStatus{InfrastructureReady: true},
+	}
+}
+
+func TestExport(t *testing.T) {
+	key := client.ObjectKey{Namespace: "default", Name: "capi-cluster"}
+
+	t.Run("Should export the PowerVS cluster along with its templates and images", func(t *testing.T) {
+		powerVSCluster := &infrav1beta2.IBMPowerVSCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "powervs-cluster",
+				Namespace:   "default",
+				Annotations: map[string]string{infrav1beta2.CreateInfrastructureAnnotation: "true"},
+			},
+			Spec: infrav1beta2.IBMPowerVSClusterSpec{
+				Zone:       ptr.To("dal10"),
+				VPC:        &infrav1beta2.VPCResourceReference{Region: ptr.To("us-south")},
+				VPCSubnets: []infrav1beta2.Subnet{{Name: ptr.To("subnet-1"), Zone: ptr.To("us-south-1")}},
+				TransitGateway: &infrav1beta2.TransitGateway{
+					Name:          ptr.To("transit-gateway"),
+					GlobalRouting: ptr.To(true),
+				},
+				LoadBalancers: []infrav1beta2.VPCLoadBalancerSpec{{Name: "load-balancer"}},
+			},
+			Status: infrav1beta2.IBMPowerVSClusterStatus{
+				Ready:           true,
+				ServiceInstance: &infrav1beta2.ResourceReference{ID: ptr.To("service-instance-id"), ControllerCreated: ptr.To(true)},
+				Network:         &infrav1beta2.ResourceReference{ID: ptr.To("network-id")},
+				VPC:             &infrav1beta2.ResourceReference{ID: ptr.To("vpc-id")},
+				VPCSubnet:       map[string]infrav1beta2.ResourceReference{"subnet-1": {ID: ptr.To("subnet-id")}},
+				TransitGateway:  &infrav1beta2.TransitGatewayStatus{ID: ptr.To("transit-gateway-id")},
+				LoadBalancers:   map[string]infrav1beta2.VPCLoadBalancerStatus{"load-balancer": {ID: ptr.To("load-balancer-id")}},
+			},
+		}
+		template := &infrav1beta2.IBMPowerVSMachineTemplate{
+			ObjectMeta: metav1.ObjectMeta{Name: "worker-template", Namespace: "default", UID: "template-uid"},
+			Spec: infrav1beta2.IBMPowerVSMachineTemplateSpec{
+				Template: infrav1beta2.IBMPowerVSMachineTemplateResource{
+					Spec: infrav1beta2.IBMPowerVSMachineSpec{MemoryGiB: 8},
+				},
+			},
+		}
+		machineDeployment := &capiv1beta1.MachineDeployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "worker",
+				Namespace: "default",
+				Labels:    map[string]string{capiv1beta1.ClusterNameLabel: "capi-cluster"},
+			},
+			Spec: capiv1beta1.MachineDeploymentSpec{
+				ClusterName: "capi-cluster",
+				Template: capiv1beta1.MachineTemplateSpec{
+					Spec: capiv1beta1.MachineSpec{
+						ClusterName: "capi-cluster",
+						InfrastructureRef: corev1.ObjectReference{
+							APIVersion: infrav1beta2.GroupVersion.String(),
+							Kind:       "IBMPowerVSMachineTemplate",
+							Name:       "worker-template",
+						},
+					},
+				},
+			},
+		}
+		image := &infrav1beta2.IBMPowerVSImage{
+			ObjectMeta: metav1.ObjectMeta{Name: "capi-image", Namespace: "default"},
+			Spec:       infrav1beta2.IBMPowerVSImageSpec{ClusterName: "capi-cluster", Bucket: ptr.To("bucket")},
+			Status:     infrav1beta2.IBMPowerVSImageStatus{ImageID: "image-id"},
+		}
+		otherImage := &infrav1beta2.IBMPowerVSImage{
+			ObjectMeta: metav1.ObjectMeta{Name: "other-image", Namespace: "default"},
+			Spec:       infrav1beta2.IBMPowerVSImageSpec{ClusterName: "other-cluster"},
+		}
+		exporter := newExporter(t, newCluster("IBMPowerVSCluster", "powervs-cluster"), powerVSCluster, template, machineDeployment, image, otherImage)
+
+		objects, err := exporter.Export(context.Background(), key)
+		require.NoError(t, err)
+		require.Len(t, objects, 4)
+
+		cluster, ok := objects[0].(*capiv1beta1.Cluster)
+		require.True(t, ok)
+		require.Equal(t, "Cluster", cluster.Kind)
+		require.Empty(t, cluster.UID)
+		require.Empty(t, cluster.ResourceVersion)
+		require.Empty(t, cluster.Finalizers)
+		require.Equal(t, map[string]string{"foo": "bar"}, cluster.Annotations)
+		require.False(t, cluster.Spec.ControlPlaneEndpoint.IsValid())
+		require.False(t, cluster.Status.InfrastructureReady)
+
+		exported, ok := objects[1].(*infrav1beta2.IBMPowerVSCluster)
+		require.True(t, ok)
+		require.Equal(t, "IBMPowerVSCluster", exported.Kind)
+		require.Equal(t, "true", exported.Annotations[infrav1beta2.CreateInfrastructureAnnotation])
+		require.Equal(t, "service-instance-id", *exported.Spec.ServiceInstance.ID)
+		require.Equal(t, "network-id", *exported.Spec.Network.ID)
+		require.Equal(t, &infrav1beta2.VPCResourceReference{ID: ptr.To("vpc-id"), Region: ptr.To("us-south")}, exported.Spec.VPC)
+		require.Equal(t, []infrav1beta2.Subnet{{Name: ptr.To("subnet-1"), ID: ptr.To("subnet-id"), Zone: ptr.To("us-south-1")}}, exported.Spec.VPCSubnets)
+		require.Equal(t, &infrav1beta2.TransitGateway{ID: ptr.To("transit-gateway-id"), GlobalRouting: ptr.To(true)}, exported.Spec.TransitGateway)
+		require.Equal(t, []infrav1beta2.VPCLoadBalancerSpec{{Name: "load-balancer"}}, exported.Spec.LoadBalancers)
+		require.False(t, exported.Status.Ready)
+
+		exportedTemplate, ok := objects[2].(*infrav1beta2.IBMPowerVSMachineTemplate)
+		require.True(t, ok)
+		require.Equal(t, "worker-template", exportedTemplate.Name)
+		require.Empty(t, exportedTemplate.UID)
+		require.Equal(t, template.Spec, exportedTemplate.Spec)
+
+		exportedImage, ok := objects[3].(*infrav1beta2.IBMPowerVSImage)
+		require.True(t, ok)
+		require.Equal(t, "capi-image", exportedImage.Name)
+		require.Empty(t, exportedImage.Status.ImageID)
+	})
+
+	t.Run("Should export the VPC cluster with the subnets reported in its status", func(t *testing.T) {
+		vpcCluster := &infrav1beta2.IBMVPCCluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "vpc-cluster", Namespace: "default"},
+			Spec: infrav1beta2.IBMVPCClusterSpec{
+				Region: "us-south",
+				Network: &infrav1beta2.VPCNetworkSpec{
+					ControlPlaneSubnets: []infrav1beta2.Subnet{{Name: ptr.To("cp-subnet"), Zone: ptr.To("us-south-1")}},
+				},
+			},
+			Status: infrav1beta2.IBMVPCClusterStatus{
+				Network: &infrav1beta2.VPCNetworkStatus{
+					VPC:                 &infrav1beta2.ResourceStatus{ID: "vpc-id"},
+					ControlPlaneSubnets: map[string]*infrav1beta2.ResourceStatus{"cp-subnet": {ID: "cp-subnet-id"}},
+					WorkerSubnets:       map[string]*infrav1beta2.ResourceStatus{"worker-subnet": {ID: "worker-subnet-id"}},
+				},
+			},
+		}
+		exporter := newExporter(t, newCluster("IBMVPCCluster", "vpc-cluster"), vpcCluster)
+
+		objects, err := exporter.Export(context.Background(), key)
+		require.NoError(t, err)
+		require.Len(t, objects, 2)
+
+		exported, ok := objects[1].(*infrav1beta2.IBMVPCCluster)
+		require.True(t, ok)
+		require.Equal(t, "vpc-id", *exported.Spec.Network.VPC.ID)
+		require.Equal(t, []infrav1beta2.Subnet{{Name: ptr.To("cp-subnet"), ID: ptr.To("cp-subnet-id"), Zone: ptr.To("us-south-1")}}, exported.Spec.Network.ControlPlaneSubnets)
+		require.Equal(t, []infrav1beta2.Subnet{{Name: ptr.To("worker-subnet"), ID: ptr.To("worker-subnet-id")}}, exported.Spec.Network.WorkerSubnets)
+	})
+
+	t.Run("Should export the machine template of the control plane", func(t *testing.T) {
+		cluster := newCluster("IBMVPCCluster", "vpc-cluster")
+		cluster.Spec.ControlPlaneRef = &corev1.ObjectReference{
+			APIVersion: "controlplane.cluster.x-k8s.io/v1beta1",
+			Kind:       "KubeadmControlPlane",
+			Name:       "control-plane",
+		}
+		controlPlane := &unstructured.Unstructured{}
+		controlPlane.SetAPIVersion("controlplane.cluster.x-k8s.io/v1beta1")
+		controlPlane.SetKind("KubeadmControlPlane")
+		controlPlane.SetNamespace("default")
+		controlPlane.SetName("control-plane")
+		require.NoError(t, unstructured.SetNestedStringMap(controlPlane.Object, map[string]string{
+			"apiVersion": infrav1beta2.GroupVersion.String(),
+			"kind":       "IBMVPCMachineTemplate",
+			"name":       "control-plane-template",
+		}, "spec", "machineTemplate", "infrastructureRef"))
+		template := &infrav1beta2.IBMVPCMachineTemplate{
+			ObjectMeta: metav1.ObjectMeta{Name: "control-plane-template", Namespace: "default"},
+			Spec: infrav1beta2.IBMVPCMachineTemplateSpec{
+				Template: infrav1beta2.IBMVPCMachineTemplateResource{
+					Spec: infrav1beta2.IBMVPCMachineSpec{Profile: "bx2-4x16"},
+				},
+			},
+		}
+		vpcCluster := &infrav1beta2.IBMVPCCluster{ObjectMeta: metav1.ObjectMeta{Name: "vpc-cluster", Namespace: "default"}}
+		exporter := newExporter(t, cluster, vpcCluster, template, controlPlane)
+
+		objects, err := exporter.Export(context.Background(), key)
+		require.NoError(t, err)
+		require.Len(t, objects, 3)
+		exportedTemplate, ok := objects[2].(*infrav1beta2.IBMVPCMachineTemplate)
+		require.True(t, ok)
+		require.Equal(t, "IBMVPCMachineTemplate", exportedTemplate.Kind)
+		require.Equal(t, template.Spec, exportedTemplate.Spec)
+	})
+
+	t.Run("Should fail when the cluster is not found", func(t *testing.T) {
+		_, err := newExporter(t).Export(context.Background(), key)
+		require.Error(t, err)
+	})
+
+	t.Run("Should fail when the infrastructure is not provided by the provider", func(t *testing.T) {
+		_, err := newExporter(t, newCluster("AWSCluster", "aws-cluster")).Export(context.Background(), key)
+		require.Error(t, err)
+	})
+}
+
+func TestWrite(t *testing.T) {
+	objects := []client.Object{
+		exportCluster(newCluster("IBMPowerVSCluster", "powervs-cluster")),
+		exportPowerVSCluster(&infrav1beta2.IBMPowerVSCluster{ObjectMeta: metav1.ObjectMeta{Name: "powervs-cluster", Namespace: "default"}}),
+	}
+	var out bytes.Buffer
+	require.NoError(t, Write(&out, objects))
+	require.Contains(t, out.String(), "---\napiVersion: cluster.x-k8s.io/v1beta1\nkind: Cluster\n")
+	require.Contains(t, out.String(), "---\napiVersion: infrastructure.cluster.x-k8s.io/v1beta2\nkind: IBMPowerVSCluster\n")
+	require.NotContains(t, out.String(), "status:")
+	require.NotContains(t, out.String(), "creationTimestamp")
+}
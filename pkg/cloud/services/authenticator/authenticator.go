@@ -24,8 +24,15 @@ import (
 
 const (
 	serviceIBMCloud = "IBMCLOUD"
+
+	// defaultIAMEndpoint is the endpoint of the public IBM Cloud IAM service.
+	defaultIAMEndpoint = "https://iam.cloud.ibm.com"
 )
 
+// IAMEndpoint is the custom endpoint of the IBM Cloud IAM service set via the service-endpoint flag,
+// it takes precedence over the IBMCLOUD_AUTH_URL of the credential file or the environment.
+var IAMEndpoint string
+
 // This expects the credential file in the following search order:
 // 1) ${IBM_CREDENTIALS_FILE}
 // 2) <user-home-dir>/ibm-credentials.env
@@ -36,6 +43,11 @@ const (
 // IBMCLOUD_AUTH_TYPE=iam
 // IBMCLOUD_APIKEY=xxxxxxxxxxxxx
 // IBMCLOUD_AUTH_URL=https://iam.cloud.ibm.com
+//
+// or, to authenticate with an IAM trusted profile using the identity of the compute resource:
+// $ cat ibm-credentials.env
+// IBMCLOUD_AUTH_TYPE=container
+// IBMCLOUD_IAM_PROFILE_NAME=xxxxxxxxxxxxx
 
 // GetAuthenticator will get the authenticator for ibmcloud.
 func GetAuthenticator() (core.Authenticator, error) {
@@ -46,9 +58,28 @@ func GetAuthenticator() (core.Authenticator, error) {
 	if auth == nil {
 		return nil, fmt.Errorf("authenticator can't be nil, please set proper authentication")
 	}
+	if IAMEndpoint != "" {
+		switch a := auth.(type) {
+		case *core.IamAuthenticator:
+			a.URL = IAMEndpoint
+		case *core.ContainerAuthenticator:
+			a.URL = IAMEndpoint
+		}
+	}
 	return auth, nil
 }
 
+// GetIAMEndpoint returns the endpoint of the IBM Cloud IAM service used to authenticate.
+func GetIAMEndpoint() string {
+	if IAMEndpoint != "" {
+		return IAMEndpoint
+	}
+	if props, err := GetProperties(); err == nil && props["AUTH_URL"] != "" {
+		return props["AUTH_URL"]
+	}
+	return defaultIAMEndpoint
+}
+
 // GetProperties returns a map containing configuration properties for the specified service that are retrieved from external configuration sources.
 func GetProperties() (map[string]string, error) {
 	properties, err := core.GetServiceProperties(serviceIBMCloud)
@@ -72,6 +103,7 @@ func GetIAMAuthenticator() (*core.IamAuthenticator, error) {
 
 	auth := &core.IamAuthenticator{
 		ApiKey: apiKey,
+		URL:    GetIAMEndpoint(),
 	}
 
 	return auth, nil
@@ -0,0 +1,180 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authenticator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// CredentialsAPIKey is the key in a credentials secret holding the IBM Cloud API key.
+	CredentialsAPIKey = "apiKey"
+	// CredentialsAuthType is the key in a credentials secret holding the authentication type, one of iam (default), container or vpc.
+	CredentialsAuthType = "authType"
+	// CredentialsTrustedProfileID is the key in a credentials secret holding the ID of the IAM trusted profile.
+	CredentialsTrustedProfileID = "trustedProfileID"
+	// CredentialsTrustedProfileName is the key in a credentials secret holding the name of the IAM trusted profile, used with the container authentication type.
+	CredentialsTrustedProfileName = "trustedProfileName"
+	// CredentialsTrustedProfileCRN is the key in a credentials secret holding the CRN of the IAM trusted profile, used with the vpc authentication type.
+	CredentialsTrustedProfileCRN = "trustedProfileCRN"
+	// CredentialsCRTokenFilename is the key in a credentials secret holding the path of the compute resource token file, used with the container authentication type.
+	CredentialsCRTokenFilename = "crTokenFilename"
+)
+
+// NamespaceCredentialsSecret is the name of the credentials secret looked up in the namespace of the reconciled object
+// when the object does not reference a credentials secret of its own, it is set via the namespace-credentials-secret flag.
+var NamespaceCredentialsSecret string
+
+// secretCredentials holds the API key of a credentials secret and the authenticator created for it.
+type secretCredentials struct {
+	resourceVersion string
+	apiKey          string
+	authenticator   core.Authenticator
+}
+
+var (
+	// secretCredentialsCache caches the credentials per secret, so the IAM tokens of the authenticators are reused across reconciliations.
+	secretCredentialsCache     = map[types.NamespacedName]secretCredentials{}
+	secretCredentialsCacheLock sync.Mutex
+)
+
+// GetAuthenticatorFromSecret returns the authenticator for the credentials held in the given credentials secret,
+// either an IAM API key or an IAM trusted profile.
+// The authenticator is cached per secret, recreated once the credentials of the secret are rotated and evicted once the secret is deleted.
+func GetAuthenticatorFromSecret(ctx context.Context, c client.Client, namespace, name string) (core.Authenticator, error) {
+	credentials, err := getSecretCredentials(ctx, c, types.NamespacedName{Namespace: namespace, Name: name})
+	if err != nil {
+		return nil, err
+	}
+	return credentials.authenticator, nil
+}
+
+// GetNamespaceAuthenticator returns the authenticator for the credentials secret of the given namespace when
+// NamespaceCredentialsSecret is set, or the authenticator configured for the controller otherwise.
+func GetNamespaceAuthenticator(ctx context.Context, c client.Client, namespace string) (core.Authenticator, error) {
+	if NamespaceCredentialsSecret == "" {
+		return GetAuthenticator()
+	}
+	return GetAuthenticatorFromSecret(ctx, c, namespace, NamespaceCredentialsSecret)
+}
+
+// GetAPIKeyFromSecret returns the API key held in the given credentials secret,
+// it is empty if the secret holds an IAM trusted profile.
+func GetAPIKeyFromSecret(ctx context.Context, c client.Client, namespace, name string) (string, error) {
+	credentials, err := getSecretCredentials(ctx, c, types.NamespacedName{Namespace: namespace, Name: name})
+	if err != nil {
+		return "", err
+	}
+	return credentials.apiKey, nil
+}
+
+func getSecretCredentials(ctx context.Context, c client.Client, key types.NamespacedName) (secretCredentials, error) {
+	secret := &corev1.Secret{}
+	if err := c.Get(ctx, key, secret); err != nil {
+		if apierrors.IsNotFound(err) {
+			evictSecretCredentials(key)
+		}
+		return secretCredentials{}, fmt.Errorf("failed to get credentials secret %s: %w", key, err)
+	}
+
+	secretCredentialsCacheLock.Lock()
+	defer secretCredentialsCacheLock.Unlock()
+
+	cached, ok := secretCredentialsCache[key]
+	if ok && cached.resourceVersion == secret.ResourceVersion {
+		return cached, nil
+	}
+	// the secret was updated, drop the authenticators of the credentials it held unless its API key is unchanged.
+	if ok && cached.apiKey != string(secret.Data[CredentialsAPIKey]) {
+		evictAssumeAuthenticators(cached.apiKey)
+	}
+	delete(secretCredentialsCache, key)
+
+	auth, err := newSecretAuthenticator(key, secret.Data)
+	if err != nil {
+		return secretCredentials{}, err
+	}
+
+	credentials := secretCredentials{
+		resourceVersion: secret.ResourceVersion,
+		apiKey:          string(secret.Data[CredentialsAPIKey]),
+		authenticator:   auth,
+	}
+	secretCredentialsCache[key] = credentials
+	return credentials, nil
+}
+
+// evictSecretCredentials drops the cached credentials of the given credentials secret along with the authenticators
+// assuming trusted profiles with its API key.
+func evictSecretCredentials(key types.NamespacedName) {
+	secretCredentialsCacheLock.Lock()
+	cached, ok := secretCredentialsCache[key]
+	delete(secretCredentialsCache, key)
+	secretCredentialsCacheLock.Unlock()
+
+	if ok {
+		evictAssumeAuthenticators(cached.apiKey)
+	}
+}
+
+func newSecretAuthenticator(key types.NamespacedName, data map[string][]byte) (core.Authenticator, error) {
+	var (
+		auth core.Authenticator
+		err  error
+	)
+	switch authType := string(data[CredentialsAuthType]); {
+	case authType == "" || strings.EqualFold(authType, core.AUTHTYPE_IAM):
+		apiKey, ok := data[CredentialsAPIKey]
+		if !ok || len(apiKey) == 0 {
+			return nil, fmt.Errorf("credentials secret %s does not contain %s", key, CredentialsAPIKey)
+		}
+		auth, err = core.NewIamAuthenticatorBuilder().
+			SetApiKey(string(apiKey)).
+			SetURL(GetIAMEndpoint()).
+			Build()
+	case strings.EqualFold(authType, core.AUTHTYPE_CONTAINER):
+		auth, err = core.NewContainerAuthenticatorBuilder().
+			SetIAMProfileID(string(data[CredentialsTrustedProfileID])).
+			SetIAMProfileName(string(data[CredentialsTrustedProfileName])).
+			SetCRTokenFilename(string(data[CredentialsCRTokenFilename])).
+			SetURL(GetIAMEndpoint()).
+			Build()
+	case strings.EqualFold(authType, core.AUTHTYPE_VPC):
+		auth, err = core.NewVpcInstanceAuthenticatorBuilder().
+			SetIAMProfileID(string(data[CredentialsTrustedProfileID])).
+			SetIAMProfileCRN(string(data[CredentialsTrustedProfileCRN])).
+			Build()
+	default:
+		return nil, fmt.Errorf("credentials secret %s contains unsupported %s %q, supported values are %s, %s and %s",
+			key, CredentialsAuthType, authType, core.AUTHTYPE_IAM, core.AUTHTYPE_CONTAINER, core.AUTHTYPE_VPC)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid credentials in credentials secret %s: %w", key, err)
+	}
+	return auth, nil
+}
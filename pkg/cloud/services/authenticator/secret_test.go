@@ -0,0 +1,66 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authenticator
+
+import (
+	"context"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestGetAuthenticatorFromSecretEviction(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	key := types.NamespacedName{Namespace: "default", Name: "credentials"}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: key.Namespace, Name: key.Name},
+		Data:       map[string][]byte{CredentialsAPIKey: []byte("old-key")},
+	}
+	c := fake.NewClientBuilder().WithObjects(secret).Build()
+	t.Cleanup(func() { evictSecretCredentials(key) })
+
+	auth, err := GetAuthenticatorFromSecret(ctx, c, key.Namespace, key.Name)
+	g.Expect(err).To(BeNil())
+	_, err = GetAssumeAuthenticator(auth, "profile", "account")
+	g.Expect(err).To(BeNil())
+	g.Expect(assumeAuthenticatorCache).To(HaveKey(assumeKey{apiKey: "old-key", trustedProfileName: "profile", accountID: "account"}))
+
+	t.Run("When the API key of the secret is rotated", func(_ *testing.T) {
+		secret.Data[CredentialsAPIKey] = []byte("new-key")
+		g.Expect(c.Update(ctx, secret)).To(Succeed())
+
+		apiKey, err := GetAPIKeyFromSecret(ctx, c, key.Namespace, key.Name)
+		g.Expect(err).To(BeNil())
+		g.Expect(apiKey).To(Equal("new-key"))
+		g.Expect(assumeAuthenticatorCache).ToNot(HaveKey(assumeKey{apiKey: "old-key", trustedProfileName: "profile", accountID: "account"}))
+	})
+
+	t.Run("When the secret is deleted", func(_ *testing.T) {
+		g.Expect(c.Delete(ctx, secret)).To(Succeed())
+
+		_, err := GetAuthenticatorFromSecret(ctx, c, key.Namespace, key.Name)
+		g.Expect(err).ToNot(BeNil())
+		g.Expect(secretCredentialsCache).ToNot(HaveKey(key))
+	})
+}
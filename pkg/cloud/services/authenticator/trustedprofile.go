@@ -0,0 +1,125 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authenticator
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// TokenAuthenticator is an authenticator which exchanges its credentials for an IAM access token,
+// it is implemented by the IAM API key, container and VPC instance authenticators.
+type TokenAuthenticator interface {
+	core.Authenticator
+	GetToken() (string, error)
+}
+
+// GetToken returns the IAM access token of the given authenticator.
+func GetToken(auth core.Authenticator) (string, error) {
+	tokenAuth, ok := auth.(TokenAuthenticator)
+	if !ok {
+		return "", fmt.Errorf("authenticator of type %s does not provide an IAM access token", auth.AuthenticationType())
+	}
+	return tokenAuth.GetToken()
+}
+
+// IsTrustedProfile returns true if the authenticator authenticates with an IAM trusted profile, either
+// using the identity of the compute resource the controller runs on or by assuming the profile with an API key.
+func IsTrustedProfile(auth core.Authenticator) bool {
+	switch auth.AuthenticationType() {
+	case core.AUTHTYPE_CONTAINER, core.AUTHTYPE_VPC, core.AUTHTYPE_IAM_ASSUME:
+		return true
+	}
+	return false
+}
+
+// IsTrustedProfileConfigured returns true if the controller is configured to authenticate with an IAM trusted profile,
+// either by setting IBMCLOUD_AUTH_TYPE to container or vpc, or by setting the profile without an API key.
+func IsTrustedProfileConfigured() bool {
+	props, err := GetProperties()
+	if err != nil {
+		return false
+	}
+	authType := props["AUTH_TYPE"]
+	if authType == "" {
+		authType = props["AUTHTYPE"]
+	}
+	if authType == "" {
+		return props["APIKEY"] == "" && (props["IAM_PROFILE_ID"] != "" || props["IAM_PROFILE_NAME"] != "")
+	}
+	return strings.EqualFold(authType, core.AUTHTYPE_CONTAINER) || strings.EqualFold(authType, core.AUTHTYPE_VPC)
+}
+
+// assumeKey identifies the trusted profile of an account assumed with an API key.
+type assumeKey struct {
+	apiKey             string
+	trustedProfileName string
+	accountID          string
+}
+
+var (
+	// assumeAuthenticatorCache caches the authenticators assuming trusted profiles, so their IAM tokens are reused across reconciliations.
+	assumeAuthenticatorCache     = map[assumeKey]core.Authenticator{}
+	assumeAuthenticatorCacheLock sync.Mutex
+)
+
+// GetAssumeAuthenticator returns the authenticator assuming the IAM trusted profile with the given name of the given account,
+// with the API key of the given IAM authenticator, which typically belongs to another account of the same enterprise.
+func GetAssumeAuthenticator(auth core.Authenticator, trustedProfileName, accountID string) (core.Authenticator, error) {
+	iamAuth, ok := auth.(*core.IamAuthenticator)
+	if !ok {
+		return nil, fmt.Errorf("authenticator of type %s can't assume trusted profile %s of account %s, an IAM API key is required", auth.AuthenticationType(), trustedProfileName, accountID)
+	}
+	key := assumeKey{apiKey: iamAuth.ApiKey, trustedProfileName: trustedProfileName, accountID: accountID}
+
+	assumeAuthenticatorCacheLock.Lock()
+	defer assumeAuthenticatorCacheLock.Unlock()
+
+	if cached, ok := assumeAuthenticatorCache[key]; ok {
+		return cached, nil
+	}
+	assumeAuth, err := core.NewIamAssumeAuthenticatorBuilder().
+		SetApiKey(iamAuth.ApiKey).
+		SetIAMProfileName(trustedProfileName).
+		SetIAMAccountID(accountID).
+		SetURL(GetIAMEndpoint()).
+		Build()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create authenticator assuming trusted profile %s of account %s: %w", trustedProfileName, accountID, err)
+	}
+	assumeAuthenticatorCache[key] = assumeAuth
+	return assumeAuth, nil
+}
+
+// evictAssumeAuthenticators drops the authenticators assuming trusted profiles with the given API key,
+// once the API key is rotated out of or deleted along with its credentials secret.
+func evictAssumeAuthenticators(apiKey string) {
+	if apiKey == "" {
+		return
+	}
+	assumeAuthenticatorCacheLock.Lock()
+	defer assumeAuthenticatorCacheLock.Unlock()
+
+	for key := range assumeAuthenticatorCache {
+		if key.apiKey == apiKey {
+			delete(assumeAuthenticatorCache, key)
+		}
+	}
+}
@@ -0,0 +1,55 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cos
+
+import (
+	"github.com/IBM/ibm-cos-sdk-go/aws/credentials"
+	"github.com/IBM/ibm-cos-sdk-go/aws/credentials/ibmiam/token"
+
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/authenticator"
+)
+
+const authenticatorProviderName = "AuthenticatorProviderIBM"
+
+// authenticatorProvider is a COS credentials provider returning the IAM token of an authenticator.
+type authenticatorProvider struct {
+	authenticator     authenticator.TokenAuthenticator
+	serviceInstanceID string
+}
+
+// Retrieve returns the IAM token of the authenticator.
+func (p *authenticatorProvider) Retrieve() (credentials.Value, error) {
+	accessToken, err := p.authenticator.GetToken()
+	if err != nil {
+		return credentials.Value{ProviderName: authenticatorProviderName}, err
+	}
+	return credentials.Value{
+		Token: token.Token{
+			AccessToken: accessToken,
+			TokenType:   "Bearer",
+		},
+		ProviderName:      authenticatorProviderName,
+		ProviderType:      "oauth",
+		ServiceInstanceID: p.serviceInstanceID,
+	}, nil
+}
+
+// IsExpired always returns true, so the token is retrieved for every request.
+// The authenticator caches the token and refreshes it before it expires.
+func (p *authenticatorProvider) IsExpired() bool {
+	return true
+}
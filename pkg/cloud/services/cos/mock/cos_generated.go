@@ -26,10 +26,12 @@ package mock
 
 import (
 	reflect "reflect"
+	time "time"
 
 	aws "github.com/IBM/ibm-cos-sdk-go/aws"
 	request "github.com/IBM/ibm-cos-sdk-go/aws/request"
 	s3 "github.com/IBM/ibm-cos-sdk-go/service/s3"
+	s3manager "github.com/IBM/ibm-cos-sdk-go/service/s3/s3manager"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -56,6 +58,36 @@ func (m *MockCos) EXPECT() *MockCosMockRecorder {
 	return m.recorder
 }
 
+// AbortMultipartUpload mocks base method.
+func (m *MockCos) AbortMultipartUpload(input *s3.AbortMultipartUploadInput) (*s3.AbortMultipartUploadOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AbortMultipartUpload", input)
+	ret0, _ := ret[0].(*s3.AbortMultipartUploadOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AbortMultipartUpload indicates an expected call of AbortMultipartUpload.
+func (mr *MockCosMockRecorder) AbortMultipartUpload(input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AbortMultipartUpload", reflect.TypeOf((*MockCos)(nil).AbortMultipartUpload), input)
+}
+
+// CompleteMultipartUpload mocks base method.
+func (m *MockCos) CompleteMultipartUpload(input *s3.CompleteMultipartUploadInput) (*s3.CompleteMultipartUploadOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteMultipartUpload", input)
+	ret0, _ := ret[0].(*s3.CompleteMultipartUploadOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompleteMultipartUpload indicates an expected call of CompleteMultipartUpload.
+func (mr *MockCosMockRecorder) CompleteMultipartUpload(input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteMultipartUpload", reflect.TypeOf((*MockCos)(nil).CompleteMultipartUpload), input)
+}
+
 // CreateBucket mocks base method.
 func (m *MockCos) CreateBucket(input *s3.CreateBucketInput) (*s3.CreateBucketOutput, error) {
 	m.ctrl.T.Helper()
@@ -91,6 +123,36 @@ func (mr *MockCosMockRecorder) CreateBucketWithContext(ctx, input any, opts ...a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBucketWithContext", reflect.TypeOf((*MockCos)(nil).CreateBucketWithContext), varargs...)
 }
 
+// CreateMultipartUpload mocks base method.
+func (m *MockCos) CreateMultipartUpload(input *s3.CreateMultipartUploadInput) (*s3.CreateMultipartUploadOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMultipartUpload", input)
+	ret0, _ := ret[0].(*s3.CreateMultipartUploadOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateMultipartUpload indicates an expected call of CreateMultipartUpload.
+func (mr *MockCosMockRecorder) CreateMultipartUpload(input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMultipartUpload", reflect.TypeOf((*MockCos)(nil).CreateMultipartUpload), input)
+}
+
+// DeleteBucket mocks base method.
+func (m *MockCos) DeleteBucket(input *s3.DeleteBucketInput) (*s3.DeleteBucketOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBucket", input)
+	ret0, _ := ret[0].(*s3.DeleteBucketOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteBucket indicates an expected call of DeleteBucket.
+func (mr *MockCosMockRecorder) DeleteBucket(input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBucket", reflect.TypeOf((*MockCos)(nil).DeleteBucket), input)
+}
+
 // DeleteObject mocks base method.
 func (m *MockCos) DeleteObject(input *s3.DeleteObjectInput) (*s3.DeleteObjectOutput, error) {
 	m.ctrl.T.Helper()
@@ -121,6 +183,21 @@ func (mr *MockCosMockRecorder) GetBucketByName(name any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBucketByName", reflect.TypeOf((*MockCos)(nil).GetBucketByName), name)
 }
 
+// GetObject mocks base method.
+func (m *MockCos) GetObject(input *s3.GetObjectInput) (*s3.GetObjectOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetObject", input)
+	ret0, _ := ret[0].(*s3.GetObjectOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetObject indicates an expected call of GetObject.
+func (mr *MockCosMockRecorder) GetObject(input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetObject", reflect.TypeOf((*MockCos)(nil).GetObject), input)
+}
+
 // GetObjectRequest mocks base method.
 func (m *MockCos) GetObjectRequest(arg0 *s3.GetObjectInput) (*request.Request, *s3.GetObjectOutput) {
 	m.ctrl.T.Helper()
@@ -136,6 +213,36 @@ func (mr *MockCosMockRecorder) GetObjectRequest(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetObjectRequest", reflect.TypeOf((*MockCos)(nil).GetObjectRequest), arg0)
 }
 
+// HeadObject mocks base method.
+func (m *MockCos) HeadObject(input *s3.HeadObjectInput) (*s3.HeadObjectOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HeadObject", input)
+	ret0, _ := ret[0].(*s3.HeadObjectOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HeadObject indicates an expected call of HeadObject.
+func (mr *MockCosMockRecorder) HeadObject(input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HeadObject", reflect.TypeOf((*MockCos)(nil).HeadObject), input)
+}
+
+// ListBuckets mocks base method.
+func (m *MockCos) ListBuckets(input *s3.ListBucketsInput) (*s3.ListBucketsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBuckets", input)
+	ret0, _ := ret[0].(*s3.ListBucketsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBuckets indicates an expected call of ListBuckets.
+func (mr *MockCosMockRecorder) ListBuckets(input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBuckets", reflect.TypeOf((*MockCos)(nil).ListBuckets), input)
+}
+
 // ListObjects mocks base method.
 func (m *MockCos) ListObjects(input *s3.ListObjectsInput) (*s3.ListObjectsOutput, error) {
 	m.ctrl.T.Helper()
@@ -151,6 +258,36 @@ func (mr *MockCosMockRecorder) ListObjects(input any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListObjects", reflect.TypeOf((*MockCos)(nil).ListObjects), input)
 }
 
+// PresignGetObject mocks base method.
+func (m *MockCos) PresignGetObject(input *s3.GetObjectInput, expiry time.Duration) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresignGetObject", input, expiry)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PresignGetObject indicates an expected call of PresignGetObject.
+func (mr *MockCosMockRecorder) PresignGetObject(input, expiry any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresignGetObject", reflect.TypeOf((*MockCos)(nil).PresignGetObject), input, expiry)
+}
+
+// PresignPutObject mocks base method.
+func (m *MockCos) PresignPutObject(input *s3.PutObjectInput, expiry time.Duration) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresignPutObject", input, expiry)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PresignPutObject indicates an expected call of PresignPutObject.
+func (mr *MockCosMockRecorder) PresignPutObject(input, expiry any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresignPutObject", reflect.TypeOf((*MockCos)(nil).PresignPutObject), input, expiry)
+}
+
 // PutObject mocks base method.
 func (m *MockCos) PutObject(arg0 *s3.PutObjectInput) (*s3.PutObjectOutput, error) {
 	m.ctrl.T.Helper()
@@ -180,3 +317,38 @@ func (mr *MockCosMockRecorder) PutPublicAccessBlock(input any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutPublicAccessBlock", reflect.TypeOf((*MockCos)(nil).PutPublicAccessBlock), input)
 }
+
+// UploadObject mocks base method.
+func (m *MockCos) UploadObject(input *s3manager.UploadInput, opts ...func(*s3manager.Uploader)) (*s3manager.UploadOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{input}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UploadObject", varargs...)
+	ret0, _ := ret[0].(*s3manager.UploadOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadObject indicates an expected call of UploadObject.
+func (mr *MockCosMockRecorder) UploadObject(input any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{input}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadObject", reflect.TypeOf((*MockCos)(nil).UploadObject), varargs...)
+}
+
+// UploadPart mocks base method.
+func (m *MockCos) UploadPart(input *s3.UploadPartInput) (*s3.UploadPartOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadPart", input)
+	ret0, _ := ret[0].(*s3.UploadPartOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadPart indicates an expected call of UploadPart.
+func (mr *MockCosMockRecorder) UploadPart(input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadPart", reflect.TypeOf((*MockCos)(nil).UploadPart), input)
+}
@@ -17,23 +17,31 @@ limitations under the License.
 package cos
 
 import (
+	"fmt"
 	"net"
 	"net/http"
 	"net/url"
+	"strings"
 	"time"
 
 	"golang.org/x/net/http/httpproxy"
 
+	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/aws/credentials"
 	"github.com/IBM/ibm-cos-sdk-go/aws/credentials/ibmiam"
 	"github.com/IBM/ibm-cos-sdk-go/aws/request"
 	cosSession "github.com/IBM/ibm-cos-sdk-go/aws/session"
 	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3/s3manager"
+
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/authenticator"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/metrics"
 )
 
-// iamEndpoint represent the IAM authorisation URL.
+// iamTokenPath represent the path of the IAM authorisation URL.
 const (
-	iamEndpoint  = "https://iam.cloud.ibm.com/identity/token"
+	iamTokenPath = "/identity/token"
 	cosURLDomain = "cloud-object-storage.appdomain.cloud"
 )
 
@@ -55,6 +63,11 @@ func (s *Service) GetBucketByName(name string) (*s3.HeadBucketOutput, error) {
 	return s.client.HeadBucket(input)
 }
 
+// ListBuckets returns the list of buckets in the COS instance.
+func (s *Service) ListBuckets(input *s3.ListBucketsInput) (*s3.ListBucketsOutput, error) {
+	return s.client.ListBuckets(input)
+}
+
 // CreateBucket creates a new bucket in the COS instance.
 func (s *Service) CreateBucket(input *s3.CreateBucketInput) (*s3.CreateBucketOutput, error) {
 	return s.client.CreateBucket(input)
@@ -65,16 +78,71 @@ func (s *Service) CreateBucketWithContext(ctx aws.Context, input *s3.CreateBucke
 	return s.client.CreateBucketWithContext(ctx, input, opts...)
 }
 
+// DeleteBucket deletes a bucket, the bucket must be empty.
+func (s *Service) DeleteBucket(input *s3.DeleteBucketInput) (*s3.DeleteBucketOutput, error) {
+	return s.client.DeleteBucket(input)
+}
+
+// HeadObject returns the metadata of an object without returning the object itself.
+func (s *Service) HeadObject(input *s3.HeadObjectInput) (*s3.HeadObjectOutput, error) {
+	return s.client.HeadObject(input)
+}
+
+// GetObject returns an object from a bucket, the caller must close the body of the output.
+func (s *Service) GetObject(input *s3.GetObjectInput) (*s3.GetObjectOutput, error) {
+	return s.client.GetObject(input)
+}
+
 // PutObject adds an object to a bucket.
 func (s *Service) PutObject(input *s3.PutObjectInput) (*s3.PutObjectOutput, error) {
 	return s.client.PutObject(input)
 }
 
+// UploadObject adds an object to a bucket, bodies larger than the part size of the uploader (5 MiB by default) are
+// uploaded concurrently in several parts with a multipart upload, which is aborted if a part fails to upload.
+func (s *Service) UploadObject(input *s3manager.UploadInput, opts ...func(*s3manager.Uploader)) (*s3manager.UploadOutput, error) {
+	return s3manager.NewUploaderWithClient(s.client, opts...).Upload(input)
+}
+
+// CreateMultipartUpload initiates a multipart upload and returns its upload id.
+func (s *Service) CreateMultipartUpload(input *s3.CreateMultipartUploadInput) (*s3.CreateMultipartUploadOutput, error) {
+	return s.client.CreateMultipartUpload(input)
+}
+
+// UploadPart uploads a part of a multipart upload.
+func (s *Service) UploadPart(input *s3.UploadPartInput) (*s3.UploadPartOutput, error) {
+	return s.client.UploadPart(input)
+}
+
+// CompleteMultipartUpload completes a multipart upload by assembling the uploaded parts into the object.
+func (s *Service) CompleteMultipartUpload(input *s3.CompleteMultipartUploadInput) (*s3.CompleteMultipartUploadOutput, error) {
+	return s.client.CompleteMultipartUpload(input)
+}
+
+// AbortMultipartUpload aborts a multipart upload and frees the storage used by its uploaded parts.
+func (s *Service) AbortMultipartUpload(input *s3.AbortMultipartUploadInput) (*s3.AbortMultipartUploadOutput, error) {
+	return s.client.AbortMultipartUpload(input)
+}
+
 // GetObjectRequest generates a "aws/request.Request" representing the client's request for the GetObject operation.
 func (s *Service) GetObjectRequest(input *s3.GetObjectInput) (*request.Request, *s3.GetObjectOutput) {
 	return s.client.GetObjectRequest(input)
 }
 
+// PresignGetObject returns a pre-signed URL to get the object, which is valid for the given duration.
+// Only services created with HMAC credentials can pre-sign URLs.
+func (s *Service) PresignGetObject(input *s3.GetObjectInput, expiry time.Duration) (string, error) {
+	req, _ := s.client.GetObjectRequest(input)
+	return req.Presign(expiry)
+}
+
+// PresignPutObject returns a pre-signed URL to put the object, which is valid for the given duration.
+// Only services created with HMAC credentials can pre-sign URLs.
+func (s *Service) PresignPutObject(input *s3.PutObjectInput, expiry time.Duration) (string, error) {
+	req, _ := s.client.PutObjectRequest(input)
+	return req.Presign(expiry)
+}
+
 // ListObjects returns the list of objects in a bucket.
 func (s *Service) ListObjects(input *s3.ListObjectsInput) (*s3.ListObjectsOutput, error) {
 	return s.client.ListObjects(input)
@@ -92,6 +160,30 @@ func (s *Service) PutPublicAccessBlock(input *s3.PutPublicAccessBlockInput) (*s3
 
 // NewService returns a new service for the IBM Cloud Resource Controller api client.
 func NewService(options ServiceOptions, apikey, serviceInstance string) (*Service, error) {
+	iamEndpoint := strings.TrimSuffix(authenticator.GetIAMEndpoint(), "/") + iamTokenPath
+	return newService(options, ibmiam.NewStaticCredentials(aws.NewConfig(), iamEndpoint, apikey, serviceInstance))
+}
+
+// NewServiceWithAuthenticator returns a new service authenticating with the IAM token of the given authenticator,
+// it is used with the authenticators of IAM trusted profiles which do not have an API key.
+func NewServiceWithAuthenticator(options ServiceOptions, auth core.Authenticator, serviceInstance string) (*Service, error) {
+	tokenAuth, ok := auth.(authenticator.TokenAuthenticator)
+	if !ok {
+		return nil, fmt.Errorf("authenticator of type %s does not provide an IAM access token", auth.AuthenticationType())
+	}
+	return newService(options, credentials.NewCredentials(&authenticatorProvider{
+		authenticator:     tokenAuth,
+		serviceInstanceID: serviceInstance,
+	}))
+}
+
+// NewServiceWithHMAC returns a new service authenticating with the HMAC credentials of the Cloud Object Storage,
+// unlike the services authenticating with IAM it can pre-sign URLs.
+func NewServiceWithHMAC(options ServiceOptions, accessKeyID, secretAccessKey string) (*Service, error) {
+	return newService(options, credentials.NewStaticCredentials(accessKeyID, secretAccessKey, ""))
+}
+
+func newService(options ServiceOptions, creds *credentials.Credentials) (*Service, error) {
 	if options.Options == nil {
 		options.Options = &cosSession.Options{}
 	}
@@ -113,13 +205,22 @@ func NewService(options ServiceOptions, apikey, serviceInstance string) (*Servic
 			ExpectContinueTimeout: 1 * time.Second,
 		},
 	}
-	options.Config.Credentials = ibmiam.NewStaticCredentials(aws.NewConfig(), iamEndpoint, apikey, serviceInstance)
+	options.Config.Credentials = creds
 
 	sess, err := cosSession.NewSessionWithOptions(*options.Options)
 	if err != nil {
 		return nil, err
 	}
+	client := s3.New(sess)
+	// The session requires an *http.Transport to load custom CA bundles, hence the attempts are recorded with a handler.
+	client.Handlers.CompleteAttempt.PushBack(func(r *request.Request) {
+		statusCode := 0
+		if r.HTTPResponse != nil {
+			statusCode = r.HTTPResponse.StatusCode
+		}
+		metrics.ObserveRequest(metrics.ServiceCOS, r.HTTPRequest.Method, statusCode, time.Since(r.AttemptTime))
+	})
 	return &Service{
-		client: s3.New(sess),
+		client: client,
 	}, nil
 }
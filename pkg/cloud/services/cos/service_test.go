@@ -0,0 +1,297 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cos
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	cosSession "github.com/IBM/ibm-cos-sdk-go/aws/session"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3/s3manager"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeCOS is a minimal in-memory implementation of the path-style bucket and object endpoints of COS.
+type fakeCOS struct {
+	mu       sync.Mutex
+	buckets  map[string]bool
+	objects  map[string][]byte
+	parts    map[int][]byte
+	requests []string
+	// failParts makes the upload of the parts of multipart uploads fail.
+	failParts bool
+}
+
+func newFakeCOS() *fakeCOS {
+	return &fakeCOS{
+		buckets: map[string]bool{},
+		objects: map[string][]byte{},
+		parts:   map[int][]byte{},
+	}
+}
+
+func (f *fakeCOS) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	body, _ := io.ReadAll(r.Body)
+	path := strings.TrimPrefix(r.URL.Path, "/")
+	bucket, key, _ := strings.Cut(path, "/")
+	query := r.URL.Query()
+	f.requests = append(f.requests, r.Method+" "+operation(query))
+
+	switch {
+	case r.Method == http.MethodGet && path == "":
+		names := make([]string, 0, len(f.buckets))
+		for name := range f.buckets {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		fmt.Fprint(w, "<ListAllMyBucketsResult><Buckets>")
+		for _, name := range names {
+			fmt.Fprintf(w, "<Bucket><Name>%s</Name></Bucket>", name)
+		}
+		fmt.Fprint(w, "</Buckets></ListAllMyBucketsResult>")
+	case key == "" && r.Method == http.MethodPut:
+		f.buckets[bucket] = true
+	case key == "" && r.Method == http.MethodHead:
+		if !f.buckets[bucket] {
+			w.WriteHeader(http.StatusNotFound)
+		}
+	case key == "" && r.Method == http.MethodDelete:
+		delete(f.buckets, bucket)
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodPost && query.Has("uploads"):
+		fmt.Fprintf(w, "<InitiateMultipartUploadResult><Bucket>%s</Bucket><Key>%s</Key><UploadId>upload-id</UploadId></InitiateMultipartUploadResult>", bucket, key)
+	case r.Method == http.MethodPut && query.Has("partNumber"):
+		if f.failParts {
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprint(w, "<Error><Code>AccessDenied</Code><Message>Access Denied</Message></Error>")
+			return
+		}
+		number, _ := strconv.Atoi(query.Get("partNumber"))
+		f.parts[number] = body
+		w.Header().Set("ETag", fmt.Sprintf("\"etag-%d\"", number))
+	case r.Method == http.MethodPost && query.Has("uploadId"):
+		var object []byte
+		for number := 1; number <= len(f.parts); number++ {
+			object = append(object, f.parts[number]...)
+		}
+		f.objects[path] = object
+		fmt.Fprintf(w, "<CompleteMultipartUploadResult><Bucket>%s</Bucket><Key>%s</Key><ETag>\"etag\"</ETag></CompleteMultipartUploadResult>", bucket, key)
+	case r.Method == http.MethodDelete && query.Has("uploadId"):
+		f.parts = map[int][]byte{}
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodPut:
+		f.objects[path] = body
+		w.Header().Set("ETag", "\"etag\"")
+	case r.Method == http.MethodHead || r.Method == http.MethodGet:
+		object, ok := f.objects[path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Length", strconv.Itoa(len(object)))
+		if r.Method == http.MethodGet {
+			_, _ = w.Write(object)
+		}
+	default:
+		w.WriteHeader(http.StatusNotImplemented)
+	}
+}
+
+// operation returns the multipart upload operation of the query, if any.
+func operation(query map[string][]string) string {
+	for _, op := range []string{"uploads", "partNumber", "uploadId"} {
+		if _, ok := query[op]; ok {
+			return op
+		}
+	}
+	return "object"
+}
+
+func newTestService(t *testing.T, fake *fakeCOS) *Service {
+	t.Helper()
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+	service, err := NewServiceWithHMAC(ServiceOptions{
+		Options: &cosSession.Options{
+			Config: aws.Config{
+				Endpoint: aws.String(server.URL),
+				Region:   aws.String("us-south"),
+			},
+		},
+	}, "access-key", "secret-key")
+	require.NoError(t, err)
+	return service
+}
+
+func TestBuckets(t *testing.T) {
+	fake := newFakeCOS()
+	service := newTestService(t, fake)
+
+	_, err := service.CreateBucket(&s3.CreateBucketInput{Bucket: aws.String("bucket")})
+	require.NoError(t, err)
+	_, err = service.GetBucketByName("bucket")
+	require.NoError(t, err)
+
+	output, err := service.ListBuckets(&s3.ListBucketsInput{})
+	require.NoError(t, err)
+	require.Len(t, output.Buckets, 1)
+	require.Equal(t, "bucket", aws.StringValue(output.Buckets[0].Name))
+
+	_, err = service.DeleteBucket(&s3.DeleteBucketInput{Bucket: aws.String("bucket")})
+	require.NoError(t, err)
+	require.Empty(t, fake.buckets)
+}
+
+func TestObjects(t *testing.T) {
+	service := newTestService(t, newFakeCOS())
+
+	_, err := service.PutObject(&s3.PutObjectInput{
+		Bucket: aws.String("bucket"),
+		Key:    aws.String("key"),
+		Body:   bytes.NewReader([]byte("data")),
+	})
+	require.NoError(t, err)
+
+	head, err := service.HeadObject(&s3.HeadObjectInput{Bucket: aws.String("bucket"), Key: aws.String("key")})
+	require.NoError(t, err)
+	require.Equal(t, int64(4), aws.Int64Value(head.ContentLength))
+
+	object, err := service.GetObject(&s3.GetObjectInput{Bucket: aws.String("bucket"), Key: aws.String("key")})
+	require.NoError(t, err)
+	defer object.Body.Close()
+	data, err := io.ReadAll(object.Body)
+	require.NoError(t, err)
+	require.Equal(t, "data", string(data))
+
+	_, err = service.HeadObject(&s3.HeadObjectInput{Bucket: aws.String("bucket"), Key: aws.String("missing")})
+	require.Error(t, err)
+}
+
+func TestUploadObject(t *testing.T) {
+	t.Run("Small objects are put in a single request", func(t *testing.T) {
+		fake := newFakeCOS()
+		service := newTestService(t, fake)
+
+		_, err := service.UploadObject(&s3manager.UploadInput{
+			Bucket: aws.String("bucket"),
+			Key:    aws.String("key"),
+			Body:   bytes.NewReader([]byte("data")),
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{"PUT object"}, fake.requests)
+		require.Equal(t, "data", string(fake.objects["bucket/key"]))
+	})
+
+	t.Run("Large objects are uploaded in several parts", func(t *testing.T) {
+		fake := newFakeCOS()
+		service := newTestService(t, fake)
+		data := bytes.Repeat([]byte("0123456789"), int(s3manager.MinUploadPartSize)/4)
+
+		_, err := service.UploadObject(&s3manager.UploadInput{
+			Bucket: aws.String("bucket"),
+			Key:    aws.String("key"),
+			Body:   bytes.NewReader(data),
+		}, func(u *s3manager.Uploader) {
+			u.Concurrency = 1
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{"POST uploads", "PUT partNumber", "PUT partNumber", "PUT partNumber", "POST uploadId"}, fake.requests)
+		require.Equal(t, data, fake.objects["bucket/key"])
+	})
+
+	t.Run("Multipart upload is aborted when a part fails to upload", func(t *testing.T) {
+		fake := newFakeCOS()
+		fake.failParts = true
+		service := newTestService(t, fake)
+
+		_, err := service.UploadObject(&s3manager.UploadInput{
+			Bucket: aws.String("bucket"),
+			Key:    aws.String("key"),
+			Body:   bytes.NewReader(make([]byte, 2*s3manager.MinUploadPartSize)),
+		})
+		require.Error(t, err)
+		require.Contains(t, fake.requests, "DELETE uploadId")
+		require.Empty(t, fake.objects)
+	})
+}
+
+func TestMultipartUpload(t *testing.T) {
+	fake := newFakeCOS()
+	service := newTestService(t, fake)
+
+	upload, err := service.CreateMultipartUpload(&s3.CreateMultipartUploadInput{Bucket: aws.String("bucket"), Key: aws.String("key")})
+	require.NoError(t, err)
+	require.Equal(t, "upload-id", aws.StringValue(upload.UploadId))
+
+	var parts []*s3.CompletedPart
+	for i, data := range []string{"first ", "second"} {
+		part, err := service.UploadPart(&s3.UploadPartInput{
+			Bucket:     aws.String("bucket"),
+			Key:        aws.String("key"),
+			UploadId:   upload.UploadId,
+			PartNumber: aws.Int64(int64(i + 1)),
+			Body:       bytes.NewReader([]byte(data)),
+		})
+		require.NoError(t, err)
+		parts = append(parts, &s3.CompletedPart{ETag: part.ETag, PartNumber: aws.Int64(int64(i + 1))})
+	}
+
+	_, err = service.CompleteMultipartUpload(&s3.CompleteMultipartUploadInput{
+		Bucket:          aws.String("bucket"),
+		Key:             aws.String("key"),
+		UploadId:        upload.UploadId,
+		MultipartUpload: &s3.CompletedMultipartUpload{Parts: parts},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "first second", string(fake.objects["bucket/key"]))
+
+	_, err = service.AbortMultipartUpload(&s3.AbortMultipartUploadInput{Bucket: aws.String("bucket"), Key: aws.String("key"), UploadId: upload.UploadId})
+	require.NoError(t, err)
+}
+
+func TestPresignPutObject(t *testing.T) {
+	fake := newFakeCOS()
+	service := newTestService(t, fake)
+
+	url, err := service.PresignPutObject(&s3.PutObjectInput{Bucket: aws.String("bucket"), Key: aws.String("key")}, time.Hour)
+	require.NoError(t, err)
+	require.Contains(t, url, "/bucket/key?")
+	require.Contains(t, url, "X-Amz-Signature=")
+	require.Contains(t, url, "X-Amz-Expires=3600")
+
+	req, err := http.NewRequest(http.MethodPut, url, bytes.NewReader([]byte("data")))
+	require.NoError(t, err)
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, "data", string(fake.objects["bucket/key"]))
+}
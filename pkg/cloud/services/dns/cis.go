@@ -0,0 +1,148 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/IBM/networking-go-sdk/dnsrecordsv1"
+
+	"k8s.io/utils/ptr"
+
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/authenticator"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/metrics"
+)
+
+// cisPageSize is the number of records listed per page, the largest page size allowed by CIS.
+const cisPageSize int64 = 1000
+
+// CISService holds the IBM Cloud Internet Services specific information, it manages the records of a single zone.
+type CISService struct {
+	client *dnsrecordsv1.DnsRecordsV1
+}
+
+// NewCISService returns a new service managing the records of the zone options.ZoneIdentifier of the CIS instance options.Crn.
+func NewCISService(options *dnsrecordsv1.DnsRecordsV1Options) (DNS, error) {
+	if options == nil {
+		options = &dnsrecordsv1.DnsRecordsV1Options{}
+	}
+	if options.Authenticator == nil {
+		auth, err := authenticator.GetAuthenticator()
+		if err != nil {
+			return nil, err
+		}
+		options.Authenticator = auth
+	}
+	client, err := dnsrecordsv1.NewDnsRecordsV1(options)
+	if err != nil {
+		return nil, err
+	}
+	metrics.InstrumentService(metrics.ServiceCIS, client.Service)
+
+	return &CISService{
+		client: client,
+	}, nil
+}
+
+// GetRecord returns the record with the given ID, returns nil if not found.
+func (s *CISService) GetRecord(id string) (*Record, error) {
+	record, response, err := s.client.GetDnsRecord(s.client.NewGetDnsRecordOptions(id))
+	if response != nil && response.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get DNS record %s: %w", id, err)
+	}
+	return fromDNSRecordDetails(record.Result), nil
+}
+
+// GetRecordByName returns the A or CNAME record with the given name, returns nil if not found.
+func (s *CISService) GetRecordByName(name string) (*Record, error) {
+	listOptions := s.client.NewListAllDnsRecordsOptions()
+	listOptions.SetName(name)
+	listOptions.SetPerPage(cisPageSize)
+	for page := int64(1); ; page++ {
+		listOptions.SetPage(page)
+		records, _, err := s.client.ListAllDnsRecords(listOptions)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list DNS records: %w", err)
+		}
+		for i := range records.Result {
+			record := fromDNSRecordDetails(&records.Result[i])
+			if SameName(record.Name, name) && (record.Type == RecordTypeA || record.Type == RecordTypeCNAME) {
+				return record, nil
+			}
+		}
+		if records.ResultInfo == nil || records.ResultInfo.TotalCount == nil || page*cisPageSize >= *records.ResultInfo.TotalCount {
+			return nil, nil
+		}
+	}
+}
+
+// CreateRecord creates the record in the zone.
+func (s *CISService) CreateRecord(record *Record) (*Record, error) {
+	createOptions := s.client.NewCreateDnsRecordOptions()
+	createOptions.SetName(record.Name)
+	createOptions.SetType(record.Type)
+	createOptions.SetContent(record.Content)
+	createOptions.SetTTL(record.TTL)
+	created, _, err := s.client.CreateDnsRecord(createOptions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create DNS record %s: %w", record.Name, err)
+	}
+	return fromDNSRecordDetails(created.Result), nil
+}
+
+// UpdateRecord updates the name, content and TTL of the record.
+func (s *CISService) UpdateRecord(record *Record) (*Record, error) {
+	updateOptions := s.client.NewUpdateDnsRecordOptions(record.ID)
+	updateOptions.SetName(record.Name)
+	updateOptions.SetType(record.Type)
+	updateOptions.SetContent(record.Content)
+	updateOptions.SetTTL(record.TTL)
+	updated, _, err := s.client.UpdateDnsRecord(updateOptions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update DNS record %s: %w", record.ID, err)
+	}
+	return fromDNSRecordDetails(updated.Result), nil
+}
+
+// DeleteRecord deletes the record with the given ID, a record which is not found is considered deleted.
+func (s *CISService) DeleteRecord(id string) error {
+	_, response, err := s.client.DeleteDnsRecord(s.client.NewDeleteDnsRecordOptions(id))
+	if response != nil && response.StatusCode == http.StatusNotFound {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to delete DNS record %s: %w", id, err)
+	}
+	return nil
+}
+
+func fromDNSRecordDetails(record *dnsrecordsv1.DnsrecordDetails) *Record {
+	if record == nil {
+		return nil
+	}
+	return &Record{
+		ID:      ptr.Deref(record.ID, ""),
+		Name:    ptr.Deref(record.Name, ""),
+		Type:    ptr.Deref(record.Type, ""),
+		Content: ptr.Deref(record.Content, ""),
+		TTL:     ptr.Deref(record.TTL, 0),
+	}
+}
@@ -0,0 +1,58 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns
+
+import "strings"
+
+//go:generate ../../../../hack/tools/bin/mockgen -source=./dns.go -destination=./mock/dns_generated.go -package=mock
+//go:generate /usr/bin/env bash -c "cat ../../../../hack/boilerplate/boilerplate.generatego.txt ./mock/dns_generated.go > ./mock/_dns_generated.go && mv ./mock/_dns_generated.go ./mock/dns_generated.go"
+
+const (
+	// RecordTypeA is the type of the records resolving a name to an IPv4 address.
+	RecordTypeA = "A"
+	// RecordTypeCNAME is the type of the records resolving a name to a canonical name.
+	RecordTypeCNAME = "CNAME"
+)
+
+// Record is a record of a DNS zone, independent of the service hosting the zone.
+type Record struct {
+	// ID is the identifier of the record in the zone.
+	ID string
+	// Name is the fully qualified name of the record.
+	Name string
+	// Type is the type of the record, RecordTypeA or RecordTypeCNAME.
+	Type string
+	// Content is the IPv4 address of a RecordTypeA record or the canonical name of a RecordTypeCNAME record.
+	Content string
+	// TTL is the time to live of the record in seconds.
+	TTL int64
+}
+
+// DNS interface defines a method that a IBMCLOUD service object should implement in order to
+// manage the records of a DNS zone hosted in IBM Cloud DNS Services or CIS.
+type DNS interface {
+	GetRecord(id string) (*Record, error)
+	GetRecordByName(name string) (*Record, error)
+	CreateRecord(record *Record) (*Record, error)
+	UpdateRecord(record *Record) (*Record, error)
+	DeleteRecord(id string) error
+}
+
+// SameName returns true when both names refer to the same fully qualified name.
+func SameName(a, b string) bool {
+	return strings.EqualFold(strings.TrimSuffix(a, "."), strings.TrimSuffix(b, "."))
+}
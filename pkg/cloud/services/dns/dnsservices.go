@@ -0,0 +1,158 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dns
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/IBM/networking-go-sdk/dnssvcsv1"
+
+	"k8s.io/utils/ptr"
+
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/authenticator"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/metrics"
+)
+
+// DNSServicesService holds the IBM Cloud DNS Services specific information, it manages the records of a single zone.
+type DNSServicesService struct {
+	client     *dnssvcsv1.DnsSvcsV1
+	instanceID string
+	zoneID     string
+}
+
+// NewDNSServicesService returns a new service managing the records of the zone zoneID of the DNS Services instance instanceID.
+func NewDNSServicesService(options *dnssvcsv1.DnsSvcsV1Options, instanceID, zoneID string) (DNS, error) {
+	if options == nil {
+		options = &dnssvcsv1.DnsSvcsV1Options{}
+	}
+	if options.Authenticator == nil {
+		auth, err := authenticator.GetAuthenticator()
+		if err != nil {
+			return nil, err
+		}
+		options.Authenticator = auth
+	}
+	client, err := dnssvcsv1.NewDnsSvcsV1(options)
+	if err != nil {
+		return nil, err
+	}
+	metrics.InstrumentService(metrics.ServiceDNSServices, client.Service)
+
+	return &DNSServicesService{
+		client:     client,
+		instanceID: instanceID,
+		zoneID:     zoneID,
+	}, nil
+}
+
+// GetRecord returns the record with the given ID, returns nil if not found.
+func (s *DNSServicesService) GetRecord(id string) (*Record, error) {
+	record, response, err := s.client.GetResourceRecord(s.client.NewGetResourceRecordOptions(s.instanceID, s.zoneID, id))
+	if response != nil && response.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get resource record %s: %w", id, err)
+	}
+	return fromResourceRecord(record), nil
+}
+
+// GetRecordByName returns the A or CNAME record with the given name, returns nil if not found.
+func (s *DNSServicesService) GetRecordByName(name string) (*Record, error) {
+	listOptions := s.client.NewListResourceRecordsOptions(s.instanceID, s.zoneID)
+	listOptions.SetName(name)
+	pager, err := s.client.NewResourceRecordsPager(listOptions)
+	if err != nil {
+		return nil, err
+	}
+	records, err := pager.GetAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list resource records: %w", err)
+	}
+	for i := range records {
+		record := fromResourceRecord(&records[i])
+		if SameName(record.Name, name) && (record.Type == RecordTypeA || record.Type == RecordTypeCNAME) {
+			return record, nil
+		}
+	}
+	return nil, nil
+}
+
+// CreateRecord creates the record in the zone.
+func (s *DNSServicesService) CreateRecord(record *Record) (*Record, error) {
+	createOptions := s.client.NewCreateResourceRecordOptions(s.instanceID, s.zoneID)
+	createOptions.SetName(record.Name)
+	createOptions.SetType(record.Type)
+	createOptions.SetTTL(record.TTL)
+	if record.Type == RecordTypeA {
+		createOptions.SetRdata(&dnssvcsv1.ResourceRecordInputRdataRdataARecord{Ip: ptr.To(record.Content)})
+	} else {
+		createOptions.SetRdata(&dnssvcsv1.ResourceRecordInputRdataRdataCnameRecord{Cname: ptr.To(record.Content)})
+	}
+	created, _, err := s.client.CreateResourceRecord(createOptions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create resource record %s: %w", record.Name, err)
+	}
+	return fromResourceRecord(created), nil
+}
+
+// UpdateRecord updates the name, content and TTL of the record, the type of a record can't be changed.
+func (s *DNSServicesService) UpdateRecord(record *Record) (*Record, error) {
+	updateOptions := s.client.NewUpdateResourceRecordOptions(s.instanceID, s.zoneID, record.ID)
+	updateOptions.SetName(record.Name)
+	updateOptions.SetTTL(record.TTL)
+	if record.Type == RecordTypeA {
+		updateOptions.SetRdata(&dnssvcsv1.ResourceRecordUpdateInputRdataRdataARecord{Ip: ptr.To(record.Content)})
+	} else {
+		updateOptions.SetRdata(&dnssvcsv1.ResourceRecordUpdateInputRdataRdataCnameRecord{Cname: ptr.To(record.Content)})
+	}
+	updated, _, err := s.client.UpdateResourceRecord(updateOptions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update resource record %s: %w", record.ID, err)
+	}
+	return fromResourceRecord(updated), nil
+}
+
+// DeleteRecord deletes the record with the given ID, a record which is not found is considered deleted.
+func (s *DNSServicesService) DeleteRecord(id string) error {
+	response, err := s.client.DeleteResourceRecord(s.client.NewDeleteResourceRecordOptions(s.instanceID, s.zoneID, id))
+	if response != nil && response.StatusCode == http.StatusNotFound {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to delete resource record %s: %w", id, err)
+	}
+	return nil
+}
+
+func fromResourceRecord(record *dnssvcsv1.ResourceRecord) *Record {
+	result := &Record{
+		ID:   ptr.Deref(record.ID, ""),
+		Name: ptr.Deref(record.Name, ""),
+		Type: ptr.Deref(record.Type, ""),
+		TTL:  ptr.Deref(record.TTL, 0),
+	}
+	key := "cname"
+	if result.Type == RecordTypeA {
+		key = "ip"
+	}
+	if content, ok := record.Rdata[key].(string); ok {
+		result.Content = content
+	}
+	return result
+}
@@ -0,0 +1,34 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package enterprisemanagement
+
+import (
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/enterprisemanagementv1"
+)
+
+//go:generate ../../../../hack/tools/bin/mockgen -source=./enterprisemanagement.go -destination=./mock/enterprisemanagement_generated.go -package=mock
+//go:generate /usr/bin/env bash -c "cat ../../../../hack/boilerplate/boilerplate.generatego.txt ./mock/enterprisemanagement_generated.go > ./mock/_enterprisemanagement_generated.go && mv ./mock/_enterprisemanagement_generated.go ./mock/enterprisemanagement_generated.go"
+
+// EnterpriseManagement interface defines a method that a IBMCLOUD service object should implement in order to
+// look up the accounts of an enterprise using Enterprise Management APIs.
+type EnterpriseManagement interface {
+	GetAccount(*enterprisemanagementv1.GetAccountOptions) (*enterprisemanagementv1.Account, *core.DetailedResponse, error)
+	ListAccounts(*enterprisemanagementv1.ListAccountsOptions) (*enterprisemanagementv1.ListAccountsResponse, *core.DetailedResponse, error)
+
+	ListAccountGroupAccounts(string) ([]enterprisemanagementv1.Account, error)
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package enterprisemanagement
+
+import (
+	"fmt"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/enterprisemanagementv1"
+
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/authenticator"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/metrics"
+)
+
+// Service holds the IBM Cloud Enterprise Management Service specific information.
+type Service struct {
+	client *enterprisemanagementv1.EnterpriseManagementV1
+}
+
+// NewService returns a new service for the enterprise management.
+func NewService(options *enterprisemanagementv1.EnterpriseManagementV1Options) (EnterpriseManagement, error) {
+	if options == nil {
+		options = &enterprisemanagementv1.EnterpriseManagementV1Options{}
+	}
+	if options.Authenticator == nil {
+		auth, err := authenticator.GetAuthenticator()
+		if err != nil {
+			return nil, err
+		}
+		options.Authenticator = auth
+	}
+	emClient, err := enterprisemanagementv1.NewEnterpriseManagementV1(options)
+	if err != nil {
+		return nil, err
+	}
+	metrics.InstrumentService(metrics.ServiceEnterpriseManagement, emClient.Service)
+	return &Service{
+		client: emClient,
+	}, nil
+}
+
+// GetAccount returns an account of the enterprise.
+func (s *Service) GetAccount(getAccountOptions *enterprisemanagementv1.GetAccountOptions) (*enterprisemanagementv1.Account, *core.DetailedResponse, error) {
+	return s.client.GetAccount(getAccountOptions)
+}
+
+// ListAccounts lists the accounts of the enterprise.
+func (s *Service) ListAccounts(listAccountsOptions *enterprisemanagementv1.ListAccountsOptions) (*enterprisemanagementv1.ListAccountsResponse, *core.DetailedResponse, error) {
+	return s.client.ListAccounts(listAccountsOptions)
+}
+
+// ListAccountGroupAccounts returns all the accounts which are direct children of the account group with the provided ID.
+func (s *Service) ListAccountGroupAccounts(accountGroupID string) ([]enterprisemanagementv1.Account, error) {
+	listOptions := s.client.NewListAccountsOptions()
+	listOptions.SetAccountGroupID(accountGroupID)
+
+	pager, err := s.client.NewAccountsPager(listOptions)
+	if err != nil {
+		return nil, err
+	}
+	accounts, err := pager.GetAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed listing the accounts of account group %s: %w", accountGroupID, err)
+	}
+	return accounts, nil
+}
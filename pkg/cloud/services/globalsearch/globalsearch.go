@@ -0,0 +1,26 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package globalsearch
+
+//go:generate ../../../../hack/tools/bin/mockgen -source=./globalsearch.go -destination=./mock/globalsearch_generated.go -package=mock
+//go:generate /usr/bin/env bash -c "cat ../../../../hack/boilerplate/boilerplate.generatego.txt ./mock/globalsearch_generated.go > ./mock/_globalsearch_generated.go && mv ./mock/_globalsearch_generated.go ./mock/globalsearch_generated.go"
+
+// GlobalSearch interface defines a method that a IBMCLOUD service object should implement in order to
+// find the resources with the Global Search APIs.
+type GlobalSearch interface {
+	GetResourcesByTag(tagName string) ([]string, error)
+}
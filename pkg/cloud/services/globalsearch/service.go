@@ -0,0 +1,92 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package globalsearch
+
+import (
+	"fmt"
+
+	"github.com/IBM/platform-services-go-sdk/globalsearchv2"
+
+	"k8s.io/utils/ptr"
+
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/authenticator"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/metrics"
+)
+
+// searchLimit is the maximum number of resources returned per page of search results.
+const searchLimit = 1000
+
+// Service holds the IBM Cloud Global Search Service specific information.
+type Service struct {
+	client *globalsearchv2.GlobalSearchV2
+}
+
+// ServiceOptions holds the IBM Cloud Global Search Service Options specific information.
+type ServiceOptions struct {
+	*globalsearchv2.GlobalSearchV2Options
+}
+
+// GetResourcesByTag returns the CRNs of the resources with the provided user Tag attached.
+func (s *Service) GetResourcesByTag(tagName string) ([]string, error) {
+	options := &globalsearchv2.SearchOptions{
+		Query:  ptr.To(fmt.Sprintf("tags:%q", tagName)),
+		Fields: []string{"crn"},
+		Limit:  ptr.To(int64(searchLimit)),
+	}
+
+	var crns []string
+	for {
+		result, _, err := s.client.Search(options)
+		if err != nil {
+			return nil, fmt.Errorf("failed searching resources tagged with %s: %w", tagName, err)
+		}
+		if result == nil {
+			return nil, fmt.Errorf("failed to search resources tagged with %s", tagName)
+		}
+		for _, item := range result.Items {
+			if item.CRN != nil {
+				crns = append(crns, *item.CRN)
+			}
+		}
+		if result.SearchCursor == nil || len(result.Items) < searchLimit {
+			return crns, nil
+		}
+		options.SearchCursor = result.SearchCursor
+	}
+}
+
+// NewService returns a new service for the IBM Cloud Global Search api client.
+func NewService(options ServiceOptions) (*Service, error) {
+	if options.GlobalSearchV2Options == nil {
+		options.GlobalSearchV2Options = &globalsearchv2.GlobalSearchV2Options{}
+	}
+	if options.Authenticator == nil {
+		auth, err := authenticator.GetAuthenticator()
+		if err != nil {
+			return nil, err
+		}
+		options.Authenticator = auth
+	}
+	service, err := globalsearchv2.NewGlobalSearchV2(options.GlobalSearchV2Options)
+	if err != nil {
+		return nil, err
+	}
+	metrics.InstrumentService(metrics.ServiceGlobalSearch, service.Service)
+	return &Service{
+		client: service,
+	}, nil
+}
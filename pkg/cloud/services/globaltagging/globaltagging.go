@@ -17,10 +17,22 @@ limitations under the License.
 package globaltagging
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/platform-services-go-sdk/globaltaggingv1"
+
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/names"
 )
 
+// maxTagLength is the maximum length of the user Tags.
+const maxTagLength = 128
+
+// ManagedTagPrefix is the prefix of the user Tags managed by the controllers, these are detached from the resources
+// when they are no longer desired.
+const ManagedTagPrefix = "capibm-"
+
 //go:generate ../../../../hack/tools/bin/mockgen -source=./globaltagging.go -destination=./mock/globaltagging_generated.go -package=mock
 //go:generate /usr/bin/env bash -c "cat ../../../../hack/boilerplate/boilerplate.generatego.txt ./mock/globaltagging_generated.go > ./mock/_globaltagging_generated.go && mv ./mock/_globaltagging_generated.go ./mock/globaltagging_generated.go"
 
@@ -29,5 +41,55 @@ import (
 type GlobalTagging interface {
 	CreateTag(*globaltaggingv1.CreateTagOptions) (*globaltaggingv1.CreateTagResults, *core.DetailedResponse, error)
 	AttachTag(*globaltaggingv1.AttachTagOptions) (*globaltaggingv1.TagResults, *core.DetailedResponse, error)
+	DetachTag(*globaltaggingv1.DetachTagOptions) (*globaltaggingv1.TagResults, *core.DetailedResponse, error)
 	GetTagByName(string) (*globaltaggingv1.Tag, error)
+	GetAttachedTags(string) ([]string, error)
+}
+
+// ClusterTag returns the user Tag attached to the resources created for the cluster, it is used to find the resources
+// left behind by the cluster on deletion. The namespace and the name are separated with an underscore, which is not
+// allowed in the names of the objects.
+func ClusterTag(namespace, clusterName string) string {
+	return names.Truncate(fmt.Sprintf("%scluster:%s_%s", ManagedTagPrefix, namespace, clusterName), maxTagLength)
+}
+
+// ClusterUIDTag returns the user Tag attached to the resources created for the cluster with the UID. Unlike the ClusterTag,
+// it is unique across the management clusters and the clusters recreated with the same name, hence it is used to find
+// the resources to delete along with the cluster.
+func ClusterUIDTag(uid string) string {
+	return fmt.Sprintf("%scluster-uid:%s", ManagedTagPrefix, uid)
+}
+
+// DiffTags returns the desired user Tags missing from the attached Tags and the attached Tags to detach. Unless prune is set,
+// only the Tags with the ManagedTagPrefix and the Tags with the key of a desired key:value Tag but another value are detached,
+// which preserves the Tags attached by other systems. User Tags are case insensitive.
+func DiffTags(attached, desired []string, prune bool) (toAttach, toDetach []string) {
+	desiredKeys := map[string]bool{}
+	for _, tag := range desired {
+		if key, _, found := strings.Cut(tag, ":"); found {
+			desiredKeys[strings.ToLower(key)] = true
+		}
+		if !containsTag(attached, tag) && !containsTag(toAttach, tag) {
+			toAttach = append(toAttach, tag)
+		}
+	}
+	for _, tag := range attached {
+		if containsTag(desired, tag) {
+			continue
+		}
+		key, _, found := strings.Cut(tag, ":")
+		if prune || strings.HasPrefix(strings.ToLower(tag), ManagedTagPrefix) || (found && desiredKeys[strings.ToLower(key)]) {
+			toDetach = append(toDetach, tag)
+		}
+	}
+	return toAttach, toDetach
+}
+
+func containsTag(tags []string, tag string) bool {
+	for _, t := range tags {
+		if strings.EqualFold(t, tag) {
+			return true
+		}
+	}
+	return false
 }
@@ -0,0 +1,84 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package globaltagging
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestDiffTags(t *testing.T) {
+	testCases := []struct {
+		name             string
+		attached         []string
+		desired          []string
+		prune            bool
+		expectedToAttach []string
+		expectedToDetach []string
+	}{
+		{
+			name:             "Untagged resource",
+			desired:          []string{"capibm-cluster:default_foo", "env:prod"},
+			expectedToAttach: []string{"capibm-cluster:default_foo", "env:prod"},
+		},
+		{
+			name:     "Tags matching case insensitively",
+			attached: []string{"capibm-cluster:default_foo", "env:prod"},
+			desired:  []string{"capibm-cluster:default_foo", "Env:Prod"},
+		},
+		{
+			name:     "Tags attached by other systems are preserved",
+			attached: []string{"capibm-cluster:default_foo", "cost-center:1234", "cmdb"},
+			desired:  []string{"capibm-cluster:default_foo"},
+		},
+		{
+			name:             "Tags with the managed prefix are detached",
+			attached:         []string{"capibm-cluster:default_foo", "capibm-cluster:default_bar", "cmdb"},
+			desired:          []string{"capibm-cluster:default_foo"},
+			expectedToDetach: []string{"capibm-cluster:default_bar"},
+		},
+		{
+			name:             "Tags with the key of a desired tag are replaced",
+			attached:         []string{"env:dev", "owner:finance"},
+			desired:          []string{"env:prod"},
+			expectedToAttach: []string{"env:prod"},
+			expectedToDetach: []string{"env:dev"},
+		},
+		{
+			name:             "Tags not desired are detached when pruning",
+			attached:         []string{"env:dev", "owner:finance", "cmdb"},
+			desired:          []string{"env:prod", "cmdb"},
+			prune:            true,
+			expectedToAttach: []string{"env:prod"},
+			expectedToDetach: []string{"env:dev", "owner:finance"},
+		},
+		{
+			name:             "Duplicated desired tags",
+			desired:          []string{"env:prod", "ENV:PROD"},
+			expectedToAttach: []string{"env:prod"},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			toAttach, toDetach := DiffTags(tc.attached, tc.desired, tc.prune)
+			g.Expect(toAttach).To(Equal(tc.expectedToAttach))
+			g.Expect(toDetach).To(Equal(tc.expectedToDetach))
+		})
+	}
+}
@@ -87,6 +87,37 @@ func (mr *MockGlobalTaggingMockRecorder) CreateTag(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTag", reflect.TypeOf((*MockGlobalTagging)(nil).CreateTag), arg0)
 }
 
+// DetachTag mocks base method.
+func (m *MockGlobalTagging) DetachTag(arg0 *globaltaggingv1.DetachTagOptions) (*globaltaggingv1.TagResults, *core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DetachTag", arg0)
+	ret0, _ := ret[0].(*globaltaggingv1.TagResults)
+	ret1, _ := ret[1].(*core.DetailedResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// DetachTag indicates an expected call of DetachTag.
+func (mr *MockGlobalTaggingMockRecorder) DetachTag(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetachTag", reflect.TypeOf((*MockGlobalTagging)(nil).DetachTag), arg0)
+}
+
+// GetAttachedTags mocks base method.
+func (m *MockGlobalTagging) GetAttachedTags(arg0 string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttachedTags", arg0)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttachedTags indicates an expected call of GetAttachedTags.
+func (mr *MockGlobalTaggingMockRecorder) GetAttachedTags(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttachedTags", reflect.TypeOf((*MockGlobalTagging)(nil).GetAttachedTags), arg0)
+}
+
 // GetTagByName mocks base method.
 func (m *MockGlobalTagging) GetTagByName(arg0 string) (*globaltaggingv1.Tag, error) {
 	m.ctrl.T.Helper()
@@ -27,6 +27,7 @@ import (
 
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/authenticator"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/utils"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/metrics"
 )
 
 // Service holds the IBM Cloud Global Tagging Service specific information.
@@ -49,9 +50,14 @@ func (s *Service) AttachTag(options *globaltaggingv1.AttachTagOptions) (*globalt
 	return s.client.AttachTag(options)
 }
 
-// GetTagByName returns the Tag with the provided name, if found.
+// DetachTag will remove tag(s) from resource(s).
+func (s *Service) DetachTag(options *globaltaggingv1.DetachTagOptions) (*globaltaggingv1.TagResults, *core.DetailedResponse, error) {
+	return s.client.DetachTag(options)
+}
+
+// GetTagByName returns the Tag with the provided name in the account of the service credentials, if found.
 func (s *Service) GetTagByName(tagName string) (*globaltaggingv1.Tag, error) {
-	accountID, err := utils.GetAccountID()
+	accountID, err := utils.GetAccount(s.client.Service.Options.Authenticator)
 	if err != nil {
 		return nil, err
 	}
@@ -75,6 +81,28 @@ func (s *Service) GetTagByName(tagName string) (*globaltaggingv1.Tag, error) {
 	return nil, nil
 }
 
+// GetAttachedTags returns the names of the user Tags attached to the resource with the provided CRN.
+func (s *Service) GetAttachedTags(resourceCRN string) ([]string, error) {
+	listOptions := s.client.NewListTagsOptions()
+	listOptions.SetTagType(globaltaggingv1.AttachTagOptionsTagTypeUserConst)
+	listOptions.SetAttachedTo(resourceCRN)
+
+	result, _, err := s.client.ListTags(listOptions)
+	if err != nil {
+		return nil, fmt.Errorf("failed listing user tags attached to %s: %w", resourceCRN, err)
+	}
+	if result == nil {
+		return nil, fmt.Errorf("failed to list tags attached to %s", resourceCRN)
+	}
+	tags := make([]string, 0, len(result.Items))
+	for _, tag := range result.Items {
+		if tag.Name != nil {
+			tags = append(tags, *tag.Name)
+		}
+	}
+	return tags, nil
+}
+
 // NewService returns a new service for the IBM Cloud Global Tagging api client.
 func NewService(options ServiceOptions) (*Service, error) {
 	if options.GlobalTaggingV1Options == nil {
@@ -91,6 +119,7 @@ func NewService(options ServiceOptions) (*Service, error) {
 	if err != nil {
 		return nil, err
 	}
+	metrics.InstrumentService(metrics.ServiceGlobalTagging, service.Service)
 	return &Service{
 		client: service,
 	}, nil
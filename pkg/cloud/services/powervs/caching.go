@@ -17,8 +17,11 @@ limitations under the License.
 package powervs
 
 import (
+	"fmt"
 	"time"
 
+	"github.com/IBM-Cloud/power-go-client/power/models"
+
 	"k8s.io/client-go/tools/cache"
 )
 
@@ -43,3 +46,34 @@ func CacheKeyFunc(obj interface{}) (string, error) {
 func InitialiseDHCPCacheStore() cache.Store {
 	return cache.NewTTLStore(CacheKeyFunc, CacheTTL)
 }
+
+// ListCacheTTL is duration of time to store the name index of the images and the virtual machines of a Power VS service instance.
+// It is kept short as the resources created or deleted outside of the controller are only seen once the index expires,
+// the resources created or deleted with a Service invalidate the index of their Power VS service instance right away.
+const ListCacheTTL = time.Duration(30) * time.Second
+
+const (
+	imageListKind    = "images"
+	instanceListKind = "instances"
+)
+
+// nameIndex holds the images or the virtual machines of a Power VS service instance keyed by name.
+type nameIndex struct {
+	Key       string
+	Images    map[string]*models.ImageReference
+	Instances map[string]*models.PVMInstanceReference
+}
+
+// nameIndexKeyFunc defines the key function of the name index cache.
+func nameIndexKeyFunc(obj interface{}) (string, error) {
+	return obj.(nameIndex).Key, nil
+}
+
+// nameIndexKey returns the key of the name index of kind in the Power VS service instance.
+func nameIndexKey(cloudInstanceID, kind string) string {
+	return fmt.Sprintf("%s/%s", cloudInstanceID, kind)
+}
+
+// nameIndexCache is shared by the Services of all the scopes, as a Service is created on every reconciliation
+// and the machines and images of a Power VS service instance are reconciled concurrently.
+var nameIndexCache = cache.NewTTLStore(nameIndexKeyFunc, ListCacheTTL)
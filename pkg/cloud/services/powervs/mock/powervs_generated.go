@@ -55,6 +55,50 @@ func (m *MockPowerVS) EXPECT() *MockPowerVSMockRecorder {
 	return m.recorder
 }
 
+// AttachVolume mocks base method.
+func (m *MockPowerVS) AttachVolume(instanceID, volumeID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AttachVolume", instanceID, volumeID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AttachVolume indicates an expected call of AttachVolume.
+func (mr *MockPowerVSMockRecorder) AttachVolume(instanceID, volumeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttachVolume", reflect.TypeOf((*MockPowerVS)(nil).AttachVolume), instanceID, volumeID)
+}
+
+// CaptureInstance mocks base method.
+func (m *MockPowerVS) CaptureInstance(id string, body *models.PVMInstanceCapture) (*models.JobReference, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CaptureInstance", id, body)
+	ret0, _ := ret[0].(*models.JobReference)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CaptureInstance indicates an expected call of CaptureInstance.
+func (mr *MockPowerVSMockRecorder) CaptureInstance(id, body any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CaptureInstance", reflect.TypeOf((*MockPowerVS)(nil).CaptureInstance), id, body)
+}
+
+// CloneVolumes mocks base method.
+func (m *MockPowerVS) CloneVolumes(body *models.VolumesCloneAsyncRequest) (*models.CloneTaskReference, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloneVolumes", body)
+	ret0, _ := ret[0].(*models.CloneTaskReference)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CloneVolumes indicates an expected call of CloneVolumes.
+func (mr *MockPowerVSMockRecorder) CloneVolumes(body any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloneVolumes", reflect.TypeOf((*MockPowerVS)(nil).CloneVolumes), body)
+}
+
 // CreateCosImage mocks base method.
 func (m *MockPowerVS) CreateCosImage(body *models.CreateCosImageImportJob) (*models.JobReference, error) {
 	m.ctrl.T.Helper()
@@ -85,6 +129,21 @@ func (mr *MockPowerVSMockRecorder) CreateDHCPServer(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDHCPServer", reflect.TypeOf((*MockPowerVS)(nil).CreateDHCPServer), arg0)
 }
 
+// CreateImage mocks base method.
+func (m *MockPowerVS) CreateImage(body *models.CreateImage) (*models.Image, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateImage", body)
+	ret0, _ := ret[0].(*models.Image)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateImage indicates an expected call of CreateImage.
+func (mr *MockPowerVSMockRecorder) CreateImage(body any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateImage", reflect.TypeOf((*MockPowerVS)(nil).CreateImage), body)
+}
+
 // CreateInstance mocks base method.
 func (m *MockPowerVS) CreateInstance(body *models.PVMInstanceCreate) (*models.PVMInstanceList, error) {
 	m.ctrl.T.Helper()
@@ -100,6 +159,66 @@ func (mr *MockPowerVSMockRecorder) CreateInstance(body any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInstance", reflect.TypeOf((*MockPowerVS)(nil).CreateInstance), body)
 }
 
+// CreateNetwork mocks base method.
+func (m *MockPowerVS) CreateNetwork(body *models.NetworkCreate) (*models.Network, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNetwork", body)
+	ret0, _ := ret[0].(*models.Network)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateNetwork indicates an expected call of CreateNetwork.
+func (mr *MockPowerVSMockRecorder) CreateNetwork(body any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNetwork", reflect.TypeOf((*MockPowerVS)(nil).CreateNetwork), body)
+}
+
+// CreateSSHKey mocks base method.
+func (m *MockPowerVS) CreateSSHKey(body *models.SSHKey) (*models.SSHKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSSHKey", body)
+	ret0, _ := ret[0].(*models.SSHKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSSHKey indicates an expected call of CreateSSHKey.
+func (mr *MockPowerVSMockRecorder) CreateSSHKey(body any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSSHKey", reflect.TypeOf((*MockPowerVS)(nil).CreateSSHKey), body)
+}
+
+// CreateSnapshot mocks base method.
+func (m *MockPowerVS) CreateSnapshot(instanceID string, body *models.SnapshotCreate) (*models.SnapshotCreateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSnapshot", instanceID, body)
+	ret0, _ := ret[0].(*models.SnapshotCreateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSnapshot indicates an expected call of CreateSnapshot.
+func (mr *MockPowerVSMockRecorder) CreateSnapshot(instanceID, body any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSnapshot", reflect.TypeOf((*MockPowerVS)(nil).CreateSnapshot), instanceID, body)
+}
+
+// CreateVolume mocks base method.
+func (m *MockPowerVS) CreateVolume(body *models.CreateDataVolume) (*models.Volume, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVolume", body)
+	ret0, _ := ret[0].(*models.Volume)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateVolume indicates an expected call of CreateVolume.
+func (mr *MockPowerVSMockRecorder) CreateVolume(body any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVolume", reflect.TypeOf((*MockPowerVS)(nil).CreateVolume), body)
+}
+
 // DeleteDHCPServer mocks base method.
 func (m *MockPowerVS) DeleteDHCPServer(id string) error {
 	m.ctrl.T.Helper()
@@ -156,6 +275,91 @@ func (mr *MockPowerVSMockRecorder) DeleteJob(id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteJob", reflect.TypeOf((*MockPowerVS)(nil).DeleteJob), id)
 }
 
+// DeleteNetwork mocks base method.
+func (m *MockPowerVS) DeleteNetwork(id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNetwork", id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteNetwork indicates an expected call of DeleteNetwork.
+func (mr *MockPowerVSMockRecorder) DeleteNetwork(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNetwork", reflect.TypeOf((*MockPowerVS)(nil).DeleteNetwork), id)
+}
+
+// DeleteSSHKey mocks base method.
+func (m *MockPowerVS) DeleteSSHKey(name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSSHKey", name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSSHKey indicates an expected call of DeleteSSHKey.
+func (mr *MockPowerVSMockRecorder) DeleteSSHKey(name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSSHKey", reflect.TypeOf((*MockPowerVS)(nil).DeleteSSHKey), name)
+}
+
+// DeleteSnapshot mocks base method.
+func (m *MockPowerVS) DeleteSnapshot(id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSnapshot", id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSnapshot indicates an expected call of DeleteSnapshot.
+func (mr *MockPowerVSMockRecorder) DeleteSnapshot(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSnapshot", reflect.TypeOf((*MockPowerVS)(nil).DeleteSnapshot), id)
+}
+
+// DeleteVolume mocks base method.
+func (m *MockPowerVS) DeleteVolume(id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVolume", id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteVolume indicates an expected call of DeleteVolume.
+func (mr *MockPowerVSMockRecorder) DeleteVolume(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVolume", reflect.TypeOf((*MockPowerVS)(nil).DeleteVolume), id)
+}
+
+// DetachVolume mocks base method.
+func (m *MockPowerVS) DetachVolume(instanceID, volumeID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DetachVolume", instanceID, volumeID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DetachVolume indicates an expected call of DetachVolume.
+func (mr *MockPowerVSMockRecorder) DetachVolume(instanceID, volumeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetachVolume", reflect.TypeOf((*MockPowerVS)(nil).DetachVolume), instanceID, volumeID)
+}
+
+// ExportImage mocks base method.
+func (m *MockPowerVS) ExportImage(id string, body *models.ExportImage) (*models.JobReference, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportImage", id, body)
+	ret0, _ := ret[0].(*models.JobReference)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportImage indicates an expected call of ExportImage.
+func (mr *MockPowerVSMockRecorder) ExportImage(id, body any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportImage", reflect.TypeOf((*MockPowerVS)(nil).ExportImage), id, body)
+}
+
 // GetAllDHCPServers mocks base method.
 func (m *MockPowerVS) GetAllDHCPServers() (models.DHCPServers, error) {
 	m.ctrl.T.Helper()
@@ -201,6 +405,36 @@ func (mr *MockPowerVSMockRecorder) GetAllInstance() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllInstance", reflect.TypeOf((*MockPowerVS)(nil).GetAllInstance))
 }
 
+// GetAllInstanceSnapshots mocks base method.
+func (m *MockPowerVS) GetAllInstanceSnapshots(instanceID string) (*models.Snapshots, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllInstanceSnapshots", instanceID)
+	ret0, _ := ret[0].(*models.Snapshots)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllInstanceSnapshots indicates an expected call of GetAllInstanceSnapshots.
+func (mr *MockPowerVSMockRecorder) GetAllInstanceSnapshots(instanceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllInstanceSnapshots", reflect.TypeOf((*MockPowerVS)(nil).GetAllInstanceSnapshots), instanceID)
+}
+
+// GetAllInstanceVolumes mocks base method.
+func (m *MockPowerVS) GetAllInstanceVolumes(id string) (*models.Volumes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllInstanceVolumes", id)
+	ret0, _ := ret[0].(*models.Volumes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllInstanceVolumes indicates an expected call of GetAllInstanceVolumes.
+func (mr *MockPowerVSMockRecorder) GetAllInstanceVolumes(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllInstanceVolumes", reflect.TypeOf((*MockPowerVS)(nil).GetAllInstanceVolumes), id)
+}
+
 // GetAllNetwork mocks base method.
 func (m *MockPowerVS) GetAllNetwork() (*models.Networks, error) {
 	m.ctrl.T.Helper()
@@ -216,6 +450,111 @@ func (mr *MockPowerVSMockRecorder) GetAllNetwork() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllNetwork", reflect.TypeOf((*MockPowerVS)(nil).GetAllNetwork))
 }
 
+// GetAllPlacementGroups mocks base method.
+func (m *MockPowerVS) GetAllPlacementGroups() (*models.PlacementGroups, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllPlacementGroups")
+	ret0, _ := ret[0].(*models.PlacementGroups)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllPlacementGroups indicates an expected call of GetAllPlacementGroups.
+func (mr *MockPowerVSMockRecorder) GetAllPlacementGroups() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllPlacementGroups", reflect.TypeOf((*MockPowerVS)(nil).GetAllPlacementGroups))
+}
+
+// GetAllSharedProcessorPools mocks base method.
+func (m *MockPowerVS) GetAllSharedProcessorPools() (*models.SharedProcessorPools, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllSharedProcessorPools")
+	ret0, _ := ret[0].(*models.SharedProcessorPools)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllSharedProcessorPools indicates an expected call of GetAllSharedProcessorPools.
+func (mr *MockPowerVSMockRecorder) GetAllSharedProcessorPools() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllSharedProcessorPools", reflect.TypeOf((*MockPowerVS)(nil).GetAllSharedProcessorPools))
+}
+
+// GetAllSnapshots mocks base method.
+func (m *MockPowerVS) GetAllSnapshots() (*models.Snapshots, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllSnapshots")
+	ret0, _ := ret[0].(*models.Snapshots)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllSnapshots indicates an expected call of GetAllSnapshots.
+func (mr *MockPowerVSMockRecorder) GetAllSnapshots() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllSnapshots", reflect.TypeOf((*MockPowerVS)(nil).GetAllSnapshots))
+}
+
+// GetAllStockImages mocks base method.
+func (m *MockPowerVS) GetAllStockImages() (*models.Images, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllStockImages")
+	ret0, _ := ret[0].(*models.Images)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllStockImages indicates an expected call of GetAllStockImages.
+func (mr *MockPowerVSMockRecorder) GetAllStockImages() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllStockImages", reflect.TypeOf((*MockPowerVS)(nil).GetAllStockImages))
+}
+
+// GetAllVolumeSnapshots mocks base method.
+func (m *MockPowerVS) GetAllVolumeSnapshots() (*models.VolumeSnapshotList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllVolumeSnapshots")
+	ret0, _ := ret[0].(*models.VolumeSnapshotList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllVolumeSnapshots indicates an expected call of GetAllVolumeSnapshots.
+func (mr *MockPowerVSMockRecorder) GetAllVolumeSnapshots() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllVolumeSnapshots", reflect.TypeOf((*MockPowerVS)(nil).GetAllVolumeSnapshots))
+}
+
+// GetAllVolumes mocks base method.
+func (m *MockPowerVS) GetAllVolumes() (*models.Volumes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllVolumes")
+	ret0, _ := ret[0].(*models.Volumes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllVolumes indicates an expected call of GetAllVolumes.
+func (mr *MockPowerVSMockRecorder) GetAllVolumes() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllVolumes", reflect.TypeOf((*MockPowerVS)(nil).GetAllVolumes))
+}
+
+// GetCloneTask mocks base method.
+func (m *MockPowerVS) GetCloneTask(id string) (*models.CloneTaskStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCloneTask", id)
+	ret0, _ := ret[0].(*models.CloneTaskStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCloneTask indicates an expected call of GetCloneTask.
+func (mr *MockPowerVSMockRecorder) GetCloneTask(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCloneTask", reflect.TypeOf((*MockPowerVS)(nil).GetCloneTask), id)
+}
+
 // GetCosImages mocks base method.
 func (m *MockPowerVS) GetCosImages(id string) (*models.Job, error) {
 	m.ctrl.T.Helper()
@@ -276,6 +615,21 @@ func (mr *MockPowerVSMockRecorder) GetImage(id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetImage", reflect.TypeOf((*MockPowerVS)(nil).GetImage), id)
 }
 
+// GetImageByName mocks base method.
+func (m *MockPowerVS) GetImageByName(name string) (*models.ImageReference, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetImageByName", name)
+	ret0, _ := ret[0].(*models.ImageReference)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetImageByName indicates an expected call of GetImageByName.
+func (mr *MockPowerVSMockRecorder) GetImageByName(name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetImageByName", reflect.TypeOf((*MockPowerVS)(nil).GetImageByName), name)
+}
+
 // GetInstance mocks base method.
 func (m *MockPowerVS) GetInstance(id string) (*models.PVMInstance, error) {
 	m.ctrl.T.Helper()
@@ -291,6 +645,36 @@ func (mr *MockPowerVSMockRecorder) GetInstance(id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInstance", reflect.TypeOf((*MockPowerVS)(nil).GetInstance), id)
 }
 
+// GetInstanceByName mocks base method.
+func (m *MockPowerVS) GetInstanceByName(name string) (*models.PVMInstanceReference, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInstanceByName", name)
+	ret0, _ := ret[0].(*models.PVMInstanceReference)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInstanceByName indicates an expected call of GetInstanceByName.
+func (mr *MockPowerVSMockRecorder) GetInstanceByName(name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInstanceByName", reflect.TypeOf((*MockPowerVS)(nil).GetInstanceByName), name)
+}
+
+// GetInstanceVolume mocks base method.
+func (m *MockPowerVS) GetInstanceVolume(instanceID, volumeID string) (*models.Volume, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInstanceVolume", instanceID, volumeID)
+	ret0, _ := ret[0].(*models.Volume)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInstanceVolume indicates an expected call of GetInstanceVolume.
+func (mr *MockPowerVSMockRecorder) GetInstanceVolume(instanceID, volumeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInstanceVolume", reflect.TypeOf((*MockPowerVS)(nil).GetInstanceVolume), instanceID, volumeID)
+}
+
 // GetJob mocks base method.
 func (m *MockPowerVS) GetJob(id string) (*models.Job, error) {
 	m.ctrl.T.Helper()
@@ -336,6 +720,197 @@ func (mr *MockPowerVSMockRecorder) GetNetworkByName(networkName any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNetworkByName", reflect.TypeOf((*MockPowerVS)(nil).GetNetworkByName), networkName)
 }
 
+// GetSSHKey mocks base method.
+func (m *MockPowerVS) GetSSHKey(name string) (*models.SSHKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSSHKey", name)
+	ret0, _ := ret[0].(*models.SSHKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSSHKey indicates an expected call of GetSSHKey.
+func (mr *MockPowerVSMockRecorder) GetSSHKey(name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSSHKey", reflect.TypeOf((*MockPowerVS)(nil).GetSSHKey), name)
+}
+
+// GetSnapshot mocks base method.
+func (m *MockPowerVS) GetSnapshot(id string) (*models.Snapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSnapshot", id)
+	ret0, _ := ret[0].(*models.Snapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSnapshot indicates an expected call of GetSnapshot.
+func (mr *MockPowerVSMockRecorder) GetSnapshot(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSnapshot", reflect.TypeOf((*MockPowerVS)(nil).GetSnapshot), id)
+}
+
+// GetStockImage mocks base method.
+func (m *MockPowerVS) GetStockImage(id string) (*models.Image, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStockImage", id)
+	ret0, _ := ret[0].(*models.Image)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStockImage indicates an expected call of GetStockImage.
+func (mr *MockPowerVSMockRecorder) GetStockImage(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStockImage", reflect.TypeOf((*MockPowerVS)(nil).GetStockImage), id)
+}
+
+// GetVolume mocks base method.
+func (m *MockPowerVS) GetVolume(id string) (*models.Volume, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVolume", id)
+	ret0, _ := ret[0].(*models.Volume)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVolume indicates an expected call of GetVolume.
+func (mr *MockPowerVSMockRecorder) GetVolume(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVolume", reflect.TypeOf((*MockPowerVS)(nil).GetVolume), id)
+}
+
+// GetVolumeSnapshot mocks base method.
+func (m *MockPowerVS) GetVolumeSnapshot(id string) (*models.SnapshotV1, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVolumeSnapshot", id)
+	ret0, _ := ret[0].(*models.SnapshotV1)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVolumeSnapshot indicates an expected call of GetVolumeSnapshot.
+func (mr *MockPowerVSMockRecorder) GetVolumeSnapshot(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVolumeSnapshot", reflect.TypeOf((*MockPowerVS)(nil).GetVolumeSnapshot), id)
+}
+
+// InstanceAction mocks base method.
+func (m *MockPowerVS) InstanceAction(id string, body *models.PVMInstanceAction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InstanceAction", id, body)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InstanceAction indicates an expected call of InstanceAction.
+func (mr *MockPowerVSMockRecorder) InstanceAction(id, body any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstanceAction", reflect.TypeOf((*MockPowerVS)(nil).InstanceAction), id, body)
+}
+
+// RestoreSnapshot mocks base method.
+func (m *MockPowerVS) RestoreSnapshot(instanceID, snapshotID, restoreFailAction string, body *models.SnapshotRestore) (*models.Snapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreSnapshot", instanceID, snapshotID, restoreFailAction, body)
+	ret0, _ := ret[0].(*models.Snapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreSnapshot indicates an expected call of RestoreSnapshot.
+func (mr *MockPowerVSMockRecorder) RestoreSnapshot(instanceID, snapshotID, restoreFailAction, body any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreSnapshot", reflect.TypeOf((*MockPowerVS)(nil).RestoreSnapshot), instanceID, snapshotID, restoreFailAction, body)
+}
+
+// SetBootVolume mocks base method.
+func (m *MockPowerVS) SetBootVolume(instanceID, volumeID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetBootVolume", instanceID, volumeID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetBootVolume indicates an expected call of SetBootVolume.
+func (mr *MockPowerVSMockRecorder) SetBootVolume(instanceID, volumeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBootVolume", reflect.TypeOf((*MockPowerVS)(nil).SetBootVolume), instanceID, volumeID)
+}
+
+// UpdateInstanceVolume mocks base method.
+func (m *MockPowerVS) UpdateInstanceVolume(instanceID, volumeID string, body *models.PVMInstanceVolumeUpdate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateInstanceVolume", instanceID, volumeID, body)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateInstanceVolume indicates an expected call of UpdateInstanceVolume.
+func (mr *MockPowerVSMockRecorder) UpdateInstanceVolume(instanceID, volumeID, body any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateInstanceVolume", reflect.TypeOf((*MockPowerVS)(nil).UpdateInstanceVolume), instanceID, volumeID, body)
+}
+
+// UpdateNetwork mocks base method.
+func (m *MockPowerVS) UpdateNetwork(id string, body *models.NetworkUpdate) (*models.Network, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateNetwork", id, body)
+	ret0, _ := ret[0].(*models.Network)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateNetwork indicates an expected call of UpdateNetwork.
+func (mr *MockPowerVSMockRecorder) UpdateNetwork(id, body any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNetwork", reflect.TypeOf((*MockPowerVS)(nil).UpdateNetwork), id, body)
+}
+
+// UpdateSnapshot mocks base method.
+func (m *MockPowerVS) UpdateSnapshot(id string, body *models.SnapshotUpdate) (models.Object, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSnapshot", id, body)
+	ret0, _ := ret[0].(models.Object)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSnapshot indicates an expected call of UpdateSnapshot.
+func (mr *MockPowerVSMockRecorder) UpdateSnapshot(id, body any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSnapshot", reflect.TypeOf((*MockPowerVS)(nil).UpdateSnapshot), id, body)
+}
+
+// UpdateVolume mocks base method.
+func (m *MockPowerVS) UpdateVolume(id string, body *models.UpdateVolume) (*models.Volume, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVolume", id, body)
+	ret0, _ := ret[0].(*models.Volume)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateVolume indicates an expected call of UpdateVolume.
+func (mr *MockPowerVSMockRecorder) UpdateVolume(id, body any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVolume", reflect.TypeOf((*MockPowerVS)(nil).UpdateVolume), id, body)
+}
+
+// VolumeAction mocks base method.
+func (m *MockPowerVS) VolumeAction(id string, body *models.VolumeAction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VolumeAction", id, body)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VolumeAction indicates an expected call of VolumeAction.
+func (mr *MockPowerVSMockRecorder) VolumeAction(id, body any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VolumeAction", reflect.TypeOf((*MockPowerVS)(nil).VolumeAction), id, body)
+}
+
 // WithClients mocks base method.
 func (m *MockPowerVS) WithClients(options powervs.ServiceOptions) *powervs.Service {
 	m.ctrl.T.Helper()
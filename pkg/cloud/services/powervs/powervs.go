@@ -27,14 +27,23 @@ import (
 type PowerVS interface {
 	CreateInstance(body *models.PVMInstanceCreate) (*models.PVMInstanceList, error)
 	DeleteInstance(id string) error
+	InstanceAction(id string, body *models.PVMInstanceAction) error
+	CaptureInstance(id string, body *models.PVMInstanceCapture) (*models.JobReference, error)
 	GetAllInstance() (*models.PVMInstances, error)
 	GetAllImage() (*models.Images, error)
 	GetAllNetwork() (*models.Networks, error)
 	GetNetworkByID(id string) (*models.Network, error)
+	CreateNetwork(body *models.NetworkCreate) (*models.Network, error)
+	UpdateNetwork(id string, body *models.NetworkUpdate) (*models.Network, error)
+	DeleteNetwork(id string) error
 	GetInstance(id string) (*models.PVMInstance, error)
 	GetImage(id string) (*models.Image, error)
 	DeleteImage(id string) error
 	CreateCosImage(body *models.CreateCosImageImportJob) (*models.JobReference, error)
+	CreateImage(body *models.CreateImage) (*models.Image, error)
+	ExportImage(id string, body *models.ExportImage) (*models.JobReference, error)
+	GetStockImage(id string) (*models.Image, error)
+	GetAllStockImages() (*models.Images, error)
 	GetCosImages(id string) (*models.Job, error)
 	GetJob(id string) (*models.Job, error)
 	DeleteJob(id string) error
@@ -42,7 +51,37 @@ type PowerVS interface {
 	GetDHCPServer(id string) (*models.DHCPServerDetail, error)
 	CreateDHCPServer(*models.DHCPServerCreate) (*models.DHCPServer, error)
 	DeleteDHCPServer(id string) error
+	GetSSHKey(name string) (*models.SSHKey, error)
+	CreateSSHKey(body *models.SSHKey) (*models.SSHKey, error)
+	DeleteSSHKey(name string) error
+	GetAllVolumes() (*models.Volumes, error)
+	CreateVolume(body *models.CreateDataVolume) (*models.Volume, error)
+	DeleteVolume(id string) error
+	GetAllInstanceVolumes(id string) (*models.Volumes, error)
+	DetachVolume(instanceID, volumeID string) error
+	GetVolume(id string) (*models.Volume, error)
+	UpdateVolume(id string, body *models.UpdateVolume) (*models.Volume, error)
+	AttachVolume(instanceID, volumeID string) error
+	GetInstanceVolume(instanceID, volumeID string) (*models.Volume, error)
+	UpdateInstanceVolume(instanceID, volumeID string, body *models.PVMInstanceVolumeUpdate) error
+	SetBootVolume(instanceID, volumeID string) error
+	VolumeAction(id string, body *models.VolumeAction) error
+	CloneVolumes(body *models.VolumesCloneAsyncRequest) (*models.CloneTaskReference, error)
+	GetCloneTask(id string) (*models.CloneTaskStatus, error)
+	CreateSnapshot(instanceID string, body *models.SnapshotCreate) (*models.SnapshotCreateResponse, error)
+	GetSnapshot(id string) (*models.Snapshot, error)
+	GetAllSnapshots() (*models.Snapshots, error)
+	GetAllInstanceSnapshots(instanceID string) (*models.Snapshots, error)
+	UpdateSnapshot(id string, body *models.SnapshotUpdate) (models.Object, error)
+	DeleteSnapshot(id string) error
+	RestoreSnapshot(instanceID, snapshotID, restoreFailAction string, body *models.SnapshotRestore) (*models.Snapshot, error)
+	GetVolumeSnapshot(id string) (*models.SnapshotV1, error)
+	GetAllVolumeSnapshots() (*models.VolumeSnapshotList, error)
 	WithClients(options ServiceOptions) *Service
 	GetNetworkByName(networkName string) (*models.NetworkReference, error)
+	GetImageByName(name string) (*models.ImageReference, error)
+	GetInstanceByName(name string) (*models.PVMInstanceReference, error)
 	GetDatacenterCapabilities(zone string) (map[string]bool, error)
+	GetAllPlacementGroups() (*models.PlacementGroups, error)
+	GetAllSharedProcessorPools() (*models.SharedProcessorPools, error)
 }
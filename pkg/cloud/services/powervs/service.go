@@ -25,21 +25,31 @@ import (
 	"github.com/IBM-Cloud/power-go-client/power/client/datacenters"
 	"github.com/IBM-Cloud/power-go-client/power/client/p_cloud_images"
 	"github.com/IBM-Cloud/power-go-client/power/models"
+	httptransport "github.com/go-openapi/runtime/client"
 
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/authenticator"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/utils"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/metrics"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/ratelimit"
 )
 
 var _ PowerVS = &Service{}
 
 // Service holds the PowerVS Service specific information.
 type Service struct {
-	session        *ibmpisession.IBMPISession
-	instanceClient *instance.IBMPIInstanceClient
-	networkClient  *instance.IBMPINetworkClient
-	imageClient    *instance.IBMPIImageClient
-	jobClient      *instance.IBMPIJobClient
-	dhcpClient     *instance.IBMPIDhcpClient
+	session                   *ibmpisession.IBMPISession
+	instanceClient            *instance.IBMPIInstanceClient
+	networkClient             *instance.IBMPINetworkClient
+	imageClient               *instance.IBMPIImageClient
+	jobClient                 *instance.IBMPIJobClient
+	dhcpClient                *instance.IBMPIDhcpClient
+	keyClient                 *instance.IBMPIKeyClient
+	volumeClient              *instance.IBMPIVolumeClient
+	cloneVolumeClient         *instance.IBMPICloneVolumeClient
+	snapshotClient            *instance.IBMPISnapshotClient
+	placementGroupClient      *instance.IBMPIPlacementGroupClient
+	sharedProcessorPoolClient *instance.IBMPISharedProcessorPoolClient
+	cloudInstanceID           string
 }
 
 // ServiceOptions holds the PowerVS Service Options specific information.
@@ -70,6 +80,9 @@ func NewService(options ServiceOptions) (PowerVS, error) {
 	if err != nil {
 		return nil, err
 	}
+	if rt, ok := session.Power.Transport.(*httptransport.Runtime); ok {
+		rt.Transport = ratelimit.Transport(metrics.ServicePowerVS, metrics.InstrumentTransport(metrics.ServicePowerVS, rt.Transport))
+	}
 
 	return &Service{
 		session: session,
@@ -84,22 +97,63 @@ func (s *Service) WithClients(options ServiceOptions) *Service {
 	s.imageClient = instance.NewIBMPIImageClient(ctx, s.session, options.CloudInstanceID)
 	s.jobClient = instance.NewIBMPIJobClient(ctx, s.session, options.CloudInstanceID)
 	s.dhcpClient = instance.NewIBMPIDhcpClient(ctx, s.session, options.CloudInstanceID)
+	s.keyClient = instance.NewIBMPIKeyClient(ctx, s.session, options.CloudInstanceID)
+	s.volumeClient = instance.NewIBMPIVolumeClient(ctx, s.session, options.CloudInstanceID)
+	s.cloneVolumeClient = instance.NewIBMPICloneVolumeClient(ctx, s.session, options.CloudInstanceID)
+	s.snapshotClient = instance.NewIBMPISnapshotClient(ctx, s.session, options.CloudInstanceID)
+	s.placementGroupClient = instance.NewIBMPIPlacementGroupClient(ctx, s.session, options.CloudInstanceID)
+	s.sharedProcessorPoolClient = instance.NewIBMPISharedProcessorPoolClient(ctx, s.session, options.CloudInstanceID)
+	s.cloudInstanceID = options.CloudInstanceID
 	return s
 }
 
 // CreateInstance creates the virtual machine in the Power VS service instance.
 func (s *Service) CreateInstance(body *models.PVMInstanceCreate) (*models.PVMInstanceList, error) {
+	defer s.invalidateNameIndex(instanceListKind)
 	return s.instanceClient.Create(body)
 }
 
 // DeleteInstance deletes the virtual machine in the Power VS service instance.
 func (s *Service) DeleteInstance(id string) error {
+	defer s.invalidateNameIndex(instanceListKind)
 	return s.instanceClient.Delete(id)
 }
 
+// InstanceAction performs the action on the virtual machine in the Power VS service instance.
+func (s *Service) InstanceAction(id string, body *models.PVMInstanceAction) error {
+	return s.instanceClient.Action(id, body)
+}
+
+// CaptureInstance captures the virtual machine into an image with a job in the Power VS service instance.
+func (s *Service) CaptureInstance(id string, body *models.PVMInstanceCapture) (*models.JobReference, error) {
+	defer s.invalidateNameIndex(imageListKind)
+	return s.instanceClient.CaptureInstanceToImageCatalogV2(id, body)
+}
+
 // GetAllInstance returns all the virtual machine in the Power VS service instance.
+// The virtual machines are always listed from the API, and refresh the name index used by GetInstanceByName.
 func (s *Service) GetAllInstance() (*models.PVMInstances, error) {
-	return s.instanceClient.GetAll()
+	instances, err := s.instanceClient.GetAll()
+	if err != nil {
+		return nil, err
+	}
+	s.indexInstances(instances)
+	return instances, nil
+}
+
+// GetInstanceByName returns the virtual machine with name in the Power VS service instance, nil if there is none.
+// The virtual machines are listed at most once per ListCacheTTL and indexed by name for the lookups of all the scopes
+// of the Power VS service instance, the API does not offer to filter or to paginate the list.
+// The index may miss a virtual machine created since it was refreshed, it must not be used to guard creations.
+func (s *Service) GetInstanceByName(name string) (*models.PVMInstanceReference, error) {
+	if obj, exists, _ := nameIndexCache.GetByKey(nameIndexKey(s.cloudInstanceID, instanceListKind)); exists {
+		return obj.(nameIndex).Instances[name], nil
+	}
+	instances, err := s.instanceClient.GetAll()
+	if err != nil {
+		return nil, err
+	}
+	return s.indexInstances(instances).Instances[name], nil
 }
 
 // GetInstance returns the virtual machine in the Power VS service instance.
@@ -113,20 +167,64 @@ func (s *Service) GetImage(id string) (*models.Image, error) {
 }
 
 // GetAllImage returns all the images in the Power VS service instance.
+// The images are always listed from the API, and refresh the name index used by GetImageByName.
 func (s *Service) GetAllImage() (*models.Images, error) {
-	return s.imageClient.GetAll()
+	images, err := s.imageClient.GetAll()
+	if err != nil {
+		return nil, err
+	}
+	s.indexImages(images)
+	return images, nil
+}
+
+// GetImageByName returns the image with name in the Power VS service instance, nil if there is none.
+// The images are listed at most once per ListCacheTTL and indexed by name for the lookups of all the scopes
+// of the Power VS service instance, the API does not offer to filter or to paginate the list.
+// The index may miss an image created since it was refreshed, it must not be used to guard creations.
+func (s *Service) GetImageByName(name string) (*models.ImageReference, error) {
+	if obj, exists, _ := nameIndexCache.GetByKey(nameIndexKey(s.cloudInstanceID, imageListKind)); exists {
+		return obj.(nameIndex).Images[name], nil
+	}
+	images, err := s.imageClient.GetAll()
+	if err != nil {
+		return nil, err
+	}
+	return s.indexImages(images).Images[name], nil
 }
 
 // DeleteImage deletes the image in the Power VS service instance.
 func (s *Service) DeleteImage(id string) error {
+	defer s.invalidateNameIndex(imageListKind)
 	return s.imageClient.Delete(id)
 }
 
 // CreateCosImage creates a import job to import the image in the Power VS service instance.
 func (s *Service) CreateCosImage(body *models.CreateCosImageImportJob) (*models.JobReference, error) {
+	defer s.invalidateNameIndex(imageListKind)
 	return s.imageClient.CreateCosImage(body)
 }
 
+// ExportImage exports the image of the Power VS service instance to a Cloud Object Storage bucket with a job.
+func (s *Service) ExportImage(id string, body *models.ExportImage) (*models.JobReference, error) {
+	return s.imageClient.ExportImage(id, body)
+}
+
+// CreateImage copies the stock image into the Power VS service instance.
+func (s *Service) CreateImage(body *models.CreateImage) (*models.Image, error) {
+	defer s.invalidateNameIndex(imageListKind)
+	return s.imageClient.Create(body)
+}
+
+// GetStockImage returns the stock image from the Power VS catalog.
+func (s *Service) GetStockImage(id string) (*models.Image, error) {
+	return s.imageClient.GetStockImage(id)
+}
+
+// GetAllStockImages returns all the stock images available in the Power VS catalog.
+func (s *Service) GetAllStockImages() (*models.Images, error) {
+	return s.imageClient.GetAllStockImages(false, false)
+}
+
 // GetCosImages returns the last import job in the Power VS service instance.
 func (s *Service) GetCosImages(id string) (*models.Job, error) {
 	params := p_cloud_images.NewPcloudV1CloudinstancesCosimagesGetParams().WithCloudInstanceID(id)
@@ -157,6 +255,137 @@ func (s *Service) GetNetworkByID(id string) (*models.Network, error) {
 	return s.networkClient.Get(id)
 }
 
+// CreateNetwork creates the network.
+func (s *Service) CreateNetwork(body *models.NetworkCreate) (*models.Network, error) {
+	return s.networkClient.Create(body)
+}
+
+// UpdateNetwork updates the network corresponding to given id.
+func (s *Service) UpdateNetwork(id string, body *models.NetworkUpdate) (*models.Network, error) {
+	return s.networkClient.Update(id, body)
+}
+
+// DeleteNetwork deletes the network corresponding to given id.
+func (s *Service) DeleteNetwork(id string) error {
+	return s.networkClient.Delete(id)
+}
+
+// GetAllVolumes returns all the volumes in the Power VS service instance.
+func (s *Service) GetAllVolumes() (*models.Volumes, error) {
+	return s.volumeClient.GetAll()
+}
+
+// CreateVolume creates the data volume in the Power VS service instance.
+func (s *Service) CreateVolume(body *models.CreateDataVolume) (*models.Volume, error) {
+	return s.volumeClient.CreateVolume(body)
+}
+
+// DeleteVolume deletes the volume with the given id in the Power VS service instance.
+func (s *Service) DeleteVolume(id string) error {
+	return s.volumeClient.DeleteVolume(id)
+}
+
+// GetAllInstanceVolumes returns all the volumes attached to the virtual machine in the Power VS service instance.
+func (s *Service) GetAllInstanceVolumes(id string) (*models.Volumes, error) {
+	return s.volumeClient.GetAllInstanceVolumes(id)
+}
+
+// DetachVolume detaches the volume with the given id from the virtual machine in the Power VS service instance.
+func (s *Service) DetachVolume(instanceID, volumeID string) error {
+	return s.volumeClient.Detach(instanceID, volumeID)
+}
+
+// GetVolume returns the volume with the given id in the Power VS service instance.
+func (s *Service) GetVolume(id string) (*models.Volume, error) {
+	return s.volumeClient.Get(id)
+}
+
+// UpdateVolume updates the volume with the given id in the Power VS service instance.
+func (s *Service) UpdateVolume(id string, body *models.UpdateVolume) (*models.Volume, error) {
+	return s.volumeClient.UpdateVolume(id, body)
+}
+
+// AttachVolume attaches the volume with the given id to the virtual machine in the Power VS service instance.
+func (s *Service) AttachVolume(instanceID, volumeID string) error {
+	return s.volumeClient.Attach(instanceID, volumeID)
+}
+
+// GetInstanceVolume returns the volume with the given id attached to the virtual machine in the Power VS service instance.
+func (s *Service) GetInstanceVolume(instanceID, volumeID string) (*models.Volume, error) {
+	return s.volumeClient.CheckVolumeAttach(instanceID, volumeID)
+}
+
+// UpdateInstanceVolume updates the attachment of the volume with the given id to the virtual machine in the Power VS service instance.
+func (s *Service) UpdateInstanceVolume(instanceID, volumeID string, body *models.PVMInstanceVolumeUpdate) error {
+	return s.volumeClient.UpdateVolumeAttach(instanceID, volumeID, body)
+}
+
+// SetBootVolume sets the volume with the given id as the boot volume of the virtual machine in the Power VS service instance.
+func (s *Service) SetBootVolume(instanceID, volumeID string) error {
+	return s.volumeClient.SetBootVolume(instanceID, volumeID)
+}
+
+// VolumeAction performs an action, e.g. resetting its state, on the volume with the given id in the Power VS service instance.
+func (s *Service) VolumeAction(id string, body *models.VolumeAction) error {
+	return s.volumeClient.VolumeAction(id, body)
+}
+
+// CloneVolumes starts an asynchronous clone of the volumes in the Power VS service instance.
+func (s *Service) CloneVolumes(body *models.VolumesCloneAsyncRequest) (*models.CloneTaskReference, error) {
+	return s.cloneVolumeClient.Create(body)
+}
+
+// GetCloneTask returns the status of the clone task with the given id in the Power VS service instance.
+func (s *Service) GetCloneTask(id string) (*models.CloneTaskStatus, error) {
+	return s.cloneVolumeClient.Get(id)
+}
+
+// CreateSnapshot creates a snapshot of the volumes of the virtual machine in the Power VS service instance.
+func (s *Service) CreateSnapshot(instanceID string, body *models.SnapshotCreate) (*models.SnapshotCreateResponse, error) {
+	return s.instanceClient.CreatePvmSnapShot(instanceID, body)
+}
+
+// GetSnapshot returns the snapshot with the given id in the Power VS service instance.
+func (s *Service) GetSnapshot(id string) (*models.Snapshot, error) {
+	return s.snapshotClient.Get(id)
+}
+
+// GetAllSnapshots returns all the snapshots in the Power VS service instance.
+func (s *Service) GetAllSnapshots() (*models.Snapshots, error) {
+	return s.snapshotClient.GetAll()
+}
+
+// GetAllInstanceSnapshots returns all the snapshots of the virtual machine in the Power VS service instance.
+func (s *Service) GetAllInstanceSnapshots(instanceID string) (*models.Snapshots, error) {
+	return s.instanceClient.GetSnapShotVM(instanceID)
+}
+
+// UpdateSnapshot updates the snapshot with the given id in the Power VS service instance.
+func (s *Service) UpdateSnapshot(id string, body *models.SnapshotUpdate) (models.Object, error) {
+	return s.snapshotClient.Update(id, body)
+}
+
+// DeleteSnapshot deletes the snapshot with the given id in the Power VS service instance.
+func (s *Service) DeleteSnapshot(id string) error {
+	return s.snapshotClient.Delete(id)
+}
+
+// RestoreSnapshot restores the volumes of the virtual machine from the snapshot with the given id in the Power VS service instance.
+// restoreFailAction is the action to take when a previous restore failed, either retry or rollback.
+func (s *Service) RestoreSnapshot(instanceID, snapshotID, restoreFailAction string, body *models.SnapshotRestore) (*models.Snapshot, error) {
+	return s.instanceClient.RestoreSnapShotVM(instanceID, snapshotID, restoreFailAction, body)
+}
+
+// GetVolumeSnapshot returns the volume snapshot with the given id in the Power VS service instance.
+func (s *Service) GetVolumeSnapshot(id string) (*models.SnapshotV1, error) {
+	return s.snapshotClient.V1VolumeSnapshotsGet(id)
+}
+
+// GetAllVolumeSnapshots returns all the volume snapshots in the Power VS service instance.
+func (s *Service) GetAllVolumeSnapshots() (*models.VolumeSnapshotList, error) {
+	return s.snapshotClient.V1VolumeSnapshotsGetall()
+}
+
 // GetAllDHCPServers returns all the DHCP servers in the Power VS service instance.
 func (s *Service) GetAllDHCPServers() (models.DHCPServers, error) {
 	return s.dhcpClient.GetAll()
@@ -177,6 +406,21 @@ func (s *Service) DeleteDHCPServer(id string) error {
 	return s.dhcpClient.Delete(id)
 }
 
+// GetSSHKey returns the SSH key associated with name.
+func (s *Service) GetSSHKey(name string) (*models.SSHKey, error) {
+	return s.keyClient.Get(name)
+}
+
+// CreateSSHKey creates a new SSH key.
+func (s *Service) CreateSSHKey(body *models.SSHKey) (*models.SSHKey, error) {
+	return s.keyClient.Create(body)
+}
+
+// DeleteSSHKey deletes the SSH key associated with name.
+func (s *Service) DeleteSSHKey(name string) error {
+	return s.keyClient.Delete(name)
+}
+
 // GetNetworkByName fetches the network with name. If not found, returns nil.
 func (s *Service) GetNetworkByName(networkName string) (*models.NetworkReference, error) {
 	var network *models.NetworkReference
@@ -193,6 +437,48 @@ func (s *Service) GetNetworkByName(networkName string) (*models.NetworkReference
 	return network, nil
 }
 
+// indexImages stores the name index of the images of the Power VS service instance, the first image wins
+// when several images have the same name.
+func (s *Service) indexImages(images *models.Images) nameIndex {
+	index := nameIndex{Key: nameIndexKey(s.cloudInstanceID, imageListKind), Images: map[string]*models.ImageReference{}}
+	if images != nil {
+		for _, image := range images.Images {
+			if image == nil || image.Name == nil {
+				continue
+			}
+			if _, ok := index.Images[*image.Name]; !ok {
+				index.Images[*image.Name] = image
+			}
+		}
+	}
+	_ = nameIndexCache.Add(index)
+	return index
+}
+
+// indexInstances stores the name index of the virtual machines of the Power VS service instance, the first virtual
+// machine wins when several virtual machines have the same name.
+func (s *Service) indexInstances(instances *models.PVMInstances) nameIndex {
+	index := nameIndex{Key: nameIndexKey(s.cloudInstanceID, instanceListKind), Instances: map[string]*models.PVMInstanceReference{}}
+	if instances != nil {
+		for _, ins := range instances.PvmInstances {
+			if ins == nil || ins.ServerName == nil {
+				continue
+			}
+			if _, ok := index.Instances[*ins.ServerName]; !ok {
+				index.Instances[*ins.ServerName] = ins
+			}
+		}
+	}
+	_ = nameIndexCache.Add(index)
+	return index
+}
+
+// invalidateNameIndex drops the name index of kind of the Power VS service instance so that the next lookup
+// lists the resources again.
+func (s *Service) invalidateNameIndex(kind string) {
+	_ = nameIndexCache.Delete(nameIndex{Key: nameIndexKey(s.cloudInstanceID, kind)})
+}
+
 // GetDatacenterCapabilities fetches the datacenter capabilities for the given zone.
 func (s *Service) GetDatacenterCapabilities(zone string) (map[string]bool, error) {
 	// though the function name is WithDatacenterRegion it takes zone as parameter
@@ -206,3 +492,13 @@ func (s *Service) GetDatacenterCapabilities(zone string) (map[string]bool, error
 	}
 	return datacenter.Payload.Capabilities, nil
 }
+
+// GetAllPlacementGroups returns all the server placement groups in the Power VS service instance.
+func (s *Service) GetAllPlacementGroups() (*models.PlacementGroups, error) {
+	return s.placementGroupClient.GetAll()
+}
+
+// GetAllSharedProcessorPools returns all the shared processor pools in the Power VS service instance.
+func (s *Service) GetAllSharedProcessorPools() (*models.SharedProcessorPools, error) {
+	return s.sharedProcessorPoolClient.GetAll()
+}
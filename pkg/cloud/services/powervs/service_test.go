@@ -0,0 +1,315 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powervs
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/IBM-Cloud/power-go-client/ibmpisession"
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/stretchr/testify/require"
+
+	"k8s.io/utils/ptr"
+)
+
+const (
+	testCloudInstanceID   = "cloud-instance-id"
+	testCloudInstancePath = "/pcloud/v1/cloud-instances/" + testCloudInstanceID
+)
+
+// newTestService returns a service sending its requests to the given server.
+func newTestService(t *testing.T, server *httptest.Server) PowerVS {
+	t.Helper()
+	options := ServiceOptions{
+		IBMPIOptions: &ibmpisession.IBMPIOptions{
+			Authenticator: &core.BearerTokenAuthenticator{BearerToken: "token"},
+			UserAccount:   "account",
+			Zone:          "dal10",
+			URL:           server.URL,
+		},
+		CloudInstanceID: testCloudInstanceID,
+	}
+	service, err := NewService(options)
+	require.NoError(t, err)
+	return service.WithClients(options)
+}
+
+func TestVolumeAndSnapshotWrappers(t *testing.T) {
+	testCases := []struct {
+		name   string
+		call   func(PowerVS) error
+		status int
+		method string
+		path   string
+	}{
+		{
+			name: "GetVolume",
+			call: func(s PowerVS) error {
+				_, err := s.GetVolume("volume-id")
+				return err
+			},
+			method: http.MethodGet,
+			path:   testCloudInstancePath + "/volumes/volume-id",
+		},
+		{
+			name: "UpdateVolume",
+			call: func(s PowerVS) error {
+				_, err := s.UpdateVolume("volume-id", &models.UpdateVolume{Name: ptr.To("volume")})
+				return err
+			},
+			method: http.MethodPut,
+			path:   testCloudInstancePath + "/volumes/volume-id",
+		},
+		{
+			name: "AttachVolume",
+			call: func(s PowerVS) error {
+				return s.AttachVolume("instance-id", "volume-id")
+			},
+			method: http.MethodPost,
+			path:   testCloudInstancePath + "/pvm-instances/instance-id/volumes/volume-id",
+		},
+		{
+			name: "GetInstanceVolume",
+			call: func(s PowerVS) error {
+				_, err := s.GetInstanceVolume("instance-id", "volume-id")
+				return err
+			},
+			method: http.MethodGet,
+			path:   testCloudInstancePath + "/pvm-instances/instance-id/volumes/volume-id",
+		},
+		{
+			name: "UpdateInstanceVolume",
+			call: func(s PowerVS) error {
+				return s.UpdateInstanceVolume("instance-id", "volume-id", &models.PVMInstanceVolumeUpdate{DeleteOnTermination: ptr.To(true)})
+			},
+			method: http.MethodPut,
+			path:   testCloudInstancePath + "/pvm-instances/instance-id/volumes/volume-id",
+		},
+		{
+			name: "SetBootVolume",
+			call: func(s PowerVS) error {
+				return s.SetBootVolume("instance-id", "volume-id")
+			},
+			method: http.MethodPut,
+			path:   testCloudInstancePath + "/pvm-instances/instance-id/volumes/volume-id/setboot",
+		},
+		{
+			name: "VolumeAction",
+			call: func(s PowerVS) error {
+				return s.VolumeAction("volume-id", &models.VolumeAction{ReplicationEnabled: ptr.To(false)})
+			},
+			status: http.StatusAccepted,
+			method: http.MethodPost,
+			path:   testCloudInstancePath + "/volumes/volume-id/action",
+		},
+		{
+			name: "CloneVolumes",
+			call: func(s PowerVS) error {
+				_, err := s.CloneVolumes(&models.VolumesCloneAsyncRequest{Name: ptr.To("clone"), VolumeIDs: []string{"volume-id"}})
+				return err
+			},
+			status: http.StatusAccepted,
+			method: http.MethodPost,
+			path:   "/pcloud/v2/cloud-instances/" + testCloudInstanceID + "/volumes/clone",
+		},
+		{
+			name: "GetCloneTask",
+			call: func(s PowerVS) error {
+				_, err := s.GetCloneTask("clone-task-id")
+				return err
+			},
+			method: http.MethodGet,
+			path:   "/pcloud/v2/cloud-instances/" + testCloudInstanceID + "/volumes/clone-tasks/clone-task-id",
+		},
+		{
+			name: "CreateSnapshot",
+			call: func(s PowerVS) error {
+				_, err := s.CreateSnapshot("instance-id", &models.SnapshotCreate{Name: ptr.To("snapshot")})
+				return err
+			},
+			status: http.StatusAccepted,
+			method: http.MethodPost,
+			path:   testCloudInstancePath + "/pvm-instances/instance-id/snapshots",
+		},
+		{
+			name: "GetSnapshot",
+			call: func(s PowerVS) error {
+				_, err := s.GetSnapshot("snapshot-id")
+				return err
+			},
+			method: http.MethodGet,
+			path:   testCloudInstancePath + "/snapshots/snapshot-id",
+		},
+		{
+			name: "GetAllSnapshots",
+			call: func(s PowerVS) error {
+				_, err := s.GetAllSnapshots()
+				return err
+			},
+			method: http.MethodGet,
+			path:   testCloudInstancePath + "/snapshots",
+		},
+		{
+			name: "GetAllInstanceSnapshots",
+			call: func(s PowerVS) error {
+				_, err := s.GetAllInstanceSnapshots("instance-id")
+				return err
+			},
+			method: http.MethodGet,
+			path:   testCloudInstancePath + "/pvm-instances/instance-id/snapshots",
+		},
+		{
+			name: "UpdateSnapshot",
+			call: func(s PowerVS) error {
+				_, err := s.UpdateSnapshot("snapshot-id", &models.SnapshotUpdate{Name: "snapshot"})
+				return err
+			},
+			method: http.MethodPut,
+			path:   testCloudInstancePath + "/snapshots/snapshot-id",
+		},
+		{
+			name: "DeleteSnapshot",
+			call: func(s PowerVS) error {
+				return s.DeleteSnapshot("snapshot-id")
+			},
+			status: http.StatusAccepted,
+			method: http.MethodDelete,
+			path:   testCloudInstancePath + "/snapshots/snapshot-id",
+		},
+		{
+			name: "RestoreSnapshot",
+			call: func(s PowerVS) error {
+				_, err := s.RestoreSnapshot("instance-id", "snapshot-id", "retry", &models.SnapshotRestore{})
+				return err
+			},
+			status: http.StatusAccepted,
+			method: http.MethodPost,
+			path:   testCloudInstancePath + "/pvm-instances/instance-id/snapshots/snapshot-id/restore",
+		},
+		{
+			name: "GetVolumeSnapshot",
+			call: func(s PowerVS) error {
+				_, err := s.GetVolumeSnapshot("volume-snapshot-id")
+				return err
+			},
+			method: http.MethodGet,
+			path:   "/v1/volume-snapshots/volume-snapshot-id",
+		},
+		{
+			name: "GetAllVolumeSnapshots",
+			call: func(s PowerVS) error {
+				_, err := s.GetAllVolumeSnapshots()
+				return err
+			},
+			method: http.MethodGet,
+			path:   "/v1/volume-snapshots",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var method, path string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				method, path = r.Method, r.URL.Path
+				status := tc.status
+				if status == 0 {
+					status = http.StatusOK
+				}
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(status)
+				_, _ = w.Write([]byte("{}"))
+			}))
+			defer server.Close()
+
+			err := tc.call(newTestService(t, server))
+			require.NoError(t, err)
+			require.Equal(t, tc.method, method)
+			require.Equal(t, tc.path, path)
+		})
+	}
+}
+
+func TestVolumeWrapperError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"description":"volume not found"}`))
+	}))
+	defer server.Close()
+
+	_, err := newTestService(t, server).GetVolume("volume-id")
+	require.ErrorContains(t, err, "volume-id")
+}
+
+func TestGetImageAndInstanceByName(t *testing.T) {
+	lists := map[string]int{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		if r.Method != http.MethodGet {
+			_, _ = w.Write([]byte("{}"))
+			return
+		}
+		lists[r.URL.Path]++
+		switch r.URL.Path {
+		case testCloudInstancePath + "/images":
+			_, _ = w.Write([]byte(`{"images":[{"imageID":"image-id","name":"image"},{"imageID":"image-id-2","name":"image"}]}`))
+		case testCloudInstancePath + "/pvm-instances":
+			_, _ = w.Write([]byte(`{"pvmInstances":[{"pvmInstanceID":"instance-id","serverName":"instance"}]}`))
+		default:
+			_, _ = w.Write([]byte("{}"))
+		}
+	}))
+	defer server.Close()
+
+	s := newTestService(t, server)
+	s.(*Service).invalidateNameIndex(imageListKind)
+	s.(*Service).invalidateNameIndex(instanceListKind)
+
+	image, err := s.GetImageByName("image")
+	require.NoError(t, err)
+	require.Equal(t, "image-id", *image.ImageID)
+	image, err = s.GetImageByName("foo")
+	require.NoError(t, err)
+	require.Nil(t, image)
+	require.Equal(t, 1, lists[testCloudInstancePath+"/images"])
+
+	instance, err := s.GetInstanceByName("instance")
+	require.NoError(t, err)
+	require.Equal(t, "instance-id", *instance.PvmInstanceID)
+	_, err = newTestService(t, server).GetInstanceByName("instance")
+	require.NoError(t, err)
+	require.Equal(t, 1, lists[testCloudInstancePath+"/pvm-instances"])
+
+	require.NoError(t, s.DeleteImage("image-id"))
+	_, err = s.GetImageByName("image")
+	require.NoError(t, err)
+	require.Equal(t, 2, lists[testCloudInstancePath+"/images"])
+
+	require.NoError(t, s.DeleteInstance("instance-id"))
+	_, err = s.GetInstanceByName("instance")
+	require.NoError(t, err)
+	require.Equal(t, 2, lists[testCloudInstancePath+"/pvm-instances"])
+
+	_, err = s.GetAllImage()
+	require.NoError(t, err)
+	_, err = s.GetImageByName("image")
+	require.NoError(t, err)
+	require.Equal(t, 3, lists[testCloudInstancePath+"/images"])
+}
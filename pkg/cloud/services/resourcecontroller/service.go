@@ -26,6 +26,8 @@ import (
 
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/authenticator"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/utils"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/metrics"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/ratelimit"
 )
 
 const (
@@ -212,6 +214,8 @@ func NewService(options ServiceOptions) (ResourceController, error) {
 	if err != nil {
 		return nil, err
 	}
+	metrics.InstrumentService(metrics.ServiceResourceController, service.Service)
+	ratelimit.LimitService(metrics.ServiceResourceController, service.Service)
 	return &Service{
 		client: service,
 	}, nil
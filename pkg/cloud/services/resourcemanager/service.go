@@ -25,6 +25,7 @@ import (
 
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/authenticator"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/utils"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/metrics"
 )
 
 // Service holds the IBM Cloud Resource Manager Service specific information.
@@ -48,6 +49,7 @@ func NewService(options *resourcemanagerv2.ResourceManagerV2Options) (ResourceMa
 	if err != nil {
 		return nil, err
 	}
+	metrics.InstrumentService(metrics.ServiceResourceManager, rmClient.Service)
 	return &Service{
 		client: rmClient,
 	}, nil
@@ -63,9 +65,9 @@ func (s *Service) ListResourceGroups(listResourceGroupsOptions *resourcemanagerv
 	return s.client.ListResourceGroups(listResourceGroupsOptions)
 }
 
-// GetResourceGroupByName returns the Resource Group with the provided name, if found.
+// GetResourceGroupByName returns the Resource Group with the provided name in the account of the service credentials, if found.
 func (s *Service) GetResourceGroupByName(rgName string) (*resourcemanagerv2.ResourceGroup, error) {
-	accountID, err := utils.GetAccountID()
+	accountID, err := utils.GetAccount(s.client.Service.Options.Authenticator)
 	if err != nil {
 		return nil, fmt.Errorf("failed getting account id for resource group lookup: %w", err)
 	}
@@ -27,6 +27,7 @@ import (
 
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/authenticator"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/utils"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/metrics"
 )
 
 var currentDate = fmt.Sprintf("%d-%02d-%02d", time.Now().Year(), time.Now().Month(), time.Now().Day())
@@ -53,6 +54,7 @@ func NewService(options *tgapiv1.TransitGatewayApisV1Options) (TransitGateway, e
 	if err != nil {
 		return nil, err
 	}
+	metrics.InstrumentService(metrics.ServiceTransitGateway, tgClient.Service)
 
 	return &Service{
 		tgClient: tgClient,
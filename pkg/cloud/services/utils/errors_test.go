@@ -0,0 +1,93 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/ibm-cos-sdk-go/aws/awserr"
+	"github.com/IBM/ibm-cos-sdk-go/aws/request"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestIsServiceUnreachable(t *testing.T) {
+	connectionErr := &url.Error{Op: "Get", URL: "https://transit.cloud.ibm.com", Err: errors.New("connection refused")}
+	testCases := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{
+			name: "Nil error",
+		},
+		{
+			name:     "Connection error",
+			err:      fmt.Errorf("failed to get transit gateway: %w", connectionErr),
+			expected: true,
+		},
+		{
+			name:     "Connection error wrapped by the SDK",
+			err:      core.SDKErrorf(connectionErr, "", "no-connection-made", &core.ProblemComponent{Name: "test"}),
+			expected: true,
+		},
+		{
+			name:     "Timeout",
+			err:      fmt.Errorf("failed to get transit gateway: %w", context.DeadlineExceeded),
+			expected: true,
+		},
+		{
+			name:     "Service unavailable response",
+			err:      &core.HTTPProblem{IBMProblem: &core.IBMProblem{Summary: "unavailable", Component: &core.ProblemComponent{Name: "test"}}, Response: &core.DetailedResponse{StatusCode: http.StatusServiceUnavailable}},
+			expected: true,
+		},
+		{
+			name: "Not found response",
+			err:  &core.HTTPProblem{IBMProblem: &core.IBMProblem{Summary: "not found", Component: &core.ProblemComponent{Name: "test"}}, Response: &core.DetailedResponse{StatusCode: http.StatusNotFound}},
+		},
+		{
+			name:     "COS service unavailable response",
+			err:      awserr.NewRequestFailure(awserr.New("ServiceUnavailable", "unavailable", nil), http.StatusServiceUnavailable, "request-id"),
+			expected: true,
+		},
+		{
+			name: "COS access denied response",
+			err:  awserr.NewRequestFailure(awserr.New("AccessDenied", "access denied", nil), http.StatusForbidden, "request-id"),
+		},
+		{
+			name:     "COS request error",
+			err:      awserr.New(request.ErrCodeRequestError, "send request failed", errors.New("no such host")),
+			expected: true,
+		},
+		{
+			name: "Other error",
+			err:  errors.New("invalid transit gateway name"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(IsServiceUnreachable(tc.err)).To(Equal(tc.expected))
+		})
+	}
+}
@@ -87,6 +87,22 @@ func (mr *MockVpcMockRecorder) CreateInstance(options any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInstance", reflect.TypeOf((*MockVpc)(nil).CreateInstance), options)
 }
 
+// CreateInstanceAction mocks base method.
+func (m *MockVpc) CreateInstanceAction(options *vpcv1.CreateInstanceActionOptions) (*vpcv1.InstanceAction, *core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateInstanceAction", options)
+	ret0, _ := ret[0].(*vpcv1.InstanceAction)
+	ret1, _ := ret[1].(*core.DetailedResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateInstanceAction indicates an expected call of CreateInstanceAction.
+func (mr *MockVpcMockRecorder) CreateInstanceAction(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInstanceAction", reflect.TypeOf((*MockVpc)(nil).CreateInstanceAction), options)
+}
+
 // CreateLoadBalancer mocks base method.
 func (m *MockVpc) CreateLoadBalancer(options *vpcv1.CreateLoadBalancerOptions) (*vpcv1.LoadBalancer, *core.DetailedResponse, error) {
 	m.ctrl.T.Helper()
@@ -214,6 +230,21 @@ func (mr *MockVpcMockRecorder) DeleteInstance(options any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteInstance", reflect.TypeOf((*MockVpc)(nil).DeleteInstance), options)
 }
 
+// DeleteInstanceVolumeAttachment mocks base method.
+func (m *MockVpc) DeleteInstanceVolumeAttachment(options *vpcv1.DeleteInstanceVolumeAttachmentOptions) (*core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteInstanceVolumeAttachment", options)
+	ret0, _ := ret[0].(*core.DetailedResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteInstanceVolumeAttachment indicates an expected call of DeleteInstanceVolumeAttachment.
+func (mr *MockVpcMockRecorder) DeleteInstanceVolumeAttachment(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteInstanceVolumeAttachment", reflect.TypeOf((*MockVpc)(nil).DeleteInstanceVolumeAttachment), options)
+}
+
 // DeleteLoadBalancer mocks base method.
 func (m *MockVpc) DeleteLoadBalancer(options *vpcv1.DeleteLoadBalancerOptions) (*core.DetailedResponse, error) {
 	m.ctrl.T.Helper()
@@ -304,6 +335,22 @@ func (mr *MockVpcMockRecorder) DeleteVPC(options any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVPC", reflect.TypeOf((*MockVpc)(nil).DeleteVPC), options)
 }
 
+// GetDedicatedHost mocks base method.
+func (m *MockVpc) GetDedicatedHost(options *vpcv1.GetDedicatedHostOptions) (*vpcv1.DedicatedHost, *core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDedicatedHost", options)
+	ret0, _ := ret[0].(*vpcv1.DedicatedHost)
+	ret1, _ := ret[1].(*core.DetailedResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetDedicatedHost indicates an expected call of GetDedicatedHost.
+func (mr *MockVpcMockRecorder) GetDedicatedHost(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDedicatedHost", reflect.TypeOf((*MockVpc)(nil).GetDedicatedHost), options)
+}
+
 // GetDedicatedHostByName mocks base method.
 func (m *MockVpc) GetDedicatedHostByName(dHostName string) (*vpcv1.DedicatedHost, error) {
 	m.ctrl.T.Helper()
@@ -319,6 +366,37 @@ func (mr *MockVpcMockRecorder) GetDedicatedHostByName(dHostName any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDedicatedHostByName", reflect.TypeOf((*MockVpc)(nil).GetDedicatedHostByName), dHostName)
 }
 
+// GetDedicatedHostGroup mocks base method.
+func (m *MockVpc) GetDedicatedHostGroup(options *vpcv1.GetDedicatedHostGroupOptions) (*vpcv1.DedicatedHostGroup, *core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDedicatedHostGroup", options)
+	ret0, _ := ret[0].(*vpcv1.DedicatedHostGroup)
+	ret1, _ := ret[1].(*core.DetailedResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetDedicatedHostGroup indicates an expected call of GetDedicatedHostGroup.
+func (mr *MockVpcMockRecorder) GetDedicatedHostGroup(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDedicatedHostGroup", reflect.TypeOf((*MockVpc)(nil).GetDedicatedHostGroup), options)
+}
+
+// GetDedicatedHostGroupByName mocks base method.
+func (m *MockVpc) GetDedicatedHostGroupByName(dHostGroupName string) (*vpcv1.DedicatedHostGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDedicatedHostGroupByName", dHostGroupName)
+	ret0, _ := ret[0].(*vpcv1.DedicatedHostGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDedicatedHostGroupByName indicates an expected call of GetDedicatedHostGroupByName.
+func (mr *MockVpcMockRecorder) GetDedicatedHostGroupByName(dHostGroupName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDedicatedHostGroupByName", reflect.TypeOf((*MockVpc)(nil).GetDedicatedHostGroupByName), dHostGroupName)
+}
+
 // GetImage mocks base method.
 func (m *MockVpc) GetImage(options *vpcv1.GetImageOptions) (*vpcv1.Image, *core.DetailedResponse, error) {
 	m.ctrl.T.Helper()
@@ -428,6 +506,68 @@ func (mr *MockVpcMockRecorder) GetLoadBalancerPoolByName(loadBalancerID, poolNam
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoadBalancerPoolByName", reflect.TypeOf((*MockVpc)(nil).GetLoadBalancerPoolByName), loadBalancerID, poolName)
 }
 
+// GetPlacementGroup mocks base method.
+func (m *MockVpc) GetPlacementGroup(options *vpcv1.GetPlacementGroupOptions) (*vpcv1.PlacementGroup, *core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPlacementGroup", options)
+	ret0, _ := ret[0].(*vpcv1.PlacementGroup)
+	ret1, _ := ret[1].(*core.DetailedResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetPlacementGroup indicates an expected call of GetPlacementGroup.
+func (mr *MockVpcMockRecorder) GetPlacementGroup(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPlacementGroup", reflect.TypeOf((*MockVpc)(nil).GetPlacementGroup), options)
+}
+
+// GetPlacementGroupByName mocks base method.
+func (m *MockVpc) GetPlacementGroupByName(placementGroupName string) (*vpcv1.PlacementGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPlacementGroupByName", placementGroupName)
+	ret0, _ := ret[0].(*vpcv1.PlacementGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPlacementGroupByName indicates an expected call of GetPlacementGroupByName.
+func (mr *MockVpcMockRecorder) GetPlacementGroupByName(placementGroupName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPlacementGroupByName", reflect.TypeOf((*MockVpc)(nil).GetPlacementGroupByName), placementGroupName)
+}
+
+// GetPublicGateway mocks base method.
+func (m *MockVpc) GetPublicGateway(options *vpcv1.GetPublicGatewayOptions) (*vpcv1.PublicGateway, *core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPublicGateway", options)
+	ret0, _ := ret[0].(*vpcv1.PublicGateway)
+	ret1, _ := ret[1].(*core.DetailedResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetPublicGateway indicates an expected call of GetPublicGateway.
+func (mr *MockVpcMockRecorder) GetPublicGateway(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPublicGateway", reflect.TypeOf((*MockVpc)(nil).GetPublicGateway), options)
+}
+
+// GetReservationByName mocks base method.
+func (m *MockVpc) GetReservationByName(reservationName string) (*vpcv1.Reservation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReservationByName", reservationName)
+	ret0, _ := ret[0].(*vpcv1.Reservation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReservationByName indicates an expected call of GetReservationByName.
+func (mr *MockVpcMockRecorder) GetReservationByName(reservationName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReservationByName", reflect.TypeOf((*MockVpc)(nil).GetReservationByName), reservationName)
+}
+
 // GetSecurityGroup mocks base method.
 func (m *MockVpc) GetSecurityGroup(options *vpcv1.GetSecurityGroupOptions) (*vpcv1.SecurityGroup, *core.DetailedResponse, error) {
 	m.ctrl.T.Helper()
@@ -599,6 +739,22 @@ func (mr *MockVpcMockRecorder) ListImages(options any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListImages", reflect.TypeOf((*MockVpc)(nil).ListImages), options)
 }
 
+// ListInstanceVolumeAttachments mocks base method.
+func (m *MockVpc) ListInstanceVolumeAttachments(options *vpcv1.ListInstanceVolumeAttachmentsOptions) (*vpcv1.VolumeAttachmentCollection, *core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListInstanceVolumeAttachments", options)
+	ret0, _ := ret[0].(*vpcv1.VolumeAttachmentCollection)
+	ret1, _ := ret[1].(*core.DetailedResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListInstanceVolumeAttachments indicates an expected call of ListInstanceVolumeAttachments.
+func (mr *MockVpcMockRecorder) ListInstanceVolumeAttachments(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInstanceVolumeAttachments", reflect.TypeOf((*MockVpc)(nil).ListInstanceVolumeAttachments), options)
+}
+
 // ListInstances mocks base method.
 func (m *MockVpc) ListInstances(options *vpcv1.ListInstancesOptions) (*vpcv1.InstanceCollection, *core.DetailedResponse, error) {
 	m.ctrl.T.Helper()
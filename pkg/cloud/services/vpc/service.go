@@ -24,6 +24,8 @@ import (
 
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/authenticator"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/utils"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/metrics"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/ratelimit"
 )
 
 // SecurityGroupByNameNotFound represents an error when security group is not found by name.
@@ -60,6 +62,26 @@ func (s *Service) ListInstances(options *vpcv1.ListInstancesOptions) (*vpcv1.Ins
 	return s.vpcService.ListInstances(options)
 }
 
+// CreateInstanceAction creates an action, such as stop, for a virtual server instance.
+func (s *Service) CreateInstanceAction(options *vpcv1.CreateInstanceActionOptions) (*vpcv1.InstanceAction, *core.DetailedResponse, error) {
+	return s.vpcService.CreateInstanceAction(options)
+}
+
+// ListInstanceVolumeAttachments returns list of volume attachments of a virtual server instance.
+func (s *Service) ListInstanceVolumeAttachments(options *vpcv1.ListInstanceVolumeAttachmentsOptions) (*vpcv1.VolumeAttachmentCollection, *core.DetailedResponse, error) {
+	return s.vpcService.ListInstanceVolumeAttachments(options)
+}
+
+// DeleteInstanceVolumeAttachment detaches a volume from a virtual server instance.
+func (s *Service) DeleteInstanceVolumeAttachment(options *vpcv1.DeleteInstanceVolumeAttachmentOptions) (*core.DetailedResponse, error) {
+	return s.vpcService.DeleteInstanceVolumeAttachment(options)
+}
+
+// GetDedicatedHost returns the Dedicated Host.
+func (s *Service) GetDedicatedHost(options *vpcv1.GetDedicatedHostOptions) (*vpcv1.DedicatedHost, *core.DetailedResponse, error) {
+	return s.vpcService.GetDedicatedHost(options)
+}
+
 // GetDedicatedHostByName returns Dedicated Host with given name. If not found, returns nil.
 func (s *Service) GetDedicatedHostByName(dHostName string) (*vpcv1.DedicatedHost, error) {
 	var dHost *vpcv1.DedicatedHost
@@ -99,6 +121,99 @@ func (s *Service) GetDedicatedHostByName(dHostName string) (*vpcv1.DedicatedHost
 	return dHost, nil
 }
 
+// GetDedicatedHostGroup returns the Dedicated Host Group.
+func (s *Service) GetDedicatedHostGroup(options *vpcv1.GetDedicatedHostGroupOptions) (*vpcv1.DedicatedHostGroup, *core.DetailedResponse, error) {
+	return s.vpcService.GetDedicatedHostGroup(options)
+}
+
+// GetDedicatedHostGroupByName returns Dedicated Host Group with given name. If not found, returns nil.
+func (s *Service) GetDedicatedHostGroupByName(dHostGroupName string) (*vpcv1.DedicatedHostGroup, error) {
+	listDedicatedHostGroupsOptions := &vpcv1.ListDedicatedHostGroupsOptions{
+		Name: &dHostGroupName,
+	}
+	dHostGroupsList, _, err := s.vpcService.ListDedicatedHostGroups(listDedicatedHostGroupsOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	if dHostGroupsList == nil {
+		return nil, fmt.Errorf("dedicated host groups list returned is nil")
+	}
+
+	for index, dHostGroup := range dHostGroupsList.Groups {
+		if *dHostGroup.Name == dHostGroupName {
+			return &dHostGroupsList.Groups[index], nil
+		}
+	}
+	return nil, nil
+}
+
+// GetPlacementGroup returns the Placement Group.
+func (s *Service) GetPlacementGroup(options *vpcv1.GetPlacementGroupOptions) (*vpcv1.PlacementGroup, *core.DetailedResponse, error) {
+	return s.vpcService.GetPlacementGroup(options)
+}
+
+// GetPlacementGroupByName returns Placement Group with given name. If not found, returns nil.
+func (s *Service) GetPlacementGroupByName(placementGroupName string) (*vpcv1.PlacementGroup, error) {
+	var placementGroup *vpcv1.PlacementGroup
+	f := func(start string) (bool, string, error) {
+		// check for existing Placement Groups
+		listPlacementGroupsOptions := &vpcv1.ListPlacementGroupsOptions{}
+		if start != "" {
+			listPlacementGroupsOptions.Start = &start
+		}
+
+		placementGroupsList, _, err := s.vpcService.ListPlacementGroups(listPlacementGroupsOptions)
+		if err != nil {
+			return false, "", err
+		}
+
+		if placementGroupsList == nil {
+			return false, "", fmt.Errorf("placement groups list returned is nil")
+		}
+
+		for index, pG := range placementGroupsList.PlacementGroups {
+			if *pG.Name == placementGroupName {
+				placementGroup = &placementGroupsList.PlacementGroups[index]
+				return true, "", nil
+			}
+		}
+
+		if placementGroupsList.Next != nil && *placementGroupsList.Next.Href != "" {
+			return false, *placementGroupsList.Next.Href, nil
+		}
+		return true, "", nil
+	}
+
+	if err := utils.PagingHelper(f); err != nil {
+		return nil, err
+	}
+
+	return placementGroup, nil
+}
+
+// GetReservationByName returns Reservation with given name. If not found, returns nil.
+func (s *Service) GetReservationByName(reservationName string) (*vpcv1.Reservation, error) {
+	listReservationsOptions := &vpcv1.ListReservationsOptions{
+		Name: &reservationName,
+	}
+	reservationsList, _, err := s.vpcService.ListReservations(listReservationsOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	if reservationsList == nil {
+		return nil, fmt.Errorf("reservations list returned is nil")
+	}
+
+	for index, reservation := range reservationsList.Reservations {
+		if *reservation.Name == reservationName {
+			return &reservationsList.Reservations[index], nil
+		}
+	}
+	return nil, nil
+}
+
 // CreateVPC creates a new VPC.
 func (s *Service) CreateVPC(options *vpcv1.CreateVPCOptions) (*vpcv1.VPC, *core.DetailedResponse, error) {
 	return s.vpcService.CreateVPC(options)
@@ -134,6 +249,11 @@ func (s *Service) GetSubnetPublicGateway(options *vpcv1.GetSubnetPublicGatewayOp
 	return s.vpcService.GetSubnetPublicGateway(options)
 }
 
+// GetPublicGateway returns the public gateway.
+func (s *Service) GetPublicGateway(options *vpcv1.GetPublicGatewayOptions) (*vpcv1.PublicGateway, *core.DetailedResponse, error) {
+	return s.vpcService.GetPublicGateway(options)
+}
+
 // CreatePublicGateway creates a public gateway for the VPC.
 func (s *Service) CreatePublicGateway(options *vpcv1.CreatePublicGatewayOptions) (*vpcv1.PublicGateway, *core.DetailedResponse, error) {
 	return s.vpcService.CreatePublicGateway(options)
@@ -523,16 +643,26 @@ func (s *Service) GetVPCZonesByRegion(region string) ([]string, error) {
 
 // NewService returns a new VPC Service.
 func NewService(svcEndpoint string) (Vpc, error) {
-	service := &Service{}
 	auth, err := authenticator.GetAuthenticator()
 	if err != nil {
 		return nil, err
 	}
+	return NewServiceWithAuthenticator(svcEndpoint, auth)
+}
 
+// NewServiceWithAuthenticator returns a new VPC Service using the given authenticator.
+func NewServiceWithAuthenticator(svcEndpoint string, auth core.Authenticator) (Vpc, error) {
+	var err error
+	service := &Service{}
 	service.vpcService, err = vpcv1.NewVpcV1(&vpcv1.VpcV1Options{
 		Authenticator: auth,
 		URL:           svcEndpoint,
 	})
+	if err != nil {
+		return nil, err
+	}
+	metrics.InstrumentService(metrics.ServiceVPC, service.vpcService.Service)
+	ratelimit.LimitService(metrics.ServiceVPC, service.vpcService.Service)
 
-	return service, err
+	return service, nil
 }
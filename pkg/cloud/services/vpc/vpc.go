@@ -30,7 +30,16 @@ type Vpc interface {
 	DeleteInstance(options *vpcv1.DeleteInstanceOptions) (*core.DetailedResponse, error)
 	GetInstance(options *vpcv1.GetInstanceOptions) (*vpcv1.Instance, *core.DetailedResponse, error)
 	ListInstances(options *vpcv1.ListInstancesOptions) (*vpcv1.InstanceCollection, *core.DetailedResponse, error)
+	CreateInstanceAction(options *vpcv1.CreateInstanceActionOptions) (*vpcv1.InstanceAction, *core.DetailedResponse, error)
+	ListInstanceVolumeAttachments(options *vpcv1.ListInstanceVolumeAttachmentsOptions) (*vpcv1.VolumeAttachmentCollection, *core.DetailedResponse, error)
+	DeleteInstanceVolumeAttachment(options *vpcv1.DeleteInstanceVolumeAttachmentOptions) (*core.DetailedResponse, error)
+	GetDedicatedHost(options *vpcv1.GetDedicatedHostOptions) (*vpcv1.DedicatedHost, *core.DetailedResponse, error)
 	GetDedicatedHostByName(dHostName string) (*vpcv1.DedicatedHost, error)
+	GetDedicatedHostGroup(options *vpcv1.GetDedicatedHostGroupOptions) (*vpcv1.DedicatedHostGroup, *core.DetailedResponse, error)
+	GetDedicatedHostGroupByName(dHostGroupName string) (*vpcv1.DedicatedHostGroup, error)
+	GetPlacementGroup(options *vpcv1.GetPlacementGroupOptions) (*vpcv1.PlacementGroup, *core.DetailedResponse, error)
+	GetPlacementGroupByName(placementGroupName string) (*vpcv1.PlacementGroup, error)
+	GetReservationByName(reservationName string) (*vpcv1.Reservation, error)
 	CreateVPC(options *vpcv1.CreateVPCOptions) (*vpcv1.VPC, *core.DetailedResponse, error)
 	DeleteVPC(options *vpcv1.DeleteVPCOptions) (response *core.DetailedResponse, err error)
 	ListVpcs(options *vpcv1.ListVpcsOptions) (*vpcv1.VPCCollection, *core.DetailedResponse, error)
@@ -40,6 +49,7 @@ type Vpc interface {
 	GetSubnetPublicGateway(options *vpcv1.GetSubnetPublicGatewayOptions) (*vpcv1.PublicGateway, *core.DetailedResponse, error)
 	SetSubnetPublicGateway(options *vpcv1.SetSubnetPublicGatewayOptions) (*vpcv1.PublicGateway, *core.DetailedResponse, error)
 	UnsetSubnetPublicGateway(options *vpcv1.UnsetSubnetPublicGatewayOptions) (*core.DetailedResponse, error)
+	GetPublicGateway(options *vpcv1.GetPublicGatewayOptions) (*vpcv1.PublicGateway, *core.DetailedResponse, error)
 	CreatePublicGateway(options *vpcv1.CreatePublicGatewayOptions) (*vpcv1.PublicGateway, *core.DetailedResponse, error)
 	DeletePublicGateway(options *vpcv1.DeletePublicGatewayOptions) (*core.DetailedResponse, error)
 	ListVPCAddressPrefixes(options *vpcv1.ListVPCAddressPrefixesOptions) (*vpcv1.AddressPrefixCollection, *core.DetailedResponse, error)
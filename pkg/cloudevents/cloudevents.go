@@ -0,0 +1,229 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudevents
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/uuid"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	capiv1beta1 "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+// Sink is the URL of the HTTP endpoint the lifecycle events are published to, no events are published when empty.
+var Sink string
+
+const (
+	// ClusterProvisioned is the type of the event published once the infrastructure of a cluster is ready.
+	ClusterProvisioned = "io.x-k8s.cluster.infrastructure.ibmcloud.cluster.provisioned"
+
+	// MachineFailed is the type of the event published once a machine failed.
+	MachineFailed = "io.x-k8s.cluster.infrastructure.ibmcloud.machine.failed"
+
+	// ImageImported is the type of the event published once an image is imported.
+	ImageImported = "io.x-k8s.cluster.infrastructure.ibmcloud.image.imported"
+
+	// ImageExported is the type of the event published once an image is exported to Cloud Object Storage.
+	ImageExported = "io.x-k8s.cluster.infrastructure.ibmcloud.image.exported"
+)
+
+const (
+	specVersion = "1.0"
+	source      = "sigs.k8s.io/cluster-api-provider-ibmcloud"
+	contentType = "application/cloudevents+json"
+
+	// defaultQueueSize is the number of events queued for publishing, further events are dropped.
+	defaultQueueSize = 100
+	// defaultTimeout is the timeout of a single attempt to deliver an event to the sink.
+	defaultTimeout = 10 * time.Second
+	// defaultRetries is the number of attempts to deliver an event to the sink.
+	defaultRetries = 3
+	// defaultRetryInterval is the interval between two attempts to deliver an event to the sink.
+	defaultRetryInterval = 2 * time.Second
+)
+
+// Event is a CloudEvent in the structured content mode.
+type Event struct {
+	SpecVersion     string    `json:"specversion"`
+	ID              string    `json:"id"`
+	Source          string    `json:"source"`
+	Type            string    `json:"type"`
+	Subject         string    `json:"subject,omitempty"`
+	Time            time.Time `json:"time"`
+	DataContentType string    `json:"datacontenttype"`
+	Data            EventData `json:"data"`
+}
+
+// EventData is the data of a lifecycle event, identifying the object the event is about.
+type EventData struct {
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	Cluster   string `json:"cluster,omitempty"`
+	Message   string `json:"message,omitempty"`
+}
+
+// NewEvent returns a lifecycle event of the given type about the object.
+func NewEvent(obj client.Object, eventType, message string) Event {
+	kind := obj.GetObjectKind().GroupVersionKind().Kind
+	if kind == "" {
+		// typed objects fetched with the client usually come without their TypeMeta.
+		kind = reflect.Indirect(reflect.ValueOf(obj)).Type().Name()
+	}
+	return Event{
+		SpecVersion:     specVersion,
+		ID:              string(uuid.NewUUID()),
+		Source:          source,
+		Type:            eventType,
+		Subject:         fmt.Sprintf("%s/%s", obj.GetNamespace(), obj.GetName()),
+		Time:            time.Now().UTC(),
+		DataContentType: "application/json",
+		Data: EventData{
+			Kind:      kind,
+			Namespace: obj.GetNamespace(),
+			Name:      obj.GetName(),
+			Cluster:   obj.GetLabels()[capiv1beta1.ClusterNameLabel],
+			Message:   message,
+		},
+	}
+}
+
+// Publisher delivers the lifecycle events to the sink in the background, so reconciliation is never blocked by the sink.
+type Publisher struct {
+	sink          string
+	client        *http.Client
+	events        chan Event
+	retries       int
+	retryInterval time.Duration
+}
+
+// NewPublisher returns a Publisher delivering the lifecycle events to the HTTP endpoint at sink.
+func NewPublisher(sink string) (*Publisher, error) {
+	sinkURL, err := url.ParseRequestURI(sink)
+	if err != nil {
+		return nil, fmt.Errorf("invalid CloudEvents sink %s: %w", sink, err)
+	}
+	if sinkURL.Scheme != "http" && sinkURL.Scheme != "https" {
+		return nil, fmt.Errorf("invalid CloudEvents sink %s: scheme must be http or https", sink)
+	}
+	return &Publisher{
+		sink:          sinkURL.String(),
+		client:        &http.Client{Timeout: defaultTimeout},
+		events:        make(chan Event, defaultQueueSize),
+		retries:       defaultRetries,
+		retryInterval: defaultRetryInterval,
+	}, nil
+}
+
+// Publish queues the event for delivery, the event is dropped when the queue is full.
+func (p *Publisher) Publish(event Event) {
+	select {
+	case p.events <- event:
+	default:
+		klog.Background().Info("Dropping CloudEvent as the queue is full", "type", event.Type, "subject", event.Subject)
+	}
+}
+
+// Start delivers the queued events to the sink until the context is cancelled.
+func (p *Publisher) Start(ctx context.Context) error {
+	log := klog.FromContext(ctx).WithValues("sink", p.sink)
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event := <-p.events:
+			if err := p.deliver(ctx, event); err != nil {
+				log.Error(err, "failed to publish CloudEvent", "type", event.Type, "subject", event.Subject)
+				continue
+			}
+			log.V(3).Info("Published CloudEvent", "type", event.Type, "subject", event.Subject)
+		}
+	}
+}
+
+// NeedLeaderElection returns true as the events are published by the controllers of the leader only.
+func (p *Publisher) NeedLeaderElection() bool {
+	return true
+}
+
+// deliver posts the event to the sink, retrying on failures.
+func (p *Publisher) deliver(ctx context.Context, event Event) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal CloudEvent: %w", err)
+	}
+	for attempt := 1; ; attempt++ {
+		err = p.post(ctx, body)
+		if err == nil || attempt >= p.retries {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(p.retryInterval):
+		}
+	}
+}
+
+func (p *Publisher) post(ctx context.Context, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.sink, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create CloudEvents request: %w", err)
+	}
+	req.Header.Set("Content-Type", contentType)
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send CloudEvent: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("CloudEvents sink responded with status %s", resp.Status)
+	}
+	return nil
+}
+
+var (
+	initOnce         sync.Once
+	defaultPublisher *Publisher
+)
+
+// InitFromPublisher initializes the global default publisher. It can only be called once.
+// Subsequent calls are considered noops.
+func InitFromPublisher(publisher *Publisher) {
+	initOnce.Do(func() {
+		defaultPublisher = publisher
+	})
+}
+
+// Publish queues a lifecycle event of the given type about the object with the global default publisher.
+// Nothing is published when no CloudEvents sink is configured.
+func Publish(obj client.Object, eventType, message string) {
+	if defaultPublisher == nil {
+		return
+	}
+	defaultPublisher.Publish(NewEvent(obj, eventType, message))
+}
@@ -0,0 +1,162 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudevents
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	capiv1beta1 "sigs.k8s.io/cluster-api/api/v1beta1"
+
+	infrav1beta2 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/v1beta2"
+)
+
+func TestNewPublisher(t *testing.T) {
+	testCases := []struct {
+		name        string
+		sink        string
+		expectError bool
+	}{
+		{
+			name: "http sink",
+			sink: "http://sink.default.svc.cluster.local",
+		},
+		{
+			name: "https sink with path",
+			sink: "https://events.example.com/capi",
+		},
+		{
+			name:        "relative sink",
+			sink:        "sink.default.svc.cluster.local",
+			expectError: true,
+		},
+		{
+			name:        "sink with unsupported scheme",
+			sink:        "ftp://sink.default.svc.cluster.local",
+			expectError: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := NewPublisher(tc.sink)
+			if tc.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestNewEvent(t *testing.T) {
+	machine := &infrav1beta2.IBMPowerVSMachine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "machine",
+			Namespace: "default",
+			Labels:    map[string]string{capiv1beta1.ClusterNameLabel: "cluster"},
+		},
+	}
+	event := NewEvent(machine, MachineFailed, "instance is in ERROR state")
+	require.Equal(t, "1.0", event.SpecVersion)
+	require.NotEmpty(t, event.ID)
+	require.Equal(t, MachineFailed, event.Type)
+	require.Equal(t, "default/machine", event.Subject)
+	require.Equal(t, EventData{
+		Kind:      "IBMPowerVSMachine",
+		Namespace: "default",
+		Name:      "machine",
+		Cluster:   "cluster",
+		Message:   "instance is in ERROR state",
+	}, event.Data)
+}
+
+func TestPublisher(t *testing.T) {
+	t.Run("delivers the event to the sink", func(t *testing.T) {
+		received := make(chan Event, 1)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			require.Equal(t, contentType, r.Header.Get("Content-Type"))
+			event := Event{}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&event))
+			received <- event
+			w.WriteHeader(http.StatusAccepted)
+		}))
+		defer server.Close()
+
+		publisher, err := NewPublisher(server.URL)
+		require.NoError(t, err)
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go func() {
+			_ = publisher.Start(ctx)
+		}()
+
+		cluster := &infrav1beta2.IBMVPCCluster{ObjectMeta: metav1.ObjectMeta{Name: "cluster", Namespace: "default"}}
+		publisher.Publish(NewEvent(cluster, ClusterProvisioned, ""))
+		select {
+		case event := <-received:
+			require.Equal(t, ClusterProvisioned, event.Type)
+			require.Equal(t, "IBMVPCCluster", event.Data.Kind)
+			require.Equal(t, "cluster", event.Data.Name)
+		case <-time.After(10 * time.Second):
+			t.Fatal("event was not delivered to the sink")
+		}
+	})
+
+	t.Run("retries the delivery when the sink fails", func(t *testing.T) {
+		attempts := make(chan struct{}, 3)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			attempts <- struct{}{}
+			if len(attempts) < 2 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+
+		publisher, err := NewPublisher(server.URL)
+		require.NoError(t, err)
+		publisher.retryInterval = time.Millisecond
+
+		machine := &infrav1beta2.IBMVPCMachine{ObjectMeta: metav1.ObjectMeta{Name: "machine", Namespace: "default"}}
+		require.NoError(t, publisher.deliver(context.Background(), NewEvent(machine, MachineFailed, "")))
+		require.Len(t, attempts, 2)
+	})
+
+	t.Run("drops the event when the queue is full", func(t *testing.T) {
+		publisher, err := NewPublisher("http://sink.default.svc.cluster.local")
+		require.NoError(t, err)
+		image := &infrav1beta2.IBMPowerVSImage{ObjectMeta: metav1.ObjectMeta{Name: "image", Namespace: "default"}}
+		for i := 0; i < defaultQueueSize+1; i++ {
+			publisher.Publish(NewEvent(image, ImageImported, ""))
+		}
+		require.Len(t, publisher.events, defaultQueueSize)
+	})
+}
+
+func TestPublishWithoutSink(_ *testing.T) {
+	// nothing is published and nothing panics when no sink is configured.
+	Publish(&infrav1beta2.IBMPowerVSImage{ObjectMeta: metav1.ObjectMeta{Name: "image", Namespace: "default"}}, ImageImported, "")
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudinit
+
+import (
+	"bytes"
+	"fmt"
+	"mime/multipart"
+	"net/textproto"
+)
+
+const (
+	// BootstrapSucceededSentinel is logged to the console and the cloud-init output of the instance once the bootstrap succeeded.
+	BootstrapSucceededSentinel = "CAPIBM_BOOTSTRAP_SUCCEEDED"
+	// BootstrapFailedSentinel is logged to the console and the cloud-init output of the instance once cloud-init finished
+	// without the bootstrap having succeeded.
+	BootstrapFailedSentinel = "CAPIBM_BOOTSTRAP_FAILED"
+
+	// bootstrapSuccessFile is written by the Cluster API bootstrap providers once the bootstrap commands succeeded.
+	bootstrapSuccessFile = "/run/cluster-api/bootstrap-success.complete"
+	// sentinelService is the name of the systemd unit reporting the result of the bootstrap.
+	sentinelService = "capibm-bootstrap-sentinel.service"
+	// jinjaHeader is the header of the cloud-configs rendered as jinja templates.
+	jinjaHeader = "## template: jinja"
+)
+
+// bootstrapSentinelBoothook installs, once per instance, a systemd unit running after cloud-init finished which logs the
+// result of the bootstrap to the console and the cloud-init output of the instance. The $ of the unit are escaped for systemd.
+var bootstrapSentinelBoothook = fmt.Sprintf(`#cloud-boothook
+#!/bin/sh
+[ -e /var/lib/cloud/capibm-bootstrap-sentinel ] && exit 0
+touch /var/lib/cloud/capibm-bootstrap-sentinel
+cat > /etc/systemd/system/%[1]s <<'EOF'
+[Unit]
+Description=Report the result of the Cluster API bootstrap
+After=cloud-final.service
+
+[Service]
+Type=oneshot
+ExecStart=/bin/sh -c 'if [ -f %[2]s ]; then r=%[3]s; else r=%[4]s; fi; echo "$$r" >> /var/log/cloud-init-output.log; echo "$$r" > /dev/console'
+EOF
+systemctl daemon-reload
+systemctl --no-block start %[1]s
+`, sentinelService, bootstrapSuccessFile, BootstrapSucceededSentinel, BootstrapFailedSentinel)
+
+// InjectBootstrapSentinel wraps the cloud-config user data into a multipart MIME message along with a boothook which logs one of
+// the bootstrap sentinels once cloud-init finished, and the additional boothooks, e.g. the one of NodeRegistrationBoothook.
+// The user data is returned unchanged along with false when it is not a cloud-config.
+func InjectBootstrapSentinel(userData []byte, boothooks ...string) ([]byte, bool) {
+	return InjectBoothooks(userData, append([]string{bootstrapSentinelBoothook}, boothooks...)...)
+}
+
+// InjectBoothooks wraps the cloud-config user data into a multipart MIME message along with the non empty boothooks, which
+// run before the cloud-config. The user data is returned unchanged along with false when it is not a cloud-config or there
+// is no boothook to inject.
+func InjectBoothooks(userData []byte, boothooks ...string) ([]byte, bool) {
+	if !isCloudConfig(userData) {
+		return userData, false
+	}
+
+	type part struct {
+		contentType string
+		data        []byte
+	}
+	var parts []part
+	for _, boothook := range boothooks {
+		if boothook != "" {
+			parts = append(parts, part{contentType: "text/cloud-boothook", data: []byte(boothook)})
+		}
+	}
+	if len(parts) == 0 {
+		return userData, false
+	}
+	configType := "text/cloud-config"
+	if bytes.HasPrefix(bytes.TrimSpace(userData), []byte(jinjaHeader)) {
+		configType = "text/jinja2"
+	}
+	parts = append(parts, part{contentType: configType, data: userData})
+
+	var buf bytes.Buffer
+	writer := multipart.NewWriter(&buf)
+	fmt.Fprintf(&buf, "Content-Type: multipart/mixed; boundary=\"%s\"\nMIME-Version: 1.0\n\n", writer.Boundary())
+	for _, part := range parts {
+		header := textproto.MIMEHeader{}
+		header.Set("Content-Type", fmt.Sprintf("%s; charset=\"us-ascii\"", part.contentType))
+		w, err := writer.CreatePart(header)
+		if err != nil {
+			return userData, false
+		}
+		if _, err := w.Write(part.data); err != nil {
+			return userData, false
+		}
+	}
+	if err := writer.Close(); err != nil {
+		return userData, false
+	}
+	return buf.Bytes(), true
+}
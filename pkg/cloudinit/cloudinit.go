@@ -0,0 +1,65 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudinit
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const (
+	cloudConfigHeader = "#cloud-config"
+)
+
+// ntpConfigPattern matches the top level ntp key of the cloud-config.
+var ntpConfigPattern = regexp.MustCompile(`(?m)^ntp:`)
+
+// InjectChrony adds the cloud-init ntp module configuration to the cloud-config user data to synchronize the clock
+// of the instance with the given servers using chrony. The user data is returned unchanged along with false when
+// it is not a cloud-config or already configures ntp.
+func InjectChrony(userData []byte, servers []string) ([]byte, bool) {
+	if len(servers) == 0 || !isCloudConfig(userData) || ntpConfigPattern.Match(userData) {
+		return userData, false
+	}
+
+	var buf bytes.Buffer
+	buf.Write(userData)
+	if !bytes.HasSuffix(userData, []byte("\n")) {
+		buf.WriteString("\n")
+	}
+	buf.WriteString("ntp:\n  enabled: true\n  ntp_client: chrony\n  servers:\n")
+	for _, server := range servers {
+		fmt.Fprintf(&buf, "  - %q\n", server)
+	}
+	return buf.Bytes(), true
+}
+
+// isCloudConfig checks if the user data is a cloud-config, the header may be preceded by the jinja template header.
+func isCloudConfig(userData []byte) bool {
+	scanner := bufio.NewScanner(bytes.NewReader(userData))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "## template:") {
+			continue
+		}
+		return strings.HasPrefix(line, cloudConfigHeader)
+	}
+	return false
+}
@@ -0,0 +1,194 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudinit
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/mail"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestInjectChrony(t *testing.T) {
+	testCases := []struct {
+		name             string
+		userData         string
+		servers          []string
+		expectedUserData string
+		expected         bool
+	}{
+		{
+			name:             "no servers",
+			userData:         "#cloud-config\nruncmd:\n- kubeadm join\n",
+			expectedUserData: "#cloud-config\nruncmd:\n- kubeadm join\n",
+		},
+		{
+			name:             "cloud-config with jinja template header",
+			userData:         "## template: jinja\n#cloud-config\nruncmd:\n- kubeadm join",
+			servers:          []string{"time.example.com", "10.0.0.1"},
+			expectedUserData: "## template: jinja\n#cloud-config\nruncmd:\n- kubeadm join\nntp:\n  enabled: true\n  ntp_client: chrony\n  servers:\n  - \"time.example.com\"\n  - \"10.0.0.1\"\n",
+			expected:         true,
+		},
+		{
+			name:             "cloud-config already configures ntp",
+			userData:         "#cloud-config\nntp:\n  enabled: true\n",
+			servers:          []string{"time.example.com"},
+			expectedUserData: "#cloud-config\nntp:\n  enabled: true\n",
+		},
+		{
+			name:             "not a cloud-config",
+			userData:         "#!/bin/bash\nkubeadm join\n",
+			servers:          []string{"time.example.com"},
+			expectedUserData: "#!/bin/bash\nkubeadm join\n",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			userData, injected := InjectChrony([]byte(tc.userData), tc.servers)
+			require.Equal(t, tc.expected, injected)
+			require.Equal(t, tc.expectedUserData, string(userData))
+		})
+	}
+}
+
+func TestInjectBootstrapSentinel(t *testing.T) {
+	testCases := []struct {
+		name                string
+		userData            string
+		expectedContentType string
+		expected            bool
+	}{
+		{
+			name:                "cloud-config",
+			userData:            "#cloud-config\nruncmd:\n- kubeadm join\n",
+			expectedContentType: "text/cloud-config",
+			expected:            true,
+		},
+		{
+			name:                "cloud-config with jinja template header",
+			userData:            "## template: jinja\n#cloud-config\nruncmd:\n- kubeadm join\n",
+			expectedContentType: "text/jinja2",
+			expected:            true,
+		},
+		{
+			name:     "shell script",
+			userData: "#!/bin/bash\nkubeadm join\n",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			data, injected := InjectBootstrapSentinel([]byte(tc.userData))
+			require.Equal(t, tc.expected, injected)
+			if !tc.expected {
+				require.Equal(t, tc.userData, string(data))
+				return
+			}
+
+			msg, err := mail.ReadMessage(bytes.NewReader(data))
+			require.NoError(t, err)
+			mediaType, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+			require.NoError(t, err)
+			require.Equal(t, "multipart/mixed", mediaType)
+
+			reader := multipart.NewReader(msg.Body, params["boundary"])
+			boothook, err := reader.NextPart()
+			require.NoError(t, err)
+			require.Contains(t, boothook.Header.Get("Content-Type"), "text/cloud-boothook")
+			body, err := io.ReadAll(boothook)
+			require.NoError(t, err)
+			require.Contains(t, string(body), BootstrapSucceededSentinel)
+			require.Contains(t, string(body), BootstrapFailedSentinel)
+
+			config, err := reader.NextPart()
+			require.NoError(t, err)
+			require.Contains(t, config.Header.Get("Content-Type"), tc.expectedContentType)
+			body, err = io.ReadAll(config)
+			require.NoError(t, err)
+			require.Equal(t, tc.userData, string(body))
+
+			_, err = reader.NextPart()
+			require.ErrorIs(t, err, io.EOF)
+		})
+	}
+}
+
+func TestInjectBoothooks(t *testing.T) {
+	t.Run("Should leave the user data unchanged without boothooks", func(t *testing.T) {
+		userData := "#cloud-config\nruncmd:\n- kubeadm join\n"
+		data, injected := InjectBoothooks([]byte(userData), "")
+		require.False(t, injected)
+		require.Equal(t, userData, string(data))
+	})
+
+	t.Run("Should wrap the user data without the bootstrap sentinel", func(t *testing.T) {
+		boothook := NodeRegistrationBoothook(map[string]string{"pool": "gpu"}, nil)
+		data, injected := InjectBoothooks([]byte("#cloud-config\nruncmd:\n- kubeadm join\n"), boothook)
+		require.True(t, injected)
+		require.Contains(t, string(data), boothook)
+		require.NotContains(t, string(data), BootstrapSucceededSentinel)
+	})
+}
+
+func TestNodeRegistrationBoothook(t *testing.T) {
+	t.Run("Should return an empty boothook without labels and taints", func(t *testing.T) {
+		require.Empty(t, NodeRegistrationBoothook(nil, nil))
+	})
+
+	t.Run("Should pass the labels and taints to the kubelet", func(t *testing.T) {
+		boothook := NodeRegistrationBoothook(map[string]string{"pool": "gpu", "example.com/spot": "true"}, []corev1.Taint{
+			{Key: "nvidia.com/gpu", Value: "true", Effect: corev1.TaintEffectNoSchedule},
+			{Key: "spot", Effect: corev1.TaintEffectNoExecute},
+		})
+		require.True(t, strings.HasPrefix(boothook, "#cloud-boothook\n"))
+		require.Contains(t, boothook, "args='--node-labels=example.com/spot=true,pool=gpu --register-with-taints=nvidia.com/gpu=true:NoSchedule,spot:NoExecute'")
+	})
+
+	t.Run("Should be injected along with the bootstrap sentinel", func(t *testing.T) {
+		boothook := NodeRegistrationBoothook(map[string]string{"pool": "gpu"}, nil)
+		data, injected := InjectBootstrapSentinel([]byte("#cloud-config\nruncmd:\n- kubeadm join\n"), boothook)
+		require.True(t, injected)
+
+		msg, err := mail.ReadMessage(bytes.NewReader(data))
+		require.NoError(t, err)
+		_, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+		require.NoError(t, err)
+		reader := multipart.NewReader(msg.Body, params["boundary"])
+		var bodies []string
+		for {
+			part, err := reader.NextPart()
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			require.NoError(t, err)
+			body, err := io.ReadAll(part)
+			require.NoError(t, err)
+			bodies = append(bodies, string(body))
+		}
+		require.Len(t, bodies, 3)
+		require.Contains(t, bodies[0], BootstrapSucceededSentinel)
+		require.Equal(t, boothook, bodies[1])
+		require.Equal(t, "#cloud-config\nruncmd:\n- kubeadm join\n", bodies[2])
+	})
+}
@@ -0,0 +1,71 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudinit
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// NodeRegistrationBoothook returns a boothook which adds, once per instance, the --node-labels and --register-with-taints
+// flags of the labels and taints to the KUBELET_EXTRA_ARGS of the environment file of the kubelet, /etc/sysconfig/kubelet
+// or /etc/default/kubelet. Since these flags are passed last to the kubelet, the labels are merged with the node labels
+// set in the kubelet extra args of the bootstrap provider, overriding the values of the same keys, while the taints replace
+// the ones of the bootstrap provider. An empty boothook is returned when there are neither labels nor taints.
+// The labels and taints must have been validated, as they are not quoted for the shell.
+func NodeRegistrationBoothook(labels map[string]string, taints []corev1.Taint) string {
+	var args []string
+	if len(labels) > 0 {
+		pairs := make([]string, 0, len(labels))
+		for key, value := range labels {
+			pairs = append(pairs, key+"="+value)
+		}
+		sort.Strings(pairs)
+		args = append(args, "--node-labels="+strings.Join(pairs, ","))
+	}
+	if len(taints) > 0 {
+		specs := make([]string, 0, len(taints))
+		for _, taint := range taints {
+			spec := taint.Key
+			if taint.Value != "" {
+				spec += "=" + taint.Value
+			}
+			specs = append(specs, spec+":"+string(taint.Effect))
+		}
+		args = append(args, "--register-with-taints="+strings.Join(specs, ","))
+	}
+	if len(args) == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf(`#cloud-boothook
+#!/bin/sh
+[ -e /var/lib/cloud/capibm-node-registration ] && exit 0
+touch /var/lib/cloud/capibm-node-registration
+args='%s'
+env=/etc/default/kubelet
+[ -d /etc/sysconfig ] && env=/etc/sysconfig/kubelet
+if grep -q '^KUBELET_EXTRA_ARGS=' "$env" 2>/dev/null; then
+  sed -i "s|^KUBELET_EXTRA_ARGS=\"\{0,1\}\([^\"]*\)\"\{0,1\}$|KUBELET_EXTRA_ARGS=\"\1 $args\"|" "$env"
+else
+  echo "KUBELET_EXTRA_ARGS=\"$args\"" >> "$env"
+fi
+`, strings.Join(args, " "))
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package connectivity
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultTimeout is the timeout of each probe when none is set.
+const DefaultTimeout = 10 * time.Second
+
+// Prober probes the network from the management cluster.
+type Prober interface {
+	// LookupHost returns the addresses the host resolves to.
+	LookupHost(ctx context.Context, host string) ([]string, error)
+	// Dial opens a TCP connection to the address and closes it.
+	Dial(ctx context.Context, address string) error
+}
+
+// NewProber returns a Prober using the resolver and the dialer of the net package.
+func NewProber() Prober {
+	return &netProber{}
+}
+
+type netProber struct {
+	dialer net.Dialer
+}
+
+func (p *netProber) LookupHost(ctx context.Context, host string) ([]string, error) {
+	return net.DefaultResolver.LookupHost(ctx, host)
+}
+
+func (p *netProber) Dial(ctx context.Context, address string) error {
+	conn, err := p.dialer.DialContext(ctx, "tcp", address)
+	if err != nil {
+		return err
+	}
+	return conn.Close()
+}
+
+// Result is the result of probing an endpoint.
+type Result struct {
+	// Addresses are the addresses the host of the endpoint resolves to, sorted.
+	Addresses []string
+	// ResolveError is the error resolving the host of the endpoint, nil once resolved.
+	ResolveError error
+	// DialError is the error opening TCP connections to the addresses of the endpoint, nil once all the addresses
+	// are reachable or when the host could not be resolved.
+	DialError error
+}
+
+// CheckEndpoint resolves the host of an endpoint and opens a TCP connection to each of its addresses on the port,
+// each probe being bound by the timeout. IP addresses are not resolved, the addresses are probed concurrently.
+func CheckEndpoint(ctx context.Context, prober Prober, host string, port int32, timeout time.Duration) Result {
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+
+	result := Result{}
+	if net.ParseIP(host) != nil {
+		result.Addresses = []string{host}
+	} else {
+		lookupCtx, cancel := context.WithTimeout(ctx, timeout)
+		addresses, err := prober.LookupHost(lookupCtx, host)
+		cancel()
+		if err != nil {
+			result.ResolveError = fmt.Errorf("failed to resolve %s: %w", host, err)
+			return result
+		}
+		if len(addresses) == 0 {
+			result.ResolveError = fmt.Errorf("failed to resolve %s: no addresses found", host)
+			return result
+		}
+		result.Addresses = append([]string(nil), addresses...)
+		sort.Strings(result.Addresses)
+	}
+
+	errs := make([]error, len(result.Addresses))
+	var wg sync.WaitGroup
+	for i, address := range result.Addresses {
+		wg.Add(1)
+		go func(i int, address string) {
+			defer wg.Done()
+			dialCtx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+			errs[i] = prober.Dial(dialCtx, net.JoinHostPort(address, strconv.Itoa(int(port))))
+		}(i, address)
+	}
+	wg.Wait()
+	var failed []string
+	for _, err := range errs {
+		if err != nil {
+			failed = append(failed, err.Error())
+		}
+	}
+	if len(failed) != 0 {
+		result.DialError = fmt.Errorf("failed to connect to %d of %d addresses of %s: %s", len(failed), len(result.Addresses), host, strings.Join(failed, "; "))
+	}
+	return result
+}
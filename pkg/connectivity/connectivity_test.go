@@ -0,0 +1,101 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package connectivity
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type fakeProber struct {
+	addresses   map[string][]string
+	unreachable map[string]bool
+	mu          sync.Mutex
+	dialed      []string
+}
+
+func (p *fakeProber) LookupHost(_ context.Context, host string) ([]string, error) {
+	addresses, ok := p.addresses[host]
+	if !ok {
+		return nil, errors.New("no such host")
+	}
+	return addresses, nil
+}
+
+func (p *fakeProber) Dial(_ context.Context, address string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.dialed = append(p.dialed, address)
+	if p.unreachable[address] {
+		return errors.New("connection refused")
+	}
+	return nil
+}
+
+func TestCheckEndpoint(t *testing.T) {
+	t.Run("Resolves the host and connects to all of its addresses", func(t *testing.T) {
+		prober := &fakeProber{addresses: map[string][]string{"lb.example.com": {"10.0.0.2", "10.0.0.1"}}}
+		result := CheckEndpoint(context.Background(), prober, "lb.example.com", 6443, 0)
+		require.NoError(t, result.ResolveError)
+		require.NoError(t, result.DialError)
+		require.Equal(t, []string{"10.0.0.1", "10.0.0.2"}, result.Addresses)
+		require.ElementsMatch(t, []string{"10.0.0.1:6443", "10.0.0.2:6443"}, prober.dialed)
+	})
+
+	t.Run("Does not resolve IP addresses", func(t *testing.T) {
+		prober := &fakeProber{}
+		result := CheckEndpoint(context.Background(), prober, "2001:db8::1", 6443, time.Second)
+		require.NoError(t, result.ResolveError)
+		require.NoError(t, result.DialError)
+		require.Equal(t, []string{"[2001:db8::1]:6443"}, prober.dialed)
+	})
+
+	t.Run("Does not connect when the host can't be resolved", func(t *testing.T) {
+		prober := &fakeProber{}
+		result := CheckEndpoint(context.Background(), prober, "lb.example.com", 6443, time.Second)
+		require.ErrorContains(t, result.ResolveError, "no such host")
+		require.NoError(t, result.DialError)
+		require.Empty(t, prober.dialed)
+	})
+
+	t.Run("Reports the unreachable addresses", func(t *testing.T) {
+		prober := &fakeProber{
+			addresses:   map[string][]string{"lb.example.com": {"10.0.0.1", "10.0.0.2"}},
+			unreachable: map[string]bool{"10.0.0.2:6443": true},
+		}
+		result := CheckEndpoint(context.Background(), prober, "lb.example.com", 6443, time.Second)
+		require.NoError(t, result.ResolveError)
+		require.ErrorContains(t, result.DialError, "failed to connect to 1 of 2 addresses of lb.example.com")
+	})
+}
+
+func TestNetProber(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+	port := listener.Addr().(*net.TCPAddr).Port
+
+	result := CheckEndpoint(context.Background(), NewProber(), "127.0.0.1", int32(port), time.Second)
+	require.NoError(t, result.ResolveError)
+	require.NoError(t, result.DialError)
+}
@@ -0,0 +1,185 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package debugdump
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	infrav1beta2 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/v1beta2"
+)
+
+const (
+	// DefaultTTL is the duration for which the dump is kept when the debug dump annotation value is not a duration.
+	DefaultTTL = time.Hour
+
+	// ExpiryAnnotation is the name of the annotation set on the ConfigMap holding the time after which the dump is deleted.
+	ExpiryAnnotation = "infrastructure.cluster.x-k8s.io/debug-dump-expiry"
+
+	// Redacted is the value set in place of the sensitive fields of the dumped resources.
+	Redacted = "REDACTED"
+)
+
+// sensitiveFields are the lower cased names of the fields whose values are redacted from the dump.
+var sensitiveFields = map[string]bool{
+	"userdata":    true,
+	"user_data":   true,
+	"password":    true,
+	"secret":      true,
+	"token":       true,
+	"apikey":      true,
+	"api_key":     true,
+	"privatekey":  true,
+	"private_key": true,
+}
+
+var now = time.Now
+
+// Requested returns true if the debug dump annotation is set on the object.
+func Requested(obj client.Object) bool {
+	value, found := obj.GetAnnotations()[infrav1beta2.DebugDumpAnnotation]
+	if !found {
+		return false
+	}
+	if enabled, err := strconv.ParseBool(value); err == nil {
+		return enabled
+	}
+	return true
+}
+
+// TTL returns the duration for which the dump of the object is kept.
+func TTL(obj client.Object) time.Duration {
+	ttl, err := time.ParseDuration(obj.GetAnnotations()[infrav1beta2.DebugDumpAnnotation])
+	if err != nil || ttl <= 0 {
+		return DefaultTTL
+	}
+	return ttl
+}
+
+// ConfigMapName returns the name of the ConfigMap holding the dump of the object.
+func ConfigMapName(obj client.Object) string {
+	return fmt.Sprintf("%s-debug-dump", obj.GetName())
+}
+
+// Sanitize returns the indented JSON of the resource with the values of the sensitive fields redacted.
+func Sanitize(resource interface{}) (string, error) {
+	data, err := json.Marshal(resource)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal resource: %w", err)
+	}
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return "", fmt.Errorf("failed to unmarshal resource: %w", err)
+	}
+	data, err = json.MarshalIndent(redact(value), "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal sanitized resource: %w", err)
+	}
+	return string(data), nil
+}
+
+func redact(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, val := range v {
+			if sensitiveFields[strings.ToLower(key)] {
+				v[key] = Redacted
+				continue
+			}
+			v[key] = redact(val)
+		}
+	case []interface{}:
+		for i := range v {
+			v[i] = redact(v[i])
+		}
+	}
+	return value
+}
+
+// Reconcile writes the sanitized dump of the resources into the ConfigMap owned by the object, the resources are keyed by
+// the name of the ConfigMap data entry holding them. Once the dump is expired, the ConfigMap is deleted and the debug dump
+// annotation is removed from the object, the caller is expected to persist the object.
+func Reconcile(ctx context.Context, c client.Client, obj client.Object, resources map[string]interface{}) error {
+	data := make(map[string]string, len(resources))
+	for key, resource := range resources {
+		if resource == nil || (reflect.ValueOf(resource).Kind() == reflect.Ptr && reflect.ValueOf(resource).IsNil()) {
+			continue
+		}
+		dump, err := Sanitize(resource)
+		if err != nil {
+			return fmt.Errorf("failed to dump %s: %w", key, err)
+		}
+		data[key] = dump
+	}
+
+	configMap := &corev1.ConfigMap{}
+	key := client.ObjectKey{Namespace: obj.GetNamespace(), Name: ConfigMapName(obj)}
+	if err := c.Get(ctx, key, configMap); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to get debug dump ConfigMap %s: %w", key, err)
+		}
+		configMap = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      key.Name,
+				Namespace: key.Namespace,
+				Annotations: map[string]string{
+					ExpiryAnnotation: now().Add(TTL(obj)).UTC().Format(time.RFC3339),
+				},
+			},
+			Data: data,
+		}
+		if err := controllerutil.SetOwnerReference(obj, configMap, c.Scheme()); err != nil {
+			return fmt.Errorf("failed to set owner reference on debug dump ConfigMap %s: %w", key, err)
+		}
+		if err := c.Create(ctx, configMap); err != nil {
+			return fmt.Errorf("failed to create debug dump ConfigMap %s: %w", key, err)
+		}
+		return nil
+	}
+
+	expiry, err := time.Parse(time.RFC3339, configMap.Annotations[ExpiryAnnotation])
+	if err != nil || now().After(expiry) {
+		if err := c.Delete(ctx, configMap); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete debug dump ConfigMap %s: %w", key, err)
+		}
+		annotations := obj.GetAnnotations()
+		delete(annotations, infrav1beta2.DebugDumpAnnotation)
+		obj.SetAnnotations(annotations)
+		return nil
+	}
+
+	if reflect.DeepEqual(configMap.Data, data) || (len(configMap.Data) == 0 && len(data) == 0) {
+		return nil
+	}
+	configMap.Data = data
+	if err := c.Update(ctx, configMap); err != nil {
+		return fmt.Errorf("failed to update debug dump ConfigMap %s: %w", key, err)
+	}
+	return nil
+}
@@ -0,0 +1,148 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package debugdump
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1beta2 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/v1beta2"
+)
+
+func TestRequested(t *testing.T) {
+	testCases := []struct {
+		name        string
+		annotations map[string]string
+		expected    bool
+		expectedTTL time.Duration
+	}{
+		{
+			name:        "annotation not set",
+			expected:    false,
+			expectedTTL: DefaultTTL,
+		},
+		{
+			name:        "annotation set to true",
+			annotations: map[string]string{infrav1beta2.DebugDumpAnnotation: "true"},
+			expected:    true,
+			expectedTTL: DefaultTTL,
+		},
+		{
+			name:        "annotation set to false",
+			annotations: map[string]string{infrav1beta2.DebugDumpAnnotation: "false"},
+			expected:    false,
+			expectedTTL: DefaultTTL,
+		},
+		{
+			name:        "annotation set to duration",
+			annotations: map[string]string{infrav1beta2.DebugDumpAnnotation: "30m"},
+			expected:    true,
+			expectedTTL: 30 * time.Minute,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			obj := &infrav1beta2.IBMPowerVSMachine{ObjectMeta: metav1.ObjectMeta{Annotations: tc.annotations}}
+			require.Equal(t, tc.expected, Requested(obj))
+			require.Equal(t, tc.expectedTTL, TTL(obj))
+		})
+	}
+}
+
+func TestSanitize(t *testing.T) {
+	resource := map[string]interface{}{
+		"name":     "instance",
+		"userData": "c2VjcmV0",
+		"volumes": []interface{}{
+			map[string]interface{}{"name": "volume", "password": "secret"},
+		},
+	}
+	dump, err := Sanitize(resource)
+	require.NoError(t, err)
+	require.Contains(t, dump, `"name": "instance"`)
+	require.Contains(t, dump, `"userData": "REDACTED"`)
+	require.Contains(t, dump, `"password": "REDACTED"`)
+	require.NotContains(t, dump, "c2VjcmV0")
+}
+
+func TestReconcile(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, infrav1beta2.AddToScheme(scheme))
+
+	current := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+	now = func() time.Time { return current }
+	defer func() { now = time.Now }()
+
+	machine := &infrav1beta2.IBMPowerVSMachine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "machine",
+			Namespace:   "default",
+			UID:         "uid",
+			Annotations: map[string]string{infrav1beta2.DebugDumpAnnotation: "10m"},
+		},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(machine).Build()
+	key := client.ObjectKey{Namespace: "default", Name: "machine-debug-dump"}
+
+	t.Run("Should create the ConfigMap with the sanitized dump", func(t *testing.T) {
+		err := Reconcile(context.Background(), c, machine, map[string]interface{}{
+			"instance.json": map[string]interface{}{"name": "instance", "userData": "data"},
+		})
+		require.NoError(t, err)
+		configMap := &corev1.ConfigMap{}
+		require.NoError(t, c.Get(context.Background(), key, configMap))
+		require.Equal(t, "2025-01-01T00:10:00Z", configMap.Annotations[ExpiryAnnotation])
+		require.Contains(t, configMap.Data["instance.json"], `"userData": "REDACTED"`)
+		require.Len(t, configMap.OwnerReferences, 1)
+		require.Equal(t, "machine", configMap.OwnerReferences[0].Name)
+	})
+	t.Run("Should update the ConfigMap when the resource changed", func(t *testing.T) {
+		err := Reconcile(context.Background(), c, machine, map[string]interface{}{
+			"instance.json": map[string]interface{}{"name": "renamed"},
+			"empty.json":    (*string)(nil),
+		})
+		require.NoError(t, err)
+		configMap := &corev1.ConfigMap{}
+		require.NoError(t, c.Get(context.Background(), key, configMap))
+		require.Contains(t, configMap.Data["instance.json"], `"name": "renamed"`)
+		require.NotContains(t, configMap.Data, "empty.json")
+	})
+	t.Run("Should delete the ConfigMap and remove the annotation once expired", func(t *testing.T) {
+		current = current.Add(11 * time.Minute)
+		err := Reconcile(context.Background(), c, machine, map[string]interface{}{
+			"instance.json": ptr.To("instance"),
+		})
+		require.NoError(t, err)
+		err = c.Get(context.Background(), key, &corev1.ConfigMap{})
+		require.True(t, apierrors.IsNotFound(err))
+		require.NotContains(t, machine.Annotations, infrav1beta2.DebugDumpAnnotation)
+		require.False(t, Requested(machine))
+	})
+}
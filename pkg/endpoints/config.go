@@ -0,0 +1,200 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package endpoints
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"sync"
+	"time"
+
+	"k8s.io/klog/v2"
+
+	"sigs.k8s.io/yaml"
+)
+
+// ServiceEndpointConfigFile is the path of the file holding the structured service endpoint overrides.
+var ServiceEndpointConfigFile string
+
+// AnyRegion is the region of the service endpoint overrides applying to every region.
+const AnyRegion = "*"
+
+// defaultReloadInterval is the interval at which the service endpoint config file is checked for changes.
+const defaultReloadInterval = 30 * time.Second
+
+// ServiceEndpointConfig is the structured form of the service endpoint overrides.
+type ServiceEndpointConfig struct {
+	// Regions lists the service endpoint overrides per region.
+	Regions []RegionServiceEndpoints `json:"regions"`
+}
+
+// RegionServiceEndpoints holds the service endpoint overrides of a region.
+type RegionServiceEndpoints struct {
+	// Region is the region the overrides apply to, * applies the overrides to every region.
+	Region string `json:"region"`
+	// Endpoints maps the ID of the service to the URL of its endpoint.
+	Endpoints map[string]string `json:"endpoints"`
+}
+
+// ParseServiceEndpointConfig parses the content of a service endpoint config file returning a list of ServiceEndpoint.
+func ParseServiceEndpointConfig(data []byte) ([]ServiceEndpoint, error) {
+	config := ServiceEndpointConfig{}
+	if err := yaml.UnmarshalStrict(data, &config); err != nil {
+		return nil, fmt.Errorf("failed to parse service endpoint config: %w", err)
+	}
+
+	seenRegions := []string{}
+	endpoints := []ServiceEndpoint{}
+	for _, regionConfig := range config.Regions {
+		if regionConfig.Region == "" {
+			return nil, fmt.Errorf("region must be set for the service endpoints")
+		}
+		if containsString(seenRegions, regionConfig.Region) {
+			return nil, fmt.Errorf("region %s defined twice", regionConfig.Region)
+		}
+		seenRegions = append(seenRegions, regionConfig.Region)
+		// iterate over the known service IDs to keep the order of the endpoints stable.
+		for _, id := range serviceIDs {
+			endpointURL, ok := regionConfig.Endpoints[string(id)]
+			if !ok {
+				continue
+			}
+			URL, err := url.ParseRequestURI(endpointURL)
+			if err != nil {
+				return nil, fmt.Errorf("invalid URL %s for service %s in region %s: %w", endpointURL, id, regionConfig.Region, errServiceEndpointURL)
+			}
+			endpoints = append(endpoints, ServiceEndpoint{
+				ID:     string(id),
+				URL:    URL.String(),
+				Region: regionConfig.Region,
+			})
+		}
+		for id := range regionConfig.Endpoints {
+			if !isServiceID(id) {
+				return nil, fmt.Errorf("invalid service ID %s in region %s", id, regionConfig.Region)
+			}
+		}
+	}
+	return endpoints, nil
+}
+
+func isServiceID(id string) bool {
+	for _, serviceID := range serviceIDs {
+		if id == string(serviceID) {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// configuredEndpoints holds the service endpoints loaded from the service endpoint config file.
+	configuredEndpoints     []ServiceEndpoint
+	configuredEndpointsLock sync.RWMutex
+)
+
+// withConfiguredEndpoints returns the given service endpoints followed by the service endpoints loaded from the service endpoint config file,
+// so the given service endpoints take precedence.
+func withConfiguredEndpoints(serviceEndpoint []ServiceEndpoint) []ServiceEndpoint {
+	configuredEndpointsLock.RLock()
+	defer configuredEndpointsLock.RUnlock()
+	if len(configuredEndpoints) == 0 {
+		return serviceEndpoint
+	}
+	endpoints := make([]ServiceEndpoint, 0, len(serviceEndpoint)+len(configuredEndpoints))
+	endpoints = append(endpoints, serviceEndpoint...)
+	return append(endpoints, configuredEndpoints...)
+}
+
+func setConfiguredEndpoints(serviceEndpoint []ServiceEndpoint) {
+	configuredEndpointsLock.Lock()
+	defer configuredEndpointsLock.Unlock()
+	configuredEndpoints = serviceEndpoint
+}
+
+// ConfigWatcher loads the service endpoints from the service endpoint config file and reloads them whenever the file changes.
+// The service endpoint config file is typically a mounted ConfigMap, which gets updated in place by the kubelet.
+type ConfigWatcher struct {
+	path     string
+	interval time.Duration
+	modTime  time.Time
+}
+
+// NewConfigWatcher returns a ConfigWatcher for the service endpoint config file at path after loading the file once.
+func NewConfigWatcher(path string) (*ConfigWatcher, error) {
+	w := &ConfigWatcher{
+		path:     path,
+		interval: defaultReloadInterval,
+	}
+	if _, err := w.reload(); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+// Start checks the service endpoint config file for changes until the context is cancelled.
+// The previously loaded service endpoints are kept when the changed file is invalid.
+func (w *ConfigWatcher) Start(ctx context.Context) error {
+	log := klog.FromContext(ctx).WithValues("path", w.path)
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			reloaded, err := w.reload()
+			if err != nil {
+				log.Error(err, "failed to reload service endpoint config, keeping the previous service endpoints")
+				continue
+			}
+			if reloaded {
+				log.Info("Reloaded service endpoint config")
+			}
+		}
+	}
+}
+
+// NeedLeaderElection returns false as the service endpoints must be reloaded on every replica of the controller.
+func (w *ConfigWatcher) NeedLeaderElection() bool {
+	return false
+}
+
+// reload loads the service endpoint config file when it changed since the last load and returns true if it was loaded.
+func (w *ConfigWatcher) reload() (bool, error) {
+	info, err := os.Stat(w.path)
+	if err != nil {
+		return false, fmt.Errorf("failed to stat service endpoint config file: %w", err)
+	}
+	if info.ModTime().Equal(w.modTime) {
+		return false, nil
+	}
+
+	data, err := os.ReadFile(w.path)
+	if err != nil {
+		return false, fmt.Errorf("failed to read service endpoint config file: %w", err)
+	}
+	serviceEndpoint, err := ParseServiceEndpointConfig(data)
+	if err != nil {
+		return false, err
+	}
+	setConfiguredEndpoints(serviceEndpoint)
+	w.modTime = info.ModTime()
+	return true, nil
+}
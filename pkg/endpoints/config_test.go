@@ -0,0 +1,253 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package endpoints
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseServiceEndpointConfig(t *testing.T) {
+	testCases := []struct {
+		name           string
+		config         string
+		expectedOutput []ServiceEndpoint
+		expectError    bool
+	}{
+		{
+			name:           "empty configuration",
+			config:         "",
+			expectedOutput: []ServiceEndpoint{},
+		},
+		{
+			name: "multiple regions, multiple services",
+			config: `regions:
+- region: us-south
+  endpoints:
+    rc: https://rchost:8080
+    vpc: https://vpchost:8080
+- region: "*"
+  endpoints:
+    iam: https://iam.test.cloud.ibm.com
+`,
+			expectedOutput: []ServiceEndpoint{
+				{
+					ID:     "vpc",
+					URL:    "https://vpchost:8080",
+					Region: "us-south",
+				},
+				{
+					ID:     "rc",
+					URL:    "https://rchost:8080",
+					Region: "us-south",
+				},
+				{
+					ID:     "iam",
+					URL:    "https://iam.test.cloud.ibm.com",
+					Region: "*",
+				},
+			},
+		},
+		{
+			name: "missing region",
+			config: `regions:
+- endpoints:
+    vpc: https://vpchost:8080
+`,
+			expectError: true,
+		},
+		{
+			name: "duplicate region",
+			config: `regions:
+- region: us-south
+  endpoints:
+    vpc: https://vpchost:8080
+- region: us-south
+  endpoints:
+    rc: https://rchost:8080
+`,
+			expectError: true,
+		},
+		{
+			name: "invalid service ID",
+			config: `regions:
+- region: us-south
+  endpoints:
+    foo: https://foohost:8080
+`,
+			expectError: true,
+		},
+		{
+			name: "invalid URL",
+			config: `regions:
+- region: us-south
+  endpoints:
+    vpc: vpchost
+`,
+			expectError: true,
+		},
+		{
+			name: "unknown field",
+			config: `regions:
+- region: us-south
+  services:
+    vpc: https://vpchost:8080
+`,
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			out, err := ParseServiceEndpointConfig([]byte(tc.config))
+			if tc.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedOutput, out)
+		})
+	}
+}
+
+func TestFetchRegionalEndpoint(t *testing.T) {
+	serviceEndpoint := []ServiceEndpoint{
+		{
+			ID:     "cos",
+			URL:    "https://s3.eu-de.cloud-object-storage.test.appdomain.cloud",
+			Region: "eu-de",
+		},
+		{
+			ID:     "cos",
+			URL:    "https://s3.cloud-object-storage.test.appdomain.cloud",
+			Region: "*",
+		},
+		{
+			ID:     "cos",
+			URL:    "https://s3.us-south.cloud-object-storage.test.appdomain.cloud",
+			Region: "us-south",
+		},
+		{
+			ID:     "rc",
+			URL:    "https://rchost:8080",
+			Region: "us-south",
+		},
+		{
+			ID:  "iam",
+			URL: "https://iam.test.cloud.ibm.com",
+		},
+	}
+
+	testCases := []struct {
+		name           string
+		serviceID      string
+		region         string
+		expectedOutput string
+	}{
+		{
+			name:           "Return endpoint of the region",
+			serviceID:      "cos",
+			region:         "us-south",
+			expectedOutput: "https://s3.us-south.cloud-object-storage.test.appdomain.cloud",
+		},
+		{
+			name:           "Return endpoint applying to every region",
+			serviceID:      "cos",
+			region:         "jp-tok",
+			expectedOutput: "https://s3.cloud-object-storage.test.appdomain.cloud",
+		},
+		{
+			name:           "Return endpoint without region",
+			serviceID:      "iam",
+			region:         "eu-de",
+			expectedOutput: "https://iam.test.cloud.ibm.com",
+		},
+		{
+			name:           "Return empty endpoint when only another region has an endpoint",
+			serviceID:      "rc",
+			region:         "eu-de",
+			expectedOutput: "",
+		},
+		{
+			name:           "Return empty endpoint",
+			serviceID:      "powervs",
+			region:         "eu-de",
+			expectedOutput: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			out := FetchRegionalEndpoint(tc.serviceID, tc.region, serviceEndpoint)
+			require.Equal(t, tc.expectedOutput, out)
+		})
+	}
+}
+
+func TestConfigWatcher(t *testing.T) {
+	t.Cleanup(func() { setConfiguredEndpoints(nil) })
+
+	path := filepath.Join(t.TempDir(), "service-endpoints.yaml")
+	writeConfig := func(config string, modTime time.Time) {
+		require.NoError(t, os.WriteFile(path, []byte(config), 0o600))
+		require.NoError(t, os.Chtimes(path, modTime, modTime))
+	}
+	now := time.Now()
+
+	writeConfig(`regions:
+- region: us-south
+  endpoints:
+    vpc: https://vpchost:8080
+`, now)
+	w, err := NewConfigWatcher(path)
+	require.NoError(t, err)
+	require.Equal(t, "https://vpchost:8080", FetchVPCEndpoint("us-south", nil))
+	require.Equal(t, "https://vpchost:8081", FetchVPCEndpoint("us-south", []ServiceEndpoint{{ID: "vpc", URL: "https://vpchost:8081", Region: "us-south"}}))
+
+	t.Run("Reload the changed service endpoint config", func(t *testing.T) {
+		writeConfig(`regions:
+- region: us-south
+  endpoints:
+    vpc: https://vpchost:9090
+`, now.Add(time.Minute))
+		reloaded, err := w.reload()
+		require.NoError(t, err)
+		require.True(t, reloaded)
+		require.Equal(t, "https://vpchost:9090", FetchVPCEndpoint("us-south", nil))
+	})
+
+	t.Run("Skip reloading the unchanged service endpoint config", func(t *testing.T) {
+		reloaded, err := w.reload()
+		require.NoError(t, err)
+		require.False(t, reloaded)
+	})
+
+	t.Run("Keep the previous service endpoints when the service endpoint config is invalid", func(t *testing.T) {
+		writeConfig(`regions:
+- region: us-south
+  endpoints:
+    foo: https://foohost:8080
+`, now.Add(2*time.Minute))
+		_, err := w.reload()
+		require.Error(t, err)
+		require.Equal(t, "https://vpchost:9090", FetchVPCEndpoint("us-south", nil))
+	})
+}
@@ -41,11 +41,19 @@ const (
 	RM serviceID = "rm"
 	// GlobalTagging used to identify the Global Tagging service.
 	GlobalTagging serviceID = "globaltagging"
+	// GlobalSearch used to identify the Global Search service.
+	GlobalSearch serviceID = "globalsearch"
+	// IAM used to identify the Identity and Access Management service.
+	IAM serviceID = "iam"
+	// DNSServices used to identify the IBM Cloud DNS Services.
+	DNSServices serviceID = "dnsservices"
+	// CIS used to identify the IBM Cloud Internet Services.
+	CIS serviceID = "cis"
 )
 
 type serviceID string
 
-var serviceIDs = []serviceID{VPC, PowerVS, RC, TransitGateway, COS, RM, GlobalTagging}
+var serviceIDs = []serviceID{VPC, PowerVS, RC, TransitGateway, COS, RM, GlobalTagging, GlobalSearch, IAM, DNSServices, CIS}
 
 // ServiceEndpoint holds the Service endpoint specific information.
 type ServiceEndpoint struct {
@@ -124,10 +132,8 @@ func containsString(slice []string, s string) bool {
 // FetchVPCEndpoint will return VPC service endpoint.
 func FetchVPCEndpoint(region string, serviceEndpoint []ServiceEndpoint) string {
 	svcEndpoint := "https://" + region + ".iaas.cloud.ibm.com/v1"
-	for _, vpcEndpoint := range serviceEndpoint {
-		if vpcEndpoint.Region == region && vpcEndpoint.ID == string(VPC) {
-			return vpcEndpoint.URL
-		}
+	if vpcEndpoint := fetchRegionEndpoint(string(VPC), region, withConfiguredEndpoints(serviceEndpoint)); vpcEndpoint != "" {
+		return vpcEndpoint
 	}
 	return svcEndpoint
 }
@@ -135,18 +141,13 @@ func FetchVPCEndpoint(region string, serviceEndpoint []ServiceEndpoint) string {
 // FetchPVSEndpoint will return PowerVS service endpoint.
 // Deprecated: User FetchEndpoints instead.
 func FetchPVSEndpoint(region string, serviceEndpoint []ServiceEndpoint) string {
-	for _, powervsEndpoint := range serviceEndpoint {
-		if powervsEndpoint.Region == region && powervsEndpoint.ID == string(PowerVS) {
-			return powervsEndpoint.URL
-		}
-	}
-	return ""
+	return fetchRegionEndpoint(string(PowerVS), region, withConfiguredEndpoints(serviceEndpoint))
 }
 
 // FetchRCEndpoint will return resource controller endpoint.
 // Deprecated: User FetchEndpoints instead.
 func FetchRCEndpoint(serviceEndpoint []ServiceEndpoint) string {
-	for _, rcEndpoint := range serviceEndpoint {
+	for _, rcEndpoint := range withConfiguredEndpoints(serviceEndpoint) {
 		if rcEndpoint.ID == string(RC) {
 			return rcEndpoint.URL
 		}
@@ -156,7 +157,7 @@ func FetchRCEndpoint(serviceEndpoint []ServiceEndpoint) string {
 
 // FetchEndpoints returns the endpoint associated with serviceID otherwise empty string.
 func FetchEndpoints(serviceID string, serviceEndpoint []ServiceEndpoint) string {
-	for _, endpoint := range serviceEndpoint {
+	for _, endpoint := range withConfiguredEndpoints(serviceEndpoint) {
 		if endpoint.ID == serviceID {
 			return endpoint.URL
 		}
@@ -164,6 +165,30 @@ func FetchEndpoints(serviceID string, serviceEndpoint []ServiceEndpoint) string
 	return ""
 }
 
+// FetchRegionalEndpoint returns the endpoint associated with serviceID in the region, falling back to the endpoint
+// applying to every region, otherwise empty string. Endpoints associated with another region are never returned.
+func FetchRegionalEndpoint(serviceID, region string, serviceEndpoint []ServiceEndpoint) string {
+	return fetchRegionEndpoint(serviceID, region, withConfiguredEndpoints(serviceEndpoint))
+}
+
+// fetchRegionEndpoint returns the endpoint associated with serviceID in the region, otherwise the endpoint applying to every region,
+// set with the * region or without a region.
+func fetchRegionEndpoint(serviceID, region string, serviceEndpoint []ServiceEndpoint) string {
+	anyRegionEndpoint := ""
+	for _, endpoint := range serviceEndpoint {
+		if endpoint.ID != serviceID {
+			continue
+		}
+		if endpoint.Region == region {
+			return endpoint.URL
+		}
+		if (endpoint.Region == AnyRegion || endpoint.Region == "") && anyRegionEndpoint == "" {
+			anyRegionEndpoint = endpoint.URL
+		}
+	}
+	return anyRegionEndpoint
+}
+
 // ConstructRegionFromZone Calculate region based on location/zone.
 func ConstructRegionFromZone(zone string) string {
 	var regex string
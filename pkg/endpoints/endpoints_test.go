@@ -65,6 +65,23 @@ func TestParseFlags(t *testing.T) {
 			},
 			expectedError: nil,
 		},
+		{
+			name:        "single region, iam and cos services",
+			flagToParse: "us-south:iam=https://iam.test.cloud.ibm.com,cos=https://s3.us-south.cloud-object-storage.test.appdomain.cloud",
+			expectedOutput: []ServiceEndpoint{
+				{
+					ID:     "iam",
+					URL:    "https://iam.test.cloud.ibm.com",
+					Region: "us-south",
+				},
+				{
+					ID:     "cos",
+					URL:    "https://s3.us-south.cloud-object-storage.test.appdomain.cloud",
+					Region: "us-south",
+				},
+			},
+			expectedError: nil,
+		},
 		{
 			name:        "single region, multiple services",
 			flagToParse: "lon:powervs=https://pvshost:8080,rc=https://rchost:8080",
@@ -0,0 +1,99 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ignition
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/blang/semver/v4"
+	ignV3Types "github.com/coreos/ignition/v2/config/v3_4/types"
+
+	"k8s.io/utils/ptr"
+)
+
+// ConfigVersion returns the Ignition version of the config, it is empty when the data is not an Ignition config.
+func ConfigVersion(data []byte) string {
+	config := struct {
+		Ignition struct {
+			Version string `json:"version"`
+		} `json:"ignition"`
+	}{}
+	if err := json.Unmarshal(data, &config); err != nil {
+		return ""
+	}
+	return config.Ignition.Version
+}
+
+// IsIgnition returns true if the data is an Ignition config, like the bootstrap data generated in the Ignition format by Cluster API.
+func IsIgnition(data []byte) bool {
+	return ConfigVersion(data) != ""
+}
+
+// ReplaceConfig returns an Ignition config of the given version which is replaced by the config fetched from the source.
+// The authorization is sent in the Authorization header of the request fetching the config when set.
+func ReplaceConfig(version, source, authorization string) ([]byte, error) {
+	v, err := semver.ParseTolerant(version)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse ignition version %q: %w", version, err)
+	}
+
+	switch v.Major {
+	case 2:
+		config := &Config{
+			Ignition: Ignition{
+				Version: v.String(),
+				Config: IgnitionConfig{
+					Replace: &ConfigReference{
+						Source: source,
+					},
+				},
+			},
+		}
+		if authorization != "" {
+			config.Ignition.Config.Replace.HTTPHeaders = HTTPHeaders{
+				{
+					Name:  "Authorization",
+					Value: authorization,
+				},
+			}
+		}
+		return json.Marshal(config)
+	case 3:
+		config := &ignV3Types.Config{
+			Ignition: ignV3Types.Ignition{
+				Version: v.String(),
+				Config: ignV3Types.IgnitionConfig{
+					Replace: ignV3Types.Resource{
+						Source: ptr.To(source),
+					},
+				},
+			},
+		}
+		if authorization != "" {
+			config.Ignition.Config.Replace.HTTPHeaders = ignV3Types.HTTPHeaders{
+				{
+					Name:  "Authorization",
+					Value: ptr.To(authorization),
+				},
+			}
+		}
+		return json.Marshal(config)
+	default:
+		return nil, fmt.Errorf("unsupported ignition version %q", version)
+	}
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ignition
+
+import (
+	"encoding/json"
+	"testing"
+
+	ignV3Types "github.com/coreos/ignition/v2/config/v3_4/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigVersion(t *testing.T) {
+	testCases := []struct {
+		name    string
+		data    string
+		version string
+	}{
+		{
+			name:    "Ignition config",
+			data:    `{"ignition":{"version":"3.4.0"}}`,
+			version: "3.4.0",
+		},
+		{
+			name: "Cloud-init config",
+			data: "#cloud-config\nruncmd:\n- kubeadm init\n",
+		},
+		{
+			name: "JSON without Ignition version",
+			data: `{"storage":{}}`,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.version, ConfigVersion([]byte(tc.data)))
+			require.Equal(t, tc.version != "", IsIgnition([]byte(tc.data)))
+		})
+	}
+}
+
+func TestReplaceConfig(t *testing.T) {
+	t.Run("Ignition v2 config with authorization", func(t *testing.T) {
+		data, err := ReplaceConfig("2.3.0", "https://bucket.example.com/object", "Bearer token")
+		require.NoError(t, err)
+		config := &Config{}
+		require.NoError(t, json.Unmarshal(data, config))
+		require.Equal(t, "2.3.0", config.Ignition.Version)
+		require.Equal(t, "https://bucket.example.com/object", config.Ignition.Config.Replace.Source)
+		require.Equal(t, HTTPHeaders{{Name: "Authorization", Value: "Bearer token"}}, config.Ignition.Config.Replace.HTTPHeaders)
+	})
+	t.Run("Ignition v3 config without authorization", func(t *testing.T) {
+		data, err := ReplaceConfig("3.4.0", "https://bucket.example.com/object?X-Amz-Signature=signature", "")
+		require.NoError(t, err)
+		config := &ignV3Types.Config{}
+		require.NoError(t, json.Unmarshal(data, config))
+		require.Equal(t, "3.4.0", config.Ignition.Version)
+		require.Equal(t, "https://bucket.example.com/object?X-Amz-Signature=signature", *config.Ignition.Config.Replace.Source)
+		require.Empty(t, config.Ignition.Config.Replace.HTTPHeaders)
+	})
+	t.Run("Unsupported Ignition version", func(t *testing.T) {
+		_, err := ReplaceConfig("1.0.0", "https://bucket.example.com/object", "")
+		require.Error(t, err)
+	})
+	t.Run("Invalid Ignition version", func(t *testing.T) {
+		_, err := ReplaceConfig("invalid", "https://bucket.example.com/object", "")
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,166 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// Names of the IBM Cloud services set in the service label of the cloud API metrics.
+const (
+	ServicePowerVS              = "powervs"
+	ServiceVPC                  = "vpc"
+	ServiceResourceController   = "resourcecontroller"
+	ServiceResourceManager      = "resourcemanager"
+	ServiceTransitGateway       = "transitgateway"
+	ServiceGlobalTagging        = "globaltagging"
+	ServiceGlobalSearch         = "globalsearch"
+	ServiceCOS                  = "cos"
+	ServiceEnterpriseManagement = "enterprisemanagement"
+	ServiceDNSServices          = "dnsservices"
+	ServiceCIS                  = "cis"
+)
+
+const (
+	// transportErrorCode is the code label of the requests which failed without a response, like connection failures and timeouts.
+	transportErrorCode = "error"
+
+	operationReconcile = "reconcile"
+	operationDelete    = "delete"
+
+	resultSuccess = "success"
+	resultError   = "error"
+)
+
+var (
+	cloudAPIRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "capibm",
+		Subsystem: "cloud_api",
+		Name:      "request_duration_seconds",
+		Help:      "Duration of the requests to the IBM Cloud APIs by service, HTTP method and status code.",
+		Buckets:   []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60},
+	}, []string{"service", "method", "code"})
+
+	cloudAPIRequestsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "capibm",
+		Subsystem: "cloud_api",
+		Name:      "requests_total",
+		Help:      "Number of requests to the IBM Cloud APIs by service, HTTP method and status code, the code is error when no response was received.",
+	}, []string{"service", "method", "code"})
+
+	cloudAPIThrottledRequestsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "capibm",
+		Subsystem: "cloud_api",
+		Name:      "throttled_requests_total",
+		Help:      "Number of requests to the IBM Cloud APIs rejected by rate limiting with 429 Too Many Requests by service.",
+	}, []string{"service"})
+
+	reconcileDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "capibm",
+		Name:      "reconcile_duration_seconds",
+		Help:      "Duration of the reconciliations by kind, operation (reconcile or delete) and result (success or error).",
+		Buckets:   []float64{0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60, 120},
+	}, []string{"kind", "operation", "result"})
+)
+
+func init() {
+	metrics.Registry.MustRegister(
+		cloudAPIRequestDuration,
+		cloudAPIRequestsTotal,
+		cloudAPIThrottledRequestsTotal,
+		reconcileDuration,
+	)
+}
+
+// instrumentedTransport records the metrics of the requests sent to an IBM Cloud service.
+type instrumentedTransport struct {
+	service string
+	next    http.RoundTripper
+}
+
+// RoundTrip sends the request with the wrapped transport and records its duration and status code.
+func (t *instrumentedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	resp, err := t.next.RoundTrip(req)
+	statusCode := 0
+	if err == nil {
+		statusCode = resp.StatusCode
+	}
+	ObserveRequest(t.service, req.Method, statusCode, time.Since(start))
+	return resp, err
+}
+
+// ObserveRequest records a request of the HTTP method sent to the service which lasted duration, the status code is 0
+// when no response was received.
+func ObserveRequest(service, method string, statusCode int, duration time.Duration) {
+	code := transportErrorCode
+	if statusCode != 0 {
+		code = strconv.Itoa(statusCode)
+	}
+	if statusCode == http.StatusTooManyRequests {
+		cloudAPIThrottledRequestsTotal.WithLabelValues(service).Inc()
+	}
+	cloudAPIRequestDuration.WithLabelValues(service, method, code).Observe(duration.Seconds())
+	cloudAPIRequestsTotal.WithLabelValues(service, method, code).Inc()
+}
+
+// InstrumentTransport returns a transport recording the metrics of the requests sent to the service with next,
+// http.DefaultTransport is used when next is nil.
+func InstrumentTransport(service string, next http.RoundTripper) http.RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	if t, ok := next.(*instrumentedTransport); ok && t.service == service {
+		return t
+	}
+	return &instrumentedTransport{service: service, next: next}
+}
+
+// InstrumentService records the metrics of the requests sent by the IBM Cloud SDK service, the retries of the requests
+// are recorded individually.
+func InstrumentService(service string, baseService *core.BaseService) {
+	if baseService == nil {
+		return
+	}
+	httpClient := baseService.GetHTTPClient()
+	if httpClient == nil {
+		return
+	}
+	httpClient.Transport = InstrumentTransport(service, httpClient.Transport)
+}
+
+// ObserveReconcile records the duration of the reconciliation of the object of the kind started at start,
+// the reconciliations of objects being deleted are recorded with the delete operation.
+func ObserveReconcile(kind string, obj client.Object, start time.Time, err error) {
+	operation := operationReconcile
+	if obj != nil && !obj.GetDeletionTimestamp().IsZero() {
+		operation = operationDelete
+	}
+	result := resultSuccess
+	if err != nil {
+		result = resultError
+	}
+	reconcileDuration.WithLabelValues(kind, operation, result).Observe(time.Since(start).Seconds())
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	infrav1beta2 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/v1beta2"
+)
+
+func TestInstrumentTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/throttled" {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: InstrumentTransport("test", nil)}
+	for _, path := range []string{"/", "/", "/throttled"} {
+		resp, err := client.Get(server.URL + path)
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+	}
+	_, err := client.Get("http://127.0.0.1:0")
+	require.Error(t, err)
+
+	require.Equal(t, float64(2), testutil.ToFloat64(cloudAPIRequestsTotal.WithLabelValues("test", http.MethodGet, "200")))
+	require.Equal(t, float64(1), testutil.ToFloat64(cloudAPIRequestsTotal.WithLabelValues("test", http.MethodGet, "429")))
+	require.Equal(t, float64(1), testutil.ToFloat64(cloudAPIRequestsTotal.WithLabelValues("test", http.MethodGet, transportErrorCode)))
+	require.Equal(t, float64(1), testutil.ToFloat64(cloudAPIThrottledRequestsTotal.WithLabelValues("test")))
+}
+
+func TestInstrumentTransportOnce(t *testing.T) {
+	transport := InstrumentTransport("test", http.DefaultTransport)
+	require.Same(t, transport, InstrumentTransport("test", transport))
+}
+
+func TestInstrumentService(t *testing.T) {
+	baseService, err := core.NewBaseService(&core.ServiceOptions{
+		URL:           "https://test.cloud.ibm.com",
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	require.NoError(t, err)
+
+	InstrumentService("test-service", baseService)
+	transport, ok := baseService.GetHTTPClient().Transport.(*instrumentedTransport)
+	require.True(t, ok)
+	require.Equal(t, "test-service", transport.service)
+}
+
+func TestObserveReconcile(t *testing.T) {
+	machine := &infrav1beta2.IBMPowerVSMachine{}
+	ObserveReconcile("IBMPowerVSMachine", machine, time.Now(), nil)
+	ObserveReconcile("IBMPowerVSMachine", machine, time.Now(), errors.New("failed to reconcile"))
+	machine.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+	ObserveReconcile("IBMPowerVSMachine", machine, time.Now(), nil)
+
+	// One series per operation and result.
+	require.Equal(t, 3, testutil.CollectAndCount(reconcileDuration, "capibm_reconcile_duration_seconds"))
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package names
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"regexp"
+	"strings"
+)
+
+const (
+	// VPCMaxLength is the maximum length of the names of the VPC resources, like VPCs, subnets, load balancers and instances,
+	// and of the transit gateways and the COS buckets.
+	VPCMaxLength = 63
+	// PowerVSInstanceMaxLength is the maximum length of the names of the Power VS instances.
+	PowerVSInstanceMaxLength = 47
+
+	// hashLength is the length of the hash suffix appended to the names which have been changed.
+	hashLength = 8
+)
+
+// invalidCharacters matches the sequences of characters not allowed in the names of the VPC resources.
+var invalidCharacters = regexp.MustCompile(`[^a-z0-9-]+`)
+
+// Normalize returns the name suitable for the VPC resources, made of lowercase letters, digits and dashes, starting with a letter,
+// not ending with a dash and with at most maxLength characters. The name is returned unchanged when it is already valid, otherwise
+// a hash of the name is appended so that distinct names don't collide once normalized, and the result is stable across reconciles.
+func Normalize(name string, maxLength int) string {
+	sanitized := invalidCharacters.ReplaceAllString(strings.ToLower(name), "-")
+	sanitized = strings.Trim(sanitized, "-")
+	if sanitized == "" || sanitized[0] < 'a' || sanitized[0] > 'z' {
+		sanitized = "n" + sanitized
+	}
+	if sanitized == name && len(name) <= maxLength {
+		return name
+	}
+	return withHash(sanitized, name, maxLength)
+}
+
+// Truncate returns the name truncated to maxLength characters, followed by a hash of the name so that distinct names
+// sharing the same prefix don't collide. The name is returned unchanged when it is not longer than maxLength.
+func Truncate(name string, maxLength int) string {
+	if len(name) <= maxLength {
+		return name
+	}
+	return withHash(name, name, maxLength)
+}
+
+// withHash truncates name to fit the hash of original within maxLength characters and appends the hash.
+func withHash(name, original string, maxLength int) string {
+	sum := sha256.Sum256([]byte(original))
+	hash := hex.EncodeToString(sum[:])[:hashLength]
+	if prefixLength := max(maxLength-hashLength-1, 1); len(name) > prefixLength {
+		name = name[:prefixLength]
+	}
+	return strings.TrimRight(name, "-.") + "-" + hash
+}
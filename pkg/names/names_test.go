@@ -0,0 +1,94 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package names
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalize(t *testing.T) {
+	longName := strings.Repeat("a", 60) + "-cluster"
+	testCases := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "valid name",
+			input:    "capi-cluster-vpc",
+			expected: "capi-cluster-vpc",
+		},
+		{
+			name:     "name with invalid characters",
+			input:    "Capi.Cluster_VPC",
+			expected: "capi-cluster-vpc-d0727502",
+		},
+		{
+			name:     "name starting with a digit",
+			input:    "1-cluster",
+			expected: "n1-cluster-c2ddf1eb",
+		},
+		{
+			name:     "long name",
+			input:    longName,
+			expected: strings.Repeat("a", 54) + "-a46fe0f5",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			normalized := Normalize(tc.input, VPCMaxLength)
+			require.Equal(t, tc.expected, normalized)
+			require.LessOrEqual(t, len(normalized), VPCMaxLength)
+			require.Equal(t, normalized, Normalize(normalized, VPCMaxLength))
+		})
+	}
+}
+
+func TestNormalizeDoesNotCollide(t *testing.T) {
+	prefix := strings.Repeat("a", VPCMaxLength)
+	require.NotEqual(t, Normalize(prefix+"-1", VPCMaxLength), Normalize(prefix+"-2", VPCMaxLength))
+	require.NotEqual(t, Normalize("cluster.a", VPCMaxLength), Normalize("cluster-a", VPCMaxLength))
+}
+
+func TestTruncate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "short name",
+			input:    "capi-machine-0",
+			expected: "capi-machine-0",
+		},
+		{
+			name:     "long name",
+			input:    strings.Repeat("b", 38) + "-control-plane-0",
+			expected: strings.Repeat("b", 38) + "-3d7d11e8",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			truncated := Truncate(tc.input, PowerVSInstanceMaxLength)
+			require.Equal(t, tc.expected, truncated)
+			require.LessOrEqual(t, len(truncated), PowerVSInstanceMaxLength)
+		})
+	}
+}
@@ -0,0 +1,174 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ratelimit
+
+import (
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"golang.org/x/time/rate"
+)
+
+var (
+	// QPS is the maximum number of requests per second sent to each IBM Cloud service by the controller, 0 disables the rate limiting.
+	QPS float64 = 20
+	// Burst is the maximum number of requests sent to each IBM Cloud service at once when QPS is set.
+	Burst = 40
+	// MaxRetries is the maximum number of retries of the requests rejected with 429 Too Many Requests or failing with
+	// 502, 503 or 504, 0 disables the retries.
+	MaxRetries = 3
+)
+
+const (
+	// minBackoff is the delay before the first retry, doubled on every retry.
+	minBackoff = 1 * time.Second
+	// maxBackoff caps the delay between the retries, along with the delay requested with the Retry-After header.
+	maxBackoff = 30 * time.Second
+)
+
+var limiters = struct {
+	sync.Mutex
+	byService map[string]*rate.Limiter
+}{byService: map[string]*rate.Limiter{}}
+
+// limiterFor returns the rate limiter shared by the clients of the service, nil when the rate limiting is disabled.
+func limiterFor(service string) *rate.Limiter {
+	if QPS <= 0 {
+		return nil
+	}
+	limiters.Lock()
+	defer limiters.Unlock()
+	limiter, ok := limiters.byService[service]
+	if !ok {
+		limiter = rate.NewLimiter(rate.Limit(QPS), Burst)
+		limiters.byService[service] = limiter
+	}
+	return limiter
+}
+
+// limitedTransport rate limits and retries the requests sent to an IBM Cloud service.
+type limitedTransport struct {
+	service string
+	next    http.RoundTripper
+	// sleep waits for the delay or until the request is canceled, it is replaced in the tests.
+	sleep func(req *http.Request, delay time.Duration) error
+}
+
+// RoundTrip waits for the rate limiter of the service and sends the request, which is retried with an exponential backoff
+// when it is throttled or the service is temporarily unavailable.
+func (t *limitedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		if limiter := limiterFor(t.service); limiter != nil {
+			if err := limiter.Wait(req.Context()); err != nil {
+				return nil, err
+			}
+		}
+		resp, err := t.next.RoundTrip(req)
+		if err != nil || attempt >= MaxRetries || !shouldRetry(req, resp) {
+			return resp, err
+		}
+
+		body, err := rewind(req)
+		if err != nil {
+			// The request can't be sent again, return the response as is.
+			return resp, nil //nolint:nilerr
+		}
+		delay := backoff(attempt, resp)
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+		if err := t.sleep(req, delay); err != nil {
+			return nil, err
+		}
+		req.Body = body
+	}
+}
+
+// shouldRetry returns true when the request was throttled, or the service is temporarily unavailable and the request is idempotent.
+// The requests failing with a server error are not retried otherwise, as they may have been processed.
+func shouldRetry(req *http.Request, resp *http.Response) bool {
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		switch req.Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+			return true
+		}
+	}
+	return false
+}
+
+// rewind returns a copy of the body of the request to send it again.
+func rewind(req *http.Request) (io.ReadCloser, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return req.Body, nil
+	}
+	if req.GetBody == nil {
+		return nil, http.ErrBodyNotAllowed
+	}
+	return req.GetBody()
+}
+
+// backoff returns the delay before the retry of the attempt, the Retry-After header of the response takes precedence.
+func backoff(attempt int, resp *http.Response) time.Duration {
+	if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && seconds >= 0 {
+		return min(time.Duration(seconds)*time.Second, maxBackoff)
+	}
+	delay := min(minBackoff<<attempt, maxBackoff)
+	// Add up to 20% of jitter so the throttled clients do not retry at once.
+	return delay + time.Duration(rand.Int63n(int64(delay/5)+1)) //nolint:gosec
+}
+
+func sleep(req *http.Request, delay time.Duration) error {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-req.Context().Done():
+		return req.Context().Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// Transport returns a transport rate limiting and retrying the requests sent to the service with next,
+// http.DefaultTransport is used when next is nil.
+func Transport(service string, next http.RoundTripper) http.RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	if t, ok := next.(*limitedTransport); ok && t.service == service {
+		return t
+	}
+	return &limitedTransport{service: service, next: next, sleep: sleep}
+}
+
+// LimitService rate limits and retries the requests sent by the IBM Cloud SDK service.
+func LimitService(service string, baseService *core.BaseService) {
+	if baseService == nil {
+		return
+	}
+	httpClient := baseService.GetHTTPClient()
+	if httpClient == nil {
+		return
+	}
+	httpClient.Transport = Transport(service, httpClient.Transport)
+}
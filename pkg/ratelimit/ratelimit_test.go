@@ -0,0 +1,138 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ratelimit
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newTestTransport(t *testing.T, statusCodes ...int) (*limitedTransport, *[]string, *[]time.Duration, string) {
+	t.Helper()
+	var bodies []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		statusCode := http.StatusOK
+		if len(bodies) <= len(statusCodes) {
+			statusCode = statusCodes[len(bodies)-1]
+		}
+		if statusCode == http.StatusTooManyRequests {
+			w.Header().Set("Retry-After", "2")
+		}
+		w.WriteHeader(statusCode)
+	}))
+	t.Cleanup(server.Close)
+
+	var delays []time.Duration
+	transport := Transport("test", nil).(*limitedTransport)
+	transport.sleep = func(_ *http.Request, delay time.Duration) error {
+		delays = append(delays, delay)
+		return nil
+	}
+	return transport, &bodies, &delays, server.URL
+}
+
+func TestRoundTrip(t *testing.T) {
+	QPS = 0
+	t.Cleanup(func() { QPS = 20 })
+
+	t.Run("Throttled request is retried after the delay of Retry-After", func(t *testing.T) {
+		transport, bodies, delays, url := newTestTransport(t, http.StatusTooManyRequests)
+		req, err := http.NewRequest(http.MethodPost, url, strings.NewReader(`{"name":"foo"}`))
+		require.NoError(t, err)
+
+		resp, err := transport.RoundTrip(req)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		require.Equal(t, []string{`{"name":"foo"}`, `{"name":"foo"}`}, *bodies)
+		require.Equal(t, []time.Duration{2 * time.Second}, *delays)
+	})
+
+	t.Run("Idempotent request is retried until the maximum number of retries", func(t *testing.T) {
+		transport, bodies, delays, url := newTestTransport(t, http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusServiceUnavailable)
+		req, err := http.NewRequest(http.MethodGet, url, nil)
+		require.NoError(t, err)
+
+		resp, err := transport.RoundTrip(req)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+		require.Len(t, *bodies, MaxRetries+1)
+		require.Len(t, *delays, MaxRetries)
+		for i, delay := range *delays {
+			require.GreaterOrEqual(t, delay, minBackoff<<i)
+		}
+	})
+
+	t.Run("Non idempotent request failing with a server error is not retried", func(t *testing.T) {
+		transport, bodies, _, url := newTestTransport(t, http.StatusServiceUnavailable)
+		req, err := http.NewRequest(http.MethodPost, url, strings.NewReader(`{"name":"foo"}`))
+		require.NoError(t, err)
+
+		resp, err := transport.RoundTrip(req)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+		require.Len(t, *bodies, 1)
+	})
+
+	t.Run("Request is not retried when the retries are disabled", func(t *testing.T) {
+		MaxRetries = 0
+		t.Cleanup(func() { MaxRetries = 3 })
+		transport, bodies, _, url := newTestTransport(t, http.StatusTooManyRequests)
+		req, err := http.NewRequest(http.MethodGet, url, nil)
+		require.NoError(t, err)
+
+		resp, err := transport.RoundTrip(req)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+		require.Len(t, *bodies, 1)
+	})
+}
+
+func TestBackoff(t *testing.T) {
+	withRetryAfter := func(value string) *http.Response {
+		return &http.Response{Header: http.Header{"Retry-After": []string{value}}}
+	}
+	require.Equal(t, 5*time.Second, backoff(0, withRetryAfter("5")))
+	require.Equal(t, maxBackoff, backoff(0, withRetryAfter("3600")))
+
+	for attempt, expected := range []time.Duration{minBackoff, 2 * minBackoff, 4 * minBackoff, maxBackoff} {
+		if attempt == 3 {
+			attempt = 10
+		}
+		delay := backoff(attempt, &http.Response{Header: http.Header{}})
+		require.GreaterOrEqual(t, delay, expected)
+		require.LessOrEqual(t, delay, expected+expected/5)
+	}
+}
+
+func TestLimiterFor(t *testing.T) {
+	QPS = 0
+	require.Nil(t, limiterFor("test-disabled"))
+
+	QPS = 20
+	limiter := limiterFor("test-shared")
+	require.NotNil(t, limiter)
+	require.Same(t, limiter, limiterFor("test-shared"))
+	require.NotSame(t, limiter, limiterFor("test-other"))
+}
@@ -0,0 +1,289 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package state
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	capiv1beta1 "sigs.k8s.io/cluster-api/api/v1beta1"
+
+	infrav1beta2 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/v1beta2"
+)
+
+const (
+	// VPCClusterKind is the kind of the infrastructure cluster of the clusters provisioned in IBM Cloud VPC.
+	VPCClusterKind = "IBMVPCCluster"
+	// PowerVSClusterKind is the kind of the infrastructure cluster of the clusters provisioned in Power VS.
+	PowerVSClusterKind = "IBMPowerVSCluster"
+	// VPCMachineKind is the kind of the infrastructure machine of the machines provisioned in IBM Cloud VPC.
+	VPCMachineKind = "IBMVPCMachine"
+	// PowerVSMachineKind is the kind of the infrastructure machine of the machines provisioned in Power VS.
+	PowerVSMachineKind = "IBMPowerVSMachine"
+	// PowerVSImageKind is the kind of the images imported into Power VS.
+	PowerVSImageKind = "IBMPowerVSImage"
+)
+
+// ClusterSummary is the state of the infrastructure cluster of a Cluster.
+type ClusterSummary struct {
+	// Name is the name of the infrastructure cluster.
+	Name string
+	// Namespace is the namespace of the infrastructure cluster.
+	Namespace string
+	// Kind is the kind of the infrastructure cluster, IBMVPCCluster or IBMPowerVSCluster.
+	Kind string
+	// Ready is true once the infrastructure of the cluster is provisioned.
+	Ready bool
+	// Endpoint is the endpoint of the control plane, empty until the load balancer of the control plane is provisioned.
+	Endpoint capiv1beta1.APIEndpoint
+	// FailureDomains are the failure domains reported for the cluster.
+	FailureDomains capiv1beta1.FailureDomains
+	// Conditions are the conditions of the infrastructure cluster.
+	Conditions capiv1beta1.Conditions
+}
+
+// MachineSummary maps an infrastructure machine of a Cluster to the instance backing it.
+type MachineSummary struct {
+	// Name is the name of the infrastructure machine.
+	Name string
+	// Namespace is the namespace of the infrastructure machine.
+	Namespace string
+	// Kind is the kind of the infrastructure machine, IBMVPCMachine or IBMPowerVSMachine.
+	Kind string
+	// MachineName is the name of the owning Machine, empty until the Machine set its owner reference.
+	MachineName string
+	// InstanceID is the ID of the instance, empty until the instance is created.
+	InstanceID string
+	// ProviderID is the provider ID of the instance, empty until the instance is created.
+	ProviderID string
+	// InstanceState is the state of the instance reported by IBM Cloud.
+	InstanceState string
+	// Zone is the zone of the instance.
+	Zone string
+	// Addresses are the addresses of the instance.
+	Addresses []corev1.NodeAddress
+	// Ready is true once the instance is running.
+	Ready bool
+}
+
+// ImageSummary is the state of an image used by the machines of a Cluster.
+type ImageSummary struct {
+	// Name is the name of the object holding the image.
+	Name string
+	// Namespace is the namespace of the object holding the image.
+	Namespace string
+	// Kind is the kind of the object holding the image, IBMPowerVSImage or IBMVPCCluster for the image imported along with the VPC cluster.
+	Kind string
+	// ImageID is the ID of the image, empty until the import of the image started.
+	ImageID string
+	// State is the state of the image reported by IBM Cloud, only set for Power VS images.
+	State string
+	// Ready is true once the image can be used to create instances.
+	Ready bool
+}
+
+// Reader queries the state of the provider objects, the scheme of its client must contain the types
+// of Cluster API and of the provider.
+type Reader struct {
+	client client.Reader
+}
+
+// NewReader returns a Reader querying the provider objects with the client.
+func NewReader(c client.Reader) *Reader {
+	return &Reader{client: c}
+}
+
+// Cluster returns the state of the infrastructure cluster of the Cluster referenced by the key.
+func (r *Reader) Cluster(ctx context.Context, key client.ObjectKey) (*ClusterSummary, error) {
+	ref, err := r.infrastructureRef(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	infraKey := client.ObjectKey{Namespace: key.Namespace, Name: ref.Name}
+	switch ref.Kind {
+	case VPCClusterKind:
+		cluster := &infrav1beta2.IBMVPCCluster{}
+		if err := r.client.Get(ctx, infraKey, cluster); err != nil {
+			return nil, fmt.Errorf("failed to get IBMVPCCluster %s: %w", infraKey, err)
+		}
+		return &ClusterSummary{
+			Name:           cluster.Name,
+			Namespace:      cluster.Namespace,
+			Kind:           VPCClusterKind,
+			Ready:          cluster.Status.Ready,
+			Endpoint:       cluster.Spec.ControlPlaneEndpoint,
+			FailureDomains: cluster.Status.FailureDomains,
+			Conditions:     cluster.Status.Conditions,
+		}, nil
+	case PowerVSClusterKind:
+		cluster := &infrav1beta2.IBMPowerVSCluster{}
+		if err := r.client.Get(ctx, infraKey, cluster); err != nil {
+			return nil, fmt.Errorf("failed to get IBMPowerVSCluster %s: %w", infraKey, err)
+		}
+		return &ClusterSummary{
+			Name:           cluster.Name,
+			Namespace:      cluster.Namespace,
+			Kind:           PowerVSClusterKind,
+			Ready:          cluster.Status.Ready,
+			Endpoint:       cluster.Spec.ControlPlaneEndpoint,
+			FailureDomains: cluster.Status.FailureDomains,
+			Conditions:     cluster.Status.Conditions,
+		}, nil
+	default:
+		return nil, fmt.Errorf("infrastructure %s of cluster %s is not provided by the provider", ref.Kind, key)
+	}
+}
+
+// FailureDomains returns the failure domains reported for the Cluster referenced by the key.
+func (r *Reader) FailureDomains(ctx context.Context, key client.ObjectKey) (capiv1beta1.FailureDomains, error) {
+	cluster, err := r.Cluster(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	return cluster.FailureDomains, nil
+}
+
+// Machines returns the infrastructure machines of the Cluster referenced by the key along with their instances, sorted by name.
+func (r *Reader) Machines(ctx context.Context, key client.ObjectKey) ([]MachineSummary, error) {
+	opts := []client.ListOption{client.InNamespace(key.Namespace), client.MatchingLabels{capiv1beta1.ClusterNameLabel: key.Name}}
+	var machines []MachineSummary
+
+	vpcMachines := &infrav1beta2.IBMVPCMachineList{}
+	if err := r.client.List(ctx, vpcMachines, opts...); err != nil {
+		return nil, fmt.Errorf("failed to list IBMVPCMachines of cluster %s: %w", key, err)
+	}
+	for _, machine := range vpcMachines.Items {
+		machines = append(machines, MachineSummary{
+			Name:          machine.Name,
+			Namespace:     machine.Namespace,
+			Kind:          VPCMachineKind,
+			MachineName:   ownerMachineName(machine.OwnerReferences),
+			InstanceID:    machine.Status.InstanceID,
+			ProviderID:    ptr.Deref(machine.Spec.ProviderID, ""),
+			InstanceState: machine.Status.InstanceStatus,
+			Zone:          machine.Spec.Zone,
+			Addresses:     machine.Status.Addresses,
+			Ready:         machine.Status.Ready,
+		})
+	}
+
+	powerVSMachines := &infrav1beta2.IBMPowerVSMachineList{}
+	if err := r.client.List(ctx, powerVSMachines, opts...); err != nil {
+		return nil, fmt.Errorf("failed to list IBMPowerVSMachines of cluster %s: %w", key, err)
+	}
+	for _, machine := range powerVSMachines.Items {
+		machines = append(machines, MachineSummary{
+			Name:          machine.Name,
+			Namespace:     machine.Namespace,
+			Kind:          PowerVSMachineKind,
+			MachineName:   ownerMachineName(machine.OwnerReferences),
+			InstanceID:    machine.Status.InstanceID,
+			ProviderID:    ptr.Deref(machine.Spec.ProviderID, ""),
+			InstanceState: string(machine.Status.InstanceState),
+			Zone:          ptr.Deref(machine.Status.Zone, ""),
+			Addresses:     machine.Status.Addresses,
+			Ready:         machine.Status.Ready,
+		})
+	}
+
+	sort.Slice(machines, func(i, j int) bool {
+		return machines[i].Name < machines[j].Name
+	})
+	return machines, nil
+}
+
+// Images returns the images of the Cluster referenced by the key, sorted by name. These are the IBMPowerVSImages
+// of the cluster and, for VPC clusters, the image imported along with the cluster.
+func (r *Reader) Images(ctx context.Context, key client.ObjectKey) ([]ImageSummary, error) {
+	var images []ImageSummary
+
+	powerVSImages := &infrav1beta2.IBMPowerVSImageList{}
+	if err := r.client.List(ctx, powerVSImages, client.InNamespace(key.Namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list IBMPowerVSImages of cluster %s: %w", key, err)
+	}
+	for _, image := range powerVSImages.Items {
+		if image.Spec.ClusterName != key.Name {
+			continue
+		}
+		images = append(images, ImageSummary{
+			Name:      image.Name,
+			Namespace: image.Namespace,
+			Kind:      PowerVSImageKind,
+			ImageID:   image.Status.ImageID,
+			State:     string(image.Status.ImageState),
+			Ready:     image.Status.Ready,
+		})
+	}
+
+	ref, err := r.infrastructureRef(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if ref.Kind == VPCClusterKind {
+		infraKey := client.ObjectKey{Namespace: key.Namespace, Name: ref.Name}
+		cluster := &infrav1beta2.IBMVPCCluster{}
+		if err := r.client.Get(ctx, infraKey, cluster); err != nil {
+			return nil, fmt.Errorf("failed to get IBMVPCCluster %s: %w", infraKey, err)
+		}
+		if cluster.Status.Image != nil {
+			images = append(images, ImageSummary{
+				Name:      cluster.Name,
+				Namespace: cluster.Namespace,
+				Kind:      VPCClusterKind,
+				ImageID:   cluster.Status.Image.ID,
+				Ready:     cluster.Status.Image.Ready,
+			})
+		}
+	}
+
+	sort.Slice(images, func(i, j int) bool {
+		return images[i].Name < images[j].Name
+	})
+	return images, nil
+}
+
+func (r *Reader) infrastructureRef(ctx context.Context, key client.ObjectKey) (*corev1.ObjectReference, error) {
+	cluster := &capiv1beta1.Cluster{}
+	if err := r.client.Get(ctx, key, cluster); err != nil {
+		return nil, fmt.Errorf("failed to get cluster %s: %w", key, err)
+	}
+	if cluster.Spec.InfrastructureRef == nil {
+		return nil, fmt.Errorf("cluster %s has no infrastructure reference", key)
+	}
+	return cluster.Spec.InfrastructureRef, nil
+}
+
+func ownerMachineName(refs []metav1.OwnerReference) string {
+	for _, ref := range refs {
+		gv, err := schema.ParseGroupVersion(ref.APIVersion)
+		if err != nil {
+			continue
+		}
+		if ref.Kind == "Machine" && gv.Group == capiv1beta1.GroupVersion.Group {
+			return ref.Name
+		}
+	}
+	return ""
+}
@@ -0,0 +1,182 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package state
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	capiv1beta1 "sigs.k8s.io/cluster-api/api/v1beta1"
+
+	infrav1beta2 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/v1beta2"
+)
+
+func newReader(t *testing.T, objs ...client.Object) *Reader {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, capiv1beta1.AddToScheme(scheme))
+	require.NoError(t, infrav1beta2.AddToScheme(scheme))
+	return NewReader(fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build())
+}
+
+func newCluster(kind, name string) *capiv1beta1.Cluster {
+	return &capiv1beta1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "capi-cluster", Namespace: "default"},
+		Spec: capiv1beta1.ClusterSpec{
+			InfrastructureRef: &corev1.ObjectReference{
+				APIVersion: infrav1beta2.GroupVersion.String(),
+				Kind:       kind,
+				Name:       name,
+			},
+		},
+	}
+}
+
+func TestCluster(t *testing.T) {
+	key := client.ObjectKey{Namespace: "default", Name: "capi-cluster"}
+	endpoint := capiv1beta1.APIEndpoint{Host: "cluster.example.com", Port: 6443}
+
+	t.Run("Should return the state of the IBMVPCCluster", func(t *testing.T) {
+		vpcCluster := &infrav1beta2.IBMVPCCluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "vpc-cluster", Namespace: "default"},
+			Spec:       infrav1beta2.IBMVPCClusterSpec{ControlPlaneEndpoint: endpoint},
+			Status: infrav1beta2.IBMVPCClusterStatus{
+				Ready:          true,
+				FailureDomains: capiv1beta1.FailureDomains{"us-south-1": {ControlPlane: true}},
+			},
+		}
+		reader := newReader(t, newCluster(VPCClusterKind, "vpc-cluster"), vpcCluster)
+		summary, err := reader.Cluster(context.Background(), key)
+		require.NoError(t, err)
+		require.Equal(t, "vpc-cluster", summary.Name)
+		require.Equal(t, VPCClusterKind, summary.Kind)
+		require.True(t, summary.Ready)
+		require.Equal(t, endpoint, summary.Endpoint)
+
+		failureDomains, err := reader.FailureDomains(context.Background(), key)
+		require.NoError(t, err)
+		require.Equal(t, vpcCluster.Status.FailureDomains, failureDomains)
+	})
+	t.Run("Should return the state of the IBMPowerVSCluster", func(t *testing.T) {
+		powerVSCluster := &infrav1beta2.IBMPowerVSCluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "powervs-cluster", Namespace: "default"},
+			Spec:       infrav1beta2.IBMPowerVSClusterSpec{ControlPlaneEndpoint: endpoint},
+			Status: infrav1beta2.IBMPowerVSClusterStatus{
+				FailureDomains: capiv1beta1.FailureDomains{"dal10": {ControlPlane: true, Attributes: map[string]string{"region": "us-south"}}},
+			},
+		}
+		reader := newReader(t, newCluster(PowerVSClusterKind, "powervs-cluster"), powerVSCluster)
+		summary, err := reader.Cluster(context.Background(), key)
+		require.NoError(t, err)
+		require.Equal(t, PowerVSClusterKind, summary.Kind)
+		require.False(t, summary.Ready)
+		require.Equal(t, endpoint, summary.Endpoint)
+		require.Equal(t, powerVSCluster.Status.FailureDomains, summary.FailureDomains)
+	})
+	t.Run("Should fail when the infrastructure is not provided by the provider", func(t *testing.T) {
+		reader := newReader(t, newCluster("DockerCluster", "docker-cluster"))
+		_, err := reader.Cluster(context.Background(), key)
+		require.Error(t, err)
+	})
+	t.Run("Should fail when the cluster doesn't exist", func(t *testing.T) {
+		reader := newReader(t)
+		_, err := reader.Cluster(context.Background(), key)
+		require.Error(t, err)
+	})
+}
+
+func TestMachines(t *testing.T) {
+	labels := map[string]string{capiv1beta1.ClusterNameLabel: "capi-cluster"}
+	owner := metav1.OwnerReference{APIVersion: capiv1beta1.GroupVersion.String(), Kind: "Machine", Name: "machine-0"}
+	reader := newReader(t,
+		&infrav1beta2.IBMVPCMachine{
+			ObjectMeta: metav1.ObjectMeta{Name: "vpc-machine", Namespace: "default", Labels: labels, OwnerReferences: []metav1.OwnerReference{owner}},
+			Spec:       infrav1beta2.IBMVPCMachineSpec{Zone: "us-south-1", ProviderID: ptr.To("ibm://account/us-south/us-south-1/instance-id")},
+			Status:     infrav1beta2.IBMVPCMachineStatus{InstanceID: "instance-id", InstanceStatus: "running", Ready: true},
+		},
+		&infrav1beta2.IBMPowerVSMachine{
+			ObjectMeta: metav1.ObjectMeta{Name: "powervs-machine", Namespace: "default", Labels: labels},
+			Status:     infrav1beta2.IBMPowerVSMachineStatus{InstanceID: "pvm-id", InstanceState: infrav1beta2.PowerVSInstanceStateBUILD, Zone: ptr.To("dal10")},
+		},
+		&infrav1beta2.IBMVPCMachine{
+			ObjectMeta: metav1.ObjectMeta{Name: "other-machine", Namespace: "default", Labels: map[string]string{capiv1beta1.ClusterNameLabel: "other"}},
+		},
+	)
+
+	machines, err := reader.Machines(context.Background(), client.ObjectKey{Namespace: "default", Name: "capi-cluster"})
+	require.NoError(t, err)
+	require.Len(t, machines, 2)
+
+	require.Equal(t, "powervs-machine", machines[0].Name)
+	require.Equal(t, PowerVSMachineKind, machines[0].Kind)
+	require.Empty(t, machines[0].MachineName)
+	require.Equal(t, "pvm-id", machines[0].InstanceID)
+	require.Equal(t, string(infrav1beta2.PowerVSInstanceStateBUILD), machines[0].InstanceState)
+	require.Equal(t, "dal10", machines[0].Zone)
+
+	require.Equal(t, "vpc-machine", machines[1].Name)
+	require.Equal(t, VPCMachineKind, machines[1].Kind)
+	require.Equal(t, "machine-0", machines[1].MachineName)
+	require.Equal(t, "instance-id", machines[1].InstanceID)
+	require.Equal(t, "ibm://account/us-south/us-south-1/instance-id", machines[1].ProviderID)
+	require.Equal(t, "us-south-1", machines[1].Zone)
+	require.True(t, machines[1].Ready)
+}
+
+func TestImages(t *testing.T) {
+	key := client.ObjectKey{Namespace: "default", Name: "capi-cluster"}
+
+	t.Run("Should return the IBMPowerVSImages of the cluster", func(t *testing.T) {
+		reader := newReader(t,
+			newCluster(PowerVSClusterKind, "powervs-cluster"),
+			&infrav1beta2.IBMPowerVSImage{
+				ObjectMeta: metav1.ObjectMeta{Name: "image", Namespace: "default"},
+				Spec:       infrav1beta2.IBMPowerVSImageSpec{ClusterName: "capi-cluster"},
+				Status:     infrav1beta2.IBMPowerVSImageStatus{ImageID: "image-id", ImageState: infrav1beta2.PowerVSImageStateACTIVE, Ready: true},
+			},
+			&infrav1beta2.IBMPowerVSImage{
+				ObjectMeta: metav1.ObjectMeta{Name: "other-image", Namespace: "default"},
+				Spec:       infrav1beta2.IBMPowerVSImageSpec{ClusterName: "other"},
+			},
+		)
+		images, err := reader.Images(context.Background(), key)
+		require.NoError(t, err)
+		require.Equal(t, []ImageSummary{{Name: "image", Namespace: "default", Kind: PowerVSImageKind, ImageID: "image-id", State: string(infrav1beta2.PowerVSImageStateACTIVE), Ready: true}}, images)
+	})
+	t.Run("Should return the image of the IBMVPCCluster", func(t *testing.T) {
+		reader := newReader(t,
+			newCluster(VPCClusterKind, "vpc-cluster"),
+			&infrav1beta2.IBMVPCCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "vpc-cluster", Namespace: "default"},
+				Status:     infrav1beta2.IBMVPCClusterStatus{Image: &infrav1beta2.ResourceStatus{ID: "image-id"}},
+			},
+		)
+		images, err := reader.Images(context.Background(), key)
+		require.NoError(t, err)
+		require.Equal(t, []ImageSummary{{Name: "vpc-cluster", Namespace: "default", Kind: VPCClusterKind, ImageID: "image-id"}}, images)
+	})
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package supportmatrix
+
+import (
+	_ "embed"
+	"fmt"
+	"sync/atomic"
+
+	"sigs.k8s.io/yaml"
+
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/endpoints"
+)
+
+// ConfigMapKey is the key in the ConfigMap data holding the support matrix.
+const ConfigMapKey = "matrix.yaml"
+
+// Feature is an IBM Cloud feature whose availability differs across regions.
+// Only the features the controllers can provision are listed. The VPC network load balancers are not, as the load balancers
+// created by the controllers are application load balancers, and neither is the dual-stack networking, as VPC subnets are
+// IPv4-only, refer docs/proposal/20261014-vpc-dual-stack.md.
+type Feature string
+
+const (
+	// PowerEdgeRouter is the Power Edge Router(PER) capability of a Power VS zone.
+	PowerEdgeRouter Feature = "PER"
+	// GPUProfiles is the availability of GPU instance profiles.
+	GPUProfiles Feature = "GPUProfiles"
+)
+
+// Matrix holds the zones and regions in which each feature is supported.
+type Matrix struct {
+	// Features maps a feature to the list of zones or regions in which it is supported.
+	// "*" can be used to indicate that the feature is supported in all the locations.
+	Features map[Feature][]string `json:"features"`
+}
+
+//go:embed matrix.yaml
+var defaultMatrix []byte
+
+var current atomic.Pointer[Matrix]
+
+func init() {
+	Reset()
+}
+
+// Parse parses the support matrix from the data.
+func Parse(data []byte) (*Matrix, error) {
+	m := &Matrix{}
+	if err := yaml.UnmarshalStrict(data, m); err != nil {
+		return nil, fmt.Errorf("failed to parse support matrix: %w", err)
+	}
+	if m.Features == nil {
+		return nil, fmt.Errorf("failed to parse support matrix: features are not set")
+	}
+	return m, nil
+}
+
+// Load parses the support matrix from the data and replaces the one currently in use.
+func Load(data []byte) error {
+	m, err := Parse(data)
+	if err != nil {
+		return err
+	}
+	current.Store(m)
+	return nil
+}
+
+// Reset restores the support matrix embedded in the binary.
+func Reset() {
+	m, err := Parse(defaultMatrix)
+	if err != nil {
+		panic(err)
+	}
+	current.Store(m)
+}
+
+// Get returns the support matrix currently in use.
+func Get() *Matrix {
+	return current.Load()
+}
+
+// IsSupported returns true if the feature is supported in the location as per the support matrix currently in use.
+func IsSupported(feature Feature, location string) bool {
+	return Get().IsSupported(feature, location)
+}
+
+// IsSupported returns true if the feature is supported in the location, where location is either a zone or a region.
+// A feature which is not part of the matrix is considered to be supported in all the locations.
+func (m *Matrix) IsSupported(feature Feature, location string) bool {
+	locations, ok := m.Features[feature]
+	if !ok {
+		return true
+	}
+	region := endpoints.ConstructRegionFromZone(location)
+	for _, l := range locations {
+		if l == "*" || l == location || l == region {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package supportmatrix
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestIsSupported(t *testing.T) {
+	testCases := []struct {
+		name     string
+		feature  Feature
+		location string
+		expected bool
+	}{
+		{
+			name:     "PER supported in zone",
+			feature:  PowerEdgeRouter,
+			location: "dal10",
+			expected: true,
+		},
+		{
+			name:     "PER supported in zone through its region",
+			feature:  PowerEdgeRouter,
+			location: "us-south",
+			expected: true,
+		},
+		{
+			name:     "PER not supported in zone",
+			feature:  PowerEdgeRouter,
+			location: "foo-zone",
+			expected: false,
+		},
+		{
+			name:     "GPUProfiles supported in VPC zone through its region",
+			feature:  GPUProfiles,
+			location: "eu-de-2",
+			expected: true,
+		},
+		{
+			name:     "GPUProfiles not supported in VPC zone",
+			feature:  GPUProfiles,
+			location: "br-sao-1",
+			expected: false,
+		},
+		{
+			name:     "Unknown feature is supported",
+			feature:  Feature("foo"),
+			location: "us-south-1",
+			expected: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(IsSupported(tc.feature, tc.location)).To(Equal(tc.expected))
+		})
+	}
+}
+
+func TestLoad(t *testing.T) {
+	t.Cleanup(Reset)
+
+	t.Run("Should replace the support matrix", func(t *testing.T) {
+		g := NewWithT(t)
+		g.Expect(Load([]byte("features:\n  GPUProfiles:\n  - \"*\"\n"))).To(Succeed())
+		g.Expect(IsSupported(GPUProfiles, "br-sao-1")).To(BeTrue())
+		g.Expect(IsSupported(PowerEdgeRouter, "foo-zone")).To(BeTrue())
+	})
+	t.Run("Should retain the support matrix on invalid data", func(t *testing.T) {
+		g := NewWithT(t)
+		matrix := Get()
+		g.Expect(Load([]byte("foo: bar"))).ToNot(Succeed())
+		g.Expect(Get()).To(Equal(matrix))
+	})
+	t.Run("Should restore the default support matrix", func(t *testing.T) {
+		g := NewWithT(t)
+		Reset()
+		g.Expect(IsSupported(GPUProfiles, "br-sao-1")).To(BeFalse())
+	})
+}